@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"sort"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/joho/godotenv"
+
+	"sso/internal/modules/identity"
+)
+
+var (
+	dbHost     string
+	dbPort     string
+	dbUser     string
+	dbPassword string
+	dbName     string
+	dbTLS      string
+
+	normalizeApply bool
+)
+
+func init() {
+	_ = godotenv.Load()
+
+	flag.StringVar(&dbHost, "host", envOr("DB_HOST", "127.0.0.1"), "database host")
+	flag.StringVar(&dbPort, "port", envOr("DB_PORT", "3306"), "database port")
+	flag.StringVar(&dbUser, "user", envOr("DB_USERNAME", "root"), "database user")
+	flag.StringVar(&dbPassword, "password", os.Getenv("DB_PASSWORD"), "database password")
+	flag.StringVar(&dbName, "db", envOr("DB_NAME", "sso"), "database name")
+	flag.StringVar(&dbTLS, "tls", envOr("DB_TLS", "false"), "database TLS mode (false|true|skip-verify|preferred)")
+
+	flag.BoolVar(&normalizeApply, "apply", false, "write the identifier keys of non-colliding rows; without it only the collision report is printed")
+
+	flag.Parse()
+}
+
+// identity-normalize (re)computes users.email_key, username_key and the
+// two skeleton columns (migration 0015) and reports rows whose keys
+// collide — accounts that the normalisation rules consider the same
+// identifier, or that look alike. Colliding rows are never keyed: they
+// stay writable through the legacy email / username lookup until an
+// operator merges, renames or deletes one side, after which a rerun keys
+// them. Keys are unique per tenant (migration 0024), so only rows of
+// the same tenant can collide. Rerun after any change to the normalisation rules or the
+// confusables table as well.
+func main() {
+	appDSN := buildAppDSN(dbHost, dbPort, dbUser, dbPassword, dbName, dbTLS)
+	collisions, err := identityNormalize(appDSN, normalizeApply)
+	if err != nil {
+		log.Fatalf("identity:normalize: %v", err)
+	}
+	if collisions > 0 && !normalizeApply {
+		os.Exit(2)
+	}
+}
+
+func buildAppDSN(host, port, user, password, dbname, tls string) string {
+	addr := net.JoinHostPort(host, port)
+	return fmt.Sprintf(
+		"%s:%s@tcp(%s)/%s?parseTime=true&loc=UTC&tls=%s",
+		user, password, addr, dbname, tls,
+	)
+}
+
+const (
+	selectUsers = `SELECT id, tenant_id, email, username, email_key, username_key, email_skeleton, username_skeleton FROM users ORDER BY id`
+
+	updateUserKeys = `UPDATE users SET email_key = ?, username_key = ?, email_skeleton = ?, username_skeleton = ? WHERE id = ?`
+)
+
+type userRow struct {
+	id       string
+	tenantID string
+	email    string
+	username string
+	current  [4]sql.NullString
+	keys     identity.IdentifierKeys
+}
+
+// column names in the order of userRow.current and keyValues.
+var keyColumns = [4]string{"email_key", "username_key", "email_skeleton", "username_skeleton"}
+
+func keyValues(k identity.IdentifierKeys) [4]string {
+	return [4]string{k.EmailKey, k.UsernameKey, k.EmailSkeleton, k.UsernameSkeleton}
+}
+
+func identityNormalize(dsn string, apply bool) (int, error) {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return 0, fmt.Errorf("open: %w", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := db.PingContext(ctx); err != nil {
+		return 0, fmt.Errorf("ping: %w", err)
+	}
+
+	users, err := loadUsers(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+
+	colliding := make(map[string]bool)
+	groups := 0
+	for col := range keyColumns {
+		// Keyed by tenant id and value: the unique keys are per tenant.
+		byValue := make(map[[2]string][]*userRow)
+		for _, u := range users {
+			v := [2]string{u.tenantID, keyValues(u.keys)[col]}
+			byValue[v] = append(byValue[v], u)
+		}
+		values := make([][2]string, 0, len(byValue))
+		for v, rows := range byValue {
+			if len(rows) > 1 {
+				values = append(values, v)
+			}
+		}
+		sort.Slice(values, func(i, j int) bool {
+			if values[i][0] != values[j][0] {
+				return values[i][0] < values[j][0]
+			}
+			return values[i][1] < values[j][1]
+		})
+		for _, v := range values {
+			groups++
+			parts := make([]string, 0, len(byValue[v]))
+			for _, u := range byValue[v] {
+				colliding[u.id] = true
+				parts = append(parts, fmt.Sprintf("%s (email=%q username=%q)", u.id, u.email, u.username))
+			}
+			fmt.Printf("identity:normalize: collision tenant=%s %s=%q: %s\n",
+				v[0], keyColumns[col], v[1], strings.Join(parts, ", "))
+		}
+	}
+
+	fmt.Printf("identity:normalize: users=%d collision_groups=%d colliding_users=%d\n",
+		len(users), groups, len(colliding))
+	if !apply {
+		return groups, nil
+	}
+
+	// Unkey the colliding rows first so their old keys do not block the
+	// rows that take them over.
+	var cleared, keyed, failed int
+	for _, u := range users {
+		if !colliding[u.id] || !hasKeys(u) {
+			continue
+		}
+		if _, err := db.ExecContext(ctx, updateUserKeys, nil, nil, nil, nil, u.id); err != nil {
+			return groups, fmt.Errorf("clear keys of %s: %w", u.id, err)
+		}
+		cleared++
+	}
+	for _, u := range users {
+		if colliding[u.id] || upToDate(u) {
+			continue
+		}
+		k := u.keys
+		_, err := db.ExecContext(ctx, updateUserKeys,
+			k.EmailKey, k.UsernameKey, k.EmailSkeleton, k.UsernameSkeleton, u.id)
+		if err != nil {
+			// Most likely a transient conflict with a row that has not
+			// been rewritten yet; a rerun picks it up.
+			fmt.Printf("identity:normalize: key %s: %v\n", u.id, err)
+			failed++
+			continue
+		}
+		keyed++
+	}
+
+	fmt.Printf("identity:normalize: done keyed=%d cleared=%d failed=%d\n", keyed, cleared, failed)
+	if failed > 0 {
+		return groups, fmt.Errorf("%d rows could not be keyed; rerun", failed)
+	}
+	return groups, nil
+}
+
+func loadUsers(ctx context.Context, db *sql.DB) ([]*userRow, error) {
+	rows, err := db.QueryContext(ctx, selectUsers)
+	if err != nil {
+		return nil, fmt.Errorf("select users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*userRow
+	for rows.Next() {
+		u := new(userRow)
+		if err := rows.Scan(&u.id, &u.tenantID, &u.email, &u.username,
+			&u.current[0], &u.current[1], &u.current[2], &u.current[3]); err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+		u.keys = identity.KeysFor(u.email, u.username)
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select users: %w", err)
+	}
+	return users, nil
+}
+
+func hasKeys(u *userRow) bool {
+	for _, c := range u.current {
+		if c.Valid {
+			return true
+		}
+	}
+	return false
+}
+
+func upToDate(u *userRow) bool {
+	want := keyValues(u.keys)
+	for i, c := range u.current {
+		if !c.Valid || c.String != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
@@ -15,27 +15,41 @@ import (
 	"github.com/google/uuid"
 	"github.com/joho/godotenv"
 	"golang.org/x/crypto/bcrypt"
+
+	"sso/internal/modules/identity"
 )
 
 const (
 	seedAppName            = "sso-admin"
 	seedAppSlug            = "sso-admin"
 	seedStatusActive uint8 = 1
+
+	// seedSystemTenant is the tenant super-admins belong to; any other
+	// tenant's admin app is named after it: sso-admin-<tenant slug>.
+	seedSystemTenant = "system"
 )
 
+// seedRoles lists the admin roles. includes names roles listed earlier
+// whose permissions the role inherits through role_includes, so
+// sso.admin.super only carries what no narrower role grants. A tenant
+// other than System gets every role but those granting tenants:* and
+// audit:*, which only System principals may hold.
 var seedRoles = []struct {
 	name        string
 	permissions []string
+	includes    []string
 }{
-	{"sso.admin.users", []string{"users:*"}},
-	{"sso.admin.apps", []string{"apps:*"}},
-	{"sso.admin.roles", []string{"roles:*"}},
-	{"sso.admin.service_accounts", []string{"service_accounts:*"}},
-	{"sso.admin.audit", []string{"audit:read"}},
+	{"sso.admin.users", []string{"users:*", "groups:*", "invitations:*"}, nil},
+	{"sso.admin.apps", []string{"apps:*"}, nil},
+	{"sso.admin.roles", []string{"roles:*"}, nil},
+	{"sso.admin.service_accounts", []string{"service_accounts:*"}, nil},
+	{"sso.admin.audit", []string{"audit:read"}, nil},
+	{"sso.admin.tenants", []string{"tenants:*"}, nil},
 	{"sso.admin.super", []string{
-		"users:*", "apps:*", "roles:*",
-		"service_accounts:*", "audit:*",
-		"sessions:*", "access:*",
+		"audit:*", "sessions:*", "access:*", "federation:*", "saml:*", "access_reviews:*",
+	}, []string{
+		"sso.admin.users", "sso.admin.apps", "sso.admin.roles",
+		"sso.admin.service_accounts", "sso.admin.audit", "sso.admin.tenants",
 	}},
 }
 
@@ -81,6 +95,7 @@ type SeedData struct {
 	password string
 	username string
 
+	tenant      string
 	appLink     string
 	displayName string
 
@@ -95,6 +110,7 @@ func getSeedDataFromEnv() (*SeedData, error) {
 		return nil, fmt.Errorf("SSO_SEED_ADMIN_EMAIL, SSO_SEED_ADMIN_PASSWORD, SSO_SEED_ADMIN_USERNAME are required")
 	}
 
+	tenant := envOr("SSO_SEED_TENANT", seedSystemTenant)
 	appLink := envOr("SSO_SEED_ADMIN_APP_LINK", "https://sso-admin.local/")
 
 	displayName := envOr("SSO_SEED_ADMIN_DISPLAY_NAME", "Admin")
@@ -107,7 +123,7 @@ func getSeedDataFromEnv() (*SeedData, error) {
 		}
 		bcryptCost = c
 	}
-	return &SeedData{email, password, username, appLink, displayName, bcryptCost}, nil
+	return &SeedData{email, password, username, tenant, appLink, displayName, bcryptCost}, nil
 }
 
 func seedAdmin(dsn string) error {
@@ -144,21 +160,49 @@ func seedAdmin(dsn string) error {
 	}
 	defer tx.Rollback() //nolint:errcheck
 
-	appID, err := seedFindOrCreateApp(ctx, tx, appLink)
+	tenantID, err := seedFindTenant(ctx, tx, data.tenant)
+	if err != nil {
+		return fmt.Errorf("tenant %s: %w", data.tenant, err)
+	}
+	appName, appSlug := seedAppName, seedAppSlug
+	if data.tenant != seedSystemTenant {
+		appName, appSlug = seedAppName+"-"+data.tenant, seedAppSlug+"-"+data.tenant
+	}
+
+	appID, err := seedFindOrCreateApp(ctx, tx, tenantID, appName, appSlug, appLink)
 	if err != nil {
 		return fmt.Errorf("app: %w", err)
 	}
 
-	roleIDs := make([]string, len(seedRoles))
-	for i, r := range seedRoles {
-		id, err := seedFindOrCreateRole(ctx, tx, appID, r.name, r.permissions)
+	var roleNames, roleIDs []string
+	roleIDByName := make(map[string]string, len(seedRoles))
+	for _, r := range seedRoles {
+		permissions := r.permissions
+		if data.tenant != seedSystemTenant {
+			if permissions = seedTenantPermissions(permissions); len(permissions) == 0 {
+				continue
+			}
+		}
+		id, err := seedFindOrCreateRole(ctx, tx, tenantID, appID, r.name, permissions)
 		if err != nil {
 			return fmt.Errorf("role %s: %w", r.name, err)
 		}
-		roleIDs[i] = id
+		roleNames = append(roleNames, r.name)
+		roleIDs = append(roleIDs, id)
+		roleIDByName[r.name] = id
+
+		for _, inc := range r.includes {
+			incID, ok := roleIDByName[inc]
+			if !ok {
+				continue
+			}
+			if err := seedEnsureInclude(ctx, tx, id, incID); err != nil {
+				return fmt.Errorf("role %s: include %s: %w", r.name, inc, err)
+			}
+		}
 	}
 
-	userID, err := seedFindOrCreateUser(ctx, tx, email, username, displayName, passwordHash)
+	userID, err := seedFindOrCreateUser(ctx, tx, tenantID, email, username, displayName, passwordHash)
 	if err != nil {
 		return fmt.Errorf("user: %w", err)
 	}
@@ -167,13 +211,13 @@ func seedAdmin(dsn string) error {
 	for i, roleID := range roleIDs {
 		created, err := seedEnsureAssignment(ctx, tx, userID, roleID, appID, now)
 		if err != nil {
-			return fmt.Errorf("assignment %s: %w", seedRoles[i].name, err)
+			return fmt.Errorf("assignment %s: %w", roleNames[i], err)
 		}
 		action := "existed"
 		if created {
 			action = "created"
 		}
-		fmt.Printf("assignment %s -> %s: %s\n", email, seedRoles[i].name, action)
+		fmt.Printf("assignment %s -> %s: %s\n", email, roleNames[i], action)
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -182,18 +226,60 @@ func seedAdmin(dsn string) error {
 	return nil
 }
 
-const (
-	findApp = `SELECT id FROM apps WHERE slug = ?`
+// seedSystemOnlyResources are the permission resources a tenant other
+// than System may not hold (see authz.AccessBackedAuthorizer).
+var seedSystemOnlyResources = []string{"tenants:", "audit:"}
+
+// seedTenantPermissions drops the System-only permissions from
+// permissions.
+func seedTenantPermissions(permissions []string) []string {
+	var out []string
+	for _, p := range permissions {
+		systemOnly := false
+		for _, res := range seedSystemOnlyResources {
+			systemOnly = systemOnly || strings.HasPrefix(p, res)
+		}
+		if !systemOnly {
+			out = append(out, p)
+		}
+	}
+	return out
+}
 
-	insertIntoApps = `INSERT INTO apps (id, name, slug, link, status, etag, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+const (
+	findTenant = `SELECT id FROM tenants WHERE slug = ?`
 )
 
-func seedFindOrCreateApp(ctx context.Context, tx *sql.Tx, link string) (string, error) {
+func seedFindTenant(ctx context.Context, tx *sql.Tx, slug string) (string, error) {
 	var id string
-	err := tx.QueryRowContext(ctx, findApp, seedAppSlug).Scan(&id)
+	if err := tx.QueryRowContext(ctx, findTenant, slug).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("no such tenant")
+		}
+		return "", fmt.Errorf("select: %w", err)
+	}
+	return id, nil
+}
+
+const (
+	findApp = `SELECT id, tenant_id FROM apps WHERE slug = ?`
+
+	insertIntoApps = `INSERT INTO apps (id, tenant_id, name, slug, link, status, etag, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+)
+
+// seedFindOrCreateApp finds or creates tenantID's admin app. Slugs are
+// unique across tenants, so an app of another tenant under the admin
+// slug is refused rather than adopted: its roles would administer
+// tenantID.
+func seedFindOrCreateApp(ctx context.Context, tx *sql.Tx, tenantID, name, slug, link string) (string, error) {
+	var id, owner string
+	err := tx.QueryRowContext(ctx, findApp, slug).Scan(&id, &owner)
 
 	if err == nil {
-		fmt.Printf("app %s: existed (id=%s)\n", seedAppSlug, id)
+		if owner != tenantID {
+			return "", fmt.Errorf("slug %s is held by an app of tenant %s", slug, owner)
+		}
+		fmt.Printf("app %s: existed (id=%s)\n", slug, id)
 		return id, nil
 	}
 
@@ -205,24 +291,24 @@ func seedFindOrCreateApp(ctx context.Context, tx *sql.Tx, link string) (string,
 
 	if _, err := tx.ExecContext(ctx,
 		insertIntoApps,
-		id, seedAppName, seedAppSlug, link, seedStatusActive, etag, now, now,
+		id, tenantID, name, slug, link, seedStatusActive, etag, now, now,
 	); err != nil {
 		return "", fmt.Errorf("insert: %w", err)
 	}
 
-	fmt.Printf("app %s: created (id=%s)\n", seedAppSlug, id)
+	fmt.Printf("app %s: created (id=%s)\n", slug, id)
 	return id, nil
 }
 
 const (
 	findRole = `SELECT id FROM roles WHERE app_id = ? AND name = ?`
 
-	insertIntoRoles = `INSERT INTO roles (id, app_id, name, description, status, etag, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	insertIntoRoles = `INSERT INTO roles (id, tenant_id, app_id, name, description, status, etag, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	insertIntoRolePermissions = `INSERT INTO role_permissions (role_id, permission) VALUES (?, ?)`
 )
 
-func seedFindOrCreateRole(ctx context.Context, tx *sql.Tx, appID, name string, permissions []string) (string, error) {
+func seedFindOrCreateRole(ctx context.Context, tx *sql.Tx, tenantID, appID, name string, permissions []string) (string, error) {
 	var id string
 	err := tx.QueryRowContext(ctx, findRole, appID, name).Scan(&id)
 
@@ -238,7 +324,7 @@ func seedFindOrCreateRole(ctx context.Context, tx *sql.Tx, appID, name string, p
 	id, etag, now := seedNewIDv7(), seedNewEtag(), time.Now().UTC()
 
 	if _, err := tx.ExecContext(ctx, insertIntoRoles,
-		id, appID, name, sql.NullString{}, seedStatusActive, etag, now, now,
+		id, tenantID, appID, name, sql.NullString{}, seedStatusActive, etag, now, now,
 	); err != nil {
 		return "", fmt.Errorf("insert role: %w", err)
 	}
@@ -257,14 +343,33 @@ func seedFindOrCreateRole(ctx context.Context, tx *sql.Tx, appID, name string, p
 }
 
 const (
-	findUser = `SELECT id FROM users WHERE email = ?`
+	insertIntoRoleIncludes = `INSERT IGNORE INTO role_includes (role_id, included_role_id) VALUES (?, ?)`
+)
+
+// seedEnsureInclude adds the (role, included) edge if missing. Existing
+// installs keep whatever permissions their roles already carry; the
+// edge only adds to them.
+func seedEnsureInclude(ctx context.Context, tx *sql.Tx, roleID, includedRoleID string) error {
+	if _, err := tx.ExecContext(ctx, insertIntoRoleIncludes, roleID, includedRoleID); err != nil {
+		return fmt.Errorf("insert: %w", err)
+	}
+	return nil
+}
+
+const (
+	// The seeded admin belongs to the seeded tenant; in the system
+	// tenant, sso.admin.tenants makes it a super-admin.
+	findUser = `SELECT id FROM users WHERE tenant_id = ? AND (email_key = ? OR (email_key IS NULL AND email = ?)) LIMIT 1`
 
-	insertIntoUsers = `INSERT INTO users (id, email, username, password_hash, display_name, avatar_url, locale, timezone, status, etag, created_at, updated_at, last_login_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	insertIntoUsers = `INSERT INTO users (id, tenant_id, email, username, email_key, username_key, email_skeleton, username_skeleton, password_hash, display_name, avatar_url, locale, timezone, status, etag, created_at, updated_at, last_login_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 )
 
-func seedFindOrCreateUser(ctx context.Context, tx *sql.Tx, email, username, displayName string, passwordHash []byte) (string, error) {
+func seedFindOrCreateUser(ctx context.Context, tx *sql.Tx, tenantID, email, username, displayName string, passwordHash []byte) (string, error) {
+	email, username = identity.NormalizeEmail(email), identity.NormalizeUsername(username)
+	keys := identity.KeysFor(email, username)
+
 	var id string
-	err := tx.QueryRowContext(ctx, findUser, email).Scan(&id)
+	err := tx.QueryRowContext(ctx, findUser, tenantID, keys.EmailKey, email).Scan(&id)
 
 	if err == nil {
 		fmt.Printf("user %s: existed (id=%s, password unchanged)\n", email, id)
@@ -279,7 +384,8 @@ func seedFindOrCreateUser(ctx context.Context, tx *sql.Tx, email, username, disp
 
 	if _, err := tx.ExecContext(ctx,
 		insertIntoUsers,
-		id, email, username,
+		id, tenantID, email, username,
+		keys.EmailKey, keys.UsernameKey, keys.EmailSkeleton, keys.UsernameSkeleton,
 		sql.NullString{String: string(passwordHash), Valid: true},
 		displayName,
 		sql.NullString{},
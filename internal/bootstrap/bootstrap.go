@@ -30,25 +30,43 @@ import (
 
 	"sso/internal/kernel/actor"
 	"sso/internal/modules/access"
+	"sso/internal/modules/accessrequest"
 	"sso/internal/modules/app"
+	"sso/internal/modules/attribute"
 	"sso/internal/modules/audit"
 	"sso/internal/modules/auth"
+	"sso/internal/modules/directory"
+	"sso/internal/modules/federation"
+	"sso/internal/modules/group"
 	"sso/internal/modules/identity"
+	"sso/internal/modules/invitation"
+	"sso/internal/modules/permission"
 	"sso/internal/modules/recoverycode"
+	"sso/internal/modules/review"
 	"sso/internal/modules/role"
+	"sso/internal/modules/saml"
+	"sso/internal/modules/scim"
 	"sso/internal/modules/serviceaccount"
 	"sso/internal/modules/session"
+	"sso/internal/modules/tenant"
 	"sso/internal/platform/audit/authz"
 	auditbus "sso/internal/platform/audit/bus"
+	"sso/internal/platform/authzversion"
 	"sso/internal/platform/config"
 	"sso/internal/platform/crypto/jwt"
 	"sso/internal/platform/crypto/randtoken"
 	recoverygen "sso/internal/platform/crypto/recoverycode"
 	grpcauth "sso/internal/platform/grpc/auth"
+	grpcrbac "sso/internal/platform/grpc/rbac"
 	grpcserver "sso/internal/platform/grpc/server"
 	"sso/internal/platform/httpserver"
+	httprbac "sso/internal/platform/httpserver/rbac"
+	"sso/internal/platform/httpserver/sessioncookie"
+	"sso/internal/platform/ldap"
+	"sso/internal/platform/mail"
 	"sso/internal/platform/mariadb"
 	"sso/internal/platform/ratelimit"
+	platsaml "sso/internal/platform/saml"
 
 	ssoauthv1 "github.com/Nergous/sso_protos/gen/go/sso/auth/v1"
 )
@@ -96,74 +114,168 @@ func New(ctx context.Context, cfg *config.Config, log *slog.Logger) (*App, error
 		auditEmitter = auditbus.NewSyncEmitter(auditModule.Repository(), log)
 	}
 
-	// ----- identity / app / role (new module layout) ------------------------
-	identityModule, err := identity.New(identity.Deps{
+	// Transactional mail is shared by identity (email-change links) and
+	// invitations.
+	mailer := buildMailSender(cfg.Mail, log)
+
+	// session is a leaf module; it is built ahead of identity, which
+	// revokes sessions after a login-email change.
+	sessionModule, err := session.New(session.Deps{DB: db, Log: log})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("bootstrap: wire session: %w", err)
+	}
+	sessionRepo := sessionModule.Repository()
+
+	// tenant only manages the tenants table; isolation of the rows they
+	// own is enforced by each module's repository through kernel/tenant.
+	tenantModule, err := tenant.New(tenant.Deps{
 		DB:    db,
 		Log:   log,
 		Clock: time.Now,
 		Audit: auditEmitter,
 	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("bootstrap: wire tenant: %w", err)
+	}
+
+	// Every write that can change a permission check bumps the authz
+	// version; access drops its cached permission snapshots when it
+	// moves, here at once and on the other replicas at their next poll.
+	authzVersion := authzversion.New(db, log, cfg.Access.VersionPollInterval)
+	authzVersion.Start(ctx)
+
+	// ----- identity / app / role (new module layout) ------------------------
+	identityModule, err := identity.New(identity.Deps{
+		DB:       db,
+		Log:      log,
+		Mailer:   mailer,
+		Sessions: sessionRepo,
+		EmailChange: identity.EmailChangeConfig{
+			ConfirmURL:   cfg.EmailChange.ConfirmURL,
+			CancelURL:    cfg.EmailChange.CancelURL,
+			TTL:          cfg.EmailChange.TTL,
+			CancelWindow: cfg.EmailChange.CancelWindow,
+		},
+		Clock:        time.Now,
+		Audit:        auditEmitter,
+		AuthzVersion: authzVersion,
+	})
 	if err != nil {
 		_ = db.Close()
 		return nil, fmt.Errorf("bootstrap: wire identity: %w", err)
 	}
 
 	appModule, err := app.New(app.Deps{
-		DB:    db,
-		Log:   log,
-		Clock: time.Now,
-		Audit: auditEmitter,
+		DB:           db,
+		Log:          log,
+		Clock:        time.Now,
+		Audit:        auditEmitter,
+		AuthzVersion: authzVersion,
 	})
 	if err != nil {
 		_ = db.Close()
 		return nil, fmt.Errorf("bootstrap: wire app: %w", err)
 	}
 
-	roleModule, err := role.New(role.Deps{
+	// role checks the permissions it stores against the apps' catalogs.
+	permModule, err := permission.New(permission.Deps{
 		DB:    db,
 		Log:   log,
+		Apps:  appModule.Repository(),
 		Clock: time.Now,
 		Audit: auditEmitter,
 	})
 	if err != nil {
 		_ = db.Close()
-		return nil, fmt.Errorf("bootstrap: wire role: %w", err)
+		return nil, fmt.Errorf("bootstrap: wire permission: %w", err)
+	}
+	if cfg.Permissions.CatalogFile != "" {
+		if err := permModule.SyncFile(ctx, cfg.Permissions.CatalogFile); err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("bootstrap: %w", err)
+		}
 	}
 
-	// access needs three sibling repositories for its cross-context
-	// precondition checks (role active, user eligible, app exists).
-	accessModule, err := access.New(access.Deps{
-		DB:    db,
-		Log:   log,
-		Clock: time.Now,
-		Audit: auditEmitter,
-		Users: identityModule.Repository(),
-		Roles: roleModule.Repository(),
-		Apps:  appModule.Repository(),
+	roleModule, err := role.New(role.Deps{
+		DB:           db,
+		Log:          log,
+		Permissions:  permModule.CatalogReader(),
+		Clock:        time.Now,
+		Audit:        auditEmitter,
+		AuthzVersion: authzVersion,
 	})
 	if err != nil {
 		_ = db.Close()
-		return nil, fmt.Errorf("bootstrap: wire access: %w", err)
+		return nil, fmt.Errorf("bootstrap: wire role: %w", err)
 	}
 
-	// Late-bind the real audit authorizer now that access is ready.
-	// The audit module was created above with AlwaysDenyAuthorizer to
-	// break the dep cycle (audit emitter is consumed by access, but
-	// the audit authz consumes access.Service).
-	auditModule.SetAuthorizer(authz.New(accessModule.Service(), db, log))
+	groupModule, err := group.New(group.Deps{
+		DB:           db,
+		Log:          log,
+		Users:        identityModule.Repository(),
+		Clock:        time.Now,
+		Audit:        auditEmitter,
+		AuthzVersion: authzVersion,
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("bootstrap: wire group: %w", err)
+	}
 
 	// ----- serviceaccount ---------------------------------------------------
 	saModule, err := serviceaccount.New(serviceaccount.Deps{
+		DB:           db,
+		Log:          log,
+		Clock:        time.Now,
+		Audit:        auditEmitter,
+		AuthzVersion: authzVersion,
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("bootstrap: wire serviceaccount: %w", err)
+	}
+	saRepo := saModule.Repository()
+
+	// access needs four sibling repositories for its cross-context
+	// precondition checks (role active, user or service account
+	// eligible, app exists), plus the group reader for grants to groups.
+	accessModule, err := access.New(access.Deps{
 		DB:    db,
 		Log:   log,
 		Clock: time.Now,
 		Audit: auditEmitter,
+
+		Users:           identityModule.Repository(),
+		ServiceAccounts: saRepo,
+		Roles:           roleModule.Repository(),
+		Apps:            appModule.Repository(),
+		Groups:          groupModule.GroupReader(),
+
+		ExpirySweepInterval: cfg.Access.ExpirySweepInterval,
+
+		Version:            authzVersion,
+		SnapshotTTL:        cfg.Access.SnapshotTTL,
+		SnapshotMaxEntries: cfg.Access.SnapshotMaxEntries,
+
+		SoDOverridePermission: cfg.Access.SoDOverridePermission,
 	})
 	if err != nil {
 		_ = db.Close()
-		return nil, fmt.Errorf("bootstrap: wire serviceaccount: %w", err)
+		return nil, fmt.Errorf("bootstrap: wire access: %w", err)
 	}
-	saRepo := saModule.Repository()
+	accessModule.Start(ctx)
+
+	// Late-bind the real audit authorizer now that access is ready.
+	// The audit module was created above with AlwaysDenyAuthorizer to
+	// break the dep cycle (audit emitter is consumed by access, but
+	// the audit authz consumes access.Service).
+	adminAuthz := authz.New(accessModule.Service(), db, log)
+	auditModule.SetAuthorizer(adminAuthz)
+	// The same authorizer decides who may override a
+	// separation-of-duties rule on a grant.
+	accessModule.SetSoDOverride(adminAuthz)
 
 	// ----- auth wiring ------------------------------------------------------
 	//
@@ -171,13 +283,6 @@ func New(ctx context.Context, cfg *config.Config, log *slog.Logger) (*App, error
 	// verified, so a load failure has to abort startup before the gRPC
 	// listener comes up. A partial bootstrap that serves requests with no
 	// auth would be far worse than a hard exit.
-	sessionModule, err := session.New(session.Deps{DB: db, Log: log})
-	if err != nil {
-		_ = db.Close()
-		return nil, fmt.Errorf("bootstrap: wire session: %w", err)
-	}
-	sessionRepo := sessionModule.Repository()
-
 	recoveryModule, err := recoverycode.New(recoverycode.Deps{DB: db, Log: log})
 	if err != nil {
 		_ = db.Close()
@@ -198,6 +303,71 @@ func New(ctx context.Context, cfg *config.Config, log *slog.Logger) (*App, error
 	signer := jwt.NewEd25519Signer(priv, cfg.Auth.JWT.Issuer, cfg.Auth.JWT.AccessTTL)
 	verifier := jwt.NewEd25519Verifier(pub, cfg.Auth.JWT.Issuer)
 
+	// ----- password backends ----------------------------------------------
+	//
+	// auth.backends is the order Login tries them in; config validation
+	// guarantees "ldap" appears iff the directory section is enabled.
+	var authenticators []auth.Authenticator
+	for _, name := range cfg.Auth.Backends {
+		switch name {
+		case config.AuthBackendLocal:
+			authenticators = append(authenticators, auth.LocalAuthenticator{})
+		case config.AuthBackendLDAP:
+			dirModule, err := directory.New(directoryDeps(cfg.Directory, db, log,
+				identityModule.Repository(), accessModule.Service(), auditEmitter))
+			if err != nil {
+				_ = db.Close()
+				return nil, fmt.Errorf("bootstrap: wire directory: %w", err)
+			}
+			authenticators = append(authenticators, dirModule.Authenticator())
+		}
+	}
+
+	// Public method whitelist: AuthService's own public RPCs plus the
+	// transport-level surfaces clients hit before authenticating.
+	// grpc.health.* covers k8s liveness/readiness probes; reflection
+	// (both v1 and v1alpha) is gated by config but always whitelisted
+	// here so a server with reflection enabled doesn't reject discovery
+	// for lack of a token.
+	publicRPCs := append([]string{}, auth.PublicRPCs...)
+	publicRPCs = append(publicRPCs,
+		"/grpc.health.v1.Health/Check",
+		"/grpc.health.v1.Health/Watch",
+		"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
+	)
+	authInterceptor := grpcauth.NewInterceptor(verifier, sessionRepo, log, publicRPCs)
+	// Super-admins (tenants:admin in sso-admin) work across tenants.
+	authInterceptor.SetSuperAdmins(adminAuthz)
+
+	// Route-level RBAC for the hand-written HTTP endpoints: the
+	// counterpart of the gRPC interceptor below, on the same admin
+	// authorizer (adminHTTPPermissions).
+	routeAuthz := httprbac.New(adminAuthz, log, auditEmitter, adminHTTPPermissions, exemptHTTPRoutes)
+
+	// ----- attributes -------------------------------------------------------
+	//
+	// Built ahead of auth, which reads the per-app token claims through
+	// it; the interceptor it authenticates with only needs the verifier
+	// and the session repository. HTTP-only and always on.
+	attrModule, err := attribute.New(attribute.Deps{
+		DB:            db,
+		Log:           log,
+		Apps:          appModule.Repository(),
+		Users:         identityModule.Repository(),
+		Authenticator: authInterceptor,
+		Authorizer:    routeAuthz,
+		Clock:         time.Now,
+		Audit:         auditEmitter,
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("bootstrap: wire attributes: %w", err)
+	}
+	// Permission conditions read user.attributes through it; access was
+	// built first, so it is bound late, as the audit authorizer is.
+	accessModule.SetAttributes(attrModule.Service())
+
 	authModule, err := auth.New(auth.Deps{
 		Log:                log,
 		Users:              identityModule.Repository(),
@@ -214,6 +384,8 @@ func New(ctx context.Context, cfg *config.Config, log *slog.Logger) (*App, error
 		RefreshTTL:         cfg.Auth.Session.RefreshTTL,
 		RefreshRotationTTL: cfg.Auth.Session.RefreshRotationTTL,
 		BcryptCost:         cfg.Auth.Bcrypt.Cost,
+		Authenticators:     authenticators,
+		Claims:             attrModule.Service(),
 		Audit:              auditEmitter,
 	})
 	if err != nil {
@@ -221,20 +393,184 @@ func New(ctx context.Context, cfg *config.Config, log *slog.Logger) (*App, error
 		return nil, fmt.Errorf("bootstrap: wire auth: %w", err)
 	}
 
-	// Public method whitelist: AuthService's own public RPCs plus the
-	// transport-level surfaces clients hit before authenticating.
-	// grpc.health.* covers k8s liveness/readiness probes; reflection
-	// (both v1 and v1alpha) is gated by config but always whitelisted
-	// here so a server with reflection enabled doesn't reject discovery
-	// for lack of a token.
-	publicRPCs := append([]string{}, auth.PublicRPCs...)
-	publicRPCs = append(publicRPCs,
-		"/grpc.health.v1.Health/Check",
-		"/grpc.health.v1.Health/Watch",
-		"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
-		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
-	)
-	authInterceptor := grpcauth.NewInterceptor(verifier, sessionRepo, log, publicRPCs)
+	// Hand-written HTTP routes mounted next to the gateway. The
+	// self-service profile (/v1/me), the email-change endpoints, the
+	// attribute admin API, groups with their role grants, role includes,
+	// the permission catalogs and tenants are always on; the rest follow
+	// their config sections.
+	httpRoutes := []func(*http.ServeMux){
+		identityModule.MeRoutes(authInterceptor, routeAuthz),
+		attrModule.RegisterHTTP,
+		groupModule.HTTPRoutes(authInterceptor, routeAuthz),
+		accessModule.HTTPRoutes(authInterceptor, routeAuthz),
+		roleModule.HTTPRoutes(authInterceptor, routeAuthz),
+		permModule.HTTPRoutes(authInterceptor, routeAuthz),
+		tenantModule.HTTPRoutes(authInterceptor, routeAuthz),
+	}
+
+	// ----- federation -------------------------------------------------------
+	//
+	// HTTP-only (browser redirects), so it is wired only when enabled;
+	// config validation guarantees the HTTP listener is on in that case.
+	if cfg.Federation.Enabled {
+		fedModule, err := federation.New(federation.Deps{
+			DB:            db,
+			Log:           log,
+			Users:         identityModule.Repository(),
+			Apps:          appModule.Repository(),
+			Sessions:      authModule.Service(),
+			Authenticator: authInterceptor,
+			Authorizer:    routeAuthz,
+			Cookies:       sessioncookie.New(cfg.HTTP.Cookies),
+			CallbackURL:   cfg.Federation.CallbackURL,
+			StateTTL:      cfg.Federation.StateTTL,
+			Clock:         time.Now,
+			Audit:         auditEmitter,
+		})
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("bootstrap: wire federation: %w", err)
+		}
+		httpRoutes = append(httpRoutes, fedModule.RegisterHTTP)
+	}
+
+	// ----- saml -------------------------------------------------------------
+	//
+	// Same shape as federation: HTTP-only, wired only when enabled.
+	if cfg.SAML.Enabled {
+		keys, err := platsaml.LoadKeyPair(cfg.SAML.CertPath, cfg.SAML.KeyPath)
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("bootstrap: load saml keys: %w", err)
+		}
+		samlModule, err := saml.New(saml.Deps{
+			DB:             db,
+			Log:            log,
+			Users:          identityModule.Repository(),
+			Apps:           appModule.Repository(),
+			Sessions:       sessionRepo,
+			Roles:          accessModule.Service(),
+			Authenticator:  authInterceptor,
+			Authorizer:     routeAuthz,
+			Keys:           keys,
+			EntityID:       cfg.SAML.EntityID,
+			BaseURL:        cfg.SAML.BaseURL,
+			LoginURL:       cfg.SAML.LoginURL,
+			PostLogoutURL:  cfg.SAML.PostLogoutURL,
+			AssertionTTL:   cfg.SAML.AssertionTTL,
+			LogoutStateTTL: cfg.SAML.LogoutStateTTL,
+			Clock:          time.Now,
+			Audit:          auditEmitter,
+		})
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("bootstrap: wire saml: %w", err)
+		}
+		httpRoutes = append(httpRoutes, samlModule.RegisterHTTP)
+	}
+
+	// ----- scim -------------------------------------------------------------
+	//
+	// Provisioning goes through identity.Service, so SCIM writes are
+	// audited like admin RPCs, with the calling service account as actor,
+	// which needs the same users:* permissions (adminHTTPPermissions).
+	if cfg.SCIM.Enabled {
+		scimModule, err := scim.New(scim.Deps{
+			Log:           log,
+			Users:         identityModule.Service(),
+			Authenticator: authInterceptor,
+			Authorizer:    routeAuthz,
+			BaseURL:       cfg.SCIM.BaseURL,
+		})
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("bootstrap: wire scim: %w", err)
+		}
+		httpRoutes = append(httpRoutes, scimModule.RegisterHTTP)
+	}
+
+	// ----- invitations ------------------------------------------------------
+	//
+	// HTTP-only like federation. Acceptance writes the user and the grants
+	// through identity and access inside the module's transaction, which
+	// works because every module shares db.
+	if cfg.Invitations.Enabled {
+		invModule, err := invitation.New(invitation.Deps{
+			DB:            db,
+			Log:           log,
+			Users:         identityModule.Repository(),
+			Roles:         roleModule.Repository(),
+			Grants:        accessModule.Service(),
+			Permissions:   adminAuthz,
+			Mailer:        mailer,
+			Authenticator: authInterceptor,
+			Authorizer:    routeAuthz,
+			AcceptURL:     cfg.Invitations.AcceptURL,
+			TTL:           cfg.Invitations.TTL,
+			BcryptCost:    cfg.Auth.Bcrypt.Cost,
+			Clock:         time.Now,
+			Audit:         auditEmitter,
+		})
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("bootstrap: wire invitations: %w", err)
+		}
+		httpRoutes = append(httpRoutes, invModule.RegisterHTTP)
+	}
+
+	// ----- access reviews ---------------------------------------------------
+	//
+	// HTTP-only as well. A revocation goes through access.Service inside
+	// the module's transaction, on the shared db like invitations.
+	if cfg.Reviews.Enabled {
+		reviewModule, err := review.New(review.Deps{
+			DB:                    db,
+			Log:                   log,
+			Access:                accessModule.Service(),
+			Apps:                  appModule.Repository(),
+			Roles:                 roleModule.Repository(),
+			Users:                 identityModule.Repository(),
+			Authenticator:         authInterceptor,
+			Authorizer:            routeAuthz,
+			DeadlineSweepInterval: cfg.Reviews.DeadlineSweepInterval,
+			EscalationGrace:       cfg.Reviews.EscalationGrace,
+			Clock:                 time.Now,
+			Audit:                 auditEmitter,
+		})
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("bootstrap: wire access reviews: %w", err)
+		}
+		reviewModule.Start(ctx)
+		httpRoutes = append(httpRoutes, reviewModule.RegisterHTTP)
+	}
+
+	// ----- access requests --------------------------------------------------
+	//
+	// HTTP-only as well. Approval grants through access.Service inside
+	// the module's transaction, on the shared db.
+	if cfg.Requests.Enabled {
+		reqModule, err := accessrequest.New(accessrequest.Deps{
+			DB:                  db,
+			Log:                 log,
+			Access:              accessModule.Service(),
+			Roles:               roleModule.Repository(),
+			Authenticator:       authInterceptor,
+			Authorizer:          routeAuthz,
+			ApproverPermission:  cfg.Requests.ApproverPermission,
+			MaxDuration:         cfg.Requests.MaxDuration,
+			PendingTTL:          cfg.Requests.PendingTTL,
+			ExpirySweepInterval: cfg.Requests.ExpirySweepInterval,
+			Clock:               time.Now,
+			Audit:               auditEmitter,
+		})
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("bootstrap: wire access requests: %w", err)
+		}
+		reqModule.Start(ctx)
+		httpRoutes = append(httpRoutes, reqModule.RegisterHTTP)
+	}
 
 	// Rate-limit interceptor. Disabled in config → nil → server.New
 	// skips it. Extractors stay in bootstrap because they know proto
@@ -250,7 +586,15 @@ func New(ctx context.Context, cfg *config.Config, log *slog.Logger) (*App, error
 		rateLimitUnary = rl.Unary()
 	}
 
-	srv, err := grpcserver.New(cfg.GRPC, log, authInterceptor.Unary(), rateLimitUnary,
+	// Method-level RBAC: admin RPCs require their permission in the
+	// sso-admin app (adminRPCPermissions); public, self-service and
+	// decision RPCs are open to any caller grpcauth let through.
+	exemptRPCs := append([]string{}, publicRPCs...)
+	exemptRPCs = append(exemptRPCs, auth.SelfServiceRPCs...)
+	exemptRPCs = append(exemptRPCs, decisionRPCs...)
+	rbac := grpcrbac.New(adminAuthz, log, auditEmitter, adminRPCPermissions, exemptRPCs)
+
+	srv, err := grpcserver.New(cfg.GRPC, log, authInterceptor.Unary(), rateLimitUnary, rbac.Unary(),
 		identityModule.RegisterServer,
 		appModule.RegisterServer,
 		roleModule.RegisterServer,
@@ -279,6 +623,8 @@ func New(ctx context.Context, cfg *config.Config, log *slog.Logger) (*App, error
 			Readiness: func(probeCtx context.Context) error {
 				return db.PingContext(probeCtx)
 			},
+			Routes:  httpRoutes,
+			Metrics: []httpserver.MetricsFunc{accessModule.WriteMetrics},
 		})
 		if err != nil {
 			_ = db.Close()
@@ -354,6 +700,236 @@ func buildRateLimiter(cfg config.RateLimitConfig) (*ratelimit.Interceptor, error
 	return ratelimit.New(policies, bindings, cfg.CleanupInterval)
 }
 
+// adminRPCPermissions maps every admin RPC to the permission its caller
+// must hold in the admin app of its tenant (sso-admin for System,
+// sso-admin-<tenant slug> otherwise; see authz.AccessBackedAuthorizer).
+// cmd/seed's sso.admin.* roles grant them a resource at a time
+// ("users:*"). A method in neither this table nor the exempt list is
+// refused.
+var adminRPCPermissions = map[string]string{
+	"/sso.identity.v1.IdentityService/CreateUser":            "users:create",
+	"/sso.identity.v1.IdentityService/GetUser":               "users:read",
+	"/sso.identity.v1.IdentityService/ListUsers":             "users:read",
+	"/sso.identity.v1.IdentityService/UpdateUser":            "users:update",
+	"/sso.identity.v1.IdentityService/DisableUser":           "users:update",
+	"/sso.identity.v1.IdentityService/EnableUser":            "users:update",
+	"/sso.identity.v1.IdentityService/SoftDeleteUser":        "users:delete",
+	"/sso.identity.v1.IdentityService/PermanentlyDeleteUser": "users:delete",
+
+	"/sso.app.v1.AppService/GetApp":               "apps:read",
+	"/sso.app.v1.AppService/ListApps":             "apps:read",
+	"/sso.app.v1.AppService/CreateApp":            "apps:create",
+	"/sso.app.v1.AppService/UpdateApp":            "apps:update",
+	"/sso.app.v1.AppService/EnterMaintenanceMode": "apps:update",
+	"/sso.app.v1.AppService/ExitMaintenanceMode":  "apps:update",
+	"/sso.app.v1.AppService/DisableApp":           "apps:update",
+	"/sso.app.v1.AppService/EnableApp":            "apps:update",
+	"/sso.app.v1.AppService/PermanentlyDeleteApp": "apps:delete",
+
+	"/sso.roles.v1.RolesService/GetRole":               "roles:read",
+	"/sso.roles.v1.RolesService/ListRoles":             "roles:read",
+	"/sso.roles.v1.RolesService/CreateRole":            "roles:create",
+	"/sso.roles.v1.RolesService/UpdateRole":            "roles:update",
+	"/sso.roles.v1.RolesService/DisableRole":           "roles:update",
+	"/sso.roles.v1.RolesService/EnableRole":            "roles:update",
+	"/sso.roles.v1.RolesService/PermanentlyDeleteRole": "roles:delete",
+
+	"/sso.serviceaccount.v1.ServiceAccountService/GetServiceAccount":               "service_accounts:read",
+	"/sso.serviceaccount.v1.ServiceAccountService/ListServiceAccounts":             "service_accounts:read",
+	"/sso.serviceaccount.v1.ServiceAccountService/CreateServiceAccount":            "service_accounts:create",
+	"/sso.serviceaccount.v1.ServiceAccountService/UpdateServiceAccount":            "service_accounts:update",
+	"/sso.serviceaccount.v1.ServiceAccountService/RotateCredentials":               "service_accounts:update",
+	"/sso.serviceaccount.v1.ServiceAccountService/DisableServiceAccount":           "service_accounts:update",
+	"/sso.serviceaccount.v1.ServiceAccountService/EnableServiceAccount":            "service_accounts:update",
+	"/sso.serviceaccount.v1.ServiceAccountService/PermanentlyDeleteServiceAccount": "service_accounts:delete",
+
+	"/sso.access.v1.AccessService/ListUserRoles":      "access:read",
+	"/sso.access.v1.AccessService/GrantRoleToUser":    "access:grant",
+	"/sso.access.v1.AccessService/BulkGrantRoles":     "access:grant",
+	"/sso.access.v1.AccessService/RemoveRoleFromUser": "access:revoke",
+	"/sso.access.v1.AccessService/BulkRemoveRoles":    "access:revoke",
+
+	"/sso.audit.v1.AuditService/GetAuditEvent":   "audit:read",
+	"/sso.audit.v1.AuditService/ListAuditEvents": "audit:read",
+}
+
+// decisionRPCs are the permission checks relying apps make about their
+// own users. Their service accounts live in the app's tenant and cannot
+// hold sso-admin roles, so these stay open to any authenticated caller.
+var decisionRPCs = []string{
+	"/sso.access.v1.AccessService/HasRoleInApp",
+	"/sso.access.v1.AccessService/CheckPermission",
+	"/sso.access.v1.AccessService/BatchCheckPermission",
+}
+
+// adminHTTPPermissions maps every admin route of the hand-written HTTP
+// endpoints, keyed by the pattern its module registers, to the
+// permission its caller must hold in the sso-admin app — the routes'
+// counterpart of adminRPCPermissions, with the same resources where the
+// gRPC API has them. A route in neither this table nor the exempt list
+// is refused.
+var adminHTTPPermissions = map[string]string{
+	"GET /v1/groups/{group_id}/roles":                       "access:read",
+	"POST /v1/groups/{group_id}/roles":                      "access:grant",
+	"DELETE /v1/groups/{group_id}/roles/{role_id}":          "access:revoke",
+	"GET /v1/users/{user_id}/effective-roles":               "access:read",
+	"POST /v1/users/{user_id}/role-grants":                  "access:grant",
+	"POST /v1/users/{user_id}/role-grants:bulk":             "access:grant",
+	"PATCH /v1/users/{user_id}/role-grants/{role_id}":       "access:grant",
+	"GET /v1/users/{user_id}/scoped-grants":                 "access:read",
+	"POST /v1/users/{user_id}/scoped-grants":                "access:grant",
+	"DELETE /v1/users/{user_id}/scoped-grants":              "access:revoke",
+	"GET /v1/users/{user_id}/resources":                     "access:read",
+	"POST /v1/users/{user_id}/permissions:explain":          "access:read",
+	"GET /v1/apps/{app_id}/principals":                      "access:read",
+	"GET /v1/roles/{role_id}/members":                       "access:read",
+	"GET /v1/apps/{app_id}/sod-rules":                       "access:read",
+	"POST /v1/apps/{app_id}/sod-rules":                      "access:sod_rules",
+	"GET /v1/sod-rules/{rule_id}":                           "access:read",
+	"DELETE /v1/sod-rules/{rule_id}":                        "access:sod_rules",
+	"GET /v1/apps/{app_id}/sod-violations":                  "access:read",
+	"GET /v1/apps/{app_id}/attributes":                      "apps:read",
+	"PUT /v1/apps/{app_id}/attributes/{key}":                "apps:update",
+	"DELETE /v1/apps/{app_id}/attributes/{key}":             "apps:update",
+	"GET /v1/apps/{app_id}/users":                           "users:read",
+	"GET /v1/users/{user_id}/attributes":                    "users:read",
+	"PUT /v1/users/{user_id}/attributes/{app_id}":           "users:update",
+	"GET /v1/apps/{app_id}/permissions":                     "apps:read",
+	"PUT /v1/apps/{app_id}/permissions":                     "apps:update",
+	"PUT /v1/apps/{app_id}/permissions/{name}":              "apps:update",
+	"DELETE /v1/apps/{app_id}/permissions/{name}":           "apps:update",
+	"GET /v1/apps/{app_id}/permissions/{name}/roles":        "roles:read",
+	"GET /v1/roles/{role_id}/includes":                      "roles:read",
+	"PUT /v1/roles/{role_id}/includes":                      "roles:update",
+	"GET /v1/roles/{role_id}/conditions":                    "roles:read",
+	"PUT /v1/roles/{role_id}/conditions":                    "roles:update",
+	"GET /v1/groups":                                        "groups:read",
+	"POST /v1/groups":                                       "groups:create",
+	"GET /v1/groups/{id}":                                   "groups:read",
+	"PATCH /v1/groups/{id}":                                 "groups:update",
+	"DELETE /v1/groups/{id}":                                "groups:delete",
+	"GET /v1/groups/{id}/members":                           "groups:read",
+	"POST /v1/groups/{id}/members":                          "groups:update",
+	"DELETE /v1/groups/{id}/members/{user_id}":              "groups:update",
+	"GET /v1/users/{user_id}/groups":                        "groups:read",
+	"GET /v1/federation/providers":                          "federation:read",
+	"POST /v1/federation/providers":                         "federation:create",
+	"GET /v1/federation/providers/{id}":                     "federation:read",
+	"PATCH /v1/federation/providers/{id}":                   "federation:update",
+	"DELETE /v1/federation/providers/{id}":                  "federation:delete",
+	"GET /v1/saml/service-providers":                        "saml:read",
+	"POST /v1/saml/service-providers":                       "saml:create",
+	"GET /v1/saml/service-providers/{app_id}":               "saml:read",
+	"PATCH /v1/saml/service-providers/{app_id}":             "saml:update",
+	"DELETE /v1/saml/service-providers/{app_id}":            "saml:delete",
+	"GET /v1/invitations":                                   "invitations:read",
+	"POST /v1/invitations":                                  "invitations:create",
+	"GET /v1/invitations/{id}":                              "invitations:read",
+	"POST /v1/invitations/{id}/resend":                      "invitations:create",
+	"DELETE /v1/invitations/{id}":                           "invitations:delete",
+	"GET /v1/access-reviews":                                "access_reviews:read",
+	"POST /v1/access-reviews":                               "access_reviews:create",
+	"GET /v1/access-reviews/{id}":                           "access_reviews:read",
+	"POST /v1/access-reviews/{id}/cancel":                   "access_reviews:update",
+	"POST /v1/access-reviews/{id}/items/{item_id}/reassign": "access_reviews:update",
+	"GET /v1/tenants":                                       "tenants:read",
+	"POST /v1/tenants":                                      "tenants:create",
+	"GET /v1/tenants/{id}":                                  "tenants:read",
+	"PATCH /v1/tenants/{id}":                                "tenants:update",
+	"DELETE /v1/tenants/{id}":                               "tenants:delete",
+	"GET /scim/v2/Users":                                    "users:read",
+	"POST /scim/v2/Users":                                   "users:create",
+	"GET /scim/v2/Users/{id}":                               "users:read",
+	"PUT /scim/v2/Users/{id}":                               "users:update",
+	"PATCH /scim/v2/Users/{id}":                             "users:update",
+	"DELETE /scim/v2/Users/{id}":                            "users:delete",
+}
+
+// exemptHTTPRoutes are the authenticated routes open to any caller:
+// self-service (the caller's own profile, federated links and SAML
+// sessions), the permission checks relying apps make (decisionRPCs'
+// counterparts), and the request and review workflows, whose services
+// check the caller against the request or item — requesters,
+// approvers and reviewers need no sso-admin role.
+var exemptHTTPRoutes = []string{
+	"GET /v1/me",
+	"PATCH /v1/me",
+	"POST /v1/me/email",
+	"POST /v1/federation/link/{slug}",
+	"GET /v1/federation/links",
+	"DELETE /v1/federation/links/{slug}",
+	"GET /v1/saml/sso",
+	"POST /v1/saml/idp/{app_id}",
+	"POST /v1/saml/logout",
+	"POST /v1/users/{user_id}/permissions:check",
+	"POST /v1/users/{user_id}/permissions:batchCheck",
+	"GET /v1/access-requests",
+	"POST /v1/access-requests",
+	"GET /v1/access-requests/{id}",
+	"POST /v1/access-requests/{id}/approve",
+	"POST /v1/access-requests/{id}/deny",
+	"POST /v1/access-requests/{id}/cancel",
+	"GET /v1/access-reviews/{id}/items",
+	"POST /v1/access-reviews/{id}/items/{item_id}/approve",
+	"POST /v1/access-reviews/{id}/items/{item_id}/revoke",
+}
+
+// directoryDeps translates the directory config section into module
+// deps. The LDAP client is built here so the module stays free of
+// config types.
+func directoryDeps(cfg config.DirectoryConfig, db *sql.DB, log *slog.Logger, users identity.Repository, roles directory.RoleSyncer, emitter audit.Emitter) directory.Deps {
+	groupRoles := make([]directory.GroupRole, 0, len(cfg.GroupRoles))
+	for _, gr := range cfg.GroupRoles {
+		groupRoles = append(groupRoles, directory.GroupRole{Group: gr.Group, RoleID: gr.RoleID})
+	}
+	return directory.Deps{
+		DB:    db,
+		Log:   log,
+		Users: users,
+		Directory: ldap.New(ldap.Config{
+			URL:                cfg.URL,
+			StartTLS:           cfg.StartTLS,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+			BindDN:             cfg.BindDN,
+			BindPassword:       string(cfg.BindPassword),
+			BaseDN:             cfg.BaseDN,
+			UserFilter:         cfg.UserFilter,
+			Attributes: []string{
+				cfg.Attributes.Email, cfg.Attributes.Username,
+				cfg.Attributes.DisplayName, cfg.GroupAttribute,
+			},
+			Timeout: cfg.Timeout,
+		}),
+		Roles:     roles,
+		Provision: cfg.Provision,
+		Attributes: directory.AttributeMapping{
+			Email:       cfg.Attributes.Email,
+			Username:    cfg.Attributes.Username,
+			DisplayName: cfg.Attributes.DisplayName,
+		},
+		GroupAttribute: cfg.GroupAttribute,
+		GroupRoles:     groupRoles,
+		Clock:          time.Now,
+		Audit:          emitter,
+	}
+}
+
+// buildMailSender picks the mail driver; config validation has already
+// rejected unknown drivers and incomplete SMTP settings.
+func buildMailSender(cfg config.MailConfig, log *slog.Logger) mail.Sender {
+	if cfg.Driver == "smtp" {
+		return mail.NewSMTPSender(mail.SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: string(cfg.SMTP.Password),
+			From:     cfg.SMTP.From,
+		})
+	}
+	log.Warn("mail: log driver in use; invitation links are written to the log")
+	return mail.LogSender{Log: log}
+}
+
 // extractPeerIP keys on the gRPC peer IP. ok=false means the peer has
 // no Addr (in-process test), in which case the policy is skipped rather
 // than failing the request.
@@ -409,10 +985,15 @@ func extractActorID(ctx context.Context, _ any) (ratelimit.Key, bool) {
 	return ratelimit.Key(a.ID), true
 }
 
+// normalizedIdentifier keys on the identity lookup key, so the aliases
+// of one account ("J.Doe+x@gmail.com", "jdoe@googlemail.com") share a
+// bucket.
 func normalizedIdentifier(email, username string) (ratelimit.Key, bool) {
-	id := strings.ToLower(strings.TrimSpace(email))
-	if id == "" {
-		id = strings.ToLower(strings.TrimSpace(username))
+	var id string
+	if strings.TrimSpace(email) != "" {
+		id = identity.EmailKey(email)
+	} else if strings.TrimSpace(username) != "" {
+		id = identity.UsernameKey(username)
 	}
 	if id == "" {
 		return "", false
@@ -36,6 +36,12 @@ const (
 	KindServiceAccount Kind = "service_account"
 )
 
+// KindSystem marks work the server does on its own behalf (background
+// sweepers, directory-driven role sync). It never comes off the wire:
+// the grpcauth interceptor only maps JWT subject types, so a System
+// actor exists only where in-process code injects one.
+const KindSystem Kind = "system"
+
 func (k Kind) String() string { return string(k) }
 
 // Actor is the authenticated principal for the current request plus
@@ -46,12 +52,18 @@ func (k Kind) String() string { return string(k) }
 //   - SessionID: server-side session id (UUIDv7) — present only for
 //     user actors; empty for service-account JWTs, which are session-
 //     less by construction.
+//   - AppID: the app the token was issued for; empty for tokens minted
+//     before it was stamped.
+//   - TenantID: the tenant the principal belongs to; empty for tokens
+//     minted before tenancy (kernel/tenant reads that as System).
 //   - IpAddress: server-derived peer IP; empty in in-process tests.
 //   - UserAgent: gRPC client's User-Agent header; empty when absent.
 type Actor struct {
 	ID        string
 	Kind      Kind
 	SessionID string
+	AppID     string
+	TenantID  string
 	IpAddress string
 	UserAgent string
 }
@@ -62,6 +74,13 @@ func (a Actor) IsUser() bool { return a.Kind == KindUser }
 // IsServiceAccount reports whether the actor is a backend identity.
 func (a Actor) IsServiceAccount() bool { return a.Kind == KindServiceAccount }
 
+// IsSystem reports whether the actor is the server itself.
+func (a Actor) IsSystem() bool { return a.Kind == KindSystem }
+
+// System returns the actor for server-initiated work. ID is empty —
+// audit records SYSTEM actors without an actor id.
+func System() Actor { return Actor{Kind: KindSystem} }
+
 // ctxKey is the unexported type used as the context-value key. Using a
 // dedicated type (not a string) avoids collisions with other packages
 // that might use the same string for their own key.
@@ -0,0 +1,36 @@
+// Package authzver is the version of the authorization data: the roles,
+// assignments, group memberships and principals CheckPermission reads.
+//
+// Every write that can change a permission decision bumps it; caches of
+// decision inputs remember the generation they were filled at and are
+// stale once it moves. The modules that write depend on Bumper alone,
+// the access module reads Version.
+//
+// The implementation behind both (platform/authzversion) is a counter
+// row in the database, so a bump on one replica reaches the others on
+// their next poll.
+//
+// kernel/authzver imports nothing from internal/.
+package authzver
+
+import "context"
+
+// Bumper records that authorization data changed. Bump is called after
+// the write succeeded and never fails the write: an error is the
+// implementation's to log.
+type Bumper interface {
+	Bump(ctx context.Context)
+}
+
+// Version is a Bumper whose changes can be observed. Generation moves
+// forward on every local Bump and on every change seen from another
+// replica; two equal reads mean no change was observed in between.
+type Version interface {
+	Bumper
+	Generation() uint64
+}
+
+// Nop is the Bumper of hosts that cache nothing.
+type Nop struct{}
+
+func (Nop) Bump(context.Context) {}
@@ -0,0 +1,197 @@
+// Package condition compiles and evaluates the CEL expressions that can
+// guard a role permission ("orders:refund only if resource.amount <
+// 1000").
+//
+// The role context compiles a condition when it is written, so a bad
+// expression is rejected up front; access evaluates it at check time.
+// Both go through the same environment declared here, which is the
+// whole contract between them:
+//
+//	user      map   id, kind ("user" | "service_account"), timezone,
+//	                locale, attributes (the app's custom attributes)
+//	app       map   id
+//	resource  map   caller-supplied attributes of the resource acted on
+//	request   map   caller-supplied attributes of the request itself
+//	now       timestamp of the check
+//
+// "Business hours in the user's timezone" is written
+// now.getHours(user.timezone) >= 9 && now.getHours(user.timezone) < 17.
+//
+// Errors are raw CEL errors; callers that need a validation.Error wrap
+// them themselves, keeping this package free of validation knowledge.
+package condition
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+)
+
+// MaxLen bounds the source text of one condition.
+const MaxLen = 1024
+
+// costLimit caps the evaluation cost of one condition, so a stored
+// expression cannot turn a permission check into a long computation.
+const costLimit = 10_000
+
+// maxPrograms bounds the program cache. Stored conditions are few, but
+// every expression a role write submits is compiled too, accepted or
+// not, so the cache must not grow with the writes.
+const maxPrograms = 4096
+
+// Vars are the inputs a condition is evaluated against. nil maps read
+// as empty ones.
+type Vars struct {
+	User     map[string]any
+	App      map[string]any
+	Resource map[string]any
+	Request  map[string]any
+	Now      time.Time
+}
+
+// Program is a compiled condition, safe for concurrent use.
+type Program struct {
+	prg cel.Program
+}
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+
+	// programs caches compiled conditions by source text.
+	programs = newProgramCache(maxPrograms)
+)
+
+// programCache holds compiled programs, least recently used evicted
+// first when full: the conditions checked on every request stay, and
+// one-off expressions roll through the tail.
+type programCache struct {
+	maxSize int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element // of *programEntry
+	lru     *list.List               // most recently used at the front
+}
+
+type programEntry struct {
+	expr string
+	prg  *Program
+}
+
+func newProgramCache(maxSize int) *programCache {
+	return &programCache{maxSize: maxSize, entries: make(map[string]*list.Element), lru: list.New()}
+}
+
+func (c *programCache) get(expr string) (*Program, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[expr]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return el.Value.(*programEntry).prg, true
+}
+
+func (c *programCache) put(expr string, p *Program) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[expr]; ok {
+		c.lru.MoveToFront(el)
+		return
+	}
+	for c.lru.Len() >= c.maxSize {
+		el := c.lru.Back()
+		c.lru.Remove(el)
+		delete(c.entries, el.Value.(*programEntry).expr)
+	}
+	c.entries[expr] = c.lru.PushFront(&programEntry{expr: expr, prg: p})
+}
+
+func (c *programCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func environment() (*cel.Env, error) {
+	envOnce.Do(func() {
+		dyn := cel.MapType(cel.StringType, cel.DynType)
+		env, envErr = cel.NewEnv(
+			cel.Variable("user", dyn),
+			cel.Variable("app", dyn),
+			cel.Variable("resource", dyn),
+			cel.Variable("request", dyn),
+			cel.Variable("now", cel.TimestampType),
+			cel.CrossTypeNumericComparisons(true),
+			ext.Strings(),
+		)
+	})
+	return env, envErr
+}
+
+// Compile parses and type-checks expr, which must yield a bool.
+// Results are cached by source text.
+func Compile(expr string) (*Program, error) {
+	if p, ok := programs.get(expr); ok {
+		return p, nil
+	}
+	if expr == "" {
+		return nil, errors.New("condition is empty")
+	}
+	if len(expr) > MaxLen {
+		return nil, fmt.Errorf("condition is longer than %d bytes", MaxLen)
+	}
+	e, err := environment()
+	if err != nil {
+		return nil, fmt.Errorf("condition: environment: %w", err)
+	}
+	ast, iss := e.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("condition must evaluate to bool, not %s", ast.OutputType())
+	}
+	prg, err := e.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, err
+	}
+	p := &Program{prg: prg}
+	programs.put(expr, p)
+	return p, nil
+}
+
+// Eval runs the condition. A runtime error — a missing resource key, a
+// bad timezone, the cost limit — is returned as an error, and callers
+// treat it as "condition not met".
+func (p *Program) Eval(v Vars) (bool, error) {
+	out, _, err := p.prg.Eval(map[string]any{
+		"user":     orEmpty(v.User),
+		"app":      orEmpty(v.App),
+		"resource": orEmpty(v.Resource),
+		"request":  orEmpty(v.Request),
+		"now":      v.Now,
+	})
+	if err != nil {
+		return false, err
+	}
+	b, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("condition yielded %s, not bool", out.Type().TypeName())
+	}
+	return b, nil
+}
+
+func orEmpty(m map[string]any) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+	return m
+}
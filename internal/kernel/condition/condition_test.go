@@ -0,0 +1,124 @@
+package condition
+
+import (
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata" // the timezone cases must not depend on the host's zoneinfo
+)
+
+func TestCompileErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{"empty", "", "empty"},
+		{"too long", "true || " + strings.Repeat("true || ", MaxLen/8) + "true", "longer than"},
+		{"syntax", "resource.amount <", "Syntax error"},
+		{"not bool", "resource.amount + 1", "must evaluate to bool"},
+		{"undeclared variable", "tenant.id == 'x'", "undeclared reference"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Compile(tc.expr)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("Compile(%q) = %v, want error containing %q", tc.expr, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestEval(t *testing.T) {
+	// 12:30 UTC: 21:30 in Tokyo, 07:30 in New York (EST).
+	noon := time.Date(2026, time.January, 15, 12, 30, 0, 0, time.UTC)
+	businessHours := "now.getHours(user.timezone) >= 9 && now.getHours(user.timezone) < 17"
+
+	cases := []struct {
+		name    string
+		expr    string
+		vars    Vars
+		want    bool
+		wantErr string
+	}{
+		{"resource attribute", "resource.amount < 1000",
+			Vars{Resource: map[string]any{"amount": 250}}, true, ""},
+		{"request attribute", "request.ip.startsWith('10.')",
+			Vars{Request: map[string]any{"ip": "192.0.2.7"}}, false, ""},
+		{"timezone in hours", businessHours,
+			Vars{User: map[string]any{"timezone": "Europe/Berlin"}, Now: noon}, true, ""},
+		{"timezone out of hours", businessHours,
+			Vars{User: map[string]any{"timezone": "Asia/Tokyo"}, Now: noon}, false, ""},
+		{"timezone before hours", businessHours,
+			Vars{User: map[string]any{"timezone": "America/New_York"}, Now: noon}, false, ""},
+		{"unknown timezone", businessHours,
+			Vars{User: map[string]any{"timezone": "Mars/Olympus"}, Now: noon}, false, "unknown time zone"},
+		{"missing context key", "resource.amount < 1000", Vars{}, false, "no such key"},
+		{"cost limit", "resource.items.all(x, resource.items.all(y, x <= y || x > y))",
+			Vars{Resource: map[string]any{"items": manyInts(200)}}, false, "cost limit exceeded"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := Compile(tc.expr)
+			if err != nil {
+				t.Fatalf("Compile: %v", err)
+			}
+			got, err := p.Eval(tc.vars)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("Eval = %v, %v; want error containing %q", got, err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tc.want {
+				t.Fatalf("Eval = %v, %v; want %v", got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestProgramCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newProgramCache(2)
+	a, b, d := &Program{}, &Program{}, &Program{}
+	c.put("a", a)
+	c.put("b", b)
+	if _, ok := c.get("a"); !ok {
+		t.Fatal("a missing")
+	}
+	c.put("d", d) // evicts b, the least recently used
+
+	if c.len() != 2 {
+		t.Fatalf("len = %d, want 2", c.len())
+	}
+	if _, ok := c.get("b"); ok {
+		t.Fatal("b survived eviction")
+	}
+	if p, ok := c.get("a"); !ok || p != a {
+		t.Fatal("a evicted")
+	}
+	if p, ok := c.get("d"); !ok || p != d {
+		t.Fatal("d missing")
+	}
+}
+
+func TestCompileCaches(t *testing.T) {
+	p1, err := Compile("app.id == 'a1'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, err := Compile("app.id == 'a1'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p1 != p2 {
+		t.Fatal("second Compile did not reuse the cached program")
+	}
+}
+
+func manyInts(n int) []any {
+	out := make([]any, n)
+	for i := range out {
+		out[i] = i
+	}
+	return out
+}
@@ -23,6 +23,14 @@ import (
 // index.
 const mysqlErrDup = 1062
 
+// mysqlErrNoReferencedRow is "Cannot add or update a child row: a
+// foreign key constraint fails" — the referenced parent row is missing.
+const mysqlErrNoReferencedRow = 1452
+
+// mysqlErrRowIsReferenced is "Cannot delete or update a parent row: a
+// foreign key constraint fails" — a child row still points at it.
+const mysqlErrRowIsReferenced = 1451
+
 // IsDuplicateEntry reports whether err is a UNIQUE-constraint violation
 // from the MySQL driver. Used by every Create / Update path to translate
 // driver errors into the per-module ErrXxxAlreadyExists sentinel.
@@ -31,6 +39,22 @@ func IsDuplicateEntry(err error) bool {
 	return errors.As(err, &me) && me.Number == mysqlErrDup
 }
 
+// IsForeignKeyViolation reports whether err is an INSERT / UPDATE whose
+// foreign key points at a missing parent row. Adapters translate it
+// into the sentinel of whatever the parent is (usually a race with a
+// concurrent delete).
+func IsForeignKeyViolation(err error) bool {
+	var me *mysql.MySQLError
+	return errors.As(err, &me) && me.Number == mysqlErrNoReferencedRow
+}
+
+// IsRowReferenced reports whether err is a DELETE refused because a
+// foreign key without ON DELETE CASCADE still points at the row.
+func IsRowReferenced(err error) bool {
+	var me *mysql.MySQLError
+	return errors.As(err, &me) && me.Number == mysqlErrRowIsReferenced
+}
+
 // InTx runs fn inside a write transaction. Commits on success, rolls back
 // on any error (including panics — the deferred Rollback is a no-op
 // after Commit).
@@ -41,7 +65,13 @@ func IsDuplicateEntry(err error) bool {
 //	    q := dbgen.New(tx)
 //	    // ...
 //	})
+//
+// Inside WithTx, fn runs on the ambient transaction instead and the
+// outer call decides whether it commits.
 func InTx(ctx context.Context, db *sql.DB, fn func(*sql.Tx) error) error {
+	if tx, ok := TxFrom(ctx); ok {
+		return fn(tx)
+	}
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
@@ -57,6 +87,29 @@ func InTx(ctx context.Context, db *sql.DB, fn func(*sql.Tx) error) error {
 	return nil
 }
 
+// txKey carries the ambient transaction of WithTx.
+type txKey struct{}
+
+// WithTx runs fn inside one write transaction carried by the context fn
+// receives. Repositories that look it up with TxFrom (and InTx, which
+// joins it) run their statements on it, so a use-case spanning several
+// modules commits or rolls back as a whole. A nested WithTx joins the
+// outer transaction.
+func WithTx(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	if _, ok := TxFrom(ctx); ok {
+		return fn(ctx)
+	}
+	return InTx(ctx, db, func(tx *sql.Tx) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// TxFrom returns the ambient transaction of WithTx, if any.
+func TxFrom(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
+	return tx, ok
+}
+
 // Discriminate is called by per-module adapters when a conditional
 // UPDATE/DELETE matched 0 rows: a follow-up COUNT(*) decides whether the
 // row is missing or the etag drifted. Callers pass their domain-specific
@@ -0,0 +1,126 @@
+// Package tenant carries the tenant a request works in.
+//
+// Users, apps, roles and service accounts each belong to one tenant;
+// their repositories read the tenant from the context rather than from
+// every call's arguments, so isolation holds for any path that reaches
+// them — a use-case, a sibling module's precondition check, a login.
+//
+// Two questions, two helpers:
+//
+//	Scope(ctx)  which rows may be read: a tenant id, or "" for all
+//	Home(ctx)   which tenant new rows land in, and the one natural
+//	            keys (email, username, app name) are resolved in
+//
+// Both start from an explicit tenant set with With — the public auth
+// flows set the tenant of the app being logged into, the gRPC
+// interceptor the one a super-admin asked for — and fall back to the
+// actor's tenant.
+//
+// The System tenant is created by the migration that introduced
+// tenancy and owns every row that predates it. Super-admins are the
+// System principals the host's check confirms (WithSuperAdminCheck):
+// unscoped reads, across every tenant. Requests with no actor and no
+// explicit tenant (background work, pre-auth lookups by id) are
+// unscoped as well; their Home is System.
+//
+// kernel/tenant sits next to kernel/actor at the bottom of the
+// dependency tree and imports nothing else from internal/.
+package tenant
+
+import (
+	"context"
+	"sync"
+
+	"sso/internal/kernel/actor"
+)
+
+// System is the id of the tenant seeded by the tenancy migration.
+const System = "00000000-0000-7000-8000-000000000000"
+
+type (
+	ctxKey        struct{}
+	superAdminKey struct{}
+)
+
+// With returns a derived context that works in tenant id, whatever the
+// actor's own tenant.
+func With(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// explicit returns the tenant set with With, if any.
+func explicit(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(ctxKey{}).(string)
+	return id, ok && id != ""
+}
+
+// Of returns the tenant an actor belongs to. Tokens minted before
+// tenancy carry none; their holders all predate it and belong to
+// System.
+func Of(a actor.Actor) string {
+	if a.TenantID == "" {
+		return System
+	}
+	return a.TenantID
+}
+
+// Scope returns the tenant reads are filtered to, or "" when the
+// request may read every tenant (super-admin, background work).
+func Scope(ctx context.Context) string {
+	if id, ok := explicit(ctx); ok {
+		return id
+	}
+	a, ok := actor.From(ctx)
+	if !ok || a.IsSystem() || IsSuperAdmin(ctx, a) {
+		return ""
+	}
+	return Of(a)
+}
+
+// Home returns the tenant writes land in and natural keys are looked
+// up in. Never empty.
+func Home(ctx context.Context) string {
+	if id, ok := explicit(ctx); ok {
+		return id
+	}
+	if a, ok := actor.From(ctx); ok && !a.IsSystem() {
+		return Of(a)
+	}
+	return System
+}
+
+// Visible reports whether a row of tenant id may be read under ctx.
+// Repositories use it for lookups by primary key, where filtering in
+// SQL would buy nothing.
+func Visible(ctx context.Context, id string) bool {
+	scope := Scope(ctx)
+	return scope == "" || scope == id
+}
+
+// superAdminCheck is the host's super-admin check for one actor.
+type superAdminCheck struct {
+	actorID string
+	check   func() bool
+}
+
+// WithSuperAdminCheck returns a derived context in which check decides
+// whether a, the request's actor, is a super-admin. check runs at most
+// once, the first time IsSuperAdmin (or Scope) needs the answer, so a
+// request that neither names another tenant nor reads unscoped never
+// pays for it. check must not consult ctx's own check: give it the
+// context the check was installed on.
+func WithSuperAdminCheck(ctx context.Context, a actor.Actor, check func() bool) context.Context {
+	return context.WithValue(ctx, superAdminKey{}, &superAdminCheck{actorID: a.ID, check: sync.OnceValue(check)})
+}
+
+// IsSuperAdmin reports whether a is a super-admin: an authenticated
+// user or service account of the System tenant that ctx's super-admin
+// check confirms. Being in the System tenant alone is not enough —
+// public registration lands there.
+func IsSuperAdmin(ctx context.Context, a actor.Actor) bool {
+	if (!a.IsUser() && !a.IsServiceAccount()) || Of(a) != System {
+		return false
+	}
+	c, ok := ctx.Value(superAdminKey{}).(*superAdminCheck)
+	return ok && c.actorID == a.ID && c.check()
+}
@@ -12,21 +12,40 @@
 // unreachable thanks to Go's "internal/" protection.
 package access
 
-import "sso/internal/modules/access/internal/domain"
+import (
+	"sso/internal/modules/access/internal/domain"
+	"sso/internal/modules/access/internal/httpapi"
+)
 
 type (
 	UserID                  = domain.UserID
 	RoleID                  = domain.RoleID
 	AppID                   = domain.AppID
+	GroupID                 = domain.GroupID
 	ActorID                 = domain.ActorID
+	Principal               = domain.Principal
+	PrincipalKind           = domain.PrincipalKind
 	RoleAssignment          = domain.RoleAssignment
 	NewRoleAssignmentParams = domain.NewRoleAssignmentParams
+	GroupRoleAssignment     = domain.GroupRoleAssignment
 	ListOrderBy             = domain.ListOrderBy
 	PageCursor              = domain.PageCursor
 	ListUserRolesQuery      = domain.ListUserRolesQuery
 	ListUserRolesRow        = domain.ListUserRolesRow
 	ListUserRolesResult     = domain.ListUserRolesResult
 	PermissionRow           = domain.PermissionRow
+	ResourceScope           = domain.ResourceScope
+	ScopedRoleAssignment    = domain.ScopedRoleAssignment
+	PermissionHolder        = domain.PermissionHolder
+	RoleMember              = domain.RoleMember
+	SoDRuleID               = domain.SoDRuleID
+	SoDRule                 = domain.SoDRule
+	SoDViolation            = domain.SoDViolation
+
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
 )
 
 // ListOrderBy enum re-exports.
@@ -38,12 +57,24 @@ const (
 	OrderByRoleIDAsc     = domain.OrderByRoleIDAsc
 )
 
+// PrincipalKind enum re-exports.
+const (
+	PrincipalKindUnspecified    = domain.PrincipalKindUnspecified
+	PrincipalKindUser           = domain.PrincipalKindUser
+	PrincipalKindServiceAccount = domain.PrincipalKindServiceAccount
+)
+
 // ID parsers re-exported as package-level variables.
 var (
 	ParseUserID  = domain.ParseUserID
 	ParseRoleID  = domain.ParseRoleID
 	ParseAppID   = domain.ParseAppID
+	ParseGroupID = domain.ParseGroupID
 	ParseActorID = domain.ParseActorID
+
+	ParseSoDRuleID = domain.ParseSoDRuleID
+
+	ParseResourceScope = domain.ParseResourceScope
 )
 
 // ----------------------------------------------------------------------------
@@ -55,9 +86,14 @@ var (
 	ErrUserNotFound       = domain.ErrUserNotFound
 	ErrRoleNotFound       = domain.ErrRoleNotFound
 	ErrAppNotFound        = domain.ErrAppNotFound
+	ErrGroupNotFound      = domain.ErrGroupNotFound
 	ErrRoleDisabled       = domain.ErrRoleDisabled
 	ErrRoleNotInApp       = domain.ErrRoleNotInApp
 	ErrUserNotEligible    = domain.ErrUserNotEligible
+	ErrSoDRuleNotFound    = domain.ErrSoDRuleNotFound
+	ErrSoDRuleExists      = domain.ErrSoDRuleExists
+	ErrSoDViolation       = domain.ErrSoDViolation
+	ErrSoDOverrideDenied  = domain.ErrSoDOverrideDenied
 )
 
 // Repository is the persistence contract for role assignments. Exposed
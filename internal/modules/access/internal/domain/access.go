@@ -26,6 +26,7 @@ import (
 type UserID string
 type RoleID string
 type AppID string
+type GroupID string
 
 // ActorID is the principal that performed a write (granted_by_user_id in
 // the proto). Practically a user_id or service_account_id; access does
@@ -54,6 +55,13 @@ func ParseAppID(s string) (AppID, error) {
 	return AppID(s), nil
 }
 
+func ParseGroupID(s string) (GroupID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "group_id", Reason: "must be a valid UUID"}
+	}
+	return GroupID(s), nil
+}
+
 // ParseActorID accepts the empty string (unknown actor) or a valid UUID.
 func ParseActorID(s string) (ActorID, error) {
 	if s == "" {
@@ -68,37 +76,159 @@ func ParseActorID(s string) (ActorID, error) {
 func (u UserID) String() string  { return string(u) }
 func (r RoleID) String() string  { return string(r) }
 func (a AppID) String() string   { return string(a) }
+func (g GroupID) String() string { return string(g) }
 func (a ActorID) String() string { return string(a) }
 
 // ----------------------------------------------------------------------------
-// RoleAssignment — flat (user × role) tuple with audit metadata.
+// Principal — who holds a direct role assignment.
+//
+// The proto only knows user_id, but service-account tokens are meant to
+// authorize exactly like user tokens, so a service account can hold
+// roles too. Both kinds are UUIDs from disjoint tables; the use-case
+// layer resolves which one an id names. Only users belong to groups.
+// ----------------------------------------------------------------------------
+
+type PrincipalKind uint8
+
+const (
+	PrincipalKindUnspecified    PrincipalKind = 0
+	PrincipalKindUser           PrincipalKind = 1
+	PrincipalKindServiceAccount PrincipalKind = 2
+)
+
+func (k PrincipalKind) String() string {
+	switch k {
+	case PrincipalKindUser:
+		return "user"
+	case PrincipalKindServiceAccount:
+		return "service_account"
+	default:
+		return "unspecified"
+	}
+}
+
+type Principal struct {
+	Kind PrincipalKind
+	ID   string
+}
+
+// UserPrincipal is the Principal for an identity user.
+func UserPrincipal(id UserID) Principal {
+	return Principal{Kind: PrincipalKindUser, ID: id.String()}
+}
+
+// ServiceAccountPrincipal is the Principal for a service account.
+func ServiceAccountPrincipal(id string) Principal {
+	return Principal{Kind: PrincipalKindServiceAccount, ID: id}
+}
+
+func (p Principal) IsUser() bool           { return p.Kind == PrincipalKindUser }
+func (p Principal) IsServiceAccount() bool { return p.Kind == PrincipalKindServiceAccount }
+
+// UserID returns the id as a UserID; meaningful only when IsUser.
+func (p Principal) UserID() UserID { return UserID(p.ID) }
+
+// ----------------------------------------------------------------------------
+// RoleAssignment — flat (principal × role) tuple with audit metadata.
 //
 // Unlike most aggregates in this codebase the RoleAssignment is
 // immutable: the proto has no UpdateRoleAssignment surface and there
-// is no etag, status or patch. Only Grant/Remove operations exist. The
-// fields are exposed as plain values; constructors stamp granted_at
-// and the use-case layer fills in the rest.
+// is no etag, status or patch. Only Grant/Remove operations exist,
+// plus ExtendRoleAssignment moving ExpiresAt. The fields are exposed as
+// plain values; constructors stamp granted_at and the use-case layer
+// fills in the rest.
+//
+// NotBefore / ExpiresAt bound the window in which the assignment
+// contributes to CheckPermission; nil means unbounded on that side.
 // ----------------------------------------------------------------------------
 
 type RoleAssignment struct {
-	UserID          UserID
+	Principal       Principal
 	RoleID          RoleID
 	AppID           AppID
 	GrantedByUserID ActorID
 	GrantedAt       time.Time
+	NotBefore       *time.Time
+	ExpiresAt       *time.Time
 }
 
 type NewRoleAssignmentParams struct {
-	UserID          UserID
+	Principal       Principal
 	RoleID          RoleID
 	AppID           AppID
 	GrantedByUserID ActorID
+	NotBefore       *time.Time
+	ExpiresAt       *time.Time
 	Now             time.Time
 }
 
 func NewRoleAssignment(p NewRoleAssignmentParams) *RoleAssignment {
 	return &RoleAssignment{
-		UserID:          p.UserID,
+		Principal:       p.Principal,
+		RoleID:          p.RoleID,
+		AppID:           p.AppID,
+		GrantedByUserID: p.GrantedByUserID,
+		GrantedAt:       p.Now,
+		NotBefore:       p.NotBefore,
+		ExpiresAt:       p.ExpiresAt,
+	}
+}
+
+// IsExpired reports whether the assignment's window closed at or before
+// now. A permanent assignment never expires.
+func (a *RoleAssignment) IsExpired(now time.Time) bool {
+	return a.ExpiresAt != nil && !a.ExpiresAt.After(now)
+}
+
+// InWindow reports whether now falls inside [NotBefore, ExpiresAt).
+func (a *RoleAssignment) InWindow(now time.Time) bool {
+	if a.NotBefore != nil && now.Before(*a.NotBefore) {
+		return false
+	}
+	return !a.IsExpired(now)
+}
+
+// ValidateWindow checks a requested [notBefore, expiresAt) window: the
+// expiry must lie in the future and after notBefore. A notBefore in the
+// past is fine — it just means "already effective".
+func ValidateWindow(notBefore, expiresAt *time.Time, now time.Time) error {
+	if expiresAt == nil {
+		return nil
+	}
+	if !expiresAt.After(now) {
+		return &validation.Error{Field: "expires_at", Reason: "must be in the future"}
+	}
+	if notBefore != nil && !expiresAt.After(*notBefore) {
+		return &validation.Error{Field: "expires_at", Reason: "must be after not_before"}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// GroupRoleAssignment — (group × role) tuple. Every member of the group
+// holds the role for as long as they stay in it; the grant itself is
+// immutable in the same way RoleAssignment is.
+// ----------------------------------------------------------------------------
+
+type GroupRoleAssignment struct {
+	GroupID         GroupID
+	RoleID          RoleID
+	AppID           AppID
+	GrantedByUserID ActorID
+	GrantedAt       time.Time
+}
+
+type NewGroupRoleAssignmentParams struct {
+	GroupID         GroupID
+	RoleID          RoleID
+	AppID           AppID
+	GrantedByUserID ActorID
+	Now             time.Time
+}
+
+func NewGroupRoleAssignment(p NewGroupRoleAssignmentParams) *GroupRoleAssignment {
+	return &GroupRoleAssignment{
+		GroupID:         p.GroupID,
 		RoleID:          p.RoleID,
 		AppID:           p.AppID,
 		GrantedByUserID: p.GrantedByUserID,
@@ -17,6 +17,9 @@ var (
 	// ErrAppNotFound — the app_id refers to no row in apps.
 	ErrAppNotFound = errors.New("access: app not found")
 
+	// ErrGroupNotFound — the group_id refers to no row in user_groups.
+	ErrGroupNotFound = errors.New("access: group not found")
+
 	// ErrRoleDisabled — the role exists but is DISABLED. Granting a
 	// disabled role fails with this; removing it does not.
 	ErrRoleDisabled = errors.New("access: role is disabled")
@@ -30,4 +33,18 @@ var (
 	// must not be granted in that state. Existing assignments stay; they
 	// just don't contribute to CheckPermission.
 	ErrUserNotEligible = errors.New("access: user is blocked or deleted")
+
+	// ErrSoDRuleNotFound — the rule_id refers to no row in sod_rules.
+	ErrSoDRuleNotFound = errors.New("access: sod rule not found")
+
+	// ErrSoDRuleExists — the app already has a rule of that name.
+	ErrSoDRuleExists = errors.New("access: sod rule already exists")
+
+	// ErrSoDViolation — the grant would leave the principal holding two
+	// or more roles of one separation-of-duties rule.
+	ErrSoDViolation = errors.New("access: grant violates a separation-of-duties rule")
+
+	// ErrSoDOverrideDenied — the caller asked to override a violated
+	// rule without holding the override permission.
+	ErrSoDOverrideDenied = errors.New("access: caller may not override separation-of-duties rules")
 )
@@ -24,20 +24,26 @@ type PageCursor struct {
 }
 
 type ListUserRolesQuery struct {
-	UserID   UserID
-	AppID    AppID
-	PageSize int
-	After    *PageCursor
-	OrderBy  ListOrderBy
+	Principal Principal
+	AppID     AppID
+	PageSize  int
+	After     *PageCursor
+	OrderBy   ListOrderBy
 }
 
 // ListUserRolesRow carries the joined (assignment + role-id) per page
 // row. The use-case fetches the actual Role aggregates from
 // role.Repository afterwards — keeps access from importing the role
 // domain package.
+//
+// A role the user holds both directly and through groups is one row:
+// Direct reports the direct grant, ViaGroups lists every group that
+// passes the role on, and GrantedAt is the earliest of those grants.
 type ListUserRolesRow struct {
 	RoleID    RoleID
 	GrantedAt time.Time
+	Direct    bool
+	ViaGroups []GroupID
 }
 
 type ListUserRolesResult struct {
@@ -49,9 +55,79 @@ type ListUserRolesResult struct {
 // PermissionRow is one (role_id, permission) tuple emitted by
 // ListActivePermissions. The use-case uses these for wildcard
 // expansion on CheckPermission and to populate matched_role_ids.
+//
+// Path is the include chain the role was reached through: the role the
+// user holds first, RoleID last. A directly held role has a one-element
+// path.
+//
+// Condition is the CEL expression guarding the permission on RoleID,
+// empty when the permission is unconditional.
+//
+// Scope is set when the root role of Path is held through a scoped
+// grant: the permission then applies only to resources Scope covers.
+// nil means app-wide.
 type PermissionRow struct {
 	RoleID     RoleID
 	Permission string
+	Condition  string
+	Path       []RoleID
+	Scope      *ResourceScope
+}
+
+// PermissionHoldersQuery pages the principals holding Permission in
+// AppID, in principal id order after After ("" = first page). Now
+// decides which time-bound assignments count.
+type PermissionHoldersQuery struct {
+	AppID      AppID
+	Permission string
+	Now        time.Time
+	After      string
+	PageSize   int
+}
+
+// PermissionHolder is one principal holding a permission, summed over
+// every way it does. AppWide is a grant across the app (direct, through
+// a group, or a service account's); Conditional marks an app-wide
+// holding whose every grant carries a CEL condition. Scoped is set when
+// the principal also or only holds it on resources, through scoped
+// grants.
+type PermissionHolder struct {
+	Principal   Principal
+	AppWide     bool
+	Conditional bool
+	Scoped      bool
+}
+
+// RoleMembersQuery pages the principals holding RoleID, in principal id
+// order after After ("" = first page).
+type RoleMembersQuery struct {
+	RoleID   RoleID
+	Now      time.Time
+	After    string
+	PageSize int
+}
+
+// RoleMember is one principal holding a role, as ListUserRolesRow
+// describes a held role: Direct and ViaGroups are not exclusive.
+type RoleMember struct {
+	Principal Principal
+	Direct    bool
+	ViaGroups []GroupID
+}
+
+// SoDViolationsQuery pages the separation-of-duties violations in
+// AppID, ordered by rule id then principal id, after After (nil = first
+// page). Now decides which time-bound assignments count.
+type SoDViolationsQuery struct {
+	AppID    AppID
+	Now      time.Time
+	After    *SoDViolationCursor
+	PageSize int
+}
+
+type SoDViolationCursor struct {
+	RuleID      SoDRuleID
+	PrincipalID string
 }
 
 // Repository is the persistence contract for role assignments. CRUD
@@ -60,35 +136,133 @@ type PermissionRow struct {
 type Repository interface {
 	// Create inserts a new assignment. Returns (created=true, nil) on a
 	// fresh insert; (created=false, nil) if a row with the same
-	// (user_id, role_id) already existed (idempotent grant). Any other
+	// (principal, role_id) already existed (idempotent grant). Any other
 	// error is propagated.
 	Create(ctx context.Context, a *RoleAssignment) (created bool, err error)
 
 	// Get returns the existing assignment or ErrAssignmentNotFound.
-	Get(ctx context.Context, userID UserID, roleID RoleID) (*RoleAssignment, error)
+	Get(ctx context.Context, p Principal, roleID RoleID) (*RoleAssignment, error)
 
 	// Delete removes the assignment. Idempotent: returns (removed=false,
 	// nil) when the row was not present.
-	Delete(ctx context.Context, userID UserID, roleID RoleID) (removed bool, err error)
+	Delete(ctx context.Context, p Principal, roleID RoleID) (removed bool, err error)
 
-	// BulkCreate inserts the given assignments atomically. The returned
+	// BulkCreate inserts the given assignments atomically. All of them
+	// share one principal. The returned
 	// slice is positionally aligned with the input; createdMask[i] is
 	// true when the assignment at index i was newly inserted (false on
 	// idempotent re-grant).
 	BulkCreate(ctx context.Context, assignments []*RoleAssignment) (createdMask []bool, err error)
 
-	// BulkDelete removes the given (user, role) pairs atomically. All
-	// pairs share the same userID; only role_ids vary.
-	BulkDelete(ctx context.Context, userID UserID, roleIDs []RoleID) error
+	// BulkDelete removes the given (principal, role) pairs atomically.
+	// All pairs share the same principal; only role_ids vary.
+	BulkDelete(ctx context.Context, p Principal, roleIDs []RoleID) error
 
-	// ListUserRoles paginates assignments for one (user, app). Returns
-	// only role_ids + granted_at; the use-case loads full Role records
-	// from the role.Repository.
+	// ListUserRoles paginates assignments for one (principal, app).
+	// Returns only role_ids + granted_at; the use-case loads full Role
+	// records from the role.Repository.
 	ListUserRoles(ctx context.Context, q ListUserRolesQuery) (ListUserRolesResult, error)
 
-	// ListActivePermissions returns one row per (role_id, permission)
-	// for ACTIVE roles assigned to the user in the target app. DISABLED
-	// roles are filtered server-side at the JOIN. The use-case layer
-	// performs wildcard matching against the requested permission.
-	ListActivePermissions(ctx context.Context, userID UserID, appID AppID) ([]PermissionRow, error)
+	// ListActivePermissions returns one row per (role_id, permission,
+	// path) for ACTIVE roles the principal holds in the target app,
+	// directly or (users only) through any group the user is a member
+	// of, plus every ACTIVE role those include transitively. DISABLED
+	// roles are filtered server-side. The use-case layer performs
+	// wildcard matching against the requested permission.
+	// Direct assignments count only while now is inside their window.
+	// Scoped grants contribute rows too, with Scope set.
+	ListActivePermissions(ctx context.Context, p Principal, appID AppID, now time.Time) ([]PermissionRow, error)
+
+	// NextWindowChange returns the earliest not_before or expires_at
+	// after now among the principal's direct assignments in the app:
+	// the next moment ListActivePermissions changes without a write.
+	// ok is false when no window edge lies ahead.
+	NextWindowChange(ctx context.Context, p Principal, appID AppID, now time.Time) (next time.Time, ok bool, err error)
+
+	// UpdateExpiresAt moves an assignment's expiry (nil = permanent).
+	// An assignment that is missing, or already expired at now, yields
+	// ErrAssignmentNotFound.
+	UpdateExpiresAt(ctx context.Context, p Principal, roleID RoleID, expiresAt *time.Time, now time.Time) error
+
+	// ListExpired returns up to limit assignments, of either principal
+	// kind, whose expires_at is at or before now, oldest expiry first.
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*RoleAssignment, error)
+
+	// DeleteExpired removes the assignment only if it is still expired
+	// at now; removed=false when it was extended or removed meanwhile.
+	DeleteExpired(ctx context.Context, p Principal, roleID RoleID, now time.Time) (removed bool, err error)
+
+	// HasRoleViaGroup reports whether any group the user is a member of
+	// holds the role.
+	HasRoleViaGroup(ctx context.Context, userID UserID, roleID RoleID) (bool, error)
+
+	// CreateGroupAssignment has the same idempotent contract as Create.
+	// A group that vanished underneath surfaces as ErrGroupNotFound.
+	CreateGroupAssignment(ctx context.Context, a *GroupRoleAssignment) (created bool, err error)
+
+	// GetGroupAssignment returns the existing grant or
+	// ErrAssignmentNotFound.
+	GetGroupAssignment(ctx context.Context, groupID GroupID, roleID RoleID) (*GroupRoleAssignment, error)
+
+	// DeleteGroupAssignment is idempotent: removed=false when the row
+	// was not present.
+	DeleteGroupAssignment(ctx context.Context, groupID GroupID, roleID RoleID) (removed bool, err error)
+
+	// ListGroupRoles returns every grant held by the group, oldest
+	// first. appID "" = all apps.
+	ListGroupRoles(ctx context.Context, groupID GroupID, appID AppID) ([]*GroupRoleAssignment, error)
+
+	// CreateScopedAssignment has the same idempotent contract as
+	// Create, keyed by (principal, role, scope).
+	CreateScopedAssignment(ctx context.Context, a *ScopedRoleAssignment) (created bool, err error)
+
+	// GetScopedAssignment returns the existing grant or
+	// ErrAssignmentNotFound.
+	GetScopedAssignment(ctx context.Context, p Principal, roleID RoleID, scope ResourceScope) (*ScopedRoleAssignment, error)
+
+	// DeleteScopedAssignment is idempotent: removed=false when the row
+	// was not present. Only the exact scope is removed; grants on
+	// scopes below it stay.
+	DeleteScopedAssignment(ctx context.Context, p Principal, roleID RoleID, scope ResourceScope) (removed bool, err error)
+
+	// ListScopedAssignments returns the principal's scoped grants in
+	// the app, ordered by scope then role.
+	ListScopedAssignments(ctx context.Context, p Principal, appID AppID) ([]*ScopedRoleAssignment, error)
+
+	// ListPermissionHolders is ListActivePermissions turned around: the
+	// principals some ACTIVE role of the app grants the permission to,
+	// exactly or by "<resource>:*", held directly, through an ACTIVE
+	// role including it, through a group or through a scoped grant.
+	// Returns up to PageSize+1 rows; the caller trims and pages.
+	ListPermissionHolders(ctx context.Context, q PermissionHoldersQuery) ([]PermissionHolder, error)
+
+	// ListRoleMembers returns the principals holding the role directly
+	// (inside the assignment's window) or through a group — those
+	// HasRoleInApp answers true for. Up to PageSize+1 rows.
+	ListRoleMembers(ctx context.Context, q RoleMembersQuery) ([]RoleMember, error)
+
+	// CreateSoDRule inserts the rule and its role set atomically. A
+	// rule of the same name in the app yields ErrSoDRuleExists.
+	CreateSoDRule(ctx context.Context, r *SoDRule) error
+
+	// GetSoDRule returns the rule or ErrSoDRuleNotFound.
+	GetSoDRule(ctx context.Context, id SoDRuleID) (*SoDRule, error)
+
+	// ListSoDRules returns every rule of the app, by name.
+	ListSoDRules(ctx context.Context, appID AppID) ([]*SoDRule, error)
+
+	// DeleteSoDRule is idempotent: removed=false when the row was not
+	// present.
+	DeleteSoDRule(ctx context.Context, id SoDRuleID) (removed bool, err error)
+
+	// ListHeldRoleIDs returns the roles of the app the principal holds
+	// app-wide, whatever their status: direct assignments not expired
+	// at now, including those whose window has yet to open, and (users
+	// only) the roles of the groups the user is a member of.
+	ListHeldRoleIDs(ctx context.Context, p Principal, appID AppID, now time.Time) ([]RoleID, error)
+
+	// ListSoDViolations returns, for every rule of the app, the
+	// principals holding two or more of its roles as ListHeldRoleIDs
+	// counts them. Up to PageSize+1 rows; the caller trims and pages.
+	ListSoDViolations(ctx context.Context, q SoDViolationsQuery) ([]SoDViolation, error)
 }
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"sso/internal/kernel/validation"
+)
+
+// ----------------------------------------------------------------------------
+// ResourceScope — the resource instances a scoped grant covers.
+//
+// Type is the kind of resource ("project"), ID the instance. IDs are
+// '/'-separated paths so that a grant can cover a subtree: a scope on
+// "acme" covers "acme" and "acme/42", but not "acme42". Access does not
+// know the resources; both halves are opaque strings the app defines.
+// ----------------------------------------------------------------------------
+
+type ResourceScope struct {
+	Type string
+	ID   string
+}
+
+const (
+	maxResourceTypeLen = 64
+	maxResourceIDLen   = 255
+)
+
+// ParseResourceScope validates a scope: Type passes
+// ValidateResourceType; ID is non-empty, has no empty path segment and
+// no whitespace or control characters.
+func ParseResourceScope(typ, id string) (ResourceScope, error) {
+	if err := ValidateResourceType(typ); err != nil {
+		return ResourceScope{}, err
+	}
+	if id == "" || len(id) > maxResourceIDLen {
+		return ResourceScope{}, &validation.Error{Field: "resource_id", Reason: "length must be between 1 and 255"}
+	}
+	for _, seg := range strings.Split(id, "/") {
+		if seg == "" {
+			return ResourceScope{}, &validation.Error{Field: "resource_id", Reason: "must not contain empty path segments"}
+		}
+	}
+	for _, c := range id {
+		if c <= ' ' || c == 0x7f {
+			return ResourceScope{}, &validation.Error{Field: "resource_id", Reason: "must not contain whitespace or control characters"}
+		}
+	}
+	return ResourceScope{Type: typ, ID: id}, nil
+}
+
+// ValidateResourceType checks that typ matches [a-z][a-z0-9_]* and is
+// at most 64 characters.
+func ValidateResourceType(typ string) error {
+	if len(typ) > maxResourceTypeLen || !isLowerIdent(typ) {
+		return &validation.Error{
+			Field:  "resource_type",
+			Reason: "must match [a-z][a-z0-9_]* and be at most 64 characters",
+		}
+	}
+	return nil
+}
+
+// Covers reports whether a grant on s extends to target: same type,
+// and target's id equals s's id or lies below it.
+func (s ResourceScope) Covers(target ResourceScope) bool {
+	if s.Type != target.Type {
+		return false
+	}
+	return target.ID == s.ID || strings.HasPrefix(target.ID, s.ID+"/")
+}
+
+func (s ResourceScope) String() string { return s.Type + ":" + s.ID }
+
+// isLowerIdent reports whether s matches `^[a-z][a-z0-9_]*$`.
+func isLowerIdent(s string) bool {
+	for i, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case i > 0 && c >= '0' && c <= '9':
+		case i > 0 && c == '_':
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
+
+// ----------------------------------------------------------------------------
+// ScopedRoleAssignment — (principal × role × resource scope). The
+// principal holds the role's permissions on the scope only; app-wide
+// checks do not see it. Immutable like GroupRoleAssignment. Users and
+// service accounts hold scoped grants; groups hold app-wide grants
+// only.
+// ----------------------------------------------------------------------------
+
+type ScopedRoleAssignment struct {
+	Principal       Principal
+	RoleID          RoleID
+	AppID           AppID
+	Scope           ResourceScope
+	GrantedByUserID ActorID
+	GrantedAt       time.Time
+}
+
+type NewScopedRoleAssignmentParams struct {
+	Principal       Principal
+	RoleID          RoleID
+	AppID           AppID
+	Scope           ResourceScope
+	GrantedByUserID ActorID
+	Now             time.Time
+}
+
+func NewScopedRoleAssignment(p NewScopedRoleAssignmentParams) *ScopedRoleAssignment {
+	return &ScopedRoleAssignment{
+		Principal:       p.Principal,
+		RoleID:          p.RoleID,
+		AppID:           p.AppID,
+		Scope:           p.Scope,
+		GrantedByUserID: p.GrantedByUserID,
+		GrantedAt:       p.Now,
+	}
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"sso/internal/kernel/validation"
+)
+
+func TestResourceScopeCovers(t *testing.T) {
+	grant := ResourceScope{Type: "project", ID: "acme"}
+	cases := []struct {
+		target ResourceScope
+		covers bool
+	}{
+		{ResourceScope{"project", "acme"}, true},
+		{ResourceScope{"project", "acme/42"}, true},
+		{ResourceScope{"project", "acme/42/build"}, true},
+		{ResourceScope{"project", "acme42"}, false},
+		{ResourceScope{"project", "acm"}, false},
+		{ResourceScope{"project", "globex/acme"}, false},
+		{ResourceScope{"folder", "acme"}, false},
+	}
+	for _, tc := range cases {
+		if got := grant.Covers(tc.target); got != tc.covers {
+			t.Errorf("%s covers %s = %v, want %v", grant, tc.target, got, tc.covers)
+		}
+	}
+	// A grant below the target does not reach up to it.
+	if (ResourceScope{Type: "project", ID: "acme/42"}).Covers(grant) {
+		t.Error("project:acme/42 covers project:acme")
+	}
+}
+
+func TestParseResourceScope(t *testing.T) {
+	cases := []struct {
+		typ, id string
+		field   string // "" = valid
+	}{
+		{"project", "acme", ""},
+		{"project", "acme/42/build", ""},
+		{"cost_center2", "EU-1", ""},
+		{"Project", "acme", "resource_type"},
+		{"2project", "acme", "resource_type"},
+		{"", "acme", "resource_type"},
+		{strings.Repeat("p", 65), "acme", "resource_type"},
+		{"project", "", "resource_id"},
+		{"project", strings.Repeat("a", 256), "resource_id"},
+		{"project", "/acme", "resource_id"},
+		{"project", "acme/", "resource_id"},
+		{"project", "acme//42", "resource_id"},
+		{"project", "acme 42", "resource_id"},
+		{"project", "acme\t42", "resource_id"},
+	}
+	for _, tc := range cases {
+		_, err := ParseResourceScope(tc.typ, tc.id)
+		var vErr *validation.Error
+		switch {
+		case tc.field == "" && err != nil:
+			t.Errorf("(%q, %q): %v", tc.typ, tc.id, err)
+		case tc.field != "" && (!errors.As(err, &vErr) || vErr.Field != tc.field):
+			t.Errorf("(%q, %q): err = %v, want a %s error", tc.typ, tc.id, err, tc.field)
+		}
+	}
+}
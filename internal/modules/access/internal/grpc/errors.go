@@ -10,7 +10,9 @@ import (
 
 // errorMap routes access-domain sentinels to their gRPC status.
 // ErrUserNotEligible reuses USER_BLOCKED — DELETED is a strict superset
-// for signalling purposes on this assignment surface.
+// for signalling purposes on this assignment surface. errors.proto has
+// no separation-of-duties reason, so ErrSoDViolation is a bare
+// FailedPrecondition; the audit event names the rule.
 var errorMap = map[error]grpcerr.ErrorMapping{
 	domain.ErrUserNotFound: {
 		Code:    codes.NotFound,
@@ -42,6 +44,15 @@ var errorMap = map[error]grpcerr.ErrorMapping{
 		Reason:  ssocommonv1.ErrorReason_ERROR_REASON_USER_BLOCKED,
 		Message: "user is not eligible for assignments",
 	},
+	domain.ErrSoDViolation: {
+		Code:    codes.FailedPrecondition,
+		Message: "grant violates a separation-of-duties rule",
+	},
+	domain.ErrSoDOverrideDenied: {
+		Code:    codes.PermissionDenied,
+		Reason:  ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED,
+		Message: "caller may not override separation-of-duties rules",
+	},
 }
 
 // toGRPCError is the per-package thin wrapper around grpcerr.MapError.
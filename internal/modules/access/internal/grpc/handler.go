@@ -10,6 +10,7 @@ import (
 
 	accesssvc "sso/internal/modules/access/internal/service"
 	"sso/internal/kernel/actor"
+	grpcauth "sso/internal/platform/grpc/auth"
 
 	ssoaccessv1 "github.com/Nergous/sso_protos/gen/go/sso/access/v1"
 	ssorolesv1 "github.com/Nergous/sso_protos/gen/go/sso/roles/v1"
@@ -98,9 +99,10 @@ func (h *Handler) ListUserRoles(ctx context.Context, req *ssoaccessv1.ListUserRo
 
 func (h *Handler) GrantRoleToUser(ctx context.Context, req *ssoaccessv1.GrantRoleToUserRequest) (*ssoaccessv1.RoleAssignment, error) {
 	out, err := h.svc.GrantRoleToUser(ctx, accesssvc.GrantRoleToUserInput{
-		UserID:  req.GetUserId(),
-		RoleID:  req.GetRoleId(),
-		ActorID: actorID(ctx),
+		UserID:      req.GetUserId(),
+		RoleID:      req.GetRoleId(),
+		ActorID:     actorID(ctx),
+		OverrideSoD: grpcauth.SoDOverrideFromCtx(ctx),
 	})
 	if err != nil {
 		return nil, toGRPCError(err)
@@ -128,10 +130,11 @@ func (h *Handler) RemoveRoleFromUser(ctx context.Context, req *ssoaccessv1.Remov
 
 func (h *Handler) BulkGrantRoles(ctx context.Context, req *ssoaccessv1.BulkGrantRolesRequest) (*ssoaccessv1.BulkGrantRolesResponse, error) {
 	out, err := h.svc.BulkGrantRoles(ctx, accesssvc.BulkGrantRolesInput{
-		UserID:  req.GetUserId(),
-		AppID:   req.GetAppId(),
-		RoleIDs: req.GetRoleIds(),
-		ActorID: actorID(ctx),
+		UserID:      req.GetUserId(),
+		AppID:       req.GetAppId(),
+		RoleIDs:     req.GetRoleIds(),
+		ActorID:     actorID(ctx),
+		OverrideSoD: grpcauth.SoDOverrideFromCtx(ctx),
 	})
 	if err != nil {
 		return nil, toGRPCError(err)
@@ -165,11 +168,18 @@ func (h *Handler) BulkRemoveRoles(ctx context.Context, req *ssoaccessv1.BulkRemo
 // CheckPermission
 // ----------------------------------------------------------------------------
 
+// CheckPermission and BatchCheckPermission take the condition context
+// from metadata (see accesssvc.CheckContext).
 func (h *Handler) CheckPermission(ctx context.Context, req *ssoaccessv1.CheckPermissionRequest) (*ssoaccessv1.CheckPermissionResponse, error) {
+	cc, err := checkContext(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	out, err := h.svc.CheckPermission(ctx, accesssvc.CheckPermissionInput{
 		UserID:     req.GetUserId(),
 		AppID:      req.GetAppId(),
 		Permission: req.GetPermission(),
+		Context:    cc,
 	})
 	if err != nil {
 		return nil, toGRPCError(err)
@@ -185,13 +195,23 @@ func (h *Handler) CheckPermission(ctx context.Context, req *ssoaccessv1.CheckPer
 // ----------------------------------------------------------------------------
 
 func (h *Handler) BatchCheckPermission(ctx context.Context, req *ssoaccessv1.BatchCheckPermissionRequest) (*ssoaccessv1.BatchCheckPermissionResponse, error) {
+	cc, err := checkContext(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	out, err := h.svc.BatchCheckPermission(ctx, accesssvc.BatchCheckPermissionInput{
 		UserID:      req.GetUserId(),
 		AppID:       req.GetAppId(),
 		Permissions: req.GetPermissions(),
+		Context:     cc,
 	})
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
 	return &ssoaccessv1.BatchCheckPermissionResponse{Allowed: out.Allowed}, nil
 }
+
+func checkContext(ctx context.Context) (accesssvc.CheckContext, error) {
+	resource, request := grpcauth.ConditionContextFromCtx(ctx)
+	return accesssvc.DecodeCheckContext(resource, request)
+}
@@ -0,0 +1,39 @@
+package grpcadapter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"sso/internal/kernel/validation"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCheckContextFromMetadata(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-condition-resource", `{"owner_id":"u-1","size":3,"tags":["a",2]}`,
+		"x-condition-request", `{"ip":"10.0.0.1"}`,
+	))
+	cc, err := checkContext(ctx)
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if cc.Resource["owner_id"] != "u-1" || cc.Resource["size"] != int64(3) || cc.Request["ip"] != "10.0.0.1" {
+		t.Fatalf("context = %+v", cc)
+	}
+	if tags := cc.Resource["tags"].([]any); tags[1] != int64(2) {
+		t.Fatalf("nested number = %T", tags[1])
+	}
+
+	cc, err = checkContext(context.Background())
+	if err != nil || cc.Resource != nil || cc.Request != nil {
+		t.Fatalf("no metadata: context = %+v, err = %v", cc, err)
+	}
+
+	bad := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-condition-resource", `["not","an","object"]`))
+	var verr *validation.Error
+	if _, err := checkContext(bad); !errors.As(err, &verr) || verr.Field != "resource" {
+		t.Fatalf("err = %v, want a resource validation error", err)
+	}
+}
@@ -11,7 +11,7 @@ import (
 // assignmentToProto renders a domain RoleAssignment as the proto message.
 func assignmentToProto(a *domain.RoleAssignment) *ssoaccessv1.RoleAssignment {
 	return &ssoaccessv1.RoleAssignment{
-		UserId:          a.UserID.String(),
+		UserId:          a.Principal.ID,
 		RoleId:          a.RoleID.String(),
 		AppId:           a.AppID.String(),
 		GrantedByUserId: a.GrantedByUserID.String(),
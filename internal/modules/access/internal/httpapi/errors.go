@@ -0,0 +1,44 @@
+package httpapi
+
+import (
+	"sso/internal/modules/access/internal/domain"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap mirrors the gRPC adapter's table plus ErrGroupNotFound,
+// ErrAssignmentNotFound and the separation-of-duties rule errors, which
+// only the HTTP endpoints raise. errors.proto has reasons for none of
+// them, so those entries are bare statuses (Reason UNSPECIFIED).
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrUserNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_NOT_FOUND, Message: "user not found"},
+	domain.ErrRoleNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_FOUND, Message: "role not found"},
+	domain.ErrAppNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_APP_NOT_FOUND, Message: "app not found"},
+	domain.ErrGroupNotFound: {
+		Code: codes.NotFound, Message: "group not found"},
+	domain.ErrRoleDisabled: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_DISABLED, Message: "role is disabled"},
+	domain.ErrRoleNotInApp: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_IN_APP, Message: "role does not belong to app"},
+	domain.ErrUserNotEligible: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_BLOCKED, Message: "user is not eligible for assignments"},
+	domain.ErrAssignmentNotFound: {
+		Code: codes.NotFound, Message: "role assignment not found"},
+	domain.ErrSoDViolation: {
+		Code: codes.FailedPrecondition, Message: "grant violates a separation-of-duties rule"},
+	domain.ErrSoDOverrideDenied: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "caller may not override separation-of-duties rules"},
+	domain.ErrSoDRuleNotFound: {
+		Code: codes.NotFound, Message: "separation-of-duties rule not found"},
+	domain.ErrSoDRuleExists: {
+		Code: codes.AlreadyExists, Message: "a separation-of-duties rule of that name already exists in the app"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
@@ -0,0 +1,140 @@
+package httpapi
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/platform/httpserver/apiutil"
+)
+
+// ----------------------------------------------------------------------------
+// Paged listings and their export
+// ----------------------------------------------------------------------------
+
+// listing describes a paged listing: the JSON field its rows go under,
+// the export file name without extension, and the row keys that make
+// the CSV columns, in order.
+type listing struct {
+	field   string
+	file    string
+	columns []string
+}
+
+// listPage is one page of a listing. meta is merged into the JSON
+// response and left out of exports.
+type listPage struct {
+	meta map[string]any
+	rows []map[string]any
+	next string
+}
+
+// list answers a listing request. Without format it writes the page as
+// JSON, next_page_token included. format=csv or format=jsonl streams
+// every page from page_token on instead, fetching one page_size at a
+// time. The first page is fetched before anything is written, so a bad
+// request still gets its error status; a later page that fails can only
+// cut the download short, and is logged.
+func (h *Handler) list(w http.ResponseWriter, r *http.Request, l listing, fetch func(token string) (listPage, error)) {
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "csv" && format != "jsonl" {
+		h.api.WriteError(w, r, &validation.Error{Field: "format", Reason: "must be csv or jsonl"})
+		return
+	}
+	page, err := fetch(r.URL.Query().Get("page_token"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	if format == "" {
+		body := map[string]any{l.field: page.rows, "next_page_token": page.next}
+		for k, v := range page.meta {
+			body[k] = v
+		}
+		apiutil.WriteJSON(w, http.StatusOK, body)
+		return
+	}
+
+	// write emits one row; flush ends a page, so a long export reaches
+	// the client as it goes.
+	var (
+		write func(row map[string]any) error
+		flush = func() error { return nil }
+	)
+	switch format {
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		cw := csv.NewWriter(w)
+		// Buffered: the header row reaches w with the first flush.
+		_ = cw.Write(l.columns)
+		write = func(row map[string]any) error {
+			rec := make([]string, len(l.columns))
+			for i, c := range l.columns {
+				rec[i] = csvCell(row[c])
+			}
+			return cw.Write(rec)
+		}
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	case "jsonl":
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		enc := json.NewEncoder(w)
+		write = func(row map[string]any) error { return enc.Encode(row) }
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, l.file, format))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		for _, row := range page.rows {
+			if err := write(row); err != nil {
+				// The client went away; nothing left to tell it.
+				return
+			}
+		}
+		if err := flush(); err != nil || page.next == "" {
+			return
+		}
+		if page, err = fetch(page.next); err != nil {
+			if r.Context().Err() == nil {
+				h.log.ErrorContext(r.Context(), "access http: export", "path", r.URL.Path, "err", err)
+			}
+			return
+		}
+	}
+}
+
+// csvCell renders a row value as listPage views hold them.
+func csvCell(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case []string:
+		return strings.Join(v, ";")
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// parsePageSize reads the page_size query parameter; "" leaves the
+// service default.
+func parsePageSize(v string) (int32, error) {
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0, &validation.Error{Field: "page_size", Reason: "must be an integer"}
+	}
+	return int32(n), nil
+}
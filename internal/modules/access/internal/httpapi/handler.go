@@ -0,0 +1,842 @@
+// Package httpapi is the HTTP adapter for the parts of the access
+// context that sso.access.v1 has no contract for: role grants to
+// groups, the provenance of a user's effective roles, time-bound user
+// grants, resource-scoped grants, permission checks with a target
+// resource and condition context, and the reverse queries. These are
+// hand-written net/http handlers mounted next to the grpc-gateway.
+// Error bodies use the same google.rpc.Status JSON shape as the
+// gateway, so clients need a single error decoder.
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/access/internal/domain"
+	accsvc "sso/internal/modules/access/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+type Handler struct {
+	svc *accsvc.Service
+	api *apiutil.Adapter
+	log *slog.Logger
+}
+
+func NewHandler(svc *accsvc.Service, authn Authenticator, authz Authorizer, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("access", authn, authz, log, toStatus), log: log}
+}
+
+// Register mounts the access endpoints. All of them are admin.
+//
+//	GET    /v1/groups/{group_id}/roles?app_id=
+//	POST   /v1/groups/{group_id}/roles               {"role_id": "..."}
+//	DELETE /v1/groups/{group_id}/roles/{role_id}
+//	GET    /v1/users/{user_id}/effective-roles?app_id=&page_size=&page_token=
+//	POST   /v1/users/{user_id}/role-grants           {"role_id", "not_before", "expires_at"}
+//	POST   /v1/users/{user_id}/role-grants:bulk      {"app_id", "role_ids", "not_before", "expires_at"}
+//	PATCH  /v1/users/{user_id}/role-grants/{role_id} {"expires_at"}
+//	GET    /v1/users/{user_id}/scoped-grants?app_id=
+//	POST   /v1/users/{user_id}/scoped-grants          {"role_id", "resource_type", "resource_id"}
+//	DELETE /v1/users/{user_id}/scoped-grants?role_id=&resource_type=&resource_id=
+//	GET    /v1/users/{user_id}/resources?app_id=&permission=&resource_type=
+//	POST   /v1/users/{user_id}/permissions:check      {"app_id", "permission", "target", "resource", "request"}
+//	POST   /v1/users/{user_id}/permissions:batchCheck {"app_id", "permissions", "target", "resource", "request"}
+//	POST   /v1/users/{user_id}/permissions:explain    {"app_id", "permission", "target", "resource", "request"}
+//	GET    /v1/apps/{app_id}/principals?permission=&page_size=&page_token=&format=
+//	GET    /v1/roles/{role_id}/members?page_size=&page_token=&format=
+//	GET    /v1/apps/{app_id}/sod-rules
+//	POST   /v1/apps/{app_id}/sod-rules                {"name", "description", "role_ids"}
+//	GET    /v1/sod-rules/{rule_id}
+//	DELETE /v1/sod-rules/{rule_id}
+//	GET    /v1/apps/{app_id}/sod-violations?page_size=&page_token=&format=
+//
+// effective-roles is ListUserRoles with each role's source (direct
+// and/or the groups it comes through); the gateway's
+// /v1/users/{id}/roles keeps the proto shape. role-grants are
+// GrantRoleToUser / BulkGrantRoles with the optional time window the
+// proto cannot carry, plus ExtendRoleAssignment. Times are RFC 3339.
+// scoped-grants grant a role on one resource scope; resource ids are
+// '/'-separated paths, which is why the DELETE takes the scope as query
+// parameters. resources lists the scopes the user may exercise a
+// permission on. The permission checks are CheckPermission /
+// BatchCheckPermission with the target resource ({"type", "id"}) that
+// scoped grants are matched against and the resource and request
+// attributes conditional permissions are evaluated against, none of
+// which the proto requests can carry. permissions:explain takes the
+// check's body and returns its decision trace: every role held in the
+// app and included by those, each permission on them with how it
+// matched and what became of it, and the user or app statuses that
+// fail before any check.
+// principals and members are the reverse queries, ListPrincipalsWithPermission
+// and ListRoleMembers: who holds a permission in the app, and who holds
+// a role. Both page by principal id; format=csv or format=jsonl exports
+// every page from page_token on as one download instead.
+// sod-rules are the app's separation-of-duties rules: sets of roles no
+// principal may hold two of. role-grants that would break one fail
+// unless sent with "X-Sod-Override: true" by a caller holding the
+// override permission, as GrantRoleToUser / BulkGrantRoles on the
+// gateway do. sod-violations lists the principals breaking a rule
+// anyway, one row per rule broken, paged and exported like principals.
+// As on the gateway, {user_id} may name a service account.
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/groups/{group_id}/roles", h.api.Authed(h.listGroupRoles))
+	mux.HandleFunc("POST /v1/groups/{group_id}/roles", h.api.Authed(h.grantToGroup))
+	mux.HandleFunc("DELETE /v1/groups/{group_id}/roles/{role_id}", h.api.Authed(h.removeFromGroup))
+	mux.HandleFunc("GET /v1/users/{user_id}/effective-roles", h.api.Authed(h.listUserRoles))
+	mux.HandleFunc("POST /v1/users/{user_id}/role-grants", h.api.Authed(h.grantToUser))
+	mux.HandleFunc("POST /v1/users/{user_id}/role-grants:bulk", h.api.Authed(h.bulkGrantToUser))
+	mux.HandleFunc("PATCH /v1/users/{user_id}/role-grants/{role_id}", h.api.Authed(h.extendUserGrant))
+	mux.HandleFunc("GET /v1/users/{user_id}/scoped-grants", h.api.Authed(h.listScopedGrants))
+	mux.HandleFunc("POST /v1/users/{user_id}/scoped-grants", h.api.Authed(h.grantScoped))
+	mux.HandleFunc("DELETE /v1/users/{user_id}/scoped-grants", h.api.Authed(h.removeScoped))
+	mux.HandleFunc("GET /v1/users/{user_id}/resources", h.api.Authed(h.listPermittedResources))
+	mux.HandleFunc("POST /v1/users/{user_id}/permissions:check", h.api.Authed(h.checkPermission))
+	mux.HandleFunc("POST /v1/users/{user_id}/permissions:batchCheck", h.api.Authed(h.batchCheckPermission))
+	mux.HandleFunc("POST /v1/users/{user_id}/permissions:explain", h.api.Authed(h.explainPermission))
+	mux.HandleFunc("GET /v1/apps/{app_id}/principals", h.api.Authed(h.listPermissionHolders))
+	mux.HandleFunc("GET /v1/roles/{role_id}/members", h.api.Authed(h.listRoleMembers))
+	mux.HandleFunc("GET /v1/apps/{app_id}/sod-rules", h.api.Authed(h.listSoDRules))
+	mux.HandleFunc("POST /v1/apps/{app_id}/sod-rules", h.api.Authed(h.createSoDRule))
+	mux.HandleFunc("GET /v1/sod-rules/{rule_id}", h.api.Authed(h.getSoDRule))
+	mux.HandleFunc("DELETE /v1/sod-rules/{rule_id}", h.api.Authed(h.deleteSoDRule))
+	mux.HandleFunc("GET /v1/apps/{app_id}/sod-violations", h.api.Authed(h.listSoDViolations))
+}
+
+// ----------------------------------------------------------------------------
+// Group grants
+// ----------------------------------------------------------------------------
+
+type grantBody struct {
+	RoleID string `json:"role_id"`
+}
+
+func (h *Handler) grantToGroup(w http.ResponseWriter, r *http.Request) {
+	var b grantBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.GrantRoleToGroup(r.Context(), accsvc.GrantRoleToGroupInput{
+		GroupID: r.PathValue("group_id"),
+		RoleID:  b.RoleID,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	code := http.StatusOK
+	if out.Created {
+		code = http.StatusCreated
+	}
+	apiutil.WriteJSON(w, code, groupAssignmentView(out.Assignment))
+}
+
+func (h *Handler) removeFromGroup(w http.ResponseWriter, r *http.Request) {
+	err := h.svc.RemoveRoleFromGroup(r.Context(), accsvc.RemoveRoleFromGroupInput{
+		GroupID: r.PathValue("group_id"),
+		RoleID:  r.PathValue("role_id"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) listGroupRoles(w http.ResponseWriter, r *http.Request) {
+	rows, err := h.svc.ListGroupRoles(r.Context(), accsvc.ListGroupRolesInput{
+		GroupID: r.PathValue("group_id"),
+		AppID:   r.URL.Query().Get("app_id"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(rows))
+	for _, a := range rows {
+		views = append(views, groupAssignmentView(a))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"assignments": views})
+}
+
+// ----------------------------------------------------------------------------
+// Time-bound user grants
+// ----------------------------------------------------------------------------
+
+type userGrantBody struct {
+	RoleID    string     `json:"role_id"`
+	NotBefore *time.Time `json:"not_before"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (h *Handler) grantToUser(w http.ResponseWriter, r *http.Request) {
+	var b userGrantBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.GrantRoleToUser(r.Context(), accsvc.GrantRoleToUserInput{
+		UserID:      r.PathValue("user_id"),
+		RoleID:      b.RoleID,
+		ActorID:     actorID(r.Context()),
+		NotBefore:   b.NotBefore,
+		ExpiresAt:   b.ExpiresAt,
+		OverrideSoD: sodOverride(r),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	code := http.StatusOK
+	if out.Created {
+		code = http.StatusCreated
+	}
+	apiutil.WriteJSON(w, code, userAssignmentView(out.Assignment))
+}
+
+type bulkUserGrantBody struct {
+	AppID     string     `json:"app_id"`
+	RoleIDs   []string   `json:"role_ids"`
+	NotBefore *time.Time `json:"not_before"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (h *Handler) bulkGrantToUser(w http.ResponseWriter, r *http.Request) {
+	var b bulkUserGrantBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.BulkGrantRoles(r.Context(), accsvc.BulkGrantRolesInput{
+		UserID:      r.PathValue("user_id"),
+		AppID:       b.AppID,
+		RoleIDs:     b.RoleIDs,
+		ActorID:     actorID(r.Context()),
+		NotBefore:   b.NotBefore,
+		ExpiresAt:   b.ExpiresAt,
+		OverrideSoD: sodOverride(r),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(out.Assignments))
+	for i, a := range out.Assignments {
+		v := userAssignmentView(a)
+		v["created"] = out.Created[i]
+		views = append(views, v)
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"assignments": views})
+}
+
+// extendBody keeps expires_at raw so that an absent field can be told
+// apart from an explicit null, which makes the assignment permanent.
+type extendBody struct {
+	ExpiresAt json.RawMessage `json:"expires_at"`
+}
+
+func (h *Handler) extendUserGrant(w http.ResponseWriter, r *http.Request) {
+	var b extendBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	if len(b.ExpiresAt) == 0 {
+		h.api.WriteError(w, r, &validation.Error{Field: "expires_at", Reason: "is required; null makes the assignment permanent"})
+		return
+	}
+	var expiresAt *time.Time
+	if err := json.Unmarshal(b.ExpiresAt, &expiresAt); err != nil {
+		h.api.WriteError(w, r, &validation.Error{Field: "expires_at", Reason: "must be an RFC 3339 timestamp or null"})
+		return
+	}
+	out, err := h.svc.ExtendRoleAssignment(r.Context(), accsvc.ExtendRoleAssignmentInput{
+		UserID:    r.PathValue("user_id"),
+		RoleID:    r.PathValue("role_id"),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, userAssignmentView(out))
+}
+
+// ----------------------------------------------------------------------------
+// Effective roles
+// ----------------------------------------------------------------------------
+
+func (h *Handler) listUserRoles(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	in := accsvc.ListUserRolesInput{
+		UserID:    r.PathValue("user_id"),
+		AppID:     q.Get("app_id"),
+		PageToken: q.Get("page_token"),
+	}
+	pageSize, err := parsePageSize(q.Get("page_size"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	in.PageSize = pageSize
+	out, err := h.svc.ListUserRoles(r.Context(), in)
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(out.Roles))
+	for i, role := range out.Roles {
+		groups := make([]string, 0, len(out.Sources[i].ViaGroups))
+		for _, g := range out.Sources[i].ViaGroups {
+			groups = append(groups, g.String())
+		}
+		views = append(views, map[string]any{
+			"role_id":    role.ID().String(),
+			"name":       role.Name,
+			"status":     role.Status().String(),
+			"direct":     out.Sources[i].Direct,
+			"via_groups": groups,
+		})
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"roles":           views,
+		"next_page_token": out.NextPageToken,
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Resource-scoped grants
+// ----------------------------------------------------------------------------
+
+type scopedGrantBody struct {
+	RoleID       string `json:"role_id"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+}
+
+func (h *Handler) grantScoped(w http.ResponseWriter, r *http.Request) {
+	var b scopedGrantBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.GrantScopedRole(r.Context(), accsvc.GrantScopedRoleInput{
+		UserID:       r.PathValue("user_id"),
+		RoleID:       b.RoleID,
+		ResourceType: b.ResourceType,
+		ResourceID:   b.ResourceID,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	code := http.StatusOK
+	if out.Created {
+		code = http.StatusCreated
+	}
+	apiutil.WriteJSON(w, code, scopedAssignmentView(out.Assignment))
+}
+
+func (h *Handler) removeScoped(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	err := h.svc.RemoveScopedRole(r.Context(), accsvc.RemoveScopedRoleInput{
+		UserID:       r.PathValue("user_id"),
+		RoleID:       q.Get("role_id"),
+		ResourceType: q.Get("resource_type"),
+		ResourceID:   q.Get("resource_id"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) listScopedGrants(w http.ResponseWriter, r *http.Request) {
+	rows, err := h.svc.ListScopedRoles(r.Context(), accsvc.ListScopedRolesInput{
+		UserID: r.PathValue("user_id"),
+		AppID:  r.URL.Query().Get("app_id"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(rows))
+	for _, a := range rows {
+		views = append(views, scopedAssignmentView(a))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"assignments": views})
+}
+
+func (h *Handler) listPermittedResources(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	out, err := h.svc.ListPermittedResources(r.Context(), accsvc.ListPermittedResourcesInput{
+		UserID:       r.PathValue("user_id"),
+		AppID:        q.Get("app_id"),
+		Permission:   q.Get("permission"),
+		ResourceType: q.Get("resource_type"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(out.Resources))
+	for _, res := range out.Resources {
+		views = append(views, map[string]any{
+			"resource_type": res.Scope.Type,
+			"resource_id":   res.Scope.ID,
+			"conditional":   res.Conditional,
+		})
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"app_wide":             out.AppWide,
+		"app_wide_conditional": out.AppWideConditional,
+		"resources":            views,
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Reverse queries
+// ----------------------------------------------------------------------------
+
+var (
+	holderColumns = []string{"principal_id", "principal_type", "app_wide", "conditional", "scoped"}
+	memberColumns = []string{"principal_id", "principal_type", "direct", "via_groups"}
+)
+
+func (h *Handler) listPermissionHolders(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	pageSize, err := parsePageSize(q.Get("page_size"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	h.list(w, r, listing{field: "principals", file: "principals", columns: holderColumns},
+		func(token string) (listPage, error) {
+			out, err := h.svc.ListPrincipalsWithPermission(r.Context(), accsvc.ListPrincipalsWithPermissionInput{
+				AppID:      r.PathValue("app_id"),
+				Permission: q.Get("permission"),
+				PageSize:   pageSize,
+				PageToken:  token,
+			})
+			if err != nil {
+				return listPage{}, err
+			}
+			views := make([]map[string]any, 0, len(out.Holders))
+			for _, hd := range out.Holders {
+				views = append(views, map[string]any{
+					"principal_id":   hd.Principal.ID,
+					"principal_type": hd.Principal.Kind.String(),
+					"app_wide":       hd.AppWide,
+					"conditional":    hd.Conditional,
+					"scoped":         hd.Scoped,
+				})
+			}
+			return listPage{rows: views, next: out.NextPageToken}, nil
+		})
+}
+
+func (h *Handler) listRoleMembers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	pageSize, err := parsePageSize(q.Get("page_size"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	h.list(w, r, listing{field: "members", file: "role-members", columns: memberColumns},
+		func(token string) (listPage, error) {
+			out, err := h.svc.ListRoleMembers(r.Context(), accsvc.ListRoleMembersInput{
+				RoleID:    r.PathValue("role_id"),
+				PageSize:  pageSize,
+				PageToken: token,
+			})
+			if err != nil {
+				return listPage{}, err
+			}
+			views := make([]map[string]any, 0, len(out.Members))
+			for _, m := range out.Members {
+				groups := make([]string, 0, len(m.ViaGroups))
+				for _, g := range m.ViaGroups {
+					groups = append(groups, g.String())
+				}
+				views = append(views, map[string]any{
+					"principal_id":   m.Principal.ID,
+					"principal_type": m.Principal.Kind.String(),
+					"direct":         m.Direct,
+					"via_groups":     groups,
+				})
+			}
+			return listPage{
+				meta: map[string]any{
+					"role_id":     out.Role.ID().String(),
+					"role_name":   out.Role.Name,
+					"role_status": out.Role.Status().String(),
+				},
+				rows: views,
+				next: out.NextPageToken,
+			}, nil
+		})
+}
+
+// ----------------------------------------------------------------------------
+// Separation of duties
+// ----------------------------------------------------------------------------
+
+type sodRuleBody struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	RoleIDs     []string `json:"role_ids"`
+}
+
+func (h *Handler) createSoDRule(w http.ResponseWriter, r *http.Request) {
+	var b sodRuleBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	rule, err := h.svc.CreateSoDRule(r.Context(), accsvc.CreateSoDRuleInput{
+		AppID:       r.PathValue("app_id"),
+		Name:        b.Name,
+		Description: b.Description,
+		RoleIDs:     b.RoleIDs,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusCreated, sodRuleView(rule))
+}
+
+func (h *Handler) listSoDRules(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.svc.ListSoDRules(r.Context(), accsvc.ListSoDRulesInput{AppID: r.PathValue("app_id")})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(rules))
+	for _, rule := range rules {
+		views = append(views, sodRuleView(rule))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"rules": views})
+}
+
+func (h *Handler) getSoDRule(w http.ResponseWriter, r *http.Request) {
+	rule, err := h.svc.GetSoDRule(r.Context(), accsvc.GetSoDRuleInput{RuleID: r.PathValue("rule_id")})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, sodRuleView(rule))
+}
+
+func (h *Handler) deleteSoDRule(w http.ResponseWriter, r *http.Request) {
+	if err := h.svc.DeleteSoDRule(r.Context(), accsvc.DeleteSoDRuleInput{RuleID: r.PathValue("rule_id")}); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+var violationColumns = []string{"rule_id", "rule_name", "principal_id", "principal_type", "role_ids"}
+
+func (h *Handler) listSoDViolations(w http.ResponseWriter, r *http.Request) {
+	pageSize, err := parsePageSize(r.URL.Query().Get("page_size"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	h.list(w, r, listing{field: "violations", file: "sod-violations", columns: violationColumns},
+		func(token string) (listPage, error) {
+			out, err := h.svc.ListSoDViolations(r.Context(), accsvc.ListSoDViolationsInput{
+				AppID:     r.PathValue("app_id"),
+				PageSize:  pageSize,
+				PageToken: token,
+			})
+			if err != nil {
+				return listPage{}, err
+			}
+			views := make([]map[string]any, 0, len(out.Violations))
+			for _, v := range out.Violations {
+				var name string
+				if rule, ok := out.Rules[v.RuleID]; ok {
+					name = rule.Name
+				}
+				views = append(views, map[string]any{
+					"rule_id":        v.RuleID.String(),
+					"rule_name":      name,
+					"principal_id":   v.Principal.ID,
+					"principal_type": v.Principal.Kind.String(),
+					"role_ids":       roleIDStrings(v.RoleIDs),
+				})
+			}
+			return listPage{rows: views, next: out.NextPageToken}, nil
+		})
+}
+
+// ----------------------------------------------------------------------------
+// Permission checks with context
+// ----------------------------------------------------------------------------
+
+type targetBody struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// checkContextBody keeps the attribute maps raw: they are decoded with
+// UseNumber so that integers reach the conditions as int64 rather than
+// float64.
+type checkContextBody struct {
+	Target   *targetBody     `json:"target"`
+	Resource json.RawMessage `json:"resource"`
+	Request  json.RawMessage `json:"request"`
+}
+
+func (b checkContextBody) target() accsvc.ResourceTarget {
+	if b.Target == nil {
+		return accsvc.ResourceTarget{}
+	}
+	return accsvc.ResourceTarget{Type: b.Target.Type, ID: b.Target.ID}
+}
+
+func (b checkContextBody) parse() (accsvc.CheckContext, error) {
+	return accsvc.DecodeCheckContext(b.Resource, b.Request)
+}
+
+type checkBody struct {
+	AppID      string `json:"app_id"`
+	Permission string `json:"permission"`
+	checkContextBody
+}
+
+func (h *Handler) checkPermission(w http.ResponseWriter, r *http.Request) {
+	var b checkBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	cc, err := b.parse()
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.CheckPermission(r.Context(), accsvc.CheckPermissionInput{
+		UserID:     r.PathValue("user_id"),
+		AppID:      b.AppID,
+		Permission: b.Permission,
+		Target:     b.target(),
+		Context:    cc,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"allowed":          out.Allowed,
+		"matched_role_ids": out.MatchedRoleIDs,
+	})
+}
+
+type batchCheckBody struct {
+	AppID       string   `json:"app_id"`
+	Permissions []string `json:"permissions"`
+	checkContextBody
+}
+
+func (h *Handler) batchCheckPermission(w http.ResponseWriter, r *http.Request) {
+	var b batchCheckBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	cc, err := b.parse()
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.BatchCheckPermission(r.Context(), accsvc.BatchCheckPermissionInput{
+		UserID:      r.PathValue("user_id"),
+		AppID:       b.AppID,
+		Permissions: b.Permissions,
+		Target:      b.target(),
+		Context:     cc,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"allowed": out.Allowed})
+}
+
+func (h *Handler) explainPermission(w http.ResponseWriter, r *http.Request) {
+	var b checkBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	cc, err := b.parse()
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.ExplainPermission(r.Context(), accsvc.ExplainPermissionInput{
+		UserID:     r.PathValue("user_id"),
+		AppID:      b.AppID,
+		Permission: b.Permission,
+		Target:     b.target(),
+		Context:    cc,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	preconditions := make([]map[string]any, 0, len(out.Preconditions))
+	for _, f := range out.Preconditions {
+		preconditions = append(preconditions, map[string]any{"subject": f.Subject, "status": f.Status})
+	}
+	roles := make([]map[string]any, 0, len(out.Roles))
+	for _, er := range out.Roles {
+		roles = append(roles, explainedRoleView(er))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"allowed":       out.Allowed,
+		"preconditions": preconditions,
+		"roles":         roles,
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Views
+// ----------------------------------------------------------------------------
+
+func groupAssignmentView(a *domain.GroupRoleAssignment) map[string]any {
+	return map[string]any{
+		"group_id":           a.GroupID.String(),
+		"role_id":            a.RoleID.String(),
+		"app_id":             a.AppID.String(),
+		"granted_by_user_id": a.GrantedByUserID.String(),
+		"granted_at":         a.GrantedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func scopedAssignmentView(a *domain.ScopedRoleAssignment) map[string]any {
+	return map[string]any{
+		"user_id":            a.Principal.ID,
+		"role_id":            a.RoleID.String(),
+		"app_id":             a.AppID.String(),
+		"resource_type":      a.Scope.Type,
+		"resource_id":        a.Scope.ID,
+		"granted_by_user_id": a.GrantedByUserID.String(),
+		"granted_at":         a.GrantedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func userAssignmentView(a *domain.RoleAssignment) map[string]any {
+	return map[string]any{
+		"user_id":            a.Principal.ID,
+		"principal_type":     a.Principal.Kind.String(),
+		"role_id":            a.RoleID.String(),
+		"app_id":             a.AppID.String(),
+		"granted_by_user_id": a.GrantedByUserID.String(),
+		"granted_at":         a.GrantedAt.UTC().Format(time.RFC3339),
+		"not_before":         optionalTime(a.NotBefore),
+		"expires_at":         optionalTime(a.ExpiresAt),
+	}
+}
+
+func explainedRoleView(er accsvc.ExplainedRole) map[string]any {
+	path := make([]string, 0, len(er.Path))
+	for _, id := range er.Path {
+		path = append(path, id.String())
+	}
+	groups := make([]string, 0, len(er.ViaGroups))
+	for _, g := range er.ViaGroups {
+		groups = append(groups, g.String())
+	}
+	perms := make([]map[string]any, 0, len(er.Permissions))
+	for _, p := range er.Permissions {
+		perms = append(perms, map[string]any{
+			"permission": p.Permission,
+			"match":      string(p.Match),
+			"condition":  p.Condition,
+			"verdict":    string(p.Verdict),
+		})
+	}
+	v := map[string]any{
+		"role_id":     er.RoleID.String(),
+		"name":        er.Name,
+		"status":      er.Status,
+		"path":        path,
+		"direct":      er.Direct,
+		"via_groups":  groups,
+		"permissions": perms,
+	}
+	if er.Scope != nil {
+		v["resource_type"] = er.Scope.Type
+		v["resource_id"] = er.Scope.ID
+	}
+	return v
+}
+
+func sodRuleView(rule *domain.SoDRule) map[string]any {
+	return map[string]any{
+		"rule_id":     rule.ID.String(),
+		"app_id":      rule.AppID.String(),
+		"name":        rule.Name,
+		"description": rule.Description,
+		"role_ids":    roleIDStrings(rule.RoleIDs),
+		"created_by":  rule.CreatedBy.String(),
+		"created_at":  rule.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func roleIDStrings(ids []domain.RoleID) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id.String())
+	}
+	return out
+}
+
+// optionalTime renders an open window bound as JSON null.
+func optionalTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// ----------------------------------------------------------------------------
+// Plumbing
+// ----------------------------------------------------------------------------
+
+// sodOverrideHeader is the gateway's x-sod-override metadata (see
+// grpcauth.SoDOverrideFromCtx), taken straight off the request here.
+const sodOverrideHeader = "X-Sod-Override"
+
+func sodOverride(r *http.Request) bool {
+	return strings.EqualFold(strings.TrimSpace(r.Header.Get(sodOverrideHeader)), "true")
+}
+
+// actorID is the caller's id for granted_by_user_id, as the gRPC
+// adapter records it.
+func actorID(ctx context.Context) string {
+	if a, ok := actor.From(ctx); ok {
+		return a.ID
+	}
+	return ""
+}
@@ -21,6 +21,15 @@ type App struct {
 	UpdatedAt time.Time
 }
 
+type AppPermission struct {
+	AppID       string
+	Name        string
+	Description string
+	Deprecated  bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
 type AuditEvent struct {
 	ID          string
 	OccurredAt  time.Time
@@ -37,6 +46,14 @@ type AuditEvent struct {
 	Metadata    json.RawMessage
 }
 
+type GroupRoleAssignment struct {
+	GroupID         string
+	RoleID          string
+	AppID           string
+	GrantedByUserID string
+	GrantedAt       time.Time
+}
+
 type RecoveryCode struct {
 	BatchID  string
 	CodeHash []byte
@@ -67,11 +84,29 @@ type RoleAssignment struct {
 	AppID           string
 	GrantedByUserID string
 	GrantedAt       time.Time
+	NotBefore       sql.NullTime
+	ExpiresAt       sql.NullTime
+}
+
+type RoleInclude struct {
+	RoleID         string
+	IncludedRoleID string
 }
 
 type RolePermission struct {
-	RoleID     string
-	Permission string
+	RoleID        string
+	Permission    string
+	ConditionExpr string
+}
+
+type ScopedRoleAssignment struct {
+	UserID          string
+	RoleID          string
+	AppID           string
+	ResourceType    string
+	ResourceID      string
+	GrantedByUserID string
+	GrantedAt       time.Time
 }
 
 type ServiceAccount struct {
@@ -86,6 +121,26 @@ type ServiceAccount struct {
 	LastAuthenticatedAt sql.NullTime
 }
 
+type ServiceAccountRoleAssignment struct {
+	ServiceAccountID string
+	RoleID           string
+	AppID            string
+	GrantedByUserID  string
+	GrantedAt        time.Time
+	NotBefore        sql.NullTime
+	ExpiresAt        sql.NullTime
+}
+
+type ServiceAccountScopedRoleAssignment struct {
+	ServiceAccountID string
+	RoleID           string
+	AppID            string
+	ResourceType     string
+	ResourceID       string
+	GrantedByUserID  string
+	GrantedAt        time.Time
+}
+
 type Session struct {
 	ID                    string
 	UserID                string
@@ -99,6 +154,20 @@ type Session struct {
 	RevokedAt             sql.NullTime
 }
 
+type SodRule struct {
+	ID          string
+	AppID       string
+	Name        string
+	Description string
+	CreatedBy   string
+	CreatedAt   time.Time
+}
+
+type SodRuleRole struct {
+	RuleID string
+	RoleID string
+}
+
 type User struct {
 	ID                  string
 	Email               string
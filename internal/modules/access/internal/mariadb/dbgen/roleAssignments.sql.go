@@ -29,8 +29,8 @@ func (q *Queries) CountRoleAssignmentsByUserApp(ctx context.Context, arg CountRo
 
 const createRoleAssignment = `-- name: CreateRoleAssignment :exec
 INSERT INTO role_assignments
-    (user_id, role_id, app_id, granted_by_user_id, granted_at)
-VALUES (?, ?, ?, ?, ?)
+    (user_id, role_id, app_id, granted_by_user_id, granted_at, not_before, expires_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateRoleAssignmentParams struct {
@@ -39,6 +39,8 @@ type CreateRoleAssignmentParams struct {
 	AppID           string
 	GrantedByUserID string
 	GrantedAt       time.Time
+	NotBefore       sql.NullTime
+	ExpiresAt       sql.NullTime
 }
 
 // Idempotent insert: callers wrap with INSERT IGNORE-style discrimination
@@ -51,10 +53,28 @@ func (q *Queries) CreateRoleAssignment(ctx context.Context, arg CreateRoleAssign
 		arg.AppID,
 		arg.GrantedByUserID,
 		arg.GrantedAt,
+		arg.NotBefore,
+		arg.ExpiresAt,
 	)
 	return err
 }
 
+const deleteExpiredRoleAssignment = `-- name: DeleteExpiredRoleAssignment :execresult
+DELETE FROM role_assignments WHERE user_id = ? AND role_id = ? AND expires_at <= ?
+`
+
+type DeleteExpiredRoleAssignmentParams struct {
+	UserID    string
+	RoleID    string
+	ExpiresAt sql.NullTime
+}
+
+// Deletes the assignment only if it is still expired, so an
+// ExtendRoleAssignment that landed after the sweeper's read wins.
+func (q *Queries) DeleteExpiredRoleAssignment(ctx context.Context, arg DeleteExpiredRoleAssignmentParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, deleteExpiredRoleAssignment, arg.UserID, arg.RoleID, arg.ExpiresAt)
+}
+
 const deleteRoleAssignment = `-- name: DeleteRoleAssignment :execresult
 DELETE FROM role_assignments WHERE user_id = ? AND role_id = ?
 `
@@ -69,7 +89,7 @@ func (q *Queries) DeleteRoleAssignment(ctx context.Context, arg DeleteRoleAssign
 }
 
 const getRoleAssignment = `-- name: GetRoleAssignment :one
-SELECT user_id, role_id, app_id, granted_by_user_id, granted_at
+SELECT user_id, role_id, app_id, granted_by_user_id, granted_at, not_before, expires_at
 FROM role_assignments
 WHERE user_id = ? AND role_id = ?
 `
@@ -88,39 +108,96 @@ func (q *Queries) GetRoleAssignment(ctx context.Context, arg GetRoleAssignmentPa
 		&i.AppID,
 		&i.GrantedByUserID,
 		&i.GrantedAt,
+		&i.NotBefore,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
 
 const listActivePermissionsByUserApp = `-- name: ListActivePermissionsByUserApp :many
-SELECT r.id AS role_id, rp.permission
-FROM role_assignments ra
-JOIN roles r           ON r.id = ra.role_id
-JOIN role_permissions rp ON rp.role_id = r.id
-WHERE ra.user_id = ?
-  AND ra.app_id  = ?
-  AND r.status   = ?
+WITH RECURSIVE held (role_id) AS (
+    SELECT ra.role_id
+    FROM role_assignments ra
+    WHERE ra.user_id = ?
+      AND ra.app_id  = ?
+      AND (ra.not_before IS NULL OR ra.not_before <= ?)
+      AND (ra.expires_at IS NULL OR ra.expires_at >  ?)
+    UNION
+    SELECT ga.role_id
+    FROM user_group_members gm
+    JOIN group_role_assignments ga ON ga.group_id = gm.group_id
+    WHERE gm.user_id = ?
+      AND ga.app_id  = ?
+),
+reach (role_id, path, depth) AS (
+    SELECT r.id, CAST(r.id AS CHAR(1024)), 0
+    FROM held h
+    JOIN roles r ON r.id = h.role_id
+    WHERE r.status = ?
+    UNION ALL
+    SELECT ri.included_role_id, CONCAT(reach.path, ',', ri.included_role_id), reach.depth + 1
+    FROM reach
+    JOIN role_includes ri ON ri.role_id = reach.role_id
+    JOIN roles r          ON r.id = ri.included_role_id
+    WHERE r.status = ?
+      AND reach.depth < 16
+      AND FIND_IN_SET(ri.included_role_id, reach.path) = 0
+)
+SELECT reach.role_id, reach.path, rp.permission, rp.condition_expr
+FROM reach
+JOIN role_permissions rp ON rp.role_id = reach.role_id
 `
 
 type ListActivePermissionsByUserAppParams struct {
-	UserID string
-	AppID  string
-	Status uint8
+	UserID   string
+	AppID    string
+	Now      sql.NullTime
+	UserID_2 string
+	AppID_2  string
+	Status   uint8
+	Status_2 uint8
 }
 
 type ListActivePermissionsByUserAppRow struct {
-	RoleID     string
-	Permission string
+	RoleID        string
+	Path          string
+	Permission    string
+	ConditionExpr string
 }
 
-// Returns all permission strings carried by ACTIVE roles assigned to the
-// user in the target app. Drives CheckPermission and BatchCheckPermission;
-// DISABLED roles are filtered server-side at the JOIN. Each (role_id,
-// permission) row is returned once — duplicates across roles ARE possible
-// and the caller (use-case layer) does the wildcard expansion + dedup
-// against the requested permission set.
+// Returns all permission strings reachable from the ACTIVE roles the
+// user holds in the target app, directly or through a group the user
+// belongs to, following role_includes transitively. Drives
+// CheckPermission and BatchCheckPermission.
+//
+// The held CTE collects the roles granted to the user; UNION folds a
+// role granted both directly and via a group into one root. A direct
+// assignment counts only inside its [not_before, expires_at) window;
+// rows past expiry linger until the sweeper deletes them. The reach
+// CTE walks the include DAG from each root. path is the comma-separated
+// chain of role ids from the root down to role_id, which CheckPermission
+// reports in matched_role_ids. A DISABLED role contributes nothing and
+// stops the walk below it. FIND_IN_SET guards against a cycle that
+// slipped past the role service, and depth caps the walk at 16 levels
+// (also keeping path within its CHAR(1024)).
+//
+// A role reachable along several paths yields one row per path; the
+// caller does the wildcard expansion + dedup against the requested
+// permission set.
+//
+// condition_expr is the permission's CEL condition, empty when it has
+// none; the caller evaluates it against the check's context.
 func (q *Queries) ListActivePermissionsByUserApp(ctx context.Context, arg ListActivePermissionsByUserAppParams) ([]ListActivePermissionsByUserAppRow, error) {
-	rows, err := q.db.QueryContext(ctx, listActivePermissionsByUserApp, arg.UserID, arg.AppID, arg.Status)
+	rows, err := q.db.QueryContext(ctx, listActivePermissionsByUserApp,
+		arg.UserID,
+		arg.AppID,
+		arg.Now,
+		arg.Now,
+		arg.UserID_2,
+		arg.AppID_2,
+		arg.Status,
+		arg.Status_2,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +205,58 @@ func (q *Queries) ListActivePermissionsByUserApp(ctx context.Context, arg ListAc
 	items := []ListActivePermissionsByUserAppRow{}
 	for rows.Next() {
 		var i ListActivePermissionsByUserAppRow
-		if err := rows.Scan(&i.RoleID, &i.Permission); err != nil {
+		if err := rows.Scan(
+			&i.RoleID,
+			&i.Path,
+			&i.Permission,
+			&i.ConditionExpr,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredRoleAssignments = `-- name: ListExpiredRoleAssignments :many
+SELECT user_id, role_id, app_id, granted_by_user_id, granted_at, not_before, expires_at
+FROM role_assignments
+WHERE expires_at <= ?
+ORDER BY expires_at
+LIMIT ?
+`
+
+type ListExpiredRoleAssignmentsParams struct {
+	ExpiresAt sql.NullTime
+	Limit     int32
+}
+
+// One sweeper batch: assignments whose window has closed, oldest expiry
+// first (idx_role_assignments_expires_at).
+func (q *Queries) ListExpiredRoleAssignments(ctx context.Context, arg ListExpiredRoleAssignmentsParams) ([]RoleAssignment, error) {
+	rows, err := q.db.QueryContext(ctx, listExpiredRoleAssignments, arg.ExpiresAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RoleAssignment{}
+	for rows.Next() {
+		var i RoleAssignment
+		if err := rows.Scan(
+			&i.UserID,
+			&i.RoleID,
+			&i.AppID,
+			&i.GrantedByUserID,
+			&i.GrantedAt,
+			&i.NotBefore,
+			&i.ExpiresAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -141,3 +269,61 @@ func (q *Queries) ListActivePermissionsByUserApp(ctx context.Context, arg ListAc
 	}
 	return items, nil
 }
+
+const nextWindowChangeByUserApp = `-- name: NextWindowChangeByUserApp :one
+SELECT MIN(CASE WHEN ra.not_before > ? THEN ra.not_before ELSE ra.expires_at END) AS next_change
+FROM role_assignments ra
+WHERE ra.user_id = ?
+  AND ra.app_id  = ?
+  AND (ra.not_before > ? OR ra.expires_at > ?)
+`
+
+type NextWindowChangeByUserAppParams struct {
+	Now    sql.NullTime
+	UserID string
+	AppID  string
+}
+
+// The earliest not_before or expires_at still ahead of now among the
+// user's direct assignments in the app, NULL when there is none: the
+// next moment ListActivePermissionsByUserApp changes its answer with
+// no write in between. Bounds how long CheckPermission may serve a
+// cached permission snapshot. A window that has not opened yet closes
+// after it opens, so its not_before is the nearer edge.
+func (q *Queries) NextWindowChangeByUserApp(ctx context.Context, arg NextWindowChangeByUserAppParams) (sql.NullTime, error) {
+	row := q.db.QueryRowContext(ctx, nextWindowChangeByUserApp,
+		arg.Now,
+		arg.UserID,
+		arg.AppID,
+		arg.Now,
+		arg.Now,
+	)
+	var next_change sql.NullTime
+	err := row.Scan(&next_change)
+	return next_change, err
+}
+
+const updateRoleAssignmentExpiresAt = `-- name: UpdateRoleAssignmentExpiresAt :execresult
+UPDATE role_assignments SET expires_at = ?
+WHERE user_id = ? AND role_id = ?
+  AND (expires_at IS NULL OR expires_at > ?)
+`
+
+type UpdateRoleAssignmentExpiresAtParams struct {
+	ExpiresAt   sql.NullTime
+	UserID      string
+	RoleID      string
+	ExpiresAt_2 sql.NullTime
+}
+
+// Moves an assignment's expiry. The second expires_at guard keeps an
+// assignment that lapsed between the use-case's read and this write
+// from being revived ahead of the sweeper.
+func (q *Queries) UpdateRoleAssignmentExpiresAt(ctx context.Context, arg UpdateRoleAssignmentExpiresAtParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateRoleAssignmentExpiresAt,
+		arg.ExpiresAt,
+		arg.UserID,
+		arg.RoleID,
+		arg.ExpiresAt_2,
+	)
+}
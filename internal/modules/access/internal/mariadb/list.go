@@ -9,29 +9,53 @@ import (
 
 // ListUserRoles is hand-written: variable ORDER BY (granted_at vs role_id)
 // and an optional keyset cursor make sqlc's static SELECT awkward. The
-// query returns only role_id + granted_at; the use-case loads the full
-// Role aggregates from role.Repository afterwards.
+// query returns only role_id + granted_at + provenance; the use-case
+// loads the full Role aggregates from role.Repository afterwards.
+//
+// Direct and group-inherited grants are folded per role_id in a derived
+// table so the keyset and ORDER BY run over one row per role. A service
+// account has no groups, so its source is the direct grants alone.
 func (r *Repository) ListUserRoles(ctx context.Context, q domain.ListUserRolesQuery) (domain.ListUserRolesResult, error) {
 	if q.PageSize <= 0 {
 		return domain.ListUserRolesResult{}, fmt.Errorf("access repo: list: page_size must be > 0")
 	}
-	if q.UserID == "" || q.AppID == "" {
-		return domain.ListUserRolesResult{}, fmt.Errorf("access repo: list: user_id and app_id are required")
+	if q.Principal.ID == "" || q.AppID == "" {
+		return domain.ListUserRolesResult{}, fmt.Errorf("access repo: list: principal and app_id are required")
 	}
 
-	where := []string{"user_id = ?", "app_id = ?"}
-	args := []any{q.UserID.String(), q.AppID.String()}
+	src := `SELECT role_id, granted_at, 1 AS direct
+        FROM role_assignments
+        WHERE user_id = ? AND app_id = ?
+        UNION ALL
+        SELECT ga.role_id, ga.granted_at, 0
+        FROM group_role_assignments ga
+        JOIN user_group_members gm ON gm.group_id = ga.group_id
+        WHERE gm.user_id = ? AND ga.app_id = ?`
+	args := []any{q.Principal.ID, q.AppID.String(), q.Principal.ID, q.AppID.String()}
+	if q.Principal.IsServiceAccount() {
+		src = `SELECT role_id, granted_at, 1 AS direct
+        FROM service_account_role_assignments
+        WHERE service_account_id = ? AND app_id = ?`
+		args = []any{q.Principal.ID, q.AppID.String()}
+	}
 
+	where := "1 = 1"
 	if q.After != nil {
 		clause, cArgs := keysetClause(q.OrderBy, *q.After)
-		where = append(where, clause)
+		where = clause
 		args = append(args, cArgs...)
 	}
 
 	limit := q.PageSize + 1
 	query := fmt.Sprintf(
-		`SELECT role_id, granted_at FROM role_assignments WHERE %s ORDER BY %s LIMIT %d`,
-		strings.Join(where, " AND "), orderClauseFor(q.OrderBy), limit,
+		`SELECT role_id, granted_at, direct FROM (
+    SELECT role_id, MIN(granted_at) AS granted_at, MAX(direct) AS direct
+    FROM (
+        %s
+    ) src
+    GROUP BY role_id
+) eff WHERE %s ORDER BY %s LIMIT %d`,
+		src, where, orderClauseFor(q.OrderBy), limit,
 	)
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
@@ -46,7 +70,7 @@ func (r *Repository) ListUserRoles(ctx context.Context, q domain.ListUserRolesQu
 			roleID string
 			row    domain.ListUserRolesRow
 		)
-		if err := rows.Scan(&roleID, &row.GrantedAt); err != nil {
+		if err := rows.Scan(&roleID, &row.GrantedAt, &row.Direct); err != nil {
 			return domain.ListUserRolesResult{}, fmt.Errorf("access repo: list: scan: %w", err)
 		}
 		row.RoleID = domain.RoleID(roleID)
@@ -62,9 +86,57 @@ func (r *Repository) ListUserRoles(ctx context.Context, q domain.ListUserRolesQu
 		last := out[len(out)-1]
 		nextCursor = &domain.PageCursor{GrantedAt: last.GrantedAt, RoleID: last.RoleID}
 	}
+	if q.Principal.IsUser() {
+		if err := r.fillViaGroups(ctx, q.Principal.UserID(), q.AppID, out); err != nil {
+			return domain.ListUserRolesResult{}, err
+		}
+	}
 	return domain.ListUserRolesResult{Rows: out, NextCursor: nextCursor}, nil
 }
 
+// fillViaGroups looks up, for the roles on one page, which of the
+// user's groups pass each role on.
+func (r *Repository) fillViaGroups(ctx context.Context, userID domain.UserID, appID domain.AppID, page []domain.ListUserRolesRow) error {
+	if len(page) == 0 {
+		return nil
+	}
+	idx := make(map[domain.RoleID]int, len(page))
+	placeholders := make([]string, 0, len(page))
+	args := []any{userID.String(), appID.String()}
+	for i, row := range page {
+		idx[row.RoleID] = i
+		placeholders = append(placeholders, "?")
+		args = append(args, row.RoleID.String())
+	}
+	query := fmt.Sprintf(
+		`SELECT ga.role_id, ga.group_id
+FROM group_role_assignments ga
+JOIN user_group_members gm ON gm.group_id = ga.group_id
+WHERE gm.user_id = ? AND ga.app_id = ? AND ga.role_id IN (%s)
+ORDER BY ga.role_id, ga.group_id`,
+		strings.Join(placeholders, ", "),
+	)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("access repo: list: via groups: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var roleID, groupID string
+		if err := rows.Scan(&roleID, &groupID); err != nil {
+			return fmt.Errorf("access repo: list: via groups: scan: %w", err)
+		}
+		if i, ok := idx[domain.RoleID(roleID)]; ok {
+			page[i].ViaGroups = append(page[i].ViaGroups, domain.GroupID(groupID))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("access repo: list: via groups: rows: %w", err)
+	}
+	return nil
+}
+
 func keysetClause(order domain.ListOrderBy, c domain.PageCursor) (string, []any) {
 	switch order {
 	case domain.OrderByGrantedAtAsc:
@@ -1,26 +1,140 @@
 package mariadb
 
 import (
+	"database/sql"
+	"time"
+
 	"sso/internal/modules/access/internal/domain"
 	"sso/internal/modules/access/internal/mariadb/dbgen"
 )
 
 func dbgenToDomain(r dbgen.RoleAssignment) *domain.RoleAssignment {
 	return &domain.RoleAssignment{
-		UserID:          domain.UserID(r.UserID),
+		Principal:       domain.UserPrincipal(domain.UserID(r.UserID)),
+		RoleID:          domain.RoleID(r.RoleID),
+		AppID:           domain.AppID(r.AppID),
+		GrantedByUserID: domain.ActorID(r.GrantedByUserID),
+		GrantedAt:       r.GrantedAt,
+		NotBefore:       timeFromDB(r.NotBefore),
+		ExpiresAt:       timeFromDB(r.ExpiresAt),
+	}
+}
+
+func saAssignmentToDomain(r dbgen.ServiceAccountRoleAssignment) *domain.RoleAssignment {
+	return &domain.RoleAssignment{
+		Principal:       domain.ServiceAccountPrincipal(r.ServiceAccountID),
 		RoleID:          domain.RoleID(r.RoleID),
 		AppID:           domain.AppID(r.AppID),
 		GrantedByUserID: domain.ActorID(r.GrantedByUserID),
 		GrantedAt:       r.GrantedAt,
+		NotBefore:       timeFromDB(r.NotBefore),
+		ExpiresAt:       timeFromDB(r.ExpiresAt),
 	}
 }
 
 func toCreateParams(a *domain.RoleAssignment) dbgen.CreateRoleAssignmentParams {
 	return dbgen.CreateRoleAssignmentParams{
-		UserID:          a.UserID.String(),
+		UserID:          a.Principal.ID,
+		RoleID:          a.RoleID.String(),
+		AppID:           a.AppID.String(),
+		GrantedByUserID: a.GrantedByUserID.String(),
+		GrantedAt:       a.GrantedAt,
+		NotBefore:       timeToDB(a.NotBefore),
+		ExpiresAt:       timeToDB(a.ExpiresAt),
+	}
+}
+
+func toCreateSAParams(a *domain.RoleAssignment) dbgen.CreateServiceAccountRoleAssignmentParams {
+	return dbgen.CreateServiceAccountRoleAssignmentParams{
+		ServiceAccountID: a.Principal.ID,
+		RoleID:           a.RoleID.String(),
+		AppID:            a.AppID.String(),
+		GrantedByUserID:  a.GrantedByUserID.String(),
+		GrantedAt:        a.GrantedAt,
+		NotBefore:        timeToDB(a.NotBefore),
+		ExpiresAt:        timeToDB(a.ExpiresAt),
+	}
+}
+
+// timeFromDB / timeToDB map an optional window bound between the
+// domain's *time.Time (nil = unbounded) and SQL NULL.
+func timeFromDB(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
+func timeToDB(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func groupAssignmentToDomain(r dbgen.GroupRoleAssignment) *domain.GroupRoleAssignment {
+	return &domain.GroupRoleAssignment{
+		GroupID:         domain.GroupID(r.GroupID),
+		RoleID:          domain.RoleID(r.RoleID),
+		AppID:           domain.AppID(r.AppID),
+		GrantedByUserID: domain.ActorID(r.GrantedByUserID),
+		GrantedAt:       r.GrantedAt,
+	}
+}
+
+func toCreateGroupParams(a *domain.GroupRoleAssignment) dbgen.CreateGroupRoleAssignmentParams {
+	return dbgen.CreateGroupRoleAssignmentParams{
+		GroupID:         a.GroupID.String(),
+		RoleID:          a.RoleID.String(),
+		AppID:           a.AppID.String(),
+		GrantedByUserID: a.GrantedByUserID.String(),
+		GrantedAt:       a.GrantedAt,
+	}
+}
+
+func scopedAssignmentToDomain(r dbgen.ScopedRoleAssignment) *domain.ScopedRoleAssignment {
+	return &domain.ScopedRoleAssignment{
+		Principal:       domain.UserPrincipal(domain.UserID(r.UserID)),
+		RoleID:          domain.RoleID(r.RoleID),
+		AppID:           domain.AppID(r.AppID),
+		Scope:           domain.ResourceScope{Type: r.ResourceType, ID: r.ResourceID},
+		GrantedByUserID: domain.ActorID(r.GrantedByUserID),
+		GrantedAt:       r.GrantedAt,
+	}
+}
+
+func toCreateScopedParams(a *domain.ScopedRoleAssignment) dbgen.CreateScopedRoleAssignmentParams {
+	return dbgen.CreateScopedRoleAssignmentParams{
+		UserID:          a.Principal.ID,
 		RoleID:          a.RoleID.String(),
 		AppID:           a.AppID.String(),
+		ResourceType:    a.Scope.Type,
+		ResourceID:      a.Scope.ID,
 		GrantedByUserID: a.GrantedByUserID.String(),
 		GrantedAt:       a.GrantedAt,
 	}
 }
+
+func saScopedAssignmentToDomain(r dbgen.ServiceAccountScopedRoleAssignment) *domain.ScopedRoleAssignment {
+	return &domain.ScopedRoleAssignment{
+		Principal:       domain.ServiceAccountPrincipal(r.ServiceAccountID),
+		RoleID:          domain.RoleID(r.RoleID),
+		AppID:           domain.AppID(r.AppID),
+		Scope:           domain.ResourceScope{Type: r.ResourceType, ID: r.ResourceID},
+		GrantedByUserID: domain.ActorID(r.GrantedByUserID),
+		GrantedAt:       r.GrantedAt,
+	}
+}
+
+func toCreateSAScopedParams(a *domain.ScopedRoleAssignment) dbgen.CreateServiceAccountScopedRoleAssignmentParams {
+	return dbgen.CreateServiceAccountScopedRoleAssignmentParams{
+		ServiceAccountID: a.Principal.ID,
+		RoleID:           a.RoleID.String(),
+		AppID:            a.AppID.String(),
+		ResourceType:     a.Scope.Type,
+		ResourceID:       a.Scope.ID,
+		GrantedByUserID:  a.GrantedByUserID.String(),
+		GrantedAt:        a.GrantedAt,
+	}
+}
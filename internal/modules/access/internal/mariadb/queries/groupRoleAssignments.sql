@@ -0,0 +1,34 @@
+-- name: CreateGroupRoleAssignment :exec
+-- Same idempotent contract as CreateRoleAssignment: a duplicate key
+-- means the group already holds the role.
+INSERT INTO group_role_assignments
+    (group_id, role_id, app_id, granted_by_user_id, granted_at)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: GetGroupRoleAssignment :one
+SELECT group_id, role_id, app_id, granted_by_user_id, granted_at
+FROM group_role_assignments
+WHERE group_id = ? AND role_id = ?;
+
+-- name: DeleteGroupRoleAssignment :execresult
+DELETE FROM group_role_assignments WHERE group_id = ? AND role_id = ?;
+
+-- name: ListGroupRoleAssignments :many
+SELECT group_id, role_id, app_id, granted_by_user_id, granted_at
+FROM group_role_assignments
+WHERE group_id = ?
+ORDER BY granted_at, role_id;
+
+-- name: ListGroupRoleAssignmentsByApp :many
+SELECT group_id, role_id, app_id, granted_by_user_id, granted_at
+FROM group_role_assignments
+WHERE group_id = ? AND app_id = ?
+ORDER BY granted_at, role_id;
+
+-- name: CountGroupGrantsOfRoleForUser :one
+-- Number of the user's groups that hold the role. Drives HasRoleInApp
+-- for roles the user does not hold directly.
+SELECT COUNT(*)
+FROM user_group_members gm
+JOIN group_role_assignments ga ON ga.group_id = gm.group_id
+WHERE gm.user_id = ? AND ga.role_id = ?;
@@ -0,0 +1,99 @@
+-- Reverse queries: the principals holding a permission or a role.
+
+-- name: ListPrincipalsWithPermission :many
+-- The principals holding the permission in the app, one row per
+-- principal, keyset-paged by principal id. Drives
+-- ListPrincipalsWithPermission.
+--
+-- The grants CTE walks the include DAG upwards, from the ACTIVE roles
+-- of the app carrying the permission (exactly or as "<resource>:*")
+-- to every ACTIVE role including them: a DISABLED role neither grants
+-- nor passes on, as in ListActivePermissionsByUserApp, and the same
+-- FIND_IN_SET and depth guards apply. unconditional is whether the
+-- permission row at the bottom of the path carries no condition.
+-- holders then collects the principals of every granting role: direct
+-- and service-account assignments inside their window, group members,
+-- and the scoped grants of users and service accounts (app_wide = 0).
+WITH RECURSIVE grants (role_id, unconditional, path, depth) AS (
+    SELECT rp.role_id, rp.condition_expr = '', CAST(rp.role_id AS CHAR(1024)), 0
+    FROM role_permissions rp
+    JOIN roles r ON r.id = rp.role_id
+    WHERE r.app_id = ?
+      AND r.status = ?
+      AND rp.permission IN (sqlc.arg(permission), sqlc.arg(wildcard))
+    UNION ALL
+    SELECT ri.role_id, grants.unconditional, CONCAT(grants.path, ',', ri.role_id), grants.depth + 1
+    FROM grants
+    JOIN role_includes ri ON ri.included_role_id = grants.role_id
+    JOIN roles r          ON r.id = ri.role_id
+    WHERE r.status = ?
+      AND grants.depth < 16
+      AND FIND_IN_SET(ri.role_id, grants.path) = 0
+),
+granting (role_id, unconditional) AS (
+    SELECT role_id, MAX(unconditional) FROM grants GROUP BY role_id
+),
+holders (principal_id, kind, app_wide, unconditional) AS (
+    SELECT ra.user_id AS principal_id, 'user' AS kind, 1 AS app_wide, g.unconditional
+    FROM role_assignments ra
+    JOIN granting g ON g.role_id = ra.role_id
+    WHERE (ra.not_before IS NULL OR ra.not_before <= sqlc.arg(now))
+      AND (ra.expires_at IS NULL OR ra.expires_at >  sqlc.arg(now))
+    UNION ALL
+    SELECT gm.user_id, 'user', 1, g.unconditional
+    FROM group_role_assignments ga
+    JOIN granting g            ON g.role_id = ga.role_id
+    JOIN user_group_members gm ON gm.group_id = ga.group_id
+    UNION ALL
+    SELECT sa.service_account_id, 'service_account', 1, g.unconditional
+    FROM service_account_role_assignments sa
+    JOIN granting g ON g.role_id = sa.role_id
+    WHERE (sa.not_before IS NULL OR sa.not_before <= sqlc.arg(now))
+      AND (sa.expires_at IS NULL OR sa.expires_at >  sqlc.arg(now))
+    UNION ALL
+    SELECT sr.user_id, 'user', 0, g.unconditional
+    FROM scoped_role_assignments sr
+    JOIN granting g ON g.role_id = sr.role_id
+    UNION ALL
+    SELECT ss.service_account_id, 'service_account', 0, g.unconditional
+    FROM service_account_scoped_role_assignments ss
+    JOIN granting g ON g.role_id = ss.role_id
+)
+SELECT principal_id, kind, MAX(app_wide) AS app_wide,
+       MAX(app_wide AND unconditional) AS unconditional,
+       MAX(NOT app_wide) AS scoped
+FROM holders
+WHERE principal_id > sqlc.arg(after)
+GROUP BY principal_id, kind
+ORDER BY principal_id
+LIMIT ?;
+
+-- name: ListRoleMembers :many
+-- The principals holding the role, one row per principal, keyset-paged
+-- by principal id: direct and service-account assignments inside their
+-- window, and the members of the groups granted the role. group_ids
+-- lists those groups, NULL when there are none.
+SELECT principal_id, kind, MAX(direct) AS direct,
+       GROUP_CONCAT(group_id ORDER BY group_id) AS group_ids
+FROM (
+    SELECT ra.user_id AS principal_id, 'user' AS kind, 1 AS direct, NULL AS group_id
+    FROM role_assignments ra
+    WHERE ra.role_id = ?
+      AND (ra.not_before IS NULL OR ra.not_before <= sqlc.arg(now))
+      AND (ra.expires_at IS NULL OR ra.expires_at >  sqlc.arg(now))
+    UNION ALL
+    SELECT sa.service_account_id, 'service_account', 1, NULL
+    FROM service_account_role_assignments sa
+    WHERE sa.role_id = ?
+      AND (sa.not_before IS NULL OR sa.not_before <= sqlc.arg(now))
+      AND (sa.expires_at IS NULL OR sa.expires_at >  sqlc.arg(now))
+    UNION ALL
+    SELECT gm.user_id, 'user', 0, ga.group_id
+    FROM group_role_assignments ga
+    JOIN user_group_members gm ON gm.group_id = ga.group_id
+    WHERE ga.role_id = ?
+) members
+WHERE principal_id > sqlc.arg(after)
+GROUP BY principal_id, kind
+ORDER BY principal_id
+LIMIT ?;
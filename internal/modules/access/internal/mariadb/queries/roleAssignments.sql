@@ -3,11 +3,11 @@
 -- via dbutil.IsDuplicateEntry — the use-case wants "already existed" to
 -- be observable so it can populate `newly_created=false` in BulkGrantRoles.
 INSERT INTO role_assignments
-    (user_id, role_id, app_id, granted_by_user_id, granted_at)
-VALUES (?, ?, ?, ?, ?);
+    (user_id, role_id, app_id, granted_by_user_id, granted_at, not_before, expires_at)
+VALUES (?, ?, ?, ?, ?, ?, ?);
 
 -- name: GetRoleAssignment :one
-SELECT user_id, role_id, app_id, granted_by_user_id, granted_at
+SELECT user_id, role_id, app_id, granted_by_user_id, granted_at, not_before, expires_at
 FROM role_assignments
 WHERE user_id = ? AND role_id = ?;
 
@@ -17,17 +17,92 @@ DELETE FROM role_assignments WHERE user_id = ? AND role_id = ?;
 -- name: CountRoleAssignmentsByUserApp :one
 SELECT COUNT(*) FROM role_assignments WHERE user_id = ? AND app_id = ?;
 
+-- name: UpdateRoleAssignmentExpiresAt :execresult
+-- Moves an assignment's expiry. The second expires_at guard keeps an
+-- assignment that lapsed between the use-case's read and this write
+-- from being revived ahead of the sweeper.
+UPDATE role_assignments SET expires_at = ?
+WHERE user_id = ? AND role_id = ?
+  AND (expires_at IS NULL OR expires_at > ?);
+
+-- name: ListExpiredRoleAssignments :many
+-- One sweeper batch: assignments whose window has closed, oldest expiry
+-- first (idx_role_assignments_expires_at).
+SELECT user_id, role_id, app_id, granted_by_user_id, granted_at, not_before, expires_at
+FROM role_assignments
+WHERE expires_at <= ?
+ORDER BY expires_at
+LIMIT ?;
+
+-- name: DeleteExpiredRoleAssignment :execresult
+-- Deletes the assignment only if it is still expired, so an
+-- ExtendRoleAssignment that landed after the sweeper's read wins.
+DELETE FROM role_assignments WHERE user_id = ? AND role_id = ? AND expires_at <= ?;
+
 -- name: ListActivePermissionsByUserApp :many
--- Returns all permission strings carried by ACTIVE roles assigned to the
--- user in the target app. Drives CheckPermission and BatchCheckPermission;
--- DISABLED roles are filtered server-side at the JOIN. Each (role_id,
--- permission) row is returned once — duplicates across roles ARE possible
--- and the caller (use-case layer) does the wildcard expansion + dedup
--- against the requested permission set.
-SELECT r.id AS role_id, rp.permission
+-- Returns all permission strings reachable from the ACTIVE roles the
+-- user holds in the target app, directly or through a group the user
+-- belongs to, following role_includes transitively. Drives
+-- CheckPermission and BatchCheckPermission.
+--
+-- The held CTE collects the roles granted to the user; UNION folds a
+-- role granted both directly and via a group into one root. A direct
+-- assignment counts only inside its [not_before, expires_at) window;
+-- rows past expiry linger until the sweeper deletes them. The reach
+-- CTE walks the include DAG from each root. path is the comma-separated
+-- chain of role ids from the root down to role_id, which CheckPermission
+-- reports in matched_role_ids. A DISABLED role contributes nothing and
+-- stops the walk below it. FIND_IN_SET guards against a cycle that
+-- slipped past the role service, and depth caps the walk at 16 levels
+-- (also keeping path within its CHAR(1024)).
+--
+-- A role reachable along several paths yields one row per path; the
+-- caller does the wildcard expansion + dedup against the requested
+-- permission set.
+--
+-- condition_expr is the permission's CEL condition, empty when it has
+-- none; the caller evaluates it against the check's context.
+WITH RECURSIVE held (role_id) AS (
+    SELECT ra.role_id
+    FROM role_assignments ra
+    WHERE ra.user_id = ?
+      AND ra.app_id  = ?
+      AND (ra.not_before IS NULL OR ra.not_before <= sqlc.arg(now))
+      AND (ra.expires_at IS NULL OR ra.expires_at >  sqlc.arg(now))
+    UNION
+    SELECT ga.role_id
+    FROM user_group_members gm
+    JOIN group_role_assignments ga ON ga.group_id = gm.group_id
+    WHERE gm.user_id = ?
+      AND ga.app_id  = ?
+),
+reach (role_id, path, depth) AS (
+    SELECT r.id, CAST(r.id AS CHAR(1024)), 0
+    FROM held h
+    JOIN roles r ON r.id = h.role_id
+    WHERE r.status = ?
+    UNION ALL
+    SELECT ri.included_role_id, CONCAT(reach.path, ',', ri.included_role_id), reach.depth + 1
+    FROM reach
+    JOIN role_includes ri ON ri.role_id = reach.role_id
+    JOIN roles r          ON r.id = ri.included_role_id
+    WHERE r.status = ?
+      AND reach.depth < 16
+      AND FIND_IN_SET(ri.included_role_id, reach.path) = 0
+)
+SELECT reach.role_id, reach.path, rp.permission, rp.condition_expr
+FROM reach
+JOIN role_permissions rp ON rp.role_id = reach.role_id;
+
+-- name: NextWindowChangeByUserApp :one
+-- The earliest not_before or expires_at still ahead of now among the
+-- user's direct assignments in the app, NULL when there is none: the
+-- next moment ListActivePermissionsByUserApp changes its answer with
+-- no write in between. Bounds how long CheckPermission may serve a
+-- cached permission snapshot. A window that has not opened yet closes
+-- after it opens, so its not_before is the nearer edge.
+SELECT MIN(CASE WHEN ra.not_before > sqlc.arg(now) THEN ra.not_before ELSE ra.expires_at END) AS next_change
 FROM role_assignments ra
-JOIN roles r           ON r.id = ra.role_id
-JOIN role_permissions rp ON rp.role_id = r.id
 WHERE ra.user_id = ?
   AND ra.app_id  = ?
-  AND r.status   = ?;
+  AND (ra.not_before > sqlc.arg(now) OR ra.expires_at > sqlc.arg(now));
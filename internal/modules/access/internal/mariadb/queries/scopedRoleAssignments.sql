@@ -0,0 +1,48 @@
+-- name: CreateScopedRoleAssignment :exec
+-- Same idempotent contract as CreateRoleAssignment: a duplicate key
+-- means the user already holds the role on that scope.
+INSERT INTO scoped_role_assignments
+    (user_id, role_id, app_id, resource_type, resource_id, granted_by_user_id, granted_at)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetScopedRoleAssignment :one
+SELECT user_id, role_id, app_id, resource_type, resource_id, granted_by_user_id, granted_at
+FROM scoped_role_assignments
+WHERE user_id = ? AND role_id = ? AND resource_type = ? AND resource_id = ?;
+
+-- name: DeleteScopedRoleAssignment :execresult
+DELETE FROM scoped_role_assignments
+WHERE user_id = ? AND role_id = ? AND resource_type = ? AND resource_id = ?;
+
+-- name: ListScopedRoleAssignmentsByUserApp :many
+SELECT user_id, role_id, app_id, resource_type, resource_id, granted_by_user_id, granted_at
+FROM scoped_role_assignments
+WHERE user_id = ? AND app_id = ?
+ORDER BY resource_type, resource_id, role_id;
+
+-- name: ListScopedPermissionsByUserApp :many
+-- ListActivePermissionsByUserApp for the user's scoped grants: every
+-- permission reachable from the ACTIVE roles the user holds on some
+-- resource scope in the app, with the scope carried along the include
+-- walk. The walk itself is the same as for app-wide grants.
+WITH RECURSIVE reach (role_id, path, depth, resource_type, resource_id) AS (
+    SELECT r.id, CAST(r.id AS CHAR(1024)), 0, sa.resource_type, sa.resource_id
+    FROM scoped_role_assignments sa
+    JOIN roles r ON r.id = sa.role_id
+    WHERE sa.user_id = ?
+      AND sa.app_id  = ?
+      AND r.status   = ?
+    UNION ALL
+    SELECT ri.included_role_id, CONCAT(reach.path, ',', ri.included_role_id), reach.depth + 1,
+           reach.resource_type, reach.resource_id
+    FROM reach
+    JOIN role_includes ri ON ri.role_id = reach.role_id
+    JOIN roles r          ON r.id = ri.included_role_id
+    WHERE r.status = ?
+      AND reach.depth < 16
+      AND FIND_IN_SET(ri.included_role_id, reach.path) = 0
+)
+SELECT reach.role_id, reach.path, rp.permission, rp.condition_expr,
+       reach.resource_type, reach.resource_id
+FROM reach
+JOIN role_permissions rp ON rp.role_id = reach.role_id;
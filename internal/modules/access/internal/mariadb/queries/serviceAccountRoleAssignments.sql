@@ -0,0 +1,68 @@
+-- name: CreateServiceAccountRoleAssignment :exec
+-- Same duplicate-key contract as CreateRoleAssignment.
+INSERT INTO service_account_role_assignments
+    (service_account_id, role_id, app_id, granted_by_user_id, granted_at, not_before, expires_at)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetServiceAccountRoleAssignment :one
+SELECT service_account_id, role_id, app_id, granted_by_user_id, granted_at, not_before, expires_at
+FROM service_account_role_assignments
+WHERE service_account_id = ? AND role_id = ?;
+
+-- name: DeleteServiceAccountRoleAssignment :execresult
+DELETE FROM service_account_role_assignments WHERE service_account_id = ? AND role_id = ?;
+
+-- name: UpdateServiceAccountRoleAssignmentExpiresAt :execresult
+-- See UpdateRoleAssignmentExpiresAt for the second expires_at guard.
+UPDATE service_account_role_assignments SET expires_at = ?
+WHERE service_account_id = ? AND role_id = ?
+  AND (expires_at IS NULL OR expires_at > ?);
+
+-- name: ListExpiredServiceAccountRoleAssignments :many
+SELECT service_account_id, role_id, app_id, granted_by_user_id, granted_at, not_before, expires_at
+FROM service_account_role_assignments
+WHERE expires_at <= ?
+ORDER BY expires_at
+LIMIT ?;
+
+-- name: DeleteExpiredServiceAccountRoleAssignment :execresult
+DELETE FROM service_account_role_assignments
+WHERE service_account_id = ? AND role_id = ? AND expires_at <= ?;
+
+-- name: ListActivePermissionsByServiceAccountApp :many
+-- ListActivePermissionsByUserApp for a service account. Service
+-- accounts belong to no groups, so the held CTE is the windowed direct
+-- assignments alone; the include walk is identical.
+WITH RECURSIVE held (role_id) AS (
+    SELECT sa.role_id
+    FROM service_account_role_assignments sa
+    WHERE sa.service_account_id = ?
+      AND sa.app_id = ?
+      AND (sa.not_before IS NULL OR sa.not_before <= sqlc.arg(now))
+      AND (sa.expires_at IS NULL OR sa.expires_at >  sqlc.arg(now))
+),
+reach (role_id, path, depth) AS (
+    SELECT r.id, CAST(r.id AS CHAR(1024)), 0
+    FROM held h
+    JOIN roles r ON r.id = h.role_id
+    WHERE r.status = ?
+    UNION ALL
+    SELECT ri.included_role_id, CONCAT(reach.path, ',', ri.included_role_id), reach.depth + 1
+    FROM reach
+    JOIN role_includes ri ON ri.role_id = reach.role_id
+    JOIN roles r          ON r.id = ri.included_role_id
+    WHERE r.status = ?
+      AND reach.depth < 16
+      AND FIND_IN_SET(ri.included_role_id, reach.path) = 0
+)
+SELECT reach.role_id, reach.path, rp.permission, rp.condition_expr
+FROM reach
+JOIN role_permissions rp ON rp.role_id = reach.role_id;
+
+-- name: NextWindowChangeByServiceAccountApp :one
+-- NextWindowChangeByUserApp for a service account.
+SELECT MIN(CASE WHEN sa.not_before > sqlc.arg(now) THEN sa.not_before ELSE sa.expires_at END) AS next_change
+FROM service_account_role_assignments sa
+WHERE sa.service_account_id = ?
+  AND sa.app_id = ?
+  AND (sa.not_before > sqlc.arg(now) OR sa.expires_at > sqlc.arg(now));
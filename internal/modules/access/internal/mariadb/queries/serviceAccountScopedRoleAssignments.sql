@@ -0,0 +1,45 @@
+-- name: CreateServiceAccountScopedRoleAssignment :exec
+-- Same duplicate-key contract as CreateScopedRoleAssignment.
+INSERT INTO service_account_scoped_role_assignments
+    (service_account_id, role_id, app_id, resource_type, resource_id, granted_by_user_id, granted_at)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetServiceAccountScopedRoleAssignment :one
+SELECT service_account_id, role_id, app_id, resource_type, resource_id, granted_by_user_id, granted_at
+FROM service_account_scoped_role_assignments
+WHERE service_account_id = ? AND role_id = ? AND resource_type = ? AND resource_id = ?;
+
+-- name: DeleteServiceAccountScopedRoleAssignment :execresult
+DELETE FROM service_account_scoped_role_assignments
+WHERE service_account_id = ? AND role_id = ? AND resource_type = ? AND resource_id = ?;
+
+-- name: ListServiceAccountScopedRoleAssignments :many
+SELECT service_account_id, role_id, app_id, resource_type, resource_id, granted_by_user_id, granted_at
+FROM service_account_scoped_role_assignments
+WHERE service_account_id = ? AND app_id = ?
+ORDER BY resource_type, resource_id, role_id;
+
+-- name: ListScopedPermissionsByServiceAccountApp :many
+-- ListScopedPermissionsByUserApp for a service account's scoped
+-- grants.
+WITH RECURSIVE reach (role_id, path, depth, resource_type, resource_id) AS (
+    SELECT r.id, CAST(r.id AS CHAR(1024)), 0, sa.resource_type, sa.resource_id
+    FROM service_account_scoped_role_assignments sa
+    JOIN roles r ON r.id = sa.role_id
+    WHERE sa.service_account_id = ?
+      AND sa.app_id = ?
+      AND r.status = ?
+    UNION ALL
+    SELECT ri.included_role_id, CONCAT(reach.path, ',', ri.included_role_id), reach.depth + 1,
+           reach.resource_type, reach.resource_id
+    FROM reach
+    JOIN role_includes ri ON ri.role_id = reach.role_id
+    JOIN roles r          ON r.id = ri.included_role_id
+    WHERE r.status = ?
+      AND reach.depth < 16
+      AND FIND_IN_SET(ri.included_role_id, reach.path) = 0
+)
+SELECT reach.role_id, reach.path, rp.permission, rp.condition_expr,
+       reach.resource_type, reach.resource_id
+FROM reach
+JOIN role_permissions rp ON rp.role_id = reach.role_id;
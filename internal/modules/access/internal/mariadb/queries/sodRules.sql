@@ -0,0 +1,97 @@
+-- Separation-of-duties rules and the holdings they are checked against.
+
+-- name: CreateSoDRule :exec
+INSERT INTO sod_rules (id, app_id, name, description, created_by, created_at)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: CreateSoDRuleRole :exec
+INSERT INTO sod_rule_roles (rule_id, role_id)
+VALUES (?, ?);
+
+-- name: GetSoDRule :one
+SELECT id, app_id, name, description, created_by, created_at
+FROM sod_rules
+WHERE id = ?;
+
+-- name: ListSoDRuleRoles :many
+SELECT role_id
+FROM sod_rule_roles
+WHERE rule_id = ?
+ORDER BY role_id;
+
+-- name: ListSoDRulesByApp :many
+SELECT id, app_id, name, description, created_by, created_at
+FROM sod_rules
+WHERE app_id = ?
+ORDER BY name;
+
+-- name: ListSoDRuleRolesByApp :many
+SELECT srr.rule_id, srr.role_id
+FROM sod_rule_roles srr
+JOIN sod_rules sr ON sr.id = srr.rule_id
+WHERE sr.app_id = ?
+ORDER BY srr.rule_id, srr.role_id;
+
+-- name: DeleteSoDRule :execresult
+DELETE FROM sod_rules
+WHERE id = ?;
+
+-- name: ListHeldRoleIDsByUserApp :many
+-- The roles of the app the user holds app-wide for the SoD check:
+-- direct assignments not yet expired (a window still to open counts —
+-- the role is as good as held) and group grants.
+SELECT ra.role_id
+FROM role_assignments ra
+WHERE ra.user_id = ?
+  AND ra.app_id  = ?
+  AND (ra.expires_at IS NULL OR ra.expires_at > sqlc.arg(now))
+UNION
+SELECT ga.role_id
+FROM group_role_assignments ga
+JOIN user_group_members gm ON gm.group_id = ga.group_id
+WHERE gm.user_id = ?
+  AND ga.app_id  = ?;
+
+-- name: ListHeldRoleIDsByServiceAccountApp :many
+SELECT role_id
+FROM service_account_role_assignments
+WHERE service_account_id = ?
+  AND app_id = ?
+  AND (expires_at IS NULL OR expires_at > sqlc.arg(now));
+
+-- name: ListSoDViolations :many
+-- One row per (rule, principal) where the principal holds two or more
+-- of the rule's roles, counted as ListHeldRoleIDsBy*App counts them,
+-- keyset-paged by (rule id, principal id). role_ids lists the
+-- conflicting roles. UNION rather than UNION ALL: a role held both
+-- directly and through a group is one holding.
+WITH held (rule_id, principal_id, kind, role_id) AS (
+    SELECT srr.rule_id, ra.user_id, 'user', ra.role_id
+    FROM sod_rules sr
+    JOIN sod_rule_roles srr  ON srr.rule_id = sr.id
+    JOIN role_assignments ra ON ra.role_id = srr.role_id
+    WHERE sr.app_id = ?
+      AND (ra.expires_at IS NULL OR ra.expires_at > sqlc.arg(now))
+    UNION
+    SELECT srr.rule_id, gm.user_id, 'user', ga.role_id
+    FROM sod_rules sr
+    JOIN sod_rule_roles srr        ON srr.rule_id = sr.id
+    JOIN group_role_assignments ga ON ga.role_id = srr.role_id
+    JOIN user_group_members gm     ON gm.group_id = ga.group_id
+    WHERE sr.app_id = ?
+    UNION
+    SELECT srr.rule_id, sa.service_account_id, 'service_account', sa.role_id
+    FROM sod_rules sr
+    JOIN sod_rule_roles srr                  ON srr.rule_id = sr.id
+    JOIN service_account_role_assignments sa ON sa.role_id = srr.role_id
+    WHERE sr.app_id = ?
+      AND (sa.expires_at IS NULL OR sa.expires_at > sqlc.arg(now))
+)
+SELECT rule_id, principal_id, kind,
+       GROUP_CONCAT(role_id ORDER BY role_id) AS role_ids
+FROM held
+WHERE (rule_id > sqlc.arg(after_rule) OR (rule_id = sqlc.arg(after_rule) AND principal_id > sqlc.arg(after_principal)))
+GROUP BY rule_id, principal_id, kind
+HAVING COUNT(*) >= 2
+ORDER BY rule_id, principal_id
+LIMIT ?;
@@ -11,6 +11,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"sso/internal/modules/access/internal/domain"
 	"sso/internal/modules/access/internal/mariadb/dbgen"
@@ -28,12 +31,22 @@ func NewRepository(db *sql.DB) *Repository {
 
 var _ domain.Repository = (*Repository)(nil)
 
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one, so access writes can join a use-case
+// that spans modules.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
 // ----------------------------------------------------------------------------
 // Create / Get / Delete
 // ----------------------------------------------------------------------------
 
 func (r *Repository) Create(ctx context.Context, a *domain.RoleAssignment) (bool, error) {
-	err := r.q.CreateRoleAssignment(ctx, toCreateParams(a))
+	err := createAssignment(ctx, r.queries(ctx), a)
 	if err == nil {
 		return true, nil
 	}
@@ -46,25 +59,37 @@ func (r *Repository) Create(ctx context.Context, a *domain.RoleAssignment) (bool
 	return false, fmt.Errorf("access repo: create: %w", err)
 }
 
-func (r *Repository) Get(ctx context.Context, userID domain.UserID, roleID domain.RoleID) (*domain.RoleAssignment, error) {
-	row, err := r.q.GetRoleAssignment(ctx, dbgen.GetRoleAssignmentParams{
-		UserID: userID.String(),
-		RoleID: roleID.String(),
-	})
+func (r *Repository) Get(ctx context.Context, p domain.Principal, roleID domain.RoleID) (*domain.RoleAssignment, error) {
+	var (
+		out *domain.RoleAssignment
+		err error
+	)
+	if p.IsServiceAccount() {
+		var row dbgen.ServiceAccountRoleAssignment
+		row, err = r.queries(ctx).GetServiceAccountRoleAssignment(ctx, dbgen.GetServiceAccountRoleAssignmentParams{
+			ServiceAccountID: p.ID,
+			RoleID:           roleID.String(),
+		})
+		out = saAssignmentToDomain(row)
+	} else {
+		var row dbgen.RoleAssignment
+		row, err = r.queries(ctx).GetRoleAssignment(ctx, dbgen.GetRoleAssignmentParams{
+			UserID: p.ID,
+			RoleID: roleID.String(),
+		})
+		out = dbgenToDomain(row)
+	}
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrAssignmentNotFound
 		}
 		return nil, fmt.Errorf("access repo: get: %w", err)
 	}
-	return dbgenToDomain(row), nil
+	return out, nil
 }
 
-func (r *Repository) Delete(ctx context.Context, userID domain.UserID, roleID domain.RoleID) (bool, error) {
-	res, err := r.q.DeleteRoleAssignment(ctx, dbgen.DeleteRoleAssignmentParams{
-		UserID: userID.String(),
-		RoleID: roleID.String(),
-	})
+func (r *Repository) Delete(ctx context.Context, p domain.Principal, roleID domain.RoleID) (bool, error) {
+	res, err := deleteAssignment(ctx, r.queries(ctx), p, roleID)
 	if err != nil {
 		return false, fmt.Errorf("access repo: delete: %w", err)
 	}
@@ -75,6 +100,131 @@ func (r *Repository) Delete(ctx context.Context, userID domain.UserID, roleID do
 	return rows == 1, nil
 }
 
+// createAssignment / deleteAssignment pick the table for the
+// principal's kind: role_assignments for users,
+// service_account_role_assignments for service accounts.
+func createAssignment(ctx context.Context, q *dbgen.Queries, a *domain.RoleAssignment) error {
+	if a.Principal.IsServiceAccount() {
+		return q.CreateServiceAccountRoleAssignment(ctx, toCreateSAParams(a))
+	}
+	return q.CreateRoleAssignment(ctx, toCreateParams(a))
+}
+
+func deleteAssignment(ctx context.Context, q *dbgen.Queries, p domain.Principal, roleID domain.RoleID) (sql.Result, error) {
+	if p.IsServiceAccount() {
+		return q.DeleteServiceAccountRoleAssignment(ctx, dbgen.DeleteServiceAccountRoleAssignmentParams{
+			ServiceAccountID: p.ID,
+			RoleID:           roleID.String(),
+		})
+	}
+	return q.DeleteRoleAssignment(ctx, dbgen.DeleteRoleAssignmentParams{
+		UserID: p.ID,
+		RoleID: roleID.String(),
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Expiry
+// ----------------------------------------------------------------------------
+
+func (r *Repository) UpdateExpiresAt(ctx context.Context, p domain.Principal, roleID domain.RoleID, expiresAt *time.Time, now time.Time) error {
+	var (
+		res sql.Result
+		err error
+	)
+	if p.IsServiceAccount() {
+		res, err = r.queries(ctx).UpdateServiceAccountRoleAssignmentExpiresAt(ctx, dbgen.UpdateServiceAccountRoleAssignmentExpiresAtParams{
+			ExpiresAt:        timeToDB(expiresAt),
+			ServiceAccountID: p.ID,
+			RoleID:           roleID.String(),
+			ExpiresAt_2:      sql.NullTime{Time: now, Valid: true},
+		})
+	} else {
+		res, err = r.queries(ctx).UpdateRoleAssignmentExpiresAt(ctx, dbgen.UpdateRoleAssignmentExpiresAtParams{
+			ExpiresAt:   timeToDB(expiresAt),
+			UserID:      p.ID,
+			RoleID:      roleID.String(),
+			ExpiresAt_2: sql.NullTime{Time: now, Valid: true},
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("access repo: update_expires_at: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("access repo: update_expires_at: rows_affected: %w", err)
+	}
+	// The use-case only calls this with a value different from the
+	// stored one, so 0 rows cannot be MariaDB's "unchanged row" case.
+	if rows != 1 {
+		return domain.ErrAssignmentNotFound
+	}
+	return nil
+}
+
+// ListExpired reads up to limit rows from each table and keeps the
+// limit with the oldest expiry, so neither principal kind can starve
+// the other across sweeper batches.
+func (r *Repository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*domain.RoleAssignment, error) {
+	cutoff := sql.NullTime{Time: now, Valid: true}
+	users, err := r.queries(ctx).ListExpiredRoleAssignments(ctx, dbgen.ListExpiredRoleAssignmentsParams{
+		ExpiresAt: cutoff,
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access repo: list_expired: %w", err)
+	}
+	sas, err := r.queries(ctx).ListExpiredServiceAccountRoleAssignments(ctx, dbgen.ListExpiredServiceAccountRoleAssignmentsParams{
+		ExpiresAt: cutoff,
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access repo: list_expired: service accounts: %w", err)
+	}
+	out := make([]*domain.RoleAssignment, 0, len(users)+len(sas))
+	for _, row := range users {
+		out = append(out, dbgenToDomain(row))
+	}
+	for _, row := range sas {
+		out = append(out, saAssignmentToDomain(row))
+	}
+	slices.SortStableFunc(out, func(a, b *domain.RoleAssignment) int {
+		return a.ExpiresAt.Compare(*b.ExpiresAt)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (r *Repository) DeleteExpired(ctx context.Context, p domain.Principal, roleID domain.RoleID, now time.Time) (bool, error) {
+	var (
+		res sql.Result
+		err error
+	)
+	if p.IsServiceAccount() {
+		res, err = r.queries(ctx).DeleteExpiredServiceAccountRoleAssignment(ctx, dbgen.DeleteExpiredServiceAccountRoleAssignmentParams{
+			ServiceAccountID: p.ID,
+			RoleID:           roleID.String(),
+			ExpiresAt:        sql.NullTime{Time: now, Valid: true},
+		})
+	} else {
+		res, err = r.queries(ctx).DeleteExpiredRoleAssignment(ctx, dbgen.DeleteExpiredRoleAssignmentParams{
+			UserID:    p.ID,
+			RoleID:    roleID.String(),
+			ExpiresAt: sql.NullTime{Time: now, Valid: true},
+		})
+	}
+	if err != nil {
+		return false, fmt.Errorf("access repo: delete_expired: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("access repo: delete_expired: rows_affected: %w", err)
+	}
+	return rows == 1, nil
+}
+
 // ----------------------------------------------------------------------------
 // Bulk
 // ----------------------------------------------------------------------------
@@ -88,7 +238,7 @@ func (r *Repository) BulkCreate(ctx context.Context, assignments []*domain.RoleA
 	err := dbutil.InTx(ctx, r.db, func(tx *sql.Tx) error {
 		q := dbgen.New(tx)
 		for i, a := range assignments {
-			err := q.CreateRoleAssignment(ctx, toCreateParams(a))
+			err := createAssignment(ctx, q, a)
 			switch {
 			case err == nil:
 				mask[i] = true
@@ -106,16 +256,14 @@ func (r *Repository) BulkCreate(ctx context.Context, assignments []*domain.RoleA
 	return mask, nil
 }
 
-// BulkDelete removes the (userID × roleIDs) pairs atomically. Missing
-// pairs are silently ignored (idempotent remove) — only DB errors abort.
-func (r *Repository) BulkDelete(ctx context.Context, userID domain.UserID, roleIDs []domain.RoleID) error {
+// BulkDelete removes the (principal × roleIDs) pairs atomically.
+// Missing pairs are silently ignored (idempotent remove) — only DB
+// errors abort.
+func (r *Repository) BulkDelete(ctx context.Context, p domain.Principal, roleIDs []domain.RoleID) error {
 	return dbutil.InTx(ctx, r.db, func(tx *sql.Tx) error {
 		q := dbgen.New(tx)
 		for _, rid := range roleIDs {
-			if _, err := q.DeleteRoleAssignment(ctx, dbgen.DeleteRoleAssignmentParams{
-				UserID: userID.String(),
-				RoleID: rid.String(),
-			}); err != nil {
+			if _, err := deleteAssignment(ctx, q, p, rid); err != nil {
 				return fmt.Errorf("access repo: bulk_delete: %w", err)
 			}
 		}
@@ -133,12 +281,34 @@ func (r *Repository) BulkDelete(ctx context.Context, userID domain.UserID, roleI
 
 const activeRoleStatus uint8 = 1 // mirrors role.RoleStatusActive
 
-func (r *Repository) ListActivePermissions(ctx context.Context, userID domain.UserID, appID domain.AppID) ([]domain.PermissionRow, error) {
-	rows, err := r.q.ListActivePermissionsByUserApp(ctx, dbgen.ListActivePermissionsByUserAppParams{
-		UserID: userID.String(),
-		AppID:  appID.String(),
-		Status: activeRoleStatus,
-	})
+func (r *Repository) ListActivePermissions(ctx context.Context, p domain.Principal, appID domain.AppID, now time.Time) ([]domain.PermissionRow, error) {
+	var (
+		rows []dbgen.ListActivePermissionsByUserAppRow
+		err  error
+	)
+	if p.IsServiceAccount() {
+		var saRows []dbgen.ListActivePermissionsByServiceAccountAppRow
+		saRows, err = r.queries(ctx).ListActivePermissionsByServiceAccountApp(ctx, dbgen.ListActivePermissionsByServiceAccountAppParams{
+			ServiceAccountID: p.ID,
+			AppID:            appID.String(),
+			Now:              sql.NullTime{Time: now, Valid: true},
+			Status:           activeRoleStatus,
+			Status_2:         activeRoleStatus,
+		})
+		for _, row := range saRows {
+			rows = append(rows, dbgen.ListActivePermissionsByUserAppRow(row))
+		}
+	} else {
+		rows, err = r.queries(ctx).ListActivePermissionsByUserApp(ctx, dbgen.ListActivePermissionsByUserAppParams{
+			UserID:   p.ID,
+			AppID:    appID.String(),
+			Now:      sql.NullTime{Time: now, Valid: true},
+			UserID_2: p.ID,
+			AppID_2:  appID.String(),
+			Status:   activeRoleStatus,
+			Status_2: activeRoleStatus,
+		})
+	}
 	if err != nil {
 		return nil, fmt.Errorf("access repo: list_active_permissions: %w", err)
 	}
@@ -147,7 +317,276 @@ func (r *Repository) ListActivePermissions(ctx context.Context, userID domain.Us
 		out = append(out, domain.PermissionRow{
 			RoleID:     domain.RoleID(row.RoleID),
 			Permission: row.Permission,
+			Condition:  row.ConditionExpr,
+			Path:       pathFromDB(row.Path),
+		})
+	}
+	var scoped []dbgen.ListScopedPermissionsByUserAppRow
+	if p.IsServiceAccount() {
+		var saScoped []dbgen.ListScopedPermissionsByServiceAccountAppRow
+		saScoped, err = r.queries(ctx).ListScopedPermissionsByServiceAccountApp(ctx, dbgen.ListScopedPermissionsByServiceAccountAppParams{
+			ServiceAccountID: p.ID,
+			AppID:            appID.String(),
+			Status:           activeRoleStatus,
+			Status_2:         activeRoleStatus,
+		})
+		for _, row := range saScoped {
+			scoped = append(scoped, dbgen.ListScopedPermissionsByUserAppRow(row))
+		}
+	} else {
+		scoped, err = r.queries(ctx).ListScopedPermissionsByUserApp(ctx, dbgen.ListScopedPermissionsByUserAppParams{
+			UserID:   p.ID,
+			AppID:    appID.String(),
+			Status:   activeRoleStatus,
+			Status_2: activeRoleStatus,
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("access repo: list_active_permissions: scoped: %w", err)
+	}
+	for _, row := range scoped {
+		out = append(out, domain.PermissionRow{
+			RoleID:     domain.RoleID(row.RoleID),
+			Permission: row.Permission,
+			Condition:  row.ConditionExpr,
+			Path:       pathFromDB(row.Path),
+			Scope:      &domain.ResourceScope{Type: row.ResourceType, ID: row.ResourceID},
+		})
+	}
+	return out, nil
+}
+
+func (r *Repository) NextWindowChange(ctx context.Context, p domain.Principal, appID domain.AppID, now time.Time) (time.Time, bool, error) {
+	var (
+		next sql.NullTime
+		err  error
+	)
+	if p.IsServiceAccount() {
+		next, err = r.queries(ctx).NextWindowChangeByServiceAccountApp(ctx, dbgen.NextWindowChangeByServiceAccountAppParams{
+			Now:              sql.NullTime{Time: now, Valid: true},
+			ServiceAccountID: p.ID,
+			AppID:            appID.String(),
+		})
+	} else {
+		next, err = r.queries(ctx).NextWindowChangeByUserApp(ctx, dbgen.NextWindowChangeByUserAppParams{
+			Now:    sql.NullTime{Time: now, Valid: true},
+			UserID: p.ID,
+			AppID:  appID.String(),
+		})
+	}
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("access repo: next_window_change: %w", err)
+	}
+	return next.Time, next.Valid, nil
+}
+
+// pathFromDB splits the comma-separated include chain the permission
+// queries build.
+func pathFromDB(raw string) []domain.RoleID {
+	ids := strings.Split(raw, ",")
+	path := make([]domain.RoleID, len(ids))
+	for i, id := range ids {
+		path[i] = domain.RoleID(id)
+	}
+	return path
+}
+
+func (r *Repository) HasRoleViaGroup(ctx context.Context, userID domain.UserID, roleID domain.RoleID) (bool, error) {
+	n, err := r.queries(ctx).CountGroupGrantsOfRoleForUser(ctx, dbgen.CountGroupGrantsOfRoleForUserParams{
+		UserID: userID.String(),
+		RoleID: roleID.String(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("access repo: has_role_via_group: %w", err)
+	}
+	return n > 0, nil
+}
+
+// ----------------------------------------------------------------------------
+// Group grants
+// ----------------------------------------------------------------------------
+
+func (r *Repository) CreateGroupAssignment(ctx context.Context, a *domain.GroupRoleAssignment) (bool, error) {
+	err := r.queries(ctx).CreateGroupRoleAssignment(ctx, toCreateGroupParams(a))
+	switch {
+	case err == nil:
+		return true, nil
+	case dbutil.IsDuplicateEntry(err):
+		return false, nil
+	case dbutil.IsForeignKeyViolation(err):
+		// The use-case checked the role and the group; the group is the
+		// one that can disappear underneath (DeleteGroup does not go
+		// through access).
+		return false, domain.ErrGroupNotFound
+	}
+	return false, fmt.Errorf("access repo: create group assignment: %w", err)
+}
+
+func (r *Repository) GetGroupAssignment(ctx context.Context, groupID domain.GroupID, roleID domain.RoleID) (*domain.GroupRoleAssignment, error) {
+	row, err := r.queries(ctx).GetGroupRoleAssignment(ctx, dbgen.GetGroupRoleAssignmentParams{
+		GroupID: groupID.String(),
+		RoleID:  roleID.String(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAssignmentNotFound
+		}
+		return nil, fmt.Errorf("access repo: get group assignment: %w", err)
+	}
+	return groupAssignmentToDomain(row), nil
+}
+
+func (r *Repository) DeleteGroupAssignment(ctx context.Context, groupID domain.GroupID, roleID domain.RoleID) (bool, error) {
+	res, err := r.queries(ctx).DeleteGroupRoleAssignment(ctx, dbgen.DeleteGroupRoleAssignmentParams{
+		GroupID: groupID.String(),
+		RoleID:  roleID.String(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("access repo: delete group assignment: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("access repo: delete group assignment: rows_affected: %w", err)
+	}
+	return rows == 1, nil
+}
+
+func (r *Repository) ListGroupRoles(ctx context.Context, groupID domain.GroupID, appID domain.AppID) ([]*domain.GroupRoleAssignment, error) {
+	var (
+		rows []dbgen.GroupRoleAssignment
+		err  error
+	)
+	if appID == "" {
+		rows, err = r.queries(ctx).ListGroupRoleAssignments(ctx, groupID.String())
+	} else {
+		rows, err = r.queries(ctx).ListGroupRoleAssignmentsByApp(ctx, dbgen.ListGroupRoleAssignmentsByAppParams{
+			GroupID: groupID.String(),
+			AppID:   appID.String(),
 		})
 	}
+	if err != nil {
+		return nil, fmt.Errorf("access repo: list group roles: %w", err)
+	}
+	out := make([]*domain.GroupRoleAssignment, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, groupAssignmentToDomain(row))
+	}
+	return out, nil
+}
+
+// ----------------------------------------------------------------------------
+// Scoped grants
+// ----------------------------------------------------------------------------
+
+func (r *Repository) CreateScopedAssignment(ctx context.Context, a *domain.ScopedRoleAssignment) (bool, error) {
+	var err error
+	if a.Principal.IsServiceAccount() {
+		err = r.queries(ctx).CreateServiceAccountScopedRoleAssignment(ctx, toCreateSAScopedParams(a))
+	} else {
+		err = r.queries(ctx).CreateScopedRoleAssignment(ctx, toCreateScopedParams(a))
+	}
+	switch {
+	case err == nil:
+		return true, nil
+	case dbutil.IsDuplicateEntry(err):
+		return false, nil
+	case dbutil.IsForeignKeyViolation(err):
+		// The use-case checked both sides; a hard delete of the
+		// principal is what can race the grant.
+		return false, domain.ErrUserNotFound
+	}
+	return false, fmt.Errorf("access repo: create scoped assignment: %w", err)
+}
+
+func (r *Repository) GetScopedAssignment(ctx context.Context, p domain.Principal, roleID domain.RoleID, scope domain.ResourceScope) (*domain.ScopedRoleAssignment, error) {
+	var (
+		out *domain.ScopedRoleAssignment
+		err error
+	)
+	if p.IsServiceAccount() {
+		var row dbgen.ServiceAccountScopedRoleAssignment
+		row, err = r.queries(ctx).GetServiceAccountScopedRoleAssignment(ctx, dbgen.GetServiceAccountScopedRoleAssignmentParams{
+			ServiceAccountID: p.ID,
+			RoleID:           roleID.String(),
+			ResourceType:     scope.Type,
+			ResourceID:       scope.ID,
+		})
+		out = saScopedAssignmentToDomain(row)
+	} else {
+		var row dbgen.ScopedRoleAssignment
+		row, err = r.queries(ctx).GetScopedRoleAssignment(ctx, dbgen.GetScopedRoleAssignmentParams{
+			UserID:       p.ID,
+			RoleID:       roleID.String(),
+			ResourceType: scope.Type,
+			ResourceID:   scope.ID,
+		})
+		out = scopedAssignmentToDomain(row)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAssignmentNotFound
+		}
+		return nil, fmt.Errorf("access repo: get scoped assignment: %w", err)
+	}
+	return out, nil
+}
+
+func (r *Repository) DeleteScopedAssignment(ctx context.Context, p domain.Principal, roleID domain.RoleID, scope domain.ResourceScope) (bool, error) {
+	var (
+		res sql.Result
+		err error
+	)
+	if p.IsServiceAccount() {
+		res, err = r.queries(ctx).DeleteServiceAccountScopedRoleAssignment(ctx, dbgen.DeleteServiceAccountScopedRoleAssignmentParams{
+			ServiceAccountID: p.ID,
+			RoleID:           roleID.String(),
+			ResourceType:     scope.Type,
+			ResourceID:       scope.ID,
+		})
+	} else {
+		res, err = r.queries(ctx).DeleteScopedRoleAssignment(ctx, dbgen.DeleteScopedRoleAssignmentParams{
+			UserID:       p.ID,
+			RoleID:       roleID.String(),
+			ResourceType: scope.Type,
+			ResourceID:   scope.ID,
+		})
+	}
+	if err != nil {
+		return false, fmt.Errorf("access repo: delete scoped assignment: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("access repo: delete scoped assignment: rows_affected: %w", err)
+	}
+	return rows == 1, nil
+}
+
+func (r *Repository) ListScopedAssignments(ctx context.Context, p domain.Principal, appID domain.AppID) ([]*domain.ScopedRoleAssignment, error) {
+	if p.IsServiceAccount() {
+		rows, err := r.queries(ctx).ListServiceAccountScopedRoleAssignments(ctx, dbgen.ListServiceAccountScopedRoleAssignmentsParams{
+			ServiceAccountID: p.ID,
+			AppID:            appID.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("access repo: list scoped assignments: %w", err)
+		}
+		out := make([]*domain.ScopedRoleAssignment, 0, len(rows))
+		for _, row := range rows {
+			out = append(out, saScopedAssignmentToDomain(row))
+		}
+		return out, nil
+	}
+
+	rows, err := r.queries(ctx).ListScopedRoleAssignmentsByUserApp(ctx, dbgen.ListScopedRoleAssignmentsByUserAppParams{
+		UserID: p.ID,
+		AppID:  appID.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access repo: list scoped assignments: %w", err)
+	}
+	out := make([]*domain.ScopedRoleAssignment, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, scopedAssignmentToDomain(row))
+	}
 	return out, nil
 }
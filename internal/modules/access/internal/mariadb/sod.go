@@ -0,0 +1,191 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/access/internal/domain"
+	"sso/internal/modules/access/internal/mariadb/dbgen"
+)
+
+// ----------------------------------------------------------------------------
+// Separation-of-duties rules
+// ----------------------------------------------------------------------------
+
+// CreateSoDRule inserts the rule row, then one row per role, in one
+// transaction. A foreign key miss on the rule row is the app deleted
+// underneath; on a role row, the role.
+func (r *Repository) CreateSoDRule(ctx context.Context, rule *domain.SoDRule) error {
+	return dbutil.WithTx(ctx, r.db, func(ctx context.Context) error {
+		q := r.queries(ctx)
+		err := q.CreateSoDRule(ctx, dbgen.CreateSoDRuleParams{
+			ID:          rule.ID.String(),
+			AppID:       rule.AppID.String(),
+			Name:        rule.Name,
+			Description: rule.Description,
+			CreatedBy:   rule.CreatedBy.String(),
+			CreatedAt:   rule.CreatedAt,
+		})
+		switch {
+		case dbutil.IsDuplicateEntry(err):
+			return domain.ErrSoDRuleExists
+		case dbutil.IsForeignKeyViolation(err):
+			return domain.ErrAppNotFound
+		case err != nil:
+			return fmt.Errorf("access repo: create sod rule: %w", err)
+		}
+		for _, rid := range rule.RoleIDs {
+			err := q.CreateSoDRuleRole(ctx, dbgen.CreateSoDRuleRoleParams{
+				RuleID: rule.ID.String(),
+				RoleID: rid.String(),
+			})
+			switch {
+			case dbutil.IsForeignKeyViolation(err):
+				return domain.ErrRoleNotFound
+			case err != nil:
+				return fmt.Errorf("access repo: create sod rule role: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *Repository) GetSoDRule(ctx context.Context, id domain.SoDRuleID) (*domain.SoDRule, error) {
+	q := r.queries(ctx)
+	row, err := q.GetSoDRule(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSoDRuleNotFound
+		}
+		return nil, fmt.Errorf("access repo: get sod rule: %w", err)
+	}
+	roles, err := q.ListSoDRuleRoles(ctx, id.String())
+	if err != nil {
+		return nil, fmt.Errorf("access repo: get sod rule: roles: %w", err)
+	}
+	rule := sodRuleToDomain(row)
+	for _, rid := range roles {
+		rule.RoleIDs = append(rule.RoleIDs, domain.RoleID(rid))
+	}
+	return rule, nil
+}
+
+func (r *Repository) ListSoDRules(ctx context.Context, appID domain.AppID) ([]*domain.SoDRule, error) {
+	q := r.queries(ctx)
+	rows, err := q.ListSoDRulesByApp(ctx, appID.String())
+	if err != nil {
+		return nil, fmt.Errorf("access repo: list sod rules: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	roles, err := q.ListSoDRuleRolesByApp(ctx, appID.String())
+	if err != nil {
+		return nil, fmt.Errorf("access repo: list sod rules: roles: %w", err)
+	}
+	byID := make(map[string]*domain.SoDRule, len(rows))
+	out := make([]*domain.SoDRule, 0, len(rows))
+	for _, row := range rows {
+		rule := sodRuleToDomain(row)
+		byID[row.ID] = rule
+		out = append(out, rule)
+	}
+	for _, rr := range roles {
+		if rule, ok := byID[rr.RuleID]; ok {
+			rule.RoleIDs = append(rule.RoleIDs, domain.RoleID(rr.RoleID))
+		}
+	}
+	return out, nil
+}
+
+func (r *Repository) DeleteSoDRule(ctx context.Context, id domain.SoDRuleID) (bool, error) {
+	res, err := r.queries(ctx).DeleteSoDRule(ctx, id.String())
+	if err != nil {
+		return false, fmt.Errorf("access repo: delete sod rule: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("access repo: delete sod rule: rows_affected: %w", err)
+	}
+	return rows == 1, nil
+}
+
+func (r *Repository) ListHeldRoleIDs(ctx context.Context, p domain.Principal, appID domain.AppID, now time.Time) ([]domain.RoleID, error) {
+	var (
+		ids []string
+		err error
+	)
+	if p.IsServiceAccount() {
+		ids, err = r.queries(ctx).ListHeldRoleIDsByServiceAccountApp(ctx, dbgen.ListHeldRoleIDsByServiceAccountAppParams{
+			ServiceAccountID: p.ID,
+			AppID:            appID.String(),
+			Now:              sql.NullTime{Time: now, Valid: true},
+		})
+	} else {
+		ids, err = r.queries(ctx).ListHeldRoleIDsByUserApp(ctx, dbgen.ListHeldRoleIDsByUserAppParams{
+			UserID:   p.ID,
+			AppID:    appID.String(),
+			Now:      sql.NullTime{Time: now, Valid: true},
+			UserID_2: p.ID,
+			AppID_2:  appID.String(),
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("access repo: list held role ids: %w", err)
+	}
+	out := make([]domain.RoleID, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, domain.RoleID(id))
+	}
+	return out, nil
+}
+
+func (r *Repository) ListSoDViolations(ctx context.Context, q domain.SoDViolationsQuery) ([]domain.SoDViolation, error) {
+	if q.PageSize <= 0 {
+		return nil, fmt.Errorf("access repo: list_sod_violations: page_size must be > 0")
+	}
+	var afterRule, afterPrincipal string
+	if q.After != nil {
+		afterRule, afterPrincipal = q.After.RuleID.String(), q.After.PrincipalID
+	}
+	rows, err := r.queries(ctx).ListSoDViolations(ctx, dbgen.ListSoDViolationsParams{
+		AppID:          q.AppID.String(),
+		Now:            sql.NullTime{Time: q.Now, Valid: true},
+		AppID_2:        q.AppID.String(),
+		AppID_3:        q.AppID.String(),
+		AfterRule:      afterRule,
+		AfterPrincipal: afterPrincipal,
+		Limit:          int32(q.PageSize + 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access repo: list_sod_violations: %w", err)
+	}
+	out := make([]domain.SoDViolation, 0, len(rows))
+	for _, row := range rows {
+		v := domain.SoDViolation{
+			RuleID:    domain.SoDRuleID(row.RuleID),
+			Principal: principalFromDB(row.Kind, row.PrincipalID),
+		}
+		for _, id := range strings.Split(row.RoleIds.String, ",") {
+			v.RoleIDs = append(v.RoleIDs, domain.RoleID(id))
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func sodRuleToDomain(row dbgen.SodRule) *domain.SoDRule {
+	return &domain.SoDRule{
+		ID:          domain.SoDRuleID(row.ID),
+		AppID:       domain.AppID(row.AppID),
+		Name:        row.Name,
+		Description: row.Description,
+		CreatedBy:   domain.ActorID(row.CreatedBy),
+		CreatedAt:   row.CreatedAt,
+	}
+}
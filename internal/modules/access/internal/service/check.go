@@ -3,11 +3,10 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 
 	"sso/internal/modules/access/internal/domain"
-	"sso/internal/modules/app"
-	"sso/internal/modules/identity"
 	"sso/internal/kernel/validation"
 )
 
@@ -20,10 +19,11 @@ type HasRoleInAppInput struct {
 	RoleID string
 }
 
-// HasRoleInApp checks whether the (user, role) assignment exists.
-// DISABLED roles still return true — the assignment exists, even if it
-// no longer contributes to CheckPermission. The proto explicitly
-// requires this distinction.
+// HasRoleInApp checks whether the user holds the role, directly or
+// through one of their groups. DISABLED roles still return true — the
+// assignment exists, even if it no longer contributes to
+// CheckPermission. The proto explicitly requires this distinction.
+// Resource-scoped grants do not count: the question is app-wide.
 func (s *Service) HasRoleInApp(ctx context.Context, in HasRoleInAppInput) (bool, error) {
 	uid, err := domain.ParseUserID(in.UserID)
 	if err != nil {
@@ -36,14 +36,50 @@ func (s *Service) HasRoleInApp(ctx context.Context, in HasRoleInAppInput) (bool,
 
 	// Existence preconditions: user + role must resolve before the
 	// "has assignment" question makes sense (proto requires NOT_FOUND).
-	if err := s.requireUserExists(ctx, identity.UserID(uid)); err != nil {
+	principal, err := s.requirePrincipal(ctx, uid)
+	if err != nil {
 		return false, err
 	}
 	if _, err := s.loadAnyRole(ctx, rid); err != nil {
 		return false, err
 	}
 
-	if _, err := s.repo.Get(ctx, uid, rid); err != nil {
+	// A direct assignment outside its window counts as absent, so the
+	// group path still gets a say.
+	ra, err := s.repo.Get(ctx, principal, rid)
+	if err != nil && !errors.Is(err, domain.ErrAssignmentNotFound) {
+		return false, err
+	}
+	if ra != nil && ra.InWindow(s.now().UTC()) {
+		return true, nil
+	}
+	if !principal.IsUser() {
+		return false, nil
+	}
+	return s.repo.HasRoleViaGroup(ctx, uid, rid)
+}
+
+// HasDirectRole checks whether the user holds a direct grant of the
+// role, in or out of its window. Group and scoped grants do not count:
+// callers that maintain direct grants themselves (the directory group
+// sync) must not mistake a group grant for one of theirs.
+func (s *Service) HasDirectRole(ctx context.Context, in HasRoleInAppInput) (bool, error) {
+	uid, err := domain.ParseUserID(in.UserID)
+	if err != nil {
+		return false, err
+	}
+	rid, err := domain.ParseRoleID(in.RoleID)
+	if err != nil {
+		return false, err
+	}
+	principal, err := s.requirePrincipal(ctx, uid)
+	if err != nil {
+		return false, err
+	}
+	if _, err := s.loadAnyRole(ctx, rid); err != nil {
+		return false, err
+	}
+	if _, err := s.repo.Get(ctx, principal, rid); err != nil {
 		if errors.Is(err, domain.ErrAssignmentNotFound) {
 			return false, nil
 		}
@@ -56,12 +92,22 @@ func (s *Service) HasRoleInApp(ctx context.Context, in HasRoleInAppInput) (bool,
 // CheckPermission
 // ----------------------------------------------------------------------------
 
+// CheckPermissionInput.Target is the resource acted on. Scoped grants
+// count only when they cover it; the gRPC adapter never sets it, so
+// checks made there see app-wide grants alone.
 type CheckPermissionInput struct {
 	UserID     string
 	AppID      string
 	Permission string
+	Target     ResourceTarget
+	Context    CheckContext
 }
 
+// CheckPermissionOutput reports every role on every include path that
+// led to a match: for each path, the role the user holds comes first
+// and the role carrying the permission last. A directly held role with
+// the permission contributes just itself. Ids are de-duplicated, first
+// occurrence wins, and paths are visited in a stable order.
 type CheckPermissionOutput struct {
 	Allowed        bool
 	MatchedRoleIDs []string
@@ -72,23 +118,33 @@ func (s *Service) CheckPermission(ctx context.Context, in CheckPermissionInput)
 	if err != nil {
 		return CheckPermissionOutput{}, err
 	}
-
-	if err := s.requireUserExists(ctx, identity.UserID(uid)); err != nil {
+	target, err := in.Target.parse()
+	if err != nil {
 		return CheckPermissionOutput{}, err
 	}
-	if err := s.requireAppExists(ctx, app.AppID(aid)); err != nil {
+
+	principal, rows, err := s.loadPermissions(ctx, uid, aid)
+	if err != nil {
 		return CheckPermissionOutput{}, err
 	}
 
-	rows, err := s.repo.ListActivePermissions(ctx, uid, aid)
+	matchedRows, err := s.newConditionScope(principal, aid, in.Context).
+		filter(ctx, matchScope(matchPermissions(rows, perms[0]), target))
 	if err != nil {
 		return CheckPermissionOutput{}, err
 	}
-
-	matchedSet := matchPermissions(rows, perms[0])
-	matched := make([]string, 0, len(matchedSet))
-	for rid := range matchedSet {
-		matched = append(matched, rid.String())
+	slices.SortFunc(matchedRows, func(a, b domain.PermissionRow) int {
+		return slices.Compare(a.Path, b.Path)
+	})
+	seen := make(map[domain.RoleID]bool)
+	matched := make([]string, 0, len(matchedRows))
+	for _, row := range matchedRows {
+		for _, rid := range row.Path {
+			if !seen[rid] {
+				seen[rid] = true
+				matched = append(matched, rid.String())
+			}
+		}
 	}
 	return CheckPermissionOutput{
 		Allowed:        len(matched) > 0,
@@ -100,10 +156,14 @@ func (s *Service) CheckPermission(ctx context.Context, in CheckPermissionInput)
 // BatchCheckPermission
 // ----------------------------------------------------------------------------
 
+// BatchCheckPermissionInput.Target applies to every permission in the
+// batch, as in CheckPermissionInput.
 type BatchCheckPermissionInput struct {
 	UserID      string
 	AppID       string
 	Permissions []string
+	Target      ResourceTarget
+	Context     CheckContext
 }
 
 type BatchCheckPermissionOutput struct {
@@ -118,22 +178,24 @@ func (s *Service) BatchCheckPermission(ctx context.Context, in BatchCheckPermiss
 	if err != nil {
 		return BatchCheckPermissionOutput{}, err
 	}
-
-	if err := s.requireUserExists(ctx, identity.UserID(uid)); err != nil {
-		return BatchCheckPermissionOutput{}, err
-	}
-	if err := s.requireAppExists(ctx, app.AppID(aid)); err != nil {
+	target, err := in.Target.parse()
+	if err != nil {
 		return BatchCheckPermissionOutput{}, err
 	}
 
-	rows, err := s.repo.ListActivePermissions(ctx, uid, aid)
+	principal, rows, err := s.loadPermissions(ctx, uid, aid)
 	if err != nil {
 		return BatchCheckPermissionOutput{}, err
 	}
 
+	conds := s.newConditionScope(principal, aid, in.Context)
 	allowed := make([]bool, len(perms))
 	for i, p := range perms {
-		allowed[i] = len(matchPermissions(rows, p)) > 0
+		matched, err := conds.filter(ctx, matchScope(matchPermissions(rows, p), target))
+		if err != nil {
+			return BatchCheckPermissionOutput{}, err
+		}
+		allowed[i] = len(matched) > 0
 	}
 	return BatchCheckPermissionOutput{Allowed: allowed}, nil
 }
@@ -209,15 +271,16 @@ func isLowerIdent(s string) bool {
 	return true
 }
 
-// matchPermissions returns the set of role_ids whose permission set
-// satisfies the requested permission. A role permission matches when:
+// matchPermissions returns the rows whose permission satisfies the
+// requested permission, before conditions are considered (see
+// conditionScope). A role permission matches when:
 //   - it equals the request exactly (e.g. "users:read" == "users:read"); or
 //   - it is "<resource>:*" and the request's resource matches.
 //
 // The caller already validated the request as concrete (no "*" on the
 // request side), so wildcard handling is one-directional.
-func matchPermissions(rows []domain.PermissionRow, requested string) map[domain.RoleID]struct{} {
-	out := map[domain.RoleID]struct{}{}
+func matchPermissions(rows []domain.PermissionRow, requested string) []domain.PermissionRow {
+	var out []domain.PermissionRow
 
 	colon := strings.IndexByte(requested, ':')
 	resource := requested
@@ -227,7 +290,7 @@ func matchPermissions(rows []domain.PermissionRow, requested string) map[domain.
 
 	for _, r := range rows {
 		if r.Permission == requested {
-			out[r.RoleID] = struct{}{}
+			out = append(out, r)
 			continue
 		}
 		// Wildcard: "<resource>:*" matches any concrete action under
@@ -235,7 +298,7 @@ func matchPermissions(rows []domain.PermissionRow, requested string) map[domain.
 		if strings.HasSuffix(r.Permission, ":*") {
 			rolePerm := r.Permission[:len(r.Permission)-2]
 			if rolePerm == resource {
-				out[r.RoleID] = struct{}{}
+				out = append(out, r)
 			}
 		}
 	}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"sso/internal/kernel/condition"
+	"sso/internal/kernel/validation"
+	access "sso/internal/modules/access/internal/domain"
+	"sso/internal/modules/identity"
+)
+
+// AttributeSource supplies a user's custom attributes for an app, read
+// by permission conditions as user.attributes. nil means none.
+// Satisfied by *attribute.Service.
+type AttributeSource interface {
+	AppAttributes(ctx context.Context, userID, appID string) (map[string]any, error)
+}
+
+// SetAttributes late-binds the attribute source: bootstrap builds the
+// attribute module after access. Until it is set, conditions see no
+// user attributes.
+func (s *Service) SetAttributes(src AttributeSource) { s.attrs.Store(&src) }
+
+// CheckContext is the caller-supplied half of a condition's input: the
+// attributes of the resource acted on and of the request itself. HTTP
+// callers send them in the check body; the gRPC messages have no room
+// for them, so gRPC callers send the same JSON objects as
+// x-condition-resource / x-condition-request metadata. Either way an
+// attribute left out reads as an empty map, so a condition on it is not
+// met.
+type CheckContext struct {
+	Resource map[string]any
+	Request  map[string]any
+}
+
+// DecodeCheckContext decodes the JSON objects of resource and request
+// attributes. Absent or null is an empty map. Numbers become int64 when
+// integral and float64 otherwise, at any depth.
+func DecodeCheckContext(resource, request []byte) (CheckContext, error) {
+	var (
+		cc  CheckContext
+		err error
+	)
+	if cc.Resource, err = decodeAttributes(resource, "resource"); err != nil {
+		return CheckContext{}, err
+	}
+	if cc.Request, err = decodeAttributes(request, "request"); err != nil {
+		return CheckContext{}, err
+	}
+	return cc, nil
+}
+
+func decodeAttributes(raw []byte, field string) (map[string]any, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var m map[string]any
+	if err := dec.Decode(&m); err != nil {
+		return nil, &validation.Error{Field: field, Reason: "must be a JSON object"}
+	}
+	for k, v := range m {
+		m[k] = normalizeNumbers(v)
+	}
+	return m, nil
+}
+
+func normalizeNumbers(v any) any {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]any:
+		for k, e := range t {
+			t[k] = normalizeNumbers(e)
+		}
+	case []any:
+		for i, e := range t {
+			t[i] = normalizeNumbers(e)
+		}
+	}
+	return v
+}
+
+// conditionScope evaluates the conditions met during one check. The
+// inputs are the same for every row of the check, so they are loaded
+// on the first conditional row and each distinct expression is
+// evaluated once.
+type conditionScope struct {
+	s         *Service
+	principal access.Principal
+	appID     access.AppID
+	cc        CheckContext
+	now       time.Time
+
+	vars    *condition.Vars
+	results map[string]bool
+}
+
+func (s *Service) newConditionScope(p access.Principal, appID access.AppID, cc CheckContext) *conditionScope {
+	return &conditionScope{s: s, principal: p, appID: appID, cc: cc, now: s.now().UTC()}
+}
+
+// filter drops the rows whose condition does not hold. rows is
+// filtered in place.
+func (c *conditionScope) filter(ctx context.Context, rows []access.PermissionRow) ([]access.PermissionRow, error) {
+	out := rows[:0]
+	for _, row := range rows {
+		if row.Condition == "" {
+			out = append(out, row)
+			continue
+		}
+		ok, err := c.holds(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+// holds reports whether row's condition is met. A condition that fails
+// to compile or evaluate is not met — the permission is denied, never
+// granted on error — and is logged, since it usually means a missing
+// context key or a role edited under an older environment. Only a
+// failure to load the inputs is returned as an error.
+func (c *conditionScope) holds(ctx context.Context, row access.PermissionRow) (bool, error) {
+	if ok, seen := c.results[row.Condition]; seen {
+		return ok, nil
+	}
+	if c.vars == nil {
+		vars, err := c.load(ctx)
+		if err != nil {
+			return false, err
+		}
+		c.vars = vars
+		c.results = make(map[string]bool)
+	}
+	prg, err := condition.Compile(row.Condition)
+	var ok bool
+	if err == nil {
+		ok, err = prg.Eval(*c.vars)
+	}
+	if err != nil {
+		c.s.log.WarnContext(ctx, "access: permission condition not met",
+			"role_id", row.RoleID, "permission", row.Permission, "err", err)
+		ok = false
+	}
+	c.results[row.Condition] = ok
+	return ok, nil
+}
+
+func (c *conditionScope) load(ctx context.Context) (*condition.Vars, error) {
+	user := map[string]any{
+		"id":         c.principal.ID,
+		"kind":       "user",
+		"timezone":   "UTC",
+		"locale":     "",
+		"attributes": map[string]any{},
+	}
+	if c.principal.IsServiceAccount() {
+		user["kind"] = "service_account"
+	} else {
+		u, err := c.s.users.GetByID(ctx, identity.UserID(c.principal.ID))
+		if err != nil {
+			return nil, err
+		}
+		if u.Timezone != "" {
+			user["timezone"] = u.Timezone
+		}
+		user["locale"] = u.Locale
+		if src := c.s.attrs.Load(); src != nil && *src != nil {
+			attrs, err := (*src).AppAttributes(ctx, c.principal.ID, c.appID.String())
+			if err != nil {
+				return nil, err
+			}
+			if attrs != nil {
+				user["attributes"] = attrs
+			}
+		}
+	}
+	return &condition.Vars{
+		User:     user,
+		App:      map[string]any{"id": c.appID.String()},
+		Resource: c.cc.Resource,
+		Request:  c.cc.Request,
+		Now:      c.now,
+	}, nil
+}
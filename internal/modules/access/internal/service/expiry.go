@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/validation"
+	access "sso/internal/modules/access/internal/domain"
+	"sso/internal/modules/audit"
+)
+
+// ----------------------------------------------------------------------------
+// ExtendRoleAssignment
+// ----------------------------------------------------------------------------
+
+type ExtendRoleAssignmentInput struct {
+	UserID string
+	RoleID string
+	// ExpiresAt is the new expiry; nil makes the assignment permanent.
+	ExpiresAt *time.Time
+}
+
+// ExtendRoleAssignment moves a direct assignment's expires_at later.
+// It only ever lengthens the window — shortening is a remove and a
+// fresh grant — and an assignment that has already lapsed cannot be
+// revived: it reads as ErrAssignmentNotFound even before the sweeper
+// deletes the row.
+func (s *Service) ExtendRoleAssignment(ctx context.Context, in ExtendRoleAssignmentInput) (*access.RoleAssignment, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := access.ParseUserID(in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	rid, err := access.ParseRoleID(in.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessExtendRoleAssignment)
+	aud.SubjectType = audit.SubjectTypeUser
+	aud.SubjectID = uid.String()
+	aud.Metadata = map[string]string{"role_id": rid.String()}
+
+	now := s.now().UTC()
+	principal, err := s.requirePrincipal(ctx, uid)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	aud.SubjectType = subjectType(principal)
+
+	ra, err := s.repo.Get(ctx, principal, rid)
+	if err == nil && ra.IsExpired(now) {
+		err = access.ErrAssignmentNotFound
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	aud.AppID = ra.AppID.String()
+
+	if err := validateExtension(ra, in.ExpiresAt, now); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	aud.Metadata["expires_at"] = formatExpiry(in.ExpiresAt)
+
+	// Permanent → permanent is the only no-op that gets past
+	// validateExtension; skip the write, MariaDB would report 0 rows.
+	if ra.ExpiresAt == nil && in.ExpiresAt == nil {
+		s.auditor.Success(ctx, aud)
+		return ra, nil
+	}
+
+	if err := s.repo.UpdateExpiresAt(ctx, principal, rid, in.ExpiresAt, now); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	ra.ExpiresAt = in.ExpiresAt
+	s.version.Bump(ctx)
+
+	s.auditor.Success(ctx, aud)
+	return ra, nil
+}
+
+// validateExtension checks that next lengthens ra's window.
+func validateExtension(ra *access.RoleAssignment, next *time.Time, now time.Time) error {
+	if next == nil {
+		return nil
+	}
+	if ra.ExpiresAt == nil {
+		return &validation.Error{Field: "expires_at", Reason: "assignment is already permanent"}
+	}
+	if !next.After(*ra.ExpiresAt) {
+		return &validation.Error{Field: "expires_at", Reason: "must be later than the current expires_at"}
+	}
+	return access.ValidateWindow(ra.NotBefore, next, now)
+}
+
+func formatExpiry(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// ----------------------------------------------------------------------------
+// Expiry sweep
+// ----------------------------------------------------------------------------
+
+// expirySweepBatch bounds one ListExpired round trip.
+const expirySweepBatch = 100
+
+// expiredReason is the metadata "reason" on the
+// access.remove_role_from_user events the sweep emits. It is metadata
+// rather than the audit Reason because the removal itself succeeded.
+const expiredReason = "expired"
+
+// SweepExpiredAssignments deletes every direct assignment whose
+// expires_at has passed and records each one as a system-initiated
+// access.remove_role_from_user. A row extended between the list and the
+// delete survives, so a concurrent ExtendRoleAssignment always wins.
+// Returns how many assignments were removed.
+func (s *Service) SweepExpiredAssignments(ctx context.Context) (int, error) {
+	now := s.now().UTC()
+	removed := 0
+	for {
+		batch, err := s.repo.ListExpired(ctx, now, expirySweepBatch)
+		if err != nil {
+			return removed, err
+		}
+		for _, ra := range batch {
+			ok, err := s.repo.DeleteExpired(ctx, ra.Principal, ra.RoleID, now)
+			if err != nil {
+				return removed, err
+			}
+			if !ok {
+				continue
+			}
+			removed++
+			s.auditor.Success(ctx, audit.NewAuditParams{
+				EventType:   audit.EventTypeAccessRemoveRoleFromUser,
+				ActorType:   audit.ActorTypeSystem,
+				SubjectType: subjectType(ra.Principal),
+				SubjectID:   ra.Principal.ID,
+				AppID:       ra.AppID.String(),
+				Metadata: map[string]string{
+					"role_id":    ra.RoleID.String(),
+					"reason":     expiredReason,
+					"expires_at": formatExpiry(ra.ExpiresAt),
+				},
+			})
+		}
+		if len(batch) < expirySweepBatch {
+			return removed, nil
+		}
+	}
+}
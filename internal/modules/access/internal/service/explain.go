@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	access "sso/internal/modules/access/internal/domain"
+	appdom "sso/internal/modules/app"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/role"
+	"sso/internal/modules/serviceaccount"
+)
+
+// ----------------------------------------------------------------------------
+// ExplainPermission
+// ----------------------------------------------------------------------------
+
+// ExplainPermissionInput mirrors CheckPermissionInput: the explanation
+// is of the decision that check would make.
+type ExplainPermissionInput struct {
+	UserID     string
+	AppID      string
+	Permission string
+	Target     ResourceTarget
+	Context    CheckContext
+}
+
+// ExplainPermissionOutput is the decision trace. Allowed is exactly what
+// CheckPermission answers. Preconditions lists the principal and app
+// states that stop the principal before any check is made — a blocked
+// user cannot sign in, an app in maintenance refuses logins — and which
+// CheckPermission itself does not look at.
+type ExplainPermissionOutput struct {
+	Allowed       bool
+	Preconditions []PreconditionFailure
+	Roles         []ExplainedRole
+}
+
+// PreconditionFailure names the subject ("user", "service_account" or
+// "app") and the status that fails it.
+type PreconditionFailure struct {
+	Subject string
+	Status  string
+}
+
+// ExplainedRole is one role on an include path from a role the
+// principal holds. Path runs from the held role to this one, as in
+// access.PermissionRow; Direct, ViaGroups and Scope describe how the
+// held role (Path[0]) is held.
+type ExplainedRole struct {
+	RoleID      access.RoleID
+	Name        string
+	Status      string
+	Path        []access.RoleID
+	Direct      bool
+	ViaGroups   []access.GroupID
+	Scope       *access.ResourceScope
+	Permissions []ExplainedPermission
+}
+
+// Match says how a role permission relates to the requested one.
+type Match string
+
+const (
+	MatchNone     Match = "none"
+	MatchExact    Match = "exact"
+	MatchWildcard Match = "wildcard"
+)
+
+// Verdict is what became of a role permission in the check. Every
+// verdict but VerdictGranted explains a miss; VerdictNoMatch is the
+// verdict of every permission that does not match.
+type Verdict string
+
+const (
+	VerdictGranted         Verdict = "granted"
+	VerdictNoMatch         Verdict = "no_match"
+	VerdictRoleDisabled    Verdict = "role_disabled"
+	VerdictNotInWindow     Verdict = "assignment_not_in_window"
+	VerdictOutOfScope      Verdict = "out_of_scope"
+	VerdictConditionNotMet Verdict = "condition_not_met"
+)
+
+type ExplainedPermission struct {
+	Permission string
+	Match      Match
+	Condition  string
+	Verdict    Verdict
+}
+
+// heldRole is one way the principal holds a role: an assignment, one or
+// more group grants, or a scoped grant.
+type heldRole struct {
+	roleID    access.RoleID
+	direct    bool
+	viaGroups []access.GroupID
+	scope     *access.ResourceScope
+}
+
+// ExplainPermission walks every role the principal holds in the app,
+// and every role those include, and reports for each permission on them
+// whether it matched the request and, if so, why it did or did not
+// grant it. The verdicts come from the same data and matching as
+// CheckPermission, so the trace cannot disagree with the check.
+func (s *Service) ExplainPermission(ctx context.Context, in ExplainPermissionInput) (ExplainPermissionOutput, error) {
+	uid, aid, perms, err := s.parseCheckInput(in.UserID, in.AppID, []string{in.Permission})
+	if err != nil {
+		return ExplainPermissionOutput{}, err
+	}
+	target, err := in.Target.parse()
+	if err != nil {
+		return ExplainPermissionOutput{}, err
+	}
+
+	principal, failures, err := s.explainPrincipal(ctx, uid)
+	if err != nil {
+		return ExplainPermissionOutput{}, err
+	}
+	a, err := s.loadApp(ctx, appdom.AppID(aid))
+	if err != nil {
+		return ExplainPermissionOutput{}, err
+	}
+	if a.Status() != appdom.AppStatusActive {
+		failures = append(failures, PreconditionFailure{Subject: "app", Status: a.Status().String()})
+	}
+
+	held, err := s.listHeldRoles(ctx, principal, aid)
+	if err != nil {
+		return ExplainPermissionOutput{}, err
+	}
+	rows, err := s.repo.ListActivePermissions(ctx, principal, aid, s.now().UTC())
+	if err != nil {
+		return ExplainPermissionOutput{}, err
+	}
+	active := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		active[rowKey(row.Path, row.Permission, row.Scope)] = true
+	}
+
+	x := &explainer{
+		s:         s,
+		requested: perms[0],
+		target:    target,
+		active:    active,
+		conds:     s.newConditionScope(principal, aid, in.Context),
+		roles:     make(map[access.RoleID]*role.Role),
+	}
+	out := ExplainPermissionOutput{Preconditions: failures}
+	for _, h := range held {
+		if err := x.walk(ctx, h, []access.RoleID{h.roleID}, false, &out); err != nil {
+			return ExplainPermissionOutput{}, err
+		}
+	}
+	return out, nil
+}
+
+// explainPrincipal resolves the principal as requirePrincipal does and
+// reports a status that would make it ineligible.
+func (s *Service) explainPrincipal(ctx context.Context, id access.UserID) (access.Principal, []PreconditionFailure, error) {
+	principal, err := s.requirePrincipal(ctx, id)
+	if err != nil {
+		return access.Principal{}, nil, err
+	}
+	if principal.IsServiceAccount() {
+		sa, err := s.serviceAccounts.GetByID(ctx, serviceaccount.ServiceAccountID(id))
+		if err != nil {
+			return access.Principal{}, nil, err
+		}
+		if sa.Status() != serviceaccount.ServiceAccountActive {
+			return principal, []PreconditionFailure{{Subject: "service_account", Status: sa.Status().String()}}, nil
+		}
+		return principal, nil, nil
+	}
+	u, err := s.users.GetByID(ctx, identity.UserID(id))
+	if err != nil {
+		return access.Principal{}, nil, err
+	}
+	if u.Status() != identity.UserStatusActive {
+		return principal, []PreconditionFailure{{Subject: "user", Status: u.Status().String()}}, nil
+	}
+	return principal, nil, nil
+}
+
+// listHeldRoles returns every role the principal holds in the app,
+// whatever its status or window: assignments and group grants first,
+// in ListUserRoles order, then scoped grants.
+func (s *Service) listHeldRoles(ctx context.Context, p access.Principal, aid access.AppID) ([]heldRole, error) {
+	var held []heldRole
+	var after *access.PageCursor
+	for {
+		res, err := s.repo.ListUserRoles(ctx, access.ListUserRolesQuery{
+			Principal: p,
+			AppID:     aid,
+			PageSize:  bulkOpsCap,
+			After:     after,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range res.Rows {
+			held = append(held, heldRole{roleID: row.RoleID, direct: row.Direct, viaGroups: row.ViaGroups})
+		}
+		if res.NextCursor == nil {
+			break
+		}
+		after = res.NextCursor
+	}
+	scoped, err := s.repo.ListScopedAssignments(ctx, p, aid)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range scoped {
+		held = append(held, heldRole{roleID: a.RoleID, scope: &a.Scope})
+	}
+	return held, nil
+}
+
+// explainer carries the state of one ExplainPermission walk. roles
+// caches role lookups: a role included from several paths is read once.
+type explainer struct {
+	s         *Service
+	requested string
+	target    *access.ResourceScope
+	active    map[string]bool
+	conds     *conditionScope
+	roles     map[access.RoleID]*role.Role
+}
+
+func (x *explainer) role(ctx context.Context, id access.RoleID) (*role.Role, error) {
+	if r, ok := x.roles[id]; ok {
+		return r, nil
+	}
+	r, err := x.s.roles.GetByID(ctx, role.RoleID(id))
+	if errors.Is(err, role.ErrRoleNotFound) {
+		// Deleted while being walked; the assignment goes with it.
+		r, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	x.roles[id] = r
+	return r, nil
+}
+
+// walk explains the role at the end of path and recurses into its
+// includes. disabled reports whether a role earlier on the path is
+// DISABLED, which stops everything below it from counting.
+func (x *explainer) walk(ctx context.Context, h heldRole, path []access.RoleID, disabled bool, out *ExplainPermissionOutput) error {
+	id := path[len(path)-1]
+	r, err := x.role(ctx, id)
+	if err != nil || r == nil {
+		return err
+	}
+	disabled = disabled || r.Status() != role.RoleStatusActive
+
+	er := ExplainedRole{
+		RoleID:    id,
+		Name:      r.Name,
+		Status:    r.Status().String(),
+		Path:      path,
+		Direct:    h.direct,
+		ViaGroups: h.viaGroups,
+		Scope:     h.scope,
+	}
+	conditions := r.Conditions()
+	for _, perm := range r.Permissions() {
+		ep := ExplainedPermission{
+			Permission: perm,
+			Match:      matchKind(perm, x.requested),
+			Condition:  conditions[perm],
+		}
+		ep.Verdict, err = x.verdict(ctx, ep, path, h.scope, disabled)
+		if err != nil {
+			return err
+		}
+		if ep.Verdict == VerdictGranted {
+			out.Allowed = true
+		}
+		er.Permissions = append(er.Permissions, ep)
+	}
+	out.Roles = append(out.Roles, er)
+
+	for _, inc := range r.Includes() {
+		next := access.RoleID(inc)
+		if slices.Contains(path, next) {
+			continue
+		}
+		if err := x.walk(ctx, h, append(path[:len(path):len(path)], next), disabled, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verdict applies CheckPermission's filters in its order: role status
+// and assignment window (ListActivePermissions), target scope
+// (matchScope), then the condition (conditionScope).
+func (x *explainer) verdict(ctx context.Context, ep ExplainedPermission, path []access.RoleID, scope *access.ResourceScope, disabled bool) (Verdict, error) {
+	switch {
+	case ep.Match == MatchNone:
+		return VerdictNoMatch, nil
+	case disabled:
+		return VerdictRoleDisabled, nil
+	case !x.active[rowKey(path, ep.Permission, scope)]:
+		return VerdictNotInWindow, nil
+	case scope != nil && (x.target == nil || !scope.Covers(*x.target)):
+		return VerdictOutOfScope, nil
+	case ep.Condition != "":
+		ok, err := x.conds.holds(ctx, access.PermissionRow{
+			RoleID:     path[len(path)-1],
+			Permission: ep.Permission,
+			Condition:  ep.Condition,
+			Path:       path,
+			Scope:      scope,
+		})
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return VerdictConditionNotMet, nil
+		}
+	}
+	return VerdictGranted, nil
+}
+
+// matchKind classifies a role permission against a concrete request,
+// by the rules of matchPermissions.
+func matchKind(rolePerm, requested string) Match {
+	if rolePerm == requested {
+		return MatchExact
+	}
+	if res, ok := strings.CutSuffix(rolePerm, ":*"); ok && strings.HasPrefix(requested, res+":") {
+		return MatchWildcard
+	}
+	return MatchNone
+}
+
+func rowKey(path []access.RoleID, permission string, scope *access.ResourceScope) string {
+	var b strings.Builder
+	for _, id := range path {
+		b.WriteString(id.String())
+		b.WriteByte('/')
+	}
+	b.WriteString(permission)
+	if scope != nil {
+		b.WriteByte('@')
+		b.WriteString(scope.String())
+	}
+	return b.String()
+}
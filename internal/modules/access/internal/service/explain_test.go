@@ -0,0 +1,166 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	access "sso/internal/modules/access/internal/domain"
+	"sso/internal/modules/identity"
+)
+
+// TestExplainPermissionMatchesCheck checks that the trace reaches the
+// check's decision for every principal of holdersWorld, with and
+// without a target and with and without the context a condition reads.
+func TestExplainPermissionMatchesCheck(t *testing.T) {
+	now := time.Date(2026, time.May, 4, 12, 0, 0, 0, time.UTC)
+	w := holdersWorld(now)
+	s, _ := w.newService(now)
+
+	var principals []string
+	for id := range w.users {
+		principals = append(principals, id.String())
+	}
+	for id := range w.accounts {
+		principals = append(principals, id.String())
+	}
+	slices.Sort(principals)
+
+	targets := []ResourceTarget{{}, {Type: "account", ID: "acme/eu/payroll"}, {Type: "account", ID: "globex"}}
+	contexts := []CheckContext{{}, {Request: map[string]any{"channel": "branch"}}}
+	allowed := 0
+	for _, id := range principals {
+		for _, perm := range []string{"payments:read", "payments:write", "reports:read"} {
+			for _, target := range targets {
+				for _, cc := range contexts {
+					check, err := s.CheckPermission(asAdmin(), CheckPermissionInput{
+						UserID: id, AppID: appID, Permission: perm, Target: target, Context: cc,
+					})
+					if err != nil {
+						t.Fatalf("CheckPermission: %v", err)
+					}
+					explain, err := s.ExplainPermission(asAdmin(), ExplainPermissionInput{
+						UserID: id, AppID: appID, Permission: perm, Target: target, Context: cc,
+					})
+					if err != nil {
+						t.Fatalf("ExplainPermission: %v", err)
+					}
+					if explain.Allowed != check.Allowed {
+						t.Errorf("%s %s on %v with %v: explained %v, checked %v",
+							id, perm, target, cc.Request, explain.Allowed, check.Allowed)
+					}
+					if check.Allowed {
+						allowed++
+					}
+				}
+			}
+		}
+	}
+	if allowed == 0 {
+		t.Fatal("no check allowed: the comparison proves nothing")
+	}
+}
+
+func TestExplainPermissionVerdicts(t *testing.T) {
+	now := time.Date(2026, time.May, 4, 12, 0, 0, 0, time.UTC)
+	branch := CheckContext{Request: map[string]any{"channel": "branch"}}
+	acme := ResourceTarget{Type: "account", ID: "acme/eu/payroll"}
+
+	cases := []struct {
+		name    string
+		holder  string
+		perm    string
+		target  ResourceTarget
+		cc      CheckContext
+		path    []access.RoleID
+		want    Verdict
+		match   Match
+		allowed bool
+	}{
+		{"held directly", holder(1), "payments:read", ResourceTarget{}, CheckContext{},
+			[]access.RoleID{roleRead}, VerdictGranted, MatchExact, true},
+		{"through a group and two includes", holder(2), "payments:read", ResourceTarget{}, CheckContext{},
+			[]access.RoleID{roleTop, roleMid, roleRead}, VerdictGranted, MatchExact, true},
+		{"by wildcard", holder(3), "payments:write", ResourceTarget{}, CheckContext{},
+			[]access.RoleID{roleAll}, VerdictGranted, MatchWildcard, true},
+		{"held role disabled", holder(4), "payments:read", ResourceTarget{}, CheckContext{},
+			[]access.RoleID{roleOff, roleRead}, VerdictRoleDisabled, MatchExact, false},
+		{"disabled role on the path", holder(5), "payments:read", ResourceTarget{}, CheckContext{},
+			[]access.RoleID{roleAboveOff, roleOff, roleRead}, VerdictRoleDisabled, MatchExact, false},
+		{"assignment expired", holder(6), "payments:read", ResourceTarget{}, CheckContext{},
+			[]access.RoleID{roleRead}, VerdictNotInWindow, MatchExact, false},
+		{"assignment not yet in force", holder(11), "payments:read", ResourceTarget{}, CheckContext{},
+			[]access.RoleID{roleRead}, VerdictNotInWindow, MatchExact, false},
+		{"condition not met", holder(7), "payments:read", ResourceTarget{}, CheckContext{},
+			[]access.RoleID{roleBranch}, VerdictConditionNotMet, MatchExact, false},
+		{"condition met", holder(7), "payments:read", ResourceTarget{}, branch,
+			[]access.RoleID{roleBranch}, VerdictGranted, MatchExact, true},
+		{"scoped grant without a target", holder(8), "payments:read", ResourceTarget{}, CheckContext{},
+			[]access.RoleID{roleRead}, VerdictOutOfScope, MatchExact, false},
+		{"scoped grant covering the target", holder(8), "payments:read", acme, CheckContext{},
+			[]access.RoleID{roleRead}, VerdictGranted, MatchExact, true},
+		{"other permission", holder(9), "payments:read", ResourceTarget{}, CheckContext{},
+			[]access.RoleID{roleReports}, VerdictNoMatch, MatchNone, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, _ := holdersWorld(now).newService(now)
+			out, err := s.ExplainPermission(asAdmin(), ExplainPermissionInput{
+				UserID: tc.holder, AppID: appID, Permission: tc.perm, Target: tc.target, Context: tc.cc,
+			})
+			if err != nil {
+				t.Fatalf("err = %v", err)
+			}
+			if out.Allowed != tc.allowed {
+				t.Fatalf("allowed = %v, want %v", out.Allowed, tc.allowed)
+			}
+			i := slices.IndexFunc(out.Roles, func(r ExplainedRole) bool { return slices.Equal(r.Path, tc.path) })
+			if i < 0 {
+				t.Fatalf("no role at %v in %+v", tc.path, out.Roles)
+			}
+			perms := out.Roles[i].Permissions
+			if len(perms) != 1 || perms[0].Verdict != tc.want || perms[0].Match != tc.match {
+				t.Fatalf("permissions at %v = %+v, want %s %s", tc.path, perms, tc.match, tc.want)
+			}
+		})
+	}
+}
+
+// TestExplainPermissionHeldHow checks how each held role is reported
+// to be held, and that a blocked user is reported alongside the check's
+// own answer.
+func TestExplainPermissionHeldHow(t *testing.T) {
+	now := time.Date(2026, time.May, 4, 12, 0, 0, 0, time.UTC)
+	w := holdersWorld(now)
+	w.addUser(holder(2), identity.UserStatusBlocked)
+	s, _ := w.newService(now)
+
+	out, err := s.ExplainPermission(asAdmin(), ExplainPermissionInput{UserID: holder(2), AppID: appID, Permission: "payments:read"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !out.Allowed || !slices.Equal(out.Preconditions, []PreconditionFailure{{Subject: "user", Status: "BLOCKED"}}) {
+		t.Fatalf("allowed = %v, preconditions = %v", out.Allowed, out.Preconditions)
+	}
+	if len(out.Roles) != 3 {
+		t.Fatalf("roles = %+v, want the group's role and its two includes", out.Roles)
+	}
+	for _, r := range out.Roles {
+		if r.Direct || !slices.Equal(r.ViaGroups, []access.GroupID{groupTellers}) || r.Scope != nil {
+			t.Fatalf("%s held as direct=%v via %v scope %v", r.RoleID, r.Direct, r.ViaGroups, r.Scope)
+		}
+	}
+
+	out, err = s.ExplainPermission(asAdmin(), ExplainPermissionInput{UserID: botScoped, AppID: appID, Permission: "payments:read"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Preconditions) != 0 || out.Allowed {
+		t.Fatalf("bot: allowed = %v, preconditions = %v", out.Allowed, out.Preconditions)
+	}
+	for _, r := range out.Roles {
+		if r.Scope == nil || r.Scope.ID != "acme/eu" {
+			t.Fatalf("%s scope = %v, want acme/eu", r.RoleID, r.Scope)
+		}
+	}
+}
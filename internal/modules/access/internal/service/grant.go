@@ -2,11 +2,11 @@ package service
 
 import (
 	"context"
+	"time"
 
 	access "sso/internal/modules/access/internal/domain"
 	appdom "sso/internal/modules/app"
 	"sso/internal/modules/audit"
-	"sso/internal/modules/identity"
 	"sso/internal/kernel/actor"
 	"sso/internal/kernel/validation"
 	"sso/internal/modules/role"
@@ -16,10 +16,21 @@ import (
 // GrantRoleToUser
 // ----------------------------------------------------------------------------
 
+// GrantRoleToUserInput.NotBefore / ExpiresAt are optional; nil leaves
+// that side of the window open. The gRPC adapter never sets them — the
+// proto has no fields — so only the HTTP grant endpoints can time-bound
+// an assignment.
+//
+// OverrideSoD lets the grant break a separation-of-duties rule when the
+// caller holds the override permission (see checkSoD). Both adapters
+// set it from the x-sod-override header.
 type GrantRoleToUserInput struct {
-	UserID  string
-	RoleID  string
-	ActorID string // granted_by_user_id; empty until the auth interceptor lands
+	UserID      string
+	RoleID      string
+	ActorID     string // granted_by_user_id; empty until the auth interceptor lands
+	NotBefore   *time.Time
+	ExpiresAt   *time.Time
+	OverrideSoD bool
 }
 
 type GrantRoleToUserOutput struct {
@@ -44,12 +55,16 @@ func (s *Service) GrantRoleToUser(ctx context.Context, in GrantRoleToUserInput)
 	if err != nil {
 		return GrantRoleToUserOutput{}, err
 	}
+	now := s.now().UTC()
+	if err := access.ValidateWindow(in.NotBefore, in.ExpiresAt, now); err != nil {
+		return GrantRoleToUserOutput{}, err
+	}
 
 	aud := audit.BaseFromActor(a, audit.EventTypeAccessGrantRoleToUser)
 	aud.SubjectType = audit.SubjectTypeUser
 	aud.SubjectID = uid.String()
 
-	// Preconditions: role active, user active. Role lookup also gives us
+	// Preconditions: role active, principal active. Role lookup also gives us
 	// the app_id to denormalise into the assignment row.
 	r, err := s.loadActiveRoleInApp(ctx, role.RoleID(rid), nil)
 	if err != nil {
@@ -59,18 +74,30 @@ func (s *Service) GrantRoleToUser(ctx context.Context, in GrantRoleToUserInput)
 	}
 	aud.AppID = r.AppID().String()
 
-	if err := s.requireUserEligible(ctx, identity.UserID(uid)); err != nil {
+	principal, err := s.requireEligiblePrincipal(inTenant(ctx, r.TenantID()), uid)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return GrantRoleToUserOutput{}, err
+	}
+	aud.SubjectType = subjectType(principal)
+
+	broken, err := s.checkSoD(ctx, a, principal, access.AppID(r.AppID().String()), []access.RoleID{rid}, in.OverrideSoD, now)
+	aud = withSoDMetadata(aud, broken, err == nil)
+	if err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return GrantRoleToUserOutput{}, err
 	}
 
 	target := access.NewRoleAssignment(access.NewRoleAssignmentParams{
-		UserID:          uid,
+		Principal:       principal,
 		RoleID:          rid,
 		AppID:           access.AppID(r.AppID().String()),
 		GrantedByUserID: actorID,
-		Now:             s.now().UTC(),
+		NotBefore:       in.NotBefore,
+		ExpiresAt:       in.ExpiresAt,
+		Now:             now,
 	})
 
 	created, err := s.repo.Create(ctx, target)
@@ -81,8 +108,9 @@ func (s *Service) GrantRoleToUser(ctx context.Context, in GrantRoleToUserInput)
 	}
 	if !created {
 		// Idempotent re-grant: surface the original row so the caller
-		// can read the canonical granted_at and granted_by_user_id.
-		existing, err := s.repo.Get(ctx, uid, rid)
+		// can read the canonical granted_at and granted_by_user_id. The
+		// existing window is kept; ExtendRoleAssignment moves it.
+		existing, err := s.repo.Get(ctx, principal, rid)
 		if err != nil {
 			out, reason := classifyError(err)
 			s.auditor.Emit(ctx, withOutcome(aud, out, reason))
@@ -92,6 +120,7 @@ func (s *Service) GrantRoleToUser(ctx context.Context, in GrantRoleToUserInput)
 		return GrantRoleToUserOutput{Assignment: existing, Created: false}, nil
 	}
 
+	s.version.Bump(ctx)
 	s.auditor.Success(ctx, aud)
 	return GrantRoleToUserOutput{Assignment: target, Created: true}, nil
 }
@@ -100,11 +129,18 @@ func (s *Service) GrantRoleToUser(ctx context.Context, in GrantRoleToUserInput)
 // BulkGrantRoles — atomic, all-or-nothing
 // ----------------------------------------------------------------------------
 
+// BulkGrantRolesInput's window, when set, applies to every role in
+// the batch. The batch is checked against the separation-of-duties
+// rules as a whole, so two exclusive roles cannot be granted together
+// either; OverrideSoD is as for GrantRoleToUser.
 type BulkGrantRolesInput struct {
-	UserID  string
-	AppID   string
-	RoleIDs []string
-	ActorID string
+	UserID      string
+	AppID       string
+	RoleIDs     []string
+	ActorID     string
+	NotBefore   *time.Time
+	ExpiresAt   *time.Time
+	OverrideSoD bool
 }
 
 type BulkGrantRolesOutput struct {
@@ -123,6 +159,10 @@ func (s *Service) BulkGrantRoles(ctx context.Context, in BulkGrantRolesInput) (B
 	if err != nil {
 		return BulkGrantRolesOutput{}, err
 	}
+	now := s.now().UTC()
+	if err := access.ValidateWindow(in.NotBefore, in.ExpiresAt, now); err != nil {
+		return BulkGrantRolesOutput{}, err
+	}
 
 	aud := audit.BaseFromActor(a, audit.EventTypeAccessBulkGrantRoles)
 	aud.SubjectType = audit.SubjectTypeUser
@@ -132,16 +172,19 @@ func (s *Service) BulkGrantRoles(ctx context.Context, in BulkGrantRolesInput) (B
 	// All preconditions (existence/eligibility, role-in-app, role-active)
 	// are validated up front so a mid-batch failure cannot leave a
 	// half-applied state.
-	if err := s.requireAppExists(ctx, appdom.AppID(aid)); err != nil {
+	ap, err := s.loadApp(ctx, appdom.AppID(aid))
+	if err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return BulkGrantRolesOutput{}, err
 	}
-	if err := s.requireUserEligible(ctx, identity.UserID(uid)); err != nil {
+	principal, err := s.requireEligiblePrincipal(inTenant(ctx, ap.TenantID()), uid)
+	if err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return BulkGrantRolesOutput{}, err
 	}
+	aud.SubjectType = subjectType(principal)
 	expectedAppID := role.AppID(aid)
 	for _, rid := range ridsTyped {
 		if _, err := s.loadActiveRoleInApp(ctx, role.RoleID(rid), &expectedAppID); err != nil {
@@ -150,15 +193,23 @@ func (s *Service) BulkGrantRoles(ctx context.Context, in BulkGrantRolesInput) (B
 			return BulkGrantRolesOutput{}, err
 		}
 	}
+	broken, err := s.checkSoD(ctx, a, principal, aid, ridsTyped, in.OverrideSoD, now)
+	aud = withSoDMetadata(aud, broken, err == nil)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return BulkGrantRolesOutput{}, err
+	}
 
-	now := s.now().UTC()
 	assignments := make([]*access.RoleAssignment, len(ridsTyped))
 	for i, rid := range ridsTyped {
 		assignments[i] = access.NewRoleAssignment(access.NewRoleAssignmentParams{
-			UserID:          uid,
+			Principal:       principal,
 			RoleID:          rid,
 			AppID:           aid,
 			GrantedByUserID: actorID,
+			NotBefore:       in.NotBefore,
+			ExpiresAt:       in.ExpiresAt,
 			Now:             now,
 		})
 	}
@@ -169,6 +220,7 @@ func (s *Service) BulkGrantRoles(ctx context.Context, in BulkGrantRolesInput) (B
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return BulkGrantRolesOutput{}, err
 	}
+	s.version.Bump(ctx)
 
 	// For the entries that were "already there" (createdMask[i]=false),
 	// fetch the canonical row so the response carries the original
@@ -177,7 +229,7 @@ func (s *Service) BulkGrantRoles(ctx context.Context, in BulkGrantRolesInput) (B
 		if was {
 			continue
 		}
-		existing, gerr := s.repo.Get(ctx, uid, ridsTyped[i])
+		existing, gerr := s.repo.Get(ctx, principal, ridsTyped[i])
 		if gerr != nil {
 			// Should not happen — we just observed a duplicate-key for
 			// this pair — but propagate anything weird honestly.
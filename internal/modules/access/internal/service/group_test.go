@@ -0,0 +1,148 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	access "sso/internal/modules/access/internal/domain"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity"
+)
+
+const (
+	roleClerk    = "0190b6f2-8a43-7c1e-9d2a-00000000b301"
+	roleRetired  = "0190b6f2-8a43-7c1e-9d2a-00000000b302"
+	groupClerks  = "0190b6f2-8a43-7c1e-9d2a-00000000d301"
+	groupNight   = "0190b6f2-8a43-7c1e-9d2a-00000000d302"
+	groupUnknown = "0190b6f2-8a43-7c1e-9d2a-00000000d3ff"
+	userAnn      = "0190b6f2-8a43-7c1e-9d2a-00000000c301"
+	userBen      = "0190b6f2-8a43-7c1e-9d2a-00000000c302"
+	userCal      = "0190b6f2-8a43-7c1e-9d2a-00000000c303"
+	userDee      = "0190b6f2-8a43-7c1e-9d2a-00000000c304"
+	userEli      = "0190b6f2-8a43-7c1e-9d2a-00000000c305"
+)
+
+// groupWorld has ann, ben, dee and eli in the clerks group, dee also on
+// the night shift, cal in no group, and eli holding roleClerk directly.
+// No group holds a role yet.
+func groupWorld() *world {
+	w := newWorld()
+	w.addRole(roleClerk, roleSpec{perms: []string{"payments:read"}})
+	w.addRole(roleRetired, roleSpec{perms: []string{"payments:read"}, disabled: true})
+	for _, id := range []string{userAnn, userBen, userCal, userDee, userEli} {
+		w.addUser(id, identity.UserStatusActive)
+	}
+	w.addGroup(groupClerks, userAnn, userBen, userDee, userEli)
+	w.addGroup(groupNight, userDee)
+	w.assign(access.UserPrincipal(userEli), roleClerk, nil)
+	return w
+}
+
+// holdsClerk reports whether the user holds roleClerk, failing the
+// test when CheckPermission and HasRoleInApp disagree about it.
+func holdsClerk(t *testing.T, s *Service, id string) bool {
+	t.Helper()
+	check, err := s.CheckPermission(asAdmin(), CheckPermissionInput{UserID: id, AppID: appID, Permission: "payments:read"})
+	if err != nil {
+		t.Fatalf("CheckPermission(%s): %v", id, err)
+	}
+	has, err := s.HasRoleInApp(asAdmin(), HasRoleInAppInput{UserID: id, RoleID: roleClerk})
+	if err != nil {
+		t.Fatalf("HasRoleInApp(%s): %v", id, err)
+	}
+	if check.Allowed != has {
+		t.Fatalf("%s: allowed = %v, holds the role = %v", id, check.Allowed, has)
+	}
+	return has
+}
+
+func TestGrantRoleToGroupReachesMembers(t *testing.T) {
+	w := groupWorld()
+	s, em := w.newService(time.Now())
+
+	out, err := s.GrantRoleToGroup(asAdmin(), GrantRoleToGroupInput{GroupID: groupClerks, RoleID: roleClerk})
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if ev := em.only(t); !out.Created || ev.Outcome() != audit.OutcomeSuccess || ev.AppID() != appID {
+		t.Fatalf("created = %v, audit = %v for app %s", out.Created, ev.Outcome(), ev.AppID())
+	}
+
+	want := map[string]bool{userAnn: true, userBen: true, userCal: false, userDee: true, userEli: true}
+	for id, held := range want {
+		if got := holdsClerk(t, s, id); got != held {
+			t.Errorf("%s holds roleClerk = %v, want %v", id, got, held)
+		}
+		direct, err := s.HasDirectRole(asAdmin(), HasRoleInAppInput{UserID: id, RoleID: roleClerk})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if direct != (id == userEli) {
+			t.Errorf("%s holds roleClerk directly = %v", id, direct)
+		}
+	}
+
+	// A member who joins later holds the role from then on.
+	w.addGroup(groupClerks, userCal)
+	if !holdsClerk(t, s, userCal) {
+		t.Fatal("a new member of the group does not hold its role")
+	}
+
+	// Granting again is a no-op.
+	out, err = s.GrantRoleToGroup(asAdmin(), GrantRoleToGroupInput{GroupID: groupClerks, RoleID: roleClerk})
+	if err != nil || out.Created || len(w.groupGrants) != 1 {
+		t.Fatalf("regrant: created = %v, err = %v, %d grants", out.Created, err, len(w.groupGrants))
+	}
+}
+
+// TestRemoveRoleFromGroup checks that removing a group's grant takes
+// the role from its members unless they hold it some other way.
+func TestRemoveRoleFromGroup(t *testing.T) {
+	w := groupWorld()
+	w.grantGroup(groupClerks, roleClerk)
+	w.grantGroup(groupNight, roleClerk)
+	s, _ := w.newService(time.Now())
+
+	for range 2 { // idempotent
+		if err := s.RemoveRoleFromGroup(asAdmin(), RemoveRoleFromGroupInput{GroupID: groupClerks, RoleID: roleClerk}); err != nil {
+			t.Fatalf("err = %v", err)
+		}
+	}
+	want := map[string]bool{userAnn: false, userBen: false, userDee: true, userEli: true}
+	for id, held := range want {
+		if got := holdsClerk(t, s, id); got != held {
+			t.Errorf("%s holds roleClerk = %v, want %v", id, got, held)
+		}
+	}
+}
+
+func TestGrantRoleToGroupPreconditions(t *testing.T) {
+	cases := []struct {
+		name    string
+		group   string
+		role    string
+		want    error
+		outcome audit.AuditOutcome
+		reason  string
+	}{
+		{"unknown group", groupUnknown, roleClerk, access.ErrGroupNotFound, audit.OutcomeFailure, audit.ReasonGroupNotFound},
+		{"disabled role", groupClerks, roleRetired, access.ErrRoleDisabled, audit.OutcomeDenied, audit.ReasonRoleDisabled},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := groupWorld()
+			s, em := w.newService(time.Now())
+			_, err := s.GrantRoleToGroup(asAdmin(), GrantRoleToGroupInput{GroupID: tc.group, RoleID: tc.role})
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("err = %v, want %v", err, tc.want)
+			}
+			if len(w.groupGrants) != 0 {
+				t.Fatalf("stored %d grants", len(w.groupGrants))
+			}
+			if ev := em.only(t); ev.Outcome() != tc.outcome || ev.Reason() != tc.reason {
+				t.Fatalf("audit = %v %s, want %v %s", ev.Outcome(), ev.Reason(), tc.outcome, tc.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"sso/internal/kernel/validation"
+	access "sso/internal/modules/access/internal/domain"
+	"sso/internal/modules/identity"
+)
+
+const (
+	roleRead     = "0190b6f2-8a43-7c1e-9d2a-00000000b101"
+	roleAll      = "0190b6f2-8a43-7c1e-9d2a-00000000b102"
+	roleMid      = "0190b6f2-8a43-7c1e-9d2a-00000000b103"
+	roleTop      = "0190b6f2-8a43-7c1e-9d2a-00000000b104"
+	roleOff      = "0190b6f2-8a43-7c1e-9d2a-00000000b105"
+	roleAboveOff = "0190b6f2-8a43-7c1e-9d2a-00000000b106"
+	roleBranch   = "0190b6f2-8a43-7c1e-9d2a-00000000b107"
+	roleReports  = "0190b6f2-8a43-7c1e-9d2a-00000000b108"
+	groupTellers = "0190b6f2-8a43-7c1e-9d2a-00000000d101"
+	groupAudit   = "0190b6f2-8a43-7c1e-9d2a-00000000d102"
+	botReader    = "0190b6f2-8a43-7c1e-9d2a-00000000e101"
+	botScoped    = "0190b6f2-8a43-7c1e-9d2a-00000000e102"
+	branchOnly   = `request.channel == "branch"`
+)
+
+// holder is the id of the n-th user of holdersWorld.
+func holder(n int) string {
+	return fmt.Sprintf("0190b6f2-8a43-7c1e-9d2a-0000000001%02d", n)
+}
+
+// holdersWorld grants the payments permissions every way there is:
+// exactly and by wildcard, through includes, groups, service accounts
+// and scoped grants, under a condition, and through roles or windows
+// that do not count.
+func holdersWorld(now time.Time) *world {
+	w := newWorld()
+	w.addRole(roleRead, roleSpec{perms: []string{"payments:read"}})
+	w.addRole(roleAll, roleSpec{perms: []string{"payments:*"}})
+	w.addRole(roleMid, roleSpec{includes: []string{roleRead}})
+	w.addRole(roleTop, roleSpec{includes: []string{roleMid}})
+	w.addRole(roleOff, roleSpec{includes: []string{roleRead}, disabled: true})
+	w.addRole(roleAboveOff, roleSpec{includes: []string{roleOff}})
+	w.addRole(roleBranch, roleSpec{perms: []string{"payments:read"}, conditions: map[string]string{"payments:read": branchOnly}})
+	w.addRole(roleReports, roleSpec{perms: []string{"reports:read"}})
+	for n := 1; n <= 11; n++ {
+		w.addUser(holder(n), identity.UserStatusActive)
+	}
+	w.addServiceAccount(botReader)
+	w.addServiceAccount(botScoped)
+
+	past, later := now.Add(-time.Hour), now.Add(time.Hour)
+	u := func(n int) access.Principal { return access.UserPrincipal(access.UserID(holder(n))) }
+	w.assign(u(1), roleRead, nil)
+	w.grantGroup(groupTellers, roleTop, holder(2))
+	w.assign(u(3), roleAll, nil)
+	w.assign(u(4), roleOff, nil)
+	w.assign(u(5), roleAboveOff, nil)
+	w.assign(u(6), roleRead, &past)
+	w.assign(u(7), roleBranch, nil)
+	w.assignScoped(u(8), roleRead, access.ResourceScope{Type: "account", ID: "acme"})
+	w.assign(u(9), roleReports, nil)
+	w.assign(u(10), roleBranch, nil)
+	w.assign(u(10), roleMid, nil)
+	w.assignments = append(w.assignments, &access.RoleAssignment{
+		Principal: u(11), RoleID: roleRead, AppID: appID, NotBefore: &later,
+	})
+	w.assign(access.ServiceAccountPrincipal(botReader), roleMid, nil)
+	w.assignScoped(access.ServiceAccountPrincipal(botScoped), roleTop, access.ResourceScope{Type: "account", ID: "acme/eu"})
+	return w
+}
+
+// listAll pages through ListPrincipalsWithPermission.
+func listAll(t *testing.T, s *Service, perm string, pageSize int32) ([]access.PermissionHolder, int) {
+	t.Helper()
+	var (
+		out   []access.PermissionHolder
+		token string
+		pages int
+	)
+	for {
+		res, err := s.ListPrincipalsWithPermission(asAdmin(), ListPrincipalsWithPermissionInput{
+			AppID: appID, Permission: perm, PageSize: pageSize, PageToken: token,
+		})
+		if err != nil {
+			t.Fatalf("ListPrincipalsWithPermission: %v", err)
+		}
+		if len(res.Holders) > int(pageSize) {
+			t.Fatalf("page of %d, want at most %d", len(res.Holders), pageSize)
+		}
+		out = append(out, res.Holders...)
+		pages++
+		if res.NextPageToken == "" {
+			return out, pages
+		}
+		token = res.NextPageToken
+	}
+}
+
+// TestListPrincipalsWithPermissionMatchesCheck checks the reverse query
+// against CheckPermission for every principal of the world: a holder
+// listed app-wide and unconditionally is allowed with no target, a
+// scoped holder on a resource its scope covers, a conditional one when
+// the condition holds, and nobody else.
+func TestListPrincipalsWithPermissionMatchesCheck(t *testing.T) {
+	now := time.Date(2026, time.May, 4, 12, 0, 0, 0, time.UTC)
+	w := holdersWorld(now)
+	s, _ := w.newService(now)
+	target := ResourceTarget{Type: "account", ID: "acme/eu/payroll"}
+
+	wantHolders := map[string][]string{
+		"payments:read": {
+			holder(1), holder(2), holder(3), holder(7), holder(8), holder(10), botReader, botScoped,
+		},
+		"payments:write": {holder(3)},
+		"reports:read":   {holder(9)},
+	}
+	var principals []string
+	for id := range w.users {
+		principals = append(principals, id.String())
+	}
+	for id := range w.accounts {
+		principals = append(principals, id.String())
+	}
+	slices.Sort(principals)
+
+	for perm, want := range wantHolders {
+		t.Run(perm, func(t *testing.T) {
+			holders, _ := listAll(t, s, perm, 100)
+			listed := map[string]access.PermissionHolder{}
+			var ids []string
+			for _, h := range holders {
+				listed[h.Principal.ID] = h
+				ids = append(ids, h.Principal.ID)
+			}
+			if !slices.Equal(ids, want) {
+				t.Fatalf("holders = %v, want %v", ids, want)
+			}
+			if h, ok := listed[botReader]; ok && h.Principal.Kind != access.PrincipalKindServiceAccount {
+				t.Fatalf("bot listed as %v", h.Principal.Kind)
+			}
+
+			for _, id := range principals {
+				h, ok := listed[id]
+				wantPlain := ok && h.AppWide && !h.Conditional
+				check := func(target ResourceTarget, cc CheckContext) bool {
+					t.Helper()
+					res, err := s.CheckPermission(asAdmin(), CheckPermissionInput{
+						UserID: id, AppID: appID, Permission: perm, Target: target, Context: cc,
+					})
+					if err != nil {
+						t.Fatalf("CheckPermission(%s): %v", id, err)
+					}
+					return res.Allowed
+				}
+				if got := check(ResourceTarget{}, CheckContext{}); got != wantPlain {
+					t.Errorf("%s: allowed = %v, listed %+v", id, got, h)
+				}
+				if got, want := check(target, CheckContext{}), wantPlain || (ok && h.Scoped); got != want {
+					t.Errorf("%s on %s: allowed = %v, listed %+v", id, target.ID, got, h)
+				}
+				branch := CheckContext{Request: map[string]any{"channel": "branch"}}
+				if got, want := check(ResourceTarget{}, branch), ok && h.AppWide; got != want {
+					t.Errorf("%s at a branch: allowed = %v, listed %+v", id, got, h)
+				}
+			}
+		})
+	}
+}
+
+func TestListPrincipalsWithPermissionPages(t *testing.T) {
+	now := time.Date(2026, time.May, 4, 12, 0, 0, 0, time.UTC)
+	s, _ := holdersWorld(now).newService(now)
+
+	all, _ := listAll(t, s, "payments:read", 100)
+	for _, size := range []int32{1, 3, 8} {
+		paged, pages := listAll(t, s, "payments:read", size)
+		if !slices.Equal(paged, all) {
+			t.Fatalf("page size %d: %v, want %v", size, paged, all)
+		}
+		if want := (len(all) + int(size) - 1) / int(size); pages != want {
+			t.Fatalf("page size %d: %d pages, want %d", size, pages, want)
+		}
+	}
+
+	var vErr *validation.Error
+	_, err := s.ListPrincipalsWithPermission(asAdmin(), ListPrincipalsWithPermissionInput{
+		AppID: appID, Permission: "payments:read", PageToken: "not-a-token",
+	})
+	if !errors.As(err, &vErr) || vErr.Field != "page_token" {
+		t.Fatalf("err = %v, want a page_token validation error", err)
+	}
+}
+
+// TestListRoleMembersMatchesHasRoleInApp checks the members listed,
+// across pages, against HasRoleInApp for every principal: direct
+// holders inside their window and group members, a DISABLED role's
+// too, and not the holders of roles including it.
+func TestListRoleMembersMatchesHasRoleInApp(t *testing.T) {
+	now := time.Date(2026, time.May, 4, 12, 0, 0, 0, time.UTC)
+	w := holdersWorld(now)
+	w.grantGroup(groupAudit, roleRead, holder(1), holder(2))
+	s, _ := w.newService(now)
+
+	wantMembers := map[string][]string{
+		roleRead: {holder(1), holder(2)},
+		roleTop:  {holder(2)},
+		roleMid:  {holder(10), botReader},
+		roleOff:  {holder(4)},
+	}
+	for rid, want := range wantMembers {
+		var (
+			members []access.RoleMember
+			token   string
+		)
+		for {
+			res, err := s.ListRoleMembers(asAdmin(), ListRoleMembersInput{RoleID: rid, PageSize: 1, PageToken: token})
+			if err != nil {
+				t.Fatalf("ListRoleMembers(%s): %v", rid, err)
+			}
+			members = append(members, res.Members...)
+			if token = res.NextPageToken; token == "" {
+				break
+			}
+		}
+		listed := map[string]access.RoleMember{}
+		var ids []string
+		for _, m := range members {
+			listed[m.Principal.ID] = m
+			ids = append(ids, m.Principal.ID)
+		}
+		if !slices.Equal(ids, want) {
+			t.Fatalf("members of %s = %v, want %v", rid, ids, want)
+		}
+		for id := range w.users {
+			got, err := s.HasRoleInApp(asAdmin(), HasRoleInAppInput{UserID: id.String(), RoleID: rid})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := listed[id.String()]; got != ok {
+				t.Errorf("%s holds %s = %v, listed %v", id, rid, got, ok)
+			}
+		}
+	}
+
+	// Holder 1 holds roleRead both ways; holder 2 only through groups.
+	res, err := s.ListRoleMembers(asAdmin(), ListRoleMembersInput{RoleID: roleRead, PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := res.Members[0]; !m.Direct || !slices.Equal(m.ViaGroups, []access.GroupID{groupAudit}) {
+		t.Fatalf("holder 1 = %+v", m)
+	}
+	if m := res.Members[1]; m.Direct || !slices.Equal(m.ViaGroups, []access.GroupID{groupAudit}) {
+		t.Fatalf("holder 2 = %+v", m)
+	}
+}
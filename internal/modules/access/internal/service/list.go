@@ -7,7 +7,6 @@ import (
 	access "sso/internal/modules/access/internal/domain"
 	appdom "sso/internal/modules/app"
 	"sso/internal/modules/audit/auditx"
-	"sso/internal/modules/identity"
 	"sso/internal/kernel/cursor"
 	"sso/internal/kernel/validation"
 	"sso/internal/modules/role"
@@ -26,13 +25,24 @@ type ListUserRolesInput struct {
 }
 
 type ListUserRolesOutput struct {
-	// Roles assigned to the user in the target app. Order matches the
-	// requested OrderBy; DISABLED roles are included (proto requires it).
-	Roles         []*role.Role
+	// Roles assigned to the user in the target app, directly or through
+	// a group. Order matches the requested OrderBy; DISABLED roles are
+	// included (proto requires it).
+	Roles []*role.Role
+	// Sources[i] says how the user holds Roles[i]. The proto has no
+	// field for it; the HTTP surface renders it.
+	Sources       []RoleSource
 	NextPageToken string
 	TotalSize     *int
 }
 
+// RoleSource is the provenance of one listed role. Direct and ViaGroups
+// are not exclusive: a role granted both ways reports both.
+type RoleSource struct {
+	Direct    bool
+	ViaGroups []access.GroupID
+}
+
 func (s *Service) ListUserRoles(ctx context.Context, in ListUserRolesInput) (ListUserRolesOutput, error) {
 	uid, err := access.ParseUserID(in.UserID)
 	if err != nil {
@@ -43,7 +53,8 @@ func (s *Service) ListUserRoles(ctx context.Context, in ListUserRolesInput) (Lis
 		return ListUserRolesOutput{}, err
 	}
 
-	if err := s.requireUserExists(ctx, identity.UserID(uid)); err != nil {
+	principal, err := s.requirePrincipal(ctx, uid)
+	if err != nil {
 		return ListUserRolesOutput{}, err
 	}
 	// app.AppID and access.AppID are both `type X string` — direct conv ok.
@@ -62,11 +73,11 @@ func (s *Service) ListUserRoles(ctx context.Context, in ListUserRolesInput) (Lis
 	}
 
 	res, err := s.repo.ListUserRoles(ctx, access.ListUserRolesQuery{
-		UserID:   uid,
-		AppID:    aid,
-		PageSize: pageSize,
-		After:    after,
-		OrderBy:  in.OrderBy,
+		Principal: principal,
+		AppID:     aid,
+		PageSize:  pageSize,
+		After:     after,
+		OrderBy:   in.OrderBy,
 	})
 	if err != nil {
 		return ListUserRolesOutput{}, err
@@ -76,6 +87,7 @@ func (s *Service) ListUserRoles(ctx context.Context, in ListUserRolesInput) (Lis
 	// access bounded context unaware of role internals (permissions,
 	// status names, etc.).
 	roles := make([]*role.Role, 0, len(res.Rows))
+	sources := make([]RoleSource, 0, len(res.Rows))
 	for _, row := range res.Rows {
 		r, err := s.roles.GetByID(ctx, role.RoleID(row.RoleID))
 		if err != nil {
@@ -86,6 +98,7 @@ func (s *Service) ListUserRoles(ctx context.Context, in ListUserRolesInput) (Lis
 			continue
 		}
 		roles = append(roles, r)
+		sources = append(sources, RoleSource{Direct: row.Direct, ViaGroups: row.ViaGroups})
 	}
 
 	nextToken, err := encodeCursor(res.NextCursor)
@@ -94,6 +107,7 @@ func (s *Service) ListUserRoles(ctx context.Context, in ListUserRolesInput) (Lis
 	}
 	return ListUserRolesOutput{
 		Roles:         roles,
+		Sources:       sources,
 		NextPageToken: nextToken,
 		TotalSize:     res.TotalSize,
 	}, nil
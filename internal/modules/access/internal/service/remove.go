@@ -6,7 +6,6 @@ import (
 	access "sso/internal/modules/access/internal/domain"
 	appdom "sso/internal/modules/app"
 	"sso/internal/modules/audit"
-	"sso/internal/modules/identity"
 	"sso/internal/kernel/actor"
 )
 
@@ -41,11 +40,13 @@ func (s *Service) RemoveRoleFromUser(ctx context.Context, in RemoveRoleFromUserI
 	aud.SubjectType = audit.SubjectTypeUser
 	aud.SubjectID = uid.String()
 
-	if err := s.requireUserExists(ctx, identity.UserID(uid)); err != nil {
+	principal, err := s.requirePrincipal(ctx, uid)
+	if err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return err
 	}
+	aud.SubjectType = subjectType(principal)
 	// Role must exist (any status). Disabled roles are still removable.
 	r, err := s.loadAnyRole(ctx, rid)
 	if err != nil {
@@ -55,11 +56,12 @@ func (s *Service) RemoveRoleFromUser(ctx context.Context, in RemoveRoleFromUserI
 	}
 	aud.AppID = r.AppID().String()
 
-	if _, err := s.repo.Delete(ctx, uid, rid); err != nil {
+	if _, err := s.repo.Delete(ctx, principal, rid); err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return err
 	}
+	s.version.Bump(ctx)
 
 	s.auditor.Success(ctx, aud)
 	return nil
@@ -95,11 +97,13 @@ func (s *Service) BulkRemoveRoles(ctx context.Context, in BulkRemoveRolesInput)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return err
 	}
-	if err := s.requireUserExists(ctx, identity.UserID(uid)); err != nil {
+	principal, err := s.requirePrincipal(ctx, uid)
+	if err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return err
 	}
+	aud.SubjectType = subjectType(principal)
 
 	// Cross-app safety: every role_id must belong to the requested app.
 	// Disabled roles are still removable, so we don't gate on status.
@@ -117,11 +121,12 @@ func (s *Service) BulkRemoveRoles(ctx context.Context, in BulkRemoveRolesInput)
 		}
 	}
 
-	if err := s.repo.BulkDelete(ctx, uid, ridsTyped); err != nil {
+	if err := s.repo.BulkDelete(ctx, principal, ridsTyped); err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return err
 	}
+	s.version.Bump(ctx)
 
 	s.auditor.Success(ctx, aud)
 	return nil
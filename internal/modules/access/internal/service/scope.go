@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"sso/internal/kernel/actor"
+	access "sso/internal/modules/access/internal/domain"
+	appdom "sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/role"
+)
+
+// ResourceTarget names the resource instance a check is about. Both
+// fields empty means "no particular resource": only app-wide grants
+// can satisfy such a check.
+type ResourceTarget struct {
+	Type string
+	ID   string
+}
+
+// parse returns nil for the empty target.
+func (t ResourceTarget) parse() (*access.ResourceScope, error) {
+	if t.Type == "" && t.ID == "" {
+		return nil, nil
+	}
+	scope, err := access.ParseResourceScope(t.Type, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &scope, nil
+}
+
+// matchScope drops the scoped rows that do not cover target. App-wide
+// rows always stay; with no target, every scoped row goes. rows is
+// filtered in place.
+func matchScope(rows []access.PermissionRow, target *access.ResourceScope) []access.PermissionRow {
+	out := rows[:0]
+	for _, row := range rows {
+		if row.Scope == nil || (target != nil && row.Scope.Covers(*target)) {
+			out = append(out, row)
+		}
+	}
+	return out
+}
+
+// ----------------------------------------------------------------------------
+// GrantScopedRole
+// ----------------------------------------------------------------------------
+
+type GrantScopedRoleInput struct {
+	UserID       string
+	RoleID       string
+	ResourceType string
+	ResourceID   string
+}
+
+type GrantScopedRoleOutput struct {
+	Assignment *access.ScopedRoleAssignment
+	Created    bool
+}
+
+// GrantScopedRole gives the principal the role's permissions on one
+// resource scope (and everything below it) instead of app-wide.
+// UserID names a user or a service account; preconditions and
+// idempotency follow GrantRoleToUser.
+func (s *Service) GrantScopedRole(ctx context.Context, in GrantScopedRoleInput) (GrantScopedRoleOutput, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return GrantScopedRoleOutput{}, err
+	}
+	uid, err := access.ParseUserID(in.UserID)
+	if err != nil {
+		return GrantScopedRoleOutput{}, err
+	}
+	rid, err := access.ParseRoleID(in.RoleID)
+	if err != nil {
+		return GrantScopedRoleOutput{}, err
+	}
+	scope, err := access.ParseResourceScope(in.ResourceType, in.ResourceID)
+	if err != nil {
+		return GrantScopedRoleOutput{}, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessGrantScopedRole)
+	aud.SubjectType = audit.SubjectTypeUser
+	aud.SubjectID = uid.String()
+	aud.Metadata = map[string]string{
+		"role_id":       rid.String(),
+		"resource_type": scope.Type,
+		"resource_id":   scope.ID,
+	}
+
+	r, err := s.loadActiveRoleInApp(ctx, role.RoleID(rid), nil)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return GrantScopedRoleOutput{}, err
+	}
+	aud.AppID = r.AppID().String()
+
+	principal, err := s.requireEligiblePrincipal(inTenant(ctx, r.TenantID()), uid)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return GrantScopedRoleOutput{}, err
+	}
+	aud.SubjectType = subjectType(principal)
+
+	target := access.NewScopedRoleAssignment(access.NewScopedRoleAssignmentParams{
+		Principal:       principal,
+		RoleID:          rid,
+		AppID:           access.AppID(r.AppID().String()),
+		Scope:           scope,
+		GrantedByUserID: access.ActorID(a.ID),
+		Now:             s.now().UTC(),
+	})
+
+	created, err := s.repo.CreateScopedAssignment(ctx, target)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return GrantScopedRoleOutput{}, err
+	}
+	if !created {
+		existing, err := s.repo.GetScopedAssignment(ctx, principal, rid, scope)
+		if err != nil {
+			out, reason := classifyError(err)
+			s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+			return GrantScopedRoleOutput{}, err
+		}
+		s.auditor.Success(ctx, aud)
+		return GrantScopedRoleOutput{Assignment: existing, Created: false}, nil
+	}
+
+	s.version.Bump(ctx)
+	s.auditor.Success(ctx, aud)
+	return GrantScopedRoleOutput{Assignment: target, Created: true}, nil
+}
+
+// ----------------------------------------------------------------------------
+// RemoveScopedRole
+// ----------------------------------------------------------------------------
+
+type RemoveScopedRoleInput struct {
+	UserID       string
+	RoleID       string
+	ResourceType string
+	ResourceID   string
+}
+
+// RemoveScopedRole is idempotent like RemoveRoleFromUser. It removes
+// the grant on exactly this scope: grants on scopes below or above it
+// are separate and stay.
+func (s *Service) RemoveScopedRole(ctx context.Context, in RemoveScopedRoleInput) error {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return err
+	}
+	uid, err := access.ParseUserID(in.UserID)
+	if err != nil {
+		return err
+	}
+	rid, err := access.ParseRoleID(in.RoleID)
+	if err != nil {
+		return err
+	}
+	scope, err := access.ParseResourceScope(in.ResourceType, in.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessRemoveScopedRole)
+	aud.SubjectType = audit.SubjectTypeUser
+	aud.SubjectID = uid.String()
+	aud.Metadata = map[string]string{
+		"role_id":       rid.String(),
+		"resource_type": scope.Type,
+		"resource_id":   scope.ID,
+	}
+
+	principal, err := s.requirePrincipal(ctx, uid)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return err
+	}
+	aud.SubjectType = subjectType(principal)
+	r, err := s.loadAnyRole(ctx, rid)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return err
+	}
+	aud.AppID = r.AppID().String()
+
+	if _, err := s.repo.DeleteScopedAssignment(ctx, principal, rid, scope); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return err
+	}
+	s.version.Bump(ctx)
+
+	s.auditor.Success(ctx, aud)
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// ListScopedRoles
+// ----------------------------------------------------------------------------
+
+type ListScopedRolesInput struct {
+	UserID string
+	AppID  string
+}
+
+// ListScopedRoles returns the principal's scoped grants in the app,
+// ordered by scope. Not paginated: scoped grants are expected to number
+// in the tens per principal and app.
+func (s *Service) ListScopedRoles(ctx context.Context, in ListScopedRolesInput) ([]*access.ScopedRoleAssignment, error) {
+	uid, aid, err := s.parseUserApp(ctx, in.UserID, in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	principal, err := s.requirePrincipal(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListScopedAssignments(ctx, principal, aid)
+}
+
+// ----------------------------------------------------------------------------
+// ListPermittedResources
+// ----------------------------------------------------------------------------
+
+type ListPermittedResourcesInput struct {
+	UserID       string
+	AppID        string
+	Permission   string
+	ResourceType string // optional; "" = every type
+}
+
+// PermittedResource is one scope the user may exercise the permission
+// on. Conditional is set when every grant covering it carries a
+// condition, so the answer for a concrete request depends on its
+// context.
+type PermittedResource struct {
+	Scope       access.ResourceScope
+	Conditional bool
+}
+
+// ListPermittedResourcesOutput.AppWide reports an app-wide grant of the
+// permission, which covers every resource whether or not it is listed;
+// AppWideConditional qualifies it the same way PermittedResource's
+// flag does.
+type ListPermittedResourcesOutput struct {
+	AppWide            bool
+	AppWideConditional bool
+	Resources          []PermittedResource
+}
+
+// ListPermittedResources answers "which resources can the user act on
+// with this permission": the scopes of the user's scoped grants whose
+// roles carry it, directly, by wildcard or through includes. A scope
+// covered by a broader listed scope is still listed, so callers see
+// every grant point. Conditions are not evaluated — there is no
+// request context — but reported through the Conditional flags.
+func (s *Service) ListPermittedResources(ctx context.Context, in ListPermittedResourcesInput) (ListPermittedResourcesOutput, error) {
+	if err := validatePermissionRequest(in.Permission); err != nil {
+		return ListPermittedResourcesOutput{}, err
+	}
+	if in.ResourceType != "" {
+		if err := access.ValidateResourceType(in.ResourceType); err != nil {
+			return ListPermittedResourcesOutput{}, err
+		}
+	}
+	uid, aid, err := s.parseUserApp(ctx, in.UserID, in.AppID)
+	if err != nil {
+		return ListPermittedResourcesOutput{}, err
+	}
+	principal, err := s.requirePrincipal(ctx, uid)
+	if err != nil {
+		return ListPermittedResourcesOutput{}, err
+	}
+
+	rows, err := s.repo.ListActivePermissions(ctx, principal, aid, s.now().UTC())
+	if err != nil {
+		return ListPermittedResourcesOutput{}, err
+	}
+
+	// unconditional[x] is set once any grant on x carries no condition.
+	var (
+		out                  ListPermittedResourcesOutput
+		appWideUnconditional bool
+		unconditional        = make(map[access.ResourceScope]bool)
+	)
+	for _, row := range matchPermissions(rows, in.Permission) {
+		if row.Scope == nil {
+			out.AppWide = true
+			appWideUnconditional = appWideUnconditional || row.Condition == ""
+			continue
+		}
+		if in.ResourceType != "" && row.Scope.Type != in.ResourceType {
+			continue
+		}
+		unconditional[*row.Scope] = unconditional[*row.Scope] || row.Condition == ""
+	}
+	out.AppWideConditional = out.AppWide && !appWideUnconditional
+
+	out.Resources = make([]PermittedResource, 0, len(unconditional))
+	for scope, uncond := range unconditional {
+		out.Resources = append(out.Resources, PermittedResource{Scope: scope, Conditional: !uncond})
+	}
+	slices.SortFunc(out.Resources, func(a, b PermittedResource) int {
+		if c := strings.Compare(a.Scope.Type, b.Scope.Type); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Scope.ID, b.Scope.ID)
+	})
+	return out, nil
+}
+
+// parseUserApp validates the ids of a per-(user, app) read and checks
+// the app exists.
+func (s *Service) parseUserApp(ctx context.Context, rawUser, rawApp string) (access.UserID, access.AppID, error) {
+	uid, err := access.ParseUserID(rawUser)
+	if err != nil {
+		return "", "", err
+	}
+	aid, err := access.ParseAppID(rawApp)
+	if err != nil {
+		return "", "", err
+	}
+	if err := s.requireAppExists(ctx, appdom.AppID(aid)); err != nil {
+		return "", "", err
+	}
+	return uid, aid, nil
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	access "sso/internal/modules/access/internal/domain"
+	"sso/internal/modules/identity"
+)
+
+const (
+	roleDeploy   = "0190b6f2-8a43-7c1e-9d2a-00000000b201"
+	roleOperator = "0190b6f2-8a43-7c1e-9d2a-00000000b202"
+	roleGuarded  = "0190b6f2-8a43-7c1e-9d2a-00000000b203"
+	roleAudit    = "0190b6f2-8a43-7c1e-9d2a-00000000b204"
+	userScoped   = "0190b6f2-8a43-7c1e-9d2a-00000000c201"
+)
+
+var scopedUser = access.UserPrincipal(userScoped)
+
+// scopeWorld has a deploy role, an operator role including it, a
+// conditional deploy role and an app-wide reader, and a user holding
+// none of them yet.
+func scopeWorld() *world {
+	w := newWorld()
+	w.addRole(roleDeploy, roleSpec{perms: []string{"builds:deploy"}})
+	w.addRole(roleOperator, roleSpec{perms: []string{"builds:*"}, includes: []string{roleDeploy}})
+	w.addRole(roleGuarded, roleSpec{
+		perms:      []string{"builds:deploy"},
+		conditions: map[string]string{"builds:deploy": `resource.frozen == false`},
+	})
+	w.addRole(roleAudit, roleSpec{perms: []string{"builds:read"}})
+	w.addUser(userScoped, identity.UserStatusActive)
+	return w
+}
+
+func project(id string) access.ResourceScope { return access.ResourceScope{Type: "project", ID: id} }
+
+func TestCheckPermissionScoped(t *testing.T) {
+	cases := []struct {
+		name    string
+		grant   func(w *world)
+		perm    string
+		target  ResourceTarget
+		cc      CheckContext
+		allowed bool
+	}{
+		{"scope itself", scopedGrant(roleDeploy, "acme"), "builds:deploy", target("acme"), CheckContext{}, true},
+		{"below the scope", scopedGrant(roleDeploy, "acme"), "builds:deploy", target("acme/42"), CheckContext{}, true},
+		{"sibling sharing a prefix", scopedGrant(roleDeploy, "acme"), "builds:deploy", target("acme42"), CheckContext{}, false},
+		{"above the scope", scopedGrant(roleDeploy, "acme/42"), "builds:deploy", target("acme"), CheckContext{}, false},
+		{"other resource type", scopedGrant(roleDeploy, "acme"), "builds:deploy",
+			ResourceTarget{Type: "folder", ID: "acme"}, CheckContext{}, false},
+		// A scoped grant never answers an app-wide question.
+		{"no target", scopedGrant(roleDeploy, "acme"), "builds:deploy", ResourceTarget{}, CheckContext{}, false},
+		{"through an include", scopedGrant(roleOperator, "acme"), "builds:deploy", target("acme/42"), CheckContext{}, true},
+		{"by wildcard", scopedGrant(roleOperator, "acme"), "builds:cancel", target("acme/42"), CheckContext{}, true},
+		{"other permission", scopedGrant(roleDeploy, "acme"), "builds:cancel", target("acme"), CheckContext{}, false},
+		{"condition met", scopedGrant(roleGuarded, "acme"), "builds:deploy", target("acme"),
+			CheckContext{Resource: map[string]any{"frozen": false}}, true},
+		{"condition not met", scopedGrant(roleGuarded, "acme"), "builds:deploy", target("acme"),
+			CheckContext{Resource: map[string]any{"frozen": true}}, false},
+		// An app-wide grant covers every resource.
+		{"app-wide grant with a target", func(w *world) { w.assign(scopedUser, roleDeploy, nil) },
+			"builds:deploy", target("globex/7"), CheckContext{}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := scopeWorld()
+			tc.grant(w)
+			s, _ := w.newService(time.Now())
+			res, err := s.CheckPermission(asAdmin(), CheckPermissionInput{
+				UserID: userScoped, AppID: appID, Permission: tc.perm, Target: tc.target, Context: tc.cc,
+			})
+			if err != nil {
+				t.Fatalf("err = %v", err)
+			}
+			if res.Allowed != tc.allowed {
+				t.Fatalf("allowed = %v, want %v", res.Allowed, tc.allowed)
+			}
+			batch, err := s.BatchCheckPermission(asAdmin(), BatchCheckPermissionInput{
+				UserID: userScoped, AppID: appID, Permissions: []string{tc.perm}, Target: tc.target, Context: tc.cc,
+			})
+			if err != nil {
+				t.Fatalf("batch err = %v", err)
+			}
+			if batch.Allowed[0] != tc.allowed {
+				t.Fatalf("batch allowed = %v, want %v", batch.Allowed[0], tc.allowed)
+			}
+		})
+	}
+}
+
+func TestListPermittedResources(t *testing.T) {
+	w := scopeWorld()
+	w.assignScoped(scopedUser, roleDeploy, project("acme"))
+	w.assignScoped(scopedUser, roleOperator, project("acme/42"))
+	w.assignScoped(scopedUser, roleGuarded, project("globex"))
+	w.assignScoped(scopedUser, roleGuarded, project("acme"))
+	w.assignScoped(scopedUser, roleAudit, project("initech"))
+	w.assignScoped(scopedUser, roleDeploy, access.ResourceScope{Type: "folder", ID: "ops"})
+	s, _ := w.newService(time.Now())
+
+	cases := []struct {
+		name string
+		in   ListPermittedResourcesInput
+		want []PermittedResource
+	}{
+		{
+			// acme is granted both plainly and under a condition: the
+			// plain grant wins. acme/42 is listed though acme covers it.
+			name: "every type",
+			in:   ListPermittedResourcesInput{Permission: "builds:deploy"},
+			want: []PermittedResource{
+				{Scope: access.ResourceScope{Type: "folder", ID: "ops"}},
+				{Scope: project("acme")},
+				{Scope: project("acme/42")},
+				{Scope: project("globex"), Conditional: true},
+			},
+		},
+		{
+			name: "one type",
+			in:   ListPermittedResourcesInput{Permission: "builds:deploy", ResourceType: "folder"},
+			want: []PermittedResource{{Scope: access.ResourceScope{Type: "folder", ID: "ops"}}},
+		},
+		{
+			name: "wildcard only",
+			in:   ListPermittedResourcesInput{Permission: "builds:cancel"},
+			want: []PermittedResource{{Scope: project("acme/42")}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.in.UserID, tc.in.AppID = userScoped, appID
+			out, err := s.ListPermittedResources(asAdmin(), tc.in)
+			if err != nil {
+				t.Fatalf("err = %v", err)
+			}
+			if out.AppWide || !slices.Equal(out.Resources, tc.want) {
+				t.Fatalf("app-wide %v, resources %v, want %v", out.AppWide, out.Resources, tc.want)
+			}
+		})
+	}
+
+	// An app-wide grant is reported apart from the scopes.
+	w.assign(scopedUser, roleGuarded, nil)
+	out, err := s.ListPermittedResources(asAdmin(), ListPermittedResourcesInput{
+		UserID: userScoped, AppID: appID, Permission: "builds:deploy",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !out.AppWide || !out.AppWideConditional || len(out.Resources) != 4 {
+		t.Fatalf("app-wide %v conditional %v, %d resources", out.AppWide, out.AppWideConditional, len(out.Resources))
+	}
+}
+
+func scopedGrant(roleID, id string) func(w *world) {
+	return func(w *world) { w.assignScoped(scopedUser, roleID, project(id)) }
+}
+
+func target(id string) ResourceTarget { return ResourceTarget{Type: "project", ID: id} }
@@ -1,10 +1,15 @@
 // Package access hosts the use-cases for sso.access.v1.AccessService.
 //
 // Cross-context cooperation: the package imports identity.Repository,
-// role.Repository, and app.Repository to enforce preconditions
-// (role active, user not blocked/deleted, role belongs to app, etc.).
+// serviceaccount.Repository, role.Repository, and app.Repository to
+// enforce preconditions (role active, user not blocked/deleted, role
+// belongs to app, etc.).
 // The access domain itself stays free of those imports.
 //
+// Principals: every user_id the proto accepts may also name a service
+// account (see resolvePrincipal), so service accounts hold roles and
+// pass CheckPermission exactly like users. Group grants stay user-only.
+//
 // File layout (one file per RPC family, mirrors usecase/role):
 //
 //	service.go     — Service struct + helpers
@@ -12,52 +17,93 @@
 //	remove.go      — RemoveRoleFromUser, BulkRemoveRoles
 //	check.go       — HasRoleInApp, CheckPermission, BatchCheckPermission
 //	list.go        — ListUserRoles
+//	group.go       — GrantRoleToGroup, RemoveRoleFromGroup, ListGroupRoles
+//	expiry.go      — ExtendRoleAssignment, SweepExpiredAssignments
+//	condition.go   — permission-condition evaluation for the checks
+//	scope.go       — resource-scoped grants, their check-time matching,
+//	                 ListPermittedResources
+//	explain.go     — ExplainPermission, the decision trace of a check
+//	snapshot.go    — the permission snapshot cache behind the checks
+//	holders.go     — ListPrincipalsWithPermission, ListRoleMembers
+//	sod.go         — separation-of-duties rules, their grant-time check,
+//	                 ListSoDViolations
 package service
 
 import (
 	"context"
 	"errors"
 	"log/slog"
+	"sync/atomic"
 	"time"
 
+	"sso/internal/kernel/authzver"
+	"sso/internal/kernel/tenant"
 	access "sso/internal/modules/access/internal/domain"
 	appdom "sso/internal/modules/app"
 	"sso/internal/modules/audit"
 	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/group"
 	"sso/internal/modules/identity"
 	"sso/internal/modules/role"
+	"sso/internal/modules/serviceaccount"
 )
 
 // Service exposes the access use-cases. now is injected for tests.
+// version is bumped after every write that can change a check; a nil
+// snapshots means the checks read through every time.
 type Service struct {
-	repo    access.Repository
-	users   identity.Repository
-	roles   role.Repository
-	apps    appdom.Repository
-	now     func() time.Time
-	auditor auditx.Auditor
+	repo            access.Repository
+	users           identity.Repository
+	serviceAccounts serviceaccount.Repository
+	roles           role.Repository
+	apps            appdom.Repository
+	groups          group.GroupReader
+	attrs           atomic.Pointer[AttributeSource]
+	sodOverride     atomic.Pointer[sodOverride]
+	now             func() time.Time
+	log             *slog.Logger
+	auditor         auditx.Auditor
+	version         authzver.Bumper
+	snapshots       *snapshotCache
 }
 
-// NewService constructs the service. All four repositories are required;
+// NewService constructs the service. All six readers are required;
 // nil panics at first use rather than at construction so wiring bugs
-// surface in tests.
+// surface in tests. version is optional: nil bumps nothing and turns
+// the snapshot cache off, as does a snapshotTTL of zero.
 func NewService(
 	log *slog.Logger,
 	repo access.Repository,
 	users identity.Repository,
+	serviceAccounts serviceaccount.Repository,
 	roles role.Repository,
 	apps appdom.Repository,
+	groups group.GroupReader,
 	now func() time.Time,
 	emitter audit.Emitter,
+	version authzver.Version,
+	snapshotTTL time.Duration,
+	snapshotMaxEntries int,
 ) *Service {
-	return &Service{
-		repo:    repo,
-		users:   users,
-		roles:   roles,
-		apps:    apps,
-		now:     now,
-		auditor: auditx.New(log, emitter),
+	s := &Service{
+		repo:            repo,
+		users:           users,
+		serviceAccounts: serviceAccounts,
+		roles:           roles,
+		apps:            apps,
+		groups:          groups,
+		now:             now,
+		log:             log,
+		auditor:         auditx.New(log, emitter),
+		version:         authzver.Nop{},
+	}
+	if version != nil {
+		s.version = version
+		if snapshotTTL > 0 {
+			s.snapshots = newSnapshotCache(version, snapshotTTL, snapshotMaxEntries)
+		}
 	}
+	return s
 }
 
 // withOutcome is a thin alias over auditx.WithOutcome.
@@ -67,16 +113,22 @@ func withOutcome(p audit.NewAuditParams, out audit.AuditOutcome, reason string)
 
 // errReasonMap maps access-domain sentinels to their audit (Outcome,
 // Reason) pair. Policy-style rejections (role disabled, user not
-// eligible, role not in app) surface as OutcomeDenied; everything else
-// as OutcomeFailure. auditx.Classify handles *validation.Error and the
-// default fallback.
+// eligible, role not in app, separation of duties) surface as
+// OutcomeDenied; everything else as OutcomeFailure. auditx.Classify
+// handles *validation.Error and the default fallback.
 var errReasonMap = map[error]auditx.OutcomeReason{
-	access.ErrRoleDisabled:     auditx.Deny(audit.ReasonRoleDisabled),
-	access.ErrUserNotEligible:  auditx.Deny(audit.ReasonUserNotEligible),
-	access.ErrRoleNotInApp:     auditx.Deny(audit.ReasonRoleNotInApp),
-	access.ErrUserNotFound:     auditx.Fail(audit.ReasonUserNotFound),
-	access.ErrRoleNotFound:     auditx.Fail(audit.ReasonRoleNotFound),
-	access.ErrAppNotFound:      auditx.Fail(audit.ReasonAppNotFound),
+	access.ErrRoleDisabled:       auditx.Deny(audit.ReasonRoleDisabled),
+	access.ErrUserNotEligible:    auditx.Deny(audit.ReasonUserNotEligible),
+	access.ErrRoleNotInApp:       auditx.Deny(audit.ReasonRoleNotInApp),
+	access.ErrUserNotFound:       auditx.Fail(audit.ReasonUserNotFound),
+	access.ErrRoleNotFound:       auditx.Fail(audit.ReasonRoleNotFound),
+	access.ErrAppNotFound:        auditx.Fail(audit.ReasonAppNotFound),
+	access.ErrGroupNotFound:      auditx.Fail(audit.ReasonGroupNotFound),
+	access.ErrAssignmentNotFound: auditx.Fail(audit.ReasonAssignmentNotFound),
+	access.ErrSoDViolation:       auditx.Deny(audit.ReasonSoDViolation),
+	access.ErrSoDOverrideDenied:  auditx.Deny(audit.ReasonPermissionDenied),
+	access.ErrSoDRuleNotFound:    auditx.Fail(audit.ReasonSoDRuleNotFound),
+	access.ErrSoDRuleExists:      auditx.Fail(audit.ReasonSoDRuleAlreadyExists),
 }
 
 // classifyError is the per-package thin wrapper around auditx.Classify.
@@ -109,41 +161,86 @@ func (s *Service) loadActiveRoleInApp(ctx context.Context, rid role.RoleID, expe
 	return r, nil
 }
 
-// requireUserEligible ensures the user exists and is ACTIVE. BLOCKED /
-// DELETED users may keep existing assignments (cascade-on-delete is
-// only at hard-delete time) but cannot receive new grants and do not
-// contribute to CheckPermission.
-func (s *Service) requireUserEligible(ctx context.Context, uid identity.UserID) error {
-	u, err := s.users.GetByID(ctx, uid)
+// requireEligiblePrincipal resolves the principal and ensures it is
+// ACTIVE. BLOCKED / DELETED users and DISABLED service accounts may keep
+// existing assignments (cascade-on-delete is only at hard-delete time)
+// but cannot receive new grants.
+func (s *Service) requireEligiblePrincipal(ctx context.Context, id access.UserID) (access.Principal, error) {
+	return s.resolvePrincipal(ctx, id, true)
+}
+
+// requirePrincipal checks existence only. Used by Read / List endpoints
+// where BLOCKED / DELETED users are still allowed to be queried (their
+// assignments are visible).
+func (s *Service) requirePrincipal(ctx context.Context, id access.UserID) (access.Principal, error) {
+	return s.resolvePrincipal(ctx, id, false)
+}
+
+// resolvePrincipal decides what the proto's user_id names: an identity
+// user or, failing that, a service account. Ids are UUIDs in both
+// tables, so at most one lookup hits. An id in neither is
+// ErrUserNotFound — the proto has no separate principal error.
+func (s *Service) resolvePrincipal(ctx context.Context, id access.UserID, requireActive bool) (access.Principal, error) {
+	u, err := s.users.GetByID(ctx, identity.UserID(id))
+	if err == nil {
+		if requireActive && u.Status() != identity.UserStatusActive {
+			return access.Principal{}, access.ErrUserNotEligible
+		}
+		return access.UserPrincipal(id), nil
+	}
+	if !errors.Is(err, identity.ErrUserNotFound) {
+		return access.Principal{}, err
+	}
+
+	sa, err := s.serviceAccounts.GetByID(ctx, serviceaccount.ServiceAccountID(id))
 	if err != nil {
-		if errors.Is(err, identity.ErrUserNotFound) {
-			return access.ErrUserNotFound
+		if errors.Is(err, serviceaccount.ErrServiceAccountNotFound) {
+			return access.Principal{}, access.ErrUserNotFound
 		}
-		return err
+		return access.Principal{}, err
 	}
-	if u.Status() != identity.UserStatusActive {
-		return access.ErrUserNotEligible
+	if requireActive && sa.Status() != serviceaccount.ServiceAccountActive {
+		return access.Principal{}, access.ErrUserNotEligible
 	}
-	return nil
+	return access.ServiceAccountPrincipal(id.String()), nil
 }
 
-// requireUserExists checks identity-level existence only. Used by Read /
-// List endpoints where BLOCKED / DELETED users are still allowed to be
-// queried (their assignments are visible).
-func (s *Service) requireUserExists(ctx context.Context, uid identity.UserID) error {
-	if _, err := s.users.GetByID(ctx, uid); err != nil {
-		if errors.Is(err, identity.ErrUserNotFound) {
-			return access.ErrUserNotFound
-		}
-		return err
+// subjectType is the audit subject for events about p's assignments.
+func subjectType(p access.Principal) audit.SubjectType {
+	if p.IsServiceAccount() {
+		return audit.SubjectTypeServiceAccount
 	}
-	return nil
+	return audit.SubjectTypeUser
 }
 
 func (s *Service) requireAppExists(ctx context.Context, aid appdom.AppID) error {
-	if _, err := s.apps.GetByID(ctx, aid); err != nil {
+	_, err := s.loadApp(ctx, aid)
+	return err
+}
+
+func (s *Service) loadApp(ctx context.Context, aid appdom.AppID) (*appdom.App, error) {
+	a, err := s.apps.GetByID(ctx, aid)
+	if err != nil {
 		if errors.Is(err, appdom.ErrAppNotFound) {
-			return access.ErrAppNotFound
+			return nil, access.ErrAppNotFound
+		}
+		return nil, err
+	}
+	return a, nil
+}
+
+// inTenant narrows ctx to tenant id for the principal lookup of a
+// grant: a role is granted only within its own tenant, so a principal
+// of another reads as not found — even to a super-admin, whose reads
+// are otherwise unscoped.
+func inTenant(ctx context.Context, id string) context.Context {
+	return tenant.With(ctx, id)
+}
+
+func (s *Service) requireGroupExists(ctx context.Context, gid access.GroupID) error {
+	if _, err := s.groups.GetByID(ctx, group.GroupID(gid)); err != nil {
+		if errors.Is(err, group.ErrGroupNotFound) {
+			return access.ErrGroupNotFound
 		}
 		return err
 	}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"sso/internal/kernel/authzver"
+	"sso/internal/kernel/tenant"
+	access "sso/internal/modules/access/internal/domain"
+	"sso/internal/modules/app"
+)
+
+// ----------------------------------------------------------------------------
+// Permission snapshots
+// ----------------------------------------------------------------------------
+
+// snapshotKey names one cached snapshot. The tenant scope is part of it
+// because it decides whether the principal and the app resolve at all.
+type snapshotKey struct {
+	scope     string
+	principal access.UserID
+	app       access.AppID
+}
+
+// snapshot is what CheckPermission and BatchCheckPermission read before
+// matching: the resolved principal and the ListActivePermissions rows.
+// Both exist, so the precondition checks passed. rows is shared between
+// checks and must not be modified; the matchers copy before filtering.
+//
+// gen is the authz version generation read before the rows were;
+// expires is the TTL or the next assignment window edge, whichever
+// comes first.
+type snapshot struct {
+	principal access.Principal
+	rows      []access.PermissionRow
+	gen       uint64
+	expires   time.Time
+}
+
+// SnapshotStats is a point-in-time view of the snapshot cache. Misses
+// include the Stale lookups, which found an entry outdated by a write.
+type SnapshotStats struct {
+	Hits      uint64
+	Misses    uint64
+	Stale     uint64
+	Evictions uint64
+	Entries   int
+}
+
+// snapshotCache holds the snapshots, least recently used evicted first
+// when full: the principals checking most often keep their snapshots,
+// and one-off checks of a large directory roll through the tail
+// without displacing them. Stale and expired entries are dropped as
+// they are found; the rest age out through the tail.
+type snapshotCache struct {
+	version authzver.Version
+	ttl     time.Duration
+	maxSize int
+
+	mu      sync.Mutex
+	entries map[snapshotKey]*list.Element // of *snapshotEntry
+	lru     *list.List                    // most recently used at the front
+
+	hits, misses, stale, evictions atomic.Uint64
+}
+
+type snapshotEntry struct {
+	key  snapshotKey
+	snap *snapshot
+}
+
+func newSnapshotCache(version authzver.Version, ttl time.Duration, maxSize int) *snapshotCache {
+	return &snapshotCache{
+		version: version,
+		ttl:     ttl,
+		maxSize: maxSize,
+		entries: make(map[snapshotKey]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// get returns the snapshot under k if it is still current at now.
+func (c *snapshotCache) get(k snapshotKey, now time.Time) (*snapshot, bool) {
+	c.mu.Lock()
+	var snap *snapshot
+	if el, ok := c.entries[k]; ok {
+		snap = el.Value.(*snapshotEntry).snap
+		if snap.gen != c.version.Generation() || !now.Before(snap.expires) {
+			c.remove(el)
+			c.stale.Add(1)
+			snap = nil
+		} else {
+			c.lru.MoveToFront(el)
+		}
+	}
+	c.mu.Unlock()
+
+	if snap == nil {
+		c.misses.Add(1)
+		return nil, false
+	}
+	c.hits.Add(1)
+	return snap, true
+}
+
+func (c *snapshotCache) put(k snapshotKey, snap *snapshot) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[k]; ok {
+		el.Value.(*snapshotEntry).snap = snap
+		c.lru.MoveToFront(el)
+		return
+	}
+	for c.lru.Len() >= c.maxSize {
+		c.remove(c.lru.Back())
+		c.evictions.Add(1)
+	}
+	c.entries[k] = c.lru.PushFront(&snapshotEntry{key: k, snap: snap})
+}
+
+// remove drops el; c.mu is held.
+func (c *snapshotCache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*snapshotEntry).key)
+}
+
+func (c *snapshotCache) stats() SnapshotStats {
+	c.mu.Lock()
+	n := len(c.entries)
+	c.mu.Unlock()
+	return SnapshotStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Stale:     c.stale.Load(),
+		Evictions: c.evictions.Load(),
+		Entries:   n,
+	}
+}
+
+// SnapshotStats reports the snapshot cache counters; all zero when the
+// cache is off.
+func (s *Service) SnapshotStats() SnapshotStats {
+	if s.snapshots == nil {
+		return SnapshotStats{}
+	}
+	return s.snapshots.stats()
+}
+
+// loadPermissions resolves the principal, checks the app exists and
+// reads the principal's active permission rows: the inputs of
+// CheckPermission and BatchCheckPermission, served from a snapshot when
+// a current one is cached. Errors are never cached, so a miss fails
+// exactly as the uncached path does, principal first.
+//
+// A snapshot goes stale when the authz version moves — on this replica
+// at the write, on the others at their next poll — and expires at the
+// TTL or the next not_before / expires_at edge of the principal's
+// assignments, the one change ListActivePermissions sees without a
+// write. The generation is read before the rows so a write racing the
+// load leaves the new snapshot stale, not wrong.
+func (s *Service) loadPermissions(ctx context.Context, uid access.UserID, aid access.AppID) (access.Principal, []access.PermissionRow, error) {
+	now := s.now().UTC()
+	key := snapshotKey{scope: tenant.Scope(ctx), principal: uid, app: aid}
+	if s.snapshots != nil {
+		if snap, ok := s.snapshots.get(key, now); ok {
+			return snap.principal, snap.rows, nil
+		}
+	}
+
+	var gen uint64
+	if s.snapshots != nil {
+		gen = s.snapshots.version.Generation()
+	}
+	principal, err := s.requirePrincipal(ctx, uid)
+	if err != nil {
+		return access.Principal{}, nil, err
+	}
+	if err := s.requireAppExists(ctx, app.AppID(aid)); err != nil {
+		return access.Principal{}, nil, err
+	}
+	rows, err := s.repo.ListActivePermissions(ctx, principal, aid, now)
+	if err != nil {
+		return access.Principal{}, nil, err
+	}
+	if s.snapshots == nil {
+		return principal, rows, nil
+	}
+
+	expires := now.Add(s.snapshots.ttl)
+	next, ok, err := s.repo.NextWindowChange(ctx, principal, aid, now)
+	if err != nil {
+		// The rows are good; only caching them is not.
+		s.log.WarnContext(ctx, "access: snapshot window lookup", "err", err)
+		return principal, rows, nil
+	}
+	if ok && next.Before(expires) {
+		expires = next
+	}
+	s.snapshots.put(key, &snapshot{principal: principal, rows: rows, gen: gen, expires: expires})
+	return principal, rows, nil
+}
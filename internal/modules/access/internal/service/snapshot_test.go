@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	access "sso/internal/modules/access/internal/domain"
+)
+
+// fixedVersion never moves.
+type fixedVersion struct{}
+
+func (fixedVersion) Bump(context.Context) {}
+func (fixedVersion) Generation() uint64   { return 1 }
+
+func TestSnapshotCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	now := time.Now()
+	c := newSnapshotCache(fixedVersion{}, time.Minute, 2)
+	key := func(id string) snapshotKey {
+		return snapshotKey{principal: access.UserID(id), app: "app"}
+	}
+	put := func(id string) {
+		c.put(key(id), &snapshot{gen: 1, expires: now.Add(time.Minute)})
+	}
+
+	put("a")
+	put("b")
+	if _, ok := c.get(key("a"), now); !ok {
+		t.Fatal("a missing before the cache filled")
+	}
+	put("c")
+
+	if _, ok := c.get(key("b"), now); ok {
+		t.Fatal("b kept, want the least recently used entry evicted")
+	}
+	for _, id := range []string{"a", "c"} {
+		if _, ok := c.get(key(id), now); !ok {
+			t.Fatalf("%s evicted", id)
+		}
+	}
+	if st := c.stats(); st.Evictions != 1 || st.Entries != 2 {
+		t.Fatalf("evictions = %d, entries = %d, want 1 and 2", st.Evictions, st.Entries)
+	}
+
+	if _, ok := c.get(key("a"), now.Add(time.Minute)); ok {
+		t.Fatal("expired snapshot served")
+	}
+	if st := c.stats(); st.Stale != 1 || st.Entries != 1 {
+		t.Fatalf("stale = %d, entries = %d, want 1 and 1", st.Stale, st.Entries)
+	}
+}
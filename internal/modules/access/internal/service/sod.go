@@ -0,0 +1,402 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/cursor"
+	"sso/internal/kernel/validation"
+	access "sso/internal/modules/access/internal/domain"
+	appdom "sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/role"
+)
+
+// SoDOverrideChecker decides whether an actor may grant past a broken
+// separation-of-duties rule, by holding the override permission.
+// Satisfied by *authz.AccessBackedAuthorizer.
+type SoDOverrideChecker interface {
+	HasPermission(ctx context.Context, act actor.Actor, permission string) (bool, error)
+}
+
+type sodOverride struct {
+	checker    SoDOverrideChecker
+	permission string
+}
+
+// SetSoDOverride late-binds the override check: the admin authorizer
+// is built on top of this service. Until it is set, no grant may
+// override a rule.
+func (s *Service) SetSoDOverride(c SoDOverrideChecker, permission string) {
+	s.sodOverride.Store(&sodOverride{checker: c, permission: permission})
+}
+
+// ----------------------------------------------------------------------------
+// Rules
+// ----------------------------------------------------------------------------
+
+type CreateSoDRuleInput struct {
+	AppID       string
+	Name        string
+	Description string
+	RoleIDs     []string
+}
+
+// CreateSoDRule declares the roles mutually exclusive in their app. The
+// roles must belong to the app, whatever their status: a DISABLED role
+// can be enabled again. Existing holdings are not checked; the
+// violations report lists them.
+func (s *Service) CreateSoDRule(ctx context.Context, in CreateSoDRuleInput) (*access.SoDRule, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	aid, err := access.ParseAppID(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	rids := make([]access.RoleID, 0, len(in.RoleIDs))
+	for _, raw := range in.RoleIDs {
+		rid, err := access.ParseRoleID(raw)
+		if err != nil {
+			return nil, err
+		}
+		rids = append(rids, rid)
+	}
+	id, err := access.NewSoDRuleID()
+	if err != nil {
+		return nil, err
+	}
+	rule, err := access.NewSoDRule(access.NewSoDRuleParams{
+		ID:          id,
+		AppID:       aid,
+		Name:        in.Name,
+		Description: in.Description,
+		RoleIDs:     rids,
+		CreatedBy:   access.ActorID(a.ID),
+		Now:         s.now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessCreateSoDRule)
+	aud.SubjectType = audit.SubjectTypeSoDRule
+	aud.SubjectID = id.String()
+	aud.AppID = aid.String()
+	aud.Metadata = sodRuleMetadata(rule)
+
+	if err := s.requireAppExists(ctx, appdom.AppID(aid)); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	expectedAppID := role.AppID(aid)
+	for _, rid := range rule.RoleIDs {
+		r, err := s.loadAnyRole(ctx, rid)
+		if err == nil && r.AppID() != expectedAppID {
+			err = access.ErrRoleNotInApp
+		}
+		if err != nil {
+			out, reason := classifyError(err)
+			s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+			return nil, err
+		}
+	}
+
+	if err := s.repo.CreateSoDRule(ctx, rule); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	s.auditor.Success(ctx, aud)
+	return rule, nil
+}
+
+type GetSoDRuleInput struct {
+	RuleID string
+}
+
+func (s *Service) GetSoDRule(ctx context.Context, in GetSoDRuleInput) (*access.SoDRule, error) {
+	id, err := access.ParseSoDRuleID(in.RuleID)
+	if err != nil {
+		return nil, err
+	}
+	return s.loadSoDRule(ctx, id)
+}
+
+type ListSoDRulesInput struct {
+	AppID string
+}
+
+// ListSoDRules returns every rule of the app, by name. Rules are few
+// per app, so the list is not paged.
+func (s *Service) ListSoDRules(ctx context.Context, in ListSoDRulesInput) ([]*access.SoDRule, error) {
+	aid, err := access.ParseAppID(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireAppExists(ctx, appdom.AppID(aid)); err != nil {
+		return nil, err
+	}
+	return s.repo.ListSoDRules(ctx, aid)
+}
+
+type DeleteSoDRuleInput struct {
+	RuleID string
+}
+
+// DeleteSoDRule lifts the constraint. Holdings it forbade stay; only
+// future grants are no longer checked against it.
+func (s *Service) DeleteSoDRule(ctx context.Context, in DeleteSoDRuleInput) error {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return err
+	}
+	id, err := access.ParseSoDRuleID(in.RuleID)
+	if err != nil {
+		return err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessDeleteSoDRule)
+	aud.SubjectType = audit.SubjectTypeSoDRule
+	aud.SubjectID = id.String()
+
+	rule, err := s.loadSoDRule(ctx, id)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return err
+	}
+	aud.AppID = rule.AppID.String()
+	aud.Metadata = sodRuleMetadata(rule)
+
+	removed, err := s.repo.DeleteSoDRule(ctx, id)
+	if err == nil && !removed {
+		err = access.ErrSoDRuleNotFound
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return err
+	}
+	s.auditor.Success(ctx, aud)
+	return nil
+}
+
+// loadSoDRule fetches the rule and hides it when its app is not
+// visible to the caller's tenant.
+func (s *Service) loadSoDRule(ctx context.Context, id access.SoDRuleID) (*access.SoDRule, error) {
+	rule, err := s.repo.GetSoDRule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireAppExists(ctx, appdom.AppID(rule.AppID)); err != nil {
+		if errors.Is(err, access.ErrAppNotFound) {
+			return nil, access.ErrSoDRuleNotFound
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+// sodRuleMetadata is the audit metadata of the rule events.
+func sodRuleMetadata(r *access.SoDRule) map[string]string {
+	return map[string]string{
+		"name":     r.Name,
+		"role_ids": joinRoleIDs(r.RoleIDs),
+	}
+}
+
+func joinRoleIDs(ids []access.RoleID) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = id.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+// ----------------------------------------------------------------------------
+// ListSoDViolations
+// ----------------------------------------------------------------------------
+
+type ListSoDViolationsInput struct {
+	AppID     string
+	PageSize  int32
+	PageToken string
+}
+
+type ListSoDViolationsOutput struct {
+	// Violations in (rule id, principal id) order. A principal breaking
+	// two rules is listed once per rule.
+	Violations []access.SoDViolation
+	// Rules holds every rule of the app, by id, for the violations to
+	// be rendered with.
+	Rules         map[access.SoDRuleID]*access.SoDRule
+	NextPageToken string
+}
+
+// ListSoDViolations reports the principals currently holding two or
+// more roles of one rule of the app — through group grants, which are
+// not checked, overridden grants, or grants made before the rule. A
+// role counts as held as the grant check counts it: direct assignments
+// not yet expired, and group grants. Principal and role status are not
+// looked at.
+func (s *Service) ListSoDViolations(ctx context.Context, in ListSoDViolationsInput) (ListSoDViolationsOutput, error) {
+	aid, err := access.ParseAppID(in.AppID)
+	if err != nil {
+		return ListSoDViolationsOutput{}, err
+	}
+	if err := s.requireAppExists(ctx, appdom.AppID(aid)); err != nil {
+		return ListSoDViolationsOutput{}, err
+	}
+	after, err := decodeSoDViolationCursor(in.PageToken)
+	if err != nil {
+		return ListSoDViolationsOutput{}, err
+	}
+	pageSize, err := auditx.ClampPageSize(in.PageSize)
+	if err != nil {
+		return ListSoDViolationsOutput{}, err
+	}
+
+	rules, err := s.repo.ListSoDRules(ctx, aid)
+	if err != nil {
+		return ListSoDViolationsOutput{}, err
+	}
+	byID := make(map[access.SoDRuleID]*access.SoDRule, len(rules))
+	for _, r := range rules {
+		byID[r.ID] = r
+	}
+	if len(rules) == 0 {
+		return ListSoDViolationsOutput{Rules: byID}, nil
+	}
+
+	rows, err := s.repo.ListSoDViolations(ctx, access.SoDViolationsQuery{
+		AppID:    aid,
+		Now:      s.now().UTC(),
+		After:    after,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		return ListSoDViolationsOutput{}, err
+	}
+
+	var next string
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		last := rows[pageSize-1]
+		if next, err = cursor.Encode(&sodViolationToken{RuleID: last.RuleID.String(), PrincipalID: last.Principal.ID}); err != nil {
+			return ListSoDViolationsOutput{}, err
+		}
+	}
+	return ListSoDViolationsOutput{Violations: rows, Rules: byID, NextPageToken: next}, nil
+}
+
+// sodViolationToken is the keyset of ListSoDViolations.
+type sodViolationToken struct {
+	RuleID      string `json:"r"`
+	PrincipalID string `json:"p"`
+}
+
+func decodeSoDViolationCursor(s string) (*access.SoDViolationCursor, error) {
+	t, err := cursor.Decode[sodViolationToken](s)
+	if err != nil {
+		return nil, &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	if t == nil {
+		return nil, nil
+	}
+	return &access.SoDViolationCursor{RuleID: access.SoDRuleID(t.RuleID), PrincipalID: t.PrincipalID}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Grant-time enforcement
+// ----------------------------------------------------------------------------
+
+// checkSoD decides whether granting roles to p in the app breaks a
+// rule: whether, with them, p would hold two or more of a rule's roles,
+// one of them newly. A rule p already breaks without the grant's help
+// does not block it. broken is the first rule broken, for the audit
+// record, and is set whenever the grant breaks one — including when
+// override lets it through.
+//
+// With override set, the caller's holding of the override permission
+// is checked, and only when a rule is actually broken: ErrSoDViolation
+// without override, ErrSoDOverrideDenied when the caller may not.
+func (s *Service) checkSoD(ctx context.Context, a actor.Actor, p access.Principal, appID access.AppID, roles []access.RoleID, override bool, now time.Time) (broken *access.SoDRule, err error) {
+	rules, err := s.repo.ListSoDRules(ctx, appID)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+	heldIDs, err := s.repo.ListHeldRoleIDs(ctx, p, appID, now)
+	if err != nil {
+		return nil, err
+	}
+	held := make(map[access.RoleID]struct{}, len(heldIDs)+len(roles))
+	for _, rid := range heldIDs {
+		held[rid] = struct{}{}
+	}
+	fresh := make(map[access.RoleID]struct{}, len(roles))
+	for _, rid := range roles {
+		if _, ok := held[rid]; !ok {
+			fresh[rid] = struct{}{}
+		}
+	}
+	if len(fresh) == 0 {
+		return nil, nil
+	}
+	for rid := range fresh {
+		held[rid] = struct{}{}
+	}
+	for _, r := range rules {
+		if len(r.Conflicts(held)) >= 2 && len(r.Conflicts(fresh)) > 0 {
+			broken = r
+			break
+		}
+	}
+	if broken == nil {
+		return nil, nil
+	}
+	if !override {
+		return broken, access.ErrSoDViolation
+	}
+	ok, err := s.canOverrideSoD(ctx, a)
+	if err != nil {
+		return broken, err
+	}
+	if !ok {
+		return broken, access.ErrSoDOverrideDenied
+	}
+	return broken, nil
+}
+
+func (s *Service) canOverrideSoD(ctx context.Context, a actor.Actor) (bool, error) {
+	o := s.sodOverride.Load()
+	if o == nil || o.checker == nil || o.permission == "" {
+		return false, nil
+	}
+	return o.checker.HasPermission(ctx, a, o.permission)
+}
+
+// withSoDMetadata records the rule a grant broke, and whether it went
+// through by override, on the grant's audit event.
+func withSoDMetadata(p audit.NewAuditParams, broken *access.SoDRule, overridden bool) audit.NewAuditParams {
+	if broken == nil {
+		return p
+	}
+	md := make(map[string]string, len(p.Metadata)+3)
+	for k, v := range p.Metadata {
+		md[k] = v
+	}
+	md["sod_rule_id"] = broken.ID.String()
+	md["sod_rule"] = broken.Name
+	if overridden {
+		md["sod_override"] = "true"
+	}
+	p.Metadata = md
+	return p
+}
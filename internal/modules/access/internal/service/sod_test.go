@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"sso/internal/kernel/actor"
+	access "sso/internal/modules/access/internal/domain"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity"
+)
+
+const (
+	roleInitiator = "0190b6f2-8a43-7c1e-9d2a-00000000b001"
+	roleApprover  = "0190b6f2-8a43-7c1e-9d2a-00000000b002"
+	roleViewer    = "0190b6f2-8a43-7c1e-9d2a-00000000b003"
+	userAda       = "0190b6f2-8a43-7c1e-9d2a-00000000c001"
+	groupFinance  = "0190b6f2-8a43-7c1e-9d2a-00000000d001"
+	ruleID        = "0190b6f2-8a43-7c1e-9d2a-00000000e001"
+	overridePerm  = "access:override_sod"
+)
+
+// sodWorld has one rule making the initiator and approver roles of the
+// app mutually exclusive, and ada, who holds neither yet.
+func sodWorld() *world {
+	w := newWorld()
+	w.addRole(roleInitiator, roleSpec{perms: []string{"payments:initiate"}})
+	w.addRole(roleApprover, roleSpec{perms: []string{"payments:approve"}})
+	w.addRole(roleViewer, roleSpec{perms: []string{"payments:read"}})
+	w.addUser(userAda, identity.UserStatusActive)
+	w.sodRules = append(w.sodRules, &access.SoDRule{
+		ID:      ruleID,
+		AppID:   appID,
+		Name:    "payments four-eyes",
+		RoleIDs: []access.RoleID{roleInitiator, roleApprover},
+	})
+	return w
+}
+
+// overrideChecker grants the override permission to the actors in
+// allowed and counts the checks.
+type overrideChecker struct {
+	allowed map[string]bool
+	calls   int
+}
+
+func (c *overrideChecker) HasPermission(_ context.Context, a actor.Actor, permission string) (bool, error) {
+	c.calls++
+	return permission == overridePerm && c.allowed[a.ID], nil
+}
+
+var ada = access.UserPrincipal(userAda)
+
+func TestGrantRefusedBySoD(t *testing.T) {
+	past := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	now := past.Add(24 * time.Hour)
+
+	cases := []struct {
+		name    string
+		setup   func(w *world)
+		grant   func(s *Service) error
+		refused bool
+	}{
+		{
+			name:  "direct holding",
+			setup: func(w *world) { w.assign(ada, roleInitiator, nil) },
+			grant: grantOne(roleApprover), refused: true,
+		},
+		{
+			// Group grants are not checked themselves, but count as
+			// held when a direct grant is checked.
+			name:  "holding through a group",
+			setup: func(w *world) { w.grantGroup(groupFinance, roleInitiator, userAda) },
+			grant: grantOne(roleApprover), refused: true,
+		},
+		{
+			name:  "both roles in one batch",
+			setup: func(*world) {},
+			grant: grantBulk(roleInitiator, roleApprover), refused: true,
+		},
+		{
+			name:  "expired holding",
+			setup: func(w *world) { w.assign(ada, roleInitiator, &past) },
+			grant: grantOne(roleApprover),
+		},
+		{
+			name:  "role outside the rule",
+			setup: func(w *world) { w.assign(ada, roleInitiator, nil) },
+			grant: grantOne(roleViewer),
+		},
+		{
+			// A rule ada already breaks does not block an idempotent
+			// re-grant of a role she holds.
+			name: "re-grant of a held role",
+			setup: func(w *world) {
+				w.assign(ada, roleInitiator, nil)
+				w.assign(ada, roleApprover, nil)
+			},
+			grant: grantOne(roleApprover),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := sodWorld()
+			tc.setup(w)
+			held := len(w.assignments)
+			s, em := w.newService(now)
+
+			err := tc.grant(s)
+			ev := em.only(t)
+			if !tc.refused {
+				if err != nil {
+					t.Fatalf("err = %v", err)
+				}
+				if ev.Outcome() != audit.OutcomeSuccess {
+					t.Fatalf("outcome = %v, want success", ev.Outcome())
+				}
+				return
+			}
+			if !errors.Is(err, access.ErrSoDViolation) {
+				t.Fatalf("err = %v, want ErrSoDViolation", err)
+			}
+			if len(w.assignments) != held {
+				t.Fatalf("assignments = %d, want %d: the refused grant was written", len(w.assignments), held)
+			}
+			md := ev.Metadata()
+			if ev.Outcome() != audit.OutcomeDenied || ev.Reason() != audit.ReasonSoDViolation ||
+				md["sod_rule_id"] != ruleID || md["sod_rule"] != "payments four-eyes" || md["sod_override"] != "" {
+				t.Fatalf("audit = %v %s %v", ev.Outcome(), ev.Reason(), md)
+			}
+		})
+	}
+}
+
+func TestGrantOverridesSoD(t *testing.T) {
+	now := time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		checker    *overrideChecker
+		override   bool
+		grant      string
+		want       error
+		wantReason string
+		wantChecks int
+	}{
+		{"override permission held", &overrideChecker{allowed: map[string]bool{adminID: true}},
+			true, roleApprover, nil, "", 1},
+		{"override permission not held", &overrideChecker{},
+			true, roleApprover, access.ErrSoDOverrideDenied, audit.ReasonPermissionDenied, 1},
+		{"no override requested", &overrideChecker{allowed: map[string]bool{adminID: true}},
+			false, roleApprover, access.ErrSoDViolation, audit.ReasonSoDViolation, 0},
+		// The permission is looked at only when a rule is broken.
+		{"override on a grant breaking nothing", &overrideChecker{},
+			true, roleViewer, nil, "", 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := sodWorld()
+			w.assign(ada, roleInitiator, nil)
+			s, em := w.newService(now)
+			s.SetSoDOverride(tc.checker, overridePerm)
+
+			_, err := s.GrantRoleToUser(asAdmin(), GrantRoleToUserInput{
+				UserID: userAda, RoleID: tc.grant, ActorID: adminID, OverrideSoD: tc.override,
+			})
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("err = %v, want %v", err, tc.want)
+			}
+			if tc.checker.calls != tc.wantChecks {
+				t.Fatalf("override checks = %d, want %d", tc.checker.calls, tc.wantChecks)
+			}
+			if got := w.holds(ada, tc.grant); got != (tc.want == nil) {
+				t.Fatalf("granted = %v", got)
+			}
+
+			ev := em.only(t)
+			md := ev.Metadata()
+			switch {
+			case tc.want != nil:
+				if ev.Outcome() != audit.OutcomeDenied || ev.Reason() != tc.wantReason || md["sod_rule_id"] != ruleID {
+					t.Fatalf("audit = %v %s %v", ev.Outcome(), ev.Reason(), md)
+				}
+			case tc.grant == roleApprover:
+				if ev.Outcome() != audit.OutcomeSuccess || md["sod_rule_id"] != ruleID || md["sod_override"] != "true" {
+					t.Fatalf("audit = %v %v, want the override recorded", ev.Outcome(), md)
+				}
+			default:
+				if ev.Outcome() != audit.OutcomeSuccess || md["sod_rule_id"] != "" {
+					t.Fatalf("audit = %v %v, want no rule recorded", ev.Outcome(), md)
+				}
+			}
+		})
+	}
+}
+
+// TestSoDOverrideUnset checks that before SetSoDOverride is wired no
+// grant may break a rule, whatever the caller asks for.
+func TestSoDOverrideUnset(t *testing.T) {
+	w := sodWorld()
+	w.assign(ada, roleInitiator, nil)
+	s, _ := w.newService(time.Now())
+	_, err := s.GrantRoleToUser(asAdmin(), GrantRoleToUserInput{UserID: userAda, RoleID: roleApprover, OverrideSoD: true})
+	if !errors.Is(err, access.ErrSoDOverrideDenied) {
+		t.Fatalf("err = %v, want ErrSoDOverrideDenied", err)
+	}
+}
+
+func grantOne(roleID string) func(*Service) error {
+	return func(s *Service) error {
+		_, err := s.GrantRoleToUser(asAdmin(), GrantRoleToUserInput{UserID: userAda, RoleID: roleID, ActorID: adminID})
+		return err
+	}
+}
+
+func grantBulk(roleIDs ...string) func(*Service) error {
+	return func(s *Service) error {
+		_, err := s.BulkGrantRoles(asAdmin(), BulkGrantRolesInput{UserID: userAda, AppID: appID, RoleIDs: roleIDs, ActorID: adminID})
+		return err
+	}
+}
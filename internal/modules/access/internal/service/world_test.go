@@ -0,0 +1,563 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
+	access "sso/internal/modules/access/internal/domain"
+	appdom "sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/group"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/role"
+	"sso/internal/modules/serviceaccount"
+)
+
+// Fixed ids of the test world. UUIDs, as the service parses them.
+const (
+	appID   = "0190b6f2-8a43-7c1e-9d2a-00000000a001"
+	adminID = "0190b6f2-8a43-7c1e-9d2a-00000000f001"
+)
+
+// world is the in-memory state behind the fakes: the apps, roles and
+// principals access reads, and the grants it writes. Each fake
+// implements only what the service calls; the rest panics through the
+// nil embedded interface.
+type world struct {
+	apps     map[appdom.AppID]*appdom.App
+	roles    map[role.RoleID]*role.Role
+	users    map[identity.UserID]*identity.User
+	accounts map[serviceaccount.ServiceAccountID]*serviceaccount.ServiceAccount
+	members  map[access.GroupID][]access.UserID
+
+	assignments []*access.RoleAssignment
+	groupGrants []*access.GroupRoleAssignment
+	scoped      []*access.ScopedRoleAssignment
+	sodRules    []*access.SoDRule
+}
+
+func newWorld() *world {
+	w := &world{
+		apps:     map[appdom.AppID]*appdom.App{},
+		roles:    map[role.RoleID]*role.Role{},
+		users:    map[identity.UserID]*identity.User{},
+		accounts: map[serviceaccount.ServiceAccountID]*serviceaccount.ServiceAccount{},
+		members:  map[access.GroupID][]access.UserID{},
+	}
+	w.apps[appID] = appdom.RestoreApp(appdom.RestoreAppParams{
+		ID: appID, TenantID: tenant.System, Name: "Payments", Slug: "payments", Status: appdom.AppStatusActive,
+	})
+	w.addUser(adminID, identity.UserStatusActive)
+	return w
+}
+
+// roleSpec describes a role of the test app.
+type roleSpec struct {
+	perms      []string
+	conditions map[string]string
+	includes   []string
+	disabled   bool
+}
+
+func (w *world) addRole(id string, spec roleSpec) {
+	includes := make([]role.RoleID, len(spec.includes))
+	for i, inc := range spec.includes {
+		includes[i] = role.RoleID(inc)
+	}
+	status := role.RoleStatusActive
+	if spec.disabled {
+		status = role.RoleStatusDisabled
+	}
+	w.roles[role.RoleID(id)] = role.RestoreRole(role.RestoreRoleParams{
+		ID:          role.RoleID(id),
+		AppID:       appID,
+		TenantID:    tenant.System,
+		Name:        id,
+		Permissions: spec.perms,
+		Conditions:  spec.conditions,
+		Includes:    includes,
+		Status:      status,
+	})
+}
+
+func (w *world) addUser(id string, status identity.UserStatus) {
+	w.users[identity.UserID(id)] = identity.RestoreUser(identity.RestoreUserParams{
+		ID: identity.UserID(id), TenantID: tenant.System, Email: id + "@example.com", Status: status,
+	})
+}
+
+func (w *world) addServiceAccount(id string) {
+	w.accounts[serviceaccount.ServiceAccountID(id)] = serviceaccount.RestoreServiceAccount(serviceaccount.RestoreServiceAccountParams{
+		ID: serviceaccount.ServiceAccountID(id), TenantID: tenant.System, Status: serviceaccount.ServiceAccountActive, Name: id,
+	})
+}
+
+// assign adds a direct assignment of the role to the principal, as a
+// grant made before the test would have.
+func (w *world) assign(p access.Principal, roleID string, expiresAt *time.Time) {
+	w.assignments = append(w.assignments, &access.RoleAssignment{
+		Principal: p, RoleID: access.RoleID(roleID), AppID: appID, ExpiresAt: expiresAt,
+	})
+}
+
+// addGroup adds the group, or more members to it. A group exists once
+// it has an entry in members, empty or not.
+func (w *world) addGroup(groupID string, members ...string) {
+	gid := access.GroupID(groupID)
+	if _, ok := w.members[gid]; !ok {
+		w.members[gid] = nil
+	}
+	for _, m := range members {
+		w.members[gid] = append(w.members[gid], access.UserID(m))
+	}
+}
+
+func (w *world) grantGroup(groupID, roleID string, members ...string) {
+	w.addGroup(groupID, members...)
+	w.groupGrants = append(w.groupGrants, &access.GroupRoleAssignment{
+		GroupID: access.GroupID(groupID), RoleID: access.RoleID(roleID), AppID: appID,
+	})
+}
+
+func (w *world) assignScoped(p access.Principal, roleID string, scope access.ResourceScope) {
+	w.scoped = append(w.scoped, &access.ScopedRoleAssignment{
+		Principal: p, RoleID: access.RoleID(roleID), AppID: appID, Scope: scope,
+	})
+}
+
+// holds reports whether p has a direct assignment of the role.
+func (w *world) holds(p access.Principal, roleID string) bool {
+	return slices.ContainsFunc(w.assignments, func(a *access.RoleAssignment) bool {
+		return a.Principal == p && a.RoleID == access.RoleID(roleID)
+	})
+}
+
+// groupsOf returns the groups the user is a member of.
+func (w *world) groupsOf(id access.UserID) []access.GroupID {
+	var out []access.GroupID
+	for g, ms := range w.members {
+		if slices.Contains(ms, id) {
+			out = append(out, g)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// newService wires a Service over the world, with the snapshot cache
+// off, and returns the events it audits.
+func (w *world) newService(now time.Time) (*Service, *recordingEmitter) {
+	em := &recordingEmitter{}
+	s := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)),
+		worldRepo{w: w}, worldUsers{w: w}, worldAccounts{w: w}, worldRoles{w: w}, worldApps{w: w}, worldGroups{w: w},
+		func() time.Time { return now }, em, nil, 0, 0)
+	return s, em
+}
+
+// asAdmin is a context carrying the admin as the calling actor.
+func asAdmin() context.Context {
+	return actor.Inject(context.Background(), actor.Actor{ID: adminID, Kind: actor.KindUser})
+}
+
+type recordingEmitter struct {
+	mu     sync.Mutex
+	events []*audit.Audit
+}
+
+func (e *recordingEmitter) Emit(_ context.Context, a *audit.Audit) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, a)
+	return nil
+}
+
+// only returns the single event recorded, failing the test otherwise.
+func (e *recordingEmitter) only(t *testing.T) *audit.Audit {
+	t.Helper()
+	if len(e.events) != 1 {
+		t.Fatalf("audited %d events, want 1", len(e.events))
+	}
+	return e.events[0]
+}
+
+// ----------------------------------------------------------------------------
+// Fakes
+// ----------------------------------------------------------------------------
+
+type worldApps struct {
+	appdom.Repository
+	w *world
+}
+
+func (a worldApps) GetByID(_ context.Context, id appdom.AppID) (*appdom.App, error) {
+	if ap, ok := a.w.apps[id]; ok {
+		return ap, nil
+	}
+	return nil, appdom.ErrAppNotFound
+}
+
+type worldRoles struct {
+	role.Repository
+	w *world
+}
+
+func (r worldRoles) GetByID(_ context.Context, id role.RoleID) (*role.Role, error) {
+	if ro, ok := r.w.roles[id]; ok {
+		return ro, nil
+	}
+	return nil, role.ErrRoleNotFound
+}
+
+type worldUsers struct {
+	identity.Repository
+	w *world
+}
+
+func (u worldUsers) GetByID(_ context.Context, id identity.UserID) (*identity.User, error) {
+	if us, ok := u.w.users[id]; ok {
+		return us, nil
+	}
+	return nil, identity.ErrUserNotFound
+}
+
+type worldAccounts struct {
+	serviceaccount.Repository
+	w *world
+}
+
+func (a worldAccounts) GetByID(_ context.Context, id serviceaccount.ServiceAccountID) (*serviceaccount.ServiceAccount, error) {
+	if sa, ok := a.w.accounts[id]; ok {
+		return sa, nil
+	}
+	return nil, serviceaccount.ErrServiceAccountNotFound
+}
+
+// worldGroups knows the groups that have an entry in members; access
+// only asks whether one exists.
+type worldGroups struct{ w *world }
+
+func (g worldGroups) GetByID(_ context.Context, id group.GroupID) (*group.Group, error) {
+	if _, ok := g.w.members[access.GroupID(id)]; ok {
+		return new(group.Group), nil
+	}
+	return nil, group.ErrGroupNotFound
+}
+
+// worldRepo is access.Repository over the world, following the
+// contracts documented on the interface.
+type worldRepo struct {
+	access.Repository
+	w *world
+}
+
+func (r worldRepo) Create(_ context.Context, a *access.RoleAssignment) (bool, error) {
+	if r.w.holds(a.Principal, a.RoleID.String()) {
+		return false, nil
+	}
+	r.w.assignments = append(r.w.assignments, a)
+	return true, nil
+}
+
+func (r worldRepo) Get(_ context.Context, p access.Principal, roleID access.RoleID) (*access.RoleAssignment, error) {
+	for _, a := range r.w.assignments {
+		if a.Principal == p && a.RoleID == roleID {
+			return a, nil
+		}
+	}
+	return nil, access.ErrAssignmentNotFound
+}
+
+func (r worldRepo) BulkCreate(ctx context.Context, as []*access.RoleAssignment) ([]bool, error) {
+	created := make([]bool, len(as))
+	for i, a := range as {
+		created[i], _ = r.Create(ctx, a)
+	}
+	return created, nil
+}
+
+func (r worldRepo) ListSoDRules(_ context.Context, aid access.AppID) ([]*access.SoDRule, error) {
+	var out []*access.SoDRule
+	for _, rule := range r.w.sodRules {
+		if rule.AppID == aid {
+			out = append(out, rule)
+		}
+	}
+	return out, nil
+}
+
+func (r worldRepo) ListHeldRoleIDs(_ context.Context, p access.Principal, aid access.AppID, now time.Time) ([]access.RoleID, error) {
+	var out []access.RoleID
+	for _, a := range r.w.assignments {
+		if a.Principal == p && a.AppID == aid && !a.IsExpired(now) {
+			out = append(out, a.RoleID)
+		}
+	}
+	if p.IsUser() {
+		groups := r.w.groupsOf(p.UserID())
+		for _, g := range r.w.groupGrants {
+			if g.AppID == aid && slices.Contains(groups, g.GroupID) {
+				out = append(out, g.RoleID)
+			}
+		}
+	}
+	return out, nil
+}
+
+// ListActivePermissions walks the include graph down from every role p
+// holds, as the recursive CTE does: a role that is missing or not
+// ACTIVE neither grants nor passes on, and a cycle is cut where it
+// closes.
+func (r worldRepo) ListActivePermissions(_ context.Context, p access.Principal, aid access.AppID, now time.Time) ([]access.PermissionRow, error) {
+	var rows []access.PermissionRow
+	var walk func(id access.RoleID, path []access.RoleID, scope *access.ResourceScope)
+	walk = func(id access.RoleID, path []access.RoleID, scope *access.ResourceScope) {
+		ro, ok := r.w.roles[role.RoleID(id)]
+		if !ok || ro.Status() != role.RoleStatusActive || ro.AppID() != appdom.AppID(aid) || slices.Contains(path, id) {
+			return
+		}
+		path = append(slices.Clone(path), id)
+		for _, perm := range ro.Permissions() {
+			rows = append(rows, access.PermissionRow{
+				RoleID: id, Permission: perm, Condition: ro.Conditions()[perm], Path: path, Scope: scope,
+			})
+		}
+		for _, inc := range ro.Includes() {
+			walk(access.RoleID(inc), path, scope)
+		}
+	}
+	for _, a := range r.w.assignments {
+		if a.Principal == p && a.AppID == aid && a.InWindow(now) {
+			walk(a.RoleID, nil, nil)
+		}
+	}
+	if p.IsUser() {
+		groups := r.w.groupsOf(p.UserID())
+		for _, g := range r.w.groupGrants {
+			if g.AppID == aid && slices.Contains(groups, g.GroupID) {
+				walk(g.RoleID, nil, nil)
+			}
+		}
+	}
+	for _, sa := range r.w.scoped {
+		if sa.Principal == p && sa.AppID == aid {
+			walk(sa.RoleID, nil, &sa.Scope)
+		}
+	}
+	return rows, nil
+}
+
+// ListPermissionHolders is the reverse query worked the other way
+// round from ListActivePermissions: it finds the roles carrying the
+// permission and climbs to the roles including them until nothing
+// changes, then collects the holders of those roles.
+func (r worldRepo) ListPermissionHolders(_ context.Context, q access.PermissionHoldersQuery) ([]access.PermissionHolder, error) {
+	resource, _, _ := strings.Cut(q.Permission, ":")
+	active := func(ro *role.Role) bool {
+		return ro.Status() == role.RoleStatusActive && ro.AppID() == appdom.AppID(q.AppID)
+	}
+	// granting maps a role granting the permission to whether some path
+	// from it ends in an unconditional permission.
+	granting := map[access.RoleID]bool{}
+	for id, ro := range r.w.roles {
+		if !active(ro) {
+			continue
+		}
+		for _, perm := range ro.Permissions() {
+			if perm == q.Permission || perm == resource+":*" {
+				granting[access.RoleID(id)] = granting[access.RoleID(id)] || ro.Conditions()[perm] == ""
+			}
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for id, ro := range r.w.roles {
+			if !active(ro) {
+				continue
+			}
+			for _, inc := range ro.Includes() {
+				incUncond, ok := granting[access.RoleID(inc)]
+				if !ok {
+					continue
+				}
+				was, had := granting[access.RoleID(id)]
+				if now := was || incUncond; !had || now != was {
+					granting[access.RoleID(id)] = now
+					changed = true
+				}
+			}
+		}
+	}
+
+	byID := map[string]*access.PermissionHolder{}
+	add := func(p access.Principal, roleID access.RoleID, appWide bool) {
+		uncond, ok := granting[roleID]
+		if !ok {
+			return
+		}
+		h := byID[p.ID]
+		if h == nil {
+			h = &access.PermissionHolder{Principal: p, Conditional: true}
+			byID[p.ID] = h
+		}
+		if appWide {
+			h.AppWide = true
+			h.Conditional = h.Conditional && !uncond
+		} else {
+			h.Scoped = true
+		}
+	}
+	for _, a := range r.w.assignments {
+		if a.InWindow(q.Now) {
+			add(a.Principal, a.RoleID, true)
+		}
+	}
+	for _, g := range r.w.groupGrants {
+		for _, m := range r.w.members[g.GroupID] {
+			add(access.UserPrincipal(m), g.RoleID, true)
+		}
+	}
+	for _, sa := range r.w.scoped {
+		add(sa.Principal, sa.RoleID, false)
+	}
+
+	var out []access.PermissionHolder
+	for id, h := range byID {
+		if id > q.After {
+			if !h.AppWide {
+				h.Conditional = false
+			}
+			out = append(out, *h)
+		}
+	}
+	slices.SortFunc(out, func(a, b access.PermissionHolder) int { return strings.Compare(a.Principal.ID, b.Principal.ID) })
+	if len(out) > q.PageSize+1 {
+		out = out[:q.PageSize+1]
+	}
+	return out, nil
+}
+
+func (r worldRepo) CreateGroupAssignment(ctx context.Context, a *access.GroupRoleAssignment) (bool, error) {
+	if _, err := r.GetGroupAssignment(ctx, a.GroupID, a.RoleID); err == nil {
+		return false, nil
+	}
+	r.w.groupGrants = append(r.w.groupGrants, a)
+	return true, nil
+}
+
+func (r worldRepo) GetGroupAssignment(_ context.Context, gid access.GroupID, rid access.RoleID) (*access.GroupRoleAssignment, error) {
+	for _, g := range r.w.groupGrants {
+		if g.GroupID == gid && g.RoleID == rid {
+			return g, nil
+		}
+	}
+	return nil, access.ErrAssignmentNotFound
+}
+
+func (r worldRepo) DeleteGroupAssignment(_ context.Context, gid access.GroupID, rid access.RoleID) (bool, error) {
+	n := len(r.w.groupGrants)
+	r.w.groupGrants = slices.DeleteFunc(r.w.groupGrants, func(g *access.GroupRoleAssignment) bool {
+		return g.GroupID == gid && g.RoleID == rid
+	})
+	return len(r.w.groupGrants) < n, nil
+}
+
+func (r worldRepo) HasRoleViaGroup(_ context.Context, uid access.UserID, rid access.RoleID) (bool, error) {
+	groups := r.w.groupsOf(uid)
+	return slices.ContainsFunc(r.w.groupGrants, func(g *access.GroupRoleAssignment) bool {
+		return g.RoleID == rid && slices.Contains(groups, g.GroupID)
+	}), nil
+}
+
+func (r worldRepo) ListRoleMembers(_ context.Context, q access.RoleMembersQuery) ([]access.RoleMember, error) {
+	byID := map[string]*access.RoleMember{}
+	member := func(p access.Principal) *access.RoleMember {
+		if byID[p.ID] == nil {
+			byID[p.ID] = &access.RoleMember{Principal: p}
+		}
+		return byID[p.ID]
+	}
+	for _, a := range r.w.assignments {
+		if a.RoleID == q.RoleID && a.InWindow(q.Now) {
+			member(a.Principal).Direct = true
+		}
+	}
+	for _, g := range r.w.groupGrants {
+		if g.RoleID != q.RoleID {
+			continue
+		}
+		for _, uid := range r.w.members[g.GroupID] {
+			m := member(access.UserPrincipal(uid))
+			m.ViaGroups = append(m.ViaGroups, g.GroupID)
+		}
+	}
+	var out []access.RoleMember
+	for id, m := range byID {
+		if id > q.After {
+			slices.Sort(m.ViaGroups)
+			out = append(out, *m)
+		}
+	}
+	slices.SortFunc(out, func(a, b access.RoleMember) int { return strings.Compare(a.Principal.ID, b.Principal.ID) })
+	if len(out) > q.PageSize+1 {
+		out = out[:q.PageSize+1]
+	}
+	return out, nil
+}
+
+// ListUserRoles pages the roles p holds in the app, directly or through
+// its groups, whatever their window, in role id order.
+func (r worldRepo) ListUserRoles(_ context.Context, q access.ListUserRolesQuery) (access.ListUserRolesResult, error) {
+	byRole := map[access.RoleID]*access.ListUserRolesRow{}
+	row := func(id access.RoleID) *access.ListUserRolesRow {
+		if byRole[id] == nil {
+			byRole[id] = &access.ListUserRolesRow{RoleID: id}
+		}
+		return byRole[id]
+	}
+	for _, a := range r.w.assignments {
+		if a.Principal == q.Principal && a.AppID == q.AppID {
+			row(a.RoleID).Direct = true
+		}
+	}
+	if q.Principal.IsUser() {
+		groups := r.w.groupsOf(q.Principal.UserID())
+		for _, g := range r.w.groupGrants {
+			if g.AppID == q.AppID && slices.Contains(groups, g.GroupID) {
+				x := row(g.RoleID)
+				x.ViaGroups = append(x.ViaGroups, g.GroupID)
+			}
+		}
+	}
+	var rows []access.ListUserRolesRow
+	for id, x := range byRole {
+		if q.After == nil || id > q.After.RoleID {
+			rows = append(rows, *x)
+		}
+	}
+	slices.SortFunc(rows, func(a, b access.ListUserRolesRow) int { return strings.Compare(a.RoleID.String(), b.RoleID.String()) })
+	var res access.ListUserRolesResult
+	if len(rows) > q.PageSize {
+		rows = rows[:q.PageSize]
+		res.NextCursor = &access.PageCursor{RoleID: rows[len(rows)-1].RoleID}
+	}
+	res.Rows = rows
+	return res, nil
+}
+
+func (r worldRepo) ListScopedAssignments(_ context.Context, p access.Principal, aid access.AppID) ([]*access.ScopedRoleAssignment, error) {
+	var out []*access.ScopedRoleAssignment
+	for _, sa := range r.w.scoped {
+		if sa.Principal == p && sa.AppID == aid {
+			out = append(out, sa)
+		}
+	}
+	return out, nil
+}
@@ -3,29 +3,41 @@
 // constructs a single *access.Module and pulls everything else off it:
 //
 //	mod.RegisterServer(grpcServer)  // attaches the AccessService handler
+//	mod.HTTPRoutes(authn)           // group, time-bound and scoped grants, effective roles, checks, explain, reverse queries, SoD rules
 //	mod.Service()                   // application-layer service
+//	mod.Start(ctx)                  // expired-assignment sweeper
+//	mod.SetAttributes(src)          // user attributes for permission conditions
+//	mod.SetSoDOverride(checker)     // who may grant past a separation-of-duties rule
+//	mod.WriteMetrics(w)             // permission snapshot cache counters
 //
 // The constructor owns the internal dependency graph (db → repo →
 // service → handler). Cross-context cooperation: access pulls
-// identity.Repository, role.Repository, and app.Repository for
-// precondition checks (role active, user not blocked/deleted, role
-// belongs to app, etc.). The access aggregate itself stays free of
+// identity.Repository, serviceaccount.Repository, role.Repository, and
+// app.Repository for precondition checks (role active, user not
+// blocked/deleted, role belongs to app, etc.). The access aggregate itself stays free of
 // those imports — only its use-case layer reaches across.
 package access
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"io"
 	"log/slog"
+	"net/http"
 	"time"
 
+	"sso/internal/kernel/authzver"
 	grpcadapter "sso/internal/modules/access/internal/grpc"
+	"sso/internal/modules/access/internal/httpapi"
 	"sso/internal/modules/access/internal/mariadb"
 	"sso/internal/modules/access/internal/service"
 	"sso/internal/modules/app"
 	"sso/internal/modules/audit"
+	"sso/internal/modules/group"
 	"sso/internal/modules/identity"
 	"sso/internal/modules/role"
+	"sso/internal/modules/serviceaccount"
 
 	"google.golang.org/grpc"
 )
@@ -35,18 +47,37 @@ import (
 type Emitter = audit.Emitter
 
 // Deps lists everything access needs from its host. Beyond the usual
-// DB / Log / Clock / Audit, access needs the three sibling
+// DB / Log / Clock / Audit, access needs the four sibling
 // repositories — supplied by the sibling Module.Repository() getters
-// in bootstrap.
+// in bootstrap — and the group reader for group grants.
 type Deps struct {
 	DB    *sql.DB
 	Log   *slog.Logger
 	Clock func() time.Time
 	Audit Emitter
 
-	Users identity.Repository
-	Roles role.Repository
-	Apps  app.Repository
+	Users           identity.Repository
+	ServiceAccounts serviceaccount.Repository
+	Roles           role.Repository
+	Apps            app.Repository
+	Groups          group.GroupReader
+
+	// ExpirySweepInterval is how often Start deletes lapsed
+	// assignments. Defaults to one minute.
+	ExpirySweepInterval time.Duration
+
+	// Version is bumped by every access write and drives the
+	// permission snapshot cache, which keeps a snapshot for at most
+	// SnapshotTTL and SnapshotMaxEntries snapshots at a time. A nil
+	// Version or a zero SnapshotTTL leaves the checks uncached.
+	Version            authzver.Version
+	SnapshotTTL        time.Duration
+	SnapshotMaxEntries int
+
+	// SoDOverridePermission is the admin permission that lets a grant
+	// break a separation-of-duties rule (see SetSoDOverride). Defaults
+	// to "access:sod_override".
+	SoDOverridePermission string
 }
 
 // Module is the assembled access bounded context. Construct with New;
@@ -55,6 +86,10 @@ type Module struct {
 	service *service.Service
 	handler *grpcadapter.Handler
 	repo    *mariadb.Repository
+	log     *slog.Logger
+
+	sweepInterval         time.Duration
+	sodOverridePermission string
 }
 
 // New wires the module from its dependencies.
@@ -68,30 +103,50 @@ func New(d Deps) (*Module, error) {
 	if d.Users == nil {
 		return nil, fmt.Errorf("access: users repository is required")
 	}
+	if d.ServiceAccounts == nil {
+		return nil, fmt.Errorf("access: service accounts repository is required")
+	}
 	if d.Roles == nil {
 		return nil, fmt.Errorf("access: roles repository is required")
 	}
 	if d.Apps == nil {
 		return nil, fmt.Errorf("access: apps repository is required")
 	}
+	if d.Groups == nil {
+		return nil, fmt.Errorf("access: groups reader is required")
+	}
 	if d.Clock == nil {
 		d.Clock = time.Now
 	}
 	if d.Audit == nil {
 		d.Audit = audit.NopEmitter{}
 	}
+	if d.ExpirySweepInterval <= 0 {
+		d.ExpirySweepInterval = time.Minute
+	}
+	if d.SoDOverridePermission == "" {
+		d.SoDOverridePermission = "access:sod_override"
+	}
+	if d.SnapshotTTL > 0 && d.SnapshotMaxEntries <= 0 {
+		return nil, fmt.Errorf("access: snapshot max entries must be > 0 when the snapshot cache is on")
+	}
 
 	repo := mariadb.NewRepository(d.DB)
 
 	var _ Repository = repo
 
-	svc := service.NewService(d.Log, repo, d.Users, d.Roles, d.Apps, d.Clock, d.Audit)
+	svc := service.NewService(d.Log, repo, d.Users, d.ServiceAccounts, d.Roles, d.Apps, d.Groups, d.Clock, d.Audit,
+		d.Version, d.SnapshotTTL, d.SnapshotMaxEntries)
 	h := grpcadapter.NewHandler(svc, d.Log)
 
 	return &Module{
 		service: svc,
 		handler: h,
 		repo:    repo,
+		log:     d.Log,
+
+		sweepInterval:         d.ExpirySweepInterval,
+		sodOverridePermission: d.SoDOverridePermission,
 	}, nil
 }
 
@@ -101,5 +156,73 @@ func (m *Module) RegisterServer(s *grpc.Server) {
 	m.handler.RegisterServer(s)
 }
 
+// HTTPRoutes returns the registrar for the HTTP-only access endpoints
+// (see httpapi.Handler.Register). The authenticator and
+// authorizer are taken here rather than in Deps because bootstrap
+// builds them (grpcauth.Interceptor, httprbac.Authorizer) after access.
+func (m *Module) HTTPRoutes(authn Authenticator, authz Authorizer) func(*http.ServeMux) {
+	h := httpapi.NewHandler(m.service, authn, authz, m.log)
+	return h.Register
+}
+
+// SetAttributes late-binds the source of the user attributes that
+// permission conditions read. bootstrap builds the attribute module
+// after access; checks made before this call see no attributes.
+func (m *Module) SetAttributes(src AttributeSource) { m.service.SetAttributes(src) }
+
+// SetSoDOverride late-binds the check behind the x-sod-override header:
+// a grant breaking a separation-of-duties rule goes through when c says
+// the caller holds SoDOverridePermission. The admin authorizer is built
+// on access, so it cannot be in Deps; until this call no grant may
+// override a rule.
+func (m *Module) SetSoDOverride(c SoDOverrideChecker) {
+	m.service.SetSoDOverride(c, m.sodOverridePermission)
+}
+
+// Start launches the sweeper that deletes role assignments past their
+// expires_at, every ExpirySweepInterval until ctx is cancelled. It
+// returns immediately.
+func (m *Module) Start(ctx context.Context) {
+	go m.sweepExpired(ctx)
+}
+
+func (m *Module) sweepExpired(ctx context.Context) {
+	ticker := time.NewTicker(m.sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := m.service.SweepExpiredAssignments(ctx)
+			if err != nil && ctx.Err() == nil {
+				m.log.ErrorContext(ctx, "access: sweep expired assignments", "removed", n, "err", err)
+				continue
+			}
+			if n > 0 {
+				m.log.InfoContext(ctx, "access: swept expired assignments", "removed", n)
+			}
+		}
+	}
+}
+
+// WriteMetrics writes the permission snapshot cache counters in the
+// Prometheus text format.
+func (m *Module) WriteMetrics(w io.Writer) {
+	st := m.service.SnapshotStats()
+	for _, mt := range []struct {
+		name, kind, help string
+		value            uint64
+	}{
+		{"sso_access_snapshot_hits_total", "counter", "Permission checks served from a cached snapshot.", st.Hits},
+		{"sso_access_snapshot_misses_total", "counter", "Permission checks that read their snapshot from the database.", st.Misses},
+		{"sso_access_snapshot_stale_total", "counter", "Cached snapshots found invalidated or expired on lookup.", st.Stale},
+		{"sso_access_snapshot_evictions_total", "counter", "Cached snapshots evicted to make room.", st.Evictions},
+		{"sso_access_snapshot_entries", "gauge", "Cached permission snapshots.", uint64(st.Entries)},
+	} {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %d\n", mt.name, mt.help, mt.name, mt.kind, mt.name, mt.value)
+	}
+}
+
 // Service returns the application-layer Service.
 func (m *Module) Service() *service.Service { return m.service }
@@ -6,9 +6,22 @@ import "sso/internal/modules/access/internal/service"
 
 // Service is the use-case orchestrator. Methods correspond 1-to-1 to
 // the AccessService RPCs and are grouped by intent across files in
-// internal/service: grant.go, remove.go, check.go, list.go.
+// internal/service: grant.go, remove.go, check.go, list.go, and group.go
+// for the group grants, expiry.go for assignment expiry and scope.go
+// for resource-scoped grants, which have no RPC yet, explain.go for the
+// decision trace of a permission check, holders.go for the reverse
+// queries, and sod.go for separation-of-duties rules.
 type Service = service.Service
 
+// AttributeSource supplies the user attributes permission conditions
+// read (Module.SetAttributes). Satisfied by *attribute.Service.
+type AttributeSource = service.AttributeSource
+
+// SoDOverrideChecker decides who may grant past a separation-of-duties
+// rule (Module.SetSoDOverride). Satisfied by
+// *authz.AccessBackedAuthorizer.
+type SoDOverrideChecker = service.SoDOverrideChecker
+
 // Input / Output type aliases. One per RPC; the names match the
 // methods on Service.
 type (
@@ -25,4 +38,59 @@ type (
 	CheckPermissionOutput      = service.CheckPermissionOutput
 	BatchCheckPermissionInput  = service.BatchCheckPermissionInput
 	BatchCheckPermissionOutput = service.BatchCheckPermissionOutput
+	CheckContext               = service.CheckContext
+	RoleSource                 = service.RoleSource
+	GrantRoleToGroupInput      = service.GrantRoleToGroupInput
+	GrantRoleToGroupOutput     = service.GrantRoleToGroupOutput
+	RemoveRoleFromGroupInput   = service.RemoveRoleFromGroupInput
+	ListGroupRolesInput        = service.ListGroupRolesInput
+	ExtendRoleAssignmentInput  = service.ExtendRoleAssignmentInput
+)
+
+// Resource-scoped grants (scope.go). HTTP-only until the proto carries
+// a resource on assignments and checks.
+type (
+	ResourceTarget               = service.ResourceTarget
+	GrantScopedRoleInput         = service.GrantScopedRoleInput
+	GrantScopedRoleOutput        = service.GrantScopedRoleOutput
+	RemoveScopedRoleInput        = service.RemoveScopedRoleInput
+	ListScopedRolesInput         = service.ListScopedRolesInput
+	ListPermittedResourcesInput  = service.ListPermittedResourcesInput
+	ListPermittedResourcesOutput = service.ListPermittedResourcesOutput
+	PermittedResource            = service.PermittedResource
+)
+
+// Decision traces (explain.go). HTTP-only: the proto has no
+// ExplainPermission RPC.
+type (
+	ExplainPermissionInput  = service.ExplainPermissionInput
+	ExplainPermissionOutput = service.ExplainPermissionOutput
+	PreconditionFailure     = service.PreconditionFailure
+	ExplainedRole           = service.ExplainedRole
+	ExplainedPermission     = service.ExplainedPermission
 )
+
+// Reverse queries (holders.go). HTTP-only: the proto has no
+// ListPrincipalsWithPermission or ListRoleMembers RPC.
+type (
+	ListPrincipalsWithPermissionInput  = service.ListPrincipalsWithPermissionInput
+	ListPrincipalsWithPermissionOutput = service.ListPrincipalsWithPermissionOutput
+	ListRoleMembersInput               = service.ListRoleMembersInput
+	ListRoleMembersOutput              = service.ListRoleMembersOutput
+)
+
+// Separation-of-duties rules (sod.go). HTTP-only: the proto has no
+// rule or violation RPCs. The grant-time check itself runs inside
+// GrantRoleToUser and BulkGrantRoles.
+type (
+	CreateSoDRuleInput      = service.CreateSoDRuleInput
+	GetSoDRuleInput         = service.GetSoDRuleInput
+	ListSoDRulesInput       = service.ListSoDRulesInput
+	DeleteSoDRuleInput      = service.DeleteSoDRuleInput
+	ListSoDViolationsInput  = service.ListSoDViolationsInput
+	ListSoDViolationsOutput = service.ListSoDViolationsOutput
+)
+
+// Permission snapshot cache counters (snapshot.go); see
+// Module.WriteMetrics.
+type SnapshotStats = service.SnapshotStats
@@ -0,0 +1,57 @@
+// Package accessrequest is the public API of the accessrequest bounded
+// context. External callers interact with the module through:
+//
+//	accessrequest.New(Deps)    wires the module (module.go)
+//	accessrequest.Service      application-layer use-cases (service.go)
+//	accessrequest.Repository   persistence contract
+package accessrequest
+
+import (
+	"sso/internal/modules/accessrequest/internal/domain"
+	"sso/internal/modules/accessrequest/internal/httpapi"
+	"sso/internal/modules/accessrequest/internal/service"
+)
+
+type (
+	Request              = domain.Request
+	RequestID            = domain.RequestID
+	Status               = domain.Status
+	NewRequestParams     = domain.NewRequestParams
+	RestoreRequestParams = domain.RestoreRequestParams
+	Repository           = domain.Repository
+
+	// Access is satisfied by *access.Service.
+	Access = service.Access
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+)
+
+// Status enum re-exports.
+const (
+	StatusPending   = domain.StatusPending
+	StatusApproved  = domain.StatusApproved
+	StatusDenied    = domain.StatusDenied
+	StatusCancelled = domain.StatusCancelled
+	StatusExpired   = domain.StatusExpired
+)
+
+var (
+	NewRequestID   = domain.NewRequestID
+	ParseRequestID = domain.ParseRequestID
+	ParseStatus    = domain.ParseStatus
+)
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var (
+	ErrRequestNotFound      = domain.ErrRequestNotFound
+	ErrEtagMismatch         = domain.ErrEtagMismatch
+	ErrRequestNotPending    = domain.ErrRequestNotPending
+	ErrRequestExpired       = domain.ErrRequestExpired
+	ErrPendingRequestExists = domain.ErrPendingRequestExists
+	ErrNotApprover          = domain.ErrNotApprover
+	ErrSelfApproval         = domain.ErrSelfApproval
+	ErrRequesterNotUser     = domain.ErrRequesterNotUser
+	ErrNotRequester         = domain.ErrNotRequester
+)
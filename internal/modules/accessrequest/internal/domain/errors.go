@@ -0,0 +1,34 @@
+package domain
+
+import "errors"
+
+var (
+	ErrRequestNotFound = errors.New("accessrequest: request not found")
+	ErrEtagMismatch    = errors.New("accessrequest: etag mismatch")
+
+	// ErrRequestNotPending — the request was already approved, denied,
+	// cancelled or expired.
+	ErrRequestNotPending = errors.New("accessrequest: request not pending")
+
+	// ErrRequestExpired — the request waited past its expires_at and
+	// can no longer be approved or denied.
+	ErrRequestExpired = errors.New("accessrequest: request expired")
+
+	// ErrPendingRequestExists — the requester already has a pending
+	// request for the role.
+	ErrPendingRequestExists = errors.New("accessrequest: pending request exists")
+
+	// ErrNotApprover — the caller lacks the approver permission in the
+	// request's app.
+	ErrNotApprover = errors.New("accessrequest: caller is not an approver")
+
+	// ErrSelfApproval — the caller is the requester.
+	ErrSelfApproval = errors.New("accessrequest: requesters cannot decide their own request")
+
+	// ErrRequesterNotUser — a service account asked for access; its
+	// roles are granted outright.
+	ErrRequesterNotUser = errors.New("accessrequest: only users may request access")
+
+	// ErrNotRequester — only the requester may cancel a request.
+	ErrNotRequester = errors.New("accessrequest: caller is not the requester")
+)
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"sso/internal/kernel/etag"
+)
+
+// Repository is the persistence contract for the accessrequest context.
+//
+// Error contract:
+//
+//	Create   → ErrPendingRequestExists
+//	GetByID  → ErrRequestNotFound
+//	Update   → ErrRequestNotFound / ErrEtagMismatch
+//
+// Update joins the ambient transaction of dbutil.WithTx, if any, so an
+// approval and its grant commit together. expectedEtag "" means
+// unconditional, same as every other module.
+type Repository interface {
+	Create(ctx context.Context, r *Request) error
+	GetByID(ctx context.Context, id RequestID) (*Request, error)
+	List(ctx context.Context, q ListQuery) (ListResult, error)
+	Update(ctx context.Context, r *Request, expectedEtag etag.Etag) error
+
+	// ListExpired returns up to limit pending requests whose expires_at
+	// is at or before now, earliest first, whatever their tenant.
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*Request, error)
+}
+
+// ListQuery pages requests newest first. Empty filters do not filter.
+type ListQuery struct {
+	PageSize    int
+	After       *PageCursor
+	Statuses    []Status
+	AppID       AppID
+	RoleID      RoleID
+	RequesterID UserID
+}
+
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        RequestID
+}
+
+type ListResult struct {
+	Requests   []*Request
+	NextCursor *PageCursor
+}
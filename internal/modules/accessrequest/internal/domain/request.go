@@ -0,0 +1,313 @@
+// Package domain holds the Request aggregate of the accessrequest
+// bounded context: a user's ask for one role of an app, for a stated
+// reason and a bounded time, which an approver grants or denies.
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/validation"
+)
+
+// ----------------------------------------------------------------------------
+// IDs
+// ----------------------------------------------------------------------------
+
+// RequestID — RFC 4122 UUID, generated as v7 (k-sortable).
+type RequestID string
+
+func NewRequestID() (RequestID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate access request id: %w", err)
+	}
+	return RequestID(id.String()), nil
+}
+
+func ParseRequestID(s string) (RequestID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "request_id", Reason: "must be a valid UUID"}
+	}
+	return RequestID(s), nil
+}
+
+func (id RequestID) String() string { return string(id) }
+
+// UserID is a cross-context handle to identity.User: the requester.
+type UserID string
+
+func ParseUserID(s string) (UserID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "requester_id", Reason: "must be a valid UUID"}
+	}
+	return UserID(s), nil
+}
+
+func (id UserID) String() string { return string(id) }
+
+// AppID is a cross-context handle to app.App.
+type AppID string
+
+func (id AppID) String() string { return string(id) }
+
+// RoleID is a cross-context handle to role.Role.
+type RoleID string
+
+func (id RoleID) String() string { return string(id) }
+
+// ActorID is the user or service account that approved or denied the
+// request.
+type ActorID string
+
+func (id ActorID) String() string { return string(id) }
+
+// ----------------------------------------------------------------------------
+// Status
+// ----------------------------------------------------------------------------
+
+// Status is the on-wire value of access_requests.status — do not
+// renumber.
+type Status uint8
+
+const (
+	StatusPending   Status = 1
+	StatusApproved  Status = 2
+	StatusDenied    Status = 3
+	StatusCancelled Status = 4
+	// StatusExpired — nobody decided before ExpiresAt; set by the
+	// expiry sweep.
+	StatusExpired Status = 5
+)
+
+func ParseStatus(s string) (Status, error) {
+	switch s {
+	case "pending":
+		return StatusPending, nil
+	case "approved":
+		return StatusApproved, nil
+	case "denied":
+		return StatusDenied, nil
+	case "cancelled":
+		return StatusCancelled, nil
+	case "expired":
+		return StatusExpired, nil
+	}
+	return 0, &validation.Error{Field: "status", Reason: "must be one of: pending, approved, denied, cancelled, expired"}
+}
+
+func (s Status) String() string {
+	switch s {
+	case StatusPending:
+		return "pending"
+	case StatusApproved:
+		return "approved"
+	case StatusDenied:
+		return "denied"
+	case StatusCancelled:
+		return "cancelled"
+	case StatusExpired:
+		return "expired"
+	}
+	return fmt.Sprintf("Status(%d)", s)
+}
+
+// ----------------------------------------------------------------------------
+// Request aggregate
+// ----------------------------------------------------------------------------
+//
+// What is asked for (app, role, duration, justification) is fixed at
+// creation. A pending request is closed exactly once, through Approve,
+// Deny, Cancel or Expire, each of which advances the etag.
+
+const maxTextLen = 1024
+
+type Request struct {
+	id              RequestID
+	tenantID        string
+	appID           AppID
+	roleID          RoleID
+	requesterID     UserID
+	duration        time.Duration
+	status          Status
+	decidedBy       ActorID
+	decisionComment string
+	decidedAt       time.Time
+	grantExpiresAt  time.Time
+	etag            etag.Etag
+	expiresAt       time.Time
+	createdAt       time.Time
+	updatedAt       time.Time
+
+	Justification string
+}
+
+type NewRequestParams struct {
+	ID            RequestID
+	TenantID      string
+	AppID         AppID
+	RoleID        RoleID
+	RequesterID   UserID
+	Justification string
+	Duration      time.Duration
+	ExpiresAt     time.Time // how long the request may wait for a decision
+	Now           time.Time
+}
+
+// NewRequest validates the justification and the duration and builds a
+// pending request.
+func NewRequest(p NewRequestParams) (*Request, error) {
+	just := strings.TrimSpace(p.Justification)
+	if n := utf8.RuneCountInString(just); n == 0 || n > maxTextLen {
+		return nil, &validation.Error{Field: "justification", Reason: fmt.Sprintf("length must be between 1 and %d", maxTextLen)}
+	}
+	if p.Duration < time.Minute {
+		return nil, &validation.Error{Field: "duration", Reason: "must be at least one minute"}
+	}
+	return &Request{
+		id:            p.ID,
+		tenantID:      p.TenantID,
+		appID:         p.AppID,
+		roleID:        p.RoleID,
+		requesterID:   p.RequesterID,
+		duration:      p.Duration.Truncate(time.Second),
+		status:        StatusPending,
+		etag:          etag.New(),
+		expiresAt:     p.ExpiresAt,
+		createdAt:     p.Now,
+		updatedAt:     p.Now,
+		Justification: just,
+	}, nil
+}
+
+type RestoreRequestParams struct {
+	ID              RequestID
+	TenantID        string
+	AppID           AppID
+	RoleID          RoleID
+	RequesterID     UserID
+	Justification   string
+	Duration        time.Duration
+	Status          Status
+	DecidedBy       ActorID
+	DecisionComment string
+	DecidedAt       time.Time
+	GrantExpiresAt  time.Time
+	Etag            etag.Etag
+	ExpiresAt       time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// RestoreRequest rebuilds a Request from a trusted row.
+func RestoreRequest(p RestoreRequestParams) *Request {
+	return &Request{
+		id:              p.ID,
+		tenantID:        p.TenantID,
+		appID:           p.AppID,
+		roleID:          p.RoleID,
+		requesterID:     p.RequesterID,
+		duration:        p.Duration,
+		status:          p.Status,
+		decidedBy:       p.DecidedBy,
+		decisionComment: p.DecisionComment,
+		decidedAt:       p.DecidedAt,
+		grantExpiresAt:  p.GrantExpiresAt,
+		etag:            p.Etag,
+		expiresAt:       p.ExpiresAt,
+		createdAt:       p.CreatedAt,
+		updatedAt:       p.UpdatedAt,
+		Justification:   p.Justification,
+	}
+}
+
+func (r *Request) ID() RequestID             { return r.id }
+func (r *Request) TenantID() string          { return r.tenantID }
+func (r *Request) AppID() AppID              { return r.appID }
+func (r *Request) RoleID() RoleID            { return r.roleID }
+func (r *Request) RequesterID() UserID       { return r.requesterID }
+func (r *Request) Duration() time.Duration   { return r.duration }
+func (r *Request) Status() Status            { return r.status }
+func (r *Request) DecidedBy() ActorID        { return r.decidedBy }
+func (r *Request) DecisionComment() string   { return r.decisionComment }
+func (r *Request) DecidedAt() time.Time      { return r.decidedAt }
+func (r *Request) GrantExpiresAt() time.Time { return r.grantExpiresAt }
+func (r *Request) Etag() etag.Etag           { return r.etag }
+func (r *Request) ExpiresAt() time.Time      { return r.expiresAt }
+func (r *Request) CreatedAt() time.Time      { return r.createdAt }
+func (r *Request) UpdatedAt() time.Time      { return r.updatedAt }
+
+// IsExpired reports whether a pending request can no longer be
+// approved.
+func (r *Request) IsExpired(now time.Time) bool {
+	return r.status == StatusPending && !now.Before(r.expiresAt)
+}
+
+// Approve records by's approval. The grant it leads to runs for the
+// request's duration from now; its expiry is returned by
+// GrantExpiresAt.
+func (r *Request) Approve(by ActorID, comment string, now time.Time) error {
+	if err := r.decide(by, comment, now); err != nil {
+		return err
+	}
+	r.status = StatusApproved
+	r.grantExpiresAt = now.Add(r.duration)
+	return nil
+}
+
+// Deny records by's refusal.
+func (r *Request) Deny(by ActorID, comment string, now time.Time) error {
+	if err := r.decide(by, comment, now); err != nil {
+		return err
+	}
+	r.status = StatusDenied
+	return nil
+}
+
+func (r *Request) decide(by ActorID, comment string, now time.Time) error {
+	if r.status != StatusPending {
+		return ErrRequestNotPending
+	}
+	if r.IsExpired(now) {
+		return ErrRequestExpired
+	}
+	comment = strings.TrimSpace(comment)
+	if utf8.RuneCountInString(comment) > maxTextLen {
+		return &validation.Error{Field: "comment", Reason: fmt.Sprintf("must be at most %d characters", maxTextLen)}
+	}
+	r.decidedBy = by
+	r.decisionComment = comment
+	r.decidedAt = now
+	r.bumpVersion(now)
+	return nil
+}
+
+// Cancel withdraws a pending request, expired or not.
+func (r *Request) Cancel(now time.Time) error {
+	if r.status != StatusPending {
+		return ErrRequestNotPending
+	}
+	r.status = StatusCancelled
+	r.bumpVersion(now)
+	return nil
+}
+
+// Expire closes a pending request that outlived ExpiresAt.
+func (r *Request) Expire(now time.Time) error {
+	if !r.IsExpired(now) {
+		return ErrRequestNotPending
+	}
+	r.status = StatusExpired
+	r.bumpVersion(now)
+	return nil
+}
+
+func (r *Request) bumpVersion(now time.Time) {
+	r.etag = etag.New()
+	r.updatedAt = now
+}
@@ -0,0 +1,56 @@
+package httpapi
+
+import (
+	"sso/internal/modules/access"
+	"sso/internal/modules/accessrequest/internal/domain"
+	"sso/internal/modules/role"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap translates accessrequest sentinels, and the role / access
+// ones the use-cases pass through, into statuses. errors.proto has no
+// access request reasons, so those entries are bare statuses (Reason
+// UNSPECIFIED) unless an existing reason fits.
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrRequestNotFound: {
+		Code: codes.NotFound, Message: "access request not found"},
+	domain.ErrEtagMismatch: {
+		Code: codes.Aborted, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ETAG_MISMATCH, Message: "etag mismatch"},
+	domain.ErrRequestNotPending: {
+		Code: codes.FailedPrecondition, Message: "access request is no longer pending"},
+	domain.ErrRequestExpired: {
+		Code: codes.FailedPrecondition, Message: "access request has expired"},
+	domain.ErrPendingRequestExists: {
+		Code: codes.AlreadyExists, Message: "a pending request for this role already exists"},
+	domain.ErrNotApprover: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "caller may not approve requests in this app"},
+	domain.ErrSelfApproval: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "requesters cannot decide their own request"},
+	domain.ErrRequesterNotUser: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "only users may request access"},
+	domain.ErrNotRequester: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "only the requester may cancel the request"},
+	role.ErrRoleNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_FOUND, Message: "role not found"},
+	role.ErrRoleDisabled: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_DISABLED, Message: "role is disabled"},
+	access.ErrRoleNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_FOUND, Message: "role not found"},
+	access.ErrRoleDisabled: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_DISABLED, Message: "role is disabled"},
+	access.ErrRoleNotInApp: {
+		Code: codes.InvalidArgument, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_IN_APP, Message: "role does not belong to the app"},
+	access.ErrAppNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_APP_NOT_FOUND, Message: "app not found"},
+	access.ErrUserNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_NOT_FOUND, Message: "user not found"},
+	access.ErrUserNotEligible: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_BLOCKED, Message: "requester is not eligible for assignments"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
@@ -0,0 +1,198 @@
+// Package httpapi is the HTTP adapter for the accessrequest context.
+//
+// No AccessRequestService contract is published in sso_protos, so
+// these are hand-written net/http handlers mounted next to the
+// grpc-gateway. Error bodies use the same google.rpc.Status JSON shape
+// as the gateway, so clients need a single error decoder.
+package httpapi
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/accessrequest/internal/domain"
+	reqsvc "sso/internal/modules/accessrequest/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+type Handler struct {
+	svc *reqsvc.Service
+	api *apiutil.Adapter
+	log *slog.Logger
+}
+
+func NewHandler(svc *reqsvc.Service, authn Authenticator, authz Authorizer, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("accessrequest", authn, authz, log, toStatus), log: log}
+}
+
+// Register mounts the access request endpoints. duration is a Go
+// duration string ("30m", "4h").
+//
+//	GET   /v1/access-requests?status=&app_id=&role_id=&requester_id=&page_size=&page_token=
+//	POST  /v1/access-requests
+//	GET   /v1/access-requests/{id}
+//	POST  /v1/access-requests/{id}/approve?etag=
+//	POST  /v1/access-requests/{id}/deny?etag=
+//	POST  /v1/access-requests/{id}/cancel?etag=
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/access-requests", h.api.Authed(h.list))
+	mux.HandleFunc("POST /v1/access-requests", h.api.Authed(h.create))
+	mux.HandleFunc("GET /v1/access-requests/{id}", h.api.Authed(h.get))
+	mux.HandleFunc("POST /v1/access-requests/{id}/approve", h.api.Authed(h.decide(h.svc.ApproveRequest)))
+	mux.HandleFunc("POST /v1/access-requests/{id}/deny", h.api.Authed(h.decide(h.svc.DenyRequest)))
+	mux.HandleFunc("POST /v1/access-requests/{id}/cancel", h.api.Authed(h.cancel))
+}
+
+type createBody struct {
+	AppID         string `json:"app_id"`
+	RoleID        string `json:"role_id"`
+	Justification string `json:"justification"`
+	Duration      string `json:"duration"`
+}
+
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	var b createBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	d, err := time.ParseDuration(b.Duration)
+	if err != nil {
+		h.api.WriteError(w, r, &validation.Error{Field: "duration", Reason: "must be a duration such as 30m or 4h"})
+		return
+	}
+	req, err := h.svc.CreateRequest(r.Context(), reqsvc.CreateRequestInput{
+		AppID:         b.AppID,
+		RoleID:        b.RoleID,
+		Justification: b.Justification,
+		Duration:      d,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusCreated, requestView(req))
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	in := reqsvc.ListRequestsInput{
+		PageToken:   q.Get("page_token"),
+		AppID:       q.Get("app_id"),
+		RoleID:      q.Get("role_id"),
+		RequesterID: q.Get("requester_id"),
+	}
+	if v := q.Get("page_size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			h.api.WriteError(w, r, &validation.Error{Field: "page_size", Reason: "must be an integer"})
+			return
+		}
+		in.PageSize = int32(n)
+	}
+	for _, v := range apiutil.SplitList(q.Get("status")) {
+		st, err := domain.ParseStatus(v)
+		if err != nil {
+			h.api.WriteError(w, r, err)
+			return
+		}
+		in.Statuses = append(in.Statuses, st)
+	}
+	out, err := h.svc.ListRequests(r.Context(), in)
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(out.Requests))
+	for _, req := range out.Requests {
+		views = append(views, requestView(req))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"access_requests": views,
+		"next_page_token": out.NextPageToken,
+	})
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
+	req, err := h.svc.GetRequest(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, requestView(req))
+}
+
+type decideBody struct {
+	Comment string `json:"comment"`
+}
+
+func (h *Handler) decide(fn func(context.Context, reqsvc.DecideRequestInput) (*domain.Request, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var b decideBody
+		if r.ContentLength != 0 {
+			if err := apiutil.DecodeJSON(r, &b); err != nil {
+				h.api.WriteError(w, r, err)
+				return
+			}
+		}
+		req, err := fn(r.Context(), reqsvc.DecideRequestInput{
+			RequestID:    r.PathValue("id"),
+			ExpectedEtag: r.URL.Query().Get("etag"),
+			Comment:      b.Comment,
+		})
+		if err != nil {
+			h.api.WriteError(w, r, err)
+			return
+		}
+		apiutil.WriteJSON(w, http.StatusOK, requestView(req))
+	}
+}
+
+func (h *Handler) cancel(w http.ResponseWriter, r *http.Request) {
+	req, err := h.svc.CancelRequest(r.Context(), reqsvc.CancelRequestInput{
+		RequestID:    r.PathValue("id"),
+		ExpectedEtag: r.URL.Query().Get("etag"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, requestView(req))
+}
+
+func requestView(req *domain.Request) map[string]any {
+	v := map[string]any{
+		"id":            req.ID().String(),
+		"app_id":        req.AppID().String(),
+		"role_id":       req.RoleID().String(),
+		"requester_id":  req.RequesterID().String(),
+		"justification": req.Justification,
+		"duration":      req.Duration().String(),
+		"status":        req.Status().String(),
+		"etag":          req.Etag().String(),
+		"expires_at":    req.ExpiresAt().UTC().Format(time.RFC3339),
+		"created_at":    req.CreatedAt().UTC().Format(time.RFC3339),
+		"updated_at":    req.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+	if req.DecidedBy() != "" {
+		v["decided_by"] = req.DecidedBy().String()
+		v["decided_at"] = req.DecidedAt().UTC().Format(time.RFC3339)
+		v["comment"] = req.DecisionComment()
+	}
+	if req.Status() == domain.StatusApproved {
+		v["grant_expires_at"] = req.GrantExpiresAt().UTC().Format(time.RFC3339)
+	}
+	return v
+}
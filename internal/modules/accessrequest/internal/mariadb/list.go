@@ -0,0 +1,93 @@
+package mariadb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/accessrequest/internal/domain"
+	"sso/internal/modules/accessrequest/internal/mariadb/dbgen"
+)
+
+// List is hand-written: every filter is optional and the keyset cursor
+// is (created_at, id) descending. Rows are filtered to tenant.Scope.
+func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListResult, error) {
+	if q.PageSize <= 0 {
+		return domain.ListResult{}, fmt.Errorf("accessrequest repo: list: page_size must be > 0")
+	}
+
+	var (
+		where []string
+		args  []any
+	)
+	if id := tenant.Scope(ctx); id != "" {
+		where = append(where, "tenant_id = ?")
+		args = append(args, id)
+	}
+	if len(q.Statuses) > 0 {
+		ph := make([]string, 0, len(q.Statuses))
+		for _, s := range q.Statuses {
+			ph = append(ph, "?")
+			args = append(args, uint8(s))
+		}
+		where = append(where, "status IN ("+strings.Join(ph, ", ")+")")
+	}
+	if q.AppID != "" {
+		where = append(where, "app_id = ?")
+		args = append(args, q.AppID.String())
+	}
+	if q.RoleID != "" {
+		where = append(where, "role_id = ?")
+		args = append(args, q.RoleID.String())
+	}
+	if q.RequesterID != "" {
+		where = append(where, "requester_id = ?")
+		args = append(args, q.RequesterID.String())
+	}
+	if q.After != nil {
+		where = append(where, "(created_at, id) < (?, ?)")
+		args = append(args, q.After.CreatedAt, q.After.ID.String())
+	}
+	whereSQL := ""
+	if len(where) > 0 {
+		whereSQL = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	query := fmt.Sprintf(
+		`SELECT id, tenant_id, app_id, role_id, requester_id, justification, duration_seconds,
+		        status, decided_by, decision_comment, decided_at, grant_expires_at, etag,
+		        expires_at, created_at, updated_at
+		 FROM access_requests %s ORDER BY created_at DESC, id DESC LIMIT %d`,
+		whereSQL, q.PageSize+1,
+	)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return domain.ListResult{}, fmt.Errorf("accessrequest repo: list: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*domain.Request, 0, q.PageSize)
+	for rows.Next() {
+		var a dbgen.AccessRequest
+		if err := rows.Scan(
+			&a.ID, &a.TenantID, &a.AppID, &a.RoleID, &a.RequesterID, &a.Justification, &a.DurationSeconds,
+			&a.Status, &a.DecidedBy, &a.DecisionComment, &a.DecidedAt, &a.GrantExpiresAt, &a.Etag,
+			&a.ExpiresAt, &a.CreatedAt, &a.UpdatedAt,
+		); err != nil {
+			return domain.ListResult{}, fmt.Errorf("accessrequest repo: list: scan: %w", err)
+		}
+		out = append(out, requestToDomain(a))
+	}
+	if err := rows.Err(); err != nil {
+		return domain.ListResult{}, fmt.Errorf("accessrequest repo: list: rows: %w", err)
+	}
+
+	var next *domain.PageCursor
+	if len(out) > q.PageSize {
+		out = out[:q.PageSize]
+		last := out[len(out)-1]
+		next = &domain.PageCursor{CreatedAt: last.CreatedAt(), ID: last.ID()}
+	}
+	return domain.ListResult{Requests: out, NextCursor: next}, nil
+}
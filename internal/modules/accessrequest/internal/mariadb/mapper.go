@@ -0,0 +1,82 @@
+package mariadb
+
+import (
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/accessrequest/internal/domain"
+	"sso/internal/modules/accessrequest/internal/mariadb/dbgen"
+)
+
+func requestToDomain(r dbgen.AccessRequest) *domain.Request {
+	return domain.RestoreRequest(domain.RestoreRequestParams{
+		ID:              domain.RequestID(r.ID),
+		TenantID:        r.TenantID,
+		AppID:           domain.AppID(r.AppID),
+		RoleID:          domain.RoleID(r.RoleID),
+		RequesterID:     domain.UserID(r.RequesterID),
+		Justification:   r.Justification,
+		Duration:        time.Duration(r.DurationSeconds) * time.Second,
+		Status:          domain.Status(r.Status),
+		DecidedBy:       domain.ActorID(r.DecidedBy.String),
+		DecisionComment: r.DecisionComment,
+		DecidedAt:       r.DecidedAt.Time,
+		GrantExpiresAt:  r.GrantExpiresAt.Time,
+		Etag:            etag.Etag(r.Etag),
+		ExpiresAt:       r.ExpiresAt,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
+	})
+}
+
+func toCreateParams(r *domain.Request) dbgen.CreateRequestParams {
+	return dbgen.CreateRequestParams{
+		ID:              r.ID().String(),
+		TenantID:        r.TenantID(),
+		AppID:           r.AppID().String(),
+		RoleID:          r.RoleID().String(),
+		RequesterID:     r.RequesterID().String(),
+		Justification:   r.Justification,
+		DurationSeconds: int64(r.Duration() / time.Second),
+		Status:          uint8(r.Status()),
+		DecidedBy:       dbutil.StringToNullString(r.DecidedBy().String()),
+		DecisionComment: r.DecisionComment(),
+		DecidedAt:       dbutil.TimeToNullTime(r.DecidedAt()),
+		GrantExpiresAt:  dbutil.TimeToNullTime(r.GrantExpiresAt()),
+		Etag:            r.Etag().String(),
+		ExpiresAt:       r.ExpiresAt(),
+		CreatedAt:       r.CreatedAt(),
+		UpdatedAt:       r.UpdatedAt(),
+	}
+}
+
+func toUpdateParams(r *domain.Request) dbgen.UpdateRequestParams {
+	return dbgen.UpdateRequestParams{
+		Status:          uint8(r.Status()),
+		DecidedBy:       dbutil.StringToNullString(r.DecidedBy().String()),
+		DecisionComment: r.DecisionComment(),
+		DecidedAt:       dbutil.TimeToNullTime(r.DecidedAt()),
+		GrantExpiresAt:  dbutil.TimeToNullTime(r.GrantExpiresAt()),
+		Etag:            r.Etag().String(),
+		UpdatedAt:       r.UpdatedAt(),
+		ID:              r.ID().String(),
+	}
+}
+
+// toUpdateWithEtagParams — Etag_2 is sqlc's positional name for the
+// `etag = ?` in the WHERE clause.
+func toUpdateWithEtagParams(r *domain.Request, expected etag.Etag) dbgen.UpdateRequestWithEtagParams {
+	u := toUpdateParams(r)
+	return dbgen.UpdateRequestWithEtagParams{
+		Status:          u.Status,
+		DecidedBy:       u.DecidedBy,
+		DecisionComment: u.DecisionComment,
+		DecidedAt:       u.DecidedAt,
+		GrantExpiresAt:  u.GrantExpiresAt,
+		Etag:            u.Etag,
+		UpdatedAt:       u.UpdatedAt,
+		ID:              u.ID,
+		Etag_2:          expected.String(),
+	}
+}
@@ -0,0 +1,35 @@
+-- Just-in-time access requests. The listing is hand-written (list.go).
+
+-- name: CreateRequest :exec
+INSERT INTO access_requests (
+    id, tenant_id, app_id, role_id, requester_id, justification,
+    duration_seconds, status, decided_by, decision_comment, decided_at,
+    grant_expires_at, etag, expires_at, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetRequestByID :one
+SELECT * FROM access_requests
+WHERE id = ?
+LIMIT 1;
+
+-- name: UpdateRequest :execresult
+UPDATE access_requests
+SET status = ?, decided_by = ?, decision_comment = ?, decided_at = ?,
+    grant_expires_at = ?, etag = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: UpdateRequestWithEtag :execresult
+UPDATE access_requests
+SET status = ?, decided_by = ?, decision_comment = ?, decided_at = ?,
+    grant_expires_at = ?, etag = ?, updated_at = ?
+WHERE id = ? AND etag = ?;
+
+-- name: CountRequestByID :one
+SELECT COUNT(*) FROM access_requests
+WHERE id = ?;
+
+-- name: ListExpiredRequests :many
+SELECT * FROM access_requests
+WHERE status = ? AND expires_at <= ?
+ORDER BY expires_at, id
+LIMIT ?;
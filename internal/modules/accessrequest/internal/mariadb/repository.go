@@ -0,0 +1,106 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/accessrequest/internal/domain"
+	"sso/internal/modules/accessrequest/internal/mariadb/dbgen"
+)
+
+type Repository struct {
+	db *sql.DB
+	q  *dbgen.Queries
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db, q: dbgen.New(db)}
+}
+
+var _ domain.Repository = (*Repository)(nil)
+
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one; an approval updates the request in
+// the transaction that makes the grant.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
+func (r *Repository) Create(ctx context.Context, req *domain.Request) error {
+	if err := r.queries(ctx).CreateRequest(ctx, toCreateParams(req)); err != nil {
+		// uk_access_requests_pending_key is the only unique key an
+		// insert with a fresh id can hit.
+		if dbutil.IsDuplicateEntry(err) {
+			return domain.ErrPendingRequestExists
+		}
+		return fmt.Errorf("accessrequest repo: create: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id domain.RequestID) (*domain.Request, error) {
+	row, err := r.queries(ctx).GetRequestByID(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRequestNotFound
+		}
+		return nil, fmt.Errorf("accessrequest repo: get: %w", err)
+	}
+	if !tenant.Visible(ctx, row.TenantID) {
+		return nil, domain.ErrRequestNotFound
+	}
+	return requestToDomain(row), nil
+}
+
+func (r *Repository) Update(ctx context.Context, req *domain.Request, expectedEtag etag.Etag) error {
+	q := r.queries(ctx)
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = q.UpdateRequest(ctx, toUpdateParams(req))
+	} else {
+		res, err = q.UpdateRequestWithEtag(ctx, toUpdateWithEtagParams(req, expectedEtag))
+	}
+	if err != nil {
+		return fmt.Errorf("accessrequest repo: update: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("accessrequest repo: rows_affected: %w", err)
+	}
+	if rows == 1 {
+		return nil
+	}
+	return dbutil.Discriminate(ctx, expectedEtag,
+		func(ctx context.Context) (int64, error) {
+			return q.CountRequestByID(ctx, req.ID().String())
+		},
+		domain.ErrRequestNotFound, domain.ErrEtagMismatch)
+}
+
+func (r *Repository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*domain.Request, error) {
+	rows, err := r.queries(ctx).ListExpiredRequests(ctx, dbgen.ListExpiredRequestsParams{
+		Status:    uint8(domain.StatusPending),
+		ExpiresAt: now,
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("accessrequest repo: list_expired: %w", err)
+	}
+	out := make([]*domain.Request, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, requestToDomain(row))
+	}
+	return out, nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/access"
+	"sso/internal/modules/accessrequest/internal/domain"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+)
+
+// ----------------------------------------------------------------------------
+// ApproveRequest / DenyRequest
+// ----------------------------------------------------------------------------
+
+type DecideRequestInput struct {
+	RequestID    string
+	ExpectedEtag string
+	Comment      string
+}
+
+// ApproveRequest grants the requested role for the request's duration,
+// counted from now. The caller must hold Config.ApproverPermission in
+// the request's app and must not be the requester. The grant is made
+// through access.GrantRoleToUser, as the approver, in the transaction
+// that stores the approval; if the requester already holds the role
+// with an earlier expiry it is extended instead, and a permanent
+// assignment is left as it is.
+func (s *Service) ApproveRequest(ctx context.Context, in DecideRequestInput) (*domain.Request, error) {
+	return s.decide(ctx, in, audit.EventTypeAccessRequestApprove, "approve access request",
+		func(r *domain.Request, by domain.ActorID, now time.Time) error {
+			return r.Approve(by, in.Comment, now)
+		})
+}
+
+// DenyRequest refuses a pending request. The same callers as for
+// ApproveRequest may deny it.
+func (s *Service) DenyRequest(ctx context.Context, in DecideRequestInput) (*domain.Request, error) {
+	return s.decide(ctx, in, audit.EventTypeAccessRequestDeny, "deny access request",
+		func(r *domain.Request, by domain.ActorID, now time.Time) error {
+			return r.Deny(by, in.Comment, now)
+		})
+}
+
+func (s *Service) decide(
+	ctx context.Context,
+	in DecideRequestInput,
+	evt audit.EventType,
+	op string,
+	apply func(r *domain.Request, by domain.ActorID, now time.Time) error,
+) (*domain.Request, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseRequestID(in.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, false)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, evt)
+	aud.SubjectType = audit.SubjectTypeAccessRequest
+	aud.SubjectID = id.String()
+
+	r, err := s.repo.GetByID(ctx, id)
+	if err == nil {
+		aud.AppID = r.AppID().String()
+		aud.Metadata = requestMetadata(r)
+		err = s.requireApprover(ctx, a, r)
+	}
+	if err == nil && expectedEtag != "" && expectedEtag != r.Etag() {
+		err = domain.ErrEtagMismatch
+	}
+	if err == nil {
+		// The update is conditional on the etag read above, so of two
+		// concurrent decisions one wins and the other aborts.
+		err = s.tx(ctx, func(ctx context.Context) error {
+			preEtag := r.Etag()
+			if err := apply(r, domain.ActorID(a.ID), s.now().UTC()); err != nil {
+				return err
+			}
+			if err := s.repo.Update(ctx, r, preEtag); err != nil {
+				return err
+			}
+			if r.Status() != domain.StatusApproved {
+				return nil
+			}
+			return s.grant(ctx, a, r)
+		})
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if c := r.DecisionComment(); c != "" {
+		aud.Metadata["comment"] = c
+	}
+	if r.Status() == domain.StatusApproved {
+		aud.Metadata["grant_expires_at"] = r.GrantExpiresAt().Format(time.RFC3339)
+	}
+	s.auditor.Success(ctx, aud)
+	return r, nil
+}
+
+// requireApprover admits holders of the approver permission in r's app,
+// the requester excepted.
+func (s *Service) requireApprover(ctx context.Context, a actor.Actor, r *domain.Request) error {
+	if a.ID == r.RequesterID().String() {
+		return domain.ErrSelfApproval
+	}
+	res, err := s.access.CheckPermission(ctx, access.CheckPermissionInput{
+		UserID:     a.ID,
+		AppID:      r.AppID().String(),
+		Permission: s.cfg.ApproverPermission,
+	})
+	if err != nil {
+		return err
+	}
+	if !res.Allowed {
+		return domain.ErrNotApprover
+	}
+	return nil
+}
+
+// grant makes the time-bound assignment of an approved request.
+func (s *Service) grant(ctx context.Context, a actor.Actor, r *domain.Request) error {
+	expiresAt := r.GrantExpiresAt()
+	res, err := s.access.GrantRoleToUser(ctx, access.GrantRoleToUserInput{
+		UserID:    r.RequesterID().String(),
+		RoleID:    r.RoleID().String(),
+		ActorID:   a.ID,
+		ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	held := res.Assignment
+	if res.Created || held.ExpiresAt == nil || !held.ExpiresAt.Before(expiresAt) {
+		return nil
+	}
+	_, err = s.access.ExtendRoleAssignment(ctx, access.ExtendRoleAssignmentInput{
+		UserID:    r.RequesterID().String(),
+		RoleID:    r.RoleID().String(),
+		ExpiresAt: &expiresAt,
+	})
+	return err
+}
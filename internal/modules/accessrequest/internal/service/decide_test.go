@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/access"
+	"sso/internal/modules/accessrequest/internal/domain"
+	"sso/internal/modules/audit"
+)
+
+const (
+	appID       = "0190b6f2-8a43-7c1e-9d2a-00000000a001"
+	roleID      = "0190b6f2-8a43-7c1e-9d2a-00000000b001"
+	requesterID = "0190b6f2-8a43-7c1e-9d2a-00000000c001"
+	approverID  = "0190b6f2-8a43-7c1e-9d2a-00000000c002"
+	bystanderID = "0190b6f2-8a43-7c1e-9d2a-00000000c003"
+	approvePerm = "access:approve_requests"
+)
+
+var t0 = time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+
+// memRepo keeps requests by id; Update honours the etag the way the
+// mariadb adapter does.
+type memRepo struct {
+	domain.Repository
+	requests map[domain.RequestID]*domain.Request
+	// lost makes Update report ErrEtagMismatch for these ids, as when
+	// another writer decided the request first.
+	lost map[domain.RequestID]bool
+}
+
+func (r *memRepo) GetByID(_ context.Context, id domain.RequestID) (*domain.Request, error) {
+	if req, ok := r.requests[id]; ok {
+		return req, nil
+	}
+	return nil, domain.ErrRequestNotFound
+}
+
+func (r *memRepo) Update(_ context.Context, req *domain.Request, expectedEtag etag.Etag) error {
+	if r.lost[req.ID()] {
+		return domain.ErrEtagMismatch
+	}
+	r.requests[req.ID()] = req
+	return nil
+}
+
+func (r *memRepo) ListExpired(_ context.Context, now time.Time, limit int) ([]*domain.Request, error) {
+	var out []*domain.Request
+	for _, req := range r.requests {
+		if req.Status() == domain.StatusPending && !req.ExpiresAt().After(now) && len(out) < limit {
+			out = append(out, req)
+		}
+	}
+	return out, nil
+}
+
+// fakeAccess allows the permission to the approvers and records the
+// grants and extensions made through it.
+type fakeAccess struct {
+	approvers map[string]bool
+	// held is the assignment the requester already has; nil for none.
+	held    *access.RoleAssignment
+	grants  []access.GrantRoleToUserInput
+	extends []access.ExtendRoleAssignmentInput
+}
+
+func (f *fakeAccess) CheckPermission(_ context.Context, in access.CheckPermissionInput) (access.CheckPermissionOutput, error) {
+	return access.CheckPermissionOutput{Allowed: in.Permission == approvePerm && f.approvers[in.UserID]}, nil
+}
+
+func (f *fakeAccess) GrantRoleToUser(_ context.Context, in access.GrantRoleToUserInput) (access.GrantRoleToUserOutput, error) {
+	f.grants = append(f.grants, in)
+	if f.held != nil {
+		return access.GrantRoleToUserOutput{Assignment: f.held}, nil
+	}
+	return access.GrantRoleToUserOutput{
+		Assignment: &access.RoleAssignment{RoleID: access.RoleID(in.RoleID), ExpiresAt: in.ExpiresAt},
+		Created:    true,
+	}, nil
+}
+
+func (f *fakeAccess) ExtendRoleAssignment(_ context.Context, in access.ExtendRoleAssignmentInput) (*access.RoleAssignment, error) {
+	f.extends = append(f.extends, in)
+	return &access.RoleAssignment{RoleID: access.RoleID(in.RoleID), ExpiresAt: in.ExpiresAt}, nil
+}
+
+type recordingEmitter struct {
+	mu     sync.Mutex
+	events []*audit.Audit
+}
+
+func (e *recordingEmitter) Emit(_ context.Context, a *audit.Audit) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, a)
+	return nil
+}
+
+// pending builds a pending request of the requester for a day of the
+// role, filed at t0 and waiting until expiresAt.
+func pending(t *testing.T, expiresAt time.Time) *domain.Request {
+	t.Helper()
+	id, err := domain.NewRequestID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := domain.NewRequest(domain.NewRequestParams{
+		ID:            id,
+		AppID:         appID,
+		RoleID:        roleID,
+		RequesterID:   requesterID,
+		Justification: "quarter close",
+		Duration:      24 * time.Hour,
+		ExpiresAt:     expiresAt,
+		Now:           t0,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func newTestService(repo *memRepo, acc *fakeAccess, now time.Time) (*Service, *recordingEmitter) {
+	em := &recordingEmitter{}
+	tx := func(ctx context.Context, fn func(ctx context.Context) error) error { return fn(ctx) }
+	s := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, acc, nil, tx,
+		Config{ApproverPermission: approvePerm, MaxDuration: 7 * 24 * time.Hour, PendingTTL: 72 * time.Hour},
+		func() time.Time { return now }, em)
+	return s, em
+}
+
+func as(id string) context.Context {
+	return actor.Inject(context.Background(), actor.Actor{ID: id, Kind: actor.KindUser})
+}
+
+func TestApproveRequest(t *testing.T) {
+	now := t0.Add(time.Hour)
+
+	cases := []struct {
+		name       string
+		caller     string
+		approvers  []string
+		expiresAt  time.Time
+		decided    bool
+		want       error
+		wantReason string
+	}{
+		{name: "approver", caller: approverID, approvers: []string{approverID}, expiresAt: t0.Add(72 * time.Hour)},
+		{
+			// Holding the approver permission does not let the requester
+			// decide their own request.
+			name: "requester holding the approver permission", caller: requesterID,
+			approvers: []string{approverID, requesterID}, expiresAt: t0.Add(72 * time.Hour),
+			want: domain.ErrSelfApproval, wantReason: audit.ReasonPermissionDenied,
+		},
+		{
+			name: "caller without the approver permission", caller: bystanderID,
+			approvers: []string{approverID}, expiresAt: t0.Add(72 * time.Hour),
+			want: domain.ErrNotApprover, wantReason: audit.ReasonPermissionDenied,
+		},
+		{
+			name: "request past its expiry", caller: approverID,
+			approvers: []string{approverID}, expiresAt: now,
+			want: domain.ErrRequestExpired, wantReason: audit.ReasonAccessRequestExpired,
+		},
+		{
+			name: "request already decided", caller: approverID,
+			approvers: []string{approverID}, expiresAt: t0.Add(72 * time.Hour), decided: true,
+			want: domain.ErrRequestNotPending, wantReason: audit.ReasonAccessRequestNotPending,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := pending(t, tc.expiresAt)
+			if tc.decided {
+				if err := r.Deny(approverID, "", t0); err != nil {
+					t.Fatal(err)
+				}
+			}
+			repo := &memRepo{requests: map[domain.RequestID]*domain.Request{r.ID(): r}}
+			acc := &fakeAccess{approvers: map[string]bool{}}
+			for _, id := range tc.approvers {
+				acc.approvers[id] = true
+			}
+			s, em := newTestService(repo, acc, now)
+
+			got, err := s.ApproveRequest(as(tc.caller), DecideRequestInput{RequestID: r.ID().String()})
+			if len(em.events) != 1 {
+				t.Fatalf("audited %d events, want 1", len(em.events))
+			}
+			ev := em.events[0]
+			if tc.want != nil {
+				if !errors.Is(err, tc.want) {
+					t.Fatalf("err = %v, want %v", err, tc.want)
+				}
+				if len(acc.grants) != 0 {
+					t.Fatalf("granted %v", acc.grants)
+				}
+				if ev.Reason() != tc.wantReason {
+					t.Fatalf("audit reason = %s, want %s", ev.Reason(), tc.wantReason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err = %v", err)
+			}
+			wantExpiry := now.Add(24 * time.Hour)
+			if got.Status() != domain.StatusApproved || got.DecidedBy() != approverID || !got.GrantExpiresAt().Equal(wantExpiry) {
+				t.Fatalf("request = %v by %s until %v", got.Status(), got.DecidedBy(), got.GrantExpiresAt())
+			}
+			if len(acc.grants) != 1 {
+				t.Fatalf("grants = %v, want one", acc.grants)
+			}
+			g := acc.grants[0]
+			if g.UserID != requesterID || g.RoleID != roleID || g.ActorID != approverID || g.ExpiresAt == nil || !g.ExpiresAt.Equal(wantExpiry) {
+				t.Fatalf("grant = %+v, want the requester's role until %v", g, wantExpiry)
+			}
+			if ev.Outcome() != audit.OutcomeSuccess || ev.Metadata()["grant_expires_at"] != wantExpiry.Format(time.RFC3339) {
+				t.Fatalf("audit = %v %v", ev.Outcome(), ev.Metadata())
+			}
+		})
+	}
+}
+
+// TestApproveRequestHeldRole checks that an approval never shortens an
+// assignment the requester already holds.
+func TestApproveRequestHeldRole(t *testing.T) {
+	now := t0.Add(time.Hour)
+	sooner := now.Add(time.Hour)
+	later := now.Add(48 * time.Hour)
+
+	cases := []struct {
+		name       string
+		heldUntil  *time.Time
+		wantExtend bool
+	}{
+		{"held until sooner", &sooner, true},
+		{"held until later", &later, false},
+		{"held permanently", nil, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := pending(t, t0.Add(72*time.Hour))
+			repo := &memRepo{requests: map[domain.RequestID]*domain.Request{r.ID(): r}}
+			acc := &fakeAccess{
+				approvers: map[string]bool{approverID: true},
+				held:      &access.RoleAssignment{RoleID: roleID, ExpiresAt: tc.heldUntil},
+			}
+			s, _ := newTestService(repo, acc, now)
+
+			if _, err := s.ApproveRequest(as(approverID), DecideRequestInput{RequestID: r.ID().String()}); err != nil {
+				t.Fatalf("err = %v", err)
+			}
+			if got := len(acc.extends) == 1; got != tc.wantExtend {
+				t.Fatalf("extends = %v, want extended %v", acc.extends, tc.wantExtend)
+			}
+			if tc.wantExtend && !acc.extends[0].ExpiresAt.Equal(now.Add(24*time.Hour)) {
+				t.Fatalf("extended to %v", acc.extends[0].ExpiresAt)
+			}
+		})
+	}
+}
+
+func TestSweepExpired(t *testing.T) {
+	now := t0.Add(72 * time.Hour)
+
+	stale := pending(t, now.Add(-time.Minute))
+	due := pending(t, now)
+	waiting := pending(t, now.Add(time.Minute))
+	// raced is stale too, but decided by another writer between the
+	// sweep's read and its update.
+	raced := pending(t, now.Add(-time.Hour))
+	repo := &memRepo{
+		requests: map[domain.RequestID]*domain.Request{
+			stale.ID(): stale, due.ID(): due, waiting.ID(): waiting, raced.ID(): raced,
+		},
+		lost: map[domain.RequestID]bool{raced.ID(): true},
+	}
+	s, em := newTestService(repo, &fakeAccess{}, now)
+
+	n, err := s.SweepExpired(context.Background())
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if n != 2 {
+		t.Fatalf("expired %d, want 2", n)
+	}
+	for _, r := range []*domain.Request{stale, due} {
+		if r.Status() != domain.StatusExpired {
+			t.Fatalf("request expiring at %v is %v, want expired", r.ExpiresAt(), r.Status())
+		}
+	}
+	if waiting.Status() != domain.StatusPending {
+		t.Fatalf("request still within its wait is %v", waiting.Status())
+	}
+	if len(em.events) != 2 {
+		t.Fatalf("audited %d events, want 2", len(em.events))
+	}
+	for _, ev := range em.events {
+		if ev.EventType() != audit.EventTypeAccessRequestExpire || ev.ActorType() != audit.ActorTypeSystem {
+			t.Fatalf("audit = %s by %s", ev.EventType(), ev.ActorType())
+		}
+	}
+
+	// An expired request can no longer be approved.
+	s, _ = newTestService(repo, &fakeAccess{approvers: map[string]bool{approverID: true}}, now)
+	if _, err := s.ApproveRequest(as(approverID), DecideRequestInput{RequestID: stale.ID().String()}); !errors.Is(err, domain.ErrRequestNotPending) {
+		t.Fatalf("approve after expiry: err = %v, want ErrRequestNotPending", err)
+	}
+}
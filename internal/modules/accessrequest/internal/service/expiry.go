@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/accessrequest/internal/domain"
+	"sso/internal/modules/audit"
+)
+
+// ----------------------------------------------------------------------------
+// Expiry sweep
+// ----------------------------------------------------------------------------
+
+// expirySweepBatch bounds the requests one sweep handles; the rest are
+// expired on the next.
+const expirySweepBatch = 100
+
+// SweepExpired closes, as the system actor, every pending request that
+// waited past its expires_at, which also lets the requester file a new
+// one for the role. A request decided meanwhile is skipped. Returns how
+// many requests were expired.
+func (s *Service) SweepExpired(ctx context.Context) (int, error) {
+	ctx = actor.Inject(ctx, actor.System())
+	now := s.now().UTC()
+	stale, err := s.repo.ListExpired(ctx, now, expirySweepBatch)
+	if err != nil {
+		return 0, err
+	}
+	done := 0
+	var errs []error
+	for _, r := range stale {
+		preEtag := r.Etag()
+		if err := r.Expire(now); err != nil {
+			continue
+		}
+		err := s.repo.Update(tenant.With(ctx, r.TenantID()), r, preEtag)
+		if errors.Is(err, domain.ErrEtagMismatch) || errors.Is(err, domain.ErrRequestNotFound) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("request %s: %w", r.ID(), err))
+			continue
+		}
+		s.auditor.Success(ctx, audit.NewAuditParams{
+			EventType:   audit.EventTypeAccessRequestExpire,
+			ActorType:   audit.ActorTypeSystem,
+			SubjectType: audit.SubjectTypeAccessRequest,
+			SubjectID:   r.ID().String(),
+			AppID:       r.AppID().String(),
+			Metadata:    requestMetadata(r),
+		})
+		done++
+	}
+	return done, errors.Join(errs...)
+}
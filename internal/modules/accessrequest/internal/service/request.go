@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/cursor"
+	"sso/internal/kernel/tenant"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/access"
+	"sso/internal/modules/accessrequest/internal/domain"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/role"
+)
+
+// ----------------------------------------------------------------------------
+// CreateRequest
+// ----------------------------------------------------------------------------
+
+type CreateRequestInput struct {
+	// AppID may be empty; if given it must be the role's app.
+	AppID         string
+	RoleID        string
+	Justification string
+	Duration      time.Duration
+}
+
+// CreateRequest files the caller's request for a role. Only users
+// request access — a service account's roles are granted outright — and
+// a user has at most one pending request per role. The role must be
+// active; whether the requester may hold it is checked by the grant on
+// approval.
+func (s *Service) CreateRequest(ctx context.Context, in CreateRequestInput) (*domain.Request, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.NewRequestID()
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessRequestCreate)
+	aud.SubjectType = audit.SubjectTypeAccessRequest
+	aud.SubjectID = id.String()
+
+	r, err := s.newRequest(ctx, a, id, in)
+	if r != nil {
+		aud.AppID = r.AppID().String()
+		aud.Metadata = requestMetadata(r)
+	}
+	if err == nil {
+		err = s.repo.Create(ctx, r)
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("create access request: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return r, nil
+}
+
+func (s *Service) newRequest(ctx context.Context, a actor.Actor, id domain.RequestID, in CreateRequestInput) (*domain.Request, error) {
+	if !a.IsUser() {
+		return nil, domain.ErrRequesterNotUser
+	}
+	if in.Duration > s.cfg.MaxDuration {
+		return nil, &validation.Error{Field: "duration", Reason: fmt.Sprintf("must be at most %s", s.cfg.MaxDuration)}
+	}
+	rid, err := role.ParseRoleID(in.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	ro, err := s.roles.GetByID(ctx, rid)
+	if err != nil {
+		return nil, err
+	}
+	if in.AppID != "" && in.AppID != ro.AppID().String() {
+		return nil, access.ErrRoleNotInApp
+	}
+	if ro.Status() != role.RoleStatusActive {
+		return nil, role.ErrRoleDisabled
+	}
+	now := s.now().UTC()
+	return domain.NewRequest(domain.NewRequestParams{
+		ID:            id,
+		TenantID:      ro.TenantID(),
+		AppID:         domain.AppID(ro.AppID().String()),
+		RoleID:        domain.RoleID(rid.String()),
+		RequesterID:   domain.UserID(a.ID),
+		Justification: in.Justification,
+		Duration:      in.Duration,
+		ExpiresAt:     now.Add(s.cfg.PendingTTL),
+		Now:           now,
+	})
+}
+
+// ----------------------------------------------------------------------------
+// GetRequest / ListRequests
+// ----------------------------------------------------------------------------
+
+func (s *Service) GetRequest(ctx context.Context, rawID string) (*domain.Request, error) {
+	id, err := domain.ParseRequestID(rawID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+type ListRequestsInput struct {
+	PageSize    int32
+	PageToken   string
+	Statuses    []domain.Status
+	AppID       string
+	RoleID      string
+	RequesterID string
+}
+
+type ListRequestsOutput struct {
+	Requests      []*domain.Request
+	NextPageToken string
+}
+
+// ListRequests pages requests newest first. Approvers filter on their
+// app and status=pending; requesters on their own id.
+func (s *Service) ListRequests(ctx context.Context, in ListRequestsInput) (ListRequestsOutput, error) {
+	after, err := decodeCursor(in.PageToken)
+	if err != nil {
+		return ListRequestsOutput{}, err
+	}
+	pageSize, err := auditx.ClampPageSize(in.PageSize)
+	if err != nil {
+		return ListRequestsOutput{}, err
+	}
+	q := domain.ListQuery{
+		PageSize: pageSize,
+		After:    after,
+		Statuses: in.Statuses,
+		AppID:    domain.AppID(in.AppID),
+		RoleID:   domain.RoleID(in.RoleID),
+	}
+	if in.RequesterID != "" {
+		if q.RequesterID, err = domain.ParseUserID(in.RequesterID); err != nil {
+			return ListRequestsOutput{}, err
+		}
+	}
+	res, err := s.repo.List(ctx, q)
+	if err != nil {
+		return ListRequestsOutput{}, err
+	}
+	next, err := encodeCursor(res.NextCursor)
+	if err != nil {
+		return ListRequestsOutput{}, err
+	}
+	return ListRequestsOutput{Requests: res.Requests, NextPageToken: next}, nil
+}
+
+type pageToken struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+func encodeCursor(c *domain.PageCursor) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	return cursor.Encode(&pageToken{CreatedAt: c.CreatedAt, ID: c.ID.String()})
+}
+
+func decodeCursor(s string) (*domain.PageCursor, error) {
+	t, err := cursor.Decode[pageToken](s)
+	if err != nil {
+		return nil, &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	if t == nil {
+		return nil, nil
+	}
+	id, err := domain.ParseRequestID(t.ID)
+	if err != nil {
+		return nil, &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	return &domain.PageCursor{CreatedAt: t.CreatedAt, ID: id}, nil
+}
+
+// ----------------------------------------------------------------------------
+// CancelRequest
+// ----------------------------------------------------------------------------
+
+type CancelRequestInput struct {
+	RequestID    string
+	ExpectedEtag string
+}
+
+// CancelRequest withdraws a pending request. Only the requester or a
+// super-admin may cancel it.
+func (s *Service) CancelRequest(ctx context.Context, in CancelRequestInput) (*domain.Request, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseRequestID(in.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, false)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessRequestCancel)
+	aud.SubjectType = audit.SubjectTypeAccessRequest
+	aud.SubjectID = id.String()
+
+	r, err := s.repo.GetByID(ctx, id)
+	if err == nil {
+		aud.AppID = r.AppID().String()
+		aud.Metadata = requestMetadata(r)
+		if a.ID != r.RequesterID().String() && !tenant.IsSuperAdmin(ctx, a) {
+			err = domain.ErrNotRequester
+		}
+	}
+	if err == nil {
+		err = r.Cancel(s.now().UTC())
+	}
+	if err == nil {
+		err = s.repo.Update(ctx, r, expectedEtag)
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("cancel access request: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return r, nil
+}
@@ -0,0 +1,126 @@
+// Package service hosts the application-layer use-cases of the
+// accessrequest bounded context:
+//
+//	service.go — Service struct + helpers
+//	request.go — Create/Get/List/CancelRequest
+//	decide.go  — ApproveRequest, DenyRequest
+//	expiry.go  — SweepExpired (pending requests nobody decided)
+//
+// The module owns only the request rows. Approvers are recognised
+// through access.Service.CheckPermission, and an approval grants the
+// role through access.Service.GrantRoleToUser with an expires_at, so
+// the grant is checked, cached and audited like an admin's and lapses
+// through the access expiry sweep; the tx port stores the approval and
+// the grant as one transaction.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"sso/internal/modules/access"
+	"sso/internal/modules/accessrequest/internal/domain"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/role"
+)
+
+// Access is the slice of access.Service a request works through.
+// Satisfied by *access.Service.
+type Access interface {
+	CheckPermission(ctx context.Context, in access.CheckPermissionInput) (access.CheckPermissionOutput, error)
+	GrantRoleToUser(ctx context.Context, in access.GrantRoleToUserInput) (access.GrantRoleToUserOutput, error)
+	ExtendRoleAssignment(ctx context.Context, in access.ExtendRoleAssignmentInput) (*access.RoleAssignment, error)
+}
+
+// TxRunner runs fn in one database transaction carried by ctx
+// (dbutil.WithTx bound to the module's *sql.DB).
+type TxRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+// Config carries the non-dependency settings of the Service.
+type Config struct {
+	// ApproverPermission is the permission, in the request's app, that
+	// makes its holder an approver.
+	ApproverPermission string
+	// MaxDuration caps the duration a request may ask for.
+	MaxDuration time.Duration
+	// PendingTTL is how long a request waits for a decision.
+	PendingTTL time.Duration
+}
+
+type Service struct {
+	repo    domain.Repository
+	access  Access
+	roles   role.Repository
+	tx      TxRunner
+	cfg     Config
+	now     func() time.Time
+	log     *slog.Logger
+	auditor auditx.Auditor
+}
+
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	acc Access,
+	roles role.Repository,
+	tx TxRunner,
+	cfg Config,
+	now func() time.Time,
+	emitter audit.Emitter,
+) *Service {
+	return &Service{
+		repo:    repo,
+		access:  acc,
+		roles:   roles,
+		tx:      tx,
+		cfg:     cfg,
+		now:     now,
+		log:     log,
+		auditor: auditx.New(log, emitter),
+	}
+}
+
+// errReasonMap maps accessrequest sentinels (and the cross-module ones
+// the use-cases pass through) to their audit (Outcome, Reason) pair.
+var errReasonMap = map[error]auditx.OutcomeReason{
+	domain.ErrRequestNotFound:      auditx.Fail(audit.ReasonAccessRequestNotFound),
+	domain.ErrEtagMismatch:         auditx.Fail(audit.ReasonEtagMismatch),
+	domain.ErrRequestNotPending:    auditx.Fail(audit.ReasonAccessRequestNotPending),
+	domain.ErrRequestExpired:       auditx.Fail(audit.ReasonAccessRequestExpired),
+	domain.ErrPendingRequestExists: auditx.Fail(audit.ReasonAccessRequestPending),
+	domain.ErrNotApprover:          auditx.Deny(audit.ReasonPermissionDenied),
+	domain.ErrSelfApproval:         auditx.Deny(audit.ReasonPermissionDenied),
+	domain.ErrNotRequester:         auditx.Deny(audit.ReasonPermissionDenied),
+	domain.ErrRequesterNotUser:     auditx.Deny(audit.ReasonPermissionDenied),
+	role.ErrRoleNotFound:           auditx.Fail(audit.ReasonRoleNotFound),
+	role.ErrRoleDisabled:           auditx.Fail(audit.ReasonRoleDisabled),
+	access.ErrRoleNotFound:         auditx.Fail(audit.ReasonRoleNotFound),
+	access.ErrRoleDisabled:         auditx.Fail(audit.ReasonRoleDisabled),
+	access.ErrRoleNotInApp:         auditx.Fail(audit.ReasonRoleNotInApp),
+	access.ErrUserNotFound:         auditx.Fail(audit.ReasonUserNotFound),
+	access.ErrUserNotEligible:      auditx.Deny(audit.ReasonUserNotEligible),
+	access.ErrAppNotFound:          auditx.Fail(audit.ReasonAppNotFound),
+}
+
+// classifyError is the per-package thin wrapper around auditx.Classify.
+func classifyError(err error) (audit.AuditOutcome, string) {
+	return auditx.Classify(err, errReasonMap)
+}
+
+// withOutcome is a thin alias over auditx.WithOutcome.
+func withOutcome(p audit.NewAuditParams, out audit.AuditOutcome, reason string) audit.NewAuditParams {
+	return auditx.WithOutcome(p, out, reason)
+}
+
+// requestMetadata is the audit metadata every access_request event
+// carries: what was asked for, and why.
+func requestMetadata(r *domain.Request) map[string]string {
+	return map[string]string{
+		"requester_id":  r.RequesterID().String(),
+		"role_id":       r.RoleID().String(),
+		"duration":      r.Duration().String(),
+		"justification": r.Justification,
+	}
+}
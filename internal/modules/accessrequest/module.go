@@ -0,0 +1,172 @@
+// Package accessrequest exposes the wire-up for the accessrequest
+// bounded context (just-in-time role requests approved by the app's
+// approvers). bootstrap.New constructs a single *accessrequest.Module
+// and pulls everything else off it:
+//
+//	mod.RegisterHTTP(mux)  // mounts the /v1/access-requests endpoints
+//	mod.Start(ctx)         // sweeper for requests nobody decided
+//	mod.Service()          // application-layer service (rare)
+//	mod.Repository()       // persistence contract
+//
+// The surface is HTTP-only until an AccessRequestService contract is
+// published in sso_protos.
+package accessrequest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/accessrequest/internal/httpapi"
+	"sso/internal/modules/accessrequest/internal/mariadb"
+	"sso/internal/modules/accessrequest/internal/service"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/role"
+)
+
+// Emitter is the audit-event sink the module uses to record write
+// operations. Type alias over audit.Emitter — keeps the wiring
+// contract local to this module.
+type Emitter = audit.Emitter
+
+// Deps lists everything accessrequest needs from its host. Access must
+// write through the same *sql.DB as DB: an approval and its grant are
+// stored in one dbutil.WithTx transaction.
+type Deps struct {
+	DB  *sql.DB
+	Log *slog.Logger
+
+	Access        Access // *access.Service
+	Roles         role.Repository
+	Authenticator Authenticator // *grpcauth.Interceptor
+	Authorizer    Authorizer    // *httprbac.Authorizer
+
+	// ApproverPermission is the permission that makes its holders in
+	// an app approvers of that app's requests.
+	ApproverPermission string
+	MaxDuration        time.Duration // default 8h
+	PendingTTL         time.Duration // default 72h
+	// ExpirySweepInterval is how often Start expires pending requests
+	// past their deadline. Defaults to one minute.
+	ExpirySweepInterval time.Duration
+
+	Clock func() time.Time
+	Audit Emitter
+}
+
+// Module is the assembled accessrequest bounded context.
+type Module struct {
+	service *service.Service
+	handler *httpapi.Handler
+	repo    *mariadb.Repository
+	log     *slog.Logger
+
+	sweepInterval time.Duration
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.DB == nil {
+		return nil, fmt.Errorf("accessrequest: db is required")
+	}
+	if d.Log == nil {
+		return nil, fmt.Errorf("accessrequest: log is required")
+	}
+	if d.Access == nil {
+		return nil, fmt.Errorf("accessrequest: access service is required")
+	}
+	if d.Roles == nil {
+		return nil, fmt.Errorf("accessrequest: roles repository is required")
+	}
+	if d.Authenticator == nil {
+		return nil, fmt.Errorf("accessrequest: authenticator is required")
+	}
+	if d.Authorizer == nil {
+		return nil, fmt.Errorf("accessrequest: authorizer is required")
+	}
+	if d.ApproverPermission == "" {
+		return nil, fmt.Errorf("accessrequest: approver permission is required")
+	}
+	if d.MaxDuration <= 0 {
+		d.MaxDuration = 8 * time.Hour
+	}
+	if d.PendingTTL <= 0 {
+		d.PendingTTL = 72 * time.Hour
+	}
+	if d.ExpirySweepInterval <= 0 {
+		d.ExpirySweepInterval = time.Minute
+	}
+	if d.Clock == nil {
+		d.Clock = time.Now
+	}
+	if d.Audit == nil {
+		d.Audit = audit.NopEmitter{}
+	}
+
+	repo := mariadb.NewRepository(d.DB)
+
+	var _ Repository = repo
+
+	tx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return dbutil.WithTx(ctx, d.DB, fn)
+	}
+	svc := service.NewService(d.Log, repo, d.Access, d.Roles, tx,
+		service.Config{
+			ApproverPermission: d.ApproverPermission,
+			MaxDuration:        d.MaxDuration,
+			PendingTTL:         d.PendingTTL,
+		},
+		d.Clock, d.Audit)
+
+	return &Module{
+		service: svc,
+		handler: httpapi.NewHandler(svc, d.Authenticator, d.Authorizer, d.Log),
+		repo:    repo,
+		log:     d.Log,
+
+		sweepInterval: d.ExpirySweepInterval,
+	}, nil
+}
+
+// RegisterHTTP mounts the access request endpoints on the HTTP
+// listener's root mux.
+func (m *Module) RegisterHTTP(mux *http.ServeMux) {
+	m.handler.Register(mux)
+}
+
+// Start launches the sweeper that expires pending requests past their
+// expires_at, every ExpirySweepInterval until ctx is cancelled. It
+// returns immediately.
+func (m *Module) Start(ctx context.Context) {
+	go m.sweepExpired(ctx)
+}
+
+func (m *Module) sweepExpired(ctx context.Context) {
+	ticker := time.NewTicker(m.sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := m.service.SweepExpired(ctx)
+			if err != nil && ctx.Err() == nil {
+				m.log.ErrorContext(ctx, "accessrequest: sweep expired requests", "expired", n, "err", err)
+				continue
+			}
+			if n > 0 {
+				m.log.InfoContext(ctx, "accessrequest: swept expired requests", "expired", n)
+			}
+		}
+	}
+}
+
+// Service returns the application-layer Service.
+func (m *Module) Service() *service.Service { return m.service }
+
+// Repository returns the persistence contract.
+func (m *Module) Repository() Repository { return m.repo }
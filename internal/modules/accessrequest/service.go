@@ -0,0 +1,19 @@
+// Package accessrequest re-exports the application-layer Service
+// together with the typed Input/Output structs declared in
+// internal/service.
+package accessrequest
+
+import "sso/internal/modules/accessrequest/internal/service"
+
+// Service is the use-case orchestrator, split across files in
+// internal/service: request.go, decide.go, expiry.go.
+type Service = service.Service
+
+// Input / Output type aliases.
+type (
+	CreateRequestInput = service.CreateRequestInput
+	ListRequestsInput  = service.ListRequestsInput
+	ListRequestsOutput = service.ListRequestsOutput
+	DecideRequestInput = service.DecideRequestInput
+	CancelRequestInput = service.CancelRequestInput
+)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sso/internal/kernel/etag"
 	"sso/internal/kernel/validation"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -32,6 +33,22 @@ func ParseAppID(s string) (AppID, error) {
 
 func (a AppID) String() string { return string(a) }
 
+// ReservedSlugPrefix starts the slugs of the admin apps seed-admin
+// creates: sso-admin for System, sso-admin-<tenant slug> for the other
+// tenants (see authz.AccessBackedAuthorizer). Slugs are unique across
+// tenants, so an app created under such a slug would squat a tenant's
+// admin app before it is seeded.
+const ReservedSlugPrefix = "sso-admin"
+
+// ValidateSlug refuses the slugs reserved for admin apps. The rest of
+// the slug rules are the protovalidate interceptor's.
+func ValidateSlug(slug string) error {
+	if strings.HasPrefix(strings.ToLower(slug), ReservedSlugPrefix) {
+		return &validation.Error{Field: "slug", Reason: "the " + ReservedSlugPrefix + " prefix is reserved for admin apps"}
+	}
+	return nil
+}
+
 // ----------------------------------------------------------------------------
 // App aggregate
 // ----------------------------------------------------------------------------
@@ -39,8 +56,8 @@ func (a AppID) String() string { return string(a) }
 // Field visibility split:
 //
 //   Unexported (only the aggregate itself can change them):
-//     id, slug, status, etag, createdAt, updatedAt
-//   * id and createdAt are immutable after construction.
+//     id, tenantID, slug, status, etag, createdAt, updatedAt
+//   * id, tenantID and createdAt are immutable after construction.
 //   * slug is immutable after Create per the proto contract.
 //   * status is advanced only by lifecycle helpers
 //     (Disable/Enable/EnterMaintenance/ExitMaintenance).
@@ -51,6 +68,7 @@ func (a AppID) String() string { return string(a) }
 
 type App struct {
 	id        AppID
+	tenantID  string
 	slug      string
 	status    AppStatus
 	etag      etag.Etag
@@ -63,13 +81,15 @@ type App struct {
 
 // NewAppParams carries the values supplied by the CreateApp use-case.
 // Server-managed fields (etag/timestamps stamped here; status defaults to
-// ACTIVE) are not part of it.
+// ACTIVE) are not part of it. TenantID is the tenant the app is created
+// in, normally tenant.Home(ctx) of the creating request.
 type NewAppParams struct {
-	ID   AppID
-	Name string
-	Slug string
-	Link string
-	Now  time.Time
+	ID       AppID
+	TenantID string
+	Name     string
+	Slug     string
+	Link     string
+	Now      time.Time
 }
 
 // NewApp constructs a fresh App. Status defaults to ACTIVE; created_at /
@@ -77,6 +97,7 @@ type NewAppParams struct {
 func NewApp(p NewAppParams) *App {
 	return &App{
 		id:        p.ID,
+		tenantID:  p.TenantID,
 		slug:      p.Slug,
 		status:    AppStatusActive,
 		etag:      etag.New(),
@@ -90,6 +111,7 @@ func NewApp(p NewAppParams) *App {
 // RestoreAppParams carries the full row read back from the repository.
 type RestoreAppParams struct {
 	ID        AppID
+	TenantID  string
 	Name      string
 	Slug      string
 	Link      string
@@ -104,6 +126,7 @@ type RestoreAppParams struct {
 func RestoreApp(p RestoreAppParams) *App {
 	return &App{
 		id:        p.ID,
+		tenantID:  p.TenantID,
 		slug:      p.Slug,
 		status:    p.Status,
 		etag:      p.Etag,
@@ -116,6 +139,7 @@ func RestoreApp(p RestoreAppParams) *App {
 
 // Read-only accessors for the unexported fields.
 func (a *App) ID() AppID            { return a.id }
+func (a *App) TenantID() string     { return a.tenantID }
 func (a *App) Slug() string         { return a.slug }
 func (a *App) Status() AppStatus    { return a.status }
 func (a *App) Etag() etag.Etag      { return a.etag }
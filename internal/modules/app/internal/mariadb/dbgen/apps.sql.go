@@ -27,8 +27,8 @@ func (q *Queries) CountAppByID(ctx context.Context, id string) (int64, error) {
 const createApp = `-- name: CreateApp :exec
 
 INSERT INTO apps
-    (id, name, slug, link, status, etag, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    (id, name, slug, link, status, etag, created_at, updated_at, tenant_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateAppParams struct {
@@ -40,6 +40,7 @@ type CreateAppParams struct {
 	Etag      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	TenantID  string
 }
 
 // Apps directory: per-row queries. Dynamic ListApps lives in the
@@ -54,6 +55,7 @@ func (q *Queries) CreateApp(ctx context.Context, arg CreateAppParams) error {
 		arg.Etag,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.TenantID,
 	)
 	return err
 }
@@ -80,7 +82,7 @@ func (q *Queries) DeleteAppWithEtag(ctx context.Context, arg DeleteAppWithEtagPa
 }
 
 const getAppByID = `-- name: GetAppByID :one
-SELECT id, name, slug, link, status, etag, created_at, updated_at
+SELECT id, name, slug, link, status, etag, created_at, updated_at, tenant_id
 FROM apps
 WHERE id = ?
 `
@@ -97,6 +99,7 @@ func (q *Queries) GetAppByID(ctx context.Context, id string) (App, error) {
 		&i.Etag,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
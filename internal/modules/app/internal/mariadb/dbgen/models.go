@@ -19,6 +19,7 @@ type App struct {
 	Etag      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	TenantID  string
 }
 
 type AuditEvent struct {
@@ -67,6 +68,8 @@ type RoleAssignment struct {
 	AppID           string
 	GrantedByUserID string
 	GrantedAt       time.Time
+	NotBefore       sql.NullTime
+	ExpiresAt       sql.NullTime
 }
 
 type RolePermission struct {
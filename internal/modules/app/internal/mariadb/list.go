@@ -8,16 +8,17 @@ import (
 	"sso/internal/modules/app/internal/domain"
 	"sso/internal/modules/app/internal/mariadb/dbgen"
 	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/tenant"
 )
 
-const listSelectCols = `id, name, slug, link, status, etag, created_at, updated_at`
+const listSelectCols = `id, name, slug, link, status, etag, created_at, updated_at, tenant_id`
 
 func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListResult, error) {
 	if q.PageSize <= 0 {
 		return domain.ListResult{}, fmt.Errorf("app repo: list: page_size must be > 0")
 	}
 
-	where, args := buildWhere(q)
+	where, args := buildWhere(q, tenant.Scope(ctx))
 	orderBy := orderClauseFor(q.OrderBy)
 	limit := q.PageSize + 1
 
@@ -41,7 +42,7 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 		var a dbgen.App
 		if err := rows.Scan(
 			&a.ID, &a.Name, &a.Slug, &a.Link, &a.Status, &a.Etag,
-			&a.CreatedAt, &a.UpdatedAt,
+			&a.CreatedAt, &a.UpdatedAt, &a.TenantID,
 		); err != nil {
 			return domain.ListResult{}, fmt.Errorf("app repo: list: scan: %w", err)
 		}
@@ -68,12 +69,18 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 // buildWhere assembles the filter portion of a ListApps SELECT. Unlike
 // the identity adapter, an empty filter set yields an empty WHERE — apps
 // have no implicit "exclude DELETED" default (no DELETED state exists).
-func buildWhere(q domain.ListQuery) ([]string, []any) {
+// tenantID is tenant.Scope; "" lists every tenant.
+func buildWhere(q domain.ListQuery, tenantID string) ([]string, []any) {
 	var (
 		where []string
 		args  []any
 	)
 
+	if tenantID != "" {
+		where = append(where, "tenant_id = ?")
+		args = append(args, tenantID)
+	}
+
 	if len(q.Statuses) > 0 {
 		ph := make([]string, len(q.Statuses))
 		for i, s := range q.Statuses {
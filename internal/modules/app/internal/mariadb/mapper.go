@@ -11,6 +11,7 @@ import (
 func dbgenToDomain(a dbgen.App) *domain.App {
 	return domain.RestoreApp(domain.RestoreAppParams{
 		ID:        domain.AppID(a.ID),
+		TenantID:  a.TenantID,
 		Name:      a.Name,
 		Slug:      a.Slug,
 		Link:      a.Link,
@@ -31,6 +32,7 @@ func toCreateParams(a *domain.App) dbgen.CreateAppParams {
 		Etag:      a.Etag().String(),
 		CreatedAt: a.CreatedAt(),
 		UpdatedAt: a.UpdatedAt(),
+		TenantID:  a.TenantID(),
 	}
 }
 
@@ -3,11 +3,11 @@
 
 -- name: CreateApp :exec
 INSERT INTO apps
-    (id, name, slug, link, status, etag, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+    (id, name, slug, link, status, etag, created_at, updated_at, tenant_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: GetAppByID :one
-SELECT id, name, slug, link, status, etag, created_at, updated_at
+SELECT id, name, slug, link, status, etag, created_at, updated_at, tenant_id
 FROM apps
 WHERE id = ?;
 
@@ -1,5 +1,7 @@
 // Package app is the MariaDB implementation of
-// internal/domain/app.Repository. Same shape as the identity adapter.
+// internal/domain/app.Repository. Same shape as the identity adapter,
+// tenancy included: an app outside tenant.Scope reads as
+// ErrAppNotFound, and List is filtered to the scope.
 package mariadb
 
 import (
@@ -12,6 +14,7 @@ import (
 	"sso/internal/modules/app/internal/mariadb/dbgen"
 	"sso/internal/kernel/dbutil"
 	"sso/internal/kernel/etag"
+	"sso/internal/kernel/tenant"
 )
 
 type Repository struct {
@@ -52,6 +55,9 @@ func (r *Repository) GetByID(ctx context.Context, id domain.AppID) (*domain.App,
 		}
 		return nil, fmt.Errorf("app repo: get_by_id: %w", err)
 	}
+	if !tenant.Visible(ctx, row.TenantID) {
+		return nil, domain.ErrAppNotFound
+	}
 	return dbgenToDomain(row), nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"sso/internal/modules/app/internal/domain"
 	"sso/internal/modules/audit"
 	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
 )
 
 // CreateAppInput is the parsed CreateAppRequest. Field validation
@@ -19,7 +20,8 @@ type CreateAppInput struct {
 
 // CreateApp provisions a new app. Server generates id, etag, timestamps;
 // status defaults to ACTIVE. Returns ErrAppAlreadyExists on uniqueness
-// collision (name or slug).
+// collision (name or slug) and a validation error for a slug reserved
+// for admin apps.
 func (s *Service) CreateApp(ctx context.Context, in CreateAppInput) (*domain.App, error) {
 	a, err := actor.Require(ctx)
 	if err != nil {
@@ -31,11 +33,12 @@ func (s *Service) CreateApp(ctx context.Context, in CreateAppInput) (*domain.App
 	}
 
 	target := domain.NewApp(domain.NewAppParams{
-		ID:   id,
-		Name: in.Name,
-		Slug: in.Slug,
-		Link: in.Link,
-		Now:  s.now().UTC(),
+		ID:       id,
+		TenantID: tenant.Home(ctx),
+		Name:     in.Name,
+		Slug:     in.Slug,
+		Link:     in.Link,
+		Now:      s.now().UTC(),
 	})
 
 	aud := audit.BaseFromActor(a, audit.EventTypeAppCreateApp)
@@ -43,7 +46,11 @@ func (s *Service) CreateApp(ctx context.Context, in CreateAppInput) (*domain.App
 	aud.SubjectID = id.String()
 	aud.AppID = id.String()
 
-	if err := s.repo.Create(ctx, target); err != nil {
+	err = domain.ValidateSlug(in.Slug)
+	if err == nil {
+		err = s.repo.Create(ctx, target)
+	}
+	if err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return nil, err
@@ -77,6 +77,7 @@ func (s *Service) PermanentlyDeleteApp(ctx context.Context, in PermanentlyDelete
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return err
 	}
+	s.version.Bump(ctx)
 
 	s.auditor.Success(ctx, aud)
 	return nil
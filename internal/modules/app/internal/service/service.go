@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"sso/internal/modules/app/internal/domain"
+	"sso/internal/kernel/authzver"
 	"sso/internal/modules/audit"
 	"sso/internal/modules/audit/auditx"
 	"sso/internal/kernel/actor"
@@ -36,11 +37,12 @@ type Service struct {
 	repo    domain.Repository
 	now     func() time.Time
 	auditor auditx.Auditor
+	version authzver.Bumper
 }
 
 // NewService constructs the service. now must not be nil.
-func NewService(log *slog.Logger, repo domain.Repository, now func() time.Time, emitter audit.Emitter) *Service {
-	return &Service{repo: repo, now: now, auditor: auditx.New(log, emitter)}
+func NewService(log *slog.Logger, repo domain.Repository, now func() time.Time, emitter audit.Emitter, version authzver.Bumper) *Service {
+	return &Service{repo: repo, now: now, auditor: auditx.New(log, emitter), version: version}
 }
 
 // EtagWildcard re-exports auditx.EtagWildcard so existing call sites
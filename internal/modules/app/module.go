@@ -19,6 +19,7 @@ import (
 	"log/slog"
 	"time"
 
+	"sso/internal/kernel/authzver"
 	appgrpc "sso/internal/modules/app/internal/grpc"
 	"sso/internal/modules/app/internal/mariadb"
 	"sso/internal/modules/app/internal/service"
@@ -43,11 +44,16 @@ type Emitter = audit.Emitter
 // Audit  — optional; defaults to audit.NopEmitter when nil (events are
 //
 //	dropped). bootstrap supplies a real emitter in production.
+//
+// AuthzVersion — optional; bumped after a permanent delete, which takes
+//
+//	the app's roles and grants with it. Defaults to authzver.Nop.
 type Deps struct {
-	DB    *sql.DB
-	Log   *slog.Logger
-	Clock func() time.Time
-	Audit Emitter
+	DB           *sql.DB
+	Log          *slog.Logger
+	Clock        func() time.Time
+	Audit        Emitter
+	AuthzVersion authzver.Bumper
 }
 
 // Module is the assembled app bounded context. Construct with New;
@@ -79,13 +85,16 @@ func New(d Deps) (*Module, error) {
 	if d.Audit == nil {
 		d.Audit = audit.NopEmitter{}
 	}
+	if d.AuthzVersion == nil {
+		d.AuthzVersion = authzver.Nop{}
+	}
 
 	repo := mariadb.NewRepository(d.DB)
 
 	var _ AppReader = repo
 	var _ Repository = repo
 
-	svc := service.NewService(d.Log, repo, d.Clock, d.Audit)
+	svc := service.NewService(d.Log, repo, d.Clock, d.Audit, d.AuthzVersion)
 	h := appgrpc.NewHandler(svc, d.Log)
 
 	return &Module{
@@ -0,0 +1,47 @@
+// Package attribute is the public API of the attribute bounded context
+// (per-app custom user attributes). External callers interact with the
+// module through:
+//
+//	attribute.New(Deps)    wires the module (module.go)
+//	attribute.Service      application-layer use-cases (service.go)
+//	attribute.Repository   persistence contract
+package attribute
+
+import (
+	"sso/internal/modules/attribute/internal/domain"
+	"sso/internal/modules/attribute/internal/httpapi"
+)
+
+type (
+	Definition              = domain.Definition
+	RestoreDefinitionParams = domain.RestoreDefinitionParams
+	Spec                    = domain.Spec
+	Type                    = domain.Type
+	Values                  = domain.Values
+	AppID                   = domain.AppID
+	UserID                  = domain.UserID
+	Repository              = domain.Repository
+
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+)
+
+// Type enum re-exports.
+const (
+	TypeString  = domain.TypeString
+	TypeInteger = domain.TypeInteger
+	TypeBoolean = domain.TypeBoolean
+)
+
+var (
+	ParseType = domain.ParseType
+	ParseKey  = domain.ParseKey
+)
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var (
+	ErrDefinitionNotFound = domain.ErrDefinitionNotFound
+	ErrTypeChange         = domain.ErrTypeChange
+)
@@ -0,0 +1,406 @@
+// Package domain holds the attribute bounded context: per-app schemas
+// of custom user attributes (employee id, department, cost center…)
+// and the values users hold against them.
+//
+// An app defines its schema one Definition at a time. Values are kept
+// per (user, app, key) in a canonical text form and always written as
+// the complete set for one app, so the schema's required flags can be
+// checked against the whole set.
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"sso/internal/kernel/validation"
+)
+
+// ----------------------------------------------------------------------------
+// Cross-context handles
+// ----------------------------------------------------------------------------
+
+// AppID is a cross-context handle to app.App.
+type AppID string
+
+func ParseAppID(s string) (AppID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "app_id", Reason: "must be a valid UUID"}
+	}
+	return AppID(s), nil
+}
+
+func (id AppID) String() string { return string(id) }
+
+// UserID is a cross-context handle to identity.User.
+type UserID string
+
+func ParseUserID(s string) (UserID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "user_id", Reason: "must be a valid UUID"}
+	}
+	return UserID(s), nil
+}
+
+func (id UserID) String() string { return string(id) }
+
+// ----------------------------------------------------------------------------
+// Type
+// ----------------------------------------------------------------------------
+
+// Type is the on-wire value of app_attribute_defs.type — do not
+// renumber.
+type Type uint8
+
+const (
+	TypeString  Type = 1
+	TypeInteger Type = 2
+	TypeBoolean Type = 3
+)
+
+func ParseType(s string) (Type, error) {
+	switch s {
+	case "string":
+		return TypeString, nil
+	case "integer":
+		return TypeInteger, nil
+	case "boolean":
+		return TypeBoolean, nil
+	default:
+		return 0, &validation.Error{Field: "type", Reason: "must be one of string, integer, boolean"}
+	}
+}
+
+func (t Type) String() string {
+	switch t {
+	case TypeString:
+		return "string"
+	case TypeInteger:
+		return "integer"
+	case TypeBoolean:
+		return "boolean"
+	default:
+		return "unknown"
+	}
+}
+
+func (t Type) IsKnown() bool {
+	return t == TypeString || t == TypeInteger || t == TypeBoolean
+}
+
+// ----------------------------------------------------------------------------
+// Definition aggregate
+// ----------------------------------------------------------------------------
+
+const (
+	MaxValueLen   = 1024 // user_attribute_values.value
+	maxPatternLen = 512
+	maxEnumValues = 100
+	maxDescLen    = 512
+)
+
+var keyRe = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// ParseKey validates an attribute key: lower-case snake_case, at most
+// 64 characters. Keys double as claim names inside "attrs".
+func ParseKey(s string) (string, error) {
+	if !keyRe.MatchString(s) {
+		return "", &validation.Error{Field: "key", Reason: "must match [a-z][a-z0-9_]{0,63}"}
+	}
+	return s, nil
+}
+
+// Spec is the client-editable part of a Definition.
+type Spec struct {
+	Type        Type
+	Required    bool
+	Pattern     string   // RE2; the whole value must match. String only.
+	Enum        []string // allowed values; empty = any. String only.
+	TokenClaim  bool     // emit in access tokens issued for the app
+	Description string
+}
+
+// Definition is one key of an app's attribute schema. The key and the
+// type are fixed once defined: stored values are only meaningful under
+// the type they were validated against, so changing it means deleting
+// the definition (and its values) and defining it again.
+type Definition struct {
+	appID     AppID
+	key       string
+	spec      Spec
+	re        *regexp.Regexp // compiled spec.Pattern; nil when empty
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewDefinition validates spec and builds a fresh definition.
+func NewDefinition(appID AppID, key string, spec Spec, now time.Time) (*Definition, error) {
+	key, err := ParseKey(key)
+	if err != nil {
+		return nil, err
+	}
+	re, err := checkSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &Definition{
+		appID:     appID,
+		key:       key,
+		spec:      spec,
+		re:        re,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+type RestoreDefinitionParams struct {
+	AppID     AppID
+	Key       string
+	Spec      Spec
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RestoreDefinition rebuilds a Definition from a trusted row. A pattern
+// that no longer compiles (it was validated on write) makes every
+// string value fail validation instead of being silently ignored.
+func RestoreDefinition(p RestoreDefinitionParams) *Definition {
+	d := &Definition{
+		appID:     p.AppID,
+		key:       p.Key,
+		spec:      p.Spec,
+		createdAt: p.CreatedAt,
+		updatedAt: p.UpdatedAt,
+	}
+	if p.Spec.Pattern != "" {
+		d.re, _ = compilePattern(p.Spec.Pattern)
+	}
+	return d
+}
+
+func (d *Definition) AppID() AppID         { return d.appID }
+func (d *Definition) Key() string          { return d.key }
+func (d *Definition) Spec() Spec           { return d.spec }
+func (d *Definition) Type() Type           { return d.spec.Type }
+func (d *Definition) Required() bool       { return d.spec.Required }
+func (d *Definition) TokenClaim() bool     { return d.spec.TokenClaim }
+func (d *Definition) CreatedAt() time.Time { return d.createdAt }
+func (d *Definition) UpdatedAt() time.Time { return d.updatedAt }
+
+// Redefine replaces the spec. Tightening constraints does not touch
+// values already stored; they are re-checked the next time the user's
+// set is written.
+func (d *Definition) Redefine(spec Spec, now time.Time) error {
+	if spec.Type != d.spec.Type {
+		return ErrTypeChange
+	}
+	re, err := checkSpec(spec)
+	if err != nil {
+		return err
+	}
+	d.spec, d.re, d.updatedAt = spec, re, now
+	return nil
+}
+
+func checkSpec(s Spec) (*regexp.Regexp, error) {
+	if !s.Type.IsKnown() {
+		return nil, &validation.Error{Field: "type", Reason: "must be one of string, integer, boolean"}
+	}
+	if utf8.RuneCountInString(s.Description) > maxDescLen {
+		return nil, &validation.Error{Field: "description", Reason: fmt.Sprintf("must be at most %d characters", maxDescLen)}
+	}
+	if s.Type != TypeString && (s.Pattern != "" || len(s.Enum) > 0) {
+		return nil, &validation.Error{Field: "type", Reason: "pattern and enum apply to string attributes only"}
+	}
+	var re *regexp.Regexp
+	if s.Pattern != "" {
+		if utf8.RuneCountInString(s.Pattern) > maxPatternLen {
+			return nil, &validation.Error{Field: "pattern", Reason: fmt.Sprintf("must be at most %d characters", maxPatternLen)}
+		}
+		var err error
+		if re, err = compilePattern(s.Pattern); err != nil {
+			return nil, &validation.Error{Field: "pattern", Reason: "must be a valid RE2 expression"}
+		}
+	}
+	if len(s.Enum) > maxEnumValues {
+		return nil, &validation.Error{Field: "enum", Reason: fmt.Sprintf("must have at most %d values", maxEnumValues)}
+	}
+	seen := make(map[string]bool, len(s.Enum))
+	for _, v := range s.Enum {
+		if v == "" || utf8.RuneCountInString(v) > MaxValueLen {
+			return nil, &validation.Error{Field: "enum", Reason: "values must be non-empty and fit the value length"}
+		}
+		if seen[v] {
+			return nil, &validation.Error{Field: "enum", Reason: "values must be unique"}
+		}
+		if re != nil && !re.MatchString(v) {
+			return nil, &validation.Error{Field: "enum", Reason: "values must match the pattern"}
+		}
+		seen[v] = true
+	}
+	return re, nil
+}
+
+func compilePattern(p string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + p + `)$`)
+}
+
+// ----------------------------------------------------------------------------
+// Values
+// ----------------------------------------------------------------------------
+
+// Values maps attribute keys to their canonical text form for one
+// (user, app).
+type Values map[string]string
+
+// Canonical checks v against the definition and returns its stored
+// form. v is what a JSON decoder produced with UseNumber: string,
+// json.Number or bool (float64 and int64 are accepted too).
+func (d *Definition) Canonical(v any) (string, error) {
+	field := "values." + d.key
+	switch d.spec.Type {
+	case TypeString:
+		s, ok := v.(string)
+		if !ok {
+			return "", &validation.Error{Field: field, Reason: "must be a string"}
+		}
+		return s, d.checkString(field, s)
+	case TypeInteger:
+		var n int64
+		switch x := v.(type) {
+		case json.Number:
+			i, err := strconv.ParseInt(x.String(), 10, 64)
+			if err != nil {
+				return "", &validation.Error{Field: field, Reason: "must be an integer"}
+			}
+			n = i
+		case int64:
+			n = x
+		case float64:
+			if x != math.Trunc(x) || math.Abs(x) > 1<<53 {
+				return "", &validation.Error{Field: field, Reason: "must be an integer"}
+			}
+			n = int64(x)
+		default:
+			return "", &validation.Error{Field: field, Reason: "must be an integer"}
+		}
+		return strconv.FormatInt(n, 10), nil
+	case TypeBoolean:
+		b, ok := v.(bool)
+		if !ok {
+			return "", &validation.Error{Field: field, Reason: "must be a boolean"}
+		}
+		return strconv.FormatBool(b), nil
+	default:
+		return "", &validation.Error{Field: field, Reason: "has an unknown type"}
+	}
+}
+
+// ParseText is Canonical for text input (query-string filters): the
+// value is parsed according to the type instead of taken from JSON.
+func (d *Definition) ParseText(s string) (string, error) {
+	field := "filter." + d.key
+	switch d.spec.Type {
+	case TypeString:
+		return s, d.checkString(field, s)
+	case TypeInteger:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return "", &validation.Error{Field: field, Reason: "must be an integer"}
+		}
+		return strconv.FormatInt(n, 10), nil
+	case TypeBoolean:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return "", &validation.Error{Field: field, Reason: "must be a boolean"}
+		}
+		return strconv.FormatBool(b), nil
+	default:
+		return "", &validation.Error{Field: field, Reason: "has an unknown type"}
+	}
+}
+
+func (d *Definition) checkString(field, s string) error {
+	if utf8.RuneCountInString(s) > MaxValueLen {
+		return &validation.Error{Field: field, Reason: fmt.Sprintf("must be at most %d characters", MaxValueLen)}
+	}
+	if d.spec.Pattern != "" && (d.re == nil || !d.re.MatchString(s)) {
+		return &validation.Error{Field: field, Reason: "does not match the pattern"}
+	}
+	if len(d.spec.Enum) > 0 {
+		for _, e := range d.spec.Enum {
+			if e == s {
+				return nil
+			}
+		}
+		return &validation.Error{Field: field, Reason: "must be one of the enum values"}
+	}
+	return nil
+}
+
+// Render turns a stored value back into its typed form for JSON and
+// token claims. A value that no longer parses under the type is
+// returned as the raw string.
+func (d *Definition) Render(stored string) any {
+	switch d.spec.Type {
+	case TypeInteger:
+		if n, err := strconv.ParseInt(stored, 10, 64); err == nil {
+			return n
+		}
+	case TypeBoolean:
+		if b, err := strconv.ParseBool(stored); err == nil {
+			return b
+		}
+	}
+	return stored
+}
+
+// ValidateValues checks a complete value set for one app against its
+// schema and returns the stored forms. Keys outside the schema are
+// rejected; a nil value counts as absent; every required key must be
+// present.
+func ValidateValues(defs []*Definition, in map[string]any) (Values, error) {
+	byKey := make(map[string]*Definition, len(defs))
+	for _, d := range defs {
+		byKey[d.key] = d
+	}
+	out := make(Values, len(in))
+	for k, v := range in {
+		d, ok := byKey[k]
+		if !ok {
+			return nil, &validation.Error{Field: "values." + k, Reason: "is not defined for the app"}
+		}
+		if v == nil {
+			continue
+		}
+		s, err := d.Canonical(v)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = s
+	}
+	for _, d := range defs {
+		if _, ok := out[d.key]; d.spec.Required && !ok {
+			return nil, &validation.Error{Field: "values." + d.key, Reason: "required"}
+		}
+	}
+	return out, nil
+}
+
+// RenderValues renders a stored set under its schema. Values whose
+// definition is gone are dropped (the FK cascade removes them anyway).
+func RenderValues(defs []*Definition, v Values) map[string]any {
+	out := make(map[string]any, len(v))
+	for _, d := range defs {
+		if s, ok := v[d.key]; ok {
+			out[d.key] = d.Render(s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"strings"
+	"testing"
+	"time"
+
+	"sso/internal/kernel/validation"
+)
+
+const appID = AppID("0190b6f2-8a43-7c1e-9d2a-00000000a001")
+
+func TestNewDefinition(t *testing.T) {
+	cases := []struct {
+		key   string
+		spec  Spec
+		field string // "" = valid
+	}{
+		{"employee_id", Spec{Type: TypeString, Pattern: `E[0-9]{4}`}, ""},
+		{"department", Spec{Type: TypeString, Enum: []string{"ops", "sales"}}, ""},
+		{"level", Spec{Type: TypeInteger, Required: true}, ""},
+		{"Level", Spec{Type: TypeInteger}, "key"},
+		{"2fa", Spec{Type: TypeBoolean}, "key"},
+		{"level", Spec{}, "type"},
+		{"level", Spec{Type: TypeInteger, Pattern: `[0-9]+`}, "type"},
+		{"remote", Spec{Type: TypeBoolean, Enum: []string{"true"}}, "type"},
+		{"employee_id", Spec{Type: TypeString, Pattern: `E[0-9`}, "pattern"},
+		{"department", Spec{Type: TypeString, Enum: []string{"ops", "ops"}}, "enum"},
+		{"department", Spec{Type: TypeString, Enum: []string{"ops", ""}}, "enum"},
+		{"department", Spec{Type: TypeString, Pattern: `[a-z]+`, Enum: []string{"ops", "R&D"}}, "enum"},
+		{"department", Spec{Type: TypeString, Description: strings.Repeat("d", maxDescLen+1)}, "description"},
+	}
+	for _, tc := range cases {
+		_, err := NewDefinition(appID, tc.key, tc.spec, time.Now())
+		var vErr *validation.Error
+		switch {
+		case tc.field == "" && err != nil:
+			t.Errorf("%s %+v: %v", tc.key, tc.spec, err)
+		case tc.field != "" && (!errors.As(err, &vErr) || vErr.Field != tc.field):
+			t.Errorf("%s %+v: err = %v, want a %s error", tc.key, tc.spec, err, tc.field)
+		}
+	}
+}
+
+func TestRedefineKeepsType(t *testing.T) {
+	d, err := NewDefinition(appID, "level", Spec{Type: TypeInteger}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Redefine(Spec{Type: TypeString}, time.Now()); !errors.Is(err, ErrTypeChange) {
+		t.Fatalf("err = %v, want ErrTypeChange", err)
+	}
+	if err := d.Redefine(Spec{Type: TypeInteger, Required: true}, time.Now()); err != nil || !d.Required() {
+		t.Fatalf("err = %v, required = %v", err, d.Required())
+	}
+}
+
+// schema is the test app's schema: a required, patterned employee id,
+// a department from a list, an integer level and a boolean.
+func schema(t *testing.T) []*Definition {
+	t.Helper()
+	specs := map[string]Spec{
+		"employee_id": {Type: TypeString, Required: true, Pattern: `E[0-9]{4}`},
+		"department":  {Type: TypeString, Enum: []string{"ops", "sales"}},
+		"level":       {Type: TypeInteger},
+		"remote":      {Type: TypeBoolean},
+	}
+	var defs []*Definition
+	for key, spec := range specs {
+		d, err := NewDefinition(appID, key, spec, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defs = append(defs, d)
+	}
+	return defs
+}
+
+func TestValidateValues(t *testing.T) {
+	defs := schema(t)
+	cases := []struct {
+		name  string
+		in    map[string]any
+		want  Values
+		field string // "" = valid
+	}{
+		{
+			name: "every key",
+			in: map[string]any{
+				"employee_id": "E0042", "department": "ops", "level": json.Number("007"), "remote": true,
+			},
+			want: Values{"employee_id": "E0042", "department": "ops", "level": "7", "remote": "true"},
+		},
+		{
+			name: "optional keys absent or null",
+			in:   map[string]any{"employee_id": "E0042", "department": nil},
+			want: Values{"employee_id": "E0042"},
+		},
+		{name: "whole float", in: map[string]any{"employee_id": "E0042", "level": 3.0}, want: Values{"employee_id": "E0042", "level": "3"}},
+		{name: "required missing", in: map[string]any{"level": json.Number("3")}, field: "values.employee_id"},
+		{name: "required null", in: map[string]any{"employee_id": nil}, field: "values.employee_id"},
+		{name: "undefined key", in: map[string]any{"employee_id": "E0042", "badge": "x"}, field: "values.badge"},
+		{name: "pattern matched in part", in: map[string]any{"employee_id": "E00421"}, field: "values.employee_id"},
+		{name: "pattern matched in the middle", in: map[string]any{"employee_id": "xE0042"}, field: "values.employee_id"},
+		{name: "not in the enum", in: map[string]any{"employee_id": "E0042", "department": "hr"}, field: "values.department"},
+		{name: "string for an integer", in: map[string]any{"employee_id": "E0042", "level": "3"}, field: "values.level"},
+		{name: "fraction", in: map[string]any{"employee_id": "E0042", "level": json.Number("3.5")}, field: "values.level"},
+		{name: "fractional float", in: map[string]any{"employee_id": "E0042", "level": 3.5}, field: "values.level"},
+		{name: "string for a boolean", in: map[string]any{"employee_id": "E0042", "remote": "true"}, field: "values.remote"},
+		{name: "number for a string", in: map[string]any{"employee_id": json.Number("42")}, field: "values.employee_id"},
+	}
+	for _, tc := range cases {
+		got, err := ValidateValues(defs, tc.in)
+		var vErr *validation.Error
+		switch {
+		case tc.field == "" && err != nil:
+			t.Errorf("%s: %v", tc.name, err)
+		case tc.field == "" && !maps.Equal(got, tc.want):
+			t.Errorf("%s: values = %v, want %v", tc.name, got, tc.want)
+		case tc.field != "" && (!errors.As(err, &vErr) || vErr.Field != tc.field):
+			t.Errorf("%s: err = %v, want a %s error", tc.name, err, tc.field)
+		}
+	}
+}
+
+// TestRestoredBadPattern checks that a stored pattern which no longer
+// compiles rejects every value rather than accepting them all.
+func TestRestoredBadPattern(t *testing.T) {
+	d := RestoreDefinition(RestoreDefinitionParams{
+		AppID: appID, Key: "employee_id", Spec: Spec{Type: TypeString, Pattern: `E[0-9`},
+	})
+	if _, err := d.Canonical("E0042"); err == nil {
+		t.Fatal("a value passed a pattern that does not compile")
+	}
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+var (
+	ErrDefinitionNotFound = errors.New("attribute: definition not found")
+
+	// ErrTypeChange — a definition's type is fixed; delete and define
+	// the key again to change it (which drops the stored values).
+	ErrTypeChange = errors.New("attribute: type cannot change")
+)
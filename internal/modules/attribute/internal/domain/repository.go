@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Repository is the persistence contract for the attribute context.
+//
+// Error contract:
+//
+//	GetDefinition     → ErrDefinitionNotFound
+//	DeleteDefinition  → ErrDefinitionNotFound
+//
+// Value reads return an empty Values, not an error, when the user holds
+// none. ReplaceValues deletes and re-inserts the set, so it is meant to
+// run inside dbutil.WithTx.
+type Repository interface {
+	// SaveDefinition inserts the definition or overwrites the one with
+	// the same (app, key).
+	SaveDefinition(ctx context.Context, d *Definition) error
+	GetDefinition(ctx context.Context, appID AppID, key string) (*Definition, error)
+	// ListDefinitions returns the app's schema ordered by key.
+	ListDefinitions(ctx context.Context, appID AppID) ([]*Definition, error)
+	// DeleteDefinition removes the definition and, by cascade, every
+	// value stored under it.
+	DeleteDefinition(ctx context.Context, appID AppID, key string) error
+
+	GetValues(ctx context.Context, userID UserID, appID AppID) (Values, error)
+	// ListValuesByUser returns the user's values in every app.
+	ListValuesByUser(ctx context.Context, userID UserID) (map[AppID]Values, error)
+	ReplaceValues(ctx context.Context, userID UserID, appID AppID, v Values, now time.Time) error
+	ListUsers(ctx context.Context, q ListQuery) (ListResult, error)
+}
+
+// ListQuery pages the users holding attributes in one app, by user id
+// ascending. Every entry of Filters (key → stored form) must match.
+type ListQuery struct {
+	AppID    AppID
+	Filters  Values
+	PageSize int
+	After    UserID // "" = first page
+}
+
+type UserValues struct {
+	UserID UserID
+	Values Values
+}
+
+type ListResult struct {
+	Users     []UserValues
+	NextAfter UserID // "" = last page
+}
@@ -0,0 +1,32 @@
+package httpapi
+
+import (
+	"sso/internal/modules/app"
+	"sso/internal/modules/attribute/internal/domain"
+	"sso/internal/modules/identity"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap translates attribute sentinels, and the app / identity ones
+// the use-cases pass through, into statuses. errors.proto has no
+// attribute reasons yet, so those entries are bare statuses (Reason
+// UNSPECIFIED).
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrDefinitionNotFound: {
+		Code: codes.NotFound, Message: "attribute definition not found"},
+	domain.ErrTypeChange: {
+		Code: codes.FailedPrecondition, Message: "attribute type cannot change; delete and recreate the definition"},
+	app.ErrAppNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_APP_NOT_FOUND, Message: "app not found"},
+	identity.ErrUserNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_NOT_FOUND, Message: "user not found"},
+	identity.ErrUserDeleted: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_DELETED, Message: "user is deleted"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
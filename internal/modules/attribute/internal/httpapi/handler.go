@@ -0,0 +1,255 @@
+// Package httpapi is the HTTP adapter for the attribute context.
+//
+// Custom attributes are not part of the published sso_protos (User has
+// no attributes field), so these are hand-written net/http handlers
+// mounted next to the grpc-gateway. Error bodies use the same
+// google.rpc.Status JSON shape as the gateway, so clients need a single
+// error decoder.
+package httpapi
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/attribute/internal/domain"
+	attrsvc "sso/internal/modules/attribute/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+type Handler struct {
+	svc *attrsvc.Service
+	api *apiutil.Adapter
+	log *slog.Logger
+}
+
+func NewHandler(svc *attrsvc.Service, authn Authenticator, authz Authorizer, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("attribute", authn, authz, log, toStatus), log: log}
+}
+
+// filterPrefix marks attribute filters in the user listing query:
+// ?attr.department=sales&attr.level=3.
+const filterPrefix = "attr."
+
+// Register mounts the attribute endpoints. All of them are admin.
+//
+//	GET    /v1/apps/{app_id}/attributes                 schema
+//	PUT    /v1/apps/{app_id}/attributes/{key}           define / redefine
+//	DELETE /v1/apps/{app_id}/attributes/{key}
+//	GET    /v1/apps/{app_id}/users?attr.<key>=&page_size=&page_token=
+//	GET    /v1/users/{user_id}/attributes?app_id=
+//	PUT    /v1/users/{user_id}/attributes/{app_id}      replace the app's set
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/apps/{app_id}/attributes", h.api.Authed(h.listDefinitions))
+	mux.HandleFunc("PUT /v1/apps/{app_id}/attributes/{key}", h.api.Authed(h.putDefinition))
+	mux.HandleFunc("DELETE /v1/apps/{app_id}/attributes/{key}", h.api.Authed(h.deleteDefinition))
+	mux.HandleFunc("GET /v1/apps/{app_id}/users", h.api.Authed(h.listUsers))
+	mux.HandleFunc("GET /v1/users/{user_id}/attributes", h.api.Authed(h.getUserAttributes))
+	mux.HandleFunc("PUT /v1/users/{user_id}/attributes/{app_id}", h.api.Authed(h.setUserAttributes))
+}
+
+// ----------------------------------------------------------------------------
+// Schema
+// ----------------------------------------------------------------------------
+
+type definitionBody struct {
+	Type        string   `json:"type"`
+	Required    bool     `json:"required"`
+	Pattern     string   `json:"pattern"`
+	Enum        []string `json:"enum"`
+	TokenClaim  bool     `json:"token_claim"`
+	Description string   `json:"description"`
+}
+
+func (h *Handler) putDefinition(w http.ResponseWriter, r *http.Request) {
+	var b definitionBody
+	if err := decodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	def, err := h.svc.PutDefinition(r.Context(), attrsvc.PutDefinitionInput{
+		AppID:       r.PathValue("app_id"),
+		Key:         r.PathValue("key"),
+		Type:        b.Type,
+		Required:    b.Required,
+		Pattern:     b.Pattern,
+		Enum:        b.Enum,
+		TokenClaim:  b.TokenClaim,
+		Description: b.Description,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, definitionView(def))
+}
+
+func (h *Handler) listDefinitions(w http.ResponseWriter, r *http.Request) {
+	defs, err := h.svc.ListDefinitions(r.Context(), r.PathValue("app_id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(defs))
+	for _, d := range defs {
+		views = append(views, definitionView(d))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"attributes": views})
+}
+
+func (h *Handler) deleteDefinition(w http.ResponseWriter, r *http.Request) {
+	err := h.svc.DeleteDefinition(r.Context(), attrsvc.DeleteDefinitionInput{
+		AppID: r.PathValue("app_id"),
+		Key:   r.PathValue("key"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ----------------------------------------------------------------------------
+// Values
+// ----------------------------------------------------------------------------
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	in := attrsvc.ListUsersInput{
+		AppID:     r.PathValue("app_id"),
+		PageToken: q.Get("page_token"),
+		Filters:   make(map[string]string),
+	}
+	if v := q.Get("page_size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			h.api.WriteError(w, r, &validation.Error{Field: "page_size", Reason: "must be an integer"})
+			return
+		}
+		in.PageSize = int32(n)
+	}
+	for k, vs := range q {
+		key, ok := strings.CutPrefix(k, filterPrefix)
+		if !ok {
+			continue
+		}
+		if len(vs) != 1 {
+			h.api.WriteError(w, r, &validation.Error{Field: "filter." + key, Reason: "must be given once"})
+			return
+		}
+		in.Filters[key] = vs[0]
+	}
+	out, err := h.svc.ListUsers(r.Context(), in)
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(out.Users))
+	for _, u := range out.Users {
+		views = append(views, map[string]any{
+			"user_id":    u.UserID.String(),
+			"attributes": u.Values,
+		})
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"users":           views,
+		"next_page_token": out.NextPageToken,
+	})
+}
+
+func (h *Handler) getUserAttributes(w http.ResponseWriter, r *http.Request) {
+	sets, err := h.svc.GetUserAttributes(r.Context(), r.PathValue("user_id"), r.URL.Query().Get("app_id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apps := make([]map[string]any, 0, len(sets))
+	for _, s := range sets {
+		apps = append(apps, map[string]any{
+			"app_id":     s.AppID.String(),
+			"attributes": s.Values,
+		})
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"user_id": r.PathValue("user_id"),
+		"apps":    apps,
+	})
+}
+
+type valuesBody struct {
+	Values map[string]any `json:"values"`
+}
+
+func (h *Handler) setUserAttributes(w http.ResponseWriter, r *http.Request) {
+	var b valuesBody
+	if err := decodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	values, err := h.svc.SetUserAttributes(r.Context(), attrsvc.SetUserAttributesInput{
+		UserID: r.PathValue("user_id"),
+		AppID:  r.PathValue("app_id"),
+		Values: b.Values,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"user_id":    r.PathValue("user_id"),
+		"app_id":     r.PathValue("app_id"),
+		"attributes": values,
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Views
+// ----------------------------------------------------------------------------
+
+func definitionView(d *domain.Definition) map[string]any {
+	spec := d.Spec()
+	enum := spec.Enum
+	if enum == nil {
+		enum = []string{}
+	}
+	return map[string]any{
+		"app_id":      d.AppID().String(),
+		"key":         d.Key(),
+		"type":        spec.Type.String(),
+		"required":    spec.Required,
+		"pattern":     spec.Pattern,
+		"enum":        enum,
+		"token_claim": spec.TokenClaim,
+		"description": spec.Description,
+		"created_at":  d.CreatedAt().UTC().Format(time.RFC3339),
+		"updated_at":  d.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Plumbing
+// ----------------------------------------------------------------------------
+
+// decodeJSON keeps numbers as json.Number so integer attributes are
+// not rounded through float64.
+func decodeJSON(r *http.Request, dst any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(nil, r.Body, apiutil.MaxBodyBytes))
+	dec.DisallowUnknownFields()
+	dec.UseNumber()
+	if err := dec.Decode(dst); err != nil {
+		return apiutil.ErrBadBody
+	}
+	return nil
+}
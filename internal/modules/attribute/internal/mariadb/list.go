@@ -0,0 +1,113 @@
+package mariadb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"sso/internal/modules/attribute/internal/domain"
+)
+
+// ListUsers is hand-written: every filter adds an EXISTS against the
+// (app_id, attr_key, value) index. The page is picked first by user id,
+// then the values of just those users are loaded.
+func (r *Repository) ListUsers(ctx context.Context, q domain.ListQuery) (domain.ListResult, error) {
+	if q.PageSize <= 0 {
+		return domain.ListResult{}, fmt.Errorf("attribute repo: list_users: page_size must be > 0")
+	}
+
+	where := []string{"v.app_id = ?"}
+	args := []any{q.AppID.String()}
+
+	// Sorted so the statement text is stable for a given filter set.
+	keys := make([]string, 0, len(q.Filters))
+	for k := range q.Filters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		where = append(where, `EXISTS (SELECT 1 FROM user_attribute_values f
+			WHERE f.user_id = v.user_id AND f.app_id = v.app_id
+			  AND f.attr_key = ? AND f.value = ?)`)
+		args = append(args, k, q.Filters[k])
+	}
+	if q.After != "" {
+		where = append(where, "v.user_id > ?")
+		args = append(args, q.After.String())
+	}
+
+	query := fmt.Sprintf(
+		`SELECT DISTINCT v.user_id FROM user_attribute_values v
+		 WHERE %s ORDER BY v.user_id LIMIT %d`,
+		strings.Join(where, " AND "), q.PageSize+1,
+	)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return domain.ListResult{}, fmt.Errorf("attribute repo: list_users: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]domain.UserID, 0, q.PageSize+1)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return domain.ListResult{}, fmt.Errorf("attribute repo: list_users: scan: %w", err)
+		}
+		ids = append(ids, domain.UserID(id))
+	}
+	if err := rows.Err(); err != nil {
+		return domain.ListResult{}, fmt.Errorf("attribute repo: list_users: rows: %w", err)
+	}
+
+	var next domain.UserID
+	if len(ids) > q.PageSize {
+		ids = ids[:q.PageSize]
+		next = ids[len(ids)-1]
+	}
+	if len(ids) == 0 {
+		return domain.ListResult{Users: []domain.UserValues{}}, nil
+	}
+
+	values, err := r.valuesOf(ctx, q.AppID, ids)
+	if err != nil {
+		return domain.ListResult{}, err
+	}
+	out := make([]domain.UserValues, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, domain.UserValues{UserID: id, Values: values[id]})
+	}
+	return domain.ListResult{Users: out, NextAfter: next}, nil
+}
+
+func (r *Repository) valuesOf(ctx context.Context, appID domain.AppID, ids []domain.UserID) (map[domain.UserID]domain.Values, error) {
+	args := make([]any, 0, len(ids)+1)
+	args = append(args, appID.String())
+	for _, id := range ids {
+		args = append(args, id.String())
+	}
+	query := `SELECT user_id, attr_key, value FROM user_attribute_values
+		WHERE app_id = ? AND user_id IN (?` + strings.Repeat(", ?", len(ids)-1) + `)`
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("attribute repo: list_users: values: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[domain.UserID]domain.Values, len(ids))
+	for rows.Next() {
+		var id, key, value string
+		if err := rows.Scan(&id, &key, &value); err != nil {
+			return nil, fmt.Errorf("attribute repo: list_users: values: scan: %w", err)
+		}
+		uid := domain.UserID(id)
+		if out[uid] == nil {
+			out[uid] = make(domain.Values)
+		}
+		out[uid][key] = value
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("attribute repo: list_users: values: rows: %w", err)
+	}
+	return out, nil
+}
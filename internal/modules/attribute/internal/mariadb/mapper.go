@@ -0,0 +1,57 @@
+package mariadb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sso/internal/modules/attribute/internal/domain"
+	"sso/internal/modules/attribute/internal/mariadb/dbgen"
+)
+
+func definitionToDomain(row dbgen.AppAttributeDef) (*domain.Definition, error) {
+	var enum []string
+	if len(row.EnumValues) > 0 {
+		if err := json.Unmarshal(row.EnumValues, &enum); err != nil {
+			return nil, fmt.Errorf("attribute repo: decode enum_values of %s/%s: %w", row.AppID, row.AttrKey, err)
+		}
+	}
+	return domain.RestoreDefinition(domain.RestoreDefinitionParams{
+		AppID: domain.AppID(row.AppID),
+		Key:   row.AttrKey,
+		Spec: domain.Spec{
+			Type:        domain.Type(row.Type),
+			Required:    row.Required,
+			Pattern:     row.Pattern,
+			Enum:        enum,
+			TokenClaim:  row.TokenClaim,
+			Description: row.Description,
+		},
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}), nil
+}
+
+func toSaveDefinitionParams(d *domain.Definition) (dbgen.SaveAttributeDefinitionParams, error) {
+	spec := d.Spec()
+	// enum_values is NULL when the attribute is unconstrained.
+	var enum json.RawMessage
+	if len(spec.Enum) > 0 {
+		raw, err := json.Marshal(spec.Enum)
+		if err != nil {
+			return dbgen.SaveAttributeDefinitionParams{}, fmt.Errorf("attribute repo: encode enum_values: %w", err)
+		}
+		enum = raw
+	}
+	return dbgen.SaveAttributeDefinitionParams{
+		AppID:       d.AppID().String(),
+		AttrKey:     d.Key(),
+		Type:        uint8(spec.Type),
+		Required:    spec.Required,
+		Pattern:     spec.Pattern,
+		EnumValues:  enum,
+		TokenClaim:  spec.TokenClaim,
+		Description: spec.Description,
+		CreatedAt:   d.CreatedAt(),
+		UpdatedAt:   d.UpdatedAt(),
+	}, nil
+}
@@ -0,0 +1,47 @@
+-- Attribute schemas and values. ListUsers is hand-written (list.go).
+
+-- name: SaveAttributeDefinition :exec
+INSERT INTO app_attribute_defs (
+    app_id, attr_key, type, required, pattern, enum_values,
+    token_claim, description, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    type = VALUES(type),
+    required = VALUES(required),
+    pattern = VALUES(pattern),
+    enum_values = VALUES(enum_values),
+    token_claim = VALUES(token_claim),
+    description = VALUES(description),
+    updated_at = VALUES(updated_at);
+
+-- name: GetAttributeDefinition :one
+SELECT * FROM app_attribute_defs
+WHERE app_id = ? AND attr_key = ?
+LIMIT 1;
+
+-- name: ListAttributeDefinitions :many
+SELECT * FROM app_attribute_defs
+WHERE app_id = ?
+ORDER BY attr_key;
+
+-- name: DeleteAttributeDefinition :execresult
+DELETE FROM app_attribute_defs
+WHERE app_id = ? AND attr_key = ?;
+
+-- name: GetUserAttributeValues :many
+SELECT attr_key, value FROM user_attribute_values
+WHERE user_id = ? AND app_id = ?;
+
+-- name: ListUserAttributeValuesByUser :many
+SELECT app_id, attr_key, value FROM user_attribute_values
+WHERE user_id = ?
+ORDER BY app_id, attr_key;
+
+-- name: DeleteUserAttributeValues :exec
+DELETE FROM user_attribute_values
+WHERE user_id = ? AND app_id = ?;
+
+-- name: InsertUserAttributeValue :exec
+INSERT INTO user_attribute_values (
+    user_id, app_id, attr_key, value, updated_at
+) VALUES (?, ?, ?, ?, ?);
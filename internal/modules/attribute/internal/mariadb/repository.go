@@ -0,0 +1,152 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/attribute/internal/domain"
+	"sso/internal/modules/attribute/internal/mariadb/dbgen"
+)
+
+type Repository struct {
+	db *sql.DB
+	q  *dbgen.Queries
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db, q: dbgen.New(db)}
+}
+
+var _ domain.Repository = (*Repository)(nil)
+
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one; ReplaceValues relies on it.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
+func (r *Repository) SaveDefinition(ctx context.Context, d *domain.Definition) error {
+	params, err := toSaveDefinitionParams(d)
+	if err != nil {
+		return err
+	}
+	if err := r.queries(ctx).SaveAttributeDefinition(ctx, params); err != nil {
+		return fmt.Errorf("attribute repo: save_definition: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetDefinition(ctx context.Context, appID domain.AppID, key string) (*domain.Definition, error) {
+	row, err := r.queries(ctx).GetAttributeDefinition(ctx, dbgen.GetAttributeDefinitionParams{
+		AppID:   appID.String(),
+		AttrKey: key,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrDefinitionNotFound
+		}
+		return nil, fmt.Errorf("attribute repo: get_definition: %w", err)
+	}
+	return definitionToDomain(row)
+}
+
+func (r *Repository) ListDefinitions(ctx context.Context, appID domain.AppID) ([]*domain.Definition, error) {
+	rows, err := r.queries(ctx).ListAttributeDefinitions(ctx, appID.String())
+	if err != nil {
+		return nil, fmt.Errorf("attribute repo: list_definitions: %w", err)
+	}
+	out := make([]*domain.Definition, 0, len(rows))
+	for _, row := range rows {
+		d, err := definitionToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, d)
+	}
+	return out, nil
+}
+
+func (r *Repository) DeleteDefinition(ctx context.Context, appID domain.AppID, key string) error {
+	res, err := r.queries(ctx).DeleteAttributeDefinition(ctx, dbgen.DeleteAttributeDefinitionParams{
+		AppID:   appID.String(),
+		AttrKey: key,
+	})
+	if err != nil {
+		return fmt.Errorf("attribute repo: delete_definition: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("attribute repo: rows_affected: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrDefinitionNotFound
+	}
+	return nil
+}
+
+func (r *Repository) GetValues(ctx context.Context, userID domain.UserID, appID domain.AppID) (domain.Values, error) {
+	rows, err := r.queries(ctx).GetUserAttributeValues(ctx, dbgen.GetUserAttributeValuesParams{
+		UserID: userID.String(),
+		AppID:  appID.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("attribute repo: get_values: %w", err)
+	}
+	out := make(domain.Values, len(rows))
+	for _, row := range rows {
+		out[row.AttrKey] = row.Value
+	}
+	return out, nil
+}
+
+func (r *Repository) ListValuesByUser(ctx context.Context, userID domain.UserID) (map[domain.AppID]domain.Values, error) {
+	rows, err := r.queries(ctx).ListUserAttributeValuesByUser(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("attribute repo: list_values_by_user: %w", err)
+	}
+	out := make(map[domain.AppID]domain.Values)
+	for _, row := range rows {
+		app := domain.AppID(row.AppID)
+		if out[app] == nil {
+			out[app] = make(domain.Values)
+		}
+		out[app][row.AttrKey] = row.Value
+	}
+	return out, nil
+}
+
+func (r *Repository) ReplaceValues(ctx context.Context, userID domain.UserID, appID domain.AppID, v domain.Values, now time.Time) error {
+	q := r.queries(ctx)
+	err := q.DeleteUserAttributeValues(ctx, dbgen.DeleteUserAttributeValuesParams{
+		UserID: userID.String(),
+		AppID:  appID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("attribute repo: replace_values: delete: %w", err)
+	}
+	for k, val := range v {
+		err := q.InsertUserAttributeValue(ctx, dbgen.InsertUserAttributeValueParams{
+			UserID:    userID.String(),
+			AppID:     appID.String(),
+			AttrKey:   k,
+			Value:     val,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			// fk_user_attribute_values_def: the definition was deleted
+			// after the set was validated.
+			if dbutil.IsForeignKeyViolation(err) {
+				return domain.ErrDefinitionNotFound
+			}
+			return fmt.Errorf("attribute repo: replace_values: insert: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/attribute/internal/domain"
+	"sso/internal/modules/audit"
+)
+
+// ----------------------------------------------------------------------------
+// PutDefinition
+// ----------------------------------------------------------------------------
+
+type PutDefinitionInput struct {
+	AppID       string
+	Key         string
+	Type        string // string | integer | boolean
+	Required    bool
+	Pattern     string
+	Enum        []string
+	TokenClaim  bool
+	Description string
+}
+
+// PutDefinition creates the attribute key in the app's schema or
+// replaces its spec. The type of an existing key cannot change
+// (ErrTypeChange).
+func (s *Service) PutDefinition(ctx context.Context, in PutDefinitionInput) (*domain.Definition, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	appID, err := domain.ParseAppID(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	key, err := domain.ParseKey(in.Key)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := domain.ParseType(in.Type)
+	if err != nil {
+		return nil, err
+	}
+	spec := domain.Spec{
+		Type:        typ,
+		Required:    in.Required,
+		Pattern:     in.Pattern,
+		Enum:        in.Enum,
+		TokenClaim:  in.TokenClaim,
+		Description: in.Description,
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAttributePutDefinition)
+	aud.SubjectType = audit.SubjectTypeApp
+	aud.SubjectID = appID.String()
+	aud.AppID = appID.String()
+	aud.Metadata = map[string]string{"key": key}
+
+	var def *domain.Definition
+	err = s.requireApp(ctx, appID)
+	if err == nil {
+		err = s.tx(ctx, func(ctx context.Context) error {
+			now := s.now().UTC()
+			existing, err := s.repo.GetDefinition(ctx, appID, key)
+			switch {
+			case err == nil:
+				if err := existing.Redefine(spec, now); err != nil {
+					return err
+				}
+				def = existing
+			case errors.Is(err, domain.ErrDefinitionNotFound):
+				if def, err = domain.NewDefinition(appID, key, spec, now); err != nil {
+					return err
+				}
+			default:
+				return err
+			}
+			return s.repo.SaveDefinition(ctx, def)
+		})
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("put attribute definition: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return def, nil
+}
+
+// ----------------------------------------------------------------------------
+// ListDefinitions
+// ----------------------------------------------------------------------------
+
+// ListDefinitions returns the app's schema ordered by key.
+func (s *Service) ListDefinitions(ctx context.Context, rawAppID string) ([]*domain.Definition, error) {
+	if _, err := actor.Require(ctx); err != nil {
+		return nil, err
+	}
+	appID, err := domain.ParseAppID(rawAppID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireApp(ctx, appID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListDefinitions(ctx, appID)
+}
+
+// ----------------------------------------------------------------------------
+// DeleteDefinition
+// ----------------------------------------------------------------------------
+
+type DeleteDefinitionInput struct {
+	AppID string
+	Key   string
+}
+
+// DeleteDefinition drops the key from the app's schema together with
+// every value users hold under it.
+func (s *Service) DeleteDefinition(ctx context.Context, in DeleteDefinitionInput) error {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return err
+	}
+	appID, err := domain.ParseAppID(in.AppID)
+	if err != nil {
+		return err
+	}
+	key, err := domain.ParseKey(in.Key)
+	if err != nil {
+		return err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAttributeDeleteDefinition)
+	aud.SubjectType = audit.SubjectTypeApp
+	aud.SubjectID = appID.String()
+	aud.AppID = appID.String()
+	aud.Metadata = map[string]string{"key": key}
+
+	if err := s.repo.DeleteDefinition(ctx, appID, key); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return fmt.Errorf("delete attribute definition: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return nil
+}
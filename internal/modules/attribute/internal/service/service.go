@@ -0,0 +1,85 @@
+// Package service hosts the application-layer use-cases of the
+// attribute bounded context:
+//
+//	service.go    — Service struct + helpers
+//	definition.go — Put/List/DeleteDefinition (admin, per-app schema)
+//	values.go     — Get/SetUserAttributes, ListUsers (admin),
+//	                TokenClaims (consumed by auth at token issuance)
+//
+// Apps and users are owned by their own contexts; the service checks
+// they exist through app.AppReader / identity.UserReader before writing
+// anything keyed on them.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"sso/internal/modules/app"
+	"sso/internal/modules/attribute/internal/domain"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/identity"
+)
+
+// TxRunner runs fn in one database transaction carried by ctx
+// (dbutil.WithTx bound to the module's *sql.DB).
+type TxRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+type Service struct {
+	repo    domain.Repository
+	apps    app.AppReader
+	users   identity.UserReader
+	tx      TxRunner
+	now     func() time.Time
+	log     *slog.Logger
+	auditor auditx.Auditor
+}
+
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	apps app.AppReader,
+	users identity.UserReader,
+	tx TxRunner,
+	now func() time.Time,
+	emitter audit.Emitter,
+) *Service {
+	return &Service{
+		repo:    repo,
+		apps:    apps,
+		users:   users,
+		tx:      tx,
+		now:     now,
+		log:     log,
+		auditor: auditx.New(log, emitter),
+	}
+}
+
+// errReasonMap maps attribute sentinels (and the cross-module ones the
+// use-cases pass through) to their audit (Outcome, Reason) pair.
+var errReasonMap = map[error]auditx.OutcomeReason{
+	domain.ErrDefinitionNotFound: auditx.Fail(audit.ReasonAttributeDefinitionNotFound),
+	domain.ErrTypeChange:         auditx.Fail(audit.ReasonAttributeTypeChange),
+	app.ErrAppNotFound:           auditx.Fail(audit.ReasonAppNotFound),
+	identity.ErrUserNotFound:     auditx.Fail(audit.ReasonUserNotFound),
+	identity.ErrUserDeleted:      auditx.Deny(audit.ReasonUserDeleted),
+}
+
+// classifyError is the per-package thin wrapper around auditx.Classify.
+func classifyError(err error) (audit.AuditOutcome, string) {
+	return auditx.Classify(err, errReasonMap)
+}
+
+// withOutcome is a thin alias over auditx.WithOutcome.
+func withOutcome(p audit.NewAuditParams, out audit.AuditOutcome, reason string) audit.NewAuditParams {
+	return auditx.WithOutcome(p, out, reason)
+}
+
+// requireApp fails with app.ErrAppNotFound for an unknown app. Disabled
+// apps keep their schema editable.
+func (s *Service) requireApp(ctx context.Context, id domain.AppID) error {
+	_, err := s.apps.GetByID(ctx, app.AppID(id))
+	return err
+}
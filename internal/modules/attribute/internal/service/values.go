@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/cursor"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/attribute/internal/domain"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/identity"
+)
+
+// ----------------------------------------------------------------------------
+// GetUserAttributes
+// ----------------------------------------------------------------------------
+
+// AppValues is one app's rendered value set for a user.
+type AppValues struct {
+	AppID  domain.AppID
+	Values map[string]any
+}
+
+// GetUserAttributes returns the user's values rendered under each app's
+// schema, ordered by app id. rawAppID narrows the result to one app;
+// an app the user holds nothing in yields an empty set.
+func (s *Service) GetUserAttributes(ctx context.Context, rawUserID, rawAppID string) ([]AppValues, error) {
+	if _, err := actor.Require(ctx); err != nil {
+		return nil, err
+	}
+	userID, err := domain.ParseUserID(rawUserID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.users.GetByID(ctx, identity.UserID(userID)); err != nil {
+		return nil, err
+	}
+
+	byApp := make(map[domain.AppID]domain.Values)
+	if rawAppID != "" {
+		appID, err := domain.ParseAppID(rawAppID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.requireApp(ctx, appID); err != nil {
+			return nil, err
+		}
+		v, err := s.repo.GetValues(ctx, userID, appID)
+		if err != nil {
+			return nil, err
+		}
+		byApp[appID] = v
+	} else {
+		if byApp, err = s.repo.ListValuesByUser(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([]AppValues, 0, len(byApp))
+	for appID, v := range byApp {
+		defs, err := s.repo.ListDefinitions(ctx, appID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, AppValues{AppID: appID, Values: domain.RenderValues(defs, v)})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].AppID < out[j].AppID })
+	return out, nil
+}
+
+// ----------------------------------------------------------------------------
+// SetUserAttributes
+// ----------------------------------------------------------------------------
+
+type SetUserAttributesInput struct {
+	UserID string
+	AppID  string
+	// Values is the complete set for the app: keys left out are
+	// cleared. Scalars as decoded from JSON with UseNumber.
+	Values map[string]any
+}
+
+// SetUserAttributes validates the value set against the app's schema and
+// replaces what the user held in that app.
+func (s *Service) SetUserAttributes(ctx context.Context, in SetUserAttributesInput) (map[string]any, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := domain.ParseUserID(in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	appID, err := domain.ParseAppID(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAttributeSetUserValues)
+	aud.SubjectType = audit.SubjectTypeUser
+	aud.SubjectID = userID.String()
+	aud.AppID = appID.String()
+
+	var rendered map[string]any
+	err = s.tx(ctx, func(ctx context.Context) error {
+		u, err := s.users.GetByID(ctx, identity.UserID(userID))
+		if err != nil {
+			return err
+		}
+		if u.Status() == identity.UserStatusDeleted {
+			return identity.ErrUserDeleted
+		}
+		if err := s.requireApp(ctx, appID); err != nil {
+			return err
+		}
+		defs, err := s.repo.ListDefinitions(ctx, appID)
+		if err != nil {
+			return err
+		}
+		v, err := domain.ValidateValues(defs, in.Values)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.ReplaceValues(ctx, userID, appID, v, s.now().UTC()); err != nil {
+			return err
+		}
+		rendered = domain.RenderValues(defs, v)
+		return nil
+	})
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("set user attributes: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return rendered, nil
+}
+
+// ----------------------------------------------------------------------------
+// ListUsers
+// ----------------------------------------------------------------------------
+
+type ListUsersInput struct {
+	AppID string
+	// Filters maps attribute keys to the value to match, as text; each
+	// is parsed under the key's type.
+	Filters   map[string]string
+	PageSize  int32
+	PageToken string
+}
+
+type ListUsersOutput struct {
+	Users         []AppUser
+	NextPageToken string
+}
+
+// AppUser is a user holding attributes in the listed app.
+type AppUser struct {
+	UserID identity.UserID
+	Values map[string]any
+}
+
+// ListUsers pages the users holding attributes in the app, by user id,
+// narrowed to those matching every filter.
+func (s *Service) ListUsers(ctx context.Context, in ListUsersInput) (ListUsersOutput, error) {
+	if _, err := actor.Require(ctx); err != nil {
+		return ListUsersOutput{}, err
+	}
+	appID, err := domain.ParseAppID(in.AppID)
+	if err != nil {
+		return ListUsersOutput{}, err
+	}
+	after, err := decodeCursor(in.PageToken)
+	if err != nil {
+		return ListUsersOutput{}, err
+	}
+	pageSize, err := auditx.ClampPageSize(in.PageSize)
+	if err != nil {
+		return ListUsersOutput{}, err
+	}
+	if err := s.requireApp(ctx, appID); err != nil {
+		return ListUsersOutput{}, err
+	}
+	defs, err := s.repo.ListDefinitions(ctx, appID)
+	if err != nil {
+		return ListUsersOutput{}, err
+	}
+
+	byKey := make(map[string]*domain.Definition, len(defs))
+	for _, d := range defs {
+		byKey[d.Key()] = d
+	}
+	filters := make(domain.Values, len(in.Filters))
+	for k, raw := range in.Filters {
+		d, ok := byKey[k]
+		if !ok {
+			return ListUsersOutput{}, &validation.Error{Field: "filter." + k, Reason: "is not defined for the app"}
+		}
+		v, err := d.ParseText(raw)
+		if err != nil {
+			return ListUsersOutput{}, err
+		}
+		filters[k] = v
+	}
+
+	res, err := s.repo.ListUsers(ctx, domain.ListQuery{
+		AppID:    appID,
+		Filters:  filters,
+		PageSize: pageSize,
+		After:    after,
+	})
+	if err != nil {
+		return ListUsersOutput{}, err
+	}
+	users := make([]AppUser, 0, len(res.Users))
+	for _, u := range res.Users {
+		users = append(users, AppUser{
+			UserID: identity.UserID(u.UserID),
+			Values: domain.RenderValues(defs, u.Values),
+		})
+	}
+	next, err := encodeCursor(res.NextAfter)
+	if err != nil {
+		return ListUsersOutput{}, err
+	}
+	return ListUsersOutput{Users: users, NextPageToken: next}, nil
+}
+
+type pageToken struct {
+	UserID string `json:"u"`
+}
+
+func encodeCursor(after domain.UserID) (string, error) {
+	if after == "" {
+		return "", nil
+	}
+	return cursor.Encode(&pageToken{UserID: after.String()})
+}
+
+func decodeCursor(s string) (domain.UserID, error) {
+	t, err := cursor.Decode[pageToken](s)
+	if err != nil {
+		return "", &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	if t == nil {
+		return "", nil
+	}
+	id, err := domain.ParseUserID(t.UserID)
+	if err != nil {
+		return "", &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	return id, nil
+}
+
+// ----------------------------------------------------------------------------
+// TokenClaims
+// ----------------------------------------------------------------------------
+
+// TokenClaims returns the user's values for the app whose definition is
+// flagged TokenClaim, typed, for the access token's "attrs" claim. nil
+// when there is nothing to emit. Called by auth without an actor.
+func (s *Service) TokenClaims(ctx context.Context, rawUserID, rawAppID string) (map[string]any, error) {
+	if rawUserID == "" || rawAppID == "" {
+		return nil, nil
+	}
+	userID, err := domain.ParseUserID(rawUserID)
+	if err != nil {
+		return nil, err
+	}
+	appID, err := domain.ParseAppID(rawAppID)
+	if err != nil {
+		return nil, err
+	}
+	defs, err := s.repo.ListDefinitions(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	claimDefs := defs[:0:0]
+	for _, d := range defs {
+		if d.TokenClaim() {
+			claimDefs = append(claimDefs, d)
+		}
+	}
+	if len(claimDefs) == 0 {
+		return nil, nil
+	}
+	v, err := s.repo.GetValues(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+	out := domain.RenderValues(claimDefs, v)
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+// ----------------------------------------------------------------------------
+// AppAttributes
+// ----------------------------------------------------------------------------
+
+// AppAttributes returns all of the user's values for the app, typed,
+// whatever their TokenClaim flag: permission conditions read them as
+// user.attributes. nil when the user has none. Called by access
+// without an actor.
+func (s *Service) AppAttributes(ctx context.Context, rawUserID, rawAppID string) (map[string]any, error) {
+	userID, err := domain.ParseUserID(rawUserID)
+	if err != nil {
+		return nil, err
+	}
+	appID, err := domain.ParseAppID(rawAppID)
+	if err != nil {
+		return nil, err
+	}
+	defs, err := s.repo.ListDefinitions(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	if len(defs) == 0 {
+		return nil, nil
+	}
+	v, err := s.repo.GetValues(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+	out := domain.RenderValues(defs, v)
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
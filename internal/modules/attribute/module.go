@@ -0,0 +1,114 @@
+// Package attribute exposes the wire-up for the attribute bounded
+// context (per-app attribute schemas and the values users hold against
+// them). bootstrap.New constructs a single *attribute.Module and pulls
+// everything else off it:
+//
+//	mod.RegisterHTTP(mux)  // mounts the attribute endpoints
+//	mod.Service()          // application-layer service; auth reads
+//	                       // token claims and access condition
+//	                       // attributes through it
+//	mod.Repository()       // persistence contract
+//
+// Like invitation, the surface is HTTP-only until sso_protos carries
+// attributes on User.
+package attribute
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/app"
+	"sso/internal/modules/attribute/internal/httpapi"
+	"sso/internal/modules/attribute/internal/mariadb"
+	"sso/internal/modules/attribute/internal/service"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity"
+)
+
+// Emitter is the audit-event sink the module uses to record write
+// operations. Type alias over audit.Emitter — keeps the wiring
+// contract local to this module.
+type Emitter = audit.Emitter
+
+// Deps lists everything attribute needs from its host. Users must read
+// through the same *sql.DB as DB: SetUserAttributes checks the user
+// inside the transaction that replaces its values.
+type Deps struct {
+	DB  *sql.DB
+	Log *slog.Logger
+
+	Apps          app.AppReader
+	Users         identity.UserReader
+	Authenticator Authenticator // *grpcauth.Interceptor
+	Authorizer    Authorizer    // *httprbac.Authorizer
+
+	Clock func() time.Time
+	Audit Emitter
+}
+
+// Module is the assembled attribute bounded context.
+type Module struct {
+	service *service.Service
+	handler *httpapi.Handler
+	repo    *mariadb.Repository
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.DB == nil {
+		return nil, fmt.Errorf("attribute: db is required")
+	}
+	if d.Log == nil {
+		return nil, fmt.Errorf("attribute: log is required")
+	}
+	if d.Apps == nil {
+		return nil, fmt.Errorf("attribute: apps reader is required")
+	}
+	if d.Users == nil {
+		return nil, fmt.Errorf("attribute: users reader is required")
+	}
+	if d.Authenticator == nil {
+		return nil, fmt.Errorf("attribute: authenticator is required")
+	}
+	if d.Authorizer == nil {
+		return nil, fmt.Errorf("attribute: authorizer is required")
+	}
+	if d.Clock == nil {
+		d.Clock = time.Now
+	}
+	if d.Audit == nil {
+		d.Audit = audit.NopEmitter{}
+	}
+
+	repo := mariadb.NewRepository(d.DB)
+
+	var _ Repository = repo
+
+	tx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return dbutil.WithTx(ctx, d.DB, fn)
+	}
+	svc := service.NewService(d.Log, repo, d.Apps, d.Users, tx, d.Clock, d.Audit)
+
+	return &Module{
+		service: svc,
+		handler: httpapi.NewHandler(svc, d.Authenticator, d.Authorizer, d.Log),
+		repo:    repo,
+	}, nil
+}
+
+// RegisterHTTP mounts the attribute endpoints on the HTTP listener's
+// root mux.
+func (m *Module) RegisterHTTP(mux *http.ServeMux) {
+	m.handler.Register(mux)
+}
+
+// Service returns the application-layer Service.
+func (m *Module) Service() *service.Service { return m.service }
+
+// Repository returns the persistence contract.
+func (m *Module) Repository() Repository { return m.repo }
@@ -0,0 +1,20 @@
+// Package attribute re-exports the application-layer Service together
+// with the typed Input/Output structs declared in internal/service.
+package attribute
+
+import "sso/internal/modules/attribute/internal/service"
+
+// Service is the use-case orchestrator, split across files in
+// internal/service: definition.go, values.go.
+type Service = service.Service
+
+// Input / Output type aliases.
+type (
+	PutDefinitionInput     = service.PutDefinitionInput
+	DeleteDefinitionInput  = service.DeleteDefinitionInput
+	SetUserAttributesInput = service.SetUserAttributesInput
+	ListUsersInput         = service.ListUsersInput
+	ListUsersOutput        = service.ListUsersOutput
+	AppValues              = service.AppValues
+	AppUser                = service.AppUser
+)
@@ -88,6 +88,14 @@ const (
 	SubjectTypeSession        = domain.SubjectTypeSession
 	SubjectTypeRoleAssignment = domain.SubjectTypeRoleAssignment
 	SubjectTypeServiceAccount = domain.SubjectTypeServiceAccount
+
+	SubjectTypeIdentityProvider = domain.SubjectTypeIdentityProvider
+	SubjectTypeInvitation       = domain.SubjectTypeInvitation
+	SubjectTypeGroup            = domain.SubjectTypeGroup
+	SubjectTypeTenant           = domain.SubjectTypeTenant
+	SubjectTypeAccessReview     = domain.SubjectTypeAccessReview
+	SubjectTypeAccessRequest    = domain.SubjectTypeAccessRequest
+	SubjectTypeSoDRule          = domain.SubjectTypeSoDRule
 )
 
 // ----------------------------------------------------------------------------
@@ -105,6 +113,10 @@ const (
 	EventTypeIdentityEnableUser            = domain.EventTypeIdentityEnableUser
 	EventTypeIdentitySoftDeleteUser        = domain.EventTypeIdentitySoftDeleteUser
 	EventTypeIdentityPermanentlyDeleteUser = domain.EventTypeIdentityPermanentlyDeleteUser
+	EventTypeIdentityUpdateMe              = domain.EventTypeIdentityUpdateMe
+	EventTypeIdentityRequestEmailChange    = domain.EventTypeIdentityRequestEmailChange
+	EventTypeIdentityConfirmEmailChange    = domain.EventTypeIdentityConfirmEmailChange
+	EventTypeIdentityCancelEmailChange     = domain.EventTypeIdentityCancelEmailChange
 
 	EventTypeAppCreateApp            = domain.EventTypeAppCreateApp
 	EventTypeAppGetApp               = domain.EventTypeAppGetApp
@@ -141,6 +153,13 @@ const (
 	EventTypeAccessRemoveRoleFromUser   = domain.EventTypeAccessRemoveRoleFromUser
 	EventTypeAccessBulkGrantRoles       = domain.EventTypeAccessBulkGrantRoles
 	EventTypeAccessBulkRemoveRoles      = domain.EventTypeAccessBulkRemoveRoles
+	EventTypeAccessGrantRoleToGroup     = domain.EventTypeAccessGrantRoleToGroup
+	EventTypeAccessRemoveRoleFromGroup  = domain.EventTypeAccessRemoveRoleFromGroup
+	EventTypeAccessExtendRoleAssignment = domain.EventTypeAccessExtendRoleAssignment
+	EventTypeAccessGrantScopedRole      = domain.EventTypeAccessGrantScopedRole
+	EventTypeAccessRemoveScopedRole     = domain.EventTypeAccessRemoveScopedRole
+	EventTypeAccessCreateSoDRule        = domain.EventTypeAccessCreateSoDRule
+	EventTypeAccessDeleteSoDRule        = domain.EventTypeAccessDeleteSoDRule
 
 	EventTypeAuthRegister                      = domain.EventTypeAuthRegister
 	EventTypeAuthLogin                         = domain.EventTypeAuthLogin
@@ -155,6 +174,58 @@ const (
 	EventTypeAuthGenerateRecoveryCodes         = domain.EventTypeAuthGenerateRecoveryCodes
 	EventTypeAuthResetPasswordWithRecoveryCode = domain.EventTypeAuthResetPasswordWithRecoveryCode
 	EventTypeAuthAuthenticateServiceAccount    = domain.EventTypeAuthAuthenticateServiceAccount
+	EventTypeAuthFederatedLogin                = domain.EventTypeAuthFederatedLogin
+
+	EventTypeFederationCreateProvider = domain.EventTypeFederationCreateProvider
+	EventTypeFederationUpdateProvider = domain.EventTypeFederationUpdateProvider
+	EventTypeFederationDeleteProvider = domain.EventTypeFederationDeleteProvider
+	EventTypeFederationLinkIdentity   = domain.EventTypeFederationLinkIdentity
+	EventTypeFederationUnlinkIdentity = domain.EventTypeFederationUnlinkIdentity
+
+	EventTypeSAMLSSO                   = domain.EventTypeSAMLSSO
+	EventTypeSAMLLogout                = domain.EventTypeSAMLLogout
+	EventTypeSAMLCreateServiceProvider = domain.EventTypeSAMLCreateServiceProvider
+	EventTypeSAMLUpdateServiceProvider = domain.EventTypeSAMLUpdateServiceProvider
+	EventTypeSAMLDeleteServiceProvider = domain.EventTypeSAMLDeleteServiceProvider
+
+	EventTypeInvitationCreate = domain.EventTypeInvitationCreate
+	EventTypeInvitationResend = domain.EventTypeInvitationResend
+	EventTypeInvitationRevoke = domain.EventTypeInvitationRevoke
+	EventTypeInvitationAccept = domain.EventTypeInvitationAccept
+
+	EventTypeAttributePutDefinition    = domain.EventTypeAttributePutDefinition
+	EventTypeAttributeDeleteDefinition = domain.EventTypeAttributeDeleteDefinition
+	EventTypeAttributeSetUserValues    = domain.EventTypeAttributeSetUserValues
+
+	EventTypeGroupCreate       = domain.EventTypeGroupCreate
+	EventTypeGroupUpdate       = domain.EventTypeGroupUpdate
+	EventTypeGroupDelete       = domain.EventTypeGroupDelete
+	EventTypeGroupAddMembers   = domain.EventTypeGroupAddMembers
+	EventTypeGroupRemoveMember = domain.EventTypeGroupRemoveMember
+
+	EventTypePermissionPut            = domain.EventTypePermissionPut
+	EventTypePermissionDelete         = domain.EventTypePermissionDelete
+	EventTypePermissionReplaceCatalog = domain.EventTypePermissionReplaceCatalog
+
+	EventTypeTenantCreate = domain.EventTypeTenantCreate
+	EventTypeTenantUpdate = domain.EventTypeTenantUpdate
+	EventTypeTenantDelete = domain.EventTypeTenantDelete
+
+	EventTypeAuthzRPCDenied   = domain.EventTypeAuthzRPCDenied
+	EventTypeAuthzRouteDenied = domain.EventTypeAuthzRouteDenied
+
+	EventTypeAccessReviewCreate   = domain.EventTypeAccessReviewCreate
+	EventTypeAccessReviewDecide   = domain.EventTypeAccessReviewDecide
+	EventTypeAccessReviewReassign = domain.EventTypeAccessReviewReassign
+	EventTypeAccessReviewCancel   = domain.EventTypeAccessReviewCancel
+	EventTypeAccessReviewEscalate = domain.EventTypeAccessReviewEscalate
+	EventTypeAccessReviewComplete = domain.EventTypeAccessReviewComplete
+
+	EventTypeAccessRequestCreate  = domain.EventTypeAccessRequestCreate
+	EventTypeAccessRequestApprove = domain.EventTypeAccessRequestApprove
+	EventTypeAccessRequestDeny    = domain.EventTypeAccessRequestDeny
+	EventTypeAccessRequestCancel  = domain.EventTypeAccessRequestCancel
+	EventTypeAccessRequestExpire  = domain.EventTypeAccessRequestExpire
 )
 
 // ----------------------------------------------------------------------------
@@ -191,6 +262,64 @@ const (
 	ReasonServiceAccountDisabled      = domain.ReasonServiceAccountDisabled
 	ReasonInvalidClientCredentials    = domain.ReasonInvalidClientCredentials
 	ReasonRateLimited                 = domain.ReasonRateLimited
+	ReasonAccountLocked               = domain.ReasonAccountLocked
+
+	ReasonIdentityProviderNotFound      = domain.ReasonIdentityProviderNotFound
+	ReasonIdentityProviderAlreadyExists = domain.ReasonIdentityProviderAlreadyExists
+	ReasonIdentityProviderDisabled      = domain.ReasonIdentityProviderDisabled
+	ReasonExternalIdentityNotLinked     = domain.ReasonExternalIdentityNotLinked
+	ReasonExternalIdentityAlreadyLinked = domain.ReasonExternalIdentityAlreadyLinked
+	ReasonFederationStateInvalid        = domain.ReasonFederationStateInvalid
+	ReasonFederationTokenInvalid        = domain.ReasonFederationTokenInvalid
+	ReasonLastSignInMethod              = domain.ReasonLastSignInMethod
+
+	ReasonSAMLServiceProviderNotFound      = domain.ReasonSAMLServiceProviderNotFound
+	ReasonSAMLServiceProviderAlreadyExists = domain.ReasonSAMLServiceProviderAlreadyExists
+	ReasonSAMLRequestInvalid               = domain.ReasonSAMLRequestInvalid
+
+	ReasonInvitationNotFound     = domain.ReasonInvitationNotFound
+	ReasonInvitationNotPending   = domain.ReasonInvitationNotPending
+	ReasonInvitationExpired      = domain.ReasonInvitationExpired
+	ReasonInvitationTokenInvalid = domain.ReasonInvitationTokenInvalid
+	ReasonInvitationPending      = domain.ReasonInvitationPending
+
+	ReasonEmailChangeTokenInvalid       = domain.ReasonEmailChangeTokenInvalid
+	ReasonEmailChangeExpired            = domain.ReasonEmailChangeExpired
+	ReasonEmailChangeNotPending         = domain.ReasonEmailChangeNotPending
+	ReasonEmailChangeCancelWindowClosed = domain.ReasonEmailChangeCancelWindowClosed
+
+	ReasonAttributeDefinitionNotFound = domain.ReasonAttributeDefinitionNotFound
+	ReasonAttributeTypeChange         = domain.ReasonAttributeTypeChange
+
+	ReasonGroupNotFound      = domain.ReasonGroupNotFound
+	ReasonGroupAlreadyExists = domain.ReasonGroupAlreadyExists
+
+	ReasonRoleIncludeCycle = domain.ReasonRoleIncludeCycle
+
+	ReasonAssignmentNotFound = domain.ReasonAssignmentNotFound
+
+	ReasonSoDRuleNotFound      = domain.ReasonSoDRuleNotFound
+	ReasonSoDRuleAlreadyExists = domain.ReasonSoDRuleAlreadyExists
+	ReasonSoDViolation         = domain.ReasonSoDViolation
+
+	ReasonPermissionNotFound = domain.ReasonPermissionNotFound
+	ReasonPermissionInUse    = domain.ReasonPermissionInUse
+
+	ReasonTenantNotFound      = domain.ReasonTenantNotFound
+	ReasonTenantAlreadyExists = domain.ReasonTenantAlreadyExists
+	ReasonTenantNotEmpty      = domain.ReasonTenantNotEmpty
+
+	ReasonAccessReviewNotFound     = domain.ReasonAccessReviewNotFound
+	ReasonAccessReviewItemNotFound = domain.ReasonAccessReviewItemNotFound
+	ReasonAccessReviewClosed       = domain.ReasonAccessReviewClosed
+	ReasonAccessReviewItemDecided  = domain.ReasonAccessReviewItemDecided
+	ReasonAccessReviewEmpty        = domain.ReasonAccessReviewEmpty
+	ReasonAccessReviewTooLarge     = domain.ReasonAccessReviewTooLarge
+
+	ReasonAccessRequestNotFound   = domain.ReasonAccessRequestNotFound
+	ReasonAccessRequestNotPending = domain.ReasonAccessRequestNotPending
+	ReasonAccessRequestExpired    = domain.ReasonAccessRequestExpired
+	ReasonAccessRequestPending    = domain.ReasonAccessRequestPending
 )
 
 // ID constructors / parsers re-exported as package-level variables.
@@ -202,7 +331,7 @@ var (
 	ParseAppID         = domain.ParseAppID
 	NewAudit           = domain.NewAudit
 	RestoreAudit       = domain.RestoreAudit
-	BaseFromActor     = domain.BaseFromActor
+	BaseFromActor      = domain.BaseFromActor
 	MapActorKind       = domain.MapActorKind
 	ParseEventTypeSlug = domain.ParseEventTypeSlug
 )
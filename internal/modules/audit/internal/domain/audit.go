@@ -356,6 +356,8 @@ func MapActorKind(k actor.Kind) ActorType {
 		return ActorTypeUser
 	case actor.KindServiceAccount:
 		return ActorTypeService
+	case actor.KindSystem:
+		return ActorTypeSystem
 	default:
 		return ActorTypeUnknown
 	}
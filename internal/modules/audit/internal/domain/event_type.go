@@ -13,6 +13,10 @@ const (
 	EventTypeIdentityEnableUser            EventType = 6
 	EventTypeIdentitySoftDeleteUser        EventType = 7
 	EventTypeIdentityPermanentlyDeleteUser EventType = 8
+	EventTypeIdentityUpdateMe              EventType = 9
+	EventTypeIdentityRequestEmailChange    EventType = 10
+	EventTypeIdentityConfirmEmailChange    EventType = 11
+	EventTypeIdentityCancelEmailChange     EventType = 12
 	// reserved for identity events 1 - 20
 
 	EventTypeAppCreateApp            EventType = 21
@@ -53,6 +57,13 @@ const (
 	EventTypeAccessRemoveRoleFromUser   EventType = 86
 	EventTypeAccessBulkGrantRoles       EventType = 87
 	EventTypeAccessBulkRemoveRoles      EventType = 88
+	EventTypeAccessGrantRoleToGroup     EventType = 89
+	EventTypeAccessRemoveRoleFromGroup  EventType = 90
+	EventTypeAccessExtendRoleAssignment EventType = 91
+	EventTypeAccessGrantScopedRole      EventType = 92
+	EventTypeAccessRemoveScopedRole     EventType = 93
+	EventTypeAccessCreateSoDRule        EventType = 94
+	EventTypeAccessDeleteSoDRule        EventType = 95
 	// reserved for access events 81 - 100
 
 	EventTypeAuthRegister                      EventType = 101
@@ -68,7 +79,69 @@ const (
 	EventTypeAuthGenerateRecoveryCodes         EventType = 111
 	EventTypeAuthResetPasswordWithRecoveryCode EventType = 112
 	EventTypeAuthAuthenticateServiceAccount    EventType = 113
+	EventTypeAuthFederatedLogin                EventType = 114
 	// reserved for auth events 101 - 130
+
+	EventTypeFederationCreateProvider EventType = 131
+	EventTypeFederationUpdateProvider EventType = 132
+	EventTypeFederationDeleteProvider EventType = 133
+	EventTypeFederationLinkIdentity   EventType = 134
+	EventTypeFederationUnlinkIdentity EventType = 135
+	// reserved for federation events 131 - 150
+
+	EventTypeSAMLSSO                   EventType = 151
+	EventTypeSAMLLogout                EventType = 152
+	EventTypeSAMLCreateServiceProvider EventType = 153
+	EventTypeSAMLUpdateServiceProvider EventType = 154
+	EventTypeSAMLDeleteServiceProvider EventType = 155
+	// reserved for saml events 151 - 170
+
+	EventTypeInvitationCreate EventType = 171
+	EventTypeInvitationResend EventType = 172
+	EventTypeInvitationRevoke EventType = 173
+	EventTypeInvitationAccept EventType = 174
+	// reserved for invitation events 171 - 190
+
+	EventTypeAttributePutDefinition    EventType = 191
+	EventTypeAttributeDeleteDefinition EventType = 192
+	EventTypeAttributeSetUserValues    EventType = 193
+	// reserved for attribute events 191 - 210
+
+	EventTypeGroupCreate       EventType = 211
+	EventTypeGroupUpdate       EventType = 212
+	EventTypeGroupDelete       EventType = 213
+	EventTypeGroupAddMembers   EventType = 214
+	EventTypeGroupRemoveMember EventType = 215
+	// reserved for group events 211 - 230
+
+	EventTypePermissionPut            EventType = 231
+	EventTypePermissionDelete         EventType = 232
+	EventTypePermissionReplaceCatalog EventType = 233
+	// reserved for permission events 231 - 250
+
+	EventTypeTenantCreate EventType = 251
+	EventTypeTenantUpdate EventType = 252
+	EventTypeTenantDelete EventType = 253
+	// reserved for tenant events 251 - 270
+
+	EventTypeAuthzRPCDenied   EventType = 271
+	EventTypeAuthzRouteDenied EventType = 272
+	// reserved for authz events 271 - 290
+
+	EventTypeAccessReviewCreate   EventType = 291
+	EventTypeAccessReviewDecide   EventType = 292
+	EventTypeAccessReviewReassign EventType = 293
+	EventTypeAccessReviewCancel   EventType = 294
+	EventTypeAccessReviewEscalate EventType = 295
+	EventTypeAccessReviewComplete EventType = 296
+	// reserved for access review events 291 - 310
+
+	EventTypeAccessRequestCreate  EventType = 311
+	EventTypeAccessRequestApprove EventType = 312
+	EventTypeAccessRequestDeny    EventType = 313
+	EventTypeAccessRequestCancel  EventType = 314
+	EventTypeAccessRequestExpire  EventType = 315
+	// reserved for access request events 311 - 330
 )
 
 func (e EventType) String() string {
@@ -89,6 +162,14 @@ func (e EventType) String() string {
 		return "identity.soft_delete_user"
 	case EventTypeIdentityPermanentlyDeleteUser:
 		return "identity.permanently_delete_user"
+	case EventTypeIdentityUpdateMe:
+		return "identity.update_me"
+	case EventTypeIdentityRequestEmailChange:
+		return "identity.request_email_change"
+	case EventTypeIdentityConfirmEmailChange:
+		return "identity.confirm_email_change"
+	case EventTypeIdentityCancelEmailChange:
+		return "identity.cancel_email_change"
 
 	case EventTypeAppCreateApp:
 		return "app.create_app"
@@ -157,6 +238,20 @@ func (e EventType) String() string {
 		return "access.bulk_grant_roles"
 	case EventTypeAccessBulkRemoveRoles:
 		return "access.bulk_remove_roles"
+	case EventTypeAccessGrantRoleToGroup:
+		return "access.grant_role_to_group"
+	case EventTypeAccessRemoveRoleFromGroup:
+		return "access.remove_role_from_group"
+	case EventTypeAccessExtendRoleAssignment:
+		return "access.extend_role_assignment"
+	case EventTypeAccessGrantScopedRole:
+		return "access.grant_scoped_role"
+	case EventTypeAccessRemoveScopedRole:
+		return "access.remove_scoped_role"
+	case EventTypeAccessCreateSoDRule:
+		return "access.create_sod_rule"
+	case EventTypeAccessDeleteSoDRule:
+		return "access.delete_sod_rule"
 
 	case EventTypeAuthRegister:
 		return "auth.register"
@@ -184,6 +279,100 @@ func (e EventType) String() string {
 		return "auth.reset_password_with_recovery_code"
 	case EventTypeAuthAuthenticateServiceAccount:
 		return "auth.authenticate_service_account"
+	case EventTypeAuthFederatedLogin:
+		return "auth.federated_login"
+
+	case EventTypeFederationCreateProvider:
+		return "federation.create_provider"
+	case EventTypeFederationUpdateProvider:
+		return "federation.update_provider"
+	case EventTypeFederationDeleteProvider:
+		return "federation.delete_provider"
+	case EventTypeFederationLinkIdentity:
+		return "federation.link_identity"
+	case EventTypeFederationUnlinkIdentity:
+		return "federation.unlink_identity"
+
+	case EventTypeSAMLSSO:
+		return "saml.sso"
+	case EventTypeSAMLLogout:
+		return "saml.logout"
+	case EventTypeSAMLCreateServiceProvider:
+		return "saml.create_service_provider"
+	case EventTypeSAMLUpdateServiceProvider:
+		return "saml.update_service_provider"
+	case EventTypeSAMLDeleteServiceProvider:
+		return "saml.delete_service_provider"
+
+	case EventTypeInvitationCreate:
+		return "invitation.create"
+	case EventTypeInvitationResend:
+		return "invitation.resend"
+	case EventTypeInvitationRevoke:
+		return "invitation.revoke"
+	case EventTypeInvitationAccept:
+		return "invitation.accept"
+
+	case EventTypeAttributePutDefinition:
+		return "attribute.put_definition"
+	case EventTypeAttributeDeleteDefinition:
+		return "attribute.delete_definition"
+	case EventTypeAttributeSetUserValues:
+		return "attribute.set_user_values"
+
+	case EventTypeGroupCreate:
+		return "group.create"
+	case EventTypeGroupUpdate:
+		return "group.update"
+	case EventTypeGroupDelete:
+		return "group.delete"
+	case EventTypeGroupAddMembers:
+		return "group.add_members"
+	case EventTypeGroupRemoveMember:
+		return "group.remove_member"
+
+	case EventTypePermissionPut:
+		return "permission.put"
+	case EventTypePermissionDelete:
+		return "permission.delete"
+	case EventTypePermissionReplaceCatalog:
+		return "permission.replace_catalog"
+
+	case EventTypeTenantCreate:
+		return "tenant.create"
+	case EventTypeTenantUpdate:
+		return "tenant.update"
+	case EventTypeTenantDelete:
+		return "tenant.delete"
+
+	case EventTypeAuthzRPCDenied:
+		return "authz.rpc_denied"
+	case EventTypeAuthzRouteDenied:
+		return "authz.route_denied"
+
+	case EventTypeAccessReviewCreate:
+		return "access_review.create"
+	case EventTypeAccessReviewDecide:
+		return "access_review.decide"
+	case EventTypeAccessReviewReassign:
+		return "access_review.reassign"
+	case EventTypeAccessReviewCancel:
+		return "access_review.cancel"
+	case EventTypeAccessReviewEscalate:
+		return "access_review.escalate"
+	case EventTypeAccessReviewComplete:
+		return "access_review.complete"
+
+	case EventTypeAccessRequestCreate:
+		return "access_request.create"
+	case EventTypeAccessRequestApprove:
+		return "access_request.approve"
+	case EventTypeAccessRequestDeny:
+		return "access_request.deny"
+	case EventTypeAccessRequestCancel:
+		return "access_request.cancel"
+	case EventTypeAccessRequestExpire:
+		return "access_request.expire"
 
 	default:
 		return "unknown"
@@ -201,7 +390,7 @@ func (e EventType) IsKnown() bool {
 // turn the stored slug back into a typed EventType.
 var eventTypeBySlug = func() map[string]EventType {
 	m := make(map[string]EventType, 64)
-	for et := EventType(1); et < 200; et++ {
+	for et := EventType(1); et < 1000; et++ {
 		s := et.String()
 		if s == "unknown" {
 			continue
@@ -31,4 +31,61 @@ const (
 	ReasonInvalidClientCredentials    = "ERROR_REASON_INVALID_CLIENT_CREDENTIALS"
 	ReasonRateLimited                 = "ERROR_REASON_RATE_LIMITED"
 	ReasonAccountLocked               = "ERROR_REASON_ACCOUNT_LOCKED"
+
+	ReasonIdentityProviderNotFound      = "ERROR_REASON_IDENTITY_PROVIDER_NOT_FOUND"
+	ReasonIdentityProviderAlreadyExists = "ERROR_REASON_IDENTITY_PROVIDER_ALREADY_EXISTS"
+	ReasonIdentityProviderDisabled      = "ERROR_REASON_IDENTITY_PROVIDER_DISABLED"
+	ReasonExternalIdentityNotLinked     = "ERROR_REASON_EXTERNAL_IDENTITY_NOT_LINKED"
+	ReasonExternalIdentityAlreadyLinked = "ERROR_REASON_EXTERNAL_IDENTITY_ALREADY_LINKED"
+	ReasonFederationStateInvalid        = "ERROR_REASON_FEDERATION_STATE_INVALID"
+	ReasonFederationTokenInvalid        = "ERROR_REASON_FEDERATION_TOKEN_INVALID"
+	ReasonLastSignInMethod              = "ERROR_REASON_LAST_SIGN_IN_METHOD"
+
+	ReasonSAMLServiceProviderNotFound      = "ERROR_REASON_SAML_SERVICE_PROVIDER_NOT_FOUND"
+	ReasonSAMLServiceProviderAlreadyExists = "ERROR_REASON_SAML_SERVICE_PROVIDER_ALREADY_EXISTS"
+	ReasonSAMLRequestInvalid               = "ERROR_REASON_SAML_REQUEST_INVALID"
+
+	ReasonInvitationNotFound     = "ERROR_REASON_INVITATION_NOT_FOUND"
+	ReasonInvitationNotPending   = "ERROR_REASON_INVITATION_NOT_PENDING"
+	ReasonInvitationExpired      = "ERROR_REASON_INVITATION_EXPIRED"
+	ReasonInvitationTokenInvalid = "ERROR_REASON_INVITATION_TOKEN_INVALID"
+	ReasonInvitationPending      = "ERROR_REASON_INVITATION_PENDING"
+
+	ReasonEmailChangeTokenInvalid       = "ERROR_REASON_EMAIL_CHANGE_TOKEN_INVALID"
+	ReasonEmailChangeExpired            = "ERROR_REASON_EMAIL_CHANGE_EXPIRED"
+	ReasonEmailChangeNotPending         = "ERROR_REASON_EMAIL_CHANGE_NOT_PENDING"
+	ReasonEmailChangeCancelWindowClosed = "ERROR_REASON_EMAIL_CHANGE_CANCEL_WINDOW_CLOSED"
+
+	ReasonAttributeDefinitionNotFound = "ERROR_REASON_ATTRIBUTE_DEFINITION_NOT_FOUND"
+	ReasonAttributeTypeChange         = "ERROR_REASON_ATTRIBUTE_TYPE_CHANGE"
+
+	ReasonGroupNotFound      = "ERROR_REASON_GROUP_NOT_FOUND"
+	ReasonGroupAlreadyExists = "ERROR_REASON_GROUP_ALREADY_EXISTS"
+
+	ReasonRoleIncludeCycle = "ERROR_REASON_ROLE_INCLUDE_CYCLE"
+
+	ReasonAssignmentNotFound = "ERROR_REASON_ASSIGNMENT_NOT_FOUND"
+
+	ReasonSoDRuleNotFound      = "ERROR_REASON_SOD_RULE_NOT_FOUND"
+	ReasonSoDRuleAlreadyExists = "ERROR_REASON_SOD_RULE_ALREADY_EXISTS"
+	ReasonSoDViolation         = "ERROR_REASON_SOD_VIOLATION"
+
+	ReasonPermissionNotFound = "ERROR_REASON_PERMISSION_NOT_FOUND"
+	ReasonPermissionInUse    = "ERROR_REASON_PERMISSION_IN_USE"
+
+	ReasonTenantNotFound      = "ERROR_REASON_TENANT_NOT_FOUND"
+	ReasonTenantAlreadyExists = "ERROR_REASON_TENANT_ALREADY_EXISTS"
+	ReasonTenantNotEmpty      = "ERROR_REASON_TENANT_NOT_EMPTY"
+
+	ReasonAccessReviewNotFound     = "ERROR_REASON_ACCESS_REVIEW_NOT_FOUND"
+	ReasonAccessReviewItemNotFound = "ERROR_REASON_ACCESS_REVIEW_ITEM_NOT_FOUND"
+	ReasonAccessReviewClosed       = "ERROR_REASON_ACCESS_REVIEW_CLOSED"
+	ReasonAccessReviewItemDecided  = "ERROR_REASON_ACCESS_REVIEW_ITEM_DECIDED"
+	ReasonAccessReviewEmpty        = "ERROR_REASON_ACCESS_REVIEW_EMPTY"
+	ReasonAccessReviewTooLarge     = "ERROR_REASON_ACCESS_REVIEW_TOO_LARGE"
+
+	ReasonAccessRequestNotFound   = "ERROR_REASON_ACCESS_REQUEST_NOT_FOUND"
+	ReasonAccessRequestNotPending = "ERROR_REASON_ACCESS_REQUEST_NOT_PENDING"
+	ReasonAccessRequestExpired    = "ERROR_REASON_ACCESS_REQUEST_EXPIRED"
+	ReasonAccessRequestPending    = "ERROR_REASON_ACCESS_REQUEST_PENDING"
 )
@@ -15,6 +15,14 @@ const (
 	SubjectTypeSession        SubjectType = 4
 	SubjectTypeRoleAssignment SubjectType = 5
 	SubjectTypeServiceAccount SubjectType = 6
+
+	SubjectTypeIdentityProvider SubjectType = 7
+	SubjectTypeInvitation       SubjectType = 8
+	SubjectTypeGroup            SubjectType = 9
+	SubjectTypeTenant           SubjectType = 10
+	SubjectTypeAccessReview     SubjectType = 11
+	SubjectTypeAccessRequest    SubjectType = 12
+	SubjectTypeSoDRule          SubjectType = 13
 )
 
 func (s SubjectType) String() string {
@@ -31,6 +39,20 @@ func (s SubjectType) String() string {
 		return "role_assignment"
 	case SubjectTypeServiceAccount:
 		return "service_account"
+	case SubjectTypeIdentityProvider:
+		return "identity_provider"
+	case SubjectTypeInvitation:
+		return "invitation"
+	case SubjectTypeGroup:
+		return "group"
+	case SubjectTypeTenant:
+		return "tenant"
+	case SubjectTypeAccessReview:
+		return "access_review"
+	case SubjectTypeAccessRequest:
+		return "access_request"
+	case SubjectTypeSoDRule:
+		return "sod_rule"
 	default:
 		return "unknown"
 	}
@@ -43,7 +65,14 @@ func (s SubjectType) IsKnown() bool {
 		SubjectTypeApp,
 		SubjectTypeSession,
 		SubjectTypeRoleAssignment,
-		SubjectTypeServiceAccount:
+		SubjectTypeServiceAccount,
+		SubjectTypeIdentityProvider,
+		SubjectTypeInvitation,
+		SubjectTypeGroup,
+		SubjectTypeTenant,
+		SubjectTypeAccessReview,
+		SubjectTypeAccessRequest,
+		SubjectTypeSoDRule:
 		return true
 	default:
 		return false
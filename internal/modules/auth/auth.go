@@ -6,6 +6,7 @@
 //	auth.New(Deps)    wires the module (module.go)
 //	auth.Service      application-layer use-cases (service.go re-exports)
 //	auth.PublicRPCs   slice of RPCs that bypass the grpcauth interceptor
+//	auth.SelfServiceRPCs  slice of RPCs that bypass the grpcrbac interceptor
 //
 // auth has no domain aggregates of its own — it orchestrates across
 // identity, session, recoverycode, app, serviceaccount. The Input /
@@ -24,6 +25,7 @@ type (
 	RegisterInput                       = service.RegisterInput
 	LoginInput                          = service.LoginInput
 	LoginOutput                         = service.LoginOutput
+	LoginFederatedInput                 = service.LoginFederatedInput
 	RefreshInput                        = service.RefreshInput
 	RefreshOutput                       = service.RefreshOutput
 	LogoutInput                         = service.LogoutInput
@@ -43,3 +45,29 @@ type (
 	AuthenticateServiceAccountInput     = service.AuthenticateServiceAccountInput
 	AuthenticateServiceAccountOutput    = service.AuthenticateServiceAccountOutput
 )
+
+// Sentinel errors. Re-exported for sibling modules that call into
+// Service in-process (federation hands its logins to LoginFederated)
+// and have to map the outcome onto their own transport.
+var (
+	ErrInvalidCredentials = service.ErrInvalidCredentials
+	ErrUserBlocked        = service.ErrUserBlocked
+	ErrUserDeleted        = service.ErrUserDeleted
+	ErrInvalidToken       = service.ErrInvalidToken
+)
+
+// Password backends. Login walks Deps.Authenticators in order; sibling
+// modules (directory) implement Authenticator to plug in.
+type (
+	Authenticator      = service.Authenticator
+	PasswordAttempt    = service.PasswordAttempt
+	LocalAuthenticator = service.LocalAuthenticator
+)
+
+// ErrNotManaged is what an Authenticator returns for accounts it does
+// not own.
+var ErrNotManaged = service.ErrNotManaged
+
+// ClaimsSource supplies per-app custom claims for user access tokens
+// (Deps.Claims). Satisfied by *attribute.Service.
+type ClaimsSource = service.ClaimsSource
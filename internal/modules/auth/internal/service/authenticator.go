@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"sso/internal/modules/identity"
+	"sso/internal/platform/crypto/passwordhash"
+)
+
+// ErrNotManaged is returned by an Authenticator that does not own the
+// account being signed in to. Login moves on to the next backend; when
+// every backend reports it, the attempt fails as invalid credentials.
+var ErrNotManaged = errors.New("auth: account not managed by authenticator")
+
+// PasswordAttempt is one Login as seen by a credential backend. User is
+// the local account matched by Email/Username, nil when there is none
+// yet; status checks have already passed when it is set. AppID and the
+// request meta are there for backends that audit what they provision.
+type PasswordAttempt struct {
+	Email    string
+	Username string
+	Password string
+	User     *identity.User
+
+	AppID     string
+	IpAddress string
+	UserAgent string
+}
+
+// Authenticator verifies a password against one credential backend.
+// Login tries the configured backends in order:
+//
+//   - (user, nil)             — authenticated; user is the local account
+//     to sign in, possibly provisioned by the backend on the fly.
+//   - ErrNotManaged           — not this backend's account, try the next.
+//   - ErrInvalidCredentials   — the backend owns the account and the
+//     password is wrong; Login stops here.
+//
+// Any other error is an infrastructure failure and aborts the login.
+type Authenticator interface {
+	// Name identifies the backend in audit metadata ("local", "ldap").
+	Name() string
+	Authenticate(ctx context.Context, in PasswordAttempt) (*identity.User, error)
+}
+
+// LocalAuthenticator checks the bcrypt hash stored on the identity row.
+// Accounts without a password (federated, directory, admin-created) are
+// left to the other backends.
+type LocalAuthenticator struct{}
+
+func (LocalAuthenticator) Name() string { return "local" }
+
+func (LocalAuthenticator) Authenticate(_ context.Context, in PasswordAttempt) (*identity.User, error) {
+	if in.User == nil || !in.User.HasPassword() {
+		return nil, ErrNotManaged
+	}
+	if err := passwordhash.Compare(in.User.PasswordHash(), in.Password); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+	return in.User, nil
+}
@@ -10,7 +10,6 @@ import (
 	"sso/internal/modules/identity"
 	"sso/internal/kernel/actor"
 	"sso/internal/kernel/validation"
-	"sso/internal/platform/crypto/jwt"
 	"sso/internal/platform/crypto/passwordhash"
 	"sso/internal/modules/session"
 
@@ -189,21 +188,17 @@ func (s *Service) ChangePassword(ctx context.Context, in ChangePasswordInput) (C
 		Now:                   now,
 		ExpiresAt:             sessionExpiresAt,
 		RefreshTokenExpiresAt: refreshExpiresAt,
+		AppID:                 session.AppID(a.AppID),
 	})
 	if err := s.sessions.Create(ctx, sess); err != nil {
 		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
 		return ChangePasswordOutput{}, fmt.Errorf("change password: create session: %w", err)
 	}
 
-	access, err := s.signer.Sign(jwt.Claims{
-		Subject:     user.ID().String(),
-		SubjectType: jwt.SubjectTypeUser,
-		SessionID:   sess.ID().String(),
-		JTI:         jti.String(),
-	})
+	access, err := s.signUserAccess(ctx, user, sess.ID().String(), jti.String(), a.AppID)
 	if err != nil {
 		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
-		return ChangePasswordOutput{}, fmt.Errorf("change password: sign access token: %w", err)
+		return ChangePasswordOutput{}, fmt.Errorf("change password: %w", err)
 	}
 
 	s.auditor.Success(ctx, aud)
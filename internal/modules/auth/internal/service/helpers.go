@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"sso/internal/modules/identity"
 	"sso/internal/modules/session"
+	"sso/internal/platform/crypto/jwt"
 )
 
 // revokeSessionBestEffort marks the session revoked in-aggregate and
@@ -23,3 +26,36 @@ func (s *Service) revokeSessionBestEffort(ctx context.Context, sess *session.Ses
 		)
 	}
 }
+
+// signUserAccess signs a user access token for the session. It names
+// the user's tenant (tid claim). With an app the token names it (app_id
+// claim and audience) and carries the user's attributes the app flags
+// as token claims. A failed claims lookup
+// fails the issuance — a token silently missing claims the app may
+// authorise on is worse than a retry.
+//
+// Issuer/IssuedAt/ExpiresAt are stamped by the signer from its own
+// configuration.
+func (s *Service) signUserAccess(ctx context.Context, user *identity.User, sessionID, jti, appID string) (string, error) {
+	userID := user.ID().String()
+	var attrs map[string]any
+	if s.claims != nil && appID != "" {
+		var err error
+		if attrs, err = s.claims.TokenClaims(ctx, userID, appID); err != nil {
+			return "", fmt.Errorf("attribute claims: %w", err)
+		}
+	}
+	access, err := s.signer.Sign(jwt.Claims{
+		Subject:     userID,
+		SubjectType: jwt.SubjectTypeUser,
+		SessionID:   sessionID,
+		JTI:         jti,
+		AppID:       appID,
+		TenantID:    user.TenantID(),
+		Attributes:  attrs,
+	})
+	if err != nil {
+		return "", fmt.Errorf("sign access token: %w", err)
+	}
+	return access, nil
+}
@@ -9,9 +9,8 @@ import (
 	"sso/internal/modules/app"
 	"sso/internal/modules/audit"
 	"sso/internal/modules/identity"
+	"sso/internal/kernel/tenant"
 	"sso/internal/kernel/validation"
-	"sso/internal/platform/crypto/jwt"
-	"sso/internal/platform/crypto/passwordhash"
 	"sso/internal/modules/session"
 
 	"github.com/google/uuid"
@@ -24,7 +23,7 @@ type LoginInput struct {
 	AppID      string
 	Email      string // exactly one of Email/Username must be set
 	Username   string
-	Password   string // plaintext; bcrypt-compared against user.PasswordHash
+	Password   string // plaintext; checked by the authenticator chain
 	UserAgent  string
 	IpAddress  string
 	DeviceName string
@@ -75,83 +74,108 @@ func (s *Service) Login(ctx context.Context, in LoginInput) (LoginOutput, error)
 	}
 	aud.AppID = appID.String()
 
-	a, err := s.apps.GetByID(ctx, appID)
+	a, err := s.requireActiveApp(ctx, appID, aud)
 	if err != nil {
-		if errors.Is(err, app.ErrAppNotFound) {
-			s.auditor.Fail(ctx, aud, audit.ReasonAppNotFound)
-			return LoginOutput{}, ErrInvalidCredentials
-		}
-		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
-		return LoginOutput{}, fmt.Errorf("login: get app: %w", err)
-	}
-	if a.Status() != app.AppStatusActive {
-		switch a.Status() {
-		case app.AppStatusDisabled:
-			s.auditor.Deny(ctx, aud, audit.ReasonAppDisabled)
-		case app.AppStatusMaintenance:
-			s.auditor.Deny(ctx, aud, audit.ReasonAppInMaintenance)
-		default:
-			s.auditor.Deny(ctx, aud, audit.ReasonInternal)
-		}
-		return LoginOutput{}, ErrInvalidCredentials
+		return LoginOutput{}, err
 	}
+	// The app's tenant is the one the user signs in to: email and
+	// username resolve there, and a directory backend provisions there.
+	ctx = tenant.With(ctx, a.TenantID())
 
 	// 3. Lookup user by email or username (whichever the client sent).
+	//    A miss is not final yet: a directory backend may provision the
+	//    account on first sign-in.
 	user, err := s.lookupUser(ctx, in.Email, in.Username)
-	if err != nil {
-		if errors.Is(err, identity.ErrUserNotFound) {
-			s.auditor.Fail(ctx, aud, audit.ReasonUserNotFound)
-			return LoginOutput{}, ErrInvalidCredentials
+	switch {
+	case err == nil:
+		aud.SubjectType = audit.SubjectTypeUser
+		aud.SubjectID = user.ID().String()
+
+		// 4. State checks. DELETED → invalid credentials (anti-
+		//    enumeration); BLOCKED is the one path we surface so the
+		//    legitimate user can recognise their account is on hold.
+		if err := s.checkLoginStatus(ctx, user, aud); err != nil {
+			return LoginOutput{}, err
 		}
+	case errors.Is(err, identity.ErrUserNotFound):
+		user = nil
+	default:
 		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
 		return LoginOutput{}, fmt.Errorf("login: lookup user: %w", err)
 	}
-	aud.SubjectType = audit.SubjectTypeUser
-	aud.SubjectID = user.ID().String()
 
-	// 4. State checks. DELETED → invalid credentials (anti-enumeration);
-	//    BLOCKED is the one path we surface so the legitimate user can
-	//    recognise their account is on hold.
-	switch user.Status() {
-	case identity.UserStatusDeleted:
-		s.auditor.Deny(ctx, aud, audit.ReasonUserDeleted)
-		return LoginOutput{}, ErrInvalidCredentials
-	case identity.UserStatusBlocked:
-		s.auditor.Deny(ctx, aud, audit.ReasonUserBlocked)
-		return LoginOutput{}, ErrUserBlocked
-	}
+	// 5. Password check against the configured backends, in order. No
+	//    backend claiming the account ≡ wrong password from the client's
+	//    perspective.
+	authed, backend, err := s.authenticatePassword(ctx, PasswordAttempt{
+		Email:    in.Email,
+		Username: in.Username,
+		Password: in.Password,
+		User:     user,
 
-	// 5. Password check. No password on file ≡ wrong password from the
-	//    client's perspective.
-	if !user.HasPassword() {
-		s.auditor.Fail(ctx, aud, audit.ReasonInvalidCredentials)
-		return LoginOutput{}, ErrInvalidCredentials
-	}
-	if err := passwordhash.Compare(user.PasswordHash(), in.Password); err != nil {
+		AppID:     aud.AppID,
+		IpAddress: in.IpAddress,
+		UserAgent: in.UserAgent,
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrInvalidCredentials):
 		s.auditor.Fail(ctx, aud, audit.ReasonPasswordMismatch)
 		return LoginOutput{}, ErrInvalidCredentials
+	case errors.Is(err, ErrNotManaged):
+		if user == nil {
+			s.auditor.Fail(ctx, aud, audit.ReasonUserNotFound)
+		} else {
+			s.auditor.Fail(ctx, aud, audit.ReasonInvalidCredentials)
+		}
+		return LoginOutput{}, ErrInvalidCredentials
+	default:
+		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
+		return LoginOutput{}, fmt.Errorf("login: %w", err)
 	}
+	aud.Metadata = map[string]string{"authenticator": backend}
+	if user == nil || authed.ID() != user.ID() {
+		aud.SubjectType = audit.SubjectTypeUser
+		aud.SubjectID = authed.ID().String()
+		if err := s.checkLoginStatus(ctx, authed, aud); err != nil {
+			return LoginOutput{}, err
+		}
+	}
+	user = authed
 
-	// 6. Mint identifiers and the refresh token.
-	sessionID, err := session.NewSessionID()
+	// 6. Mint the session and token pair.
+	out, err := s.issueUserSession(ctx, user, appID, in.UserAgent, in.IpAddress)
 	if err != nil {
 		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
-		return LoginOutput{}, fmt.Errorf("login: new session id: %w", err)
+		return LoginOutput{}, fmt.Errorf("login: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return out, nil
+}
+
+// issueUserSession is the tail shared by every interactive login path
+// (password Login, LoginFederated): mint ids and the refresh token,
+// persist the session, sign the access token, stamp last_login_at. The
+// caller has already decided the user may sign in and owns auditing.
+// appID binds the session to the app so Refresh re-issues its claims.
+func (s *Service) issueUserSession(ctx context.Context, user *identity.User, appID app.AppID, userAgent, ipAddress string) (LoginOutput, error) {
+	sessionID, err := session.NewSessionID()
+	if err != nil {
+		return LoginOutput{}, fmt.Errorf("new session id: %w", err)
 	}
 	jti, err := uuid.NewV7()
 	if err != nil {
-		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
-		return LoginOutput{}, fmt.Errorf("login: new jti: %w", err)
+		return LoginOutput{}, fmt.Errorf("new jti: %w", err)
 	}
 	refreshPlain, refreshHash, err := s.tokenGen.Generate()
 	if err != nil {
-		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
-		return LoginOutput{}, fmt.Errorf("login: gen refresh token: %w", err)
+		return LoginOutput{}, fmt.Errorf("gen refresh token: %w", err)
 	}
 
-	// 7. Compute the two expiry deadlines. The sliding window is capped
-	//    against the absolute hard-cap — defensive; config validation
-	//    already enforces refreshRotationTTL <= refreshTTL.
+	// Compute the two expiry deadlines. The sliding window is capped
+	// against the absolute hard-cap — defensive; config validation
+	// already enforces refreshRotationTTL <= refreshTTL.
 	now := s.now().UTC()
 	sessionExpiresAt := now.Add(s.refreshTTL)
 	refreshExpiresAt := now.Add(s.refreshRotationTTL)
@@ -159,38 +183,29 @@ func (s *Service) Login(ctx context.Context, in LoginInput) (LoginOutput, error)
 		refreshExpiresAt = sessionExpiresAt
 	}
 
-	// 8. Persist the session.
 	sess := session.NewSession(session.NewSessionParams{
 		ID:                    sessionID,
 		UserID:                session.UserID(user.ID().String()),
 		RefreshTokenHash:      refreshHash,
-		UserAgent:             in.UserAgent,
-		IpAddress:             in.IpAddress,
+		UserAgent:             userAgent,
+		IpAddress:             ipAddress,
 		Now:                   now,
 		ExpiresAt:             sessionExpiresAt,
 		RefreshTokenExpiresAt: refreshExpiresAt,
+		AppID:                 session.AppID(appID.String()),
 	})
 	if err := s.sessions.Create(ctx, sess); err != nil {
-		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
-		return LoginOutput{}, fmt.Errorf("login: create session: %w", err)
+		return LoginOutput{}, fmt.Errorf("create session: %w", err)
 	}
 
-	// 10. Sign the access token. Issuer/IssuedAt/ExpiresAt are stamped
-	//     by the signer from its own configuration.
-	access, err := s.signer.Sign(jwt.Claims{
-		Subject:     user.ID().String(),
-		SubjectType: jwt.SubjectTypeUser,
-		SessionID:   sess.ID().String(),
-		JTI:         jti.String(),
-	})
+	access, err := s.signUserAccess(ctx, user, sess.ID().String(), jti.String(), appID.String())
 	if err != nil {
-		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
-		return LoginOutput{}, fmt.Errorf("login: sign access token: %w", err)
+		return LoginOutput{}, err
 	}
 
-	err = s.users.UpdateLastLoginAt(ctx, user.ID(), now)
-
-	s.auditor.Success(ctx, aud)
+	if err := s.users.UpdateLastLoginAt(ctx, user.ID(), now); err != nil {
+		s.log.WarnContext(ctx, "auth: update last_login_at", "user_id", user.ID().String(), "err", err)
+	}
 
 	return LoginOutput{
 		AccessToken:      access,
@@ -202,6 +217,64 @@ func (s *Service) Login(ctx context.Context, in LoginInput) (LoginOutput, error)
 	}, nil
 }
 
+// checkLoginStatus rejects deleted and blocked accounts, auditing the
+// precise reason.
+func (s *Service) checkLoginStatus(ctx context.Context, user *identity.User, aud audit.NewAuditParams) error {
+	switch user.Status() {
+	case identity.UserStatusDeleted:
+		s.auditor.Deny(ctx, aud, audit.ReasonUserDeleted)
+		return ErrInvalidCredentials
+	case identity.UserStatusBlocked:
+		s.auditor.Deny(ctx, aud, audit.ReasonUserBlocked)
+		return ErrUserBlocked
+	}
+	return nil
+}
+
+// authenticatePassword walks the authenticator chain and returns the
+// signed-in user with the name of the backend that accepted it. The
+// first answer other than ErrNotManaged wins.
+func (s *Service) authenticatePassword(ctx context.Context, in PasswordAttempt) (*identity.User, string, error) {
+	for _, a := range s.authenticators {
+		user, err := a.Authenticate(ctx, in)
+		if errors.Is(err, ErrNotManaged) {
+			continue
+		}
+		if err != nil {
+			return nil, a.Name(), err
+		}
+		return user, a.Name(), nil
+	}
+	return nil, "", ErrNotManaged
+}
+
+// requireActiveApp loads the target app and collapses "missing" and
+// "not active" into ErrInvalidCredentials, auditing the precise reason.
+// Any other lookup failure is audited as internal and returned wrapped.
+func (s *Service) requireActiveApp(ctx context.Context, appID app.AppID, aud audit.NewAuditParams) (*app.App, error) {
+	a, err := s.apps.GetByID(ctx, appID)
+	if err != nil {
+		if errors.Is(err, app.ErrAppNotFound) {
+			s.auditor.Fail(ctx, aud, audit.ReasonAppNotFound)
+			return nil, ErrInvalidCredentials
+		}
+		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
+		return nil, fmt.Errorf("login: get app: %w", err)
+	}
+	if a.Status() != app.AppStatusActive {
+		switch a.Status() {
+		case app.AppStatusDisabled:
+			s.auditor.Deny(ctx, aud, audit.ReasonAppDisabled)
+		case app.AppStatusMaintenance:
+			s.auditor.Deny(ctx, aud, audit.ReasonAppInMaintenance)
+		default:
+			s.auditor.Deny(ctx, aud, audit.ReasonInternal)
+		}
+		return nil, ErrInvalidCredentials
+	}
+	return a, nil
+}
+
 // validateLoginInput enforces "exactly one of email/username", a
 // non-empty password, and a non-empty app_id. The full UUID parse for
 // app_id happens later (we need errors.Is-friendly handling there).
@@ -9,7 +9,6 @@ import (
 	"sso/internal/modules/audit"
 	"sso/internal/modules/identity"
 	"sso/internal/kernel/validation"
-	"sso/internal/platform/crypto/jwt"
 	"sso/internal/modules/session"
 
 	"github.com/google/uuid"
@@ -164,15 +163,10 @@ func (s *Service) Refresh(ctx context.Context, in RefreshInput) (*RefreshOutput,
 		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
 		return nil, fmt.Errorf("refresh: new jti: %w", err)
 	}
-	access, err := s.signer.Sign(jwt.Claims{
-		Subject:     user.ID().String(),
-		SubjectType: jwt.SubjectTypeUser,
-		SessionID:   sess.ID().String(),
-		JTI:         jti.String(),
-	})
+	access, err := s.signUserAccess(ctx, user, sess.ID().String(), jti.String(), sess.AppID().String())
 	if err != nil {
 		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
-		return nil, fmt.Errorf("refresh: sign access token: %w", err)
+		return nil, fmt.Errorf("refresh: %w", err)
 	}
 
 	s.auditor.Success(ctx, aud)
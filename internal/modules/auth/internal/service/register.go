@@ -7,6 +7,7 @@ import (
 
 	"sso/internal/modules/audit"
 	"sso/internal/modules/identity"
+	"sso/internal/kernel/tenant"
 	"sso/internal/kernel/validation"
 	"sso/internal/platform/crypto/passwordhash"
 )
@@ -39,8 +40,11 @@ func (s *Service) Register(ctx context.Context, r RegisterInput) (*identity.User
 		return nil, fmt.Errorf("register: new user id: %w", err)
 	}
 
+	// Register names no app, so there is no tenant to sign up to: the
+	// account lands in the System tenant, without super-admin rights.
 	user := identity.NewUser(identity.NewUserParams{
 		ID:           id,
+		TenantID:     tenant.Home(ctx),
 		Email:        r.Email,
 		Username:     r.Username,
 		DisplayName:  r.DisplayName,
@@ -9,8 +9,8 @@ import (
 	"sso/internal/modules/app"
 	"sso/internal/modules/audit"
 	"sso/internal/modules/identity"
+	"sso/internal/kernel/tenant"
 	"sso/internal/kernel/validation"
-	"sso/internal/platform/crypto/jwt"
 	"sso/internal/platform/crypto/passwordhash"
 	"sso/internal/modules/recoverycode"
 	"sso/internal/modules/session"
@@ -103,6 +103,8 @@ func (s *Service) ResetPasswordWithRecoveryCode(
 		}
 		return ResetPasswordWithRecoveryCodeOutput{}, app.ErrAppDisabled
 	}
+	// Email and username resolve in the app's tenant, as in Login.
+	ctx = tenant.With(ctx, a.TenantID())
 
 	user, err := s.lookupUser(ctx, in.Email, in.Username)
 	if err != nil {
@@ -212,21 +214,17 @@ func (s *Service) ResetPasswordWithRecoveryCode(
 		Now:                   now,
 		ExpiresAt:             sessionExpiresAt,
 		RefreshTokenExpiresAt: refreshExpiresAt,
+		AppID:                 session.AppID(a.ID().String()),
 	})
 	if err := s.sessions.Create(ctx, sess); err != nil {
 		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
 		return ResetPasswordWithRecoveryCodeOutput{}, fmt.Errorf("reset password: create session: %w", err)
 	}
 
-	access, err := s.signer.Sign(jwt.Claims{
-		Subject:     user.ID().String(),
-		SubjectType: jwt.SubjectTypeUser,
-		SessionID:   sess.ID().String(),
-		JTI:         jti.String(),
-	})
+	access, err := s.signUserAccess(ctx, user, sess.ID().String(), jti.String(), a.ID().String())
 	if err != nil {
 		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
-		return ResetPasswordWithRecoveryCodeOutput{}, fmt.Errorf("reset password: sign access token: %w", err)
+		return ResetPasswordWithRecoveryCodeOutput{}, fmt.Errorf("reset password: %w", err)
 	}
 
 	s.auditor.Success(ctx, aud)
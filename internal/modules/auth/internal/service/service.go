@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"log/slog"
 	"time"
 
@@ -16,6 +17,13 @@ import (
 	"sso/internal/modules/session"
 )
 
+// ClaimsSource supplies the custom claims a user's access token for an
+// app carries (the "attrs" claim). nil means none. Satisfied by
+// *attribute.Service.
+type ClaimsSource interface {
+	TokenClaims(ctx context.Context, userID, appID string) (map[string]any, error)
+}
+
 type Service struct {
 	log             *slog.Logger
 	users           identity.Repository
@@ -38,6 +46,13 @@ type Service struct {
 
 	bcryptCost int
 
+	// authenticators is the ordered password-backend chain Login walks.
+	authenticators []Authenticator
+
+	// claims supplies the per-app custom claims of user access tokens;
+	// nil when no source is wired.
+	claims ClaimsSource
+
 	auditor auditx.Auditor
 }
 
@@ -55,6 +70,8 @@ func NewService(
 	now func() time.Time,
 	accessTTL, refreshTTL, refreshRotationTTL time.Duration,
 	bcryptCost int,
+	authenticators []Authenticator,
+	claims ClaimsSource,
 	emitter audit.Emitter,
 ) *Service {
 	return &Service{
@@ -73,6 +90,8 @@ func NewService(
 		refreshTTL:         refreshTTL,
 		refreshRotationTTL: refreshRotationTTL,
 		bcryptCost:         bcryptCost,
+		authenticators:     authenticators,
+		claims:             claims,
 		auditor:            auditx.New(log, emitter),
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	"sso/internal/modules/app"
 	"sso/internal/modules/audit"
+	"sso/internal/kernel/tenant"
 	"sso/internal/kernel/validation"
 	"sso/internal/platform/crypto/jwt"
 	"sso/internal/platform/crypto/passwordhash"
@@ -103,6 +104,8 @@ func (s *Service) AuthenticateServiceAccount(
 		s.auditor.Deny(ctx, aud, audit.ReasonAppInMaintenance)
 		return AuthenticateServiceAccountOutput{}, app.ErrAppInMaintenance
 	}
+	// An account of another tenant than the app's reads as not found.
+	ctx = tenant.With(ctx, a.TenantID())
 
 	// 2. Resolve the service account. Missing → collapse to
 	//    invalid-credentials (anti-enumeration).
@@ -147,7 +150,9 @@ func (s *Service) AuthenticateServiceAccount(
 		// SessionID intentionally empty; the verifier path keys off
 		// SubjectType=SERVICE_ACCOUNT to skip the session lookup
 		// (see grpcauth.Interceptor and usecase Validate).
-		JTI: jti.String(),
+		JTI:      jti.String(),
+		AppID:    appID.String(),
+		TenantID: sa.TenantID(),
 	})
 	if err != nil {
 		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
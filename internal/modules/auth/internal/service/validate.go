@@ -25,12 +25,9 @@ type ValidateInput struct {
 // stays the only place that knows the proto enum. SessionID is empty
 // for service-account tokens (SAs are session-less by construction).
 //
-// AppID surfaces empty until the JWT claims gain an `app_id` field —
-// the proto contract requires it in ValidateTokenResponse but the
-// current jwt.Claims struct does not carry it yet. Wire-compliance
-// works (string default ""); semantic-compliance lands when login.go
-// and refresh.go start stamping AppID into the signing claims. The
-// downstream handler reads it from this struct unchanged either way.
+// AppID is the app the token was issued for. It is empty for tokens
+// minted before sessions carried an app (and for the rare session
+// whose app has since been deleted).
 type ValidateOutput struct {
 	SubjectID   string
 	SubjectType jwt.SubjectType
@@ -100,8 +97,7 @@ func (s *Service) Validate(ctx context.Context, in ValidateInput) (ValidateOutpu
 		SubjectID:   claims.Subject,
 		SubjectType: claims.SubjectType,
 		SessionID:   claims.SessionID,
-		// AppID stays empty until jwt.Claims carries it; see the
-		// ValidateOutput.AppID doc comment for the migration path.
-		ExpiresAt: claims.ExpiresAt,
+		AppID:       claims.AppID,
+		ExpiresAt:   claims.ExpiresAt,
 	}, nil
 }
@@ -60,6 +60,14 @@ type Deps struct {
 
 	BcryptCost int
 
+	// Authenticators is the ordered chain of password backends Login
+	// tries. Empty means local bcrypt only.
+	Authenticators []Authenticator
+
+	// Claims supplies per-app custom claims for user access tokens.
+	// Optional; nil issues tokens without them.
+	Claims ClaimsSource
+
 	Audit Emitter
 }
 
@@ -103,6 +111,9 @@ func New(d Deps) (*Module, error) {
 	if d.Audit == nil {
 		d.Audit = audit.NopEmitter{}
 	}
+	if len(d.Authenticators) == 0 {
+		d.Authenticators = []Authenticator{LocalAuthenticator{}}
+	}
 
 	svc := service.NewService(
 		d.Log,
@@ -112,6 +123,8 @@ func New(d Deps) (*Module, error) {
 		d.Clock,
 		d.AccessTTL, d.RefreshTTL, d.RefreshRotationTTL,
 		d.BcryptCost,
+		d.Authenticators,
+		d.Claims,
 		d.Audit,
 	)
 	h := grpcadapter.NewHandler(svc, d.Log)
@@ -26,3 +26,17 @@ var PublicRPCs = []string{
 	"/sso.auth.v1.AuthService/ResetPasswordWithRecoveryCode",
 	"/sso.auth.v1.AuthService/AuthenticateServiceAccount",
 }
+
+// SelfServiceRPCs lists the authenticated AuthService methods that act
+// only on the caller's own account — its sessions, tokens, password and
+// recovery codes. Every authenticated caller may use them, so the
+// grpcrbac interceptor lets them through without a permission check.
+var SelfServiceRPCs = []string{
+	"/sso.auth.v1.AuthService/Logout",
+	"/sso.auth.v1.AuthService/RevokeSession",
+	"/sso.auth.v1.AuthService/RevokeToken",
+	"/sso.auth.v1.AuthService/ListSessions",
+	"/sso.auth.v1.AuthService/RevokeAllSessions",
+	"/sso.auth.v1.AuthService/ChangePassword",
+	"/sso.auth.v1.AuthService/GenerateRecoveryCodes",
+}
@@ -0,0 +1,36 @@
+// Package directory is the public API of the directory bounded context
+// (password sign-in against an LDAP / Active Directory server).
+// External callers interact with the module through:
+//
+//	directory.New(Deps)     wires the module (module.go)
+//	mod.Authenticator()     the "ldap" backend for auth.Deps.Authenticators
+//	directory.Repository    persistence contract
+//
+// The module has no transport of its own: users sign in through the
+// regular AuthService Login, which walks the configured backends.
+package directory
+
+import (
+	"sso/internal/modules/directory/internal/domain"
+	"sso/internal/modules/directory/internal/service"
+)
+
+type (
+	Account    = domain.Account
+	UserID     = domain.UserID
+	Repository = domain.Repository
+
+	// Directory is satisfied by *ldap.Client.
+	Directory = service.Directory
+	// RoleSyncer is satisfied by *access.Service.
+	RoleSyncer = service.RoleSyncer
+
+	AttributeMapping = service.AttributeMapping
+	GroupRole        = service.GroupRole
+)
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var (
+	ErrAccountNotFound      = domain.ErrAccountNotFound
+	ErrAccountAlreadyLinked = domain.ErrAccountAlreadyLinked
+)
@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// UserID is the identity.UserID of the linked local account. Kept as a
+// plain string so domain does not import identity.
+type UserID string
+
+func (id UserID) String() string { return string(id) }
+
+// Account links a local user to the directory entry that owns its
+// password. It carries no credentials: the directory is asked on every
+// sign-in.
+type Account struct {
+	UserID   UserID
+	DN       string
+	LinkedAt time.Time
+	SyncedAt time.Time
+}
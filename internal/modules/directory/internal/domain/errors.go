@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrAccountNotFound — the user (or DN) has no directory link.
+	ErrAccountNotFound = errors.New("directory: account not found")
+
+	// ErrAccountAlreadyLinked — the DN or the user already has a link.
+	ErrAccountAlreadyLinked = errors.New("directory: account already linked")
+)
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Repository persists directory links.
+type Repository interface {
+	// CreateAccount inserts a link. A duplicate user or DN returns
+	// ErrAccountAlreadyLinked.
+	CreateAccount(ctx context.Context, a *Account) error
+
+	// GetAccountByUserID / GetAccountByDN return ErrAccountNotFound
+	// when no link exists.
+	GetAccountByUserID(ctx context.Context, userID UserID) (*Account, error)
+	GetAccountByDN(ctx context.Context, dn string) (*Account, error)
+
+	// TouchAccount stamps synced_at after a successful sign-in.
+	TouchAccount(ctx context.Context, userID UserID, now time.Time) error
+}
@@ -0,0 +1,24 @@
+package mariadb
+
+import (
+	"sso/internal/modules/directory/internal/domain"
+	"sso/internal/modules/directory/internal/mariadb/dbgen"
+)
+
+func accountToDomain(r dbgen.DirectoryAccount) *domain.Account {
+	return &domain.Account{
+		UserID:   domain.UserID(r.UserID),
+		DN:       r.Dn,
+		LinkedAt: r.LinkedAt,
+		SyncedAt: r.SyncedAt,
+	}
+}
+
+func toCreateAccountParams(a *domain.Account) dbgen.CreateDirectoryAccountParams {
+	return dbgen.CreateDirectoryAccountParams{
+		UserID:   a.UserID.String(),
+		Dn:       a.DN,
+		LinkedAt: a.LinkedAt,
+		SyncedAt: a.SyncedAt,
+	}
+}
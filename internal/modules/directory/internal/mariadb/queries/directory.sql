@@ -0,0 +1,19 @@
+-- name: CreateDirectoryAccount :exec
+INSERT INTO directory_accounts (
+    user_id, dn, linked_at, synced_at
+) VALUES (?, ?, ?, ?);
+
+-- name: GetDirectoryAccountByUserID :one
+SELECT user_id, dn, linked_at, synced_at
+FROM directory_accounts
+WHERE user_id = ?;
+
+-- name: GetDirectoryAccountByDN :one
+SELECT user_id, dn, linked_at, synced_at
+FROM directory_accounts
+WHERE dn = ?;
+
+-- name: TouchDirectoryAccount :exec
+UPDATE directory_accounts
+SET synced_at = ?
+WHERE user_id = ?;
@@ -0,0 +1,79 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/directory/internal/domain"
+	"sso/internal/modules/directory/internal/mariadb/dbgen"
+)
+
+type Repository struct {
+	db *sql.DB
+	q  *dbgen.Queries
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db, q: dbgen.New(db)}
+}
+
+var _ domain.Repository = (*Repository)(nil)
+
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one; provisioning creates the link in
+// the same transaction that creates the user.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
+// CreateAccount inserts a link. Both unique keys — user_id and dn —
+// surface as ErrAccountAlreadyLinked.
+func (r *Repository) CreateAccount(ctx context.Context, a *domain.Account) error {
+	if err := r.queries(ctx).CreateDirectoryAccount(ctx, toCreateAccountParams(a)); err != nil {
+		if dbutil.IsDuplicateEntry(err) {
+			return domain.ErrAccountAlreadyLinked
+		}
+		return fmt.Errorf("directory repo: create_account: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetAccountByUserID(ctx context.Context, userID domain.UserID) (*domain.Account, error) {
+	row, err := r.q.GetDirectoryAccountByUserID(ctx, userID.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("directory repo: get_account_by_user: %w", err)
+	}
+	return accountToDomain(row), nil
+}
+
+func (r *Repository) GetAccountByDN(ctx context.Context, dn string) (*domain.Account, error) {
+	row, err := r.q.GetDirectoryAccountByDN(ctx, dn)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("directory repo: get_account_by_dn: %w", err)
+	}
+	return accountToDomain(row), nil
+}
+
+func (r *Repository) TouchAccount(ctx context.Context, userID domain.UserID, now time.Time) error {
+	err := r.q.TouchDirectoryAccount(ctx, dbgen.TouchDirectoryAccountParams{
+		SyncedAt: now,
+		UserID:   userID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("directory repo: touch_account: %w", err)
+	}
+	return nil
+}
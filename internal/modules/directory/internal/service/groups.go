@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/access"
+	"sso/internal/modules/identity"
+	"sso/internal/platform/ldap"
+)
+
+// syncGroups applies the group → role mapping to the signed-in user:
+// roles mapped from a group the entry belongs to are granted, roles
+// mapped only from groups it left are removed. Roles that appear in no
+// mapping are never touched, so grants made by an admin survive.
+//
+// The mapping is compared against the user's direct grants only: a role
+// the user also holds through a local group still gets its direct
+// grant, and removal never targets a grant the sync could not have made.
+//
+// A grant refused by a separation-of-duties rule is logged and skipped;
+// access has audited the refusal, and the user still signs in with the
+// roles they may hold.
+//
+// The grants run as the System actor: access audits them like any other
+// grant, attributed to the server rather than to the user signing in.
+func (s *Service) syncGroups(ctx context.Context, user *identity.User, entry *ldap.Entry) error {
+	if len(s.cfg.GroupRoles) == 0 {
+		return nil
+	}
+
+	member := make(map[string]bool)
+	for _, g := range entry.Values(s.cfg.GroupAttribute) {
+		member[strings.ToLower(strings.TrimSpace(g))] = true
+	}
+
+	// A role may be mapped from several groups; one match is enough.
+	want := make(map[string]bool)
+	var order []string
+	for _, gr := range s.cfg.GroupRoles {
+		if _, seen := want[gr.RoleID]; !seen {
+			order = append(order, gr.RoleID)
+		}
+		want[gr.RoleID] = want[gr.RoleID] || member[strings.ToLower(gr.Group)]
+	}
+
+	sysCtx := actor.Inject(ctx, actor.System())
+	userID := user.ID().String()
+	for _, roleID := range order {
+		has, err := s.roles.HasDirectRole(sysCtx, access.HasRoleInAppInput{UserID: userID, RoleID: roleID})
+		if err != nil {
+			return fmt.Errorf("sync role %s: %w", roleID, err)
+		}
+		switch {
+		case want[roleID] && !has:
+			_, err := s.roles.GrantRoleToUser(sysCtx, access.GrantRoleToUserInput{UserID: userID, RoleID: roleID})
+			if errors.Is(err, access.ErrSoDViolation) {
+				s.log.WarnContext(ctx, "directory: group role breaks a separation-of-duties rule, not granted",
+					"user_id", userID, "role_id", roleID)
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("grant role %s: %w", roleID, err)
+			}
+		case !want[roleID] && has:
+			if err := s.roles.RemoveRoleFromUser(sysCtx, access.RemoveRoleFromUserInput{UserID: userID, RoleID: roleID}); err != nil {
+				return fmt.Errorf("remove role %s: %w", roleID, err)
+			}
+		}
+	}
+	return nil
+}
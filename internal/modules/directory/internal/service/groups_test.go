@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"sso/internal/modules/access"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/auth"
+	"sso/internal/platform/ldap"
+	"sso/internal/platform/ldap/ldaptest"
+)
+
+const (
+	roleAdmin   = "0190b6f2-8a43-7c1e-9d2a-000000000001"
+	roleAuditor = "0190b6f2-8a43-7c1e-9d2a-000000000002"
+	roleViewer  = "0190b6f2-8a43-7c1e-9d2a-000000000003"
+)
+
+// fakeRoles keeps direct grants per role; viaGroup roles are held
+// through a local group and are invisible to HasDirectRole. Granting a
+// role in sod fails as a separation-of-duties violation.
+type fakeRoles struct {
+	direct   map[string]bool
+	viaGroup map[string]bool
+	sod      map[string]bool
+	granted  []string
+	removed  []string
+}
+
+func (r *fakeRoles) HasDirectRole(_ context.Context, in access.HasRoleInAppInput) (bool, error) {
+	return r.direct[in.RoleID], nil
+}
+
+func (r *fakeRoles) GrantRoleToUser(_ context.Context, in access.GrantRoleToUserInput) (access.GrantRoleToUserOutput, error) {
+	if r.sod[in.RoleID] {
+		return access.GrantRoleToUserOutput{}, access.ErrSoDViolation
+	}
+	r.direct[in.RoleID] = true
+	r.granted = append(r.granted, in.RoleID)
+	return access.GrantRoleToUserOutput{Created: true}, nil
+}
+
+func (r *fakeRoles) RemoveRoleFromUser(_ context.Context, in access.RemoveRoleFromUserInput) error {
+	delete(r.direct, in.RoleID)
+	r.removed = append(r.removed, in.RoleID)
+	return nil
+}
+
+func TestSyncGroups(t *testing.T) {
+	mapping := []GroupRole{
+		{Group: "cn=Admins,ou=groups,dc=example,dc=com", RoleID: roleAdmin},
+		{Group: "cn=Admins,ou=groups,dc=example,dc=com", RoleID: roleAuditor},
+		{Group: "cn=Viewers,ou=groups,dc=example,dc=com", RoleID: roleViewer},
+	}
+	cfg := Config{
+		Provision:      true,
+		Attributes:     AttributeMapping{Email: "mail"},
+		GroupAttribute: "memberOf",
+		GroupRoles:     mapping,
+	}
+
+	cases := []struct {
+		name        string
+		roles       *fakeRoles
+		wantGranted []string
+		wantRemoved []string
+	}{
+		{
+			name:        "grants mapped roles and removes left ones",
+			roles:       &fakeRoles{direct: map[string]bool{roleViewer: true}},
+			wantGranted: []string{roleAdmin, roleAuditor},
+			wantRemoved: []string{roleViewer},
+		},
+		{
+			// A group grant is not the sync's: the direct grant is still
+			// made, and a role held only through a group is not removed.
+			name: "group grants do not count",
+			roles: &fakeRoles{
+				direct:   map[string]bool{},
+				viaGroup: map[string]bool{roleAdmin: true, roleViewer: true},
+			},
+			wantGranted: []string{roleAdmin, roleAuditor},
+		},
+		{
+			name:        "separation-of-duties refusal is skipped",
+			roles:       &fakeRoles{direct: map[string]bool{}, sod: map[string]bool{roleAdmin: true}},
+			wantGranted: []string{roleAuditor},
+		},
+		{
+			name:  "already in sync",
+			roles: &fakeRoles{direct: map[string]bool{roleAdmin: true, roleAuditor: true}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := newStore()
+			s := newTestService(st, &fakeAccounts{st: st}, tc.roles, cfg)
+			if _, err := s.Authenticate(context.Background(), auth.PasswordAttempt{Email: "ada@example.com", Password: "s3cret"}); err != nil {
+				t.Fatalf("sign-in: %v", err)
+			}
+			if !slices.Equal(tc.roles.granted, tc.wantGranted) {
+				t.Fatalf("granted = %v, want %v", tc.roles.granted, tc.wantGranted)
+			}
+			if !slices.Equal(tc.roles.removed, tc.wantRemoved) {
+				t.Fatalf("removed = %v, want %v", tc.roles.removed, tc.wantRemoved)
+			}
+		})
+	}
+}
+
+// TestSyncGroupsFromDirectory runs the mapping against groups read by
+// the real client: the directory spells the attribute and the group DNs
+// differently from the configuration, and both still match.
+func TestSyncGroupsFromDirectory(t *testing.T) {
+	const svcDN = "cn=sso,dc=example,dc=com"
+	srv := ldaptest.Start(t,
+		ldaptest.Entry{DN: svcDN, Password: "svc-pw"},
+		ldaptest.Entry{DN: adaDN, Password: "s3cret", Attributes: map[string][]string{
+			"mail":     {"ada@example.com"},
+			"uid":      {"ada"},
+			"memberof": {"CN=Admins,OU=Groups,DC=example,DC=com", "cn=Staff,ou=groups,dc=example,dc=com"},
+		}},
+	)
+	dir := ldap.New(ldap.Config{
+		URL:          srv.URL,
+		BindDN:       svcDN,
+		BindPassword: "svc-pw",
+		BaseDN:       "ou=people,dc=example,dc=com",
+		UserFilter:   "(mail={login})",
+		Attributes:   []string{"mail", "uid", "memberOf"},
+	})
+	cfg := Config{
+		Provision:      true,
+		Attributes:     AttributeMapping{Email: "mail"},
+		GroupAttribute: "memberOf",
+		GroupRoles: []GroupRole{
+			{Group: "cn=Admins,ou=groups,dc=example,dc=com", RoleID: roleAdmin},
+			{Group: "cn=Viewers,ou=groups,dc=example,dc=com", RoleID: roleViewer},
+		},
+	}
+
+	st := newStore()
+	roles := &fakeRoles{direct: map[string]bool{roleViewer: true}}
+	s := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), &fakeAccounts{st: st}, fakeUsers{st: st},
+		dir, roles, st.tx, cfg, time.Now, audit.NopEmitter{})
+
+	if _, err := s.Authenticate(context.Background(), auth.PasswordAttempt{Email: "ada@example.com", Password: "s3cret"}); err != nil {
+		t.Fatalf("sign-in: %v", err)
+	}
+	if !slices.Equal(roles.granted, []string{roleAdmin}) {
+		t.Fatalf("granted = %v, want [%s]", roles.granted, roleAdmin)
+	}
+	if !slices.Equal(roles.removed, []string{roleViewer}) {
+		t.Fatalf("removed = %v, want [%s]", roles.removed, roleViewer)
+	}
+}
@@ -0,0 +1,267 @@
+// Package service hosts the application-layer side of the directory
+// bounded context: the "ldap" password backend plugged into auth's
+// authenticator chain.
+//
+//	service.go — Service struct, Authenticate (auth.Authenticator)
+//	groups.go  — group → role synchronisation
+//
+// The service never mints tokens: it only answers "is this password
+// right, and which local user is it?". auth applies the app and
+// account-state gates and issues the session, exactly as for a bcrypt
+// login.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/access"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/auth"
+	"sso/internal/modules/directory/internal/domain"
+	"sso/internal/modules/identity"
+	"sso/internal/platform/ldap"
+)
+
+// Directory is the slice of *ldap.Client the service uses.
+type Directory interface {
+	Authenticate(ctx context.Context, login, password string) (*ldap.Entry, error)
+}
+
+// RoleSyncer is the slice of access.Service used to apply the group →
+// role mapping.
+type RoleSyncer interface {
+	HasDirectRole(ctx context.Context, in access.HasRoleInAppInput) (bool, error)
+	GrantRoleToUser(ctx context.Context, in access.GrantRoleToUserInput) (access.GrantRoleToUserOutput, error)
+	RemoveRoleFromUser(ctx context.Context, in access.RemoveRoleFromUserInput) error
+}
+
+// TxRunner runs fn in one database transaction carried by ctx
+// (dbutil.WithTx bound to the module's *sql.DB).
+type TxRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+// AttributeMapping names the directory attributes copied onto a user
+// provisioned on first sign-in.
+type AttributeMapping struct {
+	Email       string
+	Username    string
+	DisplayName string
+}
+
+// GroupRole grants RoleID to every member of the group with DN Group.
+type GroupRole struct {
+	Group  string
+	RoleID string
+}
+
+// Config carries the non-dependency settings of the Service.
+type Config struct {
+	// Provision creates a local user on the first successful bind of an
+	// entry that has no link yet. Off, only already-linked accounts can
+	// sign in through the directory.
+	Provision  bool
+	Attributes AttributeMapping
+
+	// GroupAttribute lists the entry's group DNs (memberOf on AD and
+	// OpenLDAP with the memberof overlay). Only read when GroupRoles is
+	// set.
+	GroupAttribute string
+	GroupRoles     []GroupRole
+}
+
+type Service struct {
+	repo    domain.Repository
+	users   identity.Repository
+	dir     Directory
+	roles   RoleSyncer
+	tx      TxRunner
+	cfg     Config
+	now     func() time.Time
+	log     *slog.Logger
+	auditor auditx.Auditor
+}
+
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	users identity.Repository,
+	dir Directory,
+	roles RoleSyncer,
+	tx TxRunner,
+	cfg Config,
+	now func() time.Time,
+	emitter audit.Emitter,
+) *Service {
+	return &Service{
+		repo:    repo,
+		users:   users,
+		dir:     dir,
+		roles:   roles,
+		tx:      tx,
+		cfg:     cfg,
+		now:     now,
+		log:     log,
+		auditor: auditx.New(log, emitter),
+	}
+}
+
+var _ auth.Authenticator = (*Service)(nil)
+
+// Name identifies the backend in auth audit metadata.
+func (s *Service) Name() string { return "ldap" }
+
+// Authenticate binds as the directory entry matching the login and
+// resolves it to a local user.
+//
+// Resolution, in order:
+//  1. The local account matched by email/username has no directory
+//     link → ErrNotManaged: it belongs to another backend, and a
+//     directory entry that shares its email must not take it over.
+//  2. The bind fails → ErrNotManaged when no entry matches,
+//     ErrInvalidCredentials on a wrong password.
+//  3. The entry is linked → that user (the link wins over the login
+//     lookup, so a user renamed locally still signs in).
+//  4. Not linked and Provision is on → create a password-less user from
+//     the mapped attributes and link it. Refused (ErrNotManaged) when
+//     the email or username is already taken, for the same reason as 1.
+//
+// On success the group → role mapping is applied before returning.
+func (s *Service) Authenticate(ctx context.Context, in auth.PasswordAttempt) (*identity.User, error) {
+	if in.User != nil {
+		if _, err := s.repo.GetAccountByUserID(ctx, domain.UserID(in.User.ID())); err != nil {
+			if errors.Is(err, domain.ErrAccountNotFound) {
+				return nil, auth.ErrNotManaged
+			}
+			return nil, err
+		}
+	}
+
+	login := in.Email
+	if login == "" {
+		login = in.Username
+	}
+	entry, err := s.dir.Authenticate(ctx, login, in.Password)
+	switch {
+	case err == nil:
+	case errors.Is(err, ldap.ErrUserNotFound):
+		return nil, auth.ErrNotManaged
+	case errors.Is(err, ldap.ErrInvalidCredentials):
+		return nil, auth.ErrInvalidCredentials
+	default:
+		return nil, fmt.Errorf("ldap authenticate: %w", err)
+	}
+
+	user, err := s.resolve(ctx, in, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.syncGroups(ctx, user, entry); err != nil {
+		return nil, fmt.Errorf("ldap authenticate: %w", err)
+	}
+	if err := s.repo.TouchAccount(ctx, domain.UserID(user.ID()), s.now().UTC()); err != nil {
+		s.log.WarnContext(ctx, "directory: touch account", "user_id", user.ID().String(), "err", err)
+	}
+	return user, nil
+}
+
+// resolve maps the bound entry onto its linked user, provisioning one
+// when allowed. in.User, when set, is known to be linked already.
+func (s *Service) resolve(ctx context.Context, in auth.PasswordAttempt, entry *ldap.Entry) (*identity.User, error) {
+	acct, err := s.repo.GetAccountByDN(ctx, entry.DN)
+	switch {
+	case err == nil:
+		if in.User != nil && domain.UserID(in.User.ID()) == acct.UserID {
+			return in.User, nil
+		}
+		return s.users.GetByID(ctx, identity.UserID(acct.UserID))
+	case errors.Is(err, domain.ErrAccountNotFound):
+	default:
+		return nil, err
+	}
+
+	if in.User != nil {
+		// Linked to a different DN than the one the login matches now —
+		// the directory was rearranged under us. Refuse rather than guess.
+		s.log.WarnContext(ctx, "directory: linked account matched an unlinked entry",
+			"user_id", in.User.ID().String(), "dn", entry.DN)
+		return nil, auth.ErrNotManaged
+	}
+	if !s.cfg.Provision {
+		return nil, auth.ErrNotManaged
+	}
+	return s.provision(ctx, in, entry)
+}
+
+// provision creates the local user and the link for a first-time
+// directory sign-in, in one transaction: a user whose link cannot be
+// stored would hold the email and username with no way to sign in. The
+// user has no password hash: the directory stays the source of truth
+// for it.
+func (s *Service) provision(ctx context.Context, in auth.PasswordAttempt, entry *ldap.Entry) (*identity.User, error) {
+	email := entry.First(s.cfg.Attributes.Email)
+	if email == "" {
+		s.log.WarnContext(ctx, "directory: entry has no email, not provisioning", "dn", entry.DN)
+		return nil, auth.ErrNotManaged
+	}
+	username := entry.First(s.cfg.Attributes.Username)
+	if username == "" {
+		username, _, _ = strings.Cut(email, "@")
+	}
+
+	id, err := identity.NewUserID()
+	if err != nil {
+		return nil, fmt.Errorf("provision directory user: %w", err)
+	}
+	now := s.now().UTC()
+	// Login has set the tenant of the app being signed in to.
+	user := identity.NewUser(identity.NewUserParams{
+		ID:          id,
+		TenantID:    tenant.Home(ctx),
+		Email:       email,
+		Username:    username,
+		DisplayName: entry.First(s.cfg.Attributes.DisplayName),
+		Now:         now,
+	})
+
+	reg := audit.NewAuditParams{
+		EventType:   audit.EventTypeAuthRegister,
+		ActorType:   audit.ActorTypeAnonymous,
+		SubjectType: audit.SubjectTypeUser,
+		SubjectID:   user.ID().String(),
+		AppID:       in.AppID,
+		IpAddress:   in.IpAddress,
+		UserAgent:   in.UserAgent,
+		Metadata:    map[string]string{"authenticator": s.Name()},
+	}
+
+	acct := &domain.Account{
+		UserID:   domain.UserID(user.ID()),
+		DN:       entry.DN,
+		LinkedAt: now,
+		SyncedAt: now,
+	}
+	err = s.tx(ctx, func(ctx context.Context) error {
+		if err := s.users.Create(ctx, user); err != nil {
+			return err
+		}
+		return s.repo.CreateAccount(ctx, acct)
+	})
+	if err != nil {
+		if errors.Is(err, identity.ErrUserAlreadyExists) {
+			s.auditor.Deny(ctx, reg, audit.ReasonUserAlreadyExists)
+			return nil, auth.ErrNotManaged
+		}
+		s.auditor.Fail(ctx, reg, audit.ReasonInternal)
+		return nil, fmt.Errorf("provision directory user: %w", err)
+	}
+
+	s.auditor.Success(ctx, reg)
+	return user, nil
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"sso/internal/modules/audit"
+	"sso/internal/modules/auth"
+	"sso/internal/modules/directory/internal/domain"
+	"sso/internal/modules/identity"
+	"sso/internal/platform/ldap"
+)
+
+// fakeDirectory is an in-memory directory: entries by login, each with
+// its password.
+type fakeDirectory struct {
+	entries map[string]fakeEntry
+}
+
+type fakeEntry struct {
+	password string
+	entry    *ldap.Entry
+}
+
+func (d *fakeDirectory) Authenticate(_ context.Context, login, password string) (*ldap.Entry, error) {
+	e, ok := d.entries[login]
+	if !ok {
+		return nil, ldap.ErrUserNotFound
+	}
+	if password == "" || password != e.password {
+		return nil, ldap.ErrInvalidCredentials
+	}
+	return e.entry, nil
+}
+
+// txKey marks a ctx running inside fakeTx.
+type txKey struct{}
+
+// store is the committed state behind fakeUsers and fakeAccounts;
+// writes made inside fakeTx are staged and applied only when the
+// transaction function succeeds.
+type store struct {
+	users    map[identity.UserID]*identity.User
+	accounts map[string]*domain.Account // by DN
+	staged   []func()
+}
+
+func newStore() *store {
+	return &store{users: map[identity.UserID]*identity.User{}, accounts: map[string]*domain.Account{}}
+}
+
+func (s *store) write(ctx context.Context, apply func()) {
+	if ctx.Value(txKey{}) != nil {
+		s.staged = append(s.staged, apply)
+		return
+	}
+	apply()
+}
+
+func (s *store) tx(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.staged = nil
+	err := fn(context.WithValue(ctx, txKey{}, true))
+	if err == nil {
+		for _, apply := range s.staged {
+			apply()
+		}
+	}
+	s.staged = nil
+	return err
+}
+
+type fakeUsers struct {
+	identity.Repository
+	st *store
+}
+
+func (u fakeUsers) Create(ctx context.Context, user *identity.User) error {
+	for _, existing := range u.st.users {
+		if existing.Email == user.Email {
+			return identity.ErrUserAlreadyExists
+		}
+	}
+	u.st.write(ctx, func() { u.st.users[user.ID()] = user })
+	return nil
+}
+
+func (u fakeUsers) GetByID(_ context.Context, id identity.UserID) (*identity.User, error) {
+	if user, ok := u.st.users[id]; ok {
+		return user, nil
+	}
+	return nil, identity.ErrUserNotFound
+}
+
+type fakeAccounts struct {
+	st        *store
+	createErr error
+}
+
+func (a *fakeAccounts) CreateAccount(ctx context.Context, acct *domain.Account) error {
+	if a.createErr != nil {
+		return a.createErr
+	}
+	a.st.write(ctx, func() { a.st.accounts[acct.DN] = acct })
+	return nil
+}
+
+func (a *fakeAccounts) GetAccountByUserID(_ context.Context, id domain.UserID) (*domain.Account, error) {
+	for _, acct := range a.st.accounts {
+		if acct.UserID == id {
+			return acct, nil
+		}
+	}
+	return nil, domain.ErrAccountNotFound
+}
+
+func (a *fakeAccounts) GetAccountByDN(_ context.Context, dn string) (*domain.Account, error) {
+	if acct, ok := a.st.accounts[dn]; ok {
+		return acct, nil
+	}
+	return nil, domain.ErrAccountNotFound
+}
+
+func (a *fakeAccounts) TouchAccount(context.Context, domain.UserID, time.Time) error { return nil }
+
+const adaDN = "uid=ada,ou=people,dc=example,dc=com"
+
+func newTestService(st *store, accounts *fakeAccounts, roles RoleSyncer, cfg Config) *Service {
+	dir := &fakeDirectory{entries: map[string]fakeEntry{
+		"ada@example.com": {password: "s3cret", entry: &ldap.Entry{
+			DN: adaDN,
+			Attributes: map[string][]string{
+				"mail":     {"ada@example.com"},
+				"uid":      {"ada"},
+				"memberOf": {"cn=Admins,ou=groups,dc=example,dc=com"},
+			},
+		}},
+	}}
+	return NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), accounts, fakeUsers{st: st},
+		dir, roles, st.tx, cfg, time.Now, audit.NopEmitter{})
+}
+
+func TestAuthenticateProvisions(t *testing.T) {
+	st := newStore()
+	s := newTestService(st, &fakeAccounts{st: st}, nil,
+		Config{Provision: true, Attributes: AttributeMapping{Email: "mail", Username: "uid"}})
+
+	user, err := s.Authenticate(context.Background(), auth.PasswordAttempt{Email: "ada@example.com", Password: "s3cret"})
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if user.Email != "ada@example.com" || user.Username != "ada" {
+		t.Fatalf("user = %+v", user)
+	}
+	if len(st.users) != 1 || st.accounts[adaDN] == nil {
+		t.Fatalf("users = %d, account linked = %v", len(st.users), st.accounts[adaDN] != nil)
+	}
+
+	// The next sign-in resolves through the link.
+	again, err := s.Authenticate(context.Background(), auth.PasswordAttempt{Email: "ada@example.com", Password: "s3cret", User: user})
+	if err != nil || again.ID() != user.ID() {
+		t.Fatalf("second sign-in: user %v, err %v", again, err)
+	}
+}
+
+func TestAuthenticateProvisionRollsBackWithoutLink(t *testing.T) {
+	st := newStore()
+	accounts := &fakeAccounts{st: st, createErr: errors.New("db down")}
+	s := newTestService(st, accounts, nil, Config{Provision: true, Attributes: AttributeMapping{Email: "mail"}})
+
+	if _, err := s.Authenticate(context.Background(), auth.PasswordAttempt{Email: "ada@example.com", Password: "s3cret"}); err == nil {
+		t.Fatal("sign-in succeeded without a stored link")
+	}
+	if len(st.users) != 0 {
+		t.Fatalf("users = %d, want the user rolled back with the link", len(st.users))
+	}
+}
+
+func TestAuthenticateRejects(t *testing.T) {
+	cases := []struct {
+		name    string
+		attempt auth.PasswordAttempt
+		cfg     Config
+		want    error
+	}{
+		{"wrong password", auth.PasswordAttempt{Email: "ada@example.com", Password: "nope"},
+			Config{Provision: true}, auth.ErrInvalidCredentials},
+		{"unknown login", auth.PasswordAttempt{Email: "bob@example.com", Password: "s3cret"},
+			Config{Provision: true}, auth.ErrNotManaged},
+		{"provisioning off", auth.PasswordAttempt{Email: "ada@example.com", Password: "s3cret"},
+			Config{}, auth.ErrNotManaged},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := newStore()
+			tc.cfg.Attributes.Email = "mail"
+			s := newTestService(st, &fakeAccounts{st: st}, nil, tc.cfg)
+			if _, err := s.Authenticate(context.Background(), tc.attempt); !errors.Is(err, tc.want) {
+				t.Fatalf("err = %v, want %v", err, tc.want)
+			}
+			if len(st.users) != 0 {
+				t.Fatal("a user was provisioned")
+			}
+		})
+	}
+}
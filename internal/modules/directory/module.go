@@ -0,0 +1,104 @@
+package directory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/auth"
+	"sso/internal/modules/directory/internal/mariadb"
+	"sso/internal/modules/directory/internal/service"
+	"sso/internal/modules/identity"
+)
+
+// Emitter is the audit-event sink the module uses to record write
+// operations. Type alias over audit.Emitter — keeps the wiring
+// contract local to this module.
+type Emitter = audit.Emitter
+
+// Deps lists everything directory needs from its host. Users must
+// write through the same *sql.DB as DB: provisioning creates the user
+// and its link inside one dbutil.WithTx transaction.
+type Deps struct {
+	DB  *sql.DB
+	Log *slog.Logger
+
+	Users     identity.Repository
+	Directory Directory  // *ldap.Client
+	Roles     RoleSyncer // *access.Service; only used with GroupRoles
+
+	// Provision creates local users on first sign-in.
+	Provision  bool
+	Attributes AttributeMapping
+
+	GroupAttribute string // default "memberOf"
+	GroupRoles     []GroupRole
+
+	Clock func() time.Time
+	Audit Emitter
+}
+
+// Module is the assembled directory bounded context.
+type Module struct {
+	service *service.Service
+	repo    *mariadb.Repository
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.DB == nil {
+		return nil, fmt.Errorf("directory: db is required")
+	}
+	if d.Log == nil {
+		return nil, fmt.Errorf("directory: log is required")
+	}
+	if d.Users == nil {
+		return nil, fmt.Errorf("directory: users repository is required")
+	}
+	if d.Directory == nil {
+		return nil, fmt.Errorf("directory: ldap client is required")
+	}
+	if len(d.GroupRoles) > 0 && d.Roles == nil {
+		return nil, fmt.Errorf("directory: role syncer is required with group roles")
+	}
+	if d.Attributes.Email == "" {
+		d.Attributes.Email = "mail"
+	}
+	if d.GroupAttribute == "" {
+		d.GroupAttribute = "memberOf"
+	}
+	if d.Clock == nil {
+		d.Clock = time.Now
+	}
+	if d.Audit == nil {
+		d.Audit = audit.NopEmitter{}
+	}
+
+	repo := mariadb.NewRepository(d.DB)
+
+	var _ Repository = repo
+
+	tx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return dbutil.WithTx(ctx, d.DB, fn)
+	}
+	svc := service.NewService(d.Log, repo, d.Users, d.Directory, d.Roles, tx,
+		service.Config{
+			Provision:      d.Provision,
+			Attributes:     d.Attributes,
+			GroupAttribute: d.GroupAttribute,
+			GroupRoles:     d.GroupRoles,
+		},
+		d.Clock, d.Audit)
+
+	return &Module{service: svc, repo: repo}, nil
+}
+
+// Authenticator returns the "ldap" password backend for auth's chain.
+func (m *Module) Authenticator() auth.Authenticator { return m.service }
+
+// Repository returns the persistence contract.
+func (m *Module) Repository() Repository { return m.repo }
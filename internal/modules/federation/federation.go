@@ -0,0 +1,66 @@
+// Package federation is the public API of the federation bounded
+// context. External callers interact with the module through:
+//
+//	federation.New(Deps)    wires the module (module.go)
+//	federation.Service      application-layer use-cases (service.go)
+//	federation.Repository   persistence contract
+//
+// The type aliases below let other modules program against
+// federation.Provider / federation.ExternalIdentity etc. instead of
+// importing the internal domain package directly.
+package federation
+
+import (
+	"sso/internal/modules/federation/internal/domain"
+	"sso/internal/modules/federation/internal/httpapi"
+	"sso/internal/modules/federation/internal/service"
+)
+
+type (
+	Provider                      = domain.Provider
+	ProviderID                    = domain.ProviderID
+	ProviderStatus                = domain.ProviderStatus
+	ProviderPatch                 = domain.ProviderPatch
+	ClaimMapping                  = domain.ClaimMapping
+	NewProviderParams             = domain.NewProviderParams
+	RestoreProviderParams         = domain.RestoreProviderParams
+	ExternalIdentity              = domain.ExternalIdentity
+	NewExternalIdentityParams     = domain.NewExternalIdentityParams
+	RestoreExternalIdentityParams = domain.RestoreExternalIdentityParams
+	LoginState                    = domain.LoginState
+	Repository                    = domain.Repository
+
+	// SessionIssuer is satisfied by *auth.Service.
+	SessionIssuer = service.SessionIssuer
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+)
+
+// Status enum re-exports.
+const (
+	ProviderStatusEnabled  = domain.ProviderStatusEnabled
+	ProviderStatusDisabled = domain.ProviderStatusDisabled
+)
+
+var (
+	NewProviderID       = domain.NewProviderID
+	ParseProviderID     = domain.ParseProviderID
+	DefaultClaimMapping = domain.DefaultClaimMapping
+)
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var (
+	ErrProviderNotFound      = domain.ErrProviderNotFound
+	ErrProviderAlreadyExists = domain.ErrProviderAlreadyExists
+	ErrProviderDisabled      = domain.ErrProviderDisabled
+	ErrEtagMismatch          = domain.ErrEtagMismatch
+	ErrIdentityNotLinked     = domain.ErrIdentityNotLinked
+	ErrIdentityAlreadyLinked = domain.ErrIdentityAlreadyLinked
+	ErrLinkNotFound          = domain.ErrLinkNotFound
+	ErrLastSignInMethod      = domain.ErrLastSignInMethod
+	ErrUserActorRequired     = domain.ErrUserActorRequired
+	ErrInvalidState          = domain.ErrInvalidState
+	ErrProviderRejected      = domain.ErrProviderRejected
+)
@@ -0,0 +1,42 @@
+package domain
+
+import "errors"
+
+var (
+	ErrProviderNotFound      = errors.New("federation: provider not found")
+	ErrProviderAlreadyExists = errors.New("federation: provider already exists")
+	ErrProviderDisabled      = errors.New("federation: provider disabled")
+	ErrEtagMismatch          = errors.New("federation: etag mismatch")
+
+	// ErrIdentityNotLinked — the provider authenticated the user but no
+	// local account is linked to that subject and the provider does not
+	// allow (or could not complete) just-in-time provisioning. The user
+	// has to sign in locally and link the provider first.
+	ErrIdentityNotLinked = errors.New("federation: external identity not linked")
+
+	// ErrIdentityAlreadyLinked — the provider subject is already linked
+	// to a (possibly different) local user, or the user already has a
+	// link for this provider.
+	ErrIdentityAlreadyLinked = errors.New("federation: external identity already linked")
+
+	// ErrLinkNotFound — Unlink on a provider the user has no link for.
+	ErrLinkNotFound = errors.New("federation: link not found")
+
+	// ErrLastSignInMethod — Unlink would leave the user with neither a
+	// password nor any other linked provider, i.e. locked out.
+	ErrLastSignInMethod = errors.New("federation: cannot remove the last sign-in method")
+
+	// ErrUserActorRequired — the link endpoints act on the caller's own
+	// account and so need a user, not a service account.
+	ErrUserActorRequired = errors.New("federation: a user actor is required")
+
+	// ErrInvalidState covers an unknown, expired or already-consumed
+	// state value on the callback. Deliberately one error: the caller
+	// learns nothing about which of the three it was.
+	ErrInvalidState = errors.New("federation: invalid or expired state")
+
+	// ErrProviderRejected — the provider returned an error on the
+	// callback, the code exchange failed, or the ID token did not
+	// verify.
+	ErrProviderRejected = errors.New("federation: provider rejected the login")
+)
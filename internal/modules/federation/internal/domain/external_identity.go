@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// ExternalIdentity links one provider subject to one local user.
+//
+// The (ProviderID, Subject) pair is the lookup key on login; Subject is
+// the provider's stable, never-reassigned "sub" claim. Email is the
+// address the provider reported at link time and is informational only —
+// it is never used to resolve the link, since providers let users change
+// it.
+type ExternalIdentity struct {
+	providerID ProviderID
+	subject    string
+	userID     UserID
+	linkedAt   time.Time
+
+	Email       string
+	LastLoginAt time.Time // zero = never used to sign in
+}
+
+type NewExternalIdentityParams struct {
+	ProviderID ProviderID
+	Subject    string
+	UserID     UserID
+	Email      string
+	Now        time.Time
+}
+
+func NewExternalIdentity(p NewExternalIdentityParams) *ExternalIdentity {
+	return &ExternalIdentity{
+		providerID: p.ProviderID,
+		subject:    p.Subject,
+		userID:     p.UserID,
+		linkedAt:   p.Now,
+		Email:      p.Email,
+	}
+}
+
+type RestoreExternalIdentityParams struct {
+	ProviderID  ProviderID
+	Subject     string
+	UserID      UserID
+	Email       string
+	LinkedAt    time.Time
+	LastLoginAt time.Time
+}
+
+func RestoreExternalIdentity(p RestoreExternalIdentityParams) *ExternalIdentity {
+	return &ExternalIdentity{
+		providerID:  p.ProviderID,
+		subject:     p.Subject,
+		userID:      p.UserID,
+		linkedAt:    p.LinkedAt,
+		Email:       p.Email,
+		LastLoginAt: p.LastLoginAt,
+	}
+}
+
+func (e *ExternalIdentity) ProviderID() ProviderID { return e.providerID }
+func (e *ExternalIdentity) Subject() string        { return e.subject }
+func (e *ExternalIdentity) UserID() UserID         { return e.userID }
+func (e *ExternalIdentity) LinkedAt() time.Time    { return e.linkedAt }
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// LoginState is one in-flight authorization request. It is created when
+// the browser is sent to the provider and consumed exactly once when the
+// provider redirects back.
+//
+// Only the SHA-256 of the opaque state value is stored, so a read of the
+// table does not let anyone complete someone else's flow. Nonce and
+// CodeVerifier are kept in plaintext: they are useless without the
+// authorization code, which never touches our storage.
+//
+// LinkUserID is set when an already signed-in user started the flow to
+// attach a provider to their account; the callback then creates a link
+// instead of minting a session. AppID is set for login flows only.
+type LoginState struct {
+	StateHash    []byte
+	ProviderID   ProviderID
+	AppID        string
+	Nonce        string
+	CodeVerifier string
+	LinkUserID   UserID
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+func (s *LoginState) IsExpired(now time.Time) bool { return !now.Before(s.ExpiresAt) }
+
+func (s *LoginState) IsLink() bool { return s.LinkUserID != "" }
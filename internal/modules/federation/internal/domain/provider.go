@@ -0,0 +1,355 @@
+// Package domain holds the aggregates of the federation bounded context:
+// the IdentityProvider registry entry, the ExternalIdentity link between
+// a provider subject and a local user, and the short-lived LoginState
+// that carries an authorization request across the browser redirect.
+//
+// The package knows nothing about OIDC wire formats — the service layer
+// turns provider responses into the plain values stored here.
+package domain
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/validation"
+
+	"github.com/google/uuid"
+)
+
+// ----------------------------------------------------------------------------
+// ProviderID — RFC 4122 UUID, generated as v7 (k-sortable).
+// ----------------------------------------------------------------------------
+
+type ProviderID string
+
+func NewProviderID() (ProviderID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate provider id: %w", err)
+	}
+	return ProviderID(id.String()), nil
+}
+
+func ParseProviderID(s string) (ProviderID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "provider_id", Reason: "must be a valid UUID"}
+	}
+	return ProviderID(s), nil
+}
+
+func (p ProviderID) String() string { return string(p) }
+
+// UserID is a cross-context handle to identity.User; declared here to
+// keep the domain free of an identity import.
+type UserID string
+
+func (u UserID) String() string { return string(u) }
+
+// ----------------------------------------------------------------------------
+// ProviderStatus
+// ----------------------------------------------------------------------------
+
+// ProviderStatus is the on-wire value of identity_providers.status — do
+// not renumber.
+type ProviderStatus uint8
+
+const (
+	ProviderStatusEnabled  ProviderStatus = 1
+	ProviderStatusDisabled ProviderStatus = 2
+)
+
+func (s ProviderStatus) String() string {
+	switch s {
+	case ProviderStatusEnabled:
+		return "ENABLED"
+	case ProviderStatusDisabled:
+		return "DISABLED"
+	}
+	return fmt.Sprintf("ProviderStatus(%d)", s)
+}
+
+// ----------------------------------------------------------------------------
+// ClaimMapping
+// ----------------------------------------------------------------------------
+
+// ClaimMapping names the ID-token claims that feed the local user on
+// just-in-time provisioning. Empty fields fall back to the standard OIDC
+// claim names (DefaultClaimMapping).
+type ClaimMapping struct {
+	Email       string
+	Username    string
+	DisplayName string
+}
+
+// DefaultClaimMapping is the OIDC Core §5.1 standard claim set.
+var DefaultClaimMapping = ClaimMapping{
+	Email:       "email",
+	Username:    "preferred_username",
+	DisplayName: "name",
+}
+
+func (m ClaimMapping) withDefaults() ClaimMapping {
+	if m.Email == "" {
+		m.Email = DefaultClaimMapping.Email
+	}
+	if m.Username == "" {
+		m.Username = DefaultClaimMapping.Username
+	}
+	if m.DisplayName == "" {
+		m.DisplayName = DefaultClaimMapping.DisplayName
+	}
+	return m
+}
+
+// ----------------------------------------------------------------------------
+// Provider aggregate
+// ----------------------------------------------------------------------------
+//
+// Field visibility split mirrors app.App: id, slug, status, etag and the
+// timestamps are unexported (slug is immutable after creation — it is
+// part of every login URL handed out to partners); the connection
+// settings are plain data changed through ApplyPatch so the etag always
+// advances with them.
+
+type Provider struct {
+	id        ProviderID
+	slug      string
+	status    ProviderStatus
+	etag      etag.Etag
+	createdAt time.Time
+	updatedAt time.Time
+
+	DisplayName   string
+	Issuer        string
+	ClientID      string
+	ClientSecret  string
+	Scopes        []string
+	Claims        ClaimMapping
+	AutoProvision bool
+}
+
+// NewProviderParams carries the values supplied by CreateProvider.
+type NewProviderParams struct {
+	ID            ProviderID
+	Slug          string
+	DisplayName   string
+	Issuer        string
+	ClientID      string
+	ClientSecret  string
+	Scopes        []string
+	Claims        ClaimMapping
+	AutoProvision bool
+	Now           time.Time
+}
+
+// NewProvider validates and constructs a fresh, enabled Provider.
+// "openid" is added to Scopes when missing — without it the provider
+// returns no ID token and the flow cannot work.
+func NewProvider(p NewProviderParams) (*Provider, error) {
+	if !slugRe.MatchString(p.Slug) {
+		return nil, &validation.Error{Field: "slug", Reason: "must match " + slugRe.String()}
+	}
+	pr := &Provider{
+		id:            p.ID,
+		slug:          p.Slug,
+		status:        ProviderStatusEnabled,
+		etag:          etag.New(),
+		createdAt:     p.Now,
+		updatedAt:     p.Now,
+		DisplayName:   p.DisplayName,
+		Issuer:        p.Issuer,
+		ClientID:      p.ClientID,
+		ClientSecret:  p.ClientSecret,
+		Scopes:        normalizeScopes(p.Scopes),
+		Claims:        p.Claims.withDefaults(),
+		AutoProvision: p.AutoProvision,
+	}
+	if err := pr.validate(); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// RestoreProviderParams carries the full row read back from storage.
+type RestoreProviderParams struct {
+	ID            ProviderID
+	Slug          string
+	DisplayName   string
+	Issuer        string
+	ClientID      string
+	ClientSecret  string
+	Scopes        []string
+	Claims        ClaimMapping
+	AutoProvision bool
+	Status        ProviderStatus
+	Etag          etag.Etag
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// RestoreProvider rebuilds a Provider from a trusted row.
+func RestoreProvider(p RestoreProviderParams) *Provider {
+	return &Provider{
+		id:            p.ID,
+		slug:          p.Slug,
+		status:        p.Status,
+		etag:          p.Etag,
+		createdAt:     p.CreatedAt,
+		updatedAt:     p.UpdatedAt,
+		DisplayName:   p.DisplayName,
+		Issuer:        p.Issuer,
+		ClientID:      p.ClientID,
+		ClientSecret:  p.ClientSecret,
+		Scopes:        p.Scopes,
+		Claims:        p.Claims,
+		AutoProvision: p.AutoProvision,
+	}
+}
+
+func (p *Provider) ID() ProviderID         { return p.id }
+func (p *Provider) Slug() string           { return p.slug }
+func (p *Provider) Status() ProviderStatus { return p.status }
+func (p *Provider) Etag() etag.Etag        { return p.etag }
+func (p *Provider) CreatedAt() time.Time   { return p.createdAt }
+func (p *Provider) UpdatedAt() time.Time   { return p.updatedAt }
+
+func (p *Provider) IsEnabled() bool { return p.status == ProviderStatusEnabled }
+
+// ProviderPatch — nil pointer = "leave unchanged".
+type ProviderPatch struct {
+	DisplayName   *string
+	Issuer        *string
+	ClientID      *string
+	ClientSecret  *string
+	Scopes        *[]string
+	Claims        *ClaimMapping
+	AutoProvision *bool
+	Enabled       *bool
+}
+
+func (p ProviderPatch) IsEmpty() bool {
+	return p.DisplayName == nil && p.Issuer == nil && p.ClientID == nil &&
+		p.ClientSecret == nil && p.Scopes == nil && p.Claims == nil &&
+		p.AutoProvision == nil && p.Enabled == nil
+}
+
+// ApplyPatch applies the supplied changes and re-validates. The etag
+// advances only when something actually changed.
+func (p *Provider) ApplyPatch(patch ProviderPatch, now time.Time) error {
+	next := *p
+	if patch.DisplayName != nil {
+		next.DisplayName = *patch.DisplayName
+	}
+	if patch.Issuer != nil {
+		next.Issuer = *patch.Issuer
+	}
+	if patch.ClientID != nil {
+		next.ClientID = *patch.ClientID
+	}
+	if patch.ClientSecret != nil {
+		next.ClientSecret = *patch.ClientSecret
+	}
+	if patch.Scopes != nil {
+		next.Scopes = normalizeScopes(*patch.Scopes)
+	}
+	if patch.Claims != nil {
+		next.Claims = patch.Claims.withDefaults()
+	}
+	if patch.AutoProvision != nil {
+		next.AutoProvision = *patch.AutoProvision
+	}
+	if patch.Enabled != nil {
+		next.status = ProviderStatusDisabled
+		if *patch.Enabled {
+			next.status = ProviderStatusEnabled
+		}
+	}
+	if err := next.validate(); err != nil {
+		return err
+	}
+	if next.equal(p) {
+		return nil
+	}
+	*p = next
+	p.updatedAt = now
+	p.etag = etag.New()
+	return nil
+}
+
+func (p *Provider) equal(o *Provider) bool {
+	return p.DisplayName == o.DisplayName &&
+		p.Issuer == o.Issuer &&
+		p.ClientID == o.ClientID &&
+		p.ClientSecret == o.ClientSecret &&
+		slices.Equal(p.Scopes, o.Scopes) &&
+		p.Claims == o.Claims &&
+		p.AutoProvision == o.AutoProvision &&
+		p.status == o.status
+}
+
+// ----------------------------------------------------------------------------
+// validation
+// ----------------------------------------------------------------------------
+
+var slugRe = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}[a-z0-9]$`)
+
+func (p *Provider) validate() error {
+	if strings.TrimSpace(p.DisplayName) == "" {
+		return &validation.Error{Field: "display_name", Reason: "required"}
+	}
+	if err := validateIssuer(p.Issuer); err != nil {
+		return err
+	}
+	if p.ClientID == "" {
+		return &validation.Error{Field: "client_id", Reason: "required"}
+	}
+	if p.ClientSecret == "" {
+		return &validation.Error{Field: "client_secret", Reason: "required"}
+	}
+	return nil
+}
+
+// validateIssuer requires an absolute https URL without query or
+// fragment. Plain http is accepted only for loopback hosts so a local
+// stub provider can be used in development and integration tests.
+func validateIssuer(s string) error {
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return &validation.Error{Field: "issuer", Reason: "must be an absolute URL without query or fragment"}
+	}
+	switch u.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if isLoopback(u.Hostname()) {
+			return nil
+		}
+	}
+	return &validation.Error{Field: "issuer", Reason: "must use https (http is allowed for loopback hosts only)"}
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func normalizeScopes(in []string) []string {
+	out := []string{"openid"}
+	for _, s := range in {
+		s = strings.TrimSpace(s)
+		if s == "" || slices.Contains(out, s) {
+			continue
+		}
+		out = append(out, s)
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"sso/internal/kernel/etag"
+)
+
+// Repository is the persistence contract for the federation context.
+//
+// Error contract:
+//
+//	CreateProvider        → ErrProviderAlreadyExists (slug collision)
+//	GetProvider*          → ErrProviderNotFound
+//	UpdateProvider        → ErrProviderNotFound / ErrEtagMismatch
+//	DeleteProvider        → ErrProviderNotFound / ErrEtagMismatch
+//	CreateIdentity        → ErrIdentityAlreadyLinked (either unique key)
+//	GetIdentity           → ErrIdentityNotLinked
+//	DeleteIdentity        → ErrLinkNotFound
+//	ConsumeLoginState     → ErrInvalidState (unknown or already consumed)
+//
+// expectedEtag "" means unconditional, same as every other module.
+type Repository interface {
+	CreateProvider(ctx context.Context, p *Provider) error
+	GetProviderByID(ctx context.Context, id ProviderID) (*Provider, error)
+	GetProviderBySlug(ctx context.Context, slug string) (*Provider, error)
+	ListProviders(ctx context.Context) ([]*Provider, error)
+	UpdateProvider(ctx context.Context, p *Provider, expectedEtag etag.Etag) error
+	DeleteProvider(ctx context.Context, id ProviderID, expectedEtag etag.Etag) error
+
+	CreateIdentity(ctx context.Context, e *ExternalIdentity) error
+	GetIdentity(ctx context.Context, providerID ProviderID, subject string) (*ExternalIdentity, error)
+	ListIdentitiesByUser(ctx context.Context, userID UserID) ([]*ExternalIdentity, error)
+	TouchIdentityLogin(ctx context.Context, providerID ProviderID, subject string, now time.Time) error
+	DeleteIdentity(ctx context.Context, userID UserID, providerID ProviderID) error
+
+	// CreateLoginState stores a fresh state row. Expired rows are
+	// pruned opportunistically by the same call.
+	CreateLoginState(ctx context.Context, s *LoginState) error
+
+	// ConsumeLoginState atomically reads and deletes the state row, so
+	// a replayed callback finds nothing. Expiry is checked by the
+	// caller against its own clock.
+	ConsumeLoginState(ctx context.Context, stateHash []byte) (*LoginState, error)
+}
@@ -0,0 +1,55 @@
+package httpapi
+
+import (
+	"sso/internal/modules/auth"
+	"sso/internal/modules/federation/internal/domain"
+	"sso/internal/modules/identity"
+	grpcerr "sso/internal/platform/grpc/errors"
+	"sso/internal/platform/oidc"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap translates federation sentinels (and the auth sentinels that
+// LoginFederated can return) into statuses. errors.proto has no
+// federation reasons yet, so those entries are bare statuses
+// (Reason UNSPECIFIED).
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrProviderNotFound: {
+		Code: codes.NotFound, Message: "identity provider not found"},
+	domain.ErrProviderAlreadyExists: {
+		Code: codes.AlreadyExists, Message: "identity provider already exists"},
+	domain.ErrProviderDisabled: {
+		Code: codes.FailedPrecondition, Message: "identity provider is disabled"},
+	domain.ErrEtagMismatch: {
+		Code: codes.Aborted, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ETAG_MISMATCH, Message: "etag mismatch"},
+	domain.ErrIdentityNotLinked: {
+		Code: codes.FailedPrecondition, Message: "external identity is not linked to an account"},
+	domain.ErrIdentityAlreadyLinked: {
+		Code: codes.AlreadyExists, Message: "external identity already linked"},
+	domain.ErrLinkNotFound: {
+		Code: codes.NotFound, Message: "link not found"},
+	domain.ErrLastSignInMethod: {
+		Code: codes.FailedPrecondition, Message: "cannot remove the last sign-in method"},
+	domain.ErrUserActorRequired: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "a user token is required"},
+	domain.ErrInvalidState: {
+		Code: codes.InvalidArgument, Message: "invalid or expired state"},
+	domain.ErrProviderRejected: {
+		Code: codes.Unauthenticated, Message: "identity provider rejected the login"},
+	oidc.ErrDiscovery: {
+		Code: codes.Unavailable, Message: "identity provider unavailable"},
+	identity.ErrUserNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_NOT_FOUND, Message: "user not found"},
+	auth.ErrInvalidCredentials: {
+		Code: codes.Unauthenticated, Reason: ssocommonv1.ErrorReason_ERROR_REASON_INVALID_CREDENTIALS, Message: "invalid credentials"},
+	auth.ErrUserBlocked: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_BLOCKED, Message: "user is blocked"},
+	auth.ErrUserDeleted: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_DELETED, Message: "user is deleted"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
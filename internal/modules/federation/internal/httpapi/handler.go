@@ -0,0 +1,350 @@
+// Package httpapi is the HTTP adapter for the federation context.
+//
+// Federation is browser-driven — the provider redirects back with a
+// plain GET carrying ?code&state — so these endpoints are hand-written
+// net/http handlers mounted next to the grpc-gateway rather than gRPC
+// RPCs. Error bodies use the same google.rpc.Status JSON shape as the
+// gateway, so clients need a single error decoder.
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/modules/federation/internal/domain"
+	fedsvc "sso/internal/modules/federation/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+	"sso/internal/platform/httpserver/sessioncookie"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+// stateCookieName carries the state of the flow the browser started,
+// scoped to the callback. SameSite=Lax: the provider's redirect back is
+// a cross-site top-level GET, on which Strict cookies are withheld.
+const (
+	stateCookieName = "sso_federation_state"
+	stateCookiePath = "/v1/federation/callback"
+)
+
+type Handler struct {
+	svc     *fedsvc.Service
+	api     *apiutil.Adapter
+	cookies *sessioncookie.Jar
+	log     *slog.Logger
+	now     func() time.Time
+}
+
+func NewHandler(svc *fedsvc.Service, authn Authenticator, authz Authorizer, cookies *sessioncookie.Jar, log *slog.Logger) *Handler {
+	return &Handler{
+		svc:     svc,
+		api:     apiutil.New("federation", authn, authz, log, toStatus),
+		cookies: cookies,
+		log:     log,
+		now:     time.Now,
+	}
+}
+
+// Register mounts the federation endpoints:
+//
+//	GET    /v1/federation/authorize/{slug}?app_id=   302 to the provider
+//	GET    /v1/federation/callback                   provider redirect target; 303 to the app
+//	POST   /v1/federation/link/{slug}                start linking (bearer)
+//	GET    /v1/federation/links                      my links (bearer)
+//	DELETE /v1/federation/links/{slug}               unlink (bearer)
+//	GET    /v1/federation/providers                  admin (bearer)
+//	POST   /v1/federation/providers
+//	GET    /v1/federation/providers/{id}
+//	PATCH  /v1/federation/providers/{id}
+//	DELETE /v1/federation/providers/{id}?etag=
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/federation/authorize/{slug}", h.authorize)
+	mux.HandleFunc("GET /v1/federation/callback", h.callback)
+	mux.HandleFunc("POST /v1/federation/link/{slug}", h.api.Authed(h.beginLink))
+	mux.HandleFunc("GET /v1/federation/links", h.api.Authed(h.listLinks))
+	mux.HandleFunc("DELETE /v1/federation/links/{slug}", h.api.Authed(h.unlink))
+	mux.HandleFunc("GET /v1/federation/providers", h.api.Authed(h.listProviders))
+	mux.HandleFunc("POST /v1/federation/providers", h.api.Authed(h.createProvider))
+	mux.HandleFunc("GET /v1/federation/providers/{id}", h.api.Authed(h.getProvider))
+	mux.HandleFunc("PATCH /v1/federation/providers/{id}", h.api.Authed(h.updateProvider))
+	mux.HandleFunc("DELETE /v1/federation/providers/{id}", h.api.Authed(h.deleteProvider))
+}
+
+// ----------------------------------------------------------------------------
+// Login flow
+// ----------------------------------------------------------------------------
+
+func (h *Handler) authorize(w http.ResponseWriter, r *http.Request) {
+	out, err := h.svc.BeginLogin(r.Context(), fedsvc.BeginLoginInput{
+		ProviderSlug: r.PathValue("slug"),
+		AppID:        r.URL.Query().Get("app_id"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	h.bindState(w, out)
+	http.Redirect(w, r, out.AuthorizationURL, http.StatusFound)
+}
+
+func (h *Handler) callback(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	bound := ""
+	if ck, err := r.Cookie(stateCookieName); err == nil {
+		bound = ck.Value
+	}
+	// The state is single-use whatever the outcome.
+	http.SetCookie(w, stateCookie("", -1))
+	out, err := h.svc.CompleteLogin(r.Context(), fedsvc.CompleteLoginInput{
+		State:         q.Get("state"),
+		BoundState:    bound,
+		Code:          q.Get("code"),
+		ProviderError: q.Get("error"),
+		IpAddress:     apiutil.ClientIP(r),
+		UserAgent:     r.UserAgent(),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	if out.Link != nil {
+		apiutil.WriteJSON(w, http.StatusOK, map[string]any{"link": identityView(out.Link)})
+		return
+	}
+	// A login ends as a cookie-mode session: the tokens never reach the
+	// page, which only follows the redirect back to the app.
+	s := out.Session
+	h.cookies.Set(w, sessioncookie.Tokens{
+		AccessToken:      s.AccessToken,
+		AccessExpiresAt:  s.AccessExpiresAt,
+		RefreshToken:     s.RefreshToken,
+		RefreshExpiresAt: s.RefreshExpiresAt,
+	})
+	http.Redirect(w, r, out.RedirectURL, http.StatusSeeOther)
+}
+
+// bindState ties the flow to the browser that started it; callback
+// refuses a state arriving without its cookie.
+func (h *Handler) bindState(w http.ResponseWriter, out fedsvc.BeginLoginOutput) {
+	maxAge := int(out.ExpiresAt.Sub(h.now()) / time.Second)
+	if maxAge <= 0 {
+		maxAge = -1
+	}
+	http.SetCookie(w, stateCookie(out.State, maxAge))
+}
+
+func stateCookie(value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     stateCookieName,
+		Value:    value,
+		Path:     stateCookiePath,
+		MaxAge:   maxAge,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Links (self-service)
+// ----------------------------------------------------------------------------
+
+func (h *Handler) beginLink(w http.ResponseWriter, r *http.Request) {
+	out, err := h.svc.BeginLink(r.Context(), r.PathValue("slug"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	// Not a redirect: the request carries a bearer token, so it comes
+	// from a script, which then navigates the browser itself. The
+	// script must send the request with credentials for the browser to
+	// keep the state cookie.
+	h.bindState(w, out)
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"authorization_url": out.AuthorizationURL,
+		"expires_at":        out.ExpiresAt.UTC().Format(time.RFC3339),
+	})
+}
+
+func (h *Handler) listLinks(w http.ResponseWriter, r *http.Request) {
+	links, err := h.svc.ListLinks(r.Context())
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(links))
+	for _, l := range links {
+		v := identityView(l.Identity)
+		v["provider_slug"] = l.Provider.Slug()
+		v["provider_display_name"] = l.Provider.DisplayName
+		views = append(views, v)
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"links": views})
+}
+
+func (h *Handler) unlink(w http.ResponseWriter, r *http.Request) {
+	if err := h.svc.Unlink(r.Context(), r.PathValue("slug")); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ----------------------------------------------------------------------------
+// Provider registry (admin)
+// ----------------------------------------------------------------------------
+
+// providerBody is the JSON body of create and update. update_mask lists
+// the fields an update applies, same paths as the gRPC admin RPCs.
+type providerBody struct {
+	Slug          string           `json:"slug"`
+	DisplayName   string           `json:"display_name"`
+	Issuer        string           `json:"issuer"`
+	ClientID      string           `json:"client_id"`
+	ClientSecret  string           `json:"client_secret"`
+	Scopes        []string         `json:"scopes"`
+	Claims        claimMappingBody `json:"claims"`
+	AutoProvision bool             `json:"auto_provision"`
+	Enabled       bool             `json:"enabled"`
+	UpdateMask    string           `json:"update_mask"`
+	Etag          string           `json:"etag"`
+}
+
+type claimMappingBody struct {
+	Email       string `json:"email"`
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name"`
+}
+
+func (c claimMappingBody) toDomain() domain.ClaimMapping {
+	return domain.ClaimMapping{Email: c.Email, Username: c.Username, DisplayName: c.DisplayName}
+}
+
+func (h *Handler) listProviders(w http.ResponseWriter, r *http.Request) {
+	ps, err := h.svc.ListProviders(r.Context())
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(ps))
+	for _, p := range ps {
+		views = append(views, providerView(p))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"providers": views})
+}
+
+func (h *Handler) createProvider(w http.ResponseWriter, r *http.Request) {
+	var b providerBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	p, err := h.svc.CreateProvider(r.Context(), fedsvc.CreateProviderInput{
+		Slug:          b.Slug,
+		DisplayName:   b.DisplayName,
+		Issuer:        b.Issuer,
+		ClientID:      b.ClientID,
+		ClientSecret:  b.ClientSecret,
+		Scopes:        b.Scopes,
+		Claims:        b.Claims.toDomain(),
+		AutoProvision: b.AutoProvision,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusCreated, providerView(p))
+}
+
+func (h *Handler) getProvider(w http.ResponseWriter, r *http.Request) {
+	p, err := h.svc.GetProvider(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, providerView(p))
+}
+
+func (h *Handler) updateProvider(w http.ResponseWriter, r *http.Request) {
+	var b providerBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	p, err := h.svc.UpdateProvider(r.Context(), fedsvc.UpdateProviderInput{
+		ProviderID:    r.PathValue("id"),
+		MaskPaths:     apiutil.SplitList(b.UpdateMask),
+		ExpectedEtag:  b.Etag,
+		DisplayName:   b.DisplayName,
+		Issuer:        b.Issuer,
+		ClientID:      b.ClientID,
+		ClientSecret:  b.ClientSecret,
+		Scopes:        b.Scopes,
+		Claims:        b.Claims.toDomain(),
+		AutoProvision: b.AutoProvision,
+		Enabled:       b.Enabled,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, providerView(p))
+}
+
+func (h *Handler) deleteProvider(w http.ResponseWriter, r *http.Request) {
+	err := h.svc.DeleteProvider(r.Context(), fedsvc.DeleteProviderInput{
+		ProviderID:   r.PathValue("id"),
+		ExpectedEtag: r.URL.Query().Get("etag"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ----------------------------------------------------------------------------
+// Views — client_secret is write-only and never rendered.
+// ----------------------------------------------------------------------------
+
+func providerView(p *domain.Provider) map[string]any {
+	return map[string]any{
+		"id":           p.ID().String(),
+		"slug":         p.Slug(),
+		"display_name": p.DisplayName,
+		"issuer":       p.Issuer,
+		"client_id":    p.ClientID,
+		"scopes":       p.Scopes,
+		"claims": claimMappingBody{
+			Email:       p.Claims.Email,
+			Username:    p.Claims.Username,
+			DisplayName: p.Claims.DisplayName,
+		},
+		"auto_provision": p.AutoProvision,
+		"enabled":        p.IsEnabled(),
+		"etag":           p.Etag().String(),
+		"created_at":     p.CreatedAt().UTC().Format(time.RFC3339),
+		"updated_at":     p.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+func identityView(e *domain.ExternalIdentity) map[string]any {
+	v := map[string]any{
+		"provider_id": e.ProviderID().String(),
+		"subject":     e.Subject(),
+		"user_id":     e.UserID().String(),
+		"email":       e.Email,
+		"linked_at":   e.LinkedAt().UTC().Format(time.RFC3339),
+	}
+	if !e.LastLoginAt.IsZero() {
+		v["last_login_at"] = e.LastLoginAt.UTC().Format(time.RFC3339)
+	}
+	return v
+}
@@ -0,0 +1,145 @@
+package mariadb
+
+import (
+	"strings"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/federation/internal/domain"
+	"sso/internal/modules/federation/internal/mariadb/dbgen"
+)
+
+func providerToDomain(r dbgen.IdentityProvider) *domain.Provider {
+	return domain.RestoreProvider(domain.RestoreProviderParams{
+		ID:           domain.ProviderID(r.ID),
+		Slug:         r.Slug,
+		DisplayName:  r.DisplayName,
+		Issuer:       r.Issuer,
+		ClientID:     r.ClientID,
+		ClientSecret: r.ClientSecret,
+		Scopes:       strings.Fields(r.Scopes),
+		Claims: domain.ClaimMapping{
+			Email:       r.ClaimEmail,
+			Username:    r.ClaimUsername,
+			DisplayName: r.ClaimDisplayName,
+		},
+		AutoProvision: r.AutoProvision,
+		Status:        domain.ProviderStatus(r.Status),
+		Etag:          etag.Etag(r.Etag),
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	})
+}
+
+func toCreateProviderParams(p *domain.Provider) dbgen.CreateIdentityProviderParams {
+	return dbgen.CreateIdentityProviderParams{
+		ID:               p.ID().String(),
+		Slug:             p.Slug(),
+		DisplayName:      p.DisplayName,
+		Issuer:           p.Issuer,
+		ClientID:         p.ClientID,
+		ClientSecret:     p.ClientSecret,
+		Scopes:           strings.Join(p.Scopes, " "),
+		ClaimEmail:       p.Claims.Email,
+		ClaimUsername:    p.Claims.Username,
+		ClaimDisplayName: p.Claims.DisplayName,
+		AutoProvision:    p.AutoProvision,
+		Status:           uint8(p.Status()),
+		Etag:             p.Etag().String(),
+		CreatedAt:        p.CreatedAt(),
+		UpdatedAt:        p.UpdatedAt(),
+	}
+}
+
+func toUpdateProviderParams(p *domain.Provider) dbgen.UpdateIdentityProviderParams {
+	return dbgen.UpdateIdentityProviderParams{
+		DisplayName:      p.DisplayName,
+		Issuer:           p.Issuer,
+		ClientID:         p.ClientID,
+		ClientSecret:     p.ClientSecret,
+		Scopes:           strings.Join(p.Scopes, " "),
+		ClaimEmail:       p.Claims.Email,
+		ClaimUsername:    p.Claims.Username,
+		ClaimDisplayName: p.Claims.DisplayName,
+		AutoProvision:    p.AutoProvision,
+		Status:           uint8(p.Status()),
+		Etag:             p.Etag().String(),
+		UpdatedAt:        p.UpdatedAt(),
+		ID:               p.ID().String(),
+	}
+}
+
+// toUpdateProviderWithEtagParams — Etag_2 is sqlc's positional name for
+// the `etag = ?` in the WHERE clause.
+func toUpdateProviderWithEtagParams(p *domain.Provider, expected etag.Etag) dbgen.UpdateIdentityProviderWithEtagParams {
+	u := toUpdateProviderParams(p)
+	return dbgen.UpdateIdentityProviderWithEtagParams{
+		DisplayName:      u.DisplayName,
+		Issuer:           u.Issuer,
+		ClientID:         u.ClientID,
+		ClientSecret:     u.ClientSecret,
+		Scopes:           u.Scopes,
+		ClaimEmail:       u.ClaimEmail,
+		ClaimUsername:    u.ClaimUsername,
+		ClaimDisplayName: u.ClaimDisplayName,
+		AutoProvision:    u.AutoProvision,
+		Status:           u.Status,
+		Etag:             u.Etag,
+		UpdatedAt:        u.UpdatedAt,
+		ID:               u.ID,
+		Etag_2:           expected.String(),
+	}
+}
+
+func identityToDomain(r dbgen.ExternalIdentity) *domain.ExternalIdentity {
+	var lastLogin time.Time
+	if r.LastLoginAt.Valid {
+		lastLogin = r.LastLoginAt.Time
+	}
+	return domain.RestoreExternalIdentity(domain.RestoreExternalIdentityParams{
+		ProviderID:  domain.ProviderID(r.ProviderID),
+		Subject:     r.Subject,
+		UserID:      domain.UserID(r.UserID),
+		Email:       r.Email.String,
+		LinkedAt:    r.LinkedAt,
+		LastLoginAt: lastLogin,
+	})
+}
+
+func toCreateIdentityParams(e *domain.ExternalIdentity) dbgen.CreateExternalIdentityParams {
+	return dbgen.CreateExternalIdentityParams{
+		ProviderID:  e.ProviderID().String(),
+		Subject:     e.Subject(),
+		UserID:      e.UserID().String(),
+		Email:       dbutil.StringToNullString(e.Email),
+		LinkedAt:    e.LinkedAt(),
+		LastLoginAt: dbutil.TimeToNullTime(e.LastLoginAt),
+	}
+}
+
+func loginStateToDomain(r dbgen.FederationLoginState) *domain.LoginState {
+	return &domain.LoginState{
+		StateHash:    r.StateHash,
+		ProviderID:   domain.ProviderID(r.ProviderID),
+		AppID:        r.AppID.String,
+		Nonce:        r.Nonce,
+		CodeVerifier: r.CodeVerifier,
+		LinkUserID:   domain.UserID(r.LinkUserID.String),
+		CreatedAt:    r.CreatedAt,
+		ExpiresAt:    r.ExpiresAt,
+	}
+}
+
+func toCreateLoginStateParams(s *domain.LoginState) dbgen.CreateFederationLoginStateParams {
+	return dbgen.CreateFederationLoginStateParams{
+		StateHash:    s.StateHash,
+		ProviderID:   s.ProviderID.String(),
+		AppID:        dbutil.StringToNullString(s.AppID),
+		Nonce:        s.Nonce,
+		CodeVerifier: s.CodeVerifier,
+		LinkUserID:   dbutil.StringToNullString(s.LinkUserID.String()),
+		CreatedAt:    s.CreatedAt,
+		ExpiresAt:    s.ExpiresAt,
+	}
+}
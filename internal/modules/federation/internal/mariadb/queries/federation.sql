@@ -0,0 +1,91 @@
+-- Identity providers, external-identity links and login states.
+
+-- name: CreateIdentityProvider :exec
+INSERT INTO identity_providers (
+    id, slug, display_name, issuer, client_id, client_secret, scopes,
+    claim_email, claim_username, claim_display_name, auto_provision,
+    status, etag, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetIdentityProviderByID :one
+SELECT * FROM identity_providers
+WHERE id = ?
+LIMIT 1;
+
+-- name: GetIdentityProviderBySlug :one
+SELECT * FROM identity_providers
+WHERE slug = ?
+LIMIT 1;
+
+-- name: ListIdentityProviders :many
+SELECT * FROM identity_providers
+ORDER BY slug;
+
+-- name: UpdateIdentityProvider :execresult
+UPDATE identity_providers
+SET display_name = ?, issuer = ?, client_id = ?, client_secret = ?, scopes = ?,
+    claim_email = ?, claim_username = ?, claim_display_name = ?,
+    auto_provision = ?, status = ?, etag = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: UpdateIdentityProviderWithEtag :execresult
+UPDATE identity_providers
+SET display_name = ?, issuer = ?, client_id = ?, client_secret = ?, scopes = ?,
+    claim_email = ?, claim_username = ?, claim_display_name = ?,
+    auto_provision = ?, status = ?, etag = ?, updated_at = ?
+WHERE id = ? AND etag = ?;
+
+-- name: DeleteIdentityProvider :execresult
+DELETE FROM identity_providers
+WHERE id = ?;
+
+-- name: DeleteIdentityProviderWithEtag :execresult
+DELETE FROM identity_providers
+WHERE id = ? AND etag = ?;
+
+-- name: CountIdentityProviderByID :one
+SELECT COUNT(*) FROM identity_providers
+WHERE id = ?;
+
+-- name: CreateExternalIdentity :exec
+INSERT INTO external_identities (
+    provider_id, subject, user_id, email, linked_at, last_login_at
+) VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetExternalIdentity :one
+SELECT * FROM external_identities
+WHERE provider_id = ? AND subject = ?
+LIMIT 1;
+
+-- name: ListExternalIdentitiesByUser :many
+SELECT * FROM external_identities
+WHERE user_id = ?
+ORDER BY linked_at;
+
+-- name: TouchExternalIdentityLogin :exec
+UPDATE external_identities
+SET last_login_at = ?
+WHERE provider_id = ? AND subject = ?;
+
+-- name: DeleteExternalIdentity :execresult
+DELETE FROM external_identities
+WHERE user_id = ? AND provider_id = ?;
+
+-- name: CreateFederationLoginState :exec
+INSERT INTO federation_login_states (
+    state_hash, provider_id, app_id, nonce, code_verifier, link_user_id,
+    created_at, expires_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetFederationLoginStateForUpdate :one
+SELECT * FROM federation_login_states
+WHERE state_hash = ?
+FOR UPDATE;
+
+-- name: DeleteFederationLoginState :exec
+DELETE FROM federation_login_states
+WHERE state_hash = ?;
+
+-- name: DeleteExpiredFederationLoginStates :exec
+DELETE FROM federation_login_states
+WHERE expires_at < ?;
@@ -0,0 +1,251 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/federation/internal/domain"
+	"sso/internal/modules/federation/internal/mariadb/dbgen"
+)
+
+type Repository struct {
+	db *sql.DB
+	q  *dbgen.Queries
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db, q: dbgen.New(db)}
+}
+
+var _ domain.Repository = (*Repository)(nil)
+
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one; provisioning creates the link in
+// the same transaction that creates the user.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
+// ----------------------------------------------------------------------------
+// Providers
+// ----------------------------------------------------------------------------
+
+func (r *Repository) CreateProvider(ctx context.Context, p *domain.Provider) error {
+	if err := r.q.CreateIdentityProvider(ctx, toCreateProviderParams(p)); err != nil {
+		if dbutil.IsDuplicateEntry(err) {
+			return domain.ErrProviderAlreadyExists
+		}
+		return fmt.Errorf("federation repo: create_provider: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetProviderByID(ctx context.Context, id domain.ProviderID) (*domain.Provider, error) {
+	row, err := r.q.GetIdentityProviderByID(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrProviderNotFound
+		}
+		return nil, fmt.Errorf("federation repo: get_provider: %w", err)
+	}
+	return providerToDomain(row), nil
+}
+
+func (r *Repository) GetProviderBySlug(ctx context.Context, slug string) (*domain.Provider, error) {
+	row, err := r.q.GetIdentityProviderBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrProviderNotFound
+		}
+		return nil, fmt.Errorf("federation repo: get_provider_by_slug: %w", err)
+	}
+	return providerToDomain(row), nil
+}
+
+func (r *Repository) ListProviders(ctx context.Context) ([]*domain.Provider, error) {
+	rows, err := r.q.ListIdentityProviders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("federation repo: list_providers: %w", err)
+	}
+	out := make([]*domain.Provider, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, providerToDomain(row))
+	}
+	return out, nil
+}
+
+func (r *Repository) UpdateProvider(ctx context.Context, p *domain.Provider, expectedEtag etag.Etag) error {
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = r.q.UpdateIdentityProvider(ctx, toUpdateProviderParams(p))
+	} else {
+		res, err = r.q.UpdateIdentityProviderWithEtag(ctx, toUpdateProviderWithEtagParams(p, expectedEtag))
+	}
+	if err != nil {
+		return fmt.Errorf("federation repo: update_provider: %w", err)
+	}
+	return r.discriminate(ctx, res, p.ID(), expectedEtag)
+}
+
+func (r *Repository) DeleteProvider(ctx context.Context, id domain.ProviderID, expectedEtag etag.Etag) error {
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = r.q.DeleteIdentityProvider(ctx, id.String())
+	} else {
+		res, err = r.q.DeleteIdentityProviderWithEtag(ctx, dbgen.DeleteIdentityProviderWithEtagParams{
+			ID:   id.String(),
+			Etag: expectedEtag.String(),
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("federation repo: delete_provider: %w", err)
+	}
+	return r.discriminate(ctx, res, id, expectedEtag)
+}
+
+func (r *Repository) discriminate(ctx context.Context, res sql.Result, id domain.ProviderID, expectedEtag etag.Etag) error {
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("federation repo: rows_affected: %w", err)
+	}
+	if rows == 1 {
+		return nil
+	}
+	return dbutil.Discriminate(ctx, expectedEtag,
+		func(ctx context.Context) (int64, error) {
+			return r.q.CountIdentityProviderByID(ctx, id.String())
+		},
+		domain.ErrProviderNotFound, domain.ErrEtagMismatch)
+}
+
+// ----------------------------------------------------------------------------
+// External identities
+// ----------------------------------------------------------------------------
+
+// CreateIdentity inserts a link. Both unique keys — (provider, subject)
+// and (user, provider) — surface as ErrIdentityAlreadyLinked: either the
+// subject belongs to someone already, or this user linked the provider
+// before.
+func (r *Repository) CreateIdentity(ctx context.Context, e *domain.ExternalIdentity) error {
+	if err := r.queries(ctx).CreateExternalIdentity(ctx, toCreateIdentityParams(e)); err != nil {
+		if dbutil.IsDuplicateEntry(err) {
+			return domain.ErrIdentityAlreadyLinked
+		}
+		return fmt.Errorf("federation repo: create_identity: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetIdentity(ctx context.Context, providerID domain.ProviderID, subject string) (*domain.ExternalIdentity, error) {
+	row, err := r.queries(ctx).GetExternalIdentity(ctx, dbgen.GetExternalIdentityParams{
+		ProviderID: providerID.String(),
+		Subject:    subject,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrIdentityNotLinked
+		}
+		return nil, fmt.Errorf("federation repo: get_identity: %w", err)
+	}
+	return identityToDomain(row), nil
+}
+
+func (r *Repository) ListIdentitiesByUser(ctx context.Context, userID domain.UserID) ([]*domain.ExternalIdentity, error) {
+	rows, err := r.queries(ctx).ListExternalIdentitiesByUser(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("federation repo: list_identities: %w", err)
+	}
+	out := make([]*domain.ExternalIdentity, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, identityToDomain(row))
+	}
+	return out, nil
+}
+
+func (r *Repository) TouchIdentityLogin(ctx context.Context, providerID domain.ProviderID, subject string, now time.Time) error {
+	err := r.queries(ctx).TouchExternalIdentityLogin(ctx, dbgen.TouchExternalIdentityLoginParams{
+		LastLoginAt: dbutil.TimeToNullTime(now),
+		ProviderID:  providerID.String(),
+		Subject:     subject,
+	})
+	if err != nil {
+		return fmt.Errorf("federation repo: touch_identity: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) DeleteIdentity(ctx context.Context, userID domain.UserID, providerID domain.ProviderID) error {
+	res, err := r.queries(ctx).DeleteExternalIdentity(ctx, dbgen.DeleteExternalIdentityParams{
+		UserID:     userID.String(),
+		ProviderID: providerID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("federation repo: delete_identity: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("federation repo: delete_identity: rows_affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrLinkNotFound
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Login states
+// ----------------------------------------------------------------------------
+
+// CreateLoginState prunes expired rows before inserting. The table only
+// ever holds flows started within the last state TTL, so the prune is a
+// cheap range delete on idx_federation_login_states_expires and saves a
+// dedicated sweeper.
+func (r *Repository) CreateLoginState(ctx context.Context, s *domain.LoginState) error {
+	if err := r.q.DeleteExpiredFederationLoginStates(ctx, s.CreatedAt); err != nil {
+		return fmt.Errorf("federation repo: prune_states: %w", err)
+	}
+	if err := r.q.CreateFederationLoginState(ctx, toCreateLoginStateParams(s)); err != nil {
+		return fmt.Errorf("federation repo: create_state: %w", err)
+	}
+	return nil
+}
+
+// ConsumeLoginState reads the row under FOR UPDATE and deletes it in
+// the same transaction, so two concurrent callbacks with the same state
+// cannot both succeed.
+func (r *Repository) ConsumeLoginState(ctx context.Context, stateHash []byte) (*domain.LoginState, error) {
+	var out *domain.LoginState
+	err := dbutil.InTx(ctx, r.db, func(tx *sql.Tx) error {
+		q := r.q.WithTx(tx)
+		row, err := q.GetFederationLoginStateForUpdate(ctx, stateHash)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrInvalidState
+			}
+			return fmt.Errorf("federation repo: get_state: %w", err)
+		}
+		if err := q.DeleteFederationLoginState(ctx, stateHash); err != nil {
+			return fmt.Errorf("federation repo: delete_state: %w", err)
+		}
+		out = loginStateToDomain(row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/federation/internal/domain"
+	"sso/internal/modules/identity"
+)
+
+// ----------------------------------------------------------------------------
+// BeginLink
+// ----------------------------------------------------------------------------
+
+// BeginLink starts a flow that attaches the provider to the signed-in
+// user instead of minting a session. The callback is the same as for
+// login; the stored state tells the two apart.
+func (s *Service) BeginLink(ctx context.Context, providerSlug string) (BeginLoginOutput, error) {
+	a, err := requireUser(ctx)
+	if err != nil {
+		return BeginLoginOutput{}, err
+	}
+	return s.begin(ctx, providerSlug, "", domain.UserID(a.ID))
+}
+
+// completeLink is the link branch of CompleteLogin. aud was built by
+// linkAudit.
+func (s *Service) completeLink(ctx context.Context, aud audit.NewAuditParams, p *domain.Provider, userID domain.UserID, subject, email string) (CompleteLoginOutput, error) {
+	link := domain.NewExternalIdentity(domain.NewExternalIdentityParams{
+		ProviderID: p.ID(),
+		Subject:    subject,
+		UserID:     userID,
+		Email:      email,
+		Now:        s.now().UTC(),
+	})
+	if err := s.repo.CreateIdentity(ctx, link); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return CompleteLoginOutput{}, fmt.Errorf("link identity: %w", err)
+	}
+	s.auditor.Success(ctx, aud)
+	return CompleteLoginOutput{Link: link}, nil
+}
+
+// linkAudit builds the audit base for the link branch of the callback.
+// The browser redirect carries no bearer token, so the actor is the
+// user recorded in the state when BeginLink ran.
+func (s *Service) linkAudit(userID domain.UserID, in CompleteLoginInput) audit.NewAuditParams {
+	return audit.NewAuditParams{
+		EventType:   audit.EventTypeFederationLinkIdentity,
+		ActorType:   audit.ActorTypeUser,
+		ActorID:     userID.String(),
+		SubjectType: audit.SubjectTypeUser,
+		SubjectID:   userID.String(),
+		IpAddress:   in.IpAddress,
+		UserAgent:   in.UserAgent,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// ListLinks
+// ----------------------------------------------------------------------------
+
+// Link pairs a linked identity with its provider for display.
+type Link struct {
+	Identity *domain.ExternalIdentity
+	Provider *domain.Provider
+}
+
+// ListLinks returns the calling user's linked providers. Links to a
+// provider that was deleted concurrently are skipped.
+func (s *Service) ListLinks(ctx context.Context) ([]Link, error) {
+	a, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := s.repo.ListIdentitiesByUser(ctx, domain.UserID(a.ID))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Link, 0, len(ids))
+	for _, e := range ids {
+		p, err := s.repo.GetProviderByID(ctx, e.ProviderID())
+		if errors.Is(err, domain.ErrProviderNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, Link{Identity: e, Provider: p})
+	}
+	return out, nil
+}
+
+// ----------------------------------------------------------------------------
+// Unlink
+// ----------------------------------------------------------------------------
+
+// Unlink removes the calling user's link to the provider. It refuses
+// with ErrLastSignInMethod when the user has no password and this is
+// their only link — they would be locked out of the account.
+func (s *Service) Unlink(ctx context.Context, providerSlug string) error {
+	a, err := requireUser(ctx)
+	if err != nil {
+		return err
+	}
+	userID := domain.UserID(a.ID)
+
+	aud := audit.BaseFromActor(a, audit.EventTypeFederationUnlinkIdentity)
+	aud.SubjectType = audit.SubjectTypeUser
+	aud.SubjectID = a.ID
+
+	fail := func(err error) error {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return err
+	}
+
+	p, err := s.repo.GetProviderBySlug(ctx, providerSlug)
+	if err != nil {
+		return fail(err)
+	}
+	aud.Metadata = map[string]string{"provider_id": p.ID().String()}
+
+	user, err := s.users.GetByID(ctx, identity.UserID(a.ID))
+	if err != nil {
+		return fail(err)
+	}
+	if !user.HasPassword() {
+		links, err := s.repo.ListIdentitiesByUser(ctx, userID)
+		if err != nil {
+			return fail(err)
+		}
+		if len(links) <= 1 {
+			return fail(domain.ErrLastSignInMethod)
+		}
+	}
+
+	if err := s.repo.DeleteIdentity(ctx, userID, p.ID()); err != nil {
+		return fail(err)
+	}
+	s.auditor.Success(ctx, aud)
+	return nil
+}
+
+func requireUser(ctx context.Context) (actor.Actor, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return actor.Actor{}, err
+	}
+	if !a.IsUser() {
+		return actor.Actor{}, domain.ErrUserActorRequired
+	}
+	return a, nil
+}
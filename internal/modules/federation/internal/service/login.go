@@ -0,0 +1,380 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"sso/internal/kernel/tenant"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/auth"
+	"sso/internal/modules/federation/internal/domain"
+	"sso/internal/modules/identity"
+	"sso/internal/platform/oidc"
+)
+
+// ----------------------------------------------------------------------------
+// BeginLogin
+// ----------------------------------------------------------------------------
+
+type BeginLoginInput struct {
+	ProviderSlug string
+	AppID        string
+}
+
+// BeginLoginOutput — the transport redirects the browser to
+// AuthorizationURL and binds State to that browser (an HttpOnly
+// cookie), to be handed back as CompleteLoginInput.BoundState. State
+// also travels in AuthorizationURL; nonce and the PKCE verifier stay
+// server-side.
+type BeginLoginOutput struct {
+	AuthorizationURL string
+	State            string
+	ExpiresAt        time.Time
+}
+
+// BeginLogin starts an authorization-code flow against the provider
+// and records the state that CompleteLogin will consume. The app is
+// only syntax-checked here; its status is enforced when the session is
+// minted, so an app put into maintenance mid-flow still blocks the
+// login.
+func (s *Service) BeginLogin(ctx context.Context, in BeginLoginInput) (BeginLoginOutput, error) {
+	if in.AppID == "" {
+		return BeginLoginOutput{}, &validation.Error{Field: "app_id", Reason: "required"}
+	}
+	appID, err := app.ParseAppID(in.AppID)
+	if err != nil {
+		return BeginLoginOutput{}, err
+	}
+	return s.begin(ctx, in.ProviderSlug, appID.String(), "")
+}
+
+// begin is shared by BeginLogin and BeginLink: exactly one of appID and
+// linkUserID is set.
+func (s *Service) begin(ctx context.Context, slug, appID string, linkUserID domain.UserID) (BeginLoginOutput, error) {
+	p, err := s.repo.GetProviderBySlug(ctx, slug)
+	if err != nil {
+		return BeginLoginOutput{}, err
+	}
+	if !p.IsEnabled() {
+		return BeginLoginOutput{}, domain.ErrProviderDisabled
+	}
+
+	meta, err := s.oidc.Discover(ctx, p.Issuer)
+	if err != nil {
+		return BeginLoginOutput{}, fmt.Errorf("begin federated login: %w", err)
+	}
+
+	state, err := oidc.RandomString()
+	if err != nil {
+		return BeginLoginOutput{}, err
+	}
+	nonce, err := oidc.RandomString()
+	if err != nil {
+		return BeginLoginOutput{}, err
+	}
+	verifier, challenge, err := oidc.NewPKCE()
+	if err != nil {
+		return BeginLoginOutput{}, err
+	}
+
+	now := s.now().UTC()
+	ls := &domain.LoginState{
+		StateHash:    hashState(state),
+		ProviderID:   p.ID(),
+		AppID:        appID,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		LinkUserID:   linkUserID,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(s.cfg.StateTTL),
+	}
+	if err := s.repo.CreateLoginState(ctx, ls); err != nil {
+		return BeginLoginOutput{}, fmt.Errorf("begin federated login: %w", err)
+	}
+
+	return BeginLoginOutput{
+		AuthorizationURL: oidc.AuthCodeURL(meta, oidc.AuthRequest{
+			ClientID:      p.ClientID,
+			RedirectURI:   s.cfg.CallbackURL,
+			Scopes:        p.Scopes,
+			State:         state,
+			Nonce:         nonce,
+			CodeChallenge: challenge,
+		}),
+		State:     state,
+		ExpiresAt: ls.ExpiresAt,
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// CompleteLogin
+// ----------------------------------------------------------------------------
+
+// CompleteLoginInput is the provider's redirect back to CallbackURL.
+// ProviderError carries the "error" query parameter when the provider
+// refused the request (user cancelled, consent denied, ...). BoundState
+// is the state the transport bound to the browser when the flow began;
+// it must equal State, so a callback URL planted in someone else's
+// browser (login CSRF, or linking the attacker's provider account to
+// the victim) is refused.
+type CompleteLoginInput struct {
+	State         string
+	BoundState    string
+	Code          string
+	ProviderError string
+	IpAddress     string
+	UserAgent     string
+}
+
+// CompleteLoginOutput — exactly one of Session (login flow) and Link
+// (link flow) is set. RedirectURL, set with Session, is where the
+// browser goes once the session cookies are in place: the app's link,
+// or "/" when it has none usable.
+type CompleteLoginOutput struct {
+	Session     *auth.LoginOutput
+	RedirectURL string
+	Link        *domain.ExternalIdentity
+}
+
+// CompleteLogin finishes a flow started by BeginLogin or BeginLink.
+//
+// Login resolution, in order:
+//  1. (provider, sub) is linked → sign in as the linked user.
+//  2. Not linked, provider has AutoProvision → create a password-less
+//     local user from the mapped claims, link it, sign in. Refused when
+//     the email or username is already taken: the existing account's
+//     owner has to link the provider themselves, otherwise anyone able
+//     to register that address at the IdP could take the account over.
+//  3. Otherwise → ErrIdentityNotLinked.
+func (s *Service) CompleteLogin(ctx context.Context, in CompleteLoginInput) (CompleteLoginOutput, error) {
+	aud := audit.NewAuditParams{
+		EventType: audit.EventTypeAuthFederatedLogin,
+		ActorType: audit.ActorTypeAnonymous,
+		IpAddress: in.IpAddress,
+		UserAgent: in.UserAgent,
+	}
+
+	if in.State == "" {
+		return CompleteLoginOutput{}, &validation.Error{Field: "state", Reason: "required"}
+	}
+	if subtle.ConstantTimeCompare([]byte(in.State), []byte(in.BoundState)) != 1 {
+		s.auditor.Fail(ctx, aud, audit.ReasonFederationStateInvalid)
+		return CompleteLoginOutput{}, domain.ErrInvalidState
+	}
+	ls, err := s.repo.ConsumeLoginState(ctx, hashState(in.State))
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return CompleteLoginOutput{}, err
+	}
+	if ls.IsLink() {
+		aud = s.linkAudit(ls.LinkUserID, in)
+	}
+	aud.AppID = ls.AppID
+	aud.Metadata = map[string]string{"provider_id": ls.ProviderID.String()}
+
+	if ls.IsExpired(s.now()) {
+		s.auditor.Fail(ctx, aud, audit.ReasonFederationStateInvalid)
+		return CompleteLoginOutput{}, domain.ErrInvalidState
+	}
+
+	p, claims, err := s.authenticate(ctx, ls, in)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return CompleteLoginOutput{}, err
+	}
+	subject, _ := claims["sub"].(string)
+	email := stringClaim(claims, p.Claims.Email)
+
+	if ls.IsLink() {
+		return s.completeLink(ctx, aud, p, ls.LinkUserID, subject, email)
+	}
+
+	ctx, target := s.inAppTenant(ctx, ls.AppID)
+	link, err := s.repo.GetIdentity(ctx, p.ID(), subject)
+	switch {
+	case err == nil:
+	case errors.Is(err, domain.ErrIdentityNotLinked) && p.AutoProvision:
+		link, err = s.provision(ctx, aud, p, subject, claims)
+		if err != nil {
+			return CompleteLoginOutput{}, err
+		}
+	default:
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return CompleteLoginOutput{}, err
+	}
+
+	// auth audits the outcome from here on (EventTypeAuthFederatedLogin
+	// with the resolved user as subject).
+	sess, err := s.sessions.LoginFederated(ctx, auth.LoginFederatedInput{
+		AppID:      ls.AppID,
+		UserID:     link.UserID().String(),
+		ProviderID: p.ID().String(),
+		UserAgent:  in.UserAgent,
+		IpAddress:  in.IpAddress,
+	})
+	if err != nil {
+		return CompleteLoginOutput{}, err
+	}
+	if err := s.repo.TouchIdentityLogin(ctx, p.ID(), subject, s.now().UTC()); err != nil {
+		s.log.WarnContext(ctx, "federation: touch identity login", "err", err)
+	}
+	return CompleteLoginOutput{Session: &sess, RedirectURL: redirectURL(target)}, nil
+}
+
+// authenticate turns the callback into a verified claim set: provider
+// error check, provider lookup, code exchange and ID-token verification.
+// Every provider-side failure collapses into ErrProviderRejected; the
+// detail goes to the log, not to the browser.
+func (s *Service) authenticate(ctx context.Context, ls *domain.LoginState, in CompleteLoginInput) (*domain.Provider, map[string]any, error) {
+	p, err := s.repo.GetProviderByID(ctx, ls.ProviderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !p.IsEnabled() {
+		return nil, nil, domain.ErrProviderDisabled
+	}
+	if in.ProviderError != "" {
+		s.log.InfoContext(ctx, "federation: provider returned error", "provider", p.Slug(), "error", in.ProviderError)
+		return nil, nil, domain.ErrProviderRejected
+	}
+	if in.Code == "" {
+		return nil, nil, &validation.Error{Field: "code", Reason: "required"}
+	}
+
+	meta, err := s.oidc.Discover(ctx, p.Issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("complete federated login: %w", err)
+	}
+	raw, err := s.oidc.Exchange(ctx, meta, oidc.ExchangeRequest{
+		ClientID:     p.ClientID,
+		ClientSecret: p.ClientSecret,
+		Code:         in.Code,
+		RedirectURI:  s.cfg.CallbackURL,
+		CodeVerifier: ls.CodeVerifier,
+	})
+	if err != nil {
+		s.log.WarnContext(ctx, "federation: code exchange", "provider", p.Slug(), "err", err)
+		return nil, nil, domain.ErrProviderRejected
+	}
+	claims, err := s.oidc.VerifyIDToken(ctx, raw, oidc.VerifyRequest{
+		Issuer:   p.Issuer,
+		ClientID: p.ClientID,
+		Nonce:    ls.Nonce,
+	})
+	if err != nil {
+		s.log.WarnContext(ctx, "federation: verify id token", "provider", p.Slug(), "err", err)
+		return nil, nil, domain.ErrProviderRejected
+	}
+	return p, claims, nil
+}
+
+// inAppTenant returns ctx working in the tenant of the app being signed
+// in to, so a provisioned user lands there and a linked user of another
+// tenant is refused by auth, along with the app. A failed lookup leaves
+// ctx as it is and returns a nil app; auth rejects the app on its own.
+func (s *Service) inAppTenant(ctx context.Context, appID string) (context.Context, *app.App) {
+	a, err := s.apps.GetByID(ctx, app.AppID(appID))
+	if err != nil {
+		return ctx, nil
+	}
+	return tenant.With(ctx, a.TenantID()), a
+}
+
+// redirectURL is the app's link when it is an absolute http(s) URL, so
+// a link like "javascript:…" never becomes a Location; "/" otherwise.
+func redirectURL(a *app.App) string {
+	if a == nil {
+		return "/"
+	}
+	u, err := url.Parse(a.Link)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return "/"
+	}
+	return u.String()
+}
+
+// provision creates the local user and the link for a first-time
+// federated sign-in, in one transaction: a link that cannot be stored
+// (the subject was linked concurrently) leaves no orphaned account
+// holding the address. The user has no password: it can sign in only
+// through a linked provider until one is set via a reset flow.
+func (s *Service) provision(ctx context.Context, aud audit.NewAuditParams, p *domain.Provider, subject string, claims map[string]any) (*domain.ExternalIdentity, error) {
+	email := stringClaim(claims, p.Claims.Email)
+	if email == "" || claims["email_verified"] != true {
+		// An unverified address must not become the local account's
+		// identity key. A provider that omits email_verified vouches
+		// for nothing, so only an explicit true counts.
+		s.auditor.Deny(ctx, aud, audit.ReasonExternalIdentityNotLinked)
+		return nil, domain.ErrIdentityNotLinked
+	}
+	username := stringClaim(claims, p.Claims.Username)
+	if username == "" {
+		username, _, _ = strings.Cut(email, "@")
+	}
+
+	id, err := identity.NewUserID()
+	if err != nil {
+		return nil, fmt.Errorf("provision federated user: %w", err)
+	}
+	now := s.now().UTC()
+	user := identity.NewUser(identity.NewUserParams{
+		ID:          id,
+		TenantID:    tenant.Home(ctx),
+		Email:       email,
+		Username:    username,
+		DisplayName: stringClaim(claims, p.Claims.DisplayName),
+		Now:         now,
+	})
+	link := domain.NewExternalIdentity(domain.NewExternalIdentityParams{
+		ProviderID: p.ID(),
+		Subject:    subject,
+		UserID:     domain.UserID(user.ID()),
+		Email:      email,
+		Now:        now,
+	})
+	err = s.tx(ctx, func(ctx context.Context) error {
+		if err := s.users.Create(ctx, user); err != nil {
+			return err
+		}
+		return s.repo.CreateIdentity(ctx, link)
+	})
+	if err != nil {
+		if errors.Is(err, identity.ErrUserAlreadyExists) {
+			s.auditor.Deny(ctx, aud, audit.ReasonUserAlreadyExists)
+			return nil, domain.ErrIdentityNotLinked
+		}
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("provision federated user: %w", err)
+	}
+
+	reg := audit.NewAuditParams{
+		EventType:   audit.EventTypeAuthRegister,
+		ActorType:   audit.ActorTypeAnonymous,
+		SubjectType: audit.SubjectTypeUser,
+		SubjectID:   user.ID().String(),
+		AppID:       aud.AppID,
+		IpAddress:   aud.IpAddress,
+		UserAgent:   aud.UserAgent,
+		Metadata:    map[string]string{"provider_id": p.ID().String()},
+	}
+	s.auditor.Success(ctx, reg)
+	return link, nil
+}
+
+// stringClaim reads a string claim, treating absent and non-string
+// values as empty.
+func stringClaim(claims map[string]any, name string) string {
+	v, _ := claims[name].(string)
+	return strings.TrimSpace(v)
+}
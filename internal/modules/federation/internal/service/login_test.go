@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/federation/internal/domain"
+)
+
+func TestCompleteLoginRequiresBoundState(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	// A mismatch is refused before the state is looked up, so the
+	// service needs no repository here.
+	s := NewService(log, nil, nil, nil, nil, nil, nil, Config{}, time.Now, audit.NopEmitter{})
+
+	for _, bound := range []string{"", "other-state"} {
+		_, err := s.CompleteLogin(context.Background(), CompleteLoginInput{
+			State:      "state",
+			BoundState: bound,
+			Code:       "code",
+		})
+		if !errors.Is(err, domain.ErrInvalidState) {
+			t.Fatalf("bound %q: err = %v, want ErrInvalidState", bound, err)
+		}
+	}
+}
+
+func TestRedirectURL(t *testing.T) {
+	cases := []struct {
+		link string
+		want string
+	}{
+		{"https://app.example.com/home", "https://app.example.com/home"},
+		{"http://localhost:3000", "http://localhost:3000"},
+		{"", "/"},
+		{"/relative", "/"},
+		{"javascript:alert(1)", "/"},
+		{"//evil.example.com", "/"},
+	}
+	for _, tc := range cases {
+		a := &app.App{Link: tc.link}
+		if got := redirectURL(a); got != tc.want {
+			t.Errorf("redirectURL(%q) = %q, want %q", tc.link, got, tc.want)
+		}
+	}
+	if got := redirectURL(nil); got != "/" {
+		t.Errorf("redirectURL(nil) = %q, want /", got)
+	}
+}
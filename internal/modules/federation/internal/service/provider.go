@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/federation/internal/domain"
+)
+
+// ----------------------------------------------------------------------------
+// CreateProvider
+// ----------------------------------------------------------------------------
+
+type CreateProviderInput struct {
+	Slug          string
+	DisplayName   string
+	Issuer        string
+	ClientID      string
+	ClientSecret  string
+	Scopes        []string
+	Claims        domain.ClaimMapping
+	AutoProvision bool
+}
+
+// CreateProvider registers a provider. The issuer is not contacted here:
+// discovery happens lazily on the first login, so a provider can be
+// registered before its endpoint is reachable from this network.
+func (s *Service) CreateProvider(ctx context.Context, in CreateProviderInput) (*domain.Provider, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create provider: %w", err)
+	}
+	id, err := domain.NewProviderID()
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := domain.NewProvider(domain.NewProviderParams{
+		ID:            id,
+		Slug:          in.Slug,
+		DisplayName:   in.DisplayName,
+		Issuer:        in.Issuer,
+		ClientID:      in.ClientID,
+		ClientSecret:  in.ClientSecret,
+		Scopes:        in.Scopes,
+		Claims:        in.Claims,
+		AutoProvision: in.AutoProvision,
+		Now:           s.now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeFederationCreateProvider)
+	aud.SubjectType = audit.SubjectTypeIdentityProvider
+	aud.SubjectID = p.ID().String()
+
+	if err := s.repo.CreateProvider(ctx, p); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("create provider: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return p, nil
+}
+
+// ----------------------------------------------------------------------------
+// GetProvider / ListProviders
+// ----------------------------------------------------------------------------
+
+func (s *Service) GetProvider(ctx context.Context, rawID string) (*domain.Provider, error) {
+	id, err := domain.ParseProviderID(rawID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetProviderByID(ctx, id)
+}
+
+// ListProviders returns every registered provider ordered by slug. The
+// registry is small (one row per upstream IdP), so it is not paginated.
+func (s *Service) ListProviders(ctx context.Context) ([]*domain.Provider, error) {
+	return s.repo.ListProviders(ctx)
+}
+
+// ----------------------------------------------------------------------------
+// UpdateProvider
+// ----------------------------------------------------------------------------
+
+type UpdateProviderInput struct {
+	ProviderID   string
+	MaskPaths    []string
+	ExpectedEtag string
+
+	DisplayName   string
+	Issuer        string
+	ClientID      string
+	ClientSecret  string
+	Scopes        []string
+	Claims        domain.ClaimMapping
+	AutoProvision bool
+	Enabled       bool
+}
+
+func (s *Service) UpdateProvider(ctx context.Context, in UpdateProviderInput) (*domain.Provider, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseProviderID(in.ProviderID)
+	if err != nil {
+		return nil, err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, true /*required*/)
+	if err != nil {
+		return nil, err
+	}
+	if len(in.MaskPaths) == 0 {
+		return nil, &validation.Error{Field: "update_mask", Reason: "must list at least one field"}
+	}
+	patch, err := buildProviderPatch(in)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeFederationUpdateProvider)
+	aud.SubjectType = audit.SubjectTypeIdentityProvider
+	aud.SubjectID = id.String()
+
+	p, err := s.repo.GetProviderByID(ctx, id)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	if err := p.ApplyPatch(patch, s.now().UTC()); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	if err := s.repo.UpdateProvider(ctx, p, expectedEtag); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+
+	s.auditor.Success(ctx, aud)
+	return p, nil
+}
+
+func buildProviderPatch(in UpdateProviderInput) (domain.ProviderPatch, error) {
+	var p domain.ProviderPatch
+	claims := in.Claims
+	for _, path := range in.MaskPaths {
+		switch path {
+		case "display_name":
+			v := in.DisplayName
+			p.DisplayName = &v
+		case "issuer":
+			v := in.Issuer
+			p.Issuer = &v
+		case "client_id":
+			v := in.ClientID
+			p.ClientID = &v
+		case "client_secret":
+			v := in.ClientSecret
+			p.ClientSecret = &v
+		case "scopes":
+			v := in.Scopes
+			p.Scopes = &v
+		case "claims":
+			p.Claims = &claims
+		case "auto_provision":
+			v := in.AutoProvision
+			p.AutoProvision = &v
+		case "enabled":
+			v := in.Enabled
+			p.Enabled = &v
+		default:
+			return domain.ProviderPatch{}, &validation.Error{
+				Field:  "update_mask",
+				Reason: "unknown field path: " + path,
+			}
+		}
+	}
+	return p, nil
+}
+
+// ----------------------------------------------------------------------------
+// DeleteProvider
+// ----------------------------------------------------------------------------
+
+type DeleteProviderInput struct {
+	ProviderID   string
+	ExpectedEtag string
+}
+
+// DeleteProvider removes the provider together with every link to it
+// (ON DELETE CASCADE). Users who signed in only through this provider
+// are left without a sign-in method; disable the provider instead when
+// that is not intended.
+func (s *Service) DeleteProvider(ctx context.Context, in DeleteProviderInput) error {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return fmt.Errorf("delete provider: %w", err)
+	}
+	id, err := domain.ParseProviderID(in.ProviderID)
+	if err != nil {
+		return err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, true /*required*/)
+	if err != nil {
+		return err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeFederationDeleteProvider)
+	aud.SubjectType = audit.SubjectTypeIdentityProvider
+	aud.SubjectID = id.String()
+
+	if err := s.repo.DeleteProvider(ctx, id, expectedEtag); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return err
+	}
+
+	s.auditor.Success(ctx, aud)
+	return nil
+}
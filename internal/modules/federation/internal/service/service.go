@@ -0,0 +1,119 @@
+// Package service hosts the application-layer use-cases of the
+// federation bounded context:
+//
+//	service.go  — Service struct + helpers
+//	provider.go — Create/Get/List/Update/DeleteProvider (admin)
+//	login.go    — BeginLogin, CompleteLogin (authorization-code flow)
+//	link.go     — BeginLink, ListLinks, Unlink (self-service)
+//
+// The module never mints tokens itself: once a provider subject has been
+// resolved to a local user, CompleteLogin hands over to auth through the
+// SessionIssuer port, so every session goes through the same app and
+// account-state gates as a password login.
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"time"
+
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/auth"
+	"sso/internal/modules/federation/internal/domain"
+	"sso/internal/modules/identity"
+	"sso/internal/platform/oidc"
+)
+
+// SessionIssuer is the slice of auth.Service used to mint a session for
+// a federated user.
+type SessionIssuer interface {
+	LoginFederated(ctx context.Context, in auth.LoginFederatedInput) (auth.LoginOutput, error)
+}
+
+// TxRunner runs fn in one database transaction carried by ctx
+// (dbutil.WithTx bound to the module's *sql.DB).
+type TxRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+// Config carries the non-dependency settings of the Service.
+type Config struct {
+	// CallbackURL is the absolute redirect_uri registered with every
+	// provider, e.g. https://sso.example.com/v1/federation/callback.
+	CallbackURL string
+	// StateTTL bounds how long a user may spend at the provider.
+	StateTTL time.Duration
+}
+
+type Service struct {
+	repo     domain.Repository
+	users    identity.Repository
+	apps     app.Repository
+	sessions SessionIssuer
+	oidc     *oidc.Client
+	tx       TxRunner
+	cfg      Config
+	now      func() time.Time
+	log      *slog.Logger
+	auditor  auditx.Auditor
+}
+
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	users identity.Repository,
+	apps app.Repository,
+	sessions SessionIssuer,
+	client *oidc.Client,
+	tx TxRunner,
+	cfg Config,
+	now func() time.Time,
+	emitter audit.Emitter,
+) *Service {
+	return &Service{
+		repo:     repo,
+		users:    users,
+		apps:     apps,
+		sessions: sessions,
+		oidc:     client,
+		tx:       tx,
+		cfg:      cfg,
+		now:      now,
+		log:      log,
+		auditor:  auditx.New(log, emitter),
+	}
+}
+
+// errReasonMap maps federation sentinels to their audit (Outcome,
+// Reason) pair.
+var errReasonMap = map[error]auditx.OutcomeReason{
+	domain.ErrProviderNotFound:      auditx.Fail(audit.ReasonIdentityProviderNotFound),
+	domain.ErrProviderAlreadyExists: auditx.Fail(audit.ReasonIdentityProviderAlreadyExists),
+	domain.ErrProviderDisabled:      auditx.Deny(audit.ReasonIdentityProviderDisabled),
+	domain.ErrEtagMismatch:          auditx.Fail(audit.ReasonEtagMismatch),
+	domain.ErrIdentityNotLinked:     auditx.Deny(audit.ReasonExternalIdentityNotLinked),
+	domain.ErrIdentityAlreadyLinked: auditx.Fail(audit.ReasonExternalIdentityAlreadyLinked),
+	domain.ErrLinkNotFound:          auditx.Fail(audit.ReasonExternalIdentityNotLinked),
+	domain.ErrLastSignInMethod:      auditx.Deny(audit.ReasonLastSignInMethod),
+	domain.ErrInvalidState:          auditx.Fail(audit.ReasonFederationStateInvalid),
+	domain.ErrProviderRejected:      auditx.Fail(audit.ReasonFederationTokenInvalid),
+	identity.ErrUserNotFound:        auditx.Fail(audit.ReasonUserNotFound),
+}
+
+// classifyError is the per-package thin wrapper around auditx.Classify.
+func classifyError(err error) (audit.AuditOutcome, string) {
+	return auditx.Classify(err, errReasonMap)
+}
+
+// withOutcome is a thin alias over auditx.WithOutcome.
+func withOutcome(p audit.NewAuditParams, out audit.AuditOutcome, reason string) audit.NewAuditParams {
+	return auditx.WithOutcome(p, out, reason)
+}
+
+// hashState is the storage key of a state value. The raw value only
+// ever lives in the browser's redirect.
+func hashState(state string) []byte {
+	sum := sha256.Sum256([]byte(state))
+	return sum[:]
+}
@@ -0,0 +1,143 @@
+// Package federation exposes the wire-up for the federation bounded
+// context (sign-in through external OpenID Connect providers).
+// bootstrap.New constructs a single *federation.Module and pulls
+// everything else off it:
+//
+//	mod.RegisterHTTP(mux)  // mounts the /v1/federation/* endpoints
+//	mod.Service()          // application-layer service (rare)
+//	mod.Repository()       // persistence contract
+//
+// There is no gRPC handler yet: the FederationService contract is not
+// part of the published sso_protos, so the surface is HTTP-only until
+// it is.
+package federation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/federation/internal/httpapi"
+	"sso/internal/modules/federation/internal/mariadb"
+	"sso/internal/modules/federation/internal/service"
+	"sso/internal/modules/identity"
+	"sso/internal/platform/httpserver/sessioncookie"
+	"sso/internal/platform/oidc"
+)
+
+// Emitter is the audit-event sink the module uses to record write
+// operations. Type alias over audit.Emitter — keeps the wiring
+// contract local to this module.
+type Emitter = audit.Emitter
+
+// Deps lists everything federation needs from its host. Users must
+// write through the same *sql.DB as DB: provisioning creates the user
+// and its link inside one dbutil.WithTx transaction.
+type Deps struct {
+	DB  *sql.DB
+	Log *slog.Logger
+
+	Users         identity.Repository
+	Apps          app.Repository // tenant of the app signed in to
+	Sessions      SessionIssuer  // *auth.Service
+	Authenticator Authenticator  // *grpcauth.Interceptor
+	Authorizer    Authorizer     // *httprbac.Authorizer
+
+	// OIDC defaults to a client with the package defaults.
+	OIDC *oidc.Client
+
+	// Cookies writes the session a federated login ends in; federation
+	// needs the HTTP cookie mode.
+	Cookies *sessioncookie.Jar
+
+	CallbackURL string
+	StateTTL    time.Duration // default 10m
+
+	Clock func() time.Time
+	Audit Emitter
+}
+
+// Module is the assembled federation bounded context.
+type Module struct {
+	service *service.Service
+	handler *httpapi.Handler
+	repo    *mariadb.Repository
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.DB == nil {
+		return nil, fmt.Errorf("federation: db is required")
+	}
+	if d.Log == nil {
+		return nil, fmt.Errorf("federation: log is required")
+	}
+	if d.Users == nil {
+		return nil, fmt.Errorf("federation: users repository is required")
+	}
+	if d.Apps == nil {
+		return nil, fmt.Errorf("federation: apps repository is required")
+	}
+	if d.Sessions == nil {
+		return nil, fmt.Errorf("federation: session issuer is required")
+	}
+	if d.Authenticator == nil {
+		return nil, fmt.Errorf("federation: authenticator is required")
+	}
+	if d.Authorizer == nil {
+		return nil, fmt.Errorf("federation: authorizer is required")
+	}
+	if d.Cookies == nil {
+		return nil, fmt.Errorf("federation: session cookies are required")
+	}
+	if d.CallbackURL == "" {
+		return nil, fmt.Errorf("federation: callback url is required")
+	}
+	if d.StateTTL <= 0 {
+		d.StateTTL = 10 * time.Minute
+	}
+	if d.Clock == nil {
+		d.Clock = time.Now
+	}
+	if d.OIDC == nil {
+		d.OIDC = oidc.New(oidc.Config{Now: d.Clock})
+	}
+	if d.Audit == nil {
+		d.Audit = audit.NopEmitter{}
+	}
+
+	repo := mariadb.NewRepository(d.DB)
+
+	var _ Repository = repo
+
+	tx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return dbutil.WithTx(ctx, d.DB, fn)
+	}
+	svc := service.NewService(d.Log, repo, d.Users, d.Apps, d.Sessions, d.OIDC, tx,
+		service.Config{CallbackURL: d.CallbackURL, StateTTL: d.StateTTL},
+		d.Clock, d.Audit)
+
+	return &Module{
+		service: svc,
+		handler: httpapi.NewHandler(svc, d.Authenticator, d.Authorizer, d.Cookies, d.Log),
+		repo:    repo,
+	}, nil
+}
+
+// RegisterHTTP mounts the federation endpoints on the HTTP listener's
+// root mux.
+func (m *Module) RegisterHTTP(mux *http.ServeMux) {
+	m.handler.Register(mux)
+}
+
+// Service returns the application-layer Service.
+func (m *Module) Service() *service.Service { return m.service }
+
+// Repository returns the persistence contract.
+func (m *Module) Repository() Repository { return m.repo }
@@ -0,0 +1,21 @@
+// Package federation re-exports the application-layer Service together
+// with the typed Input/Output structs declared in internal/service.
+package federation
+
+import "sso/internal/modules/federation/internal/service"
+
+// Service is the use-case orchestrator, split across files in
+// internal/service: provider.go, login.go, link.go.
+type Service = service.Service
+
+// Input / Output type aliases.
+type (
+	CreateProviderInput = service.CreateProviderInput
+	UpdateProviderInput = service.UpdateProviderInput
+	DeleteProviderInput = service.DeleteProviderInput
+	BeginLoginInput     = service.BeginLoginInput
+	BeginLoginOutput    = service.BeginLoginOutput
+	CompleteLoginInput  = service.CompleteLoginInput
+	CompleteLoginOutput = service.CompleteLoginOutput
+	Link                = service.Link
+)
@@ -0,0 +1,54 @@
+// Package group is the public API of the group bounded context (named
+// sets of users that roles can be granted to). External callers
+// interact with the module through:
+//
+//	group.New(Deps)     wires the module (module.go)
+//	group.Service       application-layer use-cases (service.go)
+//	group.Repository    persistence contract
+//	group.GroupReader   narrow read-only surface for access
+package group
+
+import (
+	"context"
+
+	"sso/internal/modules/group/internal/domain"
+	"sso/internal/modules/group/internal/httpapi"
+)
+
+type (
+	Group              = domain.Group
+	GroupID            = domain.GroupID
+	UserID             = domain.UserID
+	ActorID            = domain.ActorID
+	Member             = domain.Member
+	GroupPatch         = domain.GroupPatch
+	NewGroupParams     = domain.NewGroupParams
+	RestoreGroupParams = domain.RestoreGroupParams
+	Repository         = domain.Repository
+
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+)
+
+var (
+	NewGroupID   = domain.NewGroupID
+	ParseGroupID = domain.ParseGroupID
+)
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var (
+	ErrGroupNotFound      = domain.ErrGroupNotFound
+	ErrGroupAlreadyExists = domain.ErrGroupAlreadyExists
+	ErrEtagMismatch       = domain.ErrEtagMismatch
+)
+
+// GroupReader — narrow read-only surface used by sibling modules.
+//
+// access uses GetByID to check that a group exists before granting it
+// a role. The MariaDB Repository satisfies this interface (compile-time
+// checked in module.go).
+type GroupReader interface {
+	GetByID(ctx context.Context, id GroupID) (*Group, error)
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+var (
+	ErrGroupNotFound = errors.New("group: not found")
+	ErrEtagMismatch  = errors.New("group: etag mismatch")
+
+	// ErrGroupAlreadyExists — another group has the same name.
+	ErrGroupAlreadyExists = errors.New("group: name already in use")
+)
@@ -0,0 +1,200 @@
+// Package domain holds the Group aggregate of the group bounded
+// context: a named set of users that roles can be granted to as a
+// whole. Membership rows live next to the aggregate; the grants
+// themselves belong to access (group_role_assignments).
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/validation"
+)
+
+// ----------------------------------------------------------------------------
+// IDs
+// ----------------------------------------------------------------------------
+
+// GroupID — RFC 4122 UUID, generated as v7 (k-sortable).
+type GroupID string
+
+func NewGroupID() (GroupID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate group id: %w", err)
+	}
+	return GroupID(id.String()), nil
+}
+
+func ParseGroupID(s string) (GroupID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "group_id", Reason: "must be a valid UUID"}
+	}
+	return GroupID(s), nil
+}
+
+func (id GroupID) String() string { return string(id) }
+
+// UserID is a cross-context handle to identity.User.
+type UserID string
+
+func ParseUserID(s string) (UserID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "user_id", Reason: "must be a valid UUID"}
+	}
+	return UserID(s), nil
+}
+
+func (id UserID) String() string { return string(id) }
+
+// ActorID is the user or service account that added a member.
+type ActorID string
+
+func (id ActorID) String() string { return string(id) }
+
+// ----------------------------------------------------------------------------
+// Group
+// ----------------------------------------------------------------------------
+
+const (
+	maxNameLen        = 128
+	maxDescriptionLen = 1024
+)
+
+// Group is a named set of users. Name and Description are plain data
+// checked by ValidateName / ValidateDescription; etag and updatedAt
+// advance only through ApplyPatch.
+type Group struct {
+	id        GroupID
+	etag      etag.Etag
+	createdAt time.Time
+	updatedAt time.Time
+
+	Name        string
+	Description string
+}
+
+type NewGroupParams struct {
+	ID          GroupID
+	Name        string
+	Description string
+	Now         time.Time
+}
+
+// NewGroup validates the supplied fields and constructs a fresh Group.
+func NewGroup(p NewGroupParams) (*Group, error) {
+	name := strings.TrimSpace(p.Name)
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	if err := ValidateDescription(p.Description); err != nil {
+		return nil, err
+	}
+	return &Group{
+		id:          p.ID,
+		etag:        etag.New(),
+		createdAt:   p.Now,
+		updatedAt:   p.Now,
+		Name:        name,
+		Description: p.Description,
+	}, nil
+}
+
+// RestoreGroupParams carries the full row read back from the repository.
+type RestoreGroupParams struct {
+	ID          GroupID
+	Name        string
+	Description string
+	Etag        etag.Etag
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RestoreGroup rebuilds a Group from a persisted row. No validation.
+func RestoreGroup(p RestoreGroupParams) *Group {
+	return &Group{
+		id:          p.ID,
+		etag:        p.Etag,
+		createdAt:   p.CreatedAt,
+		updatedAt:   p.UpdatedAt,
+		Name:        p.Name,
+		Description: p.Description,
+	}
+}
+
+func (g *Group) ID() GroupID          { return g.id }
+func (g *Group) Etag() etag.Etag      { return g.etag }
+func (g *Group) CreatedAt() time.Time { return g.createdAt }
+func (g *Group) UpdatedAt() time.Time { return g.updatedAt }
+
+// GroupPatch — nil pointer = "field not in the update".
+type GroupPatch struct {
+	Name        *string
+	Description *string
+}
+
+func (p GroupPatch) IsEmpty() bool {
+	return p.Name == nil && p.Description == nil
+}
+
+// ApplyPatch validates and applies the supplied changes. Bumps
+// etag/updated_at only when a field actually changes.
+func (g *Group) ApplyPatch(p GroupPatch, now time.Time) error {
+	changed := false
+	if p.Name != nil {
+		name := strings.TrimSpace(*p.Name)
+		if err := ValidateName(name); err != nil {
+			return err
+		}
+		if name != g.Name {
+			g.Name = name
+			changed = true
+		}
+	}
+	if p.Description != nil {
+		if err := ValidateDescription(*p.Description); err != nil {
+			return err
+		}
+		if *p.Description != g.Description {
+			g.Description = *p.Description
+			changed = true
+		}
+	}
+	if changed {
+		g.updatedAt = now
+		g.etag = etag.New()
+	}
+	return nil
+}
+
+func ValidateName(name string) error {
+	if n := utf8.RuneCountInString(name); n == 0 || n > maxNameLen {
+		return &validation.Error{Field: "name", Reason: fmt.Sprintf("length must be between 1 and %d", maxNameLen)}
+	}
+	return nil
+}
+
+func ValidateDescription(d string) error {
+	if utf8.RuneCountInString(d) > maxDescriptionLen {
+		return &validation.Error{Field: "description", Reason: fmt.Sprintf("must be at most %d characters", maxDescriptionLen)}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Membership
+// ----------------------------------------------------------------------------
+
+// Member is one (group, user) membership row. Immutable: users are
+// added and removed, never edited.
+type Member struct {
+	GroupID GroupID
+	UserID  UserID
+	AddedBy ActorID
+	AddedAt time.Time
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+
+	"sso/internal/kernel/etag"
+)
+
+// Repository is the persistence contract for the group context.
+//
+// Error contract:
+//
+//	Create / Update  → ErrGroupAlreadyExists (name taken)
+//	GetByID          → ErrGroupNotFound
+//	Update / Delete  → ErrGroupNotFound / ErrEtagMismatch
+//
+// expectedEtag "" means unconditional, same as every other module.
+// Deleting a group cascades to its members and its role grants.
+type Repository interface {
+	Create(ctx context.Context, g *Group) error
+	GetByID(ctx context.Context, id GroupID) (*Group, error)
+	List(ctx context.Context, q ListQuery) (ListResult, error)
+	Update(ctx context.Context, g *Group, expectedEtag etag.Etag) error
+	Delete(ctx context.Context, id GroupID, expectedEtag etag.Etag) error
+
+	// AddMembers inserts the rows in one transaction. added[i] is false
+	// when members[i] was already in the group (idempotent add).
+	AddMembers(ctx context.Context, members []*Member) (added []bool, err error)
+	// RemoveMember is idempotent: removed=false when the row was absent.
+	RemoveMember(ctx context.Context, groupID GroupID, userID UserID) (removed bool, err error)
+	ListMembers(ctx context.Context, q ListMembersQuery) (ListMembersResult, error)
+	// ListGroupsForUser returns every group the user is a member of,
+	// ordered by name.
+	ListGroupsForUser(ctx context.Context, userID UserID) ([]*Group, error)
+}
+
+// ListQuery pages groups by name ascending. Search is a substring
+// match on the name; "" = all.
+type ListQuery struct {
+	PageSize int
+	After    *PageCursor
+	Search   string
+}
+
+type PageCursor struct {
+	Name string
+	ID   GroupID
+}
+
+type ListResult struct {
+	Groups     []*Group
+	NextCursor *PageCursor
+}
+
+// ListMembersQuery pages one group's members by user id.
+type ListMembersQuery struct {
+	GroupID  GroupID
+	PageSize int
+	After    UserID // "" = first page
+}
+
+type ListMembersResult struct {
+	Members   []*Member
+	NextAfter UserID // "" = last page
+}
@@ -0,0 +1,30 @@
+package httpapi
+
+import (
+	"sso/internal/modules/group/internal/domain"
+	"sso/internal/modules/identity"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap translates group sentinels, and the identity ones membership
+// passes through, into statuses. errors.proto has no group reasons yet,
+// so those entries are bare statuses (Reason UNSPECIFIED).
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrGroupNotFound: {
+		Code: codes.NotFound, Message: "group not found"},
+	domain.ErrGroupAlreadyExists: {
+		Code: codes.AlreadyExists, Message: "a group with this name already exists"},
+	domain.ErrEtagMismatch: {
+		Code: codes.Aborted, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ETAG_MISMATCH, Message: "etag mismatch"},
+	identity.ErrUserNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_NOT_FOUND, Message: "user not found"},
+	identity.ErrUserDeleted: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_DELETED, Message: "user is deleted"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
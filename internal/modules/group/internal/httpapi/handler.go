@@ -0,0 +1,278 @@
+// Package httpapi is the HTTP adapter for the group context.
+//
+// Groups are not part of the published sso_protos, so these are
+// hand-written net/http handlers mounted next to the grpc-gateway.
+// Error bodies use the same google.rpc.Status JSON shape as the gateway,
+// so clients need a single error decoder.
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/group/internal/domain"
+	grpsvc "sso/internal/modules/group/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+type Handler struct {
+	svc *grpsvc.Service
+	api *apiutil.Adapter
+	log *slog.Logger
+}
+
+func NewHandler(svc *grpsvc.Service, authn Authenticator, authz Authorizer, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("group", authn, authz, log, toStatus), log: log}
+}
+
+// Register mounts the group endpoints. All of them are admin.
+//
+//	GET    /v1/groups?search=&page_size=&page_token=
+//	POST   /v1/groups
+//	GET    /v1/groups/{id}
+//	PATCH  /v1/groups/{id}?etag=
+//	DELETE /v1/groups/{id}?etag=
+//	GET    /v1/groups/{id}/members?page_size=&page_token=
+//	POST   /v1/groups/{id}/members                  {"user_ids": [...]}
+//	DELETE /v1/groups/{id}/members/{user_id}
+//	GET    /v1/users/{user_id}/groups
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/groups", h.api.Authed(h.list))
+	mux.HandleFunc("POST /v1/groups", h.api.Authed(h.create))
+	mux.HandleFunc("GET /v1/groups/{id}", h.api.Authed(h.get))
+	mux.HandleFunc("PATCH /v1/groups/{id}", h.api.Authed(h.update))
+	mux.HandleFunc("DELETE /v1/groups/{id}", h.api.Authed(h.delete))
+	mux.HandleFunc("GET /v1/groups/{id}/members", h.api.Authed(h.listMembers))
+	mux.HandleFunc("POST /v1/groups/{id}/members", h.api.Authed(h.addMembers))
+	mux.HandleFunc("DELETE /v1/groups/{id}/members/{user_id}", h.api.Authed(h.removeMember))
+	mux.HandleFunc("GET /v1/users/{user_id}/groups", h.api.Authed(h.listUserGroups))
+}
+
+// ----------------------------------------------------------------------------
+// Groups
+// ----------------------------------------------------------------------------
+
+type createBody struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	var b createBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	g, err := h.svc.CreateGroup(r.Context(), grpsvc.CreateGroupInput{
+		Name:        b.Name,
+		Description: b.Description,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusCreated, groupView(g))
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	pageSize, err := parsePageSize(q.Get("page_size"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.ListGroups(r.Context(), grpsvc.ListGroupsInput{
+		PageSize:  pageSize,
+		PageToken: q.Get("page_token"),
+		Search:    q.Get("search"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"groups":          groupViews(out.Groups),
+		"next_page_token": out.NextPageToken,
+	})
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
+	g, err := h.svc.GetGroup(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, groupView(g))
+}
+
+type updateBody struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
+
+func (h *Handler) update(w http.ResponseWriter, r *http.Request) {
+	var b updateBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	g, err := h.svc.UpdateGroup(r.Context(), grpsvc.UpdateGroupInput{
+		GroupID:      r.PathValue("id"),
+		Name:         b.Name,
+		Description:  b.Description,
+		ExpectedEtag: r.URL.Query().Get("etag"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, groupView(g))
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request) {
+	err := h.svc.DeleteGroup(r.Context(), grpsvc.DeleteGroupInput{
+		GroupID:      r.PathValue("id"),
+		ExpectedEtag: r.URL.Query().Get("etag"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ----------------------------------------------------------------------------
+// Membership
+// ----------------------------------------------------------------------------
+
+type addMembersBody struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+func (h *Handler) addMembers(w http.ResponseWriter, r *http.Request) {
+	var b addMembersBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.AddMembers(r.Context(), grpsvc.AddMembersInput{
+		GroupID: r.PathValue("id"),
+		UserIDs: b.UserIDs,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"added":           userIDStrings(out.Added),
+		"already_members": userIDStrings(out.AlreadyMembers),
+	})
+}
+
+func (h *Handler) removeMember(w http.ResponseWriter, r *http.Request) {
+	err := h.svc.RemoveMember(r.Context(), grpsvc.RemoveMemberInput{
+		GroupID: r.PathValue("id"),
+		UserID:  r.PathValue("user_id"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) listMembers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	pageSize, err := parsePageSize(q.Get("page_size"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.ListMembers(r.Context(), grpsvc.ListMembersInput{
+		GroupID:   r.PathValue("id"),
+		PageSize:  pageSize,
+		PageToken: q.Get("page_token"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(out.Members))
+	for _, m := range out.Members {
+		views = append(views, map[string]any{
+			"user_id":  m.UserID.String(),
+			"added_by": m.AddedBy.String(),
+			"added_at": m.AddedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"members":         views,
+		"next_page_token": out.NextPageToken,
+	})
+}
+
+func (h *Handler) listUserGroups(w http.ResponseWriter, r *http.Request) {
+	groups, err := h.svc.ListUserGroups(r.Context(), r.PathValue("user_id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"groups": groupViews(groups)})
+}
+
+// ----------------------------------------------------------------------------
+// Views
+// ----------------------------------------------------------------------------
+
+func groupView(g *domain.Group) map[string]any {
+	return map[string]any{
+		"id":          g.ID().String(),
+		"name":        g.Name,
+		"description": g.Description,
+		"etag":        g.Etag().String(),
+		"created_at":  g.CreatedAt().UTC().Format(time.RFC3339),
+		"updated_at":  g.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+func groupViews(groups []*domain.Group) []map[string]any {
+	out := make([]map[string]any, 0, len(groups))
+	for _, g := range groups {
+		out = append(out, groupView(g))
+	}
+	return out
+}
+
+func userIDStrings(ids []domain.UserID) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id.String())
+	}
+	return out
+}
+
+// ----------------------------------------------------------------------------
+// Plumbing
+// ----------------------------------------------------------------------------
+
+func parsePageSize(v string) (int32, error) {
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0, &validation.Error{Field: "page_size", Reason: "must be an integer"}
+	}
+	return int32(n), nil
+}
@@ -0,0 +1,111 @@
+package mariadb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/group/internal/domain"
+	"sso/internal/modules/group/internal/mariadb/dbgen"
+)
+
+// List is hand-written: the name search is optional and the keyset
+// cursor is (name, id) ascending.
+func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListResult, error) {
+	if q.PageSize <= 0 {
+		return domain.ListResult{}, fmt.Errorf("group repo: list: page_size must be > 0")
+	}
+
+	var (
+		where []string
+		args  []any
+	)
+	if q.Search != "" {
+		where = append(where, "name LIKE ?")
+		args = append(args, "%"+dbutil.EscapeLike(q.Search)+"%")
+	}
+	if q.After != nil {
+		where = append(where, "(name, id) > (?, ?)")
+		args = append(args, q.After.Name, q.After.ID.String())
+	}
+	whereSQL := ""
+	if len(where) > 0 {
+		whereSQL = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	query := fmt.Sprintf(
+		`SELECT id, name, description, etag, created_at, updated_at
+		 FROM user_groups %s ORDER BY name, id LIMIT %d`,
+		whereSQL, q.PageSize+1,
+	)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return domain.ListResult{}, fmt.Errorf("group repo: list: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*domain.Group, 0, q.PageSize)
+	for rows.Next() {
+		var g dbgen.UserGroup
+		if err := rows.Scan(&g.ID, &g.Name, &g.Description, &g.Etag, &g.CreatedAt, &g.UpdatedAt); err != nil {
+			return domain.ListResult{}, fmt.Errorf("group repo: list: scan: %w", err)
+		}
+		out = append(out, groupToDomain(g))
+	}
+	if err := rows.Err(); err != nil {
+		return domain.ListResult{}, fmt.Errorf("group repo: list: rows: %w", err)
+	}
+
+	var next *domain.PageCursor
+	if len(out) > q.PageSize {
+		out = out[:q.PageSize]
+		last := out[len(out)-1]
+		next = &domain.PageCursor{Name: last.Name, ID: last.ID()}
+	}
+	return domain.ListResult{Groups: out, NextCursor: next}, nil
+}
+
+// ListMembers pages one group's members by user id ascending.
+func (r *Repository) ListMembers(ctx context.Context, q domain.ListMembersQuery) (domain.ListMembersResult, error) {
+	if q.PageSize <= 0 {
+		return domain.ListMembersResult{}, fmt.Errorf("group repo: list members: page_size must be > 0")
+	}
+
+	where := []string{"group_id = ?"}
+	args := []any{q.GroupID.String()}
+	if q.After != "" {
+		where = append(where, "user_id > ?")
+		args = append(args, q.After.String())
+	}
+
+	query := fmt.Sprintf(
+		`SELECT group_id, user_id, added_by_user_id, added_at
+		 FROM user_group_members WHERE %s ORDER BY user_id LIMIT %d`,
+		strings.Join(where, " AND "), q.PageSize+1,
+	)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return domain.ListMembersResult{}, fmt.Errorf("group repo: list members: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*domain.Member, 0, q.PageSize)
+	for rows.Next() {
+		var m dbgen.UserGroupMember
+		if err := rows.Scan(&m.GroupID, &m.UserID, &m.AddedByUserID, &m.AddedAt); err != nil {
+			return domain.ListMembersResult{}, fmt.Errorf("group repo: list members: scan: %w", err)
+		}
+		out = append(out, memberToDomain(m))
+	}
+	if err := rows.Err(); err != nil {
+		return domain.ListMembersResult{}, fmt.Errorf("group repo: list members: rows: %w", err)
+	}
+
+	var next domain.UserID
+	if len(out) > q.PageSize {
+		out = out[:q.PageSize]
+		next = out[len(out)-1].UserID
+	}
+	return domain.ListMembersResult{Members: out, NextAfter: next}, nil
+}
@@ -0,0 +1,68 @@
+package mariadb
+
+import (
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/group/internal/domain"
+	"sso/internal/modules/group/internal/mariadb/dbgen"
+)
+
+func groupToDomain(row dbgen.UserGroup) *domain.Group {
+	return domain.RestoreGroup(domain.RestoreGroupParams{
+		ID:          domain.GroupID(row.ID),
+		Name:        row.Name,
+		Description: row.Description,
+		Etag:        etag.Etag(row.Etag),
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	})
+}
+
+func toCreateParams(g *domain.Group) dbgen.CreateGroupParams {
+	return dbgen.CreateGroupParams{
+		ID:          g.ID().String(),
+		Name:        g.Name,
+		Description: g.Description,
+		Etag:        g.Etag().String(),
+		CreatedAt:   g.CreatedAt(),
+		UpdatedAt:   g.UpdatedAt(),
+	}
+}
+
+func toUpdateParams(g *domain.Group) dbgen.UpdateGroupParams {
+	return dbgen.UpdateGroupParams{
+		Name:        g.Name,
+		Description: g.Description,
+		Etag:        g.Etag().String(),
+		UpdatedAt:   g.UpdatedAt(),
+		ID:          g.ID().String(),
+	}
+}
+
+func toUpdateWithEtagParams(g *domain.Group, expected etag.Etag) dbgen.UpdateGroupWithEtagParams {
+	return dbgen.UpdateGroupWithEtagParams{
+		Name:        g.Name,
+		Description: g.Description,
+		Etag:        g.Etag().String(),
+		UpdatedAt:   g.UpdatedAt(),
+		ID:          g.ID().String(),
+		Etag_2:      expected.String(),
+	}
+}
+
+func toAddMemberParams(m *domain.Member) dbgen.AddGroupMemberParams {
+	return dbgen.AddGroupMemberParams{
+		GroupID:       m.GroupID.String(),
+		UserID:        m.UserID.String(),
+		AddedByUserID: m.AddedBy.String(),
+		AddedAt:       m.AddedAt,
+	}
+}
+
+func memberToDomain(row dbgen.UserGroupMember) *domain.Member {
+	return &domain.Member{
+		GroupID: domain.GroupID(row.GroupID),
+		UserID:  domain.UserID(row.UserID),
+		AddedBy: domain.ActorID(row.AddedByUserID),
+		AddedAt: row.AddedAt,
+	}
+}
@@ -0,0 +1,46 @@
+-- Groups and membership. List and ListMembers are hand-written (list.go).
+
+-- name: CreateGroup :exec
+INSERT INTO user_groups (
+    id, name, description, etag, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetGroupByID :one
+SELECT * FROM user_groups
+WHERE id = ?
+LIMIT 1;
+
+-- name: UpdateGroup :execresult
+UPDATE user_groups
+SET name = ?, description = ?, etag = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: UpdateGroupWithEtag :execresult
+UPDATE user_groups
+SET name = ?, description = ?, etag = ?, updated_at = ?
+WHERE id = ? AND etag = ?;
+
+-- name: DeleteGroup :execresult
+DELETE FROM user_groups WHERE id = ?;
+
+-- name: DeleteGroupWithEtag :execresult
+DELETE FROM user_groups WHERE id = ? AND etag = ?;
+
+-- name: CountGroupByID :one
+SELECT COUNT(*) FROM user_groups WHERE id = ?;
+
+-- name: AddGroupMember :exec
+INSERT INTO user_group_members (
+    group_id, user_id, added_by_user_id, added_at
+) VALUES (?, ?, ?, ?);
+
+-- name: RemoveGroupMember :execresult
+DELETE FROM user_group_members
+WHERE group_id = ? AND user_id = ?;
+
+-- name: ListGroupsForUser :many
+SELECT g.id, g.name, g.description, g.etag, g.created_at, g.updated_at
+FROM user_group_members m
+JOIN user_groups g ON g.id = m.group_id
+WHERE m.user_id = ?
+ORDER BY g.name, g.id;
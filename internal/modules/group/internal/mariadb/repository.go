@@ -0,0 +1,183 @@
+// Package mariadb is the MariaDB implementation of the group
+// Repository. Single-row statements go through sqlc; the paged lists
+// are hand-written in list.go.
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/group/internal/domain"
+	"sso/internal/modules/group/internal/mariadb/dbgen"
+)
+
+type Repository struct {
+	db *sql.DB
+	q  *dbgen.Queries
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db, q: dbgen.New(db)}
+}
+
+var _ domain.Repository = (*Repository)(nil)
+
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
+// ----------------------------------------------------------------------------
+// Groups
+// ----------------------------------------------------------------------------
+
+func (r *Repository) Create(ctx context.Context, g *domain.Group) error {
+	if err := r.queries(ctx).CreateGroup(ctx, toCreateParams(g)); err != nil {
+		if dbutil.IsDuplicateEntry(err) {
+			return domain.ErrGroupAlreadyExists
+		}
+		return fmt.Errorf("group repo: create: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id domain.GroupID) (*domain.Group, error) {
+	row, err := r.queries(ctx).GetGroupByID(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrGroupNotFound
+		}
+		return nil, fmt.Errorf("group repo: get: %w", err)
+	}
+	return groupToDomain(row), nil
+}
+
+func (r *Repository) Update(ctx context.Context, g *domain.Group, expectedEtag etag.Etag) error {
+	q := r.queries(ctx)
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = q.UpdateGroup(ctx, toUpdateParams(g))
+	} else {
+		res, err = q.UpdateGroupWithEtag(ctx, toUpdateWithEtagParams(g, expectedEtag))
+	}
+	if err != nil {
+		if dbutil.IsDuplicateEntry(err) {
+			return domain.ErrGroupAlreadyExists
+		}
+		return fmt.Errorf("group repo: update: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("group repo: update: rows_affected: %w", err)
+	}
+	if rows == 1 {
+		return nil
+	}
+	return dbutil.Discriminate(ctx, expectedEtag,
+		func(ctx context.Context) (int64, error) {
+			return q.CountGroupByID(ctx, g.ID().String())
+		},
+		domain.ErrGroupNotFound, domain.ErrEtagMismatch)
+}
+
+// Delete removes the group; user_group_members and
+// group_role_assignments cascade.
+func (r *Repository) Delete(ctx context.Context, id domain.GroupID, expectedEtag etag.Etag) error {
+	q := r.queries(ctx)
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = q.DeleteGroup(ctx, id.String())
+	} else {
+		res, err = q.DeleteGroupWithEtag(ctx, dbgen.DeleteGroupWithEtagParams{
+			ID:   id.String(),
+			Etag: expectedEtag.String(),
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("group repo: delete: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("group repo: delete: rows_affected: %w", err)
+	}
+	if rows == 1 {
+		return nil
+	}
+	return dbutil.Discriminate(ctx, expectedEtag,
+		func(ctx context.Context) (int64, error) {
+			return q.CountGroupByID(ctx, id.String())
+		},
+		domain.ErrGroupNotFound, domain.ErrEtagMismatch)
+}
+
+// ----------------------------------------------------------------------------
+// Membership
+// ----------------------------------------------------------------------------
+
+func (r *Repository) AddMembers(ctx context.Context, members []*domain.Member) ([]bool, error) {
+	added := make([]bool, len(members))
+	err := dbutil.InTx(ctx, r.db, func(tx *sql.Tx) error {
+		q := r.q.WithTx(tx)
+		for i, m := range members {
+			err := q.AddGroupMember(ctx, toAddMemberParams(m))
+			switch {
+			case err == nil:
+				added[i] = true
+			case dbutil.IsDuplicateEntry(err):
+				// Already a member; keep the original row.
+			case dbutil.IsForeignKeyViolation(err):
+				// The service checked both sides; a concurrent delete
+				// of the group is the likely cause.
+				return domain.ErrGroupNotFound
+			default:
+				return fmt.Errorf("group repo: add member: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
+func (r *Repository) RemoveMember(ctx context.Context, groupID domain.GroupID, userID domain.UserID) (bool, error) {
+	res, err := r.queries(ctx).RemoveGroupMember(ctx, dbgen.RemoveGroupMemberParams{
+		GroupID: groupID.String(),
+		UserID:  userID.String(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("group repo: remove member: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("group repo: remove member: rows_affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (r *Repository) ListGroupsForUser(ctx context.Context, userID domain.UserID) ([]*domain.Group, error) {
+	rows, err := r.queries(ctx).ListGroupsForUser(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("group repo: list groups for user: %w", err)
+	}
+	out := make([]*domain.Group, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, groupToDomain(row))
+	}
+	return out, nil
+}
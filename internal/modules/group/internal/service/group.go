@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/cursor"
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/group/internal/domain"
+)
+
+// ----------------------------------------------------------------------------
+// CreateGroup
+// ----------------------------------------------------------------------------
+
+type CreateGroupInput struct {
+	Name        string
+	Description string
+}
+
+func (s *Service) CreateGroup(ctx context.Context, in CreateGroupInput) (*domain.Group, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.NewGroupID()
+	if err != nil {
+		return nil, err
+	}
+	g, err := domain.NewGroup(domain.NewGroupParams{
+		ID:          id,
+		Name:        in.Name,
+		Description: in.Description,
+		Now:         s.now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeGroupCreate)
+	aud.SubjectType = audit.SubjectTypeGroup
+	aud.SubjectID = id.String()
+
+	if err := s.repo.Create(ctx, g); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("create group: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return g, nil
+}
+
+// ----------------------------------------------------------------------------
+// GetGroup / ListGroups
+// ----------------------------------------------------------------------------
+
+func (s *Service) GetGroup(ctx context.Context, rawID string) (*domain.Group, error) {
+	if _, err := actor.Require(ctx); err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseGroupID(rawID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+type ListGroupsInput struct {
+	PageSize  int32
+	PageToken string
+	Search    string
+}
+
+type ListGroupsOutput struct {
+	Groups        []*domain.Group
+	NextPageToken string
+}
+
+// ListGroups pages groups by name.
+func (s *Service) ListGroups(ctx context.Context, in ListGroupsInput) (ListGroupsOutput, error) {
+	if _, err := actor.Require(ctx); err != nil {
+		return ListGroupsOutput{}, err
+	}
+	after, err := decodeCursor(in.PageToken)
+	if err != nil {
+		return ListGroupsOutput{}, err
+	}
+	pageSize, err := auditx.ClampPageSize(in.PageSize)
+	if err != nil {
+		return ListGroupsOutput{}, err
+	}
+	res, err := s.repo.List(ctx, domain.ListQuery{
+		PageSize: pageSize,
+		After:    after,
+		Search:   in.Search,
+	})
+	if err != nil {
+		return ListGroupsOutput{}, err
+	}
+	next, err := encodeCursor(res.NextCursor)
+	if err != nil {
+		return ListGroupsOutput{}, err
+	}
+	return ListGroupsOutput{Groups: res.Groups, NextPageToken: next}, nil
+}
+
+type pageToken struct {
+	Name string `json:"n"`
+	ID   string `json:"i"`
+}
+
+func encodeCursor(c *domain.PageCursor) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	return cursor.Encode(&pageToken{Name: c.Name, ID: c.ID.String()})
+}
+
+func decodeCursor(s string) (*domain.PageCursor, error) {
+	t, err := cursor.Decode[pageToken](s)
+	if err != nil {
+		return nil, &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	if t == nil {
+		return nil, nil
+	}
+	id, err := domain.ParseGroupID(t.ID)
+	if err != nil {
+		return nil, &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	return &domain.PageCursor{Name: t.Name, ID: id}, nil
+}
+
+// ----------------------------------------------------------------------------
+// UpdateGroup
+// ----------------------------------------------------------------------------
+
+type UpdateGroupInput struct {
+	GroupID      string
+	Name         *string
+	Description  *string
+	ExpectedEtag string
+}
+
+func (s *Service) UpdateGroup(ctx context.Context, in UpdateGroupInput) (*domain.Group, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseGroupID(in.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, false)
+	if err != nil {
+		return nil, err
+	}
+	patch := domain.GroupPatch{Name: in.Name, Description: in.Description}
+	if patch.IsEmpty() {
+		return nil, &validation.Error{Field: "update", Reason: "must change at least one field"}
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeGroupUpdate)
+	aud.SubjectType = audit.SubjectTypeGroup
+	aud.SubjectID = id.String()
+
+	g, err := s.update(ctx, id, patch, expectedEtag)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("update group: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return g, nil
+}
+
+func (s *Service) update(ctx context.Context, id domain.GroupID, patch domain.GroupPatch, expectedEtag etag.Etag) (*domain.Group, error) {
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if expectedEtag != "" && expectedEtag != g.Etag() {
+		return nil, domain.ErrEtagMismatch
+	}
+	before := g.Etag()
+	if err := g.ApplyPatch(patch, s.now().UTC()); err != nil {
+		return nil, err
+	}
+	if g.Etag() == before {
+		// Nothing changed; MariaDB would report 0 affected rows.
+		return g, nil
+	}
+	if err := s.repo.Update(ctx, g, before); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// ----------------------------------------------------------------------------
+// DeleteGroup
+// ----------------------------------------------------------------------------
+
+type DeleteGroupInput struct {
+	GroupID      string
+	ExpectedEtag string
+}
+
+// DeleteGroup removes the group together with its memberships and role
+// grants; members lose what they held only through it.
+func (s *Service) DeleteGroup(ctx context.Context, in DeleteGroupInput) error {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return err
+	}
+	id, err := domain.ParseGroupID(in.GroupID)
+	if err != nil {
+		return err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, false)
+	if err != nil {
+		return err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeGroupDelete)
+	aud.SubjectType = audit.SubjectTypeGroup
+	aud.SubjectID = id.String()
+
+	if err := s.repo.Delete(ctx, id, expectedEtag); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return fmt.Errorf("delete group: %w", err)
+	}
+	s.version.Bump(ctx)
+
+	s.auditor.Success(ctx, aud)
+	return nil
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/cursor"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/group/internal/domain"
+	"sso/internal/modules/identity"
+)
+
+// ----------------------------------------------------------------------------
+// AddMembers
+// ----------------------------------------------------------------------------
+
+type AddMembersInput struct {
+	GroupID string
+	UserIDs []string
+}
+
+// AddMembersOutput reports which of the requested users were newly
+// added; users already in the group are listed in AlreadyMembers.
+type AddMembersOutput struct {
+	Added          []domain.UserID
+	AlreadyMembers []domain.UserID
+}
+
+// AddMembers puts up to maxMembersPerCall users into the group. Every
+// user must exist and not be deleted; the batch is all-or-nothing on
+// validation, idempotent on users that are already members.
+func (s *Service) AddMembers(ctx context.Context, in AddMembersInput) (AddMembersOutput, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return AddMembersOutput{}, err
+	}
+	groupID, err := domain.ParseGroupID(in.GroupID)
+	if err != nil {
+		return AddMembersOutput{}, err
+	}
+	userIDs, err := parseUserIDs(in.UserIDs)
+	if err != nil {
+		return AddMembersOutput{}, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeGroupAddMembers)
+	aud.SubjectType = audit.SubjectTypeGroup
+	aud.SubjectID = groupID.String()
+	aud.Metadata = map[string]string{"requested": strconv.Itoa(len(userIDs))}
+
+	out, err := s.addMembers(ctx, groupID, userIDs, domain.ActorID(a.ID))
+	if err != nil {
+		o, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, o, reason))
+		return AddMembersOutput{}, fmt.Errorf("add group members: %w", err)
+	}
+
+	aud.Metadata["added"] = strconv.Itoa(len(out.Added))
+	s.auditor.Success(ctx, aud)
+	return out, nil
+}
+
+func (s *Service) addMembers(ctx context.Context, groupID domain.GroupID, userIDs []domain.UserID, by domain.ActorID) (AddMembersOutput, error) {
+	if _, err := s.repo.GetByID(ctx, groupID); err != nil {
+		return AddMembersOutput{}, err
+	}
+	for _, id := range userIDs {
+		u, err := s.users.GetByID(ctx, identity.UserID(id))
+		if err != nil {
+			return AddMembersOutput{}, err
+		}
+		if u.Status() == identity.UserStatusDeleted {
+			return AddMembersOutput{}, identity.ErrUserDeleted
+		}
+	}
+
+	now := s.now().UTC()
+	members := make([]*domain.Member, len(userIDs))
+	for i, id := range userIDs {
+		members[i] = &domain.Member{GroupID: groupID, UserID: id, AddedBy: by, AddedAt: now}
+	}
+	added, err := s.repo.AddMembers(ctx, members)
+	if err != nil {
+		return AddMembersOutput{}, err
+	}
+	s.version.Bump(ctx)
+
+	var out AddMembersOutput
+	for i, id := range userIDs {
+		if added[i] {
+			out.Added = append(out.Added, id)
+		} else {
+			out.AlreadyMembers = append(out.AlreadyMembers, id)
+		}
+	}
+	return out, nil
+}
+
+// parseUserIDs validates the batch size and each id, dropping
+// duplicates so the repository sees every user once.
+func parseUserIDs(raw []string) ([]domain.UserID, error) {
+	if len(raw) == 0 {
+		return nil, &validation.Error{Field: "user_ids", Reason: "must not be empty"}
+	}
+	if len(raw) > maxMembersPerCall {
+		return nil, &validation.Error{Field: "user_ids", Reason: fmt.Sprintf("must contain at most %d entries", maxMembersPerCall)}
+	}
+	seen := make(map[domain.UserID]struct{}, len(raw))
+	out := make([]domain.UserID, 0, len(raw))
+	for _, r := range raw {
+		id, err := domain.ParseUserID(r)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+// ----------------------------------------------------------------------------
+// RemoveMember
+// ----------------------------------------------------------------------------
+
+type RemoveMemberInput struct {
+	GroupID string
+	UserID  string
+}
+
+// RemoveMember takes the user out of the group. Removing a non-member
+// is a no-op success so retries are safe; the audit metadata records
+// whether a row was actually deleted.
+func (s *Service) RemoveMember(ctx context.Context, in RemoveMemberInput) error {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return err
+	}
+	groupID, err := domain.ParseGroupID(in.GroupID)
+	if err != nil {
+		return err
+	}
+	userID, err := domain.ParseUserID(in.UserID)
+	if err != nil {
+		return err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeGroupRemoveMember)
+	aud.SubjectType = audit.SubjectTypeGroup
+	aud.SubjectID = groupID.String()
+	aud.Metadata = map[string]string{"user_id": userID.String()}
+
+	removed, err := s.removeMember(ctx, groupID, userID)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return fmt.Errorf("remove group member: %w", err)
+	}
+
+	aud.Metadata["removed"] = strconv.FormatBool(removed)
+	s.auditor.Success(ctx, aud)
+	return nil
+}
+
+func (s *Service) removeMember(ctx context.Context, groupID domain.GroupID, userID domain.UserID) (bool, error) {
+	if _, err := s.repo.GetByID(ctx, groupID); err != nil {
+		return false, err
+	}
+	removed, err := s.repo.RemoveMember(ctx, groupID, userID)
+	if err != nil {
+		return false, err
+	}
+	if removed {
+		s.version.Bump(ctx)
+	}
+	return removed, nil
+}
+
+// ----------------------------------------------------------------------------
+// ListMembers / ListUserGroups
+// ----------------------------------------------------------------------------
+
+type ListMembersInput struct {
+	GroupID   string
+	PageSize  int32
+	PageToken string
+}
+
+type ListMembersOutput struct {
+	Members       []*domain.Member
+	NextPageToken string
+}
+
+func (s *Service) ListMembers(ctx context.Context, in ListMembersInput) (ListMembersOutput, error) {
+	if _, err := actor.Require(ctx); err != nil {
+		return ListMembersOutput{}, err
+	}
+	groupID, err := domain.ParseGroupID(in.GroupID)
+	if err != nil {
+		return ListMembersOutput{}, err
+	}
+	after, err := decodeMemberCursor(in.PageToken)
+	if err != nil {
+		return ListMembersOutput{}, err
+	}
+	pageSize, err := auditx.ClampPageSize(in.PageSize)
+	if err != nil {
+		return ListMembersOutput{}, err
+	}
+	if _, err := s.repo.GetByID(ctx, groupID); err != nil {
+		return ListMembersOutput{}, err
+	}
+	res, err := s.repo.ListMembers(ctx, domain.ListMembersQuery{
+		GroupID:  groupID,
+		PageSize: pageSize,
+		After:    after,
+	})
+	if err != nil {
+		return ListMembersOutput{}, err
+	}
+	next, err := encodeMemberCursor(res.NextAfter)
+	if err != nil {
+		return ListMembersOutput{}, err
+	}
+	return ListMembersOutput{Members: res.Members, NextPageToken: next}, nil
+}
+
+type memberPageToken struct {
+	UserID string `json:"u"`
+}
+
+func encodeMemberCursor(after domain.UserID) (string, error) {
+	if after == "" {
+		return "", nil
+	}
+	return cursor.Encode(&memberPageToken{UserID: after.String()})
+}
+
+func decodeMemberCursor(s string) (domain.UserID, error) {
+	t, err := cursor.Decode[memberPageToken](s)
+	if err != nil {
+		return "", &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	if t == nil {
+		return "", nil
+	}
+	id, err := domain.ParseUserID(t.UserID)
+	if err != nil {
+		return "", &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	return id, nil
+}
+
+// ListUserGroups returns every group the user belongs to. The user's
+// existence is checked so an unknown id is a 404 rather than an empty
+// list.
+func (s *Service) ListUserGroups(ctx context.Context, rawUserID string) ([]*domain.Group, error) {
+	if _, err := actor.Require(ctx); err != nil {
+		return nil, err
+	}
+	userID, err := domain.ParseUserID(rawUserID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.users.GetByID(ctx, identity.UserID(userID)); err != nil {
+		return nil, err
+	}
+	return s.repo.ListGroupsForUser(ctx, userID)
+}
@@ -0,0 +1,74 @@
+// Package service hosts the application-layer use-cases of the group
+// bounded context:
+//
+//	service.go — Service struct + helpers
+//	group.go   — Create/Get/List/Update/DeleteGroup
+//	member.go  — AddMembers, RemoveMember, ListMembers, ListUserGroups
+//
+// Role grants to groups are access use-cases (access.GrantRoleToGroup);
+// this context only owns the groups and who is in them.
+package service
+
+import (
+	"log/slog"
+	"time"
+
+	"sso/internal/kernel/authzver"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/group/internal/domain"
+	"sso/internal/modules/identity"
+)
+
+type Service struct {
+	repo    domain.Repository
+	users   identity.UserReader
+	now     func() time.Time
+	log     *slog.Logger
+	auditor auditx.Auditor
+	version authzver.Bumper
+}
+
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	users identity.UserReader,
+	now func() time.Time,
+	emitter audit.Emitter,
+	version authzver.Bumper,
+) *Service {
+	return &Service{
+		repo:    repo,
+		users:   users,
+		now:     now,
+		log:     log,
+		auditor: auditx.New(log, emitter),
+		version: version,
+	}
+}
+
+// errReasonMap maps group sentinels (and the identity ones membership
+// passes through) to their audit (Outcome, Reason) pair.
+var errReasonMap = map[error]auditx.OutcomeReason{
+	domain.ErrGroupNotFound:      auditx.Fail(audit.ReasonGroupNotFound),
+	domain.ErrGroupAlreadyExists: auditx.Fail(audit.ReasonGroupAlreadyExists),
+	domain.ErrEtagMismatch:       auditx.Fail(audit.ReasonEtagMismatch),
+	identity.ErrUserNotFound:     auditx.Fail(audit.ReasonUserNotFound),
+	identity.ErrUserDeleted:      auditx.Deny(audit.ReasonUserDeleted),
+}
+
+// classifyError is the per-package thin wrapper around auditx.Classify.
+func classifyError(err error) (audit.AuditOutcome, string) {
+	return auditx.Classify(err, errReasonMap)
+}
+
+// withOutcome is a thin alias over auditx.WithOutcome.
+func withOutcome(p audit.NewAuditParams, out audit.AuditOutcome, reason string) audit.NewAuditParams {
+	return auditx.WithOutcome(p, out, reason)
+}
+
+const (
+	// maxMembersPerCall caps AddMembers; onboarding a larger team takes
+	// several calls.
+	maxMembersPerCall = 100
+)
@@ -0,0 +1,108 @@
+// Package group exposes the wire-up for the group bounded context.
+// bootstrap.New constructs a single *group.Module and pulls everything
+// else off it:
+//
+//	mod.HTTPRoutes(authn)  // /v1/groups and /v1/users/{id}/groups
+//	mod.GroupReader()      // narrow read-only surface for access
+//	mod.Service()          // application-layer service (rare)
+//	mod.Repository()       // persistence contract
+//
+// Like invitation, the surface is HTTP-only until a GroupService
+// contract is published in sso_protos.
+package group
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/kernel/authzver"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/group/internal/httpapi"
+	"sso/internal/modules/group/internal/mariadb"
+	"sso/internal/modules/group/internal/service"
+	"sso/internal/modules/identity"
+)
+
+// Emitter is the audit-event sink the module uses to record write
+// operations. Type alias over audit.Emitter — keeps the wiring
+// contract local to this module.
+type Emitter = audit.Emitter
+
+// Deps lists everything group needs from its host.
+type Deps struct {
+	DB  *sql.DB
+	Log *slog.Logger
+
+	Users identity.UserReader
+
+	Clock func() time.Time
+	Audit Emitter
+
+	// AuthzVersion is bumped after membership changes and group
+	// deletes, which change the roles a user holds through groups.
+	// Optional; defaults to authzver.Nop.
+	AuthzVersion authzver.Bumper
+}
+
+// Module is the assembled group bounded context.
+type Module struct {
+	service *service.Service
+	repo    *mariadb.Repository
+	log     *slog.Logger
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.DB == nil {
+		return nil, fmt.Errorf("group: db is required")
+	}
+	if d.Log == nil {
+		return nil, fmt.Errorf("group: log is required")
+	}
+	if d.Users == nil {
+		return nil, fmt.Errorf("group: users reader is required")
+	}
+	if d.Clock == nil {
+		d.Clock = time.Now
+	}
+	if d.Audit == nil {
+		d.Audit = audit.NopEmitter{}
+	}
+	if d.AuthzVersion == nil {
+		d.AuthzVersion = authzver.Nop{}
+	}
+
+	repo := mariadb.NewRepository(d.DB)
+
+	var _ Repository = repo
+	var _ GroupReader = repo
+
+	svc := service.NewService(d.Log, repo, d.Users, d.Clock, d.Audit, d.AuthzVersion)
+
+	return &Module{
+		service: svc,
+		repo:    repo,
+		log:     d.Log,
+	}, nil
+}
+
+// HTTPRoutes returns the registrar for the group endpoints. The
+// authenticator and authorizer are taken here rather than in Deps
+// because bootstrap builds them (grpcauth.Interceptor,
+// httprbac.Authorizer) after access, which reads groups.
+func (m *Module) HTTPRoutes(authn Authenticator, authz Authorizer) func(*http.ServeMux) {
+	h := httpapi.NewHandler(m.service, authn, authz, m.log)
+	return h.Register
+}
+
+// Service returns the application-layer Service.
+func (m *Module) Service() *service.Service { return m.service }
+
+// Repository returns the persistence contract.
+func (m *Module) Repository() Repository { return m.repo }
+
+// GroupReader returns the narrow read-only surface.
+func (m *Module) GroupReader() GroupReader { return m.repo }
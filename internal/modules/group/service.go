@@ -0,0 +1,23 @@
+// Package group re-exports the application-layer Service together with
+// the typed Input/Output structs declared in internal/service.
+package group
+
+import "sso/internal/modules/group/internal/service"
+
+// Service is the use-case orchestrator, split across files in
+// internal/service: group.go, member.go.
+type Service = service.Service
+
+// Input / Output type aliases.
+type (
+	CreateGroupInput  = service.CreateGroupInput
+	ListGroupsInput   = service.ListGroupsInput
+	ListGroupsOutput  = service.ListGroupsOutput
+	UpdateGroupInput  = service.UpdateGroupInput
+	DeleteGroupInput  = service.DeleteGroupInput
+	AddMembersInput   = service.AddMembersInput
+	AddMembersOutput  = service.AddMembersOutput
+	RemoveMemberInput = service.RemoveMemberInput
+	ListMembersInput  = service.ListMembersInput
+	ListMembersOutput = service.ListMembersOutput
+)
@@ -41,6 +41,9 @@ type (
 	ListQuery         = domain.ListQuery
 	ListResult        = domain.ListResult
 	PageCursor        = domain.PageCursor
+	EmailChange       = domain.EmailChange
+	EmailChangeStatus = domain.EmailChangeStatus
+	IdentifierKeys    = domain.IdentifierKeys
 	Etag              = etag.Etag
 )
 
@@ -52,6 +55,13 @@ const (
 	UserStatusDeleted = domain.UserStatusDeleted
 )
 
+// EmailChangeStatus enum re-exports.
+const (
+	EmailChangeStatusPending   = domain.EmailChangeStatusPending
+	EmailChangeStatusConfirmed = domain.EmailChangeStatusConfirmed
+	EmailChangeStatusCancelled = domain.EmailChangeStatusCancelled
+)
+
 // ListOrderBy enum re-exports.
 const (
 	OrderByUnspecified   = domain.OrderByUnspecified
@@ -72,6 +82,19 @@ var (
 	RestoreUser = domain.RestoreUser
 )
 
+// Identifier normalisation (domain/normalize.go). Callers that key
+// anything on an email or username — rate limits, caches, dedup — use
+// EmailKey / UsernameKey so they agree with the users table on what
+// counts as the same identifier.
+var (
+	NormalizeEmail    = domain.NormalizeEmail
+	NormalizeUsername = domain.NormalizeUsername
+	EmailKey          = domain.EmailKey
+	UsernameKey       = domain.UsernameKey
+	Skeleton          = domain.Skeleton
+	KeysFor           = domain.KeysFor
+)
+
 // ----------------------------------------------------------------------------
 // Sentinel errors. External consumers test for them with errors.Is.
 // ----------------------------------------------------------------------------
@@ -83,6 +106,13 @@ var (
 	ErrUserDeleted         = domain.ErrUserDeleted
 	ErrUserNotDeleted      = domain.ErrUserNotDeleted
 	ErrInvalidPasswordHash = domain.ErrInvalidPasswordHash
+	ErrUserActorRequired   = domain.ErrUserActorRequired
+
+	ErrEmailChangeTokenInvalid       = domain.ErrEmailChangeTokenInvalid
+	ErrEmailChangeExpired            = domain.ErrEmailChangeExpired
+	ErrEmailChangeNotPending         = domain.ErrEmailChangeNotPending
+	ErrEmailChangeCancelWindowClosed = domain.ErrEmailChangeCancelWindowClosed
+	ErrDeliveryFailed                = domain.ErrDeliveryFailed
 )
 
 // ----------------------------------------------------------------------------
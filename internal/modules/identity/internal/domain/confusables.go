@@ -0,0 +1,14 @@
+package domain
+
+// The confusables table (confusables_table.go) is generated from the
+// Unicode confusables.txt (UTS #39, table MA) by gen_confusables.go.
+// Entries whose source is ASCII are left out: "bob1" and "bobl", or
+// "rn" and "m", are distinct identifiers, and ASCII is not where a
+// spoofed name comes from. Every other character maps to its prototype,
+// so Cyrillic, Greek and other look-alikes of Latin letters — and of
+// each other — share a skeleton with the identifier they imitate.
+//
+// Regenerating against a newer Unicode release changes skeletons of
+// existing rows; rerun identity-normalize afterwards.
+
+//go:generate go run gen_confusables.go -out confusables_table.go
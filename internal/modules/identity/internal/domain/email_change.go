@@ -0,0 +1,232 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"sso/internal/kernel/validation"
+)
+
+// ----------------------------------------------------------------------------
+// EmailChangeID — RFC 4122 UUID, generated as v7.
+// ----------------------------------------------------------------------------
+
+type EmailChangeID string
+
+func NewEmailChangeID() (EmailChangeID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate email change id: %w", err)
+	}
+	return EmailChangeID(id.String()), nil
+}
+
+func (id EmailChangeID) String() string { return string(id) }
+
+// EmailChangeStatus is the on-wire value of user_email_changes.status —
+// do not renumber. Expiry is not a status: a pending change past
+// ExpiresAt can no longer be confirmed.
+type EmailChangeStatus uint8
+
+const (
+	EmailChangeStatusPending   EmailChangeStatus = 1
+	EmailChangeStatusConfirmed EmailChangeStatus = 2
+	EmailChangeStatusCancelled EmailChangeStatus = 3
+)
+
+// ParseEmailAddress validates a login email and returns its stored form
+// (NormalizeEmail). Display names ("Jane <jane@example.com>") are
+// rejected; the length bound matches users.email.
+func ParseEmailAddress(field, s string) (string, error) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(s))
+	if err != nil || addr.Name != "" {
+		return "", &validation.Error{Field: field, Reason: "must be a bare email address"}
+	}
+	v := NormalizeEmail(addr.Address)
+	if len(v) > 254 {
+		return "", &validation.Error{Field: field, Reason: "must be a bare email address"}
+	}
+	return v, nil
+}
+
+// ----------------------------------------------------------------------------
+// EmailChange aggregate
+// ----------------------------------------------------------------------------
+//
+// A request to move a user's login email from OldEmail to NewEmail. Two
+// single-use secrets are minted per request; only their SHA-256 is kept:
+//
+//   confirm token — mailed to the new address; proves control of it and
+//                   performs the swap.
+//   cancel token  — mailed to the old address together with a notice;
+//                   withdraws the request, or reverts an already
+//                   confirmed change while CancelUntil has not passed.
+//
+// The users row is not touched until Confirm, so a request the owner
+// never asked for changes nothing by itself.
+
+type EmailChange struct {
+	id             EmailChangeID
+	userID         UserID
+	status         EmailChangeStatus
+	confirmHash    []byte
+	cancelHash     []byte
+	revokeSessions bool
+	requestedBy    string
+	expiresAt      time.Time
+	cancelUntil    time.Time
+	confirmedAt    time.Time
+	cancelledAt    time.Time
+	createdAt      time.Time
+	updatedAt      time.Time
+
+	OldEmail string
+	NewEmail string
+}
+
+type NewEmailChangeParams struct {
+	ID             EmailChangeID
+	UserID         UserID
+	OldEmail       string
+	NewEmail       string
+	ConfirmHash    []byte
+	CancelHash     []byte
+	RevokeSessions bool
+	RequestedBy    string // actor id; the user themselves or an admin
+	ExpiresAt      time.Time
+	CancelUntil    time.Time
+	Now            time.Time
+}
+
+// NewEmailChange builds a pending change. NewEmail is expected to be
+// normalised already (ParseEmailAddress).
+func NewEmailChange(p NewEmailChangeParams) *EmailChange {
+	return &EmailChange{
+		id:             p.ID,
+		userID:         p.UserID,
+		status:         EmailChangeStatusPending,
+		confirmHash:    p.ConfirmHash,
+		cancelHash:     p.CancelHash,
+		revokeSessions: p.RevokeSessions,
+		requestedBy:    p.RequestedBy,
+		expiresAt:      p.ExpiresAt,
+		cancelUntil:    p.CancelUntil,
+		createdAt:      p.Now,
+		updatedAt:      p.Now,
+		OldEmail:       p.OldEmail,
+		NewEmail:       p.NewEmail,
+	}
+}
+
+type RestoreEmailChangeParams struct {
+	ID             EmailChangeID
+	UserID         UserID
+	Status         EmailChangeStatus
+	OldEmail       string
+	NewEmail       string
+	ConfirmHash    []byte
+	CancelHash     []byte
+	RevokeSessions bool
+	RequestedBy    string
+	ExpiresAt      time.Time
+	CancelUntil    time.Time
+	ConfirmedAt    time.Time
+	CancelledAt    time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// RestoreEmailChange rebuilds an EmailChange from a trusted row.
+func RestoreEmailChange(p RestoreEmailChangeParams) *EmailChange {
+	return &EmailChange{
+		id:             p.ID,
+		userID:         p.UserID,
+		status:         p.Status,
+		confirmHash:    p.ConfirmHash,
+		cancelHash:     p.CancelHash,
+		revokeSessions: p.RevokeSessions,
+		requestedBy:    p.RequestedBy,
+		expiresAt:      p.ExpiresAt,
+		cancelUntil:    p.CancelUntil,
+		confirmedAt:    p.ConfirmedAt,
+		cancelledAt:    p.CancelledAt,
+		createdAt:      p.CreatedAt,
+		updatedAt:      p.UpdatedAt,
+		OldEmail:       p.OldEmail,
+		NewEmail:       p.NewEmail,
+	}
+}
+
+func (c *EmailChange) ID() EmailChangeID         { return c.id }
+func (c *EmailChange) UserID() UserID            { return c.userID }
+func (c *EmailChange) Status() EmailChangeStatus { return c.status }
+func (c *EmailChange) ConfirmHash() []byte       { return c.confirmHash }
+func (c *EmailChange) CancelHash() []byte        { return c.cancelHash }
+func (c *EmailChange) RevokeSessions() bool      { return c.revokeSessions }
+func (c *EmailChange) RequestedBy() string       { return c.requestedBy }
+func (c *EmailChange) ExpiresAt() time.Time      { return c.expiresAt }
+func (c *EmailChange) CancelUntil() time.Time    { return c.cancelUntil }
+func (c *EmailChange) ConfirmedAt() time.Time    { return c.confirmedAt }
+func (c *EmailChange) CancelledAt() time.Time    { return c.cancelledAt }
+func (c *EmailChange) CreatedAt() time.Time      { return c.createdAt }
+func (c *EmailChange) UpdatedAt() time.Time      { return c.updatedAt }
+
+// Confirm marks a pending, unexpired change as done. The caller applies
+// NewEmail to the user in the same transaction.
+func (c *EmailChange) Confirm(now time.Time) error {
+	if c.status != EmailChangeStatusPending {
+		return ErrEmailChangeNotPending
+	}
+	if !now.Before(c.expiresAt) {
+		return ErrEmailChangeExpired
+	}
+	c.status = EmailChangeStatusConfirmed
+	c.confirmedAt = now
+	c.updatedAt = now
+	return nil
+}
+
+// Cancel withdraws the change. A pending change can always be
+// cancelled; a confirmed one only until CancelUntil, and then reverted
+// reports true so the caller restores OldEmail on the user.
+func (c *EmailChange) Cancel(now time.Time) (reverted bool, err error) {
+	switch c.status {
+	case EmailChangeStatusPending:
+	case EmailChangeStatusConfirmed:
+		if !now.Before(c.cancelUntil) {
+			return false, ErrEmailChangeCancelWindowClosed
+		}
+		reverted = true
+	default:
+		return false, ErrEmailChangeNotPending
+	}
+	c.status = EmailChangeStatusCancelled
+	c.cancelledAt = now
+	c.updatedAt = now
+	return reverted, nil
+}
+
+// EmailChangeRepository persists EmailChange rows. Kept apart from
+// Repository so cross-module consumers of identity.Repository are not
+// asked to implement it.
+//
+// Error contract:
+//
+//	Get*ForUpdate → ErrEmailChangeTokenInvalid (no row for the hash)
+//
+// The ForUpdate lookups lock the row and are meant to run inside
+// dbutil.WithTx, which serialises a confirm racing a cancel.
+type EmailChangeRepository interface {
+	CreateEmailChange(ctx context.Context, c *EmailChange) error
+	// SupersedePendingEmailChanges cancels every pending change of the
+	// user, so only the most recent request's tokens work.
+	SupersedePendingEmailChanges(ctx context.Context, userID UserID, now time.Time) error
+	GetEmailChangeByConfirmHashForUpdate(ctx context.Context, hash []byte) (*EmailChange, error)
+	GetEmailChangeByCancelHashForUpdate(ctx context.Context, hash []byte) (*EmailChange, error)
+	UpdateEmailChange(ctx context.Context, c *EmailChange) error
+}
@@ -33,4 +33,28 @@ var (
 	// non-empty value; an empty slice almost certainly indicates a
 	// caller bug. Clearing credentials goes through ClearPassword.
 	ErrInvalidPasswordHash = errors.New("identity: invalid password hash")
+
+	// ErrUserActorRequired — a self-service ("me") operation was called
+	// by a service account or the system; only users have a profile.
+	ErrUserActorRequired = errors.New("identity: a user actor is required")
+
+	// ErrEmailChangeTokenInvalid — a confirm or cancel token matched no
+	// email change. Unknown, mistyped and superseded tokens all end here.
+	ErrEmailChangeTokenInvalid = errors.New("identity: email change token invalid")
+
+	// ErrEmailChangeExpired — the confirm token of a pending change was
+	// presented after ExpiresAt.
+	ErrEmailChangeExpired = errors.New("identity: email change expired")
+
+	// ErrEmailChangeNotPending — the change was already confirmed or
+	// cancelled (tokens are single-use).
+	ErrEmailChangeNotPending = errors.New("identity: email change is not pending")
+
+	// ErrEmailChangeCancelWindowClosed — a confirmed change can only be
+	// reverted from the old address until CancelUntil.
+	ErrEmailChangeCancelWindowClosed = errors.New("identity: email change cancel window closed")
+
+	// ErrDeliveryFailed — the confirmation or notice mail could not be
+	// handed to the mail transport; the request was not stored.
+	ErrDeliveryFailed = errors.New("identity: mail delivery failed")
 )
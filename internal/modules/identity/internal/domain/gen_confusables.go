@@ -0,0 +1,132 @@
+//go:build ignore
+
+// gen_confusables writes confusables_table.go from the Unicode
+// confusables.txt (UTS #39). Run through go generate in this directory:
+//
+//	go generate ./internal/modules/identity/internal/domain
+//
+// -in takes a local copy or a URL; the default is the 15.1.0 file the
+// checked-in table was generated from, so regenerating is reproducible.
+// Bump the version here to move to a newer Unicode release.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const defaultSource = "https://www.unicode.org/Public/security/15.1.0/confusables.txt"
+
+func main() {
+	in := flag.String("in", defaultSource, "confusables.txt path or URL")
+	out := flag.String("out", "confusables_table.go", "output file")
+	flag.Parse()
+
+	src, err := open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer src.Close()
+
+	version, entries, err := parse(src)
+	if err != nil {
+		log.Fatalf("parse %s: %v", *in, err)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_confusables.go from confusables.txt %s; DO NOT EDIT.\n\n", version)
+	b.WriteString("package domain\n\n")
+	b.WriteString("// confusables maps a code point to its UTS #39 prototype (see\n")
+	b.WriteString("// confusables.go for the entries left out).\n")
+	b.WriteString("var confusables = map[rune]string{\n")
+	for _, e := range entries {
+		fmt.Fprintf(&b, "\t%+q: %+q,\n", e.from, e.to)
+	}
+	b.WriteString("}\n")
+
+	code, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("format: %v", err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func open(in string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(in, "https://") && !strings.HasPrefix(in, "http://") {
+		return os.Open(in)
+	}
+	resp, err := http.Get(in)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("get %s: %s", in, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+type entry struct {
+	from rune
+	to   string
+}
+
+// parse reads the "source ; target ; type" lines. ASCII sources are
+// skipped: ASCII identifiers stay distinct from each other.
+func parse(r io.Reader) (version string, entries []entry, err error) {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimPrefix(sc.Text(), "\ufeff")
+		if v, ok := strings.CutPrefix(line, "# Version: "); ok {
+			version = strings.TrimSpace(v)
+			continue
+		}
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Split(line, ";")
+		if len(fields) < 3 {
+			continue
+		}
+		from, err := codePoints(fields[0])
+		if err != nil || len(from) != 1 {
+			return "", nil, fmt.Errorf("source %q: %v", fields[0], err)
+		}
+		if from[0] < 0x80 {
+			continue
+		}
+		to, err := codePoints(fields[1])
+		if err != nil {
+			return "", nil, fmt.Errorf("target %q: %v", fields[1], err)
+		}
+		entries = append(entries, entry{from: from[0], to: string(to)})
+	}
+	if err := sc.Err(); err != nil {
+		return "", nil, err
+	}
+	if version == "" || len(entries) == 0 {
+		return "", nil, fmt.Errorf("not a confusables.txt")
+	}
+	return version, entries, nil
+}
+
+func codePoints(field string) ([]rune, error) {
+	var rs []rune
+	for _, hex := range strings.Fields(field) {
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, rune(v))
+	}
+	return rs, nil
+}
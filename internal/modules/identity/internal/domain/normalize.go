@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ----------------------------------------------------------------------------
+// Identifier normalisation
+// ----------------------------------------------------------------------------
+//
+// Emails and usernames exist in three forms:
+//
+//   stored form  — what users.email / users.username hold and what is
+//                  shown back: NFKC, trimmed; email domains in
+//                  lower-case ASCII (IDNA), email local parts lower-cased.
+//                  Username case is preserved.
+//   key          — the uniqueness and lookup form: the stored form
+//                  case-folded, plus per-provider email canonicalisation
+//                  (emailRules). Two identifiers with the same key are the
+//                  same account.
+//   skeleton     — the UTS #39 skeleton of the key. Two identifiers with
+//                  the same skeleton look alike ("аdmin" with a Cyrillic
+//                  а, "pаypal" / "раураl") and may not both exist.
+//
+// The keys and skeletons are stored next to the row with unique indexes
+// (migration 0015), so the database rather than its collation decides
+// what counts as a duplicate.
+
+// IdentifierKeys are the derived uniqueness columns of a user row.
+type IdentifierKeys struct {
+	EmailKey         string
+	UsernameKey      string
+	EmailSkeleton    string
+	UsernameSkeleton string
+}
+
+// KeysFor derives the IdentifierKeys of an email / username pair.
+func KeysFor(email, username string) IdentifierKeys {
+	ek, uk := EmailKey(email), UsernameKey(username)
+	return IdentifierKeys{
+		EmailKey:         ek,
+		UsernameKey:      uk,
+		EmailSkeleton:    Skeleton(ek),
+		UsernameSkeleton: Skeleton(uk),
+	}
+}
+
+// NormalizeUsername returns the stored form of a username.
+func NormalizeUsername(s string) string {
+	return strings.TrimSpace(norm.NFKC.String(s))
+}
+
+// UsernameKey returns the lookup key of a username: NFKC with full case
+// folding, re-normalised because folding can denormalise.
+func UsernameKey(s string) string {
+	return fold(NormalizeUsername(s))
+}
+
+// NormalizeEmail returns the stored form of an email address. Input that
+// does not split into local@domain is returned NFKC-trimmed and
+// lower-cased; format validation is the caller's business.
+func NormalizeEmail(s string) string {
+	s = strings.TrimSpace(norm.NFKC.String(s))
+	i := strings.LastIndexByte(s, '@')
+	if i <= 0 || i == len(s)-1 {
+		return strings.ToLower(s)
+	}
+	return strings.ToLower(s[:i]) + "@" + normalizeDomain(s[i+1:])
+}
+
+// EmailKey returns the lookup key of an email address: the stored form
+// with the local part case-folded and the provider's aliasing rules
+// applied, so "J.Doe+news@googlemail.com" and "jdoe@gmail.com" share a
+// key.
+func EmailKey(s string) string {
+	s = NormalizeEmail(s)
+	i := strings.LastIndexByte(s, '@')
+	if i <= 0 {
+		return fold(s)
+	}
+	local, domain := fold(s[:i]), s[i+1:]
+	if r, ok := emailRules[domain]; ok {
+		if r.canonical != "" {
+			domain = r.canonical
+		}
+		if r.subaddress {
+			local, _, _ = strings.Cut(local, "+")
+		}
+		if r.ignoreDots {
+			local = strings.ReplaceAll(local, ".", "")
+		}
+	}
+	return local + "@" + domain
+}
+
+// emailRule describes how a mail provider aliases addresses.
+type emailRule struct {
+	canonical  string // domain the provider treats this one as; "" = itself
+	subaddress bool   // "+tag" suffixes of the local part are dropped
+	ignoreDots bool   // dots in the local part are insignificant
+}
+
+// emailRules lists the providers whose aliasing is documented. Other
+// domains keep the local part verbatim (after folding): "+" and "." are
+// significant there.
+var emailRules = map[string]emailRule{
+	"gmail.com":      {subaddress: true, ignoreDots: true},
+	"googlemail.com": {canonical: "gmail.com", subaddress: true, ignoreDots: true},
+	"outlook.com":    {subaddress: true},
+	"hotmail.com":    {subaddress: true},
+	"live.com":       {subaddress: true},
+	"icloud.com":     {subaddress: true},
+	"me.com":         {canonical: "icloud.com", subaddress: true},
+	"mac.com":        {canonical: "icloud.com", subaddress: true},
+	"fastmail.com":   {subaddress: true},
+	"proton.me":      {subaddress: true},
+	"protonmail.com": {canonical: "proton.me", subaddress: true},
+}
+
+// normalizeDomain lower-cases the domain and converts IDNs to their
+// A-label form, so "BÜCHER.example" and "xn--bcher-kva.example" match.
+// A domain IDNA rejects is kept lower-cased as is.
+func normalizeDomain(d string) string {
+	d = strings.TrimSuffix(strings.ToLower(d), ".")
+	if a, err := idna.Lookup.ToASCII(d); err == nil {
+		return a
+	}
+	return d
+}
+
+var folder = cases.Fold()
+
+func fold(s string) string {
+	return norm.NFKC.String(folder.String(s))
+}
+
+// Skeleton returns the UTS #39 skeleton of s: NFD, every code point
+// replaced by its confusable prototype, NFD again. s is expected to be a
+// key already; the prototypes are folded as well (confusables.txt maps
+// Cyrillic "О" to "O"), so the result compares case-insensitively.
+func Skeleton(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if p, ok := confusables[r]; ok {
+			b.WriteString(p)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return norm.NFD.String(folder.String(b.String()))
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "testing"
+
+func TestSkeleton(t *testing.T) {
+	cases := []struct {
+		a, b string
+		same bool
+	}{
+		{"admin", "аdmin", true},   // Cyrillic а
+		{"paypal", "раураl", true}, // Cyrillic р, а, у
+		{"nico", "nicο", true},     // Greek ο
+		{"bob", "bОb", true},       // Cyrillic О, folded
+		{"bob1", "bobl", false},
+		{"mallory", "rnallory", false},
+		{"b0b", "bob", false},
+		{"alice", "bob", false},
+	}
+	for _, tc := range cases {
+		if got := Skeleton(UsernameKey(tc.a)) == Skeleton(UsernameKey(tc.b)); got != tc.same {
+			t.Errorf("%q vs %q: same skeleton = %v, want %v", tc.a, tc.b, got, tc.same)
+		}
+	}
+}
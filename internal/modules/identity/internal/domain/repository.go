@@ -45,10 +45,12 @@ type ListQuery struct {
 	DisplayNames []string
 	Statuses     []UserStatus
 	OrderBy      ListOrderBy
+	Offset       int  // matches skipped on the first page (After == nil)
+	CountTotal   bool // fill ListResult.TotalSize with a COUNT of every match
 }
 
 // ListResult is the output of Repository.List. NextCursor is nil on the last
-// page; TotalSize is nil when the repository chose not to compute it
+// page; TotalSize is nil unless ListQuery.CountTotal asked for it
 // (consistent with the optional total_size in the proto response).
 type ListResult struct {
 	Users      []*User
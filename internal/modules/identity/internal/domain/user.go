@@ -47,8 +47,8 @@ func (u UserID) String() string { return string(u) }
 // Field visibility split:
 //
 //   Unexported (only the aggregate itself can change them):
-//     id, status, etag, createdAt, updatedAt
-//   These are either immutable after construction (id, createdAt) or
+//     id, tenantID, status, etag, createdAt, updatedAt, unkeyed
+//   These are either immutable after construction (id, tenantID, createdAt) or
 //   advanced exclusively by behavioural helpers (Disable/Enable/SoftDelete
 //   set status; bumpVersion advances etag and updatedAt). Direct
 //   assignment would silently break the optimistic-concurrency contract.
@@ -61,12 +61,14 @@ func (u UserID) String() string { return string(u) }
 
 type User struct {
 	id                  UserID
+	tenantID            string
 	status              UserStatus
 	etag                etag.Etag
 	createdAt           time.Time
 	updatedAt           time.Time
 	passwordHash        []byte // nil/empty = no password set (admin-created user awaiting reset)
 	failedLoginAttempts int
+	unkeyed             bool // row predates identifier keys; see Keys
 
 	Email        string
 	Username     string
@@ -86,8 +88,12 @@ type User struct {
 // IdentityService.CreateUser leaves it nil (admin-created accounts must
 // go through ResetPasswordWithRecoveryCode or a similar flow before
 // they can Login).
+//
+// TenantID is the tenant the user is created in, normally
+// tenant.Home(ctx) of the creating request.
 type NewUserParams struct {
 	ID           UserID
+	TenantID     string
 	Email        string
 	Username     string
 	DisplayName  string
@@ -99,17 +105,19 @@ type NewUserParams struct {
 }
 
 // NewUser constructs a fresh User. Status defaults to ACTIVE; created_at /
-// updated_at stamped from Now; etag freshly minted.
+// updated_at stamped from Now; etag freshly minted. Email and Username are
+// stored in normalised form (NormalizeEmail / NormalizeUsername).
 func NewUser(p NewUserParams) *User {
 	return &User{
 		id:           p.ID,
+		tenantID:     p.TenantID,
 		status:       UserStatusActive,
 		etag:         etag.New(),
 		createdAt:    p.Now,
 		updatedAt:    p.Now,
 		passwordHash: p.PasswordHash,
-		Email:        p.Email,
-		Username:     p.Username,
+		Email:        NormalizeEmail(p.Email),
+		Username:     NormalizeUsername(p.Username),
 		DisplayName:  p.DisplayName,
 		AvatarURL:    p.AvatarURL,
 		Locale:       p.Locale,
@@ -120,6 +128,7 @@ func NewUser(p NewUserParams) *User {
 // RestoreUserParams carries the full row read back from the repository.
 type RestoreUserParams struct {
 	ID                  UserID
+	TenantID            string
 	Email               string
 	Username            string
 	DisplayName         string
@@ -134,6 +143,9 @@ type RestoreUserParams struct {
 	LastLoginAt         time.Time
 	FailedLoginAttempts int
 	LockoutUntil        time.Time
+	// Unkeyed marks a row whose identifier keys were never computed
+	// (written before migration 0015 and not yet backfilled).
+	Unkeyed bool
 }
 
 // RestoreUser rebuilds a User from a persisted row. No validation: the row
@@ -141,6 +153,7 @@ type RestoreUserParams struct {
 func RestoreUser(p RestoreUserParams) *User {
 	return &User{
 		id:                  p.ID,
+		tenantID:            p.TenantID,
 		status:              p.Status,
 		etag:                p.Etag,
 		createdAt:           p.CreatedAt,
@@ -155,11 +168,13 @@ func RestoreUser(p RestoreUserParams) *User {
 		LastLoginAt:         p.LastLoginAt,
 		failedLoginAttempts: p.FailedLoginAttempts,
 		LockoutUntil:        p.LockoutUntil,
+		unkeyed:             p.Unkeyed,
 	}
 }
 
 // Read-only accessors for the unexported invariant-bearing fields.
 func (u *User) ID() UserID               { return u.id }
+func (u *User) TenantID() string         { return u.tenantID }
 func (u *User) Status() UserStatus       { return u.status }
 func (u *User) Etag() etag.Etag          { return u.etag }
 func (u *User) CreatedAt() time.Time     { return u.createdAt }
@@ -172,6 +187,18 @@ func (u *User) FailedLoginAttempts() int { return u.failedLoginAttempts }
 // crypto imports.
 func (u *User) PasswordHash() []byte { return u.passwordHash }
 
+// Keys returns the identifier keys to persist with the row. ok is false
+// for an unkeyed legacy row whose Email and Username have not changed:
+// its keys stay NULL until identity-normalize backfills them, so a row
+// that collides with another can still be written (disabled, deleted)
+// while the collision is being sorted out.
+func (u *User) Keys() (keys IdentifierKeys, ok bool) {
+	if u.unkeyed {
+		return IdentifierKeys{}, false
+	}
+	return KeysFor(u.Email, u.Username), true
+}
+
 // HasPassword reports whether the user has a password on file. Useful
 // at Login: a user without a password can never authenticate via the
 // password grant and must be funnelled to ResetPasswordWithRecoveryCode.
@@ -240,18 +267,25 @@ func (u *User) SoftDelete(now time.Time) {
 
 // ApplyPatch applies the supplied changes. Rejects DELETED users (lifecycle
 // rule); bumps etag/updated_at only when at least one field actually changes.
+// Email and Username are normalised before the comparison.
 func (u *User) ApplyPatch(p UserPatch, now time.Time) error {
 	if u.status == UserStatusDeleted {
 		return ErrUserDeleted
 	}
 	changed := false
-	if p.Email != nil && *p.Email != u.Email {
-		u.Email = *p.Email
-		changed = true
+	if p.Email != nil {
+		if v := NormalizeEmail(*p.Email); v != u.Email {
+			u.Email = v
+			u.unkeyed = false
+			changed = true
+		}
 	}
-	if p.Username != nil && *p.Username != u.Username {
-		u.Username = *p.Username
-		changed = true
+	if p.Username != nil {
+		if v := NormalizeUsername(*p.Username); v != u.Username {
+			u.Username = v
+			u.unkeyed = false
+			changed = true
+		}
 	}
 	if p.DisplayName != nil && *p.DisplayName != u.DisplayName {
 		u.DisplayName = *p.DisplayName
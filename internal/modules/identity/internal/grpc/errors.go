@@ -39,9 +39,31 @@ var errorMap = map[error]grpcerr.ErrorMapping{
 		Reason:  ssocommonv1.ErrorReason_ERROR_REASON_USER_DELETED,
 		Message: "user is not in deleted state",
 	},
+	domain.ErrUserActorRequired: {
+		Code:    codes.PermissionDenied,
+		Reason:  ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED,
+		Message: "a user token is required",
+	},
+	// errors.proto has no email-change reasons yet: bare statuses.
+	domain.ErrEmailChangeTokenInvalid: {
+		Code: codes.InvalidArgument, Message: "invalid email change token"},
+	domain.ErrEmailChangeExpired: {
+		Code: codes.FailedPrecondition, Message: "email change has expired"},
+	domain.ErrEmailChangeNotPending: {
+		Code: codes.FailedPrecondition, Message: "email change is no longer pending"},
+	domain.ErrEmailChangeCancelWindowClosed: {
+		Code: codes.FailedPrecondition, Message: "email change can no longer be cancelled"},
+	domain.ErrDeliveryFailed: {
+		Code: codes.Unavailable, Message: "confirmation mail could not be sent"},
 }
 
 // toGRPCError is the per-package thin wrapper around grpcerr.MapError.
 func toGRPCError(err error) error {
 	return grpcerr.MapError(err, errorMap)
 }
+
+// ToStatus exposes the same mapping to the HTTP adapter (httpapi), so
+// /v1/me reports errors exactly as the IdentityService RPCs do.
+func ToStatus(err error) error {
+	return toGRPCError(err)
+}
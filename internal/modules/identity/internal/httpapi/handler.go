@@ -0,0 +1,192 @@
+// Package httpapi is the HTTP adapter for the self-service part of the
+// identity context: the signed-in user's own profile under /v1/me and
+// the verified email change.
+//
+// IdentityService in sso_protos has no GetMe/UpdateMe or email-change
+// RPCs, so these are hand-written net/http handlers mounted next to the
+// grpc-gateway. The user is rendered exactly as the gateway renders
+// GetUser, and error bodies use the same google.rpc.Status JSON shape.
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	grpcadapter "sso/internal/modules/identity/internal/grpc"
+	identityapp "sso/internal/modules/identity/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+type Handler struct {
+	svc *identityapp.Service
+	api *apiutil.Adapter
+	log *slog.Logger
+}
+
+func NewHandler(svc *identityapp.Service, authn Authenticator, authz Authorizer, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("identity", authn, authz, log, grpcadapter.ToStatus), log: log}
+}
+
+// Register mounts the self-service endpoints (bearer or cookie session):
+//
+//	GET   /v1/me
+//	PATCH /v1/me         {update_mask, etag, display_name, avatar_url, locale, timezone}
+//	POST  /v1/me/email   {new_email, revoke_sessions}
+//
+// and the two public, token-authenticated steps of an email change:
+//
+//	POST /v1/email-change/confirm  {token}   link mailed to the new address
+//	POST /v1/email-change/cancel   {token}   link mailed to the old address
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/me", h.api.Authed(h.getMe))
+	mux.HandleFunc("PATCH /v1/me", h.api.Authed(h.updateMe))
+	mux.HandleFunc("POST /v1/me/email", h.api.Authed(h.requestEmailChange))
+	mux.HandleFunc("POST /v1/email-change/confirm", h.confirmEmailChange)
+	mux.HandleFunc("POST /v1/email-change/cancel", h.cancelEmailChange)
+}
+
+func (h *Handler) getMe(w http.ResponseWriter, r *http.Request) {
+	u, err := h.svc.GetMe(r.Context())
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	writeProto(w, http.StatusOK, grpcadapter.UserToProto(u))
+}
+
+type updateMeBody struct {
+	UpdateMask  string `json:"update_mask"`
+	Etag        string `json:"etag"`
+	DisplayName string `json:"display_name"`
+	AvatarURL   string `json:"avatar_url"`
+	Locale      string `json:"locale"`
+	Timezone    string `json:"timezone"`
+}
+
+func (h *Handler) updateMe(w http.ResponseWriter, r *http.Request) {
+	var b updateMeBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	u, err := h.svc.UpdateMe(r.Context(), identityapp.UpdateMeInput{
+		MaskPaths:    apiutil.SplitList(b.UpdateMask),
+		ExpectedEtag: b.Etag,
+		DisplayName:  b.DisplayName,
+		AvatarURL:    b.AvatarURL,
+		Locale:       b.Locale,
+		Timezone:     b.Timezone,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	writeProto(w, http.StatusOK, grpcadapter.UserToProto(u))
+}
+
+type requestEmailChangeBody struct {
+	NewEmail       string `json:"new_email"`
+	RevokeSessions bool   `json:"revoke_sessions"`
+}
+
+type emailChangeJSON struct {
+	NewEmail    string    `json:"new_email"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CancelUntil time.Time `json:"cancel_until"`
+}
+
+// requestEmailChange answers 202 with the pending change, or 204 when
+// new_email already is the caller's address.
+func (h *Handler) requestEmailChange(w http.ResponseWriter, r *http.Request) {
+	var b requestEmailChangeBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	c, err := h.svc.RequestEmailChange(r.Context(), identityapp.RequestEmailChangeInput{
+		NewEmail:       b.NewEmail,
+		RevokeSessions: b.RevokeSessions,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	if c == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusAccepted, emailChangeJSON{
+		NewEmail:    c.NewEmail,
+		ExpiresAt:   c.ExpiresAt(),
+		CancelUntil: c.CancelUntil(),
+	})
+}
+
+type tokenBody struct {
+	Token string `json:"token"`
+}
+
+// confirmEmailChange and cancelEmailChange answer 204: the token holder
+// is not signed in and gets no account data back.
+func (h *Handler) confirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	var b tokenBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	if _, err := h.svc.ConfirmEmailChange(r.Context(), identityapp.ConfirmEmailChangeInput{
+		Token:     b.Token,
+		IpAddress: apiutil.ClientIP(r),
+		UserAgent: r.UserAgent(),
+	}); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) cancelEmailChange(w http.ResponseWriter, r *http.Request) {
+	var b tokenBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	if err := h.svc.CancelEmailChange(r.Context(), identityapp.CancelEmailChangeInput{
+		Token:     b.Token,
+		IpAddress: apiutil.ClientIP(r),
+		UserAgent: r.UserAgent(),
+	}); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ----------------------------------------------------------------------------
+// Plumbing
+// ----------------------------------------------------------------------------
+
+// writeProto renders m with the gateway's default marshaler options, so
+// /v1/me and /v1/users/{id} return the same document.
+func writeProto(w http.ResponseWriter, code int, m proto.Message) {
+	body, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		code, body = http.StatusInternalServerError, []byte(`{"code":13,"message":"internal error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_, _ = w.Write(body)
+}
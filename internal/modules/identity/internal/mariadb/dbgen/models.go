@@ -67,6 +67,8 @@ type RoleAssignment struct {
 	AppID           string
 	GrantedByUserID string
 	GrantedAt       time.Time
+	NotBefore       sql.NullTime
+	ExpiresAt       sql.NullTime
 }
 
 type RolePermission struct {
@@ -115,4 +117,27 @@ type User struct {
 	LastLoginAt         sql.NullTime
 	FailedLoginAttempts int32
 	LockoutUntil        sql.NullTime
+	EmailKey            sql.NullString
+	UsernameKey         sql.NullString
+	EmailSkeleton       sql.NullString
+	UsernameSkeleton    sql.NullString
+	TenantID            string
+}
+
+type UserEmailChange struct {
+	ID               string
+	UserID           string
+	OldEmail         string
+	NewEmail         string
+	Status           uint8
+	ConfirmTokenHash []byte
+	CancelTokenHash  []byte
+	RevokeSessions   bool
+	RequestedBy      string
+	ExpiresAt        time.Time
+	CancelUntil      time.Time
+	ConfirmedAt      sql.NullTime
+	CancelledAt      sql.NullTime
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
@@ -28,24 +28,30 @@ const createUser = `-- name: CreateUser :exec
 
 INSERT INTO users
     (id, email, username, password_hash, display_name, avatar_url, locale, timezone,
-     status, etag, created_at, updated_at, last_login_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+     status, etag, created_at, updated_at, last_login_at,
+     email_key, username_key, email_skeleton, username_skeleton, tenant_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateUserParams struct {
-	ID           string
-	Email        string
-	Username     string
-	PasswordHash sql.NullString
-	DisplayName  string
-	AvatarUrl    sql.NullString
-	Locale       string
-	Timezone     string
-	Status       uint8
-	Etag         string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	LastLoginAt  sql.NullTime
+	ID               string
+	Email            string
+	Username         string
+	PasswordHash     sql.NullString
+	DisplayName      string
+	AvatarUrl        sql.NullString
+	Locale           string
+	Timezone         string
+	Status           uint8
+	Etag             string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	LastLoginAt      sql.NullTime
+	EmailKey         sql.NullString
+	UsernameKey      sql.NullString
+	EmailSkeleton    sql.NullString
+	UsernameSkeleton sql.NullString
+	TenantID         string
 }
 
 // Identity directory: per-row queries. Dynamic ListUsers lives in the
@@ -66,6 +72,11 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.LastLoginAt,
+		arg.EmailKey,
+		arg.UsernameKey,
+		arg.EmailSkeleton,
+		arg.UsernameSkeleton,
+		arg.TenantID,
 	)
 	return err
 }
@@ -103,15 +114,26 @@ func (q *Queries) GetFailedLoginAttempts(ctx context.Context, id string) (int32,
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, username, password_hash, display_name, avatar_url, locale, timezone, status, etag, created_at, updated_at, last_login_at, failed_login_attempts, lockout_until FROM users
-WHERE email = ?
+SELECT id, email, username, password_hash, display_name, avatar_url, locale, timezone, status, etag, created_at, updated_at, last_login_at, failed_login_attempts, lockout_until, email_key, username_key, email_skeleton, username_skeleton, tenant_id FROM users
+WHERE tenant_id = ?
+  AND (email_key = ? OR (email_key IS NULL AND email = ?))
+ORDER BY email_key IS NULL
+LIMIT 1
 `
 
+type GetUserByEmailParams struct {
+	TenantID string
+	EmailKey sql.NullString
+	Email    string
+}
+
 // Used by AuthService.Login when the caller authenticates by email.
 // Returns the full row including password_hash so the use-case can run
-// bcrypt verification without a follow-up Get.
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByEmail, email)
+// bcrypt verification without a follow-up Get. Matches on email_key;
+// rows not yet backfilled (NULL key) fall back to the stored email.
+// Emails are unique per tenant, so the lookup names one.
+func (q *Queries) GetUserByEmail(ctx context.Context, arg GetUserByEmailParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByEmail, arg.TenantID, arg.EmailKey, arg.Email)
 	var i User
 	err := row.Scan(
 		&i.ID,
@@ -129,12 +151,17 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.LastLoginAt,
 		&i.FailedLoginAttempts,
 		&i.LockoutUntil,
+		&i.EmailKey,
+		&i.UsernameKey,
+		&i.EmailSkeleton,
+		&i.UsernameSkeleton,
+		&i.TenantID,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, username, password_hash, display_name, avatar_url, locale, timezone, status, etag, created_at, updated_at, last_login_at, failed_login_attempts, lockout_until FROM users
+SELECT id, email, username, password_hash, display_name, avatar_url, locale, timezone, status, etag, created_at, updated_at, last_login_at, failed_login_attempts, lockout_until, email_key, username_key, email_skeleton, username_skeleton, tenant_id FROM users
 WHERE id = ?
 `
 
@@ -157,18 +184,33 @@ func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
 		&i.LastLoginAt,
 		&i.FailedLoginAttempts,
 		&i.LockoutUntil,
+		&i.EmailKey,
+		&i.UsernameKey,
+		&i.EmailSkeleton,
+		&i.UsernameSkeleton,
+		&i.TenantID,
 	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, email, username, password_hash, display_name, avatar_url, locale, timezone, status, etag, created_at, updated_at, last_login_at, failed_login_attempts, lockout_until FROM users
-WHERE username = ?
+SELECT id, email, username, password_hash, display_name, avatar_url, locale, timezone, status, etag, created_at, updated_at, last_login_at, failed_login_attempts, lockout_until, email_key, username_key, email_skeleton, username_skeleton, tenant_id FROM users
+WHERE tenant_id = ?
+  AND (username_key = ? OR (username_key IS NULL AND username = ?))
+ORDER BY username_key IS NULL
+LIMIT 1
 `
 
+type GetUserByUsernameParams struct {
+	TenantID    string
+	UsernameKey sql.NullString
+	Username    string
+}
+
 // Used by AuthService.Login when the caller authenticates by username.
-func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByUsername, username)
+// Same key-then-legacy matching, and tenant, as GetUserByEmail.
+func (q *Queries) GetUserByUsername(ctx context.Context, arg GetUserByUsernameParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByUsername, arg.TenantID, arg.UsernameKey, arg.Username)
 	var i User
 	err := row.Scan(
 		&i.ID,
@@ -186,6 +228,11 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 		&i.LastLoginAt,
 		&i.FailedLoginAttempts,
 		&i.LockoutUntil,
+		&i.EmailKey,
+		&i.UsernameKey,
+		&i.EmailSkeleton,
+		&i.UsernameSkeleton,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -229,23 +276,30 @@ const updateUser = `-- name: UpdateUser :execresult
 UPDATE users SET
     email = ?, username = ?, display_name = ?, password_hash = ?, avatar_url = ?,
     locale = ?, timezone = ?, status = ?, etag = ?,
-    updated_at = ?, last_login_at = ?
+    updated_at = ?, last_login_at = ?,
+    email_key = COALESCE(?, email_key), username_key = COALESCE(?, username_key),
+    email_skeleton = COALESCE(?, email_skeleton),
+    username_skeleton = COALESCE(?, username_skeleton)
 WHERE id = ?
 `
 
 type UpdateUserParams struct {
-	Email        string
-	Username     string
-	DisplayName  string
-	PasswordHash sql.NullString
-	AvatarUrl    sql.NullString
-	Locale       string
-	Timezone     string
-	Status       uint8
-	Etag         string
-	UpdatedAt    time.Time
-	LastLoginAt  sql.NullTime
-	ID           string
+	Email            string
+	Username         string
+	DisplayName      string
+	PasswordHash     sql.NullString
+	AvatarUrl        sql.NullString
+	Locale           string
+	Timezone         string
+	Status           uint8
+	Etag             string
+	UpdatedAt        time.Time
+	LastLoginAt      sql.NullTime
+	EmailKey         sql.NullString
+	UsernameKey      sql.NullString
+	EmailSkeleton    sql.NullString
+	UsernameSkeleton sql.NullString
+	ID               string
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (sql.Result, error) {
@@ -261,6 +315,10 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (sql.Res
 		arg.Etag,
 		arg.UpdatedAt,
 		arg.LastLoginAt,
+		arg.EmailKey,
+		arg.UsernameKey,
+		arg.EmailSkeleton,
+		arg.UsernameSkeleton,
 		arg.ID,
 	)
 }
@@ -308,24 +366,31 @@ const updateUserWithEtag = `-- name: UpdateUserWithEtag :execresult
 UPDATE users SET
     email = ?, username = ?, display_name = ?, password_hash = ?, avatar_url = ?,
     locale = ?, timezone = ?, status = ?, etag = ?,
-    updated_at = ?, last_login_at = ?
+    updated_at = ?, last_login_at = ?,
+    email_key = COALESCE(?, email_key), username_key = COALESCE(?, username_key),
+    email_skeleton = COALESCE(?, email_skeleton),
+    username_skeleton = COALESCE(?, username_skeleton)
 WHERE id = ? AND etag = ?
 `
 
 type UpdateUserWithEtagParams struct {
-	Email        string
-	Username     string
-	DisplayName  string
-	PasswordHash sql.NullString
-	AvatarUrl    sql.NullString
-	Locale       string
-	Timezone     string
-	Status       uint8
-	Etag         string
-	UpdatedAt    time.Time
-	LastLoginAt  sql.NullTime
-	ID           string
-	Etag_2       string
+	Email            string
+	Username         string
+	DisplayName      string
+	PasswordHash     sql.NullString
+	AvatarUrl        sql.NullString
+	Locale           string
+	Timezone         string
+	Status           uint8
+	Etag             string
+	UpdatedAt        time.Time
+	LastLoginAt      sql.NullTime
+	EmailKey         sql.NullString
+	UsernameKey      sql.NullString
+	EmailSkeleton    sql.NullString
+	UsernameSkeleton sql.NullString
+	ID               string
+	Etag_2           string
 }
 
 func (q *Queries) UpdateUserWithEtag(ctx context.Context, arg UpdateUserWithEtagParams) (sql.Result, error) {
@@ -341,6 +406,10 @@ func (q *Queries) UpdateUserWithEtag(ctx context.Context, arg UpdateUserWithEtag
 		arg.Etag,
 		arg.UpdatedAt,
 		arg.LastLoginAt,
+		arg.EmailKey,
+		arg.UsernameKey,
+		arg.EmailSkeleton,
+		arg.UsernameSkeleton,
 		arg.ID,
 		arg.Etag_2,
 	)
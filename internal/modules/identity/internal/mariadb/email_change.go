@@ -0,0 +1,115 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/identity/internal/domain"
+	"sso/internal/modules/identity/internal/mariadb/dbgen"
+)
+
+// Compile-time check.
+var _ domain.EmailChangeRepository = (*Repository)(nil)
+
+func (r *Repository) CreateEmailChange(ctx context.Context, c *domain.EmailChange) error {
+	err := r.queries(ctx).CreateEmailChange(ctx, dbgen.CreateEmailChangeParams{
+		ID:               c.ID().String(),
+		UserID:           c.UserID().String(),
+		OldEmail:         c.OldEmail,
+		NewEmail:         c.NewEmail,
+		Status:           uint8(c.Status()),
+		ConfirmTokenHash: c.ConfirmHash(),
+		CancelTokenHash:  c.CancelHash(),
+		RevokeSessions:   c.RevokeSessions(),
+		RequestedBy:      c.RequestedBy(),
+		ExpiresAt:        c.ExpiresAt(),
+		CancelUntil:      c.CancelUntil(),
+		ConfirmedAt:      dbutil.TimeToNullTime(c.ConfirmedAt()),
+		CancelledAt:      dbutil.TimeToNullTime(c.CancelledAt()),
+		CreatedAt:        c.CreatedAt(),
+		UpdatedAt:        c.UpdatedAt(),
+	})
+	if err != nil {
+		return fmt.Errorf("identity repo: create_email_change: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) SupersedePendingEmailChanges(ctx context.Context, userID domain.UserID, now time.Time) error {
+	err := r.queries(ctx).SupersedePendingEmailChanges(ctx, dbgen.SupersedePendingEmailChangesParams{
+		CancelledAt: dbutil.TimeToNullTime(now),
+		UpdatedAt:   now,
+		UserID:      userID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("identity repo: supersede_email_changes: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetEmailChangeByConfirmHashForUpdate(ctx context.Context, hash []byte) (*domain.EmailChange, error) {
+	row, err := r.queries(ctx).GetEmailChangeByConfirmHashForUpdate(ctx, hash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrEmailChangeTokenInvalid
+		}
+		return nil, fmt.Errorf("identity repo: get_email_change_by_confirm_hash: %w", err)
+	}
+	return emailChangeToDomain(row), nil
+}
+
+func (r *Repository) GetEmailChangeByCancelHashForUpdate(ctx context.Context, hash []byte) (*domain.EmailChange, error) {
+	row, err := r.queries(ctx).GetEmailChangeByCancelHashForUpdate(ctx, hash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrEmailChangeTokenInvalid
+		}
+		return nil, fmt.Errorf("identity repo: get_email_change_by_cancel_hash: %w", err)
+	}
+	return emailChangeToDomain(row), nil
+}
+
+func (r *Repository) UpdateEmailChange(ctx context.Context, c *domain.EmailChange) error {
+	_, err := r.queries(ctx).UpdateEmailChange(ctx, dbgen.UpdateEmailChangeParams{
+		Status:      uint8(c.Status()),
+		ConfirmedAt: dbutil.TimeToNullTime(c.ConfirmedAt()),
+		CancelledAt: dbutil.TimeToNullTime(c.CancelledAt()),
+		UpdatedAt:   c.UpdatedAt(),
+		ID:          c.ID().String(),
+	})
+	if err != nil {
+		return fmt.Errorf("identity repo: update_email_change: %w", err)
+	}
+	return nil
+}
+
+func emailChangeToDomain(row dbgen.UserEmailChange) *domain.EmailChange {
+	var confirmedAt, cancelledAt time.Time
+	if row.ConfirmedAt.Valid {
+		confirmedAt = row.ConfirmedAt.Time
+	}
+	if row.CancelledAt.Valid {
+		cancelledAt = row.CancelledAt.Time
+	}
+	return domain.RestoreEmailChange(domain.RestoreEmailChangeParams{
+		ID:             domain.EmailChangeID(row.ID),
+		UserID:         domain.UserID(row.UserID),
+		Status:         domain.EmailChangeStatus(row.Status),
+		OldEmail:       row.OldEmail,
+		NewEmail:       row.NewEmail,
+		ConfirmHash:    row.ConfirmTokenHash,
+		CancelHash:     row.CancelTokenHash,
+		RevokeSessions: row.RevokeSessions,
+		RequestedBy:    row.RequestedBy,
+		ExpiresAt:      row.ExpiresAt,
+		CancelUntil:    row.CancelUntil,
+		ConfirmedAt:    confirmedAt,
+		CancelledAt:    cancelledAt,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	})
+}
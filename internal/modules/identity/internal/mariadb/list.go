@@ -8,6 +8,7 @@ import (
 	"sso/internal/modules/identity/internal/domain"
 	"sso/internal/modules/identity/internal/mariadb/dbgen"
 	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/tenant"
 )
 
 // listSelectCols mirrors the column list used by the sqlc GetUserByID query.
@@ -15,7 +16,7 @@ import (
 // dbgen.User struct field order.
 const listSelectCols = `
     id, email, username, display_name, avatar_url, locale, timezone,
-    status, etag, created_at, updated_at, last_login_at`
+    status, etag, created_at, updated_at, last_login_at, email_key, tenant_id`
 
 // List paginates the identity directory. Hand-written rather than sqlc-
 // generated because the WHERE / ORDER BY shape varies per request (filters
@@ -25,7 +26,7 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 		return domain.ListResult{}, fmt.Errorf("identity repo: list: page_size must be > 0")
 	}
 
-	where, args := buildWhere(q)
+	where, args := buildWhere(q, tenant.Scope(ctx))
 	orderBy := orderClauseFor(q.OrderBy)
 
 	// Fetch one extra row to detect "has next page".
@@ -33,6 +34,9 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 
 	query := fmt.Sprintf(`SELECT %s FROM users WHERE %s ORDER BY %s LIMIT %d`,
 		listSelectCols, strings.Join(where, " AND "), orderBy, limit)
+	if q.After == nil && q.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", q.Offset)
+	}
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -46,7 +50,7 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 		if err := rows.Scan(
 			&u.ID, &u.Email, &u.Username, &u.DisplayName, &u.AvatarUrl,
 			&u.Locale, &u.Timezone, &u.Status, &u.Etag,
-			&u.CreatedAt, &u.UpdatedAt, &u.LastLoginAt,
+			&u.CreatedAt, &u.UpdatedAt, &u.LastLoginAt, &u.EmailKey, &u.TenantID,
 		); err != nil {
 			return domain.ListResult{}, fmt.Errorf("identity repo: list: scan: %w", err)
 		}
@@ -69,19 +73,47 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 		}
 	}
 
-	return domain.ListResult{Users: users, NextCursor: nextCursor}, nil
+	res := domain.ListResult{Users: users, NextCursor: nextCursor}
+	if q.CountTotal {
+		total, err := r.count(ctx, q)
+		if err != nil {
+			return domain.ListResult{}, err
+		}
+		res.TotalSize = &total
+	}
+	return res, nil
+}
+
+// count is the number of users matching q's filters, whatever page q
+// is on.
+func (r *Repository) count(ctx context.Context, q domain.ListQuery) (int, error) {
+	q.After = nil
+	where, args := buildWhere(q, tenant.Scope(ctx))
+	var n int
+	query := `SELECT COUNT(*) FROM users WHERE ` + strings.Join(where, " AND ")
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&n); err != nil {
+		return 0, fmt.Errorf("identity repo: list: count: %w", err)
+	}
+	return n, nil
 }
 
 // buildWhere assembles the filter portion of a ListUsers SELECT. Returns the
 // list of AND-joined predicates and the matching positional args. The slice
 // is never empty: it always contains at least the status filter (the
-// proto-default "exclude DELETED" or the explicit list).
-func buildWhere(q domain.ListQuery) ([]string, []any) {
+// proto-default "exclude DELETED" or the explicit list). tenantID is
+// tenant.Scope; "" lists every tenant.
+func buildWhere(q domain.ListQuery, tenantID string) ([]string, []any) {
 	var (
 		where []string
 		args  []any
 	)
 
+	// --- tenant -----------------------------------------------------------
+	if tenantID != "" {
+		where = append(where, "tenant_id = ?")
+		args = append(args, tenantID)
+	}
+
 	// --- status -----------------------------------------------------------
 	if len(q.Statuses) == 0 {
 		where = append(where, "status != ?")
@@ -108,13 +140,13 @@ func buildWhere(q domain.ListQuery) ([]string, []any) {
 	if len(q.Emails) > 0 {
 		where = append(where, "email IN ("+placeholders(len(q.Emails))+")")
 		for _, v := range q.Emails {
-			args = append(args, v)
+			args = append(args, domain.NormalizeEmail(v))
 		}
 	}
 	if len(q.Usernames) > 0 {
 		where = append(where, "username IN ("+placeholders(len(q.Usernames))+")")
 		for _, v := range q.Usernames {
-			args = append(args, v)
+			args = append(args, domain.NormalizeUsername(v))
 		}
 	}
 	if len(q.DisplayNames) > 0 {
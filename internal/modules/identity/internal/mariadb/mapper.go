@@ -1,6 +1,7 @@
 package mariadb
 
 import (
+	"database/sql"
 	"time"
 
 	"sso/internal/kernel/dbutil"
@@ -33,6 +34,7 @@ func dbgenToDomain(u dbgen.User) *domain.User {
 
 	return domain.RestoreUser(domain.RestoreUserParams{
 		ID:                  domain.UserID(u.ID),
+		TenantID:            u.TenantID,
 		Email:               u.Email,
 		Username:            u.Username,
 		DisplayName:         u.DisplayName,
@@ -47,44 +49,76 @@ func dbgenToDomain(u dbgen.User) *domain.User {
 		LastLoginAt:         lastLogin,
 		FailedLoginAttempts: int(u.FailedLoginAttempts),
 		LockoutUntil:        lockoutUntil,
+		Unkeyed:             !u.EmailKey.Valid,
 	})
 }
 
+// keyParams holds the identifier-key columns of a write. All NULL when
+// the aggregate reports no keys (unkeyed legacy row), which the
+// COALESCE in the UPDATE statements turns into "leave as is".
+type keyParams struct {
+	emailKey, usernameKey, emailSkeleton, usernameSkeleton sql.NullString
+}
+
+func toKeyParams(u *domain.User) keyParams {
+	k, ok := u.Keys()
+	if !ok {
+		return keyParams{}
+	}
+	return keyParams{
+		emailKey:         dbutil.StringToNullString(k.EmailKey),
+		usernameKey:      dbutil.StringToNullString(k.UsernameKey),
+		emailSkeleton:    dbutil.StringToNullString(k.EmailSkeleton),
+		usernameSkeleton: dbutil.StringToNullString(k.UsernameSkeleton),
+	}
+}
+
 // toCreateParams flattens a domain.User into the sqlc CreateUser arg shape.
 func toCreateParams(u *domain.User) dbgen.CreateUserParams {
+	k := toKeyParams(u)
 	return dbgen.CreateUserParams{
-		ID:           u.ID().String(),
-		Email:        u.Email,
-		Username:     u.Username,
-		DisplayName:  u.DisplayName,
-		PasswordHash: dbutil.BytesToNullString(u.PasswordHash()),
-		AvatarUrl:    dbutil.StringToNullString(u.AvatarURL),
-		Locale:       u.Locale,
-		Timezone:     u.Timezone,
-		Status:       uint8(u.Status()),
-		Etag:         u.Etag().String(),
-		CreatedAt:    u.CreatedAt(),
-		UpdatedAt:    u.UpdatedAt(),
-		LastLoginAt:  dbutil.TimeToNullTime(u.LastLoginAt),
+		ID:               u.ID().String(),
+		Email:            u.Email,
+		Username:         u.Username,
+		DisplayName:      u.DisplayName,
+		PasswordHash:     dbutil.BytesToNullString(u.PasswordHash()),
+		AvatarUrl:        dbutil.StringToNullString(u.AvatarURL),
+		Locale:           u.Locale,
+		Timezone:         u.Timezone,
+		Status:           uint8(u.Status()),
+		Etag:             u.Etag().String(),
+		CreatedAt:        u.CreatedAt(),
+		UpdatedAt:        u.UpdatedAt(),
+		LastLoginAt:      dbutil.TimeToNullTime(u.LastLoginAt),
+		EmailKey:         k.emailKey,
+		UsernameKey:      k.usernameKey,
+		EmailSkeleton:    k.emailSkeleton,
+		UsernameSkeleton: k.usernameSkeleton,
+		TenantID:         u.TenantID(),
 	}
 }
 
 // toUpdateParams flattens a domain.User into the sqlc UpdateUser arg shape
 // (no etag in WHERE — unconditional update).
 func toUpdateParams(u *domain.User) dbgen.UpdateUserParams {
+	k := toKeyParams(u)
 	return dbgen.UpdateUserParams{
-		Email:        u.Email,
-		Username:     u.Username,
-		DisplayName:  u.DisplayName,
-		PasswordHash: dbutil.BytesToNullString(u.PasswordHash()),
-		AvatarUrl:    dbutil.StringToNullString(u.AvatarURL),
-		Locale:       u.Locale,
-		Timezone:     u.Timezone,
-		Status:       uint8(u.Status()),
-		Etag:         u.Etag().String(),
-		UpdatedAt:    u.UpdatedAt(),
-		LastLoginAt:  dbutil.TimeToNullTime(u.LastLoginAt),
-		ID:           u.ID().String(),
+		Email:            u.Email,
+		Username:         u.Username,
+		DisplayName:      u.DisplayName,
+		PasswordHash:     dbutil.BytesToNullString(u.PasswordHash()),
+		AvatarUrl:        dbutil.StringToNullString(u.AvatarURL),
+		Locale:           u.Locale,
+		Timezone:         u.Timezone,
+		Status:           uint8(u.Status()),
+		Etag:             u.Etag().String(),
+		UpdatedAt:        u.UpdatedAt(),
+		LastLoginAt:      dbutil.TimeToNullTime(u.LastLoginAt),
+		EmailKey:         k.emailKey,
+		UsernameKey:      k.usernameKey,
+		EmailSkeleton:    k.emailSkeleton,
+		UsernameSkeleton: k.usernameSkeleton,
+		ID:               u.ID().String(),
 	}
 }
 
@@ -92,20 +126,25 @@ func toUpdateParams(u *domain.User) dbgen.UpdateUserParams {
 // sqlc UpdateUserWithEtag arg shape. Etag_2 is sqlc's positional name for
 // the second occurrence of `etag = ?` (in the WHERE clause).
 func toUpdateWithEtagParams(u *domain.User, expectedEtag etag.Etag) dbgen.UpdateUserWithEtagParams {
+	k := toKeyParams(u)
 	return dbgen.UpdateUserWithEtagParams{
-		Email:        u.Email,
-		Username:     u.Username,
-		DisplayName:  u.DisplayName,
-		PasswordHash: dbutil.BytesToNullString(u.PasswordHash()),
-		AvatarUrl:    dbutil.StringToNullString(u.AvatarURL),
-		Locale:       u.Locale,
-		Timezone:     u.Timezone,
-		Status:       uint8(u.Status()),
-		Etag:         u.Etag().String(),
-		UpdatedAt:    u.UpdatedAt(),
-		LastLoginAt:  dbutil.TimeToNullTime(u.LastLoginAt),
-		ID:           u.ID().String(),
-		Etag_2:       expectedEtag.String(),
+		Email:            u.Email,
+		Username:         u.Username,
+		DisplayName:      u.DisplayName,
+		PasswordHash:     dbutil.BytesToNullString(u.PasswordHash()),
+		AvatarUrl:        dbutil.StringToNullString(u.AvatarURL),
+		Locale:           u.Locale,
+		Timezone:         u.Timezone,
+		Status:           uint8(u.Status()),
+		Etag:             u.Etag().String(),
+		UpdatedAt:        u.UpdatedAt(),
+		LastLoginAt:      dbutil.TimeToNullTime(u.LastLoginAt),
+		EmailKey:         k.emailKey,
+		UsernameKey:      k.usernameKey,
+		EmailSkeleton:    k.emailSkeleton,
+		UsernameSkeleton: k.usernameSkeleton,
+		ID:               u.ID().String(),
+		Etag_2:           expectedEtag.String(),
 	}
 }
 
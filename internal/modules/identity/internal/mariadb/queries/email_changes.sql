@@ -0,0 +1,31 @@
+-- Login-email change requests (see migration 0014). Token lookups lock
+-- the row: they run inside the confirm / cancel transaction.
+
+-- name: CreateEmailChange :exec
+INSERT INTO user_email_changes (
+    id, user_id, old_email, new_email, status, confirm_token_hash,
+    cancel_token_hash, revoke_sessions, requested_by, expires_at,
+    cancel_until, confirmed_at, cancelled_at, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: SupersedePendingEmailChanges :exec
+UPDATE user_email_changes
+SET status = 3, cancelled_at = ?, updated_at = ?
+WHERE user_id = ? AND status = 1;
+
+-- name: GetEmailChangeByConfirmHashForUpdate :one
+SELECT * FROM user_email_changes
+WHERE confirm_token_hash = ?
+LIMIT 1
+FOR UPDATE;
+
+-- name: GetEmailChangeByCancelHashForUpdate :one
+SELECT * FROM user_email_changes
+WHERE cancel_token_hash = ?
+LIMIT 1
+FOR UPDATE;
+
+-- name: UpdateEmailChange :execresult
+UPDATE user_email_changes
+SET status = ?, confirmed_at = ?, cancelled_at = ?, updated_at = ?
+WHERE id = ?;
@@ -1,12 +1,17 @@
 -- Identity directory: per-row queries. Dynamic ListUsers lives in the
 -- repository's hand-written list.go (sqlc cannot template variable WHERE /
 -- ORDER BY economically).
+--
+-- Updates COALESCE the identifier keys: the repository passes NULL for
+-- a row that has none yet (domain.User.Keys), which must not clobber a
+-- backfill that ran after the row was read.
 
 -- name: CreateUser :exec
 INSERT INTO users
     (id, email, username, password_hash, display_name, avatar_url, locale, timezone,
-     status, etag, created_at, updated_at, last_login_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+     status, etag, created_at, updated_at, last_login_at,
+     email_key, username_key, email_skeleton, username_skeleton, tenant_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: GetUserByID :one
 SELECT * FROM users
@@ -15,27 +20,42 @@ WHERE id = ?;
 -- name: GetUserByEmail :one
 -- Used by AuthService.Login when the caller authenticates by email.
 -- Returns the full row including password_hash so the use-case can run
--- bcrypt verification without a follow-up Get.
+-- bcrypt verification without a follow-up Get. Matches on email_key;
+-- rows not yet backfilled (NULL key) fall back to the stored email.
+-- Emails are unique per tenant, so the lookup names one.
 SELECT * FROM users
-WHERE email = ?;
+WHERE tenant_id = ?
+  AND (email_key = ? OR (email_key IS NULL AND email = ?))
+ORDER BY email_key IS NULL
+LIMIT 1;
 
 -- name: GetUserByUsername :one
 -- Used by AuthService.Login when the caller authenticates by username.
+-- Same key-then-legacy matching, and tenant, as GetUserByEmail.
 SELECT * FROM users
-WHERE username = ?;
+WHERE tenant_id = ?
+  AND (username_key = ? OR (username_key IS NULL AND username = ?))
+ORDER BY username_key IS NULL
+LIMIT 1;
 
 -- name: UpdateUserWithEtag :execresult
 UPDATE users SET
     email = ?, username = ?, display_name = ?, password_hash = ?, avatar_url = ?,
     locale = ?, timezone = ?, status = ?, etag = ?,
-    updated_at = ?, last_login_at = ?
+    updated_at = ?, last_login_at = ?,
+    email_key = COALESCE(?, email_key), username_key = COALESCE(?, username_key),
+    email_skeleton = COALESCE(?, email_skeleton),
+    username_skeleton = COALESCE(?, username_skeleton)
 WHERE id = ? AND etag = ?;
 
 -- name: UpdateUser :execresult
 UPDATE users SET
     email = ?, username = ?, display_name = ?, password_hash = ?, avatar_url = ?,
     locale = ?, timezone = ?, status = ?, etag = ?,
-    updated_at = ?, last_login_at = ?
+    updated_at = ?, last_login_at = ?,
+    email_key = COALESCE(?, email_key), username_key = COALESCE(?, username_key),
+    email_skeleton = COALESCE(?, email_skeleton),
+    username_skeleton = COALESCE(?, username_skeleton)
 WHERE id = ?;
 
 -- name: DeleteUserWithEtag :execresult
@@ -1,6 +1,11 @@
 // Package mariadb is the MariaDB implementation of the identity module's
 // domain.Repository.
 //
+// Users are tenant-owned: reads honour kernel/tenant. A user outside
+// tenant.Scope reads as ErrUserNotFound; email and username resolve in
+// tenant.Home, where they are unique. Writes address rows the use-case
+// has already read, so they are not filtered again.
+//
 // Static per-row queries (Create/GetByID/Update/Delete + the existence check
 // used to discriminate ErrUserNotFound vs ErrEtagMismatch) go through sqlc-
 // generated code in dbgen. The dynamic Repository.List query — variable
@@ -17,6 +22,7 @@ import (
 
 	"sso/internal/kernel/dbutil"
 	"sso/internal/kernel/etag"
+	"sso/internal/kernel/tenant"
 	"sso/internal/modules/identity/internal/domain"
 	"sso/internal/modules/identity/internal/mariadb/dbgen"
 )
@@ -36,12 +42,22 @@ func NewRepository(db *sql.DB) *Repository {
 // Compile-time check.
 var _ domain.Repository = (*Repository)(nil)
 
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one, so identity writes can join a use-case
+// that spans modules.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
 // ----------------------------------------------------------------------------
 // Create
 // ----------------------------------------------------------------------------
 
 func (r *Repository) Create(ctx context.Context, u *domain.User) error {
-	if err := r.q.CreateUser(ctx, toCreateParams(u)); err != nil {
+	if err := r.queries(ctx).CreateUser(ctx, toCreateParams(u)); err != nil {
 		if dbutil.IsDuplicateEntry(err) {
 			return domain.ErrUserAlreadyExists
 		}
@@ -55,13 +71,16 @@ func (r *Repository) Create(ctx context.Context, u *domain.User) error {
 // ----------------------------------------------------------------------------
 
 func (r *Repository) GetByID(ctx context.Context, id domain.UserID) (*domain.User, error) {
-	row, err := r.q.GetUserByID(ctx, id.String())
+	row, err := r.queries(ctx).GetUserByID(ctx, id.String())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
 		}
 		return nil, fmt.Errorf("identity repo: get_by_id: %w", err)
 	}
+	if !tenant.Visible(ctx, row.TenantID) {
+		return nil, domain.ErrUserNotFound
+	}
 	return dbgenToDomain(row), nil
 }
 
@@ -74,10 +93,16 @@ func (r *Repository) GetByID(ctx context.Context, id domain.UserID) (*domain.Use
 // username). Auth declares its own narrow interface at the point of use
 // and the concrete *Repository here satisfies it via duck-typing.
 
-// GetByEmail returns the user with the given email. Email lookups are
-// unique (uk_users_email).
+// GetByEmail returns the user of tenant.Home with the given email,
+// compared by domain.EmailKey (uk_users_tenant_email_key), so case,
+// Unicode compatibility forms and provider aliases all find the same
+// account.
 func (r *Repository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
-	row, err := r.q.GetUserByEmail(ctx, email)
+	row, err := r.queries(ctx).GetUserByEmail(ctx, dbgen.GetUserByEmailParams{
+		TenantID: tenant.Home(ctx),
+		EmailKey: dbutil.StringToNullString(domain.EmailKey(email)),
+		Email:    domain.NormalizeEmail(email),
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
@@ -87,10 +112,14 @@ func (r *Repository) GetByEmail(ctx context.Context, email string) (*domain.User
 	return dbgenToDomain(row), nil
 }
 
-// GetByUsername returns the user with the given username. Username
-// lookups are unique (uk_users_username).
+// GetByUsername returns the user of tenant.Home with the given
+// username, compared by domain.UsernameKey (uk_users_tenant_username_key).
 func (r *Repository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
-	row, err := r.q.GetUserByUsername(ctx, username)
+	row, err := r.queries(ctx).GetUserByUsername(ctx, dbgen.GetUserByUsernameParams{
+		TenantID:    tenant.Home(ctx),
+		UsernameKey: dbutil.StringToNullString(domain.UsernameKey(username)),
+		Username:    domain.NormalizeUsername(username),
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
@@ -101,7 +130,7 @@ func (r *Repository) GetByUsername(ctx context.Context, username string) (*domai
 }
 
 func (r *Repository) GetFailedLoginAttempts(ctx context.Context, id domain.UserID) (int, error) {
-	count, err := r.q.GetFailedLoginAttempts(ctx, id.String())
+	count, err := r.queries(ctx).GetFailedLoginAttempts(ctx, id.String())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return 0, domain.ErrUserNotFound
@@ -121,9 +150,9 @@ func (r *Repository) Update(ctx context.Context, u *domain.User, expectedEtag et
 		err error
 	)
 	if expectedEtag == "" {
-		res, err = r.q.UpdateUser(ctx, toUpdateParams(u))
+		res, err = r.queries(ctx).UpdateUser(ctx, toUpdateParams(u))
 	} else {
-		res, err = r.q.UpdateUserWithEtag(ctx, toUpdateWithEtagParams(u, expectedEtag))
+		res, err = r.queries(ctx).UpdateUserWithEtag(ctx, toUpdateWithEtagParams(u, expectedEtag))
 	}
 	if err != nil {
 		if dbutil.IsDuplicateEntry(err) {
@@ -141,7 +170,7 @@ func (r *Repository) Update(ctx context.Context, u *domain.User, expectedEtag et
 	}
 	return dbutil.Discriminate(ctx, expectedEtag,
 		func(ctx context.Context) (int64, error) {
-			return r.q.CountUserByID(ctx, u.ID().String())
+			return r.queries(ctx).CountUserByID(ctx, u.ID().String())
 		},
 		domain.ErrUserNotFound, domain.ErrEtagMismatch)
 }
@@ -167,7 +196,7 @@ func (r *Repository) UpdatePassword(ctx context.Context, u *domain.User, expecte
 	if expectedEtag == "" {
 		return fmt.Errorf("identity repo: update_password: expected etag is required")
 	}
-	res, err := r.q.UpdateUserPasswordWithEtag(ctx, toUpdatePasswordWithEtagParams(u, expectedEtag))
+	res, err := r.queries(ctx).UpdateUserPasswordWithEtag(ctx, toUpdatePasswordWithEtagParams(u, expectedEtag))
 	if err != nil {
 		return fmt.Errorf("identity repo: update_password: %w", err)
 	}
@@ -180,13 +209,13 @@ func (r *Repository) UpdatePassword(ctx context.Context, u *domain.User, expecte
 	}
 	return dbutil.Discriminate(ctx, expectedEtag,
 		func(ctx context.Context) (int64, error) {
-			return r.q.CountUserByID(ctx, u.ID().String())
+			return r.queries(ctx).CountUserByID(ctx, u.ID().String())
 		},
 		domain.ErrUserNotFound, domain.ErrEtagMismatch)
 }
 
 func (r *Repository) UpdateLastLoginAt(ctx context.Context, id domain.UserID, now time.Time) error {
-	res, err := r.q.UpdateUserLastLoginAt(ctx, toUpdateLastLoginAtParams(id, now))
+	res, err := r.queries(ctx).UpdateUserLastLoginAt(ctx, toUpdateLastLoginAtParams(id, now))
 	if err != nil {
 		return fmt.Errorf("identity repo: update_last_login: %w", err)
 	}
@@ -201,7 +230,7 @@ func (r *Repository) UpdateLastLoginAt(ctx context.Context, id domain.UserID, no
 }
 
 func (r *Repository) IncrementFailedLogins(ctx context.Context, id domain.UserID) error {
-	res, err := r.q.IncrementFailedLogins(ctx, id.String())
+	res, err := r.queries(ctx).IncrementFailedLogins(ctx, id.String())
 	if err != nil {
 		return fmt.Errorf("identity repo: increment_failed_logins: %w", err)
 	}
@@ -216,7 +245,7 @@ func (r *Repository) IncrementFailedLogins(ctx context.Context, id domain.UserID
 }
 
 func (r *Repository) LockUser(ctx context.Context, id domain.UserID, until time.Time) error {
-	res, err := r.q.LockUser(ctx, toLockUserParams(id, until))
+	res, err := r.queries(ctx).LockUser(ctx, toLockUserParams(id, until))
 	if err != nil {
 		return fmt.Errorf("identity repo: lock_user: %w", err)
 	}
@@ -231,7 +260,7 @@ func (r *Repository) LockUser(ctx context.Context, id domain.UserID, until time.
 }
 
 func (r *Repository) ResetLoginFailures(ctx context.Context, id domain.UserID) error {
-	res, err := r.q.ResetLoginFailures(ctx, id.String())
+	res, err := r.queries(ctx).ResetLoginFailures(ctx, id.String())
 	if err != nil {
 		return fmt.Errorf("identity repo: reset_login_failures: %w", err)
 	}
@@ -255,9 +284,9 @@ func (r *Repository) Delete(ctx context.Context, id domain.UserID, expectedEtag
 		err error
 	)
 	if expectedEtag == "" {
-		res, err = r.q.DeleteUser(ctx, id.String())
+		res, err = r.queries(ctx).DeleteUser(ctx, id.String())
 	} else {
-		res, err = r.q.DeleteUserWithEtag(ctx, dbgen.DeleteUserWithEtagParams{
+		res, err = r.queries(ctx).DeleteUserWithEtag(ctx, dbgen.DeleteUserWithEtagParams{
 			ID:   id.String(),
 			Etag: expectedEtag.String(),
 		})
@@ -274,7 +303,7 @@ func (r *Repository) Delete(ctx context.Context, id domain.UserID, expectedEtag
 	}
 	return dbutil.Discriminate(ctx, expectedEtag,
 		func(ctx context.Context) (int64, error) {
-			return r.q.CountUserByID(ctx, id.String())
+			return r.queries(ctx).CountUserByID(ctx, id.String())
 		},
 		domain.ErrUserNotFound, domain.ErrEtagMismatch)
 }
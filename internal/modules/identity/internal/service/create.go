@@ -6,6 +6,7 @@ import (
 	"sso/internal/modules/audit"
 	"sso/internal/modules/identity/internal/domain"
 	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
 )
 
 // CreateUserInput is the parsed CreateUserRequest. Field validation is
@@ -35,6 +36,7 @@ func (s *Service) CreateUser(ctx context.Context, in CreateUserInput) (*domain.U
 
 	user := domain.NewUser(domain.NewUserParams{
 		ID:          id,
+		TenantID:    tenant.Home(ctx),
 		Email:       in.Email,
 		Username:    in.Username,
 		DisplayName: in.DisplayName,
@@ -97,6 +97,7 @@ func (s *Service) PermanentlyDeleteUser(ctx context.Context, in PermanentlyDelet
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return err
 	}
+	s.version.Bump(ctx)
 
 	s.auditor.Success(ctx, aud)
 	return nil
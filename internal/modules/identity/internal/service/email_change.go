@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity/internal/domain"
+	"sso/internal/modules/session"
+	"sso/internal/platform/mail"
+)
+
+// ----------------------------------------------------------------------------
+// Verified email change
+// ----------------------------------------------------------------------------
+//
+// A user never overwrites their own users.email directly. A change
+// request stores a pending EmailChange and mails two links:
+//
+//	new address — confirm token; ConfirmEmailChange performs the swap.
+//	old address — notice with a cancel token; CancelEmailChange withdraws
+//	              the request, or reverts it after confirmation while
+//	              the cancel window is still open.
+//
+// Requests come from the user (RequestEmailChange, /v1/me/email). Admins
+// and SCIM clients set the address directly through UpdateUser, which
+// supersedes any pending request.
+
+// RequestEmailChangeInput is the self-service request. RevokeSessions
+// ends every session of the user once the change is confirmed.
+type RequestEmailChangeInput struct {
+	NewEmail       string
+	RevokeSessions bool
+}
+
+// RequestEmailChange starts a change of the calling user's login email.
+// Returns nil when NewEmail already is the user's address (same
+// domain.EmailKey).
+//
+// Errors:
+//
+//	ErrUserActorRequired  — caller is not a user
+//	ValidationError       — NewEmail is not a bare address
+//	ErrUserAlreadyExists  — another account uses NewEmail
+//	ErrUserDeleted        — caller's account is DELETED
+//	ErrDeliveryFailed     — a mail could not be sent; the request is withdrawn
+func (s *Service) RequestEmailChange(ctx context.Context, in RequestEmailChangeInput) (*domain.EmailChange, error) {
+	a, err := requireUserActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseUserID(a.ID)
+	if err != nil {
+		return nil, err
+	}
+	newEmail, err := domain.ParseEmailAddress("new_email", in.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.startEmailChange(ctx, a, user, newEmail, in.RevokeSessions)
+}
+
+// startEmailChange supersedes the user's earlier pending requests and
+// stores a new one in one transaction, then mails both links once it
+// has committed. When a send fails the new request is withdrawn again.
+func (s *Service) startEmailChange(ctx context.Context, a actor.Actor, user *domain.User, newEmail string, revokeSessions bool) (*domain.EmailChange, error) {
+	// Same key means same mailbox (case, dots, +tags): nothing to verify.
+	if domain.EmailKey(newEmail) == domain.EmailKey(user.Email) {
+		return nil, nil
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeIdentityRequestEmailChange)
+	aud.SubjectType = audit.SubjectTypeUser
+	aud.SubjectID = user.ID().String()
+
+	change, err := s.newEmailChange(ctx, a, user, newEmail, revokeSessions)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("request email change: %w", err)
+	}
+
+	aud.Metadata = map[string]string{
+		"email_change_id": change.ID().String(),
+		"revoke_sessions": strconv.FormatBool(revokeSessions),
+	}
+	s.auditor.Success(ctx, aud)
+	return change, nil
+}
+
+func (s *Service) newEmailChange(ctx context.Context, a actor.Actor, user *domain.User, newEmail string, revokeSessions bool) (*domain.EmailChange, error) {
+	if user.Status() == domain.UserStatusDeleted {
+		return nil, domain.ErrUserDeleted
+	}
+	// Checked again by uk_users_email at confirmation; this only spares
+	// the owner of newEmail a confirmation mail that cannot succeed.
+	switch _, err := s.repo.GetByEmail(ctx, newEmail); {
+	case err == nil:
+		return nil, domain.ErrUserAlreadyExists
+	case !errors.Is(err, domain.ErrUserNotFound):
+		return nil, err
+	}
+
+	id, err := domain.NewEmailChangeID()
+	if err != nil {
+		return nil, err
+	}
+	confirmToken, confirmHash, err := s.tokens.Generate()
+	if err != nil {
+		return nil, err
+	}
+	cancelToken, cancelHash, err := s.tokens.Generate()
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().UTC()
+	change := domain.NewEmailChange(domain.NewEmailChangeParams{
+		ID:             id,
+		UserID:         user.ID(),
+		OldEmail:       user.Email,
+		NewEmail:       newEmail,
+		ConfirmHash:    confirmHash,
+		CancelHash:     cancelHash,
+		RevokeSessions: revokeSessions,
+		RequestedBy:    a.ID,
+		ExpiresAt:      now.Add(s.cfg.TTL),
+		CancelUntil:    now.Add(s.cfg.CancelWindow),
+		Now:            now,
+	})
+
+	err = s.tx(ctx, func(ctx context.Context) error {
+		if err := s.changes.SupersedePendingEmailChanges(ctx, user.ID(), now); err != nil {
+			return err
+		}
+		return s.changes.CreateEmailChange(ctx, change)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The notice goes out first: a confirm link is never mailed unless
+	// the old address has been told and holds a cancel link.
+	err = s.sendNotice(ctx, change, cancelToken)
+	if err == nil {
+		err = s.sendConfirm(ctx, change, confirmToken)
+	}
+	if err != nil {
+		s.withdrawEmailChange(ctx, user.ID())
+		return nil, err
+	}
+	return change, nil
+}
+
+// withdrawEmailChange supersedes a stored request whose mails could not
+// all be sent. A failure is only logged: the request's confirm token
+// may never have been delivered, and it expires on its own.
+func (s *Service) withdrawEmailChange(ctx context.Context, id domain.UserID) {
+	if err := s.changes.SupersedePendingEmailChanges(ctx, id, s.now().UTC()); err != nil {
+		s.log.ErrorContext(ctx, "identity: withdraw undelivered email change", "user_id", id.String(), "err", err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// ConfirmEmailChange
+// ----------------------------------------------------------------------------
+
+// ConfirmEmailChangeInput — the token is the only credential.
+type ConfirmEmailChangeInput struct {
+	Token     string
+	IpAddress string
+	UserAgent string
+}
+
+// ConfirmEmailChange redeems a confirm token and moves the user to the
+// new address, with the change row locked so a confirm cannot race a
+// cancel. When the request asked for it, every session of the user is
+// revoked afterwards; a failure there is logged, not returned, because
+// the change itself is already committed.
+func (s *Service) ConfirmEmailChange(ctx context.Context, in ConfirmEmailChangeInput) (*domain.User, error) {
+	if in.Token == "" {
+		return nil, &validation.Error{Field: "token", Reason: "required"}
+	}
+	aud := audit.NewAuditParams{
+		EventType:   audit.EventTypeIdentityConfirmEmailChange,
+		ActorType:   audit.ActorTypeAnonymous,
+		SubjectType: audit.SubjectTypeUser,
+		IpAddress:   in.IpAddress,
+		UserAgent:   in.UserAgent,
+	}
+
+	var (
+		user   *domain.User
+		change *domain.EmailChange
+	)
+	err := s.tx(ctx, func(ctx context.Context) error {
+		var err error
+		change, err = s.changes.GetEmailChangeByConfirmHashForUpdate(ctx, s.tokens.Hash(in.Token))
+		if err != nil {
+			return err
+		}
+		aud.SubjectID = change.UserID().String()
+		now := s.now().UTC()
+		if err := change.Confirm(now); err != nil {
+			return err
+		}
+		user, err = s.setEmail(ctx, change.UserID(), change.NewEmail, now)
+		if err != nil {
+			return err
+		}
+		return s.changes.UpdateEmailChange(ctx, change)
+	})
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("confirm email change: %w", err)
+	}
+
+	if change.RevokeSessions() {
+		s.revokeSessions(ctx, user.ID())
+	}
+	aud.Metadata = map[string]string{
+		"email_change_id":  change.ID().String(),
+		"sessions_revoked": strconv.FormatBool(change.RevokeSessions()),
+	}
+	s.auditor.Success(ctx, aud)
+	return user, nil
+}
+
+// ----------------------------------------------------------------------------
+// CancelEmailChange
+// ----------------------------------------------------------------------------
+
+// CancelEmailChangeInput — the token is the only credential.
+type CancelEmailChangeInput struct {
+	Token     string
+	IpAddress string
+	UserAgent string
+}
+
+// CancelEmailChange redeems a cancel token from the old address. A
+// pending change is withdrawn. A confirmed change is reverted to the old
+// address while the cancel window is open, and then every session of
+// the user is revoked regardless of the request's choice: whoever
+// confirmed may already have signed in.
+func (s *Service) CancelEmailChange(ctx context.Context, in CancelEmailChangeInput) error {
+	if in.Token == "" {
+		return &validation.Error{Field: "token", Reason: "required"}
+	}
+	aud := audit.NewAuditParams{
+		EventType:   audit.EventTypeIdentityCancelEmailChange,
+		ActorType:   audit.ActorTypeAnonymous,
+		SubjectType: audit.SubjectTypeUser,
+		IpAddress:   in.IpAddress,
+		UserAgent:   in.UserAgent,
+	}
+
+	var (
+		change   *domain.EmailChange
+		reverted bool
+	)
+	err := s.tx(ctx, func(ctx context.Context) error {
+		var err error
+		change, err = s.changes.GetEmailChangeByCancelHashForUpdate(ctx, s.tokens.Hash(in.Token))
+		if err != nil {
+			return err
+		}
+		aud.SubjectID = change.UserID().String()
+		now := s.now().UTC()
+		if reverted, err = change.Cancel(now); err != nil {
+			return err
+		}
+		if reverted {
+			if _, err := s.setEmail(ctx, change.UserID(), change.OldEmail, now); err != nil {
+				return err
+			}
+		}
+		return s.changes.UpdateEmailChange(ctx, change)
+	})
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return fmt.Errorf("cancel email change: %w", err)
+	}
+
+	if reverted {
+		s.revokeSessions(ctx, change.UserID())
+	}
+	aud.Metadata = map[string]string{
+		"email_change_id": change.ID().String(),
+		"reverted":        strconv.FormatBool(reverted),
+	}
+	s.auditor.Success(ctx, aud)
+	return nil
+}
+
+// setEmail writes email to the user, checked against the etag it was
+// read with.
+func (s *Service) setEmail(ctx context.Context, id domain.UserID, email string, now time.Time) (*domain.User, error) {
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	preEtag := user.Etag()
+	if err := user.ApplyPatch(domain.UserPatch{Email: &email}, now); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, user, preEtag); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *Service) revokeSessions(ctx context.Context, id domain.UserID) {
+	if err := s.sessions.RevokeAllForUser(ctx, session.UserID(id.String()), s.now().UTC()); err != nil {
+		s.log.ErrorContext(ctx, "identity: revoke sessions after email change", "user_id", id.String(), "err", err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Mail
+// ----------------------------------------------------------------------------
+
+func (s *Service) sendConfirm(ctx context.Context, c *domain.EmailChange, token string) error {
+	link, err := tokenLink(s.cfg.ConfirmURL, token)
+	if err != nil {
+		return err
+	}
+	body := "A request was made to use this address to sign in.\n\n" +
+		"Open the link below to confirm the change:\n\n" +
+		link + "\n\n" +
+		"The link can be used once and expires on " +
+		c.ExpiresAt().UTC().Format(time.RFC1123) + ".\n" +
+		"If you did not ask for this you can ignore this message.\n"
+	return s.deliver(ctx, mail.Message{To: c.NewEmail, Subject: "Confirm your new email address", Body: body})
+}
+
+func (s *Service) sendNotice(ctx context.Context, c *domain.EmailChange, token string) error {
+	link, err := tokenLink(s.cfg.CancelURL, token)
+	if err != nil {
+		return err
+	}
+	body := "A request was made to change the sign-in email of your account\n" +
+		"from this address to " + c.NewEmail + ".\n\n" +
+		"If this was not you, open the link below to cancel it:\n\n" +
+		link + "\n\n" +
+		"The link also undoes the change after it has been confirmed, until " +
+		c.CancelUntil().UTC().Format(time.RFC1123) + ".\n"
+	return s.deliver(ctx, mail.Message{To: c.OldEmail, Subject: "Your sign-in email is being changed", Body: body})
+}
+
+func (s *Service) deliver(ctx context.Context, m mail.Message) error {
+	if err := s.mailer.Send(ctx, m); err != nil {
+		return errors.Join(domain.ErrDeliveryFailed, err)
+	}
+	return nil
+}
+
+// tokenLink appends the token to base, keeping any query the configured
+// URL already has.
+func tokenLink(base, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("identity: email change url: %w", err)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
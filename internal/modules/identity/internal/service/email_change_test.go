@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity/internal/domain"
+	"sso/internal/platform/mail"
+)
+
+// fakeUsers serves one user; methods the tests do not reach panic
+// through the nil embedded interface.
+type fakeUsers struct {
+	domain.Repository
+	user *domain.User
+}
+
+func (r *fakeUsers) GetByID(_ context.Context, id domain.UserID) (*domain.User, error) {
+	if r.user == nil || r.user.ID() != id {
+		return nil, domain.ErrUserNotFound
+	}
+	return r.user, nil
+}
+
+func (r *fakeUsers) GetByEmail(_ context.Context, email string) (*domain.User, error) {
+	if r.user == nil || r.user.Email != email {
+		return nil, domain.ErrUserNotFound
+	}
+	return r.user, nil
+}
+
+func (r *fakeUsers) Update(_ context.Context, u *domain.User, _ etag.Etag) error {
+	r.user = u
+	return nil
+}
+
+// txKey marks a ctx running inside runTx.
+type txKey struct{}
+
+func runTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+// fakeChanges holds the pending requests by id.
+type fakeChanges struct {
+	domain.EmailChangeRepository
+	pending map[domain.EmailChangeID]*domain.EmailChange
+}
+
+func (r *fakeChanges) CreateEmailChange(_ context.Context, c *domain.EmailChange) error {
+	r.pending[c.ID()] = c
+	return nil
+}
+
+func (r *fakeChanges) SupersedePendingEmailChanges(_ context.Context, _ domain.UserID, _ time.Time) error {
+	clear(r.pending)
+	return nil
+}
+
+// fakeMailer records what it sent, whether a send ran inside a
+// transaction and how many requests were pending at each send. Sends to
+// an address in failTo fail.
+type fakeMailer struct {
+	failTo  map[string]bool
+	sent    []mail.Message
+	inTx    bool
+	changes *fakeChanges
+	stored  []int
+}
+
+func (m *fakeMailer) Send(ctx context.Context, msg mail.Message) error {
+	if ctx.Value(txKey{}) != nil {
+		m.inTx = true
+	}
+	m.stored = append(m.stored, len(m.changes.pending))
+	if m.failTo[msg.To] {
+		return errors.New("smtp: connection refused")
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func newEmailChangeService(t *testing.T, failTo ...string) (*Service, *fakeChanges, *fakeMailer, context.Context) {
+	t.Helper()
+	id, err := domain.NewUserID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := domain.NewUser(domain.NewUserParams{ID: id, Email: "ada@example.com", Username: "ada", Now: time.Now()})
+	changes := &fakeChanges{pending: map[domain.EmailChangeID]*domain.EmailChange{}}
+	mailer := &fakeMailer{failTo: map[string]bool{}, changes: changes}
+	for _, to := range failTo {
+		mailer.failTo[to] = true
+	}
+	s := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), &fakeUsers{user: user}, changes, nil, mailer, runTx,
+		EmailChangeConfig{
+			ConfirmURL:   "https://app.example.com/email/confirm",
+			CancelURL:    "https://app.example.com/email/cancel",
+			TTL:          time.Hour,
+			CancelWindow: 24 * time.Hour,
+		}, time.Now, audit.NopEmitter{}, nil)
+	ctx := actor.Inject(context.Background(), actor.Actor{ID: id.String(), Kind: actor.KindUser})
+	return s, changes, mailer, ctx
+}
+
+func TestRequestEmailChangeMailsAfterCommit(t *testing.T) {
+	s, changes, mailer, ctx := newEmailChangeService(t)
+
+	change, err := s.RequestEmailChange(ctx, RequestEmailChangeInput{NewEmail: "ada@new.example.com"})
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if mailer.inTx {
+		t.Fatal("a mail was sent inside the transaction")
+	}
+	if len(mailer.sent) != 2 || mailer.stored[0] != 1 {
+		t.Fatalf("sent %d mails, request stored before the first: %v", len(mailer.sent), mailer.stored[0] == 1)
+	}
+	if mailer.sent[0].To != "ada@example.com" || mailer.sent[1].To != "ada@new.example.com" {
+		t.Fatalf("mails went to %q then %q, want the notice before the confirm link", mailer.sent[0].To, mailer.sent[1].To)
+	}
+	if changes.pending[change.ID()] == nil {
+		t.Fatal("request not pending")
+	}
+}
+
+func TestRequestEmailChangeWithdrawnOnDeliveryFailure(t *testing.T) {
+	cases := []struct {
+		name     string
+		failTo   string
+		wantSent int
+	}{
+		{"notice fails", "ada@example.com", 0},
+		{"confirm fails", "ada@new.example.com", 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, changes, mailer, ctx := newEmailChangeService(t, tc.failTo)
+			_, err := s.RequestEmailChange(ctx, RequestEmailChangeInput{NewEmail: "ada@new.example.com"})
+			if !errors.Is(err, domain.ErrDeliveryFailed) {
+				t.Fatalf("err = %v, want ErrDeliveryFailed", err)
+			}
+			if len(mailer.sent) != tc.wantSent {
+				t.Fatalf("sent = %d, want %d", len(mailer.sent), tc.wantSent)
+			}
+			if len(changes.pending) != 0 {
+				t.Fatal("undelivered request left pending")
+			}
+		})
+	}
+}
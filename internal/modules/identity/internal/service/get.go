@@ -39,10 +39,15 @@ type ListUsersInput struct {
 	DisplayNames []string
 	Statuses     []domain.UserStatus
 	OrderBy      domain.ListOrderBy
+	// Offset skips that many matches on the first page; SCIM pages by
+	// offset. CountTotal asks for TotalSize.
+	Offset     int
+	CountTotal bool
 }
 
 // ListUsersOutput is the use-case return — opaque page token plus typed
-// users. Total size is forwarded from the repository (nil = not computed).
+// users. Total size is forwarded from the repository (nil unless
+// CountTotal was set).
 type ListUsersOutput struct {
 	Users         []*domain.User
 	NextPageToken string
@@ -71,6 +76,8 @@ func (s *Service) ListUsers(ctx context.Context, in ListUsersInput) (ListUsersOu
 		DisplayNames: in.DisplayNames,
 		Statuses:     in.Statuses,
 		OrderBy:      in.OrderBy,
+		Offset:       in.Offset,
+		CountTotal:   in.CountTotal,
 	})
 	if err != nil {
 		return ListUsersOutput{}, err
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/identity/internal/domain"
+)
+
+// ----------------------------------------------------------------------------
+// GetMe / UpdateMe — self-service profile
+// ----------------------------------------------------------------------------
+//
+// The subject is always the calling user (actor.ID); there is no user_id
+// input to get wrong. Service accounts and the system have no profile
+// and get ErrUserActorRequired.
+
+// GetMe returns the calling user's identity record.
+func (s *Service) GetMe(ctx context.Context) (*domain.User, error) {
+	a, err := requireUserActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseUserID(a.ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+// UpdateMeInput is UpdateUserInput restricted to what a user may change
+// about themselves.
+//
+// Allowed mask paths (anything else surfaces ValidationError):
+//
+//	display_name, avatar_url, locale, timezone
+//
+// email and username are identifiers other systems key on and stay with
+// the admin UpdateUser.
+type UpdateMeInput struct {
+	MaskPaths    []string
+	ExpectedEtag string // "*" wildcard or a UUID
+
+	DisplayName string
+	AvatarURL   string
+	Locale      string
+	Timezone    string
+}
+
+// UpdateMe applies a FieldMask-driven partial update to the calling
+// user. Audited as identity.update_me with the user as both actor and
+// subject.
+//
+// Errors:
+//
+//	ErrUserActorRequired  — caller is not a user
+//	ValidationError       — empty mask, field not allowed for self-service
+//	ErrEtagMismatch       — supplied etag != current
+//	ErrUserDeleted        — caller's account is DELETED
+func (s *Service) UpdateMe(ctx context.Context, in UpdateMeInput) (*domain.User, error) {
+	a, err := requireUserActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseUserID(a.ID)
+	if err != nil {
+		return nil, err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, true /*required*/)
+	if err != nil {
+		return nil, err
+	}
+	if len(in.MaskPaths) == 0 {
+		return nil, &validation.Error{Field: "update_mask", Reason: "must list at least one field"}
+	}
+	patch, err := buildMePatch(in)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeIdentityUpdateMe)
+	aud.SubjectType = audit.SubjectTypeUser
+	aud.SubjectID = id.String()
+	aud.Metadata = map[string]string{"fields": strings.Join(in.MaskPaths, ",")}
+
+	user, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	if err := user.ApplyPatch(patch, s.now().UTC()); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, user, expectedEtag); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+
+	s.auditor.Success(ctx, aud)
+	return user, nil
+}
+
+// buildMePatch is buildPatch over the self-service subset.
+func buildMePatch(in UpdateMeInput) (domain.UserPatch, error) {
+	var p domain.UserPatch
+	for _, path := range in.MaskPaths {
+		switch path {
+		case "display_name":
+			v := in.DisplayName
+			p.DisplayName = &v
+		case "avatar_url":
+			v := in.AvatarURL
+			p.AvatarURL = &v
+		case "locale":
+			v := in.Locale
+			p.Locale = &v
+		case "timezone":
+			v := in.Timezone
+			p.Timezone = &v
+		default:
+			return domain.UserPatch{}, &validation.Error{
+				Field:  "update_mask",
+				Reason: "field not allowed: " + path,
+			}
+		}
+	}
+	return p, nil
+}
+
+func requireUserActor(ctx context.Context) (actor.Actor, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return actor.Actor{}, err
+	}
+	if !a.IsUser() {
+		return actor.Actor{}, domain.ErrUserActorRequired
+	}
+	return a, nil
+}
@@ -7,6 +7,8 @@
 //	get.go     — GetUser, ListUsers (and the page-cursor codec)
 //	update.go  — UpdateUser, DisableUser, EnableUser, buildPatch
 //	delete.go  — SoftDeleteUser, PermanentlyDeleteUser
+//	me.go      — GetMe, UpdateMe (self-service, HTTP-only)
+//	email_change.go — RequestEmailChange, Confirm/CancelEmailChange
 //
 // The service is a thin orchestrator: it parses inputs into typed values,
 // calls User mutators on aggregates loaded through Repository, and returns
@@ -19,23 +21,80 @@ import (
 	"log/slog"
 	"time"
 
+	"sso/internal/kernel/authzver"
 	"sso/internal/modules/audit"
 	"sso/internal/modules/audit/auditx"
 	"sso/internal/modules/identity/internal/domain"
+	"sso/internal/modules/session"
 	"sso/internal/kernel/actor"
+	"sso/internal/platform/crypto/randtoken"
+	"sso/internal/platform/mail"
 )
 
+// SessionRevoker ends every session of a user after a login-email
+// change. Satisfied by session.Repository.
+type SessionRevoker interface {
+	RevokeAllForUser(ctx context.Context, userID session.UserID, now time.Time) error
+}
+
+// TxRunner runs fn in one database transaction carried by ctx
+// (dbutil.WithTx bound to the module's *sql.DB).
+type TxRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+// EmailChangeConfig carries the settings of the email-change flow.
+type EmailChangeConfig struct {
+	// ConfirmURL / CancelURL are the front-end pages the mailed links
+	// point to; the token is appended as ?token=.
+	ConfirmURL string
+	CancelURL  string
+	// TTL is how long the confirm token stays valid.
+	TTL time.Duration
+	// CancelWindow, counted from the request, is how long the old
+	// address can cancel — and, once confirmed, revert — the change.
+	CancelWindow time.Duration
+}
+
 // Service exposes the identity use-cases. now is injected for testability;
 // production wiring uses time.Now (see bootstrap).
 type Service struct {
-	repo    domain.Repository
-	now     func() time.Time
-	auditor auditx.Auditor
+	repo     domain.Repository
+	changes  domain.EmailChangeRepository
+	sessions SessionRevoker
+	mailer   mail.Sender
+	tx       TxRunner
+	tokens   randtoken.Generator // email-change tokens; only the hash is stored
+	cfg      EmailChangeConfig
+	now      func() time.Time
+	log      *slog.Logger
+	auditor  auditx.Auditor
+	version  authzver.Bumper
 }
 
 // NewService constructs the service. now must not be nil.
-func NewService(log *slog.Logger, repo domain.Repository, now func() time.Time, emitter audit.Emitter) *Service {
-	return &Service{repo: repo, now: now, auditor: auditx.New(log, emitter)}
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	changes domain.EmailChangeRepository,
+	sessions SessionRevoker,
+	mailer mail.Sender,
+	tx TxRunner,
+	cfg EmailChangeConfig,
+	now func() time.Time,
+	emitter audit.Emitter,
+	version authzver.Bumper,
+) *Service {
+	return &Service{
+		repo:     repo,
+		changes:  changes,
+		sessions: sessions,
+		mailer:   mailer,
+		tx:       tx,
+		cfg:      cfg,
+		now:      now,
+		log:      log,
+		auditor:  auditx.New(log, emitter),
+		version:  version,
+	}
 }
 
 // EtagWildcard re-exports auditx.EtagWildcard so existing call sites
@@ -46,14 +105,19 @@ const EtagWildcard = auditx.EtagWildcard
 // errReasonMap maps identity-domain sentinels to their audit (Outcome,
 // Reason) pair. auditx.Classify handles *validation.Error and the
 // default fallback; this table only enumerates per-domain sentinels.
-// Identity has no policy-level rejections — every recognised error is a
-// Failure.
+// Only the token-authenticated email-change steps have policy-level
+// rejections (Deny); everything else is a Failure.
 var errReasonMap = map[error]auditx.OutcomeReason{
 	domain.ErrUserNotFound:      auditx.Fail(audit.ReasonUserNotFound),
 	domain.ErrUserAlreadyExists: auditx.Fail(audit.ReasonUserAlreadyExists),
 	domain.ErrUserDeleted:       auditx.Fail(audit.ReasonUserDeleted),
 	domain.ErrUserNotDeleted:    auditx.Fail(audit.ReasonUserNotDeleted),
 	domain.ErrEtagMismatch:      auditx.Fail(audit.ReasonEtagMismatch),
+
+	domain.ErrEmailChangeTokenInvalid:       auditx.Deny(audit.ReasonEmailChangeTokenInvalid),
+	domain.ErrEmailChangeExpired:            auditx.Deny(audit.ReasonEmailChangeExpired),
+	domain.ErrEmailChangeNotPending:         auditx.Fail(audit.ReasonEmailChangeNotPending),
+	domain.ErrEmailChangeCancelWindowClosed: auditx.Deny(audit.ReasonEmailChangeCancelWindowClosed),
 }
 
 // classifyError is the per-package thin wrapper around auditx.Classify.
@@ -36,6 +36,11 @@ type UpdateUserInput struct {
 
 // UpdateUser applies a FieldMask-driven partial update.
 //
+// This is the admin (and SCIM) path, so email is written directly; the
+// owner's verification flow is RequestEmailChange. A new address
+// supersedes the user's pending email changes in the same transaction,
+// so none of their links can later overwrite it.
+//
 // Errors:
 //
 //	ValidationError       — empty mask, unknown mask path
@@ -84,13 +89,24 @@ func (s *Service) UpdateUser(ctx context.Context, in UpdateUserInput) (*domain.U
 		return nil, err
 	}
 
-	if err := user.ApplyPatch(patch, s.now().UTC()); err != nil {
+	oldEmail := user.Email
+	now := s.now().UTC()
+	if err := user.ApplyPatch(patch, now); err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return nil, err
 	}
 
-	if err := s.repo.Update(ctx, user, expectedEtag); err != nil {
+	err = s.tx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, user, expectedEtag); err != nil {
+			return err
+		}
+		if user.Email == oldEmail {
+			return nil
+		}
+		return s.changes.SupersedePendingEmailChanges(ctx, user.ID(), now)
+	})
+	if err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return nil, err
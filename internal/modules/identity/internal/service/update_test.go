@@ -0,0 +1,31 @@
+package service
+
+import "testing"
+
+func TestUpdateUserSetsEmailDirectly(t *testing.T) {
+	s, changes, mailer, ctx := newEmailChangeService(t)
+	if _, err := s.RequestEmailChange(ctx, RequestEmailChangeInput{NewEmail: "ada@new.example.com"}); err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	mailer.sent = nil
+
+	user, err := s.UpdateUser(ctx, UpdateUserInput{
+		UserID:       s.repo.(*fakeUsers).user.ID().String(),
+		MaskPaths:    []string{"email", "display_name"},
+		ExpectedEtag: EtagWildcard,
+		Email:        "ada@admin.example.com",
+		DisplayName:  "Ada",
+	})
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if user.Email != "ada@admin.example.com" || user.DisplayName != "Ada" {
+		t.Fatalf("user = %q / %q, want the patch applied", user.Email, user.DisplayName)
+	}
+	if len(mailer.sent) != 0 {
+		t.Fatalf("sent %d mails, want none", len(mailer.sent))
+	}
+	if len(changes.pending) != 0 {
+		t.Fatal("the earlier self-service request is still pending")
+	}
+}
@@ -3,6 +3,7 @@
 // pulls everything else off it:
 //
 //	mod.RegisterServer(grpcServer)  // attaches the IdentityService handler
+//	mod.MeRoutes(authn)             // /v1/me and email-change HTTP routes
 //	mod.Repository()                // full persistence contract for auth
 //	mod.UserReader()                // narrow read-only surface for access
 //	mod.Service()                   // full admin Service (rarely needed)
@@ -14,15 +15,21 @@
 package identity
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"time"
 
+	"sso/internal/kernel/authzver"
+	"sso/internal/kernel/dbutil"
 	"sso/internal/modules/audit"
 	grpcadapter "sso/internal/modules/identity/internal/grpc"
+	"sso/internal/modules/identity/internal/httpapi"
 	"sso/internal/modules/identity/internal/mariadb"
 	"sso/internal/modules/identity/internal/service"
+	"sso/internal/platform/mail"
 
 	"google.golang.org/grpc"
 )
@@ -35,19 +42,49 @@ import (
 // dependency. bootstrap supplies the concrete emitter via Deps.
 type Emitter = audit.Emitter
 
+// Authenticator resolves the bearer token of /v1/me requests. Satisfied
+// by *grpcauth.Interceptor.
+type Authenticator = httpapi.Authenticator
+
+// Authorizer decides which /v1/me routes a caller may use. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = httpapi.Authorizer
+
+// SessionRevoker ends a user's sessions after a login-email change.
+// Satisfied by session.Repository.
+type SessionRevoker = service.SessionRevoker
+
+// EmailChangeConfig carries the links and timings of the verified
+// email-change flow.
+type EmailChangeConfig = service.EmailChangeConfig
+
 // Deps lists everything identity needs from its host.
 //
-// DB     — connection pool, owned upstream (bootstrap closes it).
-// Log    — structured logger; required.
+// DB          — connection pool, owned upstream (bootstrap closes it).
+// Log         — structured logger; required.
+// Mailer      — sends the email-change confirmation and notice; required.
+// Sessions    — revokes sessions after an email change; required.
+// EmailChange — ConfirmURL and CancelURL are required; TTL defaults to
+//
+//	24h, CancelWindow to 72h (never shorter than TTL).
+//
 // Clock  — optional; defaults to time.Now when nil.
 // Audit  — optional; defaults to audit.NopEmitter when nil (events are
 //
 //	dropped). bootstrap supplies a real emitter in production.
+//
+// AuthzVersion — optional; bumped after a permanent delete, which takes
+//
+//	the user's grants with it. Defaults to authzver.Nop.
 type Deps struct {
-	DB    *sql.DB
-	Log   *slog.Logger
-	Clock func() time.Time
-	Audit Emitter
+	DB           *sql.DB
+	Log          *slog.Logger
+	Mailer       mail.Sender
+	Sessions     SessionRevoker
+	EmailChange  EmailChangeConfig
+	Clock        func() time.Time
+	Audit        Emitter
+	AuthzVersion authzver.Bumper
 }
 
 // Module is the assembled identity bounded context. Construct with New;
@@ -56,6 +93,7 @@ type Module struct {
 	service *service.Service
 	handler *grpcadapter.Handler
 	repo    *mariadb.Repository
+	log     *slog.Logger
 }
 
 // New wires the module from its dependencies.
@@ -73,12 +111,33 @@ func New(d Deps) (*Module, error) {
 	if d.Log == nil {
 		return nil, fmt.Errorf("identity: log is required")
 	}
+	if d.Mailer == nil {
+		return nil, fmt.Errorf("identity: mailer is required")
+	}
+	if d.Sessions == nil {
+		return nil, fmt.Errorf("identity: sessions is required")
+	}
+	if d.EmailChange.ConfirmURL == "" || d.EmailChange.CancelURL == "" {
+		return nil, fmt.Errorf("identity: email change confirm and cancel urls are required")
+	}
+	if d.EmailChange.TTL <= 0 {
+		d.EmailChange.TTL = 24 * time.Hour
+	}
+	if d.EmailChange.CancelWindow <= 0 {
+		d.EmailChange.CancelWindow = 72 * time.Hour
+	}
+	if d.EmailChange.CancelWindow < d.EmailChange.TTL {
+		d.EmailChange.CancelWindow = d.EmailChange.TTL
+	}
 	if d.Clock == nil {
 		d.Clock = time.Now
 	}
 	if d.Audit == nil {
 		d.Audit = audit.NopEmitter{}
 	}
+	if d.AuthzVersion == nil {
+		d.AuthzVersion = authzver.Nop{}
+	}
 
 	repo := mariadb.NewRepository(d.DB)
 
@@ -88,13 +147,17 @@ func New(d Deps) (*Module, error) {
 	var _ UserReader = repo
 	var _ Repository = repo
 
-	svc := service.NewService(d.Log, repo, d.Clock, d.Audit)
+	tx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return dbutil.WithTx(ctx, d.DB, fn)
+	}
+	svc := service.NewService(d.Log, repo, repo, d.Sessions, d.Mailer, tx, d.EmailChange, d.Clock, d.Audit, d.AuthzVersion)
 	h := grpcadapter.NewHandler(svc, d.Log)
 
 	return &Module{
 		service: svc,
 		handler: h,
 		repo:    repo,
+		log:     d.Log,
 	}, nil
 }
 
@@ -105,6 +168,16 @@ func (m *Module) RegisterServer(s *grpc.Server) {
 	m.handler.RegisterServer(s)
 }
 
+// MeRoutes returns the registrar for the self-service /v1/me endpoints
+// and the public confirm / cancel steps of an email change.
+// The authenticator and authorizer are taken here rather than in Deps
+// because bootstrap builds them (grpcauth.Interceptor,
+// httprbac.Authorizer) after identity.
+func (m *Module) MeRoutes(authn Authenticator, authz Authorizer) func(*http.ServeMux) {
+	h := httpapi.NewHandler(m.service, authn, authz, m.log)
+	return h.Register
+}
+
 // Service returns the application-layer Service. Most callers don't
 // need this — the gRPC handler in this module already routes the
 // public RPCs. Useful for admin tooling that wants to bypass the
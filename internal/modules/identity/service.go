@@ -13,7 +13,8 @@ import "sso/internal/modules/identity/internal/service"
 
 // Service is the use-case orchestrator. Methods correspond 1-to-1 to
 // the IdentityService RPCs and are grouped by intent across files in
-// internal/service: create.go, get.go, update.go, delete.go.
+// internal/service: create.go, get.go, update.go, delete.go, me.go,
+// email_change.go.
 type Service = service.Service
 
 // Input / Output type aliases. One per RPC; the names match the methods
@@ -27,6 +28,10 @@ type (
 	EnableUserInput            = service.EnableUserInput
 	SoftDeleteUserInput        = service.SoftDeleteUserInput
 	PermanentlyDeleteUserInput = service.PermanentlyDeleteUserInput
+	UpdateMeInput              = service.UpdateMeInput
+	RequestEmailChangeInput    = service.RequestEmailChangeInput
+	ConfirmEmailChangeInput    = service.ConfirmEmailChangeInput
+	CancelEmailChangeInput     = service.CancelEmailChangeInput
 )
 
 // EtagWildcard is the wire-level sentinel meaning "skip optimistic
@@ -0,0 +1,38 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvitationNotFound = errors.New("invitation: not found")
+	ErrEtagMismatch       = errors.New("invitation: etag mismatch")
+
+	// ErrInvitationNotPending — the invitation was already accepted or
+	// revoked; it can no longer be resent, revoked or accepted.
+	ErrInvitationNotPending = errors.New("invitation: not pending")
+
+	// ErrInvitationExpired — a pending invitation past its expiry. Resend
+	// issues a fresh token and expiry.
+	ErrInvitationExpired = errors.New("invitation: expired")
+
+	// ErrInvalidToken — the acceptance token matches no invitation.
+	// Unknown, rotated (by Resend) and malformed tokens are
+	// indistinguishable on purpose.
+	ErrInvalidToken = errors.New("invitation: invalid token")
+
+	// ErrPendingInvitationExists — the address already has a pending
+	// invitation; resend or revoke that one instead.
+	ErrPendingInvitationExists = errors.New("invitation: pending invitation exists")
+
+	// ErrInviteDenied — the inviter lacks the permission to invite, or to
+	// grant the invited roles directly; an invitation cannot carry roles
+	// its sender could not grant.
+	ErrInviteDenied = errors.New("invitation: inviter may not grant the invited roles")
+
+	// ErrUserBlocked — the invited address belongs to a blocked account.
+	// An invitation neither unblocks it nor grants it roles.
+	ErrUserBlocked = errors.New("invitation: invited account is blocked")
+
+	// ErrDeliveryFailed — the mail sender refused the invitation mail;
+	// nothing was stored or changed.
+	ErrDeliveryFailed = errors.New("invitation: mail delivery failed")
+)
@@ -0,0 +1,261 @@
+// Package domain holds the Invitation aggregate of the invitation
+// bounded context: an admin's offer of an account (with pre-selected
+// roles) to an email address, accepted once through a secret token
+// mailed to that address.
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/validation"
+)
+
+// ----------------------------------------------------------------------------
+// IDs
+// ----------------------------------------------------------------------------
+
+// InvitationID — RFC 4122 UUID, generated as v7 (k-sortable).
+type InvitationID string
+
+func NewInvitationID() (InvitationID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate invitation id: %w", err)
+	}
+	return InvitationID(id.String()), nil
+}
+
+func ParseInvitationID(s string) (InvitationID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "invitation_id", Reason: "must be a valid UUID"}
+	}
+	return InvitationID(s), nil
+}
+
+func (id InvitationID) String() string { return string(id) }
+
+// UserID is a cross-context handle to identity.User.
+type UserID string
+
+func (id UserID) String() string { return string(id) }
+
+// ActorID is the user or service account that sent the invitation.
+type ActorID string
+
+func (id ActorID) String() string { return string(id) }
+
+// RoleID is a cross-context handle to role.Role.
+type RoleID string
+
+func (id RoleID) String() string { return string(id) }
+
+// ----------------------------------------------------------------------------
+// Status
+// ----------------------------------------------------------------------------
+
+// InvitationStatus is the on-wire value of invitations.status — do not
+// renumber. Expiry is not a status: a pending invitation past
+// ExpiresAt is reported as expired but can still be resent.
+type InvitationStatus uint8
+
+const (
+	InvitationStatusPending  InvitationStatus = 1
+	InvitationStatusAccepted InvitationStatus = 2
+	InvitationStatusRevoked  InvitationStatus = 3
+)
+
+func ParseInvitationStatus(s string) (InvitationStatus, error) {
+	switch s {
+	case "pending":
+		return InvitationStatusPending, nil
+	case "accepted":
+		return InvitationStatusAccepted, nil
+	case "revoked":
+		return InvitationStatusRevoked, nil
+	}
+	return 0, &validation.Error{Field: "status", Reason: "must be one of: pending, accepted, revoked"}
+}
+
+func (s InvitationStatus) String() string {
+	switch s {
+	case InvitationStatusPending:
+		return "pending"
+	case InvitationStatusAccepted:
+		return "accepted"
+	case InvitationStatusRevoked:
+		return "revoked"
+	}
+	return fmt.Sprintf("InvitationStatus(%d)", s)
+}
+
+// ----------------------------------------------------------------------------
+// Invitation aggregate
+// ----------------------------------------------------------------------------
+//
+// Everything but the address and the roles changes only through the
+// lifecycle methods (Reissue, Revoke, Accept), which advance the etag.
+// tenantID, the tenant the account is created in, is fixed at creation.
+// Only the SHA-256 of the token is kept; the token itself exists in the
+// mail and nowhere else.
+
+type Invitation struct {
+	id         InvitationID
+	tenantID   string
+	status     InvitationStatus
+	tokenHash  []byte
+	invitedBy  ActorID
+	acceptedBy UserID
+	etag       etag.Etag
+	expiresAt  time.Time
+	acceptedAt time.Time
+	createdAt  time.Time
+	updatedAt  time.Time
+
+	Email   string
+	RoleIDs []RoleID
+}
+
+type NewInvitationParams struct {
+	ID        InvitationID
+	TenantID  string
+	Email     string
+	RoleIDs   []RoleID
+	InvitedBy ActorID
+	TokenHash []byte
+	ExpiresAt time.Time
+	Now       time.Time
+}
+
+// NewInvitation builds a pending invitation. The address is normalised
+// to its bare, lower-cased form.
+func NewInvitation(p NewInvitationParams) (*Invitation, error) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(p.Email))
+	if err != nil || addr.Name != "" || len(addr.Address) > 255 {
+		return nil, &validation.Error{Field: "email", Reason: "must be a bare email address"}
+	}
+	seen := make(map[RoleID]bool, len(p.RoleIDs))
+	roles := make([]RoleID, 0, len(p.RoleIDs))
+	for _, r := range p.RoleIDs {
+		if _, err := uuid.Parse(r.String()); err != nil {
+			return nil, &validation.Error{Field: "role_ids", Reason: "must be valid UUIDs"}
+		}
+		if !seen[r] {
+			seen[r] = true
+			roles = append(roles, r)
+		}
+	}
+	return &Invitation{
+		id:        p.ID,
+		tenantID:  p.TenantID,
+		status:    InvitationStatusPending,
+		tokenHash: p.TokenHash,
+		invitedBy: p.InvitedBy,
+		etag:      etag.New(),
+		expiresAt: p.ExpiresAt,
+		createdAt: p.Now,
+		updatedAt: p.Now,
+		Email:     strings.ToLower(addr.Address),
+		RoleIDs:   roles,
+	}, nil
+}
+
+type RestoreInvitationParams struct {
+	ID         InvitationID
+	TenantID   string
+	Status     InvitationStatus
+	TokenHash  []byte
+	Email      string
+	RoleIDs    []RoleID
+	InvitedBy  ActorID
+	AcceptedBy UserID
+	Etag       etag.Etag
+	ExpiresAt  time.Time
+	AcceptedAt time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// RestoreInvitation rebuilds an Invitation from a trusted row.
+func RestoreInvitation(p RestoreInvitationParams) *Invitation {
+	return &Invitation{
+		id:         p.ID,
+		tenantID:   p.TenantID,
+		status:     p.Status,
+		tokenHash:  p.TokenHash,
+		invitedBy:  p.InvitedBy,
+		acceptedBy: p.AcceptedBy,
+		etag:       p.Etag,
+		expiresAt:  p.ExpiresAt,
+		acceptedAt: p.AcceptedAt,
+		createdAt:  p.CreatedAt,
+		updatedAt:  p.UpdatedAt,
+		Email:      p.Email,
+		RoleIDs:    p.RoleIDs,
+	}
+}
+
+func (i *Invitation) ID() InvitationID         { return i.id }
+func (i *Invitation) TenantID() string         { return i.tenantID }
+func (i *Invitation) Status() InvitationStatus { return i.status }
+func (i *Invitation) TokenHash() []byte        { return i.tokenHash }
+func (i *Invitation) InvitedBy() ActorID       { return i.invitedBy }
+func (i *Invitation) AcceptedBy() UserID       { return i.acceptedBy }
+func (i *Invitation) Etag() etag.Etag          { return i.etag }
+func (i *Invitation) ExpiresAt() time.Time     { return i.expiresAt }
+func (i *Invitation) AcceptedAt() time.Time    { return i.acceptedAt }
+func (i *Invitation) CreatedAt() time.Time     { return i.createdAt }
+func (i *Invitation) UpdatedAt() time.Time     { return i.updatedAt }
+
+// IsExpired reports whether a pending invitation can no longer be
+// accepted.
+func (i *Invitation) IsExpired(now time.Time) bool {
+	return i.status == InvitationStatusPending && !now.Before(i.expiresAt)
+}
+
+// Reissue replaces the token and restarts the expiry clock; the old
+// token stops working. Allowed on pending invitations, expired or not.
+func (i *Invitation) Reissue(tokenHash []byte, expiresAt, now time.Time) error {
+	if i.status != InvitationStatusPending {
+		return ErrInvitationNotPending
+	}
+	i.tokenHash = tokenHash
+	i.expiresAt = expiresAt
+	i.bumpVersion(now)
+	return nil
+}
+
+// Revoke withdraws a pending invitation.
+func (i *Invitation) Revoke(now time.Time) error {
+	if i.status != InvitationStatusPending {
+		return ErrInvitationNotPending
+	}
+	i.status = InvitationStatusRevoked
+	i.bumpVersion(now)
+	return nil
+}
+
+// Accept records that userID took up the invitation.
+func (i *Invitation) Accept(userID UserID, now time.Time) error {
+	if i.status != InvitationStatusPending {
+		return ErrInvitationNotPending
+	}
+	if i.IsExpired(now) {
+		return ErrInvitationExpired
+	}
+	i.status = InvitationStatusAccepted
+	i.acceptedBy = userID
+	i.acceptedAt = now
+	i.bumpVersion(now)
+	return nil
+}
+
+func (i *Invitation) bumpVersion(now time.Time) {
+	i.etag = etag.New()
+	i.updatedAt = now
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"sso/internal/kernel/etag"
+)
+
+// Repository is the persistence contract for the invitation context.
+//
+// Error contract:
+//
+//	Create                   → ErrPendingInvitationExists (address has one)
+//	GetByID                  → ErrInvitationNotFound
+//	GetByTokenHashForUpdate  → ErrInvalidToken
+//	Update                   → ErrInvitationNotFound / ErrEtagMismatch
+//
+// expectedEtag "" means unconditional, same as every other module.
+type Repository interface {
+	Create(ctx context.Context, inv *Invitation) error
+	GetByID(ctx context.Context, id InvitationID) (*Invitation, error)
+	// GetByTokenHashForUpdate locks the row for the rest of the ambient
+	// transaction (dbutil.WithTx), so one token is accepted once.
+	GetByTokenHashForUpdate(ctx context.Context, tokenHash []byte) (*Invitation, error)
+	List(ctx context.Context, q ListQuery) (ListResult, error)
+	Update(ctx context.Context, inv *Invitation, expectedEtag etag.Etag) error
+}
+
+// ListQuery pages invitations newest first. Statuses empty = all.
+type ListQuery struct {
+	PageSize int
+	After    *PageCursor
+	Email    string
+	Statuses []InvitationStatus
+}
+
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        InvitationID
+}
+
+type ListResult struct {
+	Invitations []*Invitation
+	NextCursor  *PageCursor
+}
@@ -0,0 +1,53 @@
+package httpapi
+
+import (
+	"sso/internal/modules/access"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/invitation/internal/domain"
+	"sso/internal/modules/role"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap translates invitation sentinels, and the identity / role /
+// access ones the use-cases pass through, into statuses. errors.proto
+// has no invitation reasons yet, so those entries are bare statuses
+// (Reason UNSPECIFIED).
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrInvitationNotFound: {
+		Code: codes.NotFound, Message: "invitation not found"},
+	domain.ErrInvitationNotPending: {
+		Code: codes.FailedPrecondition, Message: "invitation is no longer pending"},
+	domain.ErrInvitationExpired: {
+		Code: codes.FailedPrecondition, Message: "invitation has expired"},
+	domain.ErrInvalidToken: {
+		Code: codes.InvalidArgument, Message: "invalid invitation token"},
+	domain.ErrPendingInvitationExists: {
+		Code: codes.AlreadyExists, Message: "a pending invitation for this address already exists"},
+	domain.ErrInviteDenied: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "permission denied"},
+	domain.ErrUserBlocked: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_BLOCKED, Message: "user is blocked"},
+	domain.ErrDeliveryFailed: {
+		Code: codes.Unavailable, Message: "invitation mail could not be sent"},
+	domain.ErrEtagMismatch: {
+		Code: codes.Aborted, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ETAG_MISMATCH, Message: "etag mismatch"},
+	role.ErrRoleNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_FOUND, Message: "role not found"},
+	role.ErrRoleDisabled: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_DISABLED, Message: "role is disabled"},
+	access.ErrRoleNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_FOUND, Message: "role not found"},
+	access.ErrRoleDisabled: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_DISABLED, Message: "role is disabled"},
+	identity.ErrUserAlreadyExists: {
+		Code: codes.AlreadyExists, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_ALREADY_EXISTS, Message: "username already in use"},
+	identity.ErrUserDeleted: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_DELETED, Message: "user is deleted"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
@@ -0,0 +1,216 @@
+// Package httpapi is the HTTP adapter for the invitation context.
+//
+// The InvitationService contract is not part of the published
+// sso_protos, so these are hand-written net/http handlers mounted next
+// to the grpc-gateway. Error bodies use the same google.rpc.Status JSON
+// shape as the gateway, so clients need a single error decoder.
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/invitation/internal/domain"
+	invsvc "sso/internal/modules/invitation/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+type Handler struct {
+	svc *invsvc.Service
+	api *apiutil.Adapter
+	log *slog.Logger
+}
+
+func NewHandler(svc *invsvc.Service, authn Authenticator, authz Authorizer, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("invitation", authn, authz, log, toStatus), log: log}
+}
+
+// Register mounts the invitation endpoints. Acceptance is public — the
+// token in the body is the credential; everything else is admin.
+//
+//	POST   /v1/invitations/accept                     accept (public)
+//	GET    /v1/invitations?status=&email=&page_size=&page_token=
+//	POST   /v1/invitations
+//	GET    /v1/invitations/{id}
+//	POST   /v1/invitations/{id}/resend
+//	DELETE /v1/invitations/{id}?etag=                 revoke
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v1/invitations/accept", h.accept)
+	mux.HandleFunc("GET /v1/invitations", h.api.Authed(h.list))
+	mux.HandleFunc("POST /v1/invitations", h.api.Authed(h.create))
+	mux.HandleFunc("GET /v1/invitations/{id}", h.api.Authed(h.get))
+	mux.HandleFunc("POST /v1/invitations/{id}/resend", h.api.Authed(h.resend))
+	mux.HandleFunc("DELETE /v1/invitations/{id}", h.api.Authed(h.revoke))
+}
+
+// ----------------------------------------------------------------------------
+// Admin
+// ----------------------------------------------------------------------------
+
+type createBody struct {
+	Email   string   `json:"email"`
+	RoleIDs []string `json:"role_ids"`
+}
+
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	var b createBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	inv, err := h.svc.CreateInvitation(r.Context(), invsvc.CreateInvitationInput{
+		Email:   b.Email,
+		RoleIDs: b.RoleIDs,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusCreated, invitationView(inv, time.Now()))
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	in := invsvc.ListInvitationsInput{
+		PageToken: q.Get("page_token"),
+		Email:     q.Get("email"),
+	}
+	if v := q.Get("page_size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			h.api.WriteError(w, r, &validation.Error{Field: "page_size", Reason: "must be an integer"})
+			return
+		}
+		in.PageSize = int32(n)
+	}
+	for _, v := range apiutil.SplitList(q.Get("status")) {
+		st, err := domain.ParseInvitationStatus(v)
+		if err != nil {
+			h.api.WriteError(w, r, err)
+			return
+		}
+		in.Statuses = append(in.Statuses, st)
+	}
+	out, err := h.svc.ListInvitations(r.Context(), in)
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	now := time.Now()
+	views := make([]map[string]any, 0, len(out.Invitations))
+	for _, inv := range out.Invitations {
+		views = append(views, invitationView(inv, now))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"invitations":     views,
+		"next_page_token": out.NextPageToken,
+	})
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
+	inv, err := h.svc.GetInvitation(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, invitationView(inv, time.Now()))
+}
+
+func (h *Handler) resend(w http.ResponseWriter, r *http.Request) {
+	inv, err := h.svc.ResendInvitation(r.Context(), invsvc.ResendInvitationInput{
+		InvitationID: r.PathValue("id"),
+		ExpectedEtag: r.URL.Query().Get("etag"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, invitationView(inv, time.Now()))
+}
+
+func (h *Handler) revoke(w http.ResponseWriter, r *http.Request) {
+	_, err := h.svc.RevokeInvitation(r.Context(), invsvc.RevokeInvitationInput{
+		InvitationID: r.PathValue("id"),
+		ExpectedEtag: r.URL.Query().Get("etag"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ----------------------------------------------------------------------------
+// Acceptance
+// ----------------------------------------------------------------------------
+
+type acceptBody struct {
+	Token       string `json:"token"`
+	Password    string `json:"password"`
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name"`
+}
+
+func (h *Handler) accept(w http.ResponseWriter, r *http.Request) {
+	var b acceptBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.AcceptInvitation(r.Context(), invsvc.AcceptInvitationInput{
+		Token:       b.Token,
+		Password:    b.Password,
+		Username:    b.Username,
+		DisplayName: b.DisplayName,
+		IpAddress:   apiutil.ClientIP(r),
+		UserAgent:   r.UserAgent(),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"user_id":  out.User.ID().String(),
+		"email":    out.User.Email,
+		"username": out.User.Username,
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Views — the token hash is never rendered.
+// ----------------------------------------------------------------------------
+
+func invitationView(inv *domain.Invitation, now time.Time) map[string]any {
+	roles := make([]string, 0, len(inv.RoleIDs))
+	for _, id := range inv.RoleIDs {
+		roles = append(roles, id.String())
+	}
+	v := map[string]any{
+		"id":         inv.ID().String(),
+		"email":      inv.Email,
+		"role_ids":   roles,
+		"status":     inv.Status().String(),
+		"expired":    inv.IsExpired(now),
+		"invited_by": inv.InvitedBy().String(),
+		"etag":       inv.Etag().String(),
+		"expires_at": inv.ExpiresAt().UTC().Format(time.RFC3339),
+		"created_at": inv.CreatedAt().UTC().Format(time.RFC3339),
+		"updated_at": inv.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+	if inv.Status() == domain.InvitationStatusAccepted {
+		v["accepted_by"] = inv.AcceptedBy().String()
+		v["accepted_at"] = inv.AcceptedAt().UTC().Format(time.RFC3339)
+	}
+	return v
+}
@@ -0,0 +1,88 @@
+package mariadb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/invitation/internal/domain"
+	"sso/internal/modules/invitation/internal/mariadb/dbgen"
+)
+
+// List is hand-written: the status and email filters are optional and
+// the keyset cursor is (created_at, id) descending. Rows are filtered
+// to tenant.Scope.
+func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListResult, error) {
+	if q.PageSize <= 0 {
+		return domain.ListResult{}, fmt.Errorf("invitation repo: list: page_size must be > 0")
+	}
+
+	var (
+		where []string
+		args  []any
+	)
+	if id := tenant.Scope(ctx); id != "" {
+		where = append(where, "tenant_id = ?")
+		args = append(args, id)
+	}
+	if q.Email != "" {
+		where = append(where, "email = ?")
+		args = append(args, q.Email)
+	}
+	if len(q.Statuses) > 0 {
+		ph := make([]string, 0, len(q.Statuses))
+		for _, s := range q.Statuses {
+			ph = append(ph, "?")
+			args = append(args, uint8(s))
+		}
+		where = append(where, "status IN ("+strings.Join(ph, ", ")+")")
+	}
+	if q.After != nil {
+		where = append(where, "(created_at, id) < (?, ?)")
+		args = append(args, q.After.CreatedAt, q.After.ID.String())
+	}
+	whereSQL := ""
+	if len(where) > 0 {
+		whereSQL = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	query := fmt.Sprintf(
+		`SELECT id, email, role_ids, status, token_hash, invited_by, accepted_by,
+		        etag, expires_at, accepted_at, created_at, updated_at, pending_email, tenant_id
+		 FROM invitations %s ORDER BY created_at DESC, id DESC LIMIT %d`,
+		whereSQL, q.PageSize+1,
+	)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return domain.ListResult{}, fmt.Errorf("invitation repo: list: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*domain.Invitation, 0, q.PageSize)
+	for rows.Next() {
+		var i dbgen.Invitation
+		if err := rows.Scan(
+			&i.ID, &i.Email, &i.RoleIds, &i.Status, &i.TokenHash, &i.InvitedBy, &i.AcceptedBy,
+			&i.Etag, &i.ExpiresAt, &i.AcceptedAt, &i.CreatedAt, &i.UpdatedAt, &i.PendingEmail, &i.TenantID,
+		); err != nil {
+			return domain.ListResult{}, fmt.Errorf("invitation repo: list: scan: %w", err)
+		}
+		inv, err := invitationToDomain(i)
+		if err != nil {
+			return domain.ListResult{}, err
+		}
+		out = append(out, inv)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.ListResult{}, fmt.Errorf("invitation repo: list: rows: %w", err)
+	}
+
+	var next *domain.PageCursor
+	if len(out) > q.PageSize {
+		out = out[:q.PageSize]
+		last := out[len(out)-1]
+		next = &domain.PageCursor{CreatedAt: last.CreatedAt(), ID: last.ID()}
+	}
+	return domain.ListResult{Invitations: out, NextCursor: next}, nil
+}
@@ -0,0 +1,111 @@
+package mariadb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/invitation/internal/domain"
+	"sso/internal/modules/invitation/internal/mariadb/dbgen"
+)
+
+func invitationToDomain(r dbgen.Invitation) (*domain.Invitation, error) {
+	roles, err := rolesFromDB(r.RoleIds)
+	if err != nil {
+		return nil, err
+	}
+	return domain.RestoreInvitation(domain.RestoreInvitationParams{
+		ID:         domain.InvitationID(r.ID),
+		TenantID:   r.TenantID,
+		Status:     domain.InvitationStatus(r.Status),
+		TokenHash:  r.TokenHash,
+		Email:      r.Email,
+		RoleIDs:    roles,
+		InvitedBy:  domain.ActorID(r.InvitedBy),
+		AcceptedBy: domain.UserID(r.AcceptedBy.String),
+		Etag:       etag.Etag(r.Etag),
+		ExpiresAt:  r.ExpiresAt,
+		AcceptedAt: r.AcceptedAt.Time,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}), nil
+}
+
+func toCreateParams(inv *domain.Invitation) (dbgen.CreateInvitationParams, error) {
+	roles, err := rolesToDB(inv.RoleIDs)
+	if err != nil {
+		return dbgen.CreateInvitationParams{}, err
+	}
+	return dbgen.CreateInvitationParams{
+		ID:         inv.ID().String(),
+		Email:      inv.Email,
+		RoleIds:    roles,
+		Status:     uint8(inv.Status()),
+		TokenHash:  inv.TokenHash(),
+		InvitedBy:  inv.InvitedBy().String(),
+		AcceptedBy: dbutil.StringToNullString(inv.AcceptedBy().String()),
+		Etag:       inv.Etag().String(),
+		ExpiresAt:  inv.ExpiresAt(),
+		AcceptedAt: dbutil.TimeToNullTime(inv.AcceptedAt()),
+		CreatedAt:  inv.CreatedAt(),
+		UpdatedAt:  inv.UpdatedAt(),
+		TenantID:   inv.TenantID(),
+	}, nil
+}
+
+func toUpdateParams(inv *domain.Invitation) dbgen.UpdateInvitationParams {
+	return dbgen.UpdateInvitationParams{
+		Status:     uint8(inv.Status()),
+		TokenHash:  inv.TokenHash(),
+		AcceptedBy: dbutil.StringToNullString(inv.AcceptedBy().String()),
+		Etag:       inv.Etag().String(),
+		ExpiresAt:  inv.ExpiresAt(),
+		AcceptedAt: dbutil.TimeToNullTime(inv.AcceptedAt()),
+		UpdatedAt:  inv.UpdatedAt(),
+		ID:         inv.ID().String(),
+	}
+}
+
+// toUpdateWithEtagParams — Etag_2 is sqlc's positional name for the
+// `etag = ?` in the WHERE clause.
+func toUpdateWithEtagParams(inv *domain.Invitation, expected etag.Etag) dbgen.UpdateInvitationWithEtagParams {
+	u := toUpdateParams(inv)
+	return dbgen.UpdateInvitationWithEtagParams{
+		Status:     u.Status,
+		TokenHash:  u.TokenHash,
+		AcceptedBy: u.AcceptedBy,
+		Etag:       u.Etag,
+		ExpiresAt:  u.ExpiresAt,
+		AcceptedAt: u.AcceptedAt,
+		UpdatedAt:  u.UpdatedAt,
+		ID:         u.ID,
+		Etag_2:     expected.String(),
+	}
+}
+
+// role_ids is a JSON array of strings; an invitation without roles
+// stores [] rather than NULL.
+func rolesToDB(ids []domain.RoleID) (json.RawMessage, error) {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id.String())
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("invitation mapper: role_ids: %w", err)
+	}
+	return b, nil
+}
+
+func rolesFromDB(raw json.RawMessage) ([]domain.RoleID, error) {
+	var ids []string
+	if err := json.Unmarshal(raw, &ids); err != nil {
+		return nil, fmt.Errorf("invitation mapper: role_ids: %w", err)
+	}
+	out := make([]domain.RoleID, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, domain.RoleID(id))
+	}
+	return out, nil
+}
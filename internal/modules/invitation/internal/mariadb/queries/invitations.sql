@@ -0,0 +1,34 @@
+-- Invitations. List is hand-written (list.go).
+
+-- name: CreateInvitation :exec
+INSERT INTO invitations (
+    id, email, role_ids, status, token_hash, invited_by, accepted_by,
+    etag, expires_at, accepted_at, created_at, updated_at, tenant_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetInvitationByID :one
+SELECT * FROM invitations
+WHERE id = ?
+LIMIT 1;
+
+-- name: GetInvitationByTokenHashForUpdate :one
+SELECT * FROM invitations
+WHERE token_hash = ?
+LIMIT 1
+FOR UPDATE;
+
+-- name: UpdateInvitation :execresult
+UPDATE invitations
+SET status = ?, token_hash = ?, accepted_by = ?, etag = ?,
+    expires_at = ?, accepted_at = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: UpdateInvitationWithEtag :execresult
+UPDATE invitations
+SET status = ?, token_hash = ?, accepted_by = ?, etag = ?,
+    expires_at = ?, accepted_at = ?, updated_at = ?
+WHERE id = ? AND etag = ?;
+
+-- name: CountInvitationByID :one
+SELECT COUNT(*) FROM invitations
+WHERE id = ?;
@@ -0,0 +1,107 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/invitation/internal/domain"
+	"sso/internal/modules/invitation/internal/mariadb/dbgen"
+)
+
+type Repository struct {
+	db *sql.DB
+	q  *dbgen.Queries
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db, q: dbgen.New(db)}
+}
+
+var _ domain.Repository = (*Repository)(nil)
+
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one; acceptance locks and updates the
+// invitation in the same transaction that creates the user.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
+func (r *Repository) Create(ctx context.Context, inv *domain.Invitation) error {
+	params, err := toCreateParams(inv)
+	if err != nil {
+		return err
+	}
+	if err := r.queries(ctx).CreateInvitation(ctx, params); err != nil {
+		// uk_invitations_pending_email; token_hash collisions of 256
+		// random bits are not a case worth a sentinel.
+		if dbutil.IsDuplicateEntry(err) {
+			return domain.ErrPendingInvitationExists
+		}
+		return fmt.Errorf("invitation repo: create: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id domain.InvitationID) (*domain.Invitation, error) {
+	row, err := r.queries(ctx).GetInvitationByID(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("invitation repo: get: %w", err)
+	}
+	if !tenant.Visible(ctx, row.TenantID) {
+		return nil, domain.ErrInvitationNotFound
+	}
+	return invitationToDomain(row)
+}
+
+// GetByTokenHashForUpdate takes the row lock with FOR UPDATE. Outside
+// dbutil.WithTx the lock ends with the statement, so callers that rely
+// on it must run inside one.
+func (r *Repository) GetByTokenHashForUpdate(ctx context.Context, tokenHash []byte) (*domain.Invitation, error) {
+	row, err := r.queries(ctx).GetInvitationByTokenHashForUpdate(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvalidToken
+		}
+		return nil, fmt.Errorf("invitation repo: get_by_token: %w", err)
+	}
+	return invitationToDomain(row)
+}
+
+func (r *Repository) Update(ctx context.Context, inv *domain.Invitation, expectedEtag etag.Etag) error {
+	q := r.queries(ctx)
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = q.UpdateInvitation(ctx, toUpdateParams(inv))
+	} else {
+		res, err = q.UpdateInvitationWithEtag(ctx, toUpdateWithEtagParams(inv, expectedEtag))
+	}
+	if err != nil {
+		return fmt.Errorf("invitation repo: update: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("invitation repo: rows_affected: %w", err)
+	}
+	if rows == 1 {
+		return nil
+	}
+	return dbutil.Discriminate(ctx, expectedEtag,
+		func(ctx context.Context) (int64, error) {
+			return q.CountInvitationByID(ctx, inv.ID().String())
+		},
+		domain.ErrInvitationNotFound, domain.ErrEtagMismatch)
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/access"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/invitation/internal/domain"
+	"sso/internal/platform/crypto/passwordhash"
+)
+
+// ----------------------------------------------------------------------------
+// AcceptInvitation
+// ----------------------------------------------------------------------------
+
+// AcceptInvitationInput — the token is the only credential. Password,
+// Username and DisplayName are used only when a new account is created:
+// Password is then required, and an empty Username defaults to the local
+// part of the invited address.
+type AcceptInvitationInput struct {
+	Token       string
+	Password    string
+	Username    string
+	DisplayName string
+	IpAddress   string
+	UserAgent   string
+}
+
+type AcceptInvitationOutput struct {
+	User       *identity.User
+	Invitation *domain.Invitation
+}
+
+// AcceptInvitation redeems a token: it creates the account for the
+// invited address, or picks the existing active one, and grants the
+// invited roles, all in one transaction with the invitation row locked,
+// so a token is redeemed at most once and a failed grant leaves neither
+// account nor invitation changed.
+//
+// An existing account keeps its credentials and status: the token only
+// proves control of the mailbox, and the account may be protected by
+// more than its password (MFA, a federated login). A blocked or deleted
+// account is refused rather than reactivated.
+//
+// Grants are made by SYSTEM on behalf of the inviter, who is recorded as
+// granted_by_user_id.
+func (s *Service) AcceptInvitation(ctx context.Context, in AcceptInvitationInput) (AcceptInvitationOutput, error) {
+	if err := validateAcceptInput(in); err != nil {
+		return AcceptInvitationOutput{}, err
+	}
+	aud := audit.NewAuditParams{
+		EventType:   audit.EventTypeInvitationAccept,
+		ActorType:   audit.ActorTypeAnonymous,
+		SubjectType: audit.SubjectTypeInvitation,
+		IpAddress:   in.IpAddress,
+		UserAgent:   in.UserAgent,
+	}
+
+	// bcrypt is slow on purpose; keep it out of the transaction. The
+	// hash goes unused when the address already has an account.
+	var hash []byte
+	if in.Password != "" {
+		var err error
+		if hash, err = passwordhash.Hash(in.Password, s.cfg.BcryptCost); err != nil {
+			return AcceptInvitationOutput{}, fmt.Errorf("accept invitation: hash password: %w", err)
+		}
+	}
+
+	var out AcceptInvitationOutput
+	err := s.tx(ctx, func(ctx context.Context) error {
+		inv, err := s.repo.GetByTokenHashForUpdate(ctx, s.tokens.Hash(in.Token))
+		if err != nil {
+			return err
+		}
+		aud.SubjectID = inv.ID().String()
+		now := s.now().UTC()
+		if inv.Status() != domain.InvitationStatusPending {
+			return domain.ErrInvitationNotPending
+		}
+		if inv.IsExpired(now) {
+			return domain.ErrInvitationExpired
+		}
+		// The account and its grants live in the inviter's tenant.
+		ctx = tenant.With(ctx, inv.TenantID())
+
+		u, err := s.upsertUser(ctx, inv, in, hash)
+		if err != nil {
+			return err
+		}
+
+		sys := actor.System()
+		sys.IpAddress, sys.UserAgent = in.IpAddress, in.UserAgent
+		grantCtx := actor.Inject(ctx, sys)
+		for _, rid := range inv.RoleIDs {
+			if _, err := s.grants.GrantRoleToUser(grantCtx, access.GrantRoleToUserInput{
+				UserID:  u.ID().String(),
+				RoleID:  rid.String(),
+				ActorID: inv.InvitedBy().String(),
+			}); err != nil {
+				return err
+			}
+		}
+
+		preEtag := inv.Etag()
+		if err := inv.Accept(domain.UserID(u.ID().String()), now); err != nil {
+			return err
+		}
+		if err := s.repo.Update(ctx, inv, preEtag); err != nil {
+			return err
+		}
+		out = AcceptInvitationOutput{User: u, Invitation: inv}
+		return nil
+	})
+	if err != nil {
+		o, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, o, reason))
+		return AcceptInvitationOutput{}, fmt.Errorf("accept invitation: %w", err)
+	}
+
+	aud.Metadata = map[string]string{"user_id": out.User.ID().String()}
+	s.auditor.Success(ctx, aud)
+	return out, nil
+}
+
+// upsertUser creates the invited account, or returns the existing
+// active account with that address unchanged. A blocked account is not
+// unblocked and a soft-deleted one is not brought back.
+func (s *Service) upsertUser(ctx context.Context, inv *domain.Invitation, in AcceptInvitationInput, hash []byte) (*identity.User, error) {
+	now := s.now().UTC()
+	u, err := s.users.GetByEmail(ctx, inv.Email)
+	switch {
+	case errors.Is(err, identity.ErrUserNotFound):
+		if hash == nil {
+			return nil, &validation.Error{Field: "password", Reason: "required for a new account"}
+		}
+		id, err := identity.NewUserID()
+		if err != nil {
+			return nil, err
+		}
+		username := strings.TrimSpace(in.Username)
+		if username == "" {
+			username, _, _ = strings.Cut(inv.Email, "@")
+		}
+		u = identity.NewUser(identity.NewUserParams{
+			ID:           id,
+			TenantID:     inv.TenantID(),
+			Email:        inv.Email,
+			Username:     username,
+			DisplayName:  strings.TrimSpace(in.DisplayName),
+			PasswordHash: hash,
+			Now:          now,
+		})
+		if err := s.users.Create(ctx, u); err != nil {
+			return nil, err
+		}
+		return u, nil
+	case err != nil:
+		return nil, err
+	}
+
+	switch u.Status() {
+	case identity.UserStatusDeleted:
+		return nil, identity.ErrUserDeleted
+	case identity.UserStatusBlocked:
+		return nil, domain.ErrUserBlocked
+	}
+	return u, nil
+}
+
+func validateAcceptInput(in AcceptInvitationInput) error {
+	if in.Token == "" {
+		return &validation.Error{Field: "token", Reason: "required"}
+	}
+	// bcrypt ignores everything past 72 bytes; refuse rather than
+	// silently truncate.
+	if len(in.Password) > 72 {
+		return &validation.Error{Field: "password", Reason: "must be at most 72 bytes"}
+	}
+	return nil
+}
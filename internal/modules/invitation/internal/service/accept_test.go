@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/invitation/internal/domain"
+)
+
+// fakeUsers serves one account by email and fails the test on any
+// write: accepting for an existing address must leave the account as is.
+type fakeUsers struct {
+	identity.Repository
+	t    *testing.T
+	user *identity.User
+}
+
+func (f fakeUsers) GetByEmail(context.Context, string) (*identity.User, error) {
+	if f.user == nil {
+		return nil, identity.ErrUserNotFound
+	}
+	return f.user, nil
+}
+
+func (f fakeUsers) Update(context.Context, *identity.User, etag.Etag) error {
+	f.t.Fatal("existing account was updated")
+	return nil
+}
+
+func (f fakeUsers) Create(context.Context, *identity.User) error {
+	f.t.Fatal("account was created")
+	return nil
+}
+
+func TestUpsertUserLeavesExistingAccounts(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	inv, err := domain.NewInvitation(domain.NewInvitationParams{
+		ID:        domain.InvitationID("0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d92"),
+		Email:     "known@example.com",
+		InvitedBy: domain.ActorID("0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90"),
+		TokenHash: []byte("hash"),
+		ExpiresAt: time.Now().Add(time.Hour),
+		Now:       time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("NewInvitation: %v", err)
+	}
+	existing := func(st identity.UserStatus) *identity.User {
+		return identity.RestoreUser(identity.RestoreUserParams{
+			ID:           identity.UserID("0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d93"),
+			Email:        "known@example.com",
+			PasswordHash: []byte("old"),
+			Status:       st,
+		})
+	}
+
+	cases := []struct {
+		name    string
+		user    *identity.User
+		wantErr error
+	}{
+		{"active", existing(identity.UserStatusActive), nil},
+		{"blocked", existing(identity.UserStatusBlocked), domain.ErrUserBlocked},
+		{"deleted", existing(identity.UserStatusDeleted), identity.ErrUserDeleted},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewService(log, nil, fakeUsers{t: t, user: tc.user}, nil, nil, nil, nil, nil,
+				Config{}, time.Now, audit.NopEmitter{})
+			u, err := s.upsertUser(context.Background(), inv,
+				AcceptInvitationInput{Password: "new password"}, []byte("new"))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tc.wantErr)
+			}
+			if err == nil && (u != tc.user || u.Status() != identity.UserStatusActive) {
+				t.Fatalf("user = %+v, want the existing account unchanged", u)
+			}
+		})
+	}
+}
+
+func TestUpsertUserNeedsPasswordForNewAccount(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	inv, err := domain.NewInvitation(domain.NewInvitationParams{
+		ID:        domain.InvitationID("0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d92"),
+		Email:     "new@example.com",
+		InvitedBy: domain.ActorID("0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90"),
+		TokenHash: []byte("hash"),
+		ExpiresAt: time.Now().Add(time.Hour),
+		Now:       time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("NewInvitation: %v", err)
+	}
+	s := NewService(log, nil, fakeUsers{t: t}, nil, nil, nil, nil, nil,
+		Config{}, time.Now, audit.NopEmitter{})
+	if _, err := s.upsertUser(context.Background(), inv, AcceptInvitationInput{}, nil); err == nil {
+		t.Fatal("new account without a password: err = nil")
+	}
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/cursor"
+	"sso/internal/kernel/tenant"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/invitation/internal/domain"
+	"sso/internal/modules/role"
+	"sso/internal/platform/mail"
+)
+
+// ----------------------------------------------------------------------------
+// CreateInvitation
+// ----------------------------------------------------------------------------
+
+type CreateInvitationInput struct {
+	Email   string
+	RoleIDs []string
+}
+
+// CreateInvitation stores a pending invitation and mails its token. The
+// caller must hold invitePermission and, when roles are attached,
+// grantPermission. The roles must exist and be active now; access
+// re-checks them at acceptance. The row and the mail go together: when delivery fails the
+// insert is rolled back, so a failed send never blocks a retry with
+// ErrPendingInvitationExists.
+func (s *Service) CreateInvitation(ctx context.Context, in CreateInvitationInput) (*domain.Invitation, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.NewInvitationID()
+	if err != nil {
+		return nil, err
+	}
+	token, hash, err := s.tokens.Generate()
+	if err != nil {
+		return nil, err
+	}
+	roleIDs := make([]domain.RoleID, 0, len(in.RoleIDs))
+	for _, r := range in.RoleIDs {
+		roleIDs = append(roleIDs, domain.RoleID(r))
+	}
+	now := s.now().UTC()
+	inv, err := domain.NewInvitation(domain.NewInvitationParams{
+		ID:        id,
+		TenantID:  tenant.Home(ctx),
+		Email:     in.Email,
+		RoleIDs:   roleIDs,
+		InvitedBy: domain.ActorID(a.ID),
+		TokenHash: hash,
+		ExpiresAt: now.Add(s.cfg.TTL),
+		Now:       now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeInvitationCreate)
+	aud.SubjectType = audit.SubjectTypeInvitation
+	aud.SubjectID = inv.ID().String()
+
+	err = s.requireInviter(ctx, a, len(inv.RoleIDs) > 0)
+	if err == nil {
+		err = s.requireActiveRoles(ctx, inv.RoleIDs)
+	}
+	if err == nil {
+		err = s.tx(ctx, func(ctx context.Context) error {
+			if err := s.repo.Create(ctx, inv); err != nil {
+				return err
+			}
+			return s.send(ctx, inv, token)
+		})
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("create invitation: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return inv, nil
+}
+
+// requireInviter checks a may invite and, when the invitation carries
+// roles, grant them.
+func (s *Service) requireInviter(ctx context.Context, a actor.Actor, withRoles bool) error {
+	perms := []string{invitePermission}
+	if withRoles {
+		perms = append(perms, grantPermission)
+	}
+	for _, p := range perms {
+		ok, err := s.perms.HasPermission(ctx, a, p)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return domain.ErrInviteDenied
+		}
+	}
+	return nil
+}
+
+func (s *Service) requireActiveRoles(ctx context.Context, ids []domain.RoleID) error {
+	for _, id := range ids {
+		r, err := s.roles.GetByID(ctx, role.RoleID(id))
+		if err != nil {
+			return err
+		}
+		if r.Status() != role.RoleStatusActive {
+			return role.ErrRoleDisabled
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// GetInvitation / ListInvitations
+// ----------------------------------------------------------------------------
+
+func (s *Service) GetInvitation(ctx context.Context, rawID string) (*domain.Invitation, error) {
+	id, err := domain.ParseInvitationID(rawID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+type ListInvitationsInput struct {
+	PageSize  int32
+	PageToken string
+	Email     string
+	Statuses  []domain.InvitationStatus
+}
+
+type ListInvitationsOutput struct {
+	Invitations   []*domain.Invitation
+	NextPageToken string
+}
+
+// ListInvitations pages invitations newest first.
+func (s *Service) ListInvitations(ctx context.Context, in ListInvitationsInput) (ListInvitationsOutput, error) {
+	after, err := decodeCursor(in.PageToken)
+	if err != nil {
+		return ListInvitationsOutput{}, err
+	}
+	pageSize, err := auditx.ClampPageSize(in.PageSize)
+	if err != nil {
+		return ListInvitationsOutput{}, err
+	}
+	res, err := s.repo.List(ctx, domain.ListQuery{
+		PageSize: pageSize,
+		After:    after,
+		Email:    strings.ToLower(strings.TrimSpace(in.Email)),
+		Statuses: in.Statuses,
+	})
+	if err != nil {
+		return ListInvitationsOutput{}, err
+	}
+	next, err := encodeCursor(res.NextCursor)
+	if err != nil {
+		return ListInvitationsOutput{}, err
+	}
+	return ListInvitationsOutput{Invitations: res.Invitations, NextPageToken: next}, nil
+}
+
+type pageToken struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+func encodeCursor(c *domain.PageCursor) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	return cursor.Encode(&pageToken{CreatedAt: c.CreatedAt, ID: c.ID.String()})
+}
+
+func decodeCursor(s string) (*domain.PageCursor, error) {
+	t, err := cursor.Decode[pageToken](s)
+	if err != nil {
+		return nil, &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	if t == nil {
+		return nil, nil
+	}
+	id, err := domain.ParseInvitationID(t.ID)
+	if err != nil {
+		return nil, &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	return &domain.PageCursor{CreatedAt: t.CreatedAt, ID: id}, nil
+}
+
+// ----------------------------------------------------------------------------
+// ResendInvitation
+// ----------------------------------------------------------------------------
+
+type ResendInvitationInput struct {
+	InvitationID string
+	ExpectedEtag string
+}
+
+// ResendInvitation mails a fresh token and restarts the expiry clock;
+// the previous token stops working. Works on expired invitations too —
+// that is how an expired one is revived.
+func (s *Service) ResendInvitation(ctx context.Context, in ResendInvitationInput) (*domain.Invitation, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseInvitationID(in.InvitationID)
+	if err != nil {
+		return nil, err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, false)
+	if err != nil {
+		return nil, err
+	}
+	token, hash, err := s.tokens.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeInvitationResend)
+	aud.SubjectType = audit.SubjectTypeInvitation
+	aud.SubjectID = id.String()
+
+	var inv *domain.Invitation
+	err = s.tx(ctx, func(ctx context.Context) error {
+		var err error
+		inv, err = s.repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if expectedEtag == "" {
+			expectedEtag = inv.Etag()
+		}
+		now := s.now().UTC()
+		if err := inv.Reissue(hash, now.Add(s.cfg.TTL), now); err != nil {
+			return err
+		}
+		if err := s.repo.Update(ctx, inv, expectedEtag); err != nil {
+			return err
+		}
+		return s.send(ctx, inv, token)
+	})
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("resend invitation: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return inv, nil
+}
+
+// ----------------------------------------------------------------------------
+// RevokeInvitation
+// ----------------------------------------------------------------------------
+
+type RevokeInvitationInput struct {
+	InvitationID string
+	ExpectedEtag string
+}
+
+func (s *Service) RevokeInvitation(ctx context.Context, in RevokeInvitationInput) (*domain.Invitation, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseInvitationID(in.InvitationID)
+	if err != nil {
+		return nil, err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, false)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeInvitationRevoke)
+	aud.SubjectType = audit.SubjectTypeInvitation
+	aud.SubjectID = id.String()
+
+	inv, err := s.repo.GetByID(ctx, id)
+	if err == nil {
+		if expectedEtag == "" {
+			expectedEtag = inv.Etag()
+		}
+		if err = inv.Revoke(s.now().UTC()); err == nil {
+			err = s.repo.Update(ctx, inv, expectedEtag)
+		}
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("revoke invitation: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return inv, nil
+}
+
+// ----------------------------------------------------------------------------
+// Mail
+// ----------------------------------------------------------------------------
+
+func (s *Service) send(ctx context.Context, inv *domain.Invitation, token string) error {
+	link, err := acceptLink(s.cfg.AcceptURL, token)
+	if err != nil {
+		return err
+	}
+	body := "You have been invited to create an account.\n\n" +
+		"Open the link below to choose a password and accept the invitation:\n\n" +
+		link + "\n\n" +
+		"The link can be used once and expires on " +
+		inv.ExpiresAt().UTC().Format(time.RFC1123) + ".\n" +
+		"If you did not expect this invitation you can ignore this message.\n"
+	if err := s.mailer.Send(ctx, mail.Message{
+		To:      inv.Email,
+		Subject: "You have been invited",
+		Body:    body,
+	}); err != nil {
+		return errors.Join(domain.ErrDeliveryFailed, err)
+	}
+	return nil
+}
+
+// acceptLink appends the token to AcceptURL, keeping any query the
+// configured URL already has.
+func acceptLink(base, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("invitation: accept url: %w", err)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
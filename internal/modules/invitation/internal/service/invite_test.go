@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/invitation/internal/domain"
+)
+
+// stubPerms grants the permissions in held.
+type stubPerms map[string]bool
+
+func (p stubPerms) HasPermission(_ context.Context, _ actor.Actor, permission string) (bool, error) {
+	return p[permission], nil
+}
+
+// CreateInvitation refuses an inviter lacking a permission before it
+// looks at the roles or writes anything, so the service needs none of
+// its other collaborators here.
+func TestCreateInvitationRequiresInviterPermissions(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := actor.Inject(context.Background(), actor.Actor{
+		ID:   "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90",
+		Kind: actor.KindUser,
+	})
+	roleID := "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d91"
+
+	cases := []struct {
+		name  string
+		perms stubPerms
+		roles []string
+	}{
+		{"no invite permission", stubPerms{"access:grant": true}, nil},
+		{"roles without grant permission", stubPerms{"invitations:create": true}, []string{roleID}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewService(log, nil, nil, nil, nil, tc.perms, nil, nil,
+				Config{TTL: time.Hour}, time.Now, audit.NopEmitter{})
+			_, err := s.CreateInvitation(ctx, CreateInvitationInput{
+				Email:   "new@example.com",
+				RoleIDs: tc.roles,
+			})
+			if !errors.Is(err, domain.ErrInviteDenied) {
+				t.Fatalf("err = %v, want ErrInviteDenied", err)
+			}
+		})
+	}
+}
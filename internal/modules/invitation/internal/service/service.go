@@ -0,0 +1,137 @@
+// Package service hosts the application-layer use-cases of the
+// invitation bounded context:
+//
+//	service.go — Service struct + helpers
+//	invite.go  — Create/Get/List/Resend/RevokeInvitation (admin)
+//	accept.go  — AcceptInvitation (anonymous, token-authenticated)
+//
+// The module owns only the invitation rows. Accounts are written through
+// identity.Repository and roles are granted through access.Service, so
+// acceptance goes through the same eligibility checks and audit events
+// as an admin grant; the tx port makes the whole acceptance one
+// transaction.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/access"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/invitation/internal/domain"
+	"sso/internal/modules/role"
+	"sso/internal/platform/crypto/randtoken"
+	"sso/internal/platform/mail"
+)
+
+// Grants is the slice of access.Service used to apply an invitation's
+// roles. Satisfied by *access.Service.
+type Grants interface {
+	GrantRoleToUser(ctx context.Context, in access.GrantRoleToUserInput) (access.GrantRoleToUserOutput, error)
+}
+
+// PermissionChecker decides whether an actor holds an admin permission.
+// Satisfied by *authz.AccessBackedAuthorizer.
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, act actor.Actor, permission string) (bool, error)
+}
+
+// The admin permissions an inviter must hold: the one to invite, and
+// the one a direct grant of the invited roles would need. Acceptance
+// grants as SYSTEM, so without the second an invitation would let its
+// sender hand out roles they could not grant themselves.
+const (
+	invitePermission = "invitations:create"
+	grantPermission  = "access:grant"
+)
+
+// TxRunner runs fn in one database transaction carried by ctx
+// (dbutil.WithTx bound to the module's *sql.DB).
+type TxRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+// Config carries the non-dependency settings of the Service.
+type Config struct {
+	// AcceptURL is the page that collects the password; the token is
+	// appended as ?token=.
+	AcceptURL string
+	// TTL is how long a freshly sent token stays valid.
+	TTL time.Duration
+	// BcryptCost is the cost for the password set on acceptance.
+	BcryptCost int
+}
+
+type Service struct {
+	repo    domain.Repository
+	users   identity.Repository
+	roles   role.Repository
+	grants  Grants
+	perms   PermissionChecker
+	mailer  mail.Sender
+	tx      TxRunner
+	tokens  randtoken.Generator // acceptance tokens; only the hash is stored
+	cfg     Config
+	now     func() time.Time
+	log     *slog.Logger
+	auditor auditx.Auditor
+}
+
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	users identity.Repository,
+	roles role.Repository,
+	grants Grants,
+	perms PermissionChecker,
+	mailer mail.Sender,
+	tx TxRunner,
+	cfg Config,
+	now func() time.Time,
+	emitter audit.Emitter,
+) *Service {
+	return &Service{
+		repo:    repo,
+		users:   users,
+		roles:   roles,
+		grants:  grants,
+		perms:   perms,
+		mailer:  mailer,
+		tx:      tx,
+		cfg:     cfg,
+		now:     now,
+		log:     log,
+		auditor: auditx.New(log, emitter),
+	}
+}
+
+// errReasonMap maps invitation sentinels (and the cross-module ones the
+// use-cases pass through) to their audit (Outcome, Reason) pair.
+var errReasonMap = map[error]auditx.OutcomeReason{
+	domain.ErrInvitationNotFound:      auditx.Fail(audit.ReasonInvitationNotFound),
+	domain.ErrInvitationNotPending:    auditx.Fail(audit.ReasonInvitationNotPending),
+	domain.ErrInvitationExpired:       auditx.Deny(audit.ReasonInvitationExpired),
+	domain.ErrInvalidToken:            auditx.Deny(audit.ReasonInvitationTokenInvalid),
+	domain.ErrPendingInvitationExists: auditx.Fail(audit.ReasonInvitationPending),
+	domain.ErrInviteDenied:            auditx.Deny(audit.ReasonPermissionDenied),
+	domain.ErrUserBlocked:             auditx.Deny(audit.ReasonUserBlocked),
+	domain.ErrEtagMismatch:            auditx.Fail(audit.ReasonEtagMismatch),
+	role.ErrRoleNotFound:              auditx.Fail(audit.ReasonRoleNotFound),
+	role.ErrRoleDisabled:              auditx.Fail(audit.ReasonRoleDisabled),
+	access.ErrRoleNotFound:            auditx.Fail(audit.ReasonRoleNotFound),
+	access.ErrRoleDisabled:            auditx.Fail(audit.ReasonRoleDisabled),
+	identity.ErrUserAlreadyExists:     auditx.Fail(audit.ReasonUserAlreadyExists),
+	identity.ErrUserDeleted:           auditx.Deny(audit.ReasonUserDeleted),
+}
+
+// classifyError is the per-package thin wrapper around auditx.Classify.
+func classifyError(err error) (audit.AuditOutcome, string) {
+	return auditx.Classify(err, errReasonMap)
+}
+
+// withOutcome is a thin alias over auditx.WithOutcome.
+func withOutcome(p audit.NewAuditParams, out audit.AuditOutcome, reason string) audit.NewAuditParams {
+	return auditx.WithOutcome(p, out, reason)
+}
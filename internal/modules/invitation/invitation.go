@@ -0,0 +1,57 @@
+// Package invitation is the public API of the invitation bounded
+// context. External callers interact with the module through:
+//
+//	invitation.New(Deps)    wires the module (module.go)
+//	invitation.Service      application-layer use-cases (service.go)
+//	invitation.Repository   persistence contract
+package invitation
+
+import (
+	"sso/internal/modules/invitation/internal/domain"
+	"sso/internal/modules/invitation/internal/httpapi"
+	"sso/internal/modules/invitation/internal/service"
+)
+
+type (
+	Invitation              = domain.Invitation
+	InvitationID            = domain.InvitationID
+	InvitationStatus        = domain.InvitationStatus
+	NewInvitationParams     = domain.NewInvitationParams
+	RestoreInvitationParams = domain.RestoreInvitationParams
+	Repository              = domain.Repository
+
+	// Grants is satisfied by *access.Service.
+	Grants = service.Grants
+	// PermissionChecker is satisfied by *authz.AccessBackedAuthorizer.
+	PermissionChecker = service.PermissionChecker
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+)
+
+// Status enum re-exports.
+const (
+	InvitationStatusPending  = domain.InvitationStatusPending
+	InvitationStatusAccepted = domain.InvitationStatusAccepted
+	InvitationStatusRevoked  = domain.InvitationStatusRevoked
+)
+
+var (
+	NewInvitationID       = domain.NewInvitationID
+	ParseInvitationID     = domain.ParseInvitationID
+	ParseInvitationStatus = domain.ParseInvitationStatus
+)
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var (
+	ErrInvitationNotFound      = domain.ErrInvitationNotFound
+	ErrInvitationNotPending    = domain.ErrInvitationNotPending
+	ErrInvitationExpired       = domain.ErrInvitationExpired
+	ErrInvalidToken            = domain.ErrInvalidToken
+	ErrPendingInvitationExists = domain.ErrPendingInvitationExists
+	ErrDeliveryFailed          = domain.ErrDeliveryFailed
+	ErrInviteDenied            = domain.ErrInviteDenied
+	ErrUserBlocked             = domain.ErrUserBlocked
+	ErrEtagMismatch            = domain.ErrEtagMismatch
+)
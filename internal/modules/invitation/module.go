@@ -0,0 +1,140 @@
+// Package invitation exposes the wire-up for the invitation bounded
+// context (admin invitations accepted through a mailed one-time token).
+// bootstrap.New constructs a single *invitation.Module and pulls
+// everything else off it:
+//
+//	mod.RegisterHTTP(mux)  // mounts the /v1/invitations endpoints
+//	mod.Service()          // application-layer service (rare)
+//	mod.Repository()       // persistence contract
+//
+// Like federation, the surface is HTTP-only until an InvitationService
+// contract is published in sso_protos.
+package invitation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/invitation/internal/httpapi"
+	"sso/internal/modules/invitation/internal/mariadb"
+	"sso/internal/modules/invitation/internal/service"
+	"sso/internal/modules/role"
+	"sso/internal/platform/mail"
+)
+
+// Emitter is the audit-event sink the module uses to record write
+// operations. Type alias over audit.Emitter — keeps the wiring
+// contract local to this module.
+type Emitter = audit.Emitter
+
+// Deps lists everything invitation needs from its host. Users and
+// Grants must write through the same *sql.DB as DB: acceptance runs
+// them inside one dbutil.WithTx transaction.
+type Deps struct {
+	DB  *sql.DB
+	Log *slog.Logger
+
+	Users         identity.Repository
+	Roles         role.Repository
+	Grants        Grants            // *access.Service
+	Permissions   PermissionChecker // *authz.AccessBackedAuthorizer
+	Mailer        mail.Sender
+	Authenticator Authenticator // *grpcauth.Interceptor
+	Authorizer    Authorizer    // *httprbac.Authorizer
+
+	AcceptURL  string
+	TTL        time.Duration // default 168h
+	BcryptCost int
+
+	Clock func() time.Time
+	Audit Emitter
+}
+
+// Module is the assembled invitation bounded context.
+type Module struct {
+	service *service.Service
+	handler *httpapi.Handler
+	repo    *mariadb.Repository
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.DB == nil {
+		return nil, fmt.Errorf("invitation: db is required")
+	}
+	if d.Log == nil {
+		return nil, fmt.Errorf("invitation: log is required")
+	}
+	if d.Users == nil {
+		return nil, fmt.Errorf("invitation: users repository is required")
+	}
+	if d.Roles == nil {
+		return nil, fmt.Errorf("invitation: roles repository is required")
+	}
+	if d.Grants == nil {
+		return nil, fmt.Errorf("invitation: grants service is required")
+	}
+	if d.Permissions == nil {
+		return nil, fmt.Errorf("invitation: permission checker is required")
+	}
+	if d.Mailer == nil {
+		return nil, fmt.Errorf("invitation: mailer is required")
+	}
+	if d.Authenticator == nil {
+		return nil, fmt.Errorf("invitation: authenticator is required")
+	}
+	if d.Authorizer == nil {
+		return nil, fmt.Errorf("invitation: authorizer is required")
+	}
+	if d.AcceptURL == "" {
+		return nil, fmt.Errorf("invitation: accept url is required")
+	}
+	if d.BcryptCost <= 0 {
+		return nil, fmt.Errorf("invitation: bcrypt cost is required")
+	}
+	if d.TTL <= 0 {
+		d.TTL = 168 * time.Hour
+	}
+	if d.Clock == nil {
+		d.Clock = time.Now
+	}
+	if d.Audit == nil {
+		d.Audit = audit.NopEmitter{}
+	}
+
+	repo := mariadb.NewRepository(d.DB)
+
+	var _ Repository = repo
+
+	tx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return dbutil.WithTx(ctx, d.DB, fn)
+	}
+	svc := service.NewService(d.Log, repo, d.Users, d.Roles, d.Grants, d.Permissions, d.Mailer, tx,
+		service.Config{AcceptURL: d.AcceptURL, TTL: d.TTL, BcryptCost: d.BcryptCost},
+		d.Clock, d.Audit)
+
+	return &Module{
+		service: svc,
+		handler: httpapi.NewHandler(svc, d.Authenticator, d.Authorizer, d.Log),
+		repo:    repo,
+	}, nil
+}
+
+// RegisterHTTP mounts the invitation endpoints on the HTTP listener's
+// root mux.
+func (m *Module) RegisterHTTP(mux *http.ServeMux) {
+	m.handler.Register(mux)
+}
+
+// Service returns the application-layer Service.
+func (m *Module) Service() *service.Service { return m.service }
+
+// Repository returns the persistence contract.
+func (m *Module) Repository() Repository { return m.repo }
@@ -0,0 +1,20 @@
+// Package invitation re-exports the application-layer Service together
+// with the typed Input/Output structs declared in internal/service.
+package invitation
+
+import "sso/internal/modules/invitation/internal/service"
+
+// Service is the use-case orchestrator, split across files in
+// internal/service: invite.go, accept.go.
+type Service = service.Service
+
+// Input / Output type aliases.
+type (
+	CreateInvitationInput  = service.CreateInvitationInput
+	ListInvitationsInput   = service.ListInvitationsInput
+	ListInvitationsOutput  = service.ListInvitationsOutput
+	ResendInvitationInput  = service.ResendInvitationInput
+	RevokeInvitationInput  = service.RevokeInvitationInput
+	AcceptInvitationInput  = service.AcceptInvitationInput
+	AcceptInvitationOutput = service.AcceptInvitationOutput
+)
@@ -0,0 +1,80 @@
+package permission
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"sso/internal/modules/permission/internal/service"
+)
+
+// catalogFile is the declarative catalog format: each app's whole
+// catalog keyed by app id.
+//
+//	apps:
+//	  0190b6f4-8d5e-7c1a-9f00-3a2b1c4d5e6f:
+//	    - name: users:read
+//	      description: Read user profiles
+//	    - name: users:export
+//	      deprecated: true
+//
+// Apps the file does not mention are left alone; an app listed with an
+// empty sequence has its catalog cleared, which turns validation off
+// for its roles.
+type catalogFile struct {
+	Apps map[string][]catalogFileEntry `yaml:"apps"`
+}
+
+type catalogFileEntry struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Deprecated  bool   `yaml:"deprecated"`
+}
+
+// SyncFile applies the catalog file at path with ReplaceCatalog
+// semantics, one app at a time in app id order. Each app is its own
+// transaction: an error stops the sync but leaves the apps before it
+// applied. Unknown keys in the file are an error, so a typo there does
+// not silently drop a field.
+func (m *Module) SyncFile(ctx context.Context, path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("permission: read catalog file: %w", err)
+	}
+	var f catalogFile
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+	if err := dec.Decode(&f); err != nil {
+		return fmt.Errorf("permission: parse catalog file %s: %w", path, err)
+	}
+
+	appIDs := make([]string, 0, len(f.Apps))
+	for id := range f.Apps {
+		appIDs = append(appIDs, id)
+	}
+	sort.Strings(appIDs)
+
+	for _, id := range appIDs {
+		in := service.ReplaceCatalogInput{
+			AppID:       id,
+			Permissions: make([]service.PermissionSpec, 0, len(f.Apps[id])),
+		}
+		for _, e := range f.Apps[id] {
+			in.Permissions = append(in.Permissions, service.PermissionSpec{
+				Name:        e.Name,
+				Description: e.Description,
+				Deprecated:  e.Deprecated,
+			})
+		}
+		catalog, err := m.service.SyncCatalog(ctx, in)
+		if err != nil {
+			return fmt.Errorf("permission: sync catalog of app %s: %w", id, err)
+		}
+		m.log.InfoContext(ctx, "permission catalog synced", "app_id", id, "permissions", len(catalog))
+	}
+	return nil
+}
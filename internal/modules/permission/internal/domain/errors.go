@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+var (
+	ErrPermissionNotFound = errors.New("permission: not found")
+
+	// ErrPermissionInUse — a role of the app still holds the permission
+	// by name. Deprecate it instead, or take it off the roles first.
+	ErrPermissionInUse = errors.New("permission: in use by roles")
+)
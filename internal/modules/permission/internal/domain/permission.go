@@ -0,0 +1,291 @@
+// Package domain holds the permission bounded context: the catalog of
+// resource:action permissions an app declares, against which the role
+// context checks the permissions it stores.
+//
+// A catalog is opt-in per app. Until an app declares its first
+// permission its roles accept any well-formed string, as they always
+// have; from then on a role may only name catalog entries, or
+// "<resource>:*" for a resource the catalog knows.
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"sso/internal/kernel/validation"
+)
+
+// ----------------------------------------------------------------------------
+// Cross-context handles
+// ----------------------------------------------------------------------------
+
+// AppID is a cross-context handle to app.App.
+type AppID string
+
+func ParseAppID(s string) (AppID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "app_id", Reason: "must be a valid UUID"}
+	}
+	return AppID(s), nil
+}
+
+func (id AppID) String() string { return string(id) }
+
+// ----------------------------------------------------------------------------
+// Names
+// ----------------------------------------------------------------------------
+
+const (
+	// maxNameLen matches role_permissions.permission.
+	maxNameLen        = 64
+	maxDescriptionLen = 512
+
+	wildcardAction = "*"
+)
+
+// ParseName validates a concrete catalog name: resource:action, both
+// parts matching [a-z][a-z0-9_]*. Wildcards are never catalog entries.
+func ParseName(s string) (string, error) {
+	_, action, err := splitName(s, "name")
+	if err != nil {
+		return "", err
+	}
+	if action == wildcardAction {
+		return "", &validation.Error{Field: "name", Reason: "wildcards cannot be declared in the catalog"}
+	}
+	return s, nil
+}
+
+// ParseReference validates a permission as a role may hold it: a
+// concrete name or "<resource>:*".
+func ParseReference(s string) (string, error) {
+	if _, _, err := splitName(s, "name"); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// Resource returns the resource part of a resource:action string.
+func Resource(name string) string {
+	resource, _, _ := strings.Cut(name, ":")
+	return resource
+}
+
+// Wildcard returns the "<resource>:*" form covering name.
+func Wildcard(name string) string {
+	return Resource(name) + ":" + wildcardAction
+}
+
+func splitName(s, field string) (resource, action string, err error) {
+	if len(s) < 3 || len(s) > maxNameLen {
+		return "", "", &validation.Error{Field: field, Reason: fmt.Sprintf("length must be between 3 and %d", maxNameLen)}
+	}
+	resource, action, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", "", &validation.Error{Field: field, Reason: "must be in resource:action form"}
+	}
+	if !isLowerIdent(resource) || (action != wildcardAction && !isLowerIdent(action)) {
+		return "", "", &validation.Error{
+			Field:  field,
+			Reason: "resource and action must match [a-z][a-z0-9_]*",
+		}
+	}
+	return resource, action, nil
+}
+
+// isLowerIdent reports whether s matches `^[a-z][a-z0-9_]*$`.
+func isLowerIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case i > 0 && c >= '0' && c <= '9':
+		case i > 0 && c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// ----------------------------------------------------------------------------
+// Permission
+// ----------------------------------------------------------------------------
+
+// Permission is one catalog entry. Deprecated entries keep working on
+// the roles that already hold them; they only stop being grantable.
+type Permission struct {
+	appID     AppID
+	name      string
+	createdAt time.Time
+	updatedAt time.Time
+
+	Description string
+	Deprecated  bool
+}
+
+type NewPermissionParams struct {
+	AppID       AppID
+	Name        string
+	Description string
+	Deprecated  bool
+	Now         time.Time
+}
+
+// NewPermission validates the supplied fields and builds a fresh entry.
+func NewPermission(p NewPermissionParams) (*Permission, error) {
+	name, err := ParseName(p.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateDescription(p.Description); err != nil {
+		return nil, err
+	}
+	return &Permission{
+		appID:       p.AppID,
+		name:        name,
+		createdAt:   p.Now,
+		updatedAt:   p.Now,
+		Description: p.Description,
+		Deprecated:  p.Deprecated,
+	}, nil
+}
+
+type RestorePermissionParams struct {
+	AppID       AppID
+	Name        string
+	Description string
+	Deprecated  bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RestorePermission rebuilds a Permission from a persisted row. No
+// validation.
+func RestorePermission(p RestorePermissionParams) *Permission {
+	return &Permission{
+		appID:       p.AppID,
+		name:        p.Name,
+		createdAt:   p.CreatedAt,
+		updatedAt:   p.UpdatedAt,
+		Description: p.Description,
+		Deprecated:  p.Deprecated,
+	}
+}
+
+func (p *Permission) AppID() AppID         { return p.appID }
+func (p *Permission) Name() string         { return p.name }
+func (p *Permission) Resource() string     { return Resource(p.name) }
+func (p *Permission) CreatedAt() time.Time { return p.createdAt }
+func (p *Permission) UpdatedAt() time.Time { return p.updatedAt }
+
+// Redefine replaces the description and deprecated flag. Returns false,
+// leaving updatedAt alone, when neither changes.
+func (p *Permission) Redefine(description string, deprecated bool, now time.Time) (bool, error) {
+	if err := ValidateDescription(description); err != nil {
+		return false, err
+	}
+	if description == p.Description && deprecated == p.Deprecated {
+		return false, nil
+	}
+	p.Description, p.Deprecated, p.updatedAt = description, deprecated, now
+	return true, nil
+}
+
+func ValidateDescription(s string) error {
+	if utf8.RuneCountInString(s) > maxDescriptionLen {
+		return &validation.Error{Field: "description", Reason: fmt.Sprintf("must be at most %d characters", maxDescriptionLen)}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Catalog
+// ----------------------------------------------------------------------------
+
+// Catalog is an app's full permission set, indexed for checking the
+// permission list of a role.
+type Catalog struct {
+	byName    map[string]*Permission
+	resources map[string]struct{}
+}
+
+func NewCatalog(entries []*Permission) *Catalog {
+	c := &Catalog{
+		byName:    make(map[string]*Permission, len(entries)),
+		resources: make(map[string]struct{}),
+	}
+	for _, p := range entries {
+		c.byName[p.Name()] = p
+		c.resources[p.Resource()] = struct{}{}
+	}
+	return c
+}
+
+// Empty reports whether the app has declared no permissions; such an
+// app's roles are not checked.
+func (c *Catalog) Empty() bool { return len(c.byName) == 0 }
+
+// CheckRolePermissions validates the permission list of a role against
+// the catalog. held is what the role stores today: a deprecated entry
+// the role already holds may stay, a new one may not. A
+// "<resource>:*" wildcard needs at least one catalog entry under the
+// resource.
+func (c *Catalog) CheckRolePermissions(perms, held []string) error {
+	if c.Empty() {
+		return nil
+	}
+	kept := make(map[string]struct{}, len(held))
+	for _, h := range held {
+		kept[h] = struct{}{}
+	}
+	for _, perm := range perms {
+		resource, action, ok := strings.Cut(perm, ":")
+		if ok && action == wildcardAction {
+			if _, known := c.resources[resource]; !known {
+				return &validation.Error{
+					Field:  "permissions",
+					Reason: fmt.Sprintf("%q: resource %q has no permissions in the app's catalog", perm, resource),
+				}
+			}
+			continue
+		}
+		p, known := c.byName[perm]
+		if !known {
+			return &validation.Error{
+				Field:  "permissions",
+				Reason: fmt.Sprintf("%q is not in the app's permission catalog", perm),
+			}
+		}
+		if _, ok := kept[perm]; p.Deprecated && !ok {
+			return &validation.Error{
+				Field:  "permissions",
+				Reason: fmt.Sprintf("%q is deprecated and cannot be added to a role", perm),
+			}
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Usage
+// ----------------------------------------------------------------------------
+
+// Usage is one role that grants a permission, either by name or through
+// a "<resource>:*" wildcard.
+type Usage struct {
+	RoleID   string
+	RoleName string
+	// Via is the permission string the role holds: the name itself or
+	// the wildcard covering it.
+	Via string
+}
+
+// Exact reports whether the role holds the permission by name.
+func (u Usage) Exact(name string) bool { return u.Via == name }
@@ -0,0 +1,29 @@
+package domain
+
+import "context"
+
+// Repository is the persistence contract for the permission context.
+//
+// Error contract:
+//
+//	Get     → ErrPermissionNotFound
+//	Delete  → ErrPermissionNotFound
+//
+// List returns an empty slice, not an error, for an app with no
+// catalog. ReplaceCatalog in the service layer runs Save/Delete inside
+// dbutil.WithTx; the adapter picks the ambient transaction up.
+type Repository interface {
+	// Save inserts the entry or overwrites the one with the same
+	// (app, name).
+	Save(ctx context.Context, p *Permission) error
+	Get(ctx context.Context, appID AppID, name string) (*Permission, error)
+	// List returns the app's catalog ordered by name.
+	List(ctx context.Context, appID AppID) ([]*Permission, error)
+	Delete(ctx context.Context, appID AppID, name string) error
+
+	// ListUsage returns the app's roles holding name or the
+	// "<resource>:*" wildcard covering it, ordered by role name. It reads
+	// role_permissions directly and does not require name to be in the
+	// catalog, so it also finds typos.
+	ListUsage(ctx context.Context, appID AppID, name string) ([]Usage, error)
+}
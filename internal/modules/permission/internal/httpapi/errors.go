@@ -0,0 +1,26 @@
+package httpapi
+
+import (
+	"sso/internal/modules/app"
+	"sso/internal/modules/permission/internal/domain"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap translates permission sentinels, and the app one the
+// use-cases pass through, into statuses. errors.proto has no permission
+// reasons yet, so those entries are bare statuses (Reason UNSPECIFIED).
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrPermissionNotFound: {
+		Code: codes.NotFound, Message: "permission not found"},
+	domain.ErrPermissionInUse: {
+		Code: codes.FailedPrecondition, Message: "permission is held by roles; remove it from them or deprecate it"},
+	app.ErrAppNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_APP_NOT_FOUND, Message: "app not found"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
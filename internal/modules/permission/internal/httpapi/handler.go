@@ -0,0 +1,195 @@
+// Package httpapi is the HTTP adapter for the permission context.
+//
+// sso_protos has no catalog RPCs, so apps register their permissions
+// through these hand-written net/http handlers mounted next to the
+// grpc-gateway. Error bodies use the same google.rpc.Status JSON shape
+// as the gateway, so clients need a single error decoder.
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/modules/permission/internal/domain"
+	permsvc "sso/internal/modules/permission/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+type Handler struct {
+	svc *permsvc.Service
+	api *apiutil.Adapter
+	log *slog.Logger
+}
+
+func NewHandler(svc *permsvc.Service, authn Authenticator, authz Authorizer, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("permission", authn, authz, log, toStatus), log: log}
+}
+
+// Register mounts the permission endpoints. All of them are admin.
+//
+//	GET    /v1/apps/{app_id}/permissions                 catalog
+//	PUT    /v1/apps/{app_id}/permissions                 replace the catalog
+//	PUT    /v1/apps/{app_id}/permissions/{name}          declare / redefine
+//	DELETE /v1/apps/{app_id}/permissions/{name}
+//	GET    /v1/apps/{app_id}/permissions/{name}/roles    where-used
+//
+// The whole-catalog PUT is the declarative form: an app ships its
+// permission list on deploy and entries it no longer lists are dropped.
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/apps/{app_id}/permissions", h.api.Authed(h.listPermissions))
+	mux.HandleFunc("PUT /v1/apps/{app_id}/permissions", h.api.Authed(h.replaceCatalog))
+	mux.HandleFunc("PUT /v1/apps/{app_id}/permissions/{name}", h.api.Authed(h.putPermission))
+	mux.HandleFunc("DELETE /v1/apps/{app_id}/permissions/{name}", h.api.Authed(h.deletePermission))
+	mux.HandleFunc("GET /v1/apps/{app_id}/permissions/{name}/roles", h.api.Authed(h.listUsage))
+}
+
+// ----------------------------------------------------------------------------
+// Catalog
+// ----------------------------------------------------------------------------
+
+type permissionBody struct {
+	Description string `json:"description"`
+	Deprecated  bool   `json:"deprecated"`
+}
+
+func (h *Handler) putPermission(w http.ResponseWriter, r *http.Request) {
+	var b permissionBody
+	if err := apiutil.DecodeJSONLimit(r, &b, maxBodyBytes); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	p, err := h.svc.PutPermission(r.Context(), permsvc.PutPermissionInput{
+		AppID:       r.PathValue("app_id"),
+		Name:        r.PathValue("name"),
+		Description: b.Description,
+		Deprecated:  b.Deprecated,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, permissionView(p))
+}
+
+type catalogBody struct {
+	Permissions []catalogEntry `json:"permissions"`
+}
+
+type catalogEntry struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Deprecated  bool   `json:"deprecated"`
+}
+
+func (h *Handler) replaceCatalog(w http.ResponseWriter, r *http.Request) {
+	var b catalogBody
+	if err := apiutil.DecodeJSONLimit(r, &b, maxBodyBytes); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	in := permsvc.ReplaceCatalogInput{
+		AppID:       r.PathValue("app_id"),
+		Permissions: make([]permsvc.PermissionSpec, 0, len(b.Permissions)),
+	}
+	for _, e := range b.Permissions {
+		in.Permissions = append(in.Permissions, permsvc.PermissionSpec{
+			Name:        e.Name,
+			Description: e.Description,
+			Deprecated:  e.Deprecated,
+		})
+	}
+	catalog, err := h.svc.ReplaceCatalog(r.Context(), in)
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, catalogView(catalog))
+}
+
+func (h *Handler) listPermissions(w http.ResponseWriter, r *http.Request) {
+	catalog, err := h.svc.ListPermissions(r.Context(), r.PathValue("app_id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, catalogView(catalog))
+}
+
+func (h *Handler) deletePermission(w http.ResponseWriter, r *http.Request) {
+	err := h.svc.DeletePermission(r.Context(), permsvc.DeletePermissionInput{
+		AppID: r.PathValue("app_id"),
+		Name:  r.PathValue("name"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ----------------------------------------------------------------------------
+// Where-used
+// ----------------------------------------------------------------------------
+
+func (h *Handler) listUsage(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	usage, err := h.svc.ListPermissionUsage(r.Context(), r.PathValue("app_id"), name)
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	roles := make([]map[string]any, 0, len(usage))
+	for _, u := range usage {
+		roles = append(roles, map[string]any{
+			"role_id":   u.RoleID,
+			"role_name": u.RoleName,
+			"via":       u.Via,
+			"wildcard":  !u.Exact(name),
+		})
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"app_id":     r.PathValue("app_id"),
+		"permission": name,
+		"roles":      roles,
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Views
+// ----------------------------------------------------------------------------
+
+func catalogView(catalog []*domain.Permission) map[string]any {
+	views := make([]map[string]any, 0, len(catalog))
+	for _, p := range catalog {
+		views = append(views, permissionView(p))
+	}
+	return map[string]any{"permissions": views}
+}
+
+func permissionView(p *domain.Permission) map[string]any {
+	return map[string]any{
+		"app_id":      p.AppID().String(),
+		"name":        p.Name(),
+		"description": p.Description,
+		"deprecated":  p.Deprecated,
+		"created_at":  p.CreatedAt().UTC().Format(time.RFC3339),
+		"updated_at":  p.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Plumbing
+// ----------------------------------------------------------------------------
+
+// maxBodyBytes is larger than apiutil.MaxBodyBytes: a whole
+// catalog travels in one PUT.
+const maxBodyBytes = 1 << 20
@@ -0,0 +1,28 @@
+package mariadb
+
+import (
+	"sso/internal/modules/permission/internal/domain"
+	"sso/internal/modules/permission/internal/mariadb/dbgen"
+)
+
+func permissionToDomain(row dbgen.AppPermission) *domain.Permission {
+	return domain.RestorePermission(domain.RestorePermissionParams{
+		AppID:       domain.AppID(row.AppID),
+		Name:        row.Name,
+		Description: row.Description,
+		Deprecated:  row.Deprecated,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	})
+}
+
+func toSavePermissionParams(p *domain.Permission) dbgen.SavePermissionParams {
+	return dbgen.SavePermissionParams{
+		AppID:       p.AppID().String(),
+		Name:        p.Name(),
+		Description: p.Description,
+		Deprecated:  p.Deprecated,
+		CreatedAt:   p.CreatedAt(),
+		UpdatedAt:   p.UpdatedAt(),
+	}
+}
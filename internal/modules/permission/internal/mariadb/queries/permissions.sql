@@ -0,0 +1,31 @@
+-- Permission catalog, and the where-used lookup over role_permissions.
+
+-- name: SavePermission :exec
+INSERT INTO app_permissions (
+    app_id, name, description, deprecated, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    description = VALUES(description),
+    deprecated = VALUES(deprecated),
+    updated_at = VALUES(updated_at);
+
+-- name: GetPermission :one
+SELECT * FROM app_permissions
+WHERE app_id = ? AND name = ?
+LIMIT 1;
+
+-- name: ListPermissions :many
+SELECT * FROM app_permissions
+WHERE app_id = ?
+ORDER BY name;
+
+-- name: DeletePermission :execresult
+DELETE FROM app_permissions
+WHERE app_id = ? AND name = ?;
+
+-- name: ListPermissionUsage :many
+SELECT r.id, r.name, rp.permission
+FROM role_permissions rp
+JOIN roles r ON r.id = rp.role_id
+WHERE r.app_id = ? AND rp.permission IN (sqlc.arg(name), sqlc.arg(wildcard))
+ORDER BY r.name, r.id, rp.permission;
@@ -0,0 +1,98 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/permission/internal/domain"
+	"sso/internal/modules/permission/internal/mariadb/dbgen"
+)
+
+type Repository struct {
+	q *dbgen.Queries
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{q: dbgen.New(db)}
+}
+
+var _ domain.Repository = (*Repository)(nil)
+
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one; ReplaceCatalog relies on it.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
+func (r *Repository) Save(ctx context.Context, p *domain.Permission) error {
+	if err := r.queries(ctx).SavePermission(ctx, toSavePermissionParams(p)); err != nil {
+		return fmt.Errorf("permission repo: save: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, appID domain.AppID, name string) (*domain.Permission, error) {
+	row, err := r.queries(ctx).GetPermission(ctx, dbgen.GetPermissionParams{
+		AppID: appID.String(),
+		Name:  name,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrPermissionNotFound
+		}
+		return nil, fmt.Errorf("permission repo: get: %w", err)
+	}
+	return permissionToDomain(row), nil
+}
+
+func (r *Repository) List(ctx context.Context, appID domain.AppID) ([]*domain.Permission, error) {
+	rows, err := r.queries(ctx).ListPermissions(ctx, appID.String())
+	if err != nil {
+		return nil, fmt.Errorf("permission repo: list: %w", err)
+	}
+	out := make([]*domain.Permission, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, permissionToDomain(row))
+	}
+	return out, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, appID domain.AppID, name string) error {
+	res, err := r.queries(ctx).DeletePermission(ctx, dbgen.DeletePermissionParams{
+		AppID: appID.String(),
+		Name:  name,
+	})
+	if err != nil {
+		return fmt.Errorf("permission repo: delete: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("permission repo: rows_affected: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrPermissionNotFound
+	}
+	return nil
+}
+
+func (r *Repository) ListUsage(ctx context.Context, appID domain.AppID, name string) ([]domain.Usage, error) {
+	rows, err := r.queries(ctx).ListPermissionUsage(ctx, dbgen.ListPermissionUsageParams{
+		AppID:    appID.String(),
+		Name:     name,
+		Wildcard: domain.Wildcard(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("permission repo: list_usage: %w", err)
+	}
+	out := make([]domain.Usage, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, domain.Usage{RoleID: row.ID, RoleName: row.Name, Via: row.Permission})
+	}
+	return out, nil
+}
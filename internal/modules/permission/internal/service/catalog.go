@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/permission/internal/domain"
+)
+
+// ----------------------------------------------------------------------------
+// PutPermission
+// ----------------------------------------------------------------------------
+
+type PutPermissionInput struct {
+	AppID       string
+	Name        string
+	Description string
+	Deprecated  bool
+}
+
+// PutPermission declares the permission in the app's catalog or
+// replaces its description and deprecated flag.
+func (s *Service) PutPermission(ctx context.Context, in PutPermissionInput) (*domain.Permission, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	appID, err := domain.ParseAppID(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	name, err := domain.ParseName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypePermissionPut)
+	aud.SubjectType = audit.SubjectTypeApp
+	aud.SubjectID = appID.String()
+	aud.AppID = appID.String()
+	aud.Metadata = map[string]string{
+		"name":       name,
+		"deprecated": strconv.FormatBool(in.Deprecated),
+	}
+
+	var p *domain.Permission
+	err = s.requireApp(ctx, appID)
+	if err == nil {
+		p, err = s.put(ctx, appID, PermissionSpec{
+			Name:        name,
+			Description: in.Description,
+			Deprecated:  in.Deprecated,
+		})
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("put permission: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return p, nil
+}
+
+// put creates or redefines one entry. An unchanged entry is not
+// written.
+func (s *Service) put(ctx context.Context, appID domain.AppID, spec PermissionSpec) (*domain.Permission, error) {
+	now := s.now().UTC()
+	existing, err := s.repo.Get(ctx, appID, spec.Name)
+	switch {
+	case err == nil:
+		changed, err := existing.Redefine(spec.Description, spec.Deprecated, now)
+		if err != nil || !changed {
+			return existing, err
+		}
+		return existing, s.repo.Save(ctx, existing)
+	case errors.Is(err, domain.ErrPermissionNotFound):
+		p, err := domain.NewPermission(domain.NewPermissionParams{
+			AppID:       appID,
+			Name:        spec.Name,
+			Description: spec.Description,
+			Deprecated:  spec.Deprecated,
+			Now:         now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return p, s.repo.Save(ctx, p)
+	default:
+		return nil, err
+	}
+}
+
+// ----------------------------------------------------------------------------
+// ListPermissions
+// ----------------------------------------------------------------------------
+
+// ListPermissions returns the app's catalog ordered by name.
+func (s *Service) ListPermissions(ctx context.Context, rawAppID string) ([]*domain.Permission, error) {
+	if _, err := actor.Require(ctx); err != nil {
+		return nil, err
+	}
+	appID, err := domain.ParseAppID(rawAppID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireApp(ctx, appID); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, appID)
+}
+
+// ----------------------------------------------------------------------------
+// DeletePermission
+// ----------------------------------------------------------------------------
+
+type DeletePermissionInput struct {
+	AppID string
+	Name  string
+}
+
+// DeletePermission drops the entry from the app's catalog. It fails
+// with ErrPermissionInUse while a role holds the permission by name;
+// wildcard holders do not block it.
+func (s *Service) DeletePermission(ctx context.Context, in DeletePermissionInput) error {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return err
+	}
+	appID, err := domain.ParseAppID(in.AppID)
+	if err != nil {
+		return err
+	}
+	name, err := domain.ParseName(in.Name)
+	if err != nil {
+		return err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypePermissionDelete)
+	aud.SubjectType = audit.SubjectTypeApp
+	aud.SubjectID = appID.String()
+	aud.AppID = appID.String()
+	aud.Metadata = map[string]string{"name": name}
+
+	err = s.tx(ctx, func(ctx context.Context) error {
+		return s.remove(ctx, appID, name)
+	})
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return fmt.Errorf("delete permission: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return nil
+}
+
+func (s *Service) remove(ctx context.Context, appID domain.AppID, name string) error {
+	usage, err := s.repo.ListUsage(ctx, appID, name)
+	if err != nil {
+		return err
+	}
+	for _, u := range usage {
+		if u.Exact(name) {
+			return fmt.Errorf("%w: %s is held by role %s", domain.ErrPermissionInUse, name, u.RoleName)
+		}
+	}
+	return s.repo.Delete(ctx, appID, name)
+}
+
+// ----------------------------------------------------------------------------
+// ReplaceCatalog / SyncCatalog
+// ----------------------------------------------------------------------------
+
+// PermissionSpec is one entry of a declared catalog.
+type PermissionSpec struct {
+	Name        string
+	Description string
+	Deprecated  bool
+}
+
+// ReplaceCatalogInput is an app's whole catalog as declared by the app
+// (admin PUT or the catalog file).
+type ReplaceCatalogInput struct {
+	AppID       string
+	Permissions []PermissionSpec
+}
+
+// ReplaceCatalog makes the app's catalog exactly in.Permissions in one
+// transaction. Entries missing from the input are deleted, which fails
+// the whole call with ErrPermissionInUse if a role still holds one by
+// name — keep such entries and mark them deprecated instead.
+func (s *Service) ReplaceCatalog(ctx context.Context, in ReplaceCatalogInput) ([]*domain.Permission, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.replaceCatalog(ctx, audit.BaseFromActor(a, audit.EventTypePermissionReplaceCatalog), in)
+}
+
+// SyncCatalog is ReplaceCatalog on behalf of the system: bootstrap
+// applies the declarative catalog file with it before serving.
+func (s *Service) SyncCatalog(ctx context.Context, in ReplaceCatalogInput) ([]*domain.Permission, error) {
+	return s.replaceCatalog(ctx, audit.NewAuditParams{
+		EventType: audit.EventTypePermissionReplaceCatalog,
+		ActorType: audit.ActorTypeSystem,
+	}, in)
+}
+
+func (s *Service) replaceCatalog(ctx context.Context, aud audit.NewAuditParams, in ReplaceCatalogInput) ([]*domain.Permission, error) {
+	appID, err := domain.ParseAppID(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	specs, err := parseSpecs(in.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	aud.SubjectType = audit.SubjectTypeApp
+	aud.SubjectID = appID.String()
+	aud.AppID = appID.String()
+
+	var (
+		catalog []*domain.Permission
+		removed int
+	)
+	err = s.requireApp(ctx, appID)
+	if err == nil {
+		err = s.tx(ctx, func(ctx context.Context) error {
+			existing, err := s.repo.List(ctx, appID)
+			if err != nil {
+				return err
+			}
+			for _, p := range existing {
+				if _, keep := specs[p.Name()]; keep {
+					continue
+				}
+				if err := s.remove(ctx, appID, p.Name()); err != nil {
+					return err
+				}
+				removed++
+			}
+			catalog = make([]*domain.Permission, 0, len(in.Permissions))
+			for _, spec := range in.Permissions {
+				p, err := s.put(ctx, appID, spec)
+				if err != nil {
+					return err
+				}
+				catalog = append(catalog, p)
+			}
+			return nil
+		})
+	}
+	aud.Metadata = map[string]string{
+		"permissions": strconv.Itoa(len(specs)),
+		"removed":     strconv.Itoa(removed),
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("replace permission catalog: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return catalog, nil
+}
+
+// parseSpecs validates every name and rejects duplicates, so a bad
+// entry fails the call before anything is written.
+func parseSpecs(in []PermissionSpec) (map[string]struct{}, error) {
+	seen := make(map[string]struct{}, len(in))
+	for i, spec := range in {
+		if _, err := domain.ParseName(spec.Name); err != nil {
+			var ve *validation.Error
+			if errors.As(err, &ve) {
+				ve.Field = fmt.Sprintf("permissions[%d].name", i)
+			}
+			return nil, err
+		}
+		if err := domain.ValidateDescription(spec.Description); err != nil {
+			return nil, err
+		}
+		if _, dup := seen[spec.Name]; dup {
+			return nil, &validation.Error{
+				Field:  fmt.Sprintf("permissions[%d].name", i),
+				Reason: "duplicate permission " + spec.Name,
+			}
+		}
+		seen[spec.Name] = struct{}{}
+	}
+	return seen, nil
+}
@@ -0,0 +1,78 @@
+// Package service hosts the application-layer use-cases of the
+// permission bounded context:
+//
+//	service.go — Service struct + helpers
+//	catalog.go — Put/List/DeletePermission, ReplaceCatalog (admin) and
+//	             SyncCatalog (declarative file, at startup)
+//	usage.go   — ListPermissionUsage (where-used across roles)
+//
+// Apps are owned by their own context; the service checks they exist
+// through app.AppReader before writing anything keyed on them.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/permission/internal/domain"
+)
+
+// TxRunner runs fn in one database transaction carried by ctx
+// (dbutil.WithTx bound to the module's *sql.DB).
+type TxRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+type Service struct {
+	repo    domain.Repository
+	apps    app.AppReader
+	tx      TxRunner
+	now     func() time.Time
+	log     *slog.Logger
+	auditor auditx.Auditor
+}
+
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	apps app.AppReader,
+	tx TxRunner,
+	now func() time.Time,
+	emitter audit.Emitter,
+) *Service {
+	return &Service{
+		repo:    repo,
+		apps:    apps,
+		tx:      tx,
+		now:     now,
+		log:     log,
+		auditor: auditx.New(log, emitter),
+	}
+}
+
+// errReasonMap maps permission sentinels (and the app one the
+// use-cases pass through) to their audit (Outcome, Reason) pair.
+var errReasonMap = map[error]auditx.OutcomeReason{
+	domain.ErrPermissionNotFound: auditx.Fail(audit.ReasonPermissionNotFound),
+	domain.ErrPermissionInUse:    auditx.Fail(audit.ReasonPermissionInUse),
+	app.ErrAppNotFound:           auditx.Fail(audit.ReasonAppNotFound),
+}
+
+// classifyError is the per-package thin wrapper around auditx.Classify.
+func classifyError(err error) (audit.AuditOutcome, string) {
+	return auditx.Classify(err, errReasonMap)
+}
+
+// withOutcome is a thin alias over auditx.WithOutcome.
+func withOutcome(p audit.NewAuditParams, out audit.AuditOutcome, reason string) audit.NewAuditParams {
+	return auditx.WithOutcome(p, out, reason)
+}
+
+// requireApp fails with app.ErrAppNotFound for an unknown app. Disabled
+// apps keep their catalog editable.
+func (s *Service) requireApp(ctx context.Context, id domain.AppID) error {
+	_, err := s.apps.GetByID(ctx, app.AppID(id))
+	return err
+}
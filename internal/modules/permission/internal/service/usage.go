@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/permission/internal/domain"
+)
+
+// ListPermissionUsage answers "where is this permission used": the
+// app's roles that hold name, or the "<resource>:*" wildcard covering
+// it. name need not be in the catalog — looking up a misspelt
+// permission finds the roles that carry the typo. A wildcard name
+// lists the roles holding that wildcard.
+func (s *Service) ListPermissionUsage(ctx context.Context, rawAppID, rawName string) ([]domain.Usage, error) {
+	if _, err := actor.Require(ctx); err != nil {
+		return nil, err
+	}
+	appID, err := domain.ParseAppID(rawAppID)
+	if err != nil {
+		return nil, err
+	}
+	name, err := domain.ParseReference(rawName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireApp(ctx, appID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListUsage(ctx, appID, name)
+}
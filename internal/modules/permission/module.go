@@ -0,0 +1,105 @@
+// Package permission exposes the wire-up for the permission bounded
+// context. bootstrap.New constructs a single *permission.Module and
+// pulls everything else off it:
+//
+//	mod.HTTPRoutes(authn)   // /v1/apps/{app_id}/permissions
+//	mod.SyncFile(ctx, path) // applies the declarative catalog file
+//	mod.CatalogReader()     // narrow read-only surface for role
+//	mod.Service()           // application-layer service (rare)
+//	mod.Repository()        // persistence contract
+//
+// Like group, the surface is HTTP-only until a catalog contract is
+// published in sso_protos.
+package permission
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/permission/internal/httpapi"
+	"sso/internal/modules/permission/internal/mariadb"
+	"sso/internal/modules/permission/internal/service"
+)
+
+// Emitter is the audit-event sink the module uses to record write
+// operations. Type alias over audit.Emitter — keeps the wiring
+// contract local to this module.
+type Emitter = audit.Emitter
+
+// Deps lists everything permission needs from its host.
+type Deps struct {
+	DB  *sql.DB
+	Log *slog.Logger
+
+	Apps app.AppReader
+
+	Clock func() time.Time
+	Audit Emitter
+}
+
+// Module is the assembled permission bounded context.
+type Module struct {
+	service *service.Service
+	repo    *mariadb.Repository
+	log     *slog.Logger
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.DB == nil {
+		return nil, fmt.Errorf("permission: db is required")
+	}
+	if d.Log == nil {
+		return nil, fmt.Errorf("permission: log is required")
+	}
+	if d.Apps == nil {
+		return nil, fmt.Errorf("permission: apps reader is required")
+	}
+	if d.Clock == nil {
+		d.Clock = time.Now
+	}
+	if d.Audit == nil {
+		d.Audit = audit.NopEmitter{}
+	}
+
+	repo := mariadb.NewRepository(d.DB)
+
+	var _ Repository = repo
+	var _ CatalogReader = repo
+
+	tx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return dbutil.WithTx(ctx, d.DB, fn)
+	}
+	svc := service.NewService(d.Log, repo, d.Apps, tx, d.Clock, d.Audit)
+
+	return &Module{
+		service: svc,
+		repo:    repo,
+		log:     d.Log,
+	}, nil
+}
+
+// HTTPRoutes returns the registrar for the permission endpoints. The
+// authenticator and authorizer are taken here rather than in Deps
+// because bootstrap builds them (grpcauth.Interceptor,
+// httprbac.Authorizer) after role, which reads catalogs.
+func (m *Module) HTTPRoutes(authn Authenticator, authz Authorizer) func(*http.ServeMux) {
+	h := httpapi.NewHandler(m.service, authn, authz, m.log)
+	return h.Register
+}
+
+// Service returns the application-layer Service.
+func (m *Module) Service() *service.Service { return m.service }
+
+// Repository returns the persistence contract.
+func (m *Module) Repository() Repository { return m.repo }
+
+// CatalogReader returns the narrow read-only surface.
+func (m *Module) CatalogReader() CatalogReader { return m.repo }
@@ -0,0 +1,55 @@
+// Package permission is the public API of the permission bounded
+// context (the per-app catalog of resource:action permissions roles
+// may name). External callers interact with the module through:
+//
+//	permission.New(Deps)       wires the module (module.go)
+//	permission.Service         application-layer use-cases (service.go)
+//	permission.Repository      persistence contract
+//	permission.CatalogReader   narrow read-only surface for role
+package permission
+
+import (
+	"context"
+
+	"sso/internal/modules/permission/internal/domain"
+	"sso/internal/modules/permission/internal/httpapi"
+)
+
+type (
+	Permission              = domain.Permission
+	NewPermissionParams     = domain.NewPermissionParams
+	RestorePermissionParams = domain.RestorePermissionParams
+	Catalog                 = domain.Catalog
+	Usage                   = domain.Usage
+	AppID                   = domain.AppID
+	Repository              = domain.Repository
+
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+)
+
+var (
+	ParseAppID        = domain.ParseAppID
+	ParseName         = domain.ParseName
+	ParseReference    = domain.ParseReference
+	NewCatalog        = domain.NewCatalog
+	RestorePermission = domain.RestorePermission
+)
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var (
+	ErrPermissionNotFound = domain.ErrPermissionNotFound
+	ErrPermissionInUse    = domain.ErrPermissionInUse
+)
+
+// CatalogReader — narrow read-only surface used by sibling modules.
+//
+// role lists an app's catalog to check the permissions of a role it
+// creates or updates (NewCatalog + Catalog.CheckRolePermissions). The
+// MariaDB Repository satisfies this interface (compile-time checked in
+// module.go).
+type CatalogReader interface {
+	List(ctx context.Context, appID AppID) ([]*Permission, error)
+}
@@ -0,0 +1,17 @@
+// Package permission re-exports the application-layer Service together
+// with the typed Input/Output structs declared in internal/service.
+package permission
+
+import "sso/internal/modules/permission/internal/service"
+
+// Service is the use-case orchestrator, split across files in
+// internal/service: catalog.go, usage.go.
+type Service = service.Service
+
+// Input / Output type aliases.
+type (
+	PutPermissionInput    = service.PutPermissionInput
+	DeletePermissionInput = service.DeletePermissionInput
+	ReplaceCatalogInput   = service.ReplaceCatalogInput
+	PermissionSpec        = service.PermissionSpec
+)
@@ -0,0 +1,33 @@
+package domain
+
+import "errors"
+
+var (
+	ErrCampaignNotFound = errors.New("review: campaign not found")
+	ErrItemNotFound     = errors.New("review: item not found")
+	ErrEtagMismatch     = errors.New("review: etag mismatch")
+
+	// ErrCampaignClosed — the campaign was completed or cancelled; its
+	// items can no longer be decided or reassigned.
+	ErrCampaignClosed = errors.New("review: campaign closed")
+
+	// ErrItemDecided — the item was already approved or revoked.
+	ErrItemDecided = errors.New("review: item already decided")
+
+	// ErrNotReviewer — the caller is not the item's reviewer.
+	ErrNotReviewer = errors.New("review: caller is not the item's reviewer")
+
+	// ErrNotCampaignOwner — the caller neither opened the campaign nor
+	// is a super-admin.
+	ErrNotCampaignOwner = errors.New("review: caller does not own the campaign")
+
+	// ErrNothingToReview — the scope holds no direct assignments.
+	ErrNothingToReview = errors.New("review: no assignments to review")
+
+	// ErrCampaignTooLarge — the scope holds more assignments than one
+	// campaign takes; review it role by role.
+	ErrCampaignTooLarge = errors.New("review: too many assignments for one campaign")
+
+	// ErrSelfReview — the reviewer named is the item's own principal.
+	ErrSelfReview = errors.New("review: reviewers cannot review their own access")
+)
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"sso/internal/kernel/etag"
+)
+
+// Repository is the persistence contract for the review context.
+//
+// Error contract:
+//
+//	GetByID           → ErrCampaignNotFound
+//	Update            → ErrCampaignNotFound / ErrEtagMismatch
+//	GetItem           → ErrItemNotFound
+//	DecideItem        → ErrItemNotFound / ErrItemDecided
+//	ReassignItem      → ErrItemNotFound / ErrItemDecided
+//
+// Create writes the campaign and its items in one transaction (joining
+// the ambient one of dbutil.WithTx, if any). expectedEtag "" means
+// unconditional, same as every other module.
+type Repository interface {
+	Create(ctx context.Context, c *Campaign, items []*Item) error
+	GetByID(ctx context.Context, id CampaignID) (*Campaign, error)
+	List(ctx context.Context, q ListQuery) (ListResult, error)
+	Update(ctx context.Context, c *Campaign, expectedEtag etag.Etag) error
+
+	// ListDue returns up to limit open campaigns whose due_at is at or
+	// before now, earliest first, whatever their tenant.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*Campaign, error)
+
+	GetItem(ctx context.Context, campaignID CampaignID, id ItemID) (*Item, error)
+	ListItems(ctx context.Context, q ItemsQuery) ([]*Item, error)
+	CountItems(ctx context.Context, campaignID CampaignID) (ItemCounts, error)
+
+	// DecideItem stores the item's decision, provided it is still
+	// pending: of two concurrent decisions one wins, the other gets
+	// ErrItemDecided.
+	DecideItem(ctx context.Context, it *Item) error
+	// ReassignItem hands a pending item to reviewer.
+	ReassignItem(ctx context.Context, campaignID CampaignID, id ItemID, reviewer UserID) error
+	// ReassignPending hands every pending item of the campaign to
+	// reviewer, except those whose principal reviewer is, and returns
+	// how many moved.
+	ReassignPending(ctx context.Context, campaignID CampaignID, reviewer UserID) (int64, error)
+}
+
+// ListQuery pages campaigns newest first. Statuses empty = all.
+type ListQuery struct {
+	PageSize int
+	After    *PageCursor
+	Statuses []CampaignStatus
+}
+
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        CampaignID
+}
+
+type ListResult struct {
+	Campaigns  []*Campaign
+	NextCursor *PageCursor
+}
+
+// ItemsQuery pages a campaign's items in id order. The implementation
+// returns up to PageSize+1 rows so the caller can tell whether another
+// page follows. ReviewerID "" and Decision 0 do not filter.
+type ItemsQuery struct {
+	CampaignID CampaignID
+	ReviewerID UserID
+	Decision   Decision
+	After      ItemID
+	PageSize   int
+}
+
+// ItemCounts is a campaign's progress.
+type ItemCounts struct {
+	Pending  int
+	Approved int
+	Revoked  int
+}
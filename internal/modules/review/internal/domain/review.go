@@ -0,0 +1,447 @@
+// Package domain holds the aggregates of the review bounded context:
+// the Campaign, a time-boxed re-certification of the role assignments
+// in one app (or of one role in it), and its Items, one per assignment
+// captured when the campaign opened, each decided by a reviewer.
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/validation"
+)
+
+// ----------------------------------------------------------------------------
+// IDs
+// ----------------------------------------------------------------------------
+
+// CampaignID — RFC 4122 UUID, generated as v7 (k-sortable).
+type CampaignID string
+
+func NewCampaignID() (CampaignID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate campaign id: %w", err)
+	}
+	return CampaignID(id.String()), nil
+}
+
+func ParseCampaignID(s string) (CampaignID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "campaign_id", Reason: "must be a valid UUID"}
+	}
+	return CampaignID(s), nil
+}
+
+func (id CampaignID) String() string { return string(id) }
+
+// ItemID — RFC 4122 UUID, generated as v7 (k-sortable).
+type ItemID string
+
+func NewItemID() (ItemID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate review item id: %w", err)
+	}
+	return ItemID(id.String()), nil
+}
+
+func ParseItemID(s string) (ItemID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "item_id", Reason: "must be a valid UUID"}
+	}
+	return ItemID(s), nil
+}
+
+func (id ItemID) String() string { return string(id) }
+
+// UserID is a cross-context handle to identity.User: a reviewer.
+type UserID string
+
+func ParseUserID(s string) (UserID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "reviewer_id", Reason: "must be a valid UUID"}
+	}
+	return UserID(s), nil
+}
+
+func (id UserID) String() string { return string(id) }
+
+// AppID is a cross-context handle to app.App.
+type AppID string
+
+func (id AppID) String() string { return string(id) }
+
+// RoleID is a cross-context handle to role.Role.
+type RoleID string
+
+func (id RoleID) String() string { return string(id) }
+
+// ActorID is the user or service account that opened a campaign or
+// decided an item.
+type ActorID string
+
+func (id ActorID) String() string { return string(id) }
+
+// ----------------------------------------------------------------------------
+// Enums
+// ----------------------------------------------------------------------------
+
+// CampaignStatus is the on-wire value of access_review_campaigns.status
+// — do not renumber.
+type CampaignStatus uint8
+
+const (
+	CampaignStatusOpen      CampaignStatus = 1
+	CampaignStatusCompleted CampaignStatus = 2
+	CampaignStatusCancelled CampaignStatus = 3
+)
+
+func ParseCampaignStatus(s string) (CampaignStatus, error) {
+	switch s {
+	case "open":
+		return CampaignStatusOpen, nil
+	case "completed":
+		return CampaignStatusCompleted, nil
+	case "cancelled":
+		return CampaignStatusCancelled, nil
+	}
+	return 0, &validation.Error{Field: "status", Reason: "must be one of: open, completed, cancelled"}
+}
+
+func (s CampaignStatus) String() string {
+	switch s {
+	case CampaignStatusOpen:
+		return "open"
+	case CampaignStatusCompleted:
+		return "completed"
+	case CampaignStatusCancelled:
+		return "cancelled"
+	}
+	return fmt.Sprintf("CampaignStatus(%d)", s)
+}
+
+// DeadlineAction is what the deadline does to items still pending —
+// the on-wire value of access_review_campaigns.deadline_action.
+type DeadlineAction uint8
+
+const (
+	// DeadlineRevoke revokes them: access that nobody vouched for goes.
+	DeadlineRevoke DeadlineAction = 1
+	// DeadlineEscalate hands them to the campaign's escalation reviewer
+	// and moves the deadline once; the moved deadline revokes.
+	DeadlineEscalate DeadlineAction = 2
+)
+
+func ParseDeadlineAction(s string) (DeadlineAction, error) {
+	switch s {
+	case "revoke":
+		return DeadlineRevoke, nil
+	case "escalate":
+		return DeadlineEscalate, nil
+	}
+	return 0, &validation.Error{Field: "deadline_action", Reason: "must be one of: revoke, escalate"}
+}
+
+func (a DeadlineAction) String() string {
+	switch a {
+	case DeadlineRevoke:
+		return "revoke"
+	case DeadlineEscalate:
+		return "escalate"
+	}
+	return fmt.Sprintf("DeadlineAction(%d)", a)
+}
+
+// Decision is the on-wire value of access_review_items.decision.
+type Decision uint8
+
+const (
+	DecisionPending  Decision = 1
+	DecisionApproved Decision = 2
+	DecisionRevoked  Decision = 3
+)
+
+func ParseDecision(s string) (Decision, error) {
+	switch s {
+	case "pending":
+		return DecisionPending, nil
+	case "approved":
+		return DecisionApproved, nil
+	case "revoked":
+		return DecisionRevoked, nil
+	}
+	return 0, &validation.Error{Field: "decision", Reason: "must be one of: pending, approved, revoked"}
+}
+
+func (d Decision) String() string {
+	switch d {
+	case DecisionPending:
+		return "pending"
+	case DecisionApproved:
+		return "approved"
+	case DecisionRevoked:
+		return "revoked"
+	}
+	return fmt.Sprintf("Decision(%d)", d)
+}
+
+// PrincipalType says what an item's principal is. The values mirror
+// access.PrincipalKind.
+type PrincipalType uint8
+
+const (
+	PrincipalUser           PrincipalType = 1
+	PrincipalServiceAccount PrincipalType = 2
+)
+
+func (t PrincipalType) String() string {
+	switch t {
+	case PrincipalUser:
+		return "user"
+	case PrincipalServiceAccount:
+		return "service_account"
+	}
+	return fmt.Sprintf("PrincipalType(%d)", t)
+}
+
+// ----------------------------------------------------------------------------
+// Campaign aggregate
+// ----------------------------------------------------------------------------
+//
+// The scope (app, role), the deadline action and the escalation
+// reviewer are fixed at creation. Status, the deadline and the
+// escalation mark change only through Complete, Cancel and Escalate,
+// which advance the etag.
+
+const (
+	maxNameLen    = 128
+	maxCommentLen = 1024
+)
+
+type Campaign struct {
+	id             CampaignID
+	tenantID       string
+	appID          AppID
+	roleID         RoleID
+	status         CampaignStatus
+	deadlineAction DeadlineAction
+	escalateTo     UserID
+	dueAt          time.Time
+	escalatedAt    time.Time
+	createdBy      ActorID
+	etag           etag.Etag
+	createdAt      time.Time
+	updatedAt      time.Time
+	closedAt       time.Time
+
+	Name string
+}
+
+type NewCampaignParams struct {
+	ID             CampaignID
+	TenantID       string
+	Name           string
+	AppID          AppID
+	RoleID         RoleID // "" = every role of the app
+	DeadlineAction DeadlineAction
+	EscalateTo     UserID // required with DeadlineEscalate
+	DueAt          time.Time
+	CreatedBy      ActorID
+	Now            time.Time
+}
+
+// NewCampaign validates the supplied fields and opens a campaign.
+func NewCampaign(p NewCampaignParams) (*Campaign, error) {
+	name := strings.TrimSpace(p.Name)
+	if n := utf8.RuneCountInString(name); n == 0 || n > maxNameLen {
+		return nil, &validation.Error{Field: "name", Reason: fmt.Sprintf("length must be between 1 and %d", maxNameLen)}
+	}
+	if !p.DueAt.After(p.Now) {
+		return nil, &validation.Error{Field: "due_at", Reason: "must be in the future"}
+	}
+	switch p.DeadlineAction {
+	case DeadlineRevoke:
+		if p.EscalateTo != "" {
+			return nil, &validation.Error{Field: "escalate_to", Reason: "is only allowed with deadline_action escalate"}
+		}
+	case DeadlineEscalate:
+		if p.EscalateTo == "" {
+			return nil, &validation.Error{Field: "escalate_to", Reason: "is required with deadline_action escalate"}
+		}
+	default:
+		return nil, &validation.Error{Field: "deadline_action", Reason: "must be one of: revoke, escalate"}
+	}
+	return &Campaign{
+		id:             p.ID,
+		tenantID:       p.TenantID,
+		appID:          p.AppID,
+		roleID:         p.RoleID,
+		status:         CampaignStatusOpen,
+		deadlineAction: p.DeadlineAction,
+		escalateTo:     p.EscalateTo,
+		dueAt:          p.DueAt,
+		createdBy:      p.CreatedBy,
+		etag:           etag.New(),
+		createdAt:      p.Now,
+		updatedAt:      p.Now,
+		Name:           name,
+	}, nil
+}
+
+type RestoreCampaignParams struct {
+	ID             CampaignID
+	TenantID       string
+	Name           string
+	AppID          AppID
+	RoleID         RoleID
+	Status         CampaignStatus
+	DeadlineAction DeadlineAction
+	EscalateTo     UserID
+	DueAt          time.Time
+	EscalatedAt    time.Time
+	CreatedBy      ActorID
+	Etag           etag.Etag
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ClosedAt       time.Time
+}
+
+// RestoreCampaign rebuilds a Campaign from a trusted row.
+func RestoreCampaign(p RestoreCampaignParams) *Campaign {
+	return &Campaign{
+		id:             p.ID,
+		tenantID:       p.TenantID,
+		appID:          p.AppID,
+		roleID:         p.RoleID,
+		status:         p.Status,
+		deadlineAction: p.DeadlineAction,
+		escalateTo:     p.EscalateTo,
+		dueAt:          p.DueAt,
+		escalatedAt:    p.EscalatedAt,
+		createdBy:      p.CreatedBy,
+		etag:           p.Etag,
+		createdAt:      p.CreatedAt,
+		updatedAt:      p.UpdatedAt,
+		closedAt:       p.ClosedAt,
+		Name:           p.Name,
+	}
+}
+
+func (c *Campaign) ID() CampaignID                 { return c.id }
+func (c *Campaign) TenantID() string               { return c.tenantID }
+func (c *Campaign) AppID() AppID                   { return c.appID }
+func (c *Campaign) RoleID() RoleID                 { return c.roleID }
+func (c *Campaign) Status() CampaignStatus         { return c.status }
+func (c *Campaign) DeadlineAction() DeadlineAction { return c.deadlineAction }
+func (c *Campaign) EscalateTo() UserID             { return c.escalateTo }
+func (c *Campaign) DueAt() time.Time               { return c.dueAt }
+func (c *Campaign) EscalatedAt() time.Time         { return c.escalatedAt }
+func (c *Campaign) CreatedBy() ActorID             { return c.createdBy }
+func (c *Campaign) Etag() etag.Etag                { return c.etag }
+func (c *Campaign) CreatedAt() time.Time           { return c.createdAt }
+func (c *Campaign) UpdatedAt() time.Time           { return c.updatedAt }
+func (c *Campaign) ClosedAt() time.Time            { return c.closedAt }
+
+// IsEscalated reports whether the deadline already escalated once.
+func (c *Campaign) IsEscalated() bool { return !c.escalatedAt.IsZero() }
+
+// EscalatesAtDeadline reports whether the next deadline escalates
+// rather than revokes.
+func (c *Campaign) EscalatesAtDeadline() bool {
+	return c.deadlineAction == DeadlineEscalate && !c.IsEscalated()
+}
+
+// Complete closes an open campaign whose items are all decided.
+func (c *Campaign) Complete(now time.Time) error {
+	return c.close(CampaignStatusCompleted, now)
+}
+
+// Cancel closes an open campaign early. Decisions made so far stand;
+// pending items stay pending for good.
+func (c *Campaign) Cancel(now time.Time) error {
+	return c.close(CampaignStatusCancelled, now)
+}
+
+func (c *Campaign) close(status CampaignStatus, now time.Time) error {
+	if c.status != CampaignStatusOpen {
+		return ErrCampaignClosed
+	}
+	c.status = status
+	c.closedAt = now
+	c.bumpVersion(now)
+	return nil
+}
+
+// Escalate records the deadline's escalation and moves the deadline to
+// dueAt.
+func (c *Campaign) Escalate(dueAt, now time.Time) error {
+	if c.status != CampaignStatusOpen {
+		return ErrCampaignClosed
+	}
+	c.escalatedAt = now
+	c.dueAt = dueAt
+	c.bumpVersion(now)
+	return nil
+}
+
+func (c *Campaign) bumpVersion(now time.Time) {
+	c.etag = etag.New()
+	c.updatedAt = now
+}
+
+// ----------------------------------------------------------------------------
+// Item
+// ----------------------------------------------------------------------------
+
+// Item is one role assignment under review. Only the reviewer and the
+// decision change after creation, and the decision only once: the
+// repository applies both conditionally on the item still being
+// pending.
+type Item struct {
+	ID            ItemID
+	CampaignID    CampaignID
+	PrincipalType PrincipalType
+	PrincipalID   string
+	RoleID        RoleID
+	ReviewerID    UserID
+	Decision      Decision
+	DecidedBy     ActorID // "" = decided by the deadline
+	DecidedAt     time.Time
+	Comment       string
+	CreatedAt     time.Time
+}
+
+// IsPending reports whether the item still awaits a decision.
+func (i *Item) IsPending() bool { return i.Decision == DecisionPending }
+
+// Decide records the decision on a pending item. by is "" for the
+// deadline's revocations.
+func (i *Item) Decide(d Decision, by ActorID, comment string, now time.Time) error {
+	if !i.IsPending() {
+		return ErrItemDecided
+	}
+	if d != DecisionApproved && d != DecisionRevoked {
+		return &validation.Error{Field: "decision", Reason: "must be one of: approved, revoked"}
+	}
+	if err := ValidateComment(comment); err != nil {
+		return err
+	}
+	i.Decision = d
+	i.DecidedBy = by
+	i.DecidedAt = now
+	i.Comment = comment
+	return nil
+}
+
+func ValidateComment(c string) error {
+	if utf8.RuneCountInString(c) > maxCommentLen {
+		return &validation.Error{Field: "comment", Reason: fmt.Sprintf("must be at most %d characters", maxCommentLen)}
+	}
+	return nil
+}
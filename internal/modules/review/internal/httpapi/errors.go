@@ -0,0 +1,56 @@
+package httpapi
+
+import (
+	"sso/internal/modules/access"
+	"sso/internal/modules/app"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/review/internal/domain"
+	"sso/internal/modules/role"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap translates review sentinels, and the app / role / identity /
+// access ones the use-cases pass through, into statuses. errors.proto
+// has no access review reasons, so those entries are bare statuses
+// (Reason UNSPECIFIED) unless an existing reason fits.
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrCampaignNotFound: {
+		Code: codes.NotFound, Message: "access review not found"},
+	domain.ErrItemNotFound: {
+		Code: codes.NotFound, Message: "access review item not found"},
+	domain.ErrEtagMismatch: {
+		Code: codes.Aborted, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ETAG_MISMATCH, Message: "etag mismatch"},
+	domain.ErrCampaignClosed: {
+		Code: codes.FailedPrecondition, Message: "access review is closed"},
+	domain.ErrItemDecided: {
+		Code: codes.FailedPrecondition, Message: "access review item is already decided"},
+	domain.ErrNotReviewer: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "only the item's reviewer may decide it"},
+	domain.ErrNotCampaignOwner: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "only the campaign's creator may do this"},
+	domain.ErrSelfReview: {
+		Code: codes.FailedPrecondition, Message: "reviewers cannot review their own access"},
+	domain.ErrNothingToReview: {
+		Code: codes.FailedPrecondition, Message: "no role assignments to review"},
+	domain.ErrCampaignTooLarge: {
+		Code: codes.FailedPrecondition, Message: "too many role assignments for one campaign; review role by role"},
+	app.ErrAppNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_APP_NOT_FOUND, Message: "app not found"},
+	role.ErrRoleNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_FOUND, Message: "role not found"},
+	access.ErrRoleNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_FOUND, Message: "role not found"},
+	access.ErrRoleNotInApp: {
+		Code: codes.InvalidArgument, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_IN_APP, Message: "role does not belong to the app"},
+	identity.ErrUserNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_NOT_FOUND, Message: "user not found"},
+	access.ErrUserNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_NOT_FOUND, Message: "user not found"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
@@ -0,0 +1,312 @@
+// Package httpapi is the HTTP adapter for the review context.
+//
+// No AccessReviewService contract is published in sso_protos, so these
+// are hand-written net/http handlers mounted next to the grpc-gateway.
+// Error bodies use the same google.rpc.Status JSON shape as the
+// gateway, so clients need a single error decoder.
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/modules/review/internal/domain"
+	revsvc "sso/internal/modules/review/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+type Handler struct {
+	svc *revsvc.Service
+	api *apiutil.Adapter
+	log *slog.Logger
+}
+
+func NewHandler(svc *revsvc.Service, authn Authenticator, authz Authorizer, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("review", authn, authz, log, toStatus), log: log}
+}
+
+// Register mounts the access review endpoints. The item listing takes
+// format=csv or format=jsonl to export every page as one download.
+//
+//	GET   /v1/access-reviews?status=&page_size=&page_token=
+//	POST  /v1/access-reviews
+//	GET   /v1/access-reviews/{id}
+//	POST  /v1/access-reviews/{id}/cancel?etag=
+//	GET   /v1/access-reviews/{id}/items?reviewer_id=&decision=&page_size=&page_token=&format=
+//	POST  /v1/access-reviews/{id}/items/{item_id}/approve
+//	POST  /v1/access-reviews/{id}/items/{item_id}/revoke
+//	POST  /v1/access-reviews/{id}/items/{item_id}/reassign
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/access-reviews", h.api.Authed(h.listCampaigns))
+	mux.HandleFunc("POST /v1/access-reviews", h.api.Authed(h.createCampaign))
+	mux.HandleFunc("GET /v1/access-reviews/{id}", h.api.Authed(h.getCampaign))
+	mux.HandleFunc("POST /v1/access-reviews/{id}/cancel", h.api.Authed(h.cancelCampaign))
+	mux.HandleFunc("GET /v1/access-reviews/{id}/items", h.api.Authed(h.listItems))
+	mux.HandleFunc("POST /v1/access-reviews/{id}/items/{item_id}/approve", h.api.Authed(h.decide(domain.DecisionApproved)))
+	mux.HandleFunc("POST /v1/access-reviews/{id}/items/{item_id}/revoke", h.api.Authed(h.decide(domain.DecisionRevoked)))
+	mux.HandleFunc("POST /v1/access-reviews/{id}/items/{item_id}/reassign", h.api.Authed(h.reassign))
+}
+
+// ----------------------------------------------------------------------------
+// Campaigns
+// ----------------------------------------------------------------------------
+
+type createBody struct {
+	Name           string    `json:"name"`
+	AppID          string    `json:"app_id"`
+	RoleID         string    `json:"role_id"`
+	ReviewerIDs    []string  `json:"reviewer_ids"`
+	DeadlineAction string    `json:"deadline_action"` // "" = revoke
+	EscalateTo     string    `json:"escalate_to"`
+	DueAt          time.Time `json:"due_at"`
+}
+
+func (h *Handler) createCampaign(w http.ResponseWriter, r *http.Request) {
+	var b createBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	action := domain.DeadlineRevoke
+	if b.DeadlineAction != "" {
+		var err error
+		if action, err = domain.ParseDeadlineAction(b.DeadlineAction); err != nil {
+			h.api.WriteError(w, r, err)
+			return
+		}
+	}
+	c, err := h.svc.CreateCampaign(r.Context(), revsvc.CreateCampaignInput{
+		Name:           b.Name,
+		AppID:          b.AppID,
+		RoleID:         b.RoleID,
+		ReviewerIDs:    b.ReviewerIDs,
+		DeadlineAction: action,
+		EscalateTo:     b.EscalateTo,
+		DueAt:          b.DueAt,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusCreated, campaignView(c))
+}
+
+func (h *Handler) listCampaigns(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	in := revsvc.ListCampaignsInput{PageToken: q.Get("page_token")}
+	n, err := parsePageSize(q.Get("page_size"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	in.PageSize = n
+	for _, v := range apiutil.SplitList(q.Get("status")) {
+		st, err := domain.ParseCampaignStatus(v)
+		if err != nil {
+			h.api.WriteError(w, r, err)
+			return
+		}
+		in.Statuses = append(in.Statuses, st)
+	}
+	out, err := h.svc.ListCampaigns(r.Context(), in)
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(out.Campaigns))
+	for _, c := range out.Campaigns {
+		views = append(views, campaignView(c))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"access_reviews":  views,
+		"next_page_token": out.NextPageToken,
+	})
+}
+
+func (h *Handler) getCampaign(w http.ResponseWriter, r *http.Request) {
+	out, err := h.svc.GetCampaign(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	v := campaignView(out.Campaign)
+	v["items"] = map[string]int{
+		"pending":  out.Counts.Pending,
+		"approved": out.Counts.Approved,
+		"revoked":  out.Counts.Revoked,
+	}
+	apiutil.WriteJSON(w, http.StatusOK, v)
+}
+
+func (h *Handler) cancelCampaign(w http.ResponseWriter, r *http.Request) {
+	c, err := h.svc.CancelCampaign(r.Context(), revsvc.CancelCampaignInput{
+		CampaignID:   r.PathValue("id"),
+		ExpectedEtag: r.URL.Query().Get("etag"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, campaignView(c))
+}
+
+// ----------------------------------------------------------------------------
+// Items
+// ----------------------------------------------------------------------------
+
+// itemColumns are the CSV columns of the item export.
+var itemColumns = []string{
+	"id", "principal_type", "principal_id", "role_id", "reviewer_id",
+	"decision", "decided_by", "decided_at", "comment", "created_at",
+}
+
+func (h *Handler) listItems(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	in := revsvc.ListItemsInput{
+		CampaignID: r.PathValue("id"),
+		ReviewerID: q.Get("reviewer_id"),
+	}
+	n, err := parsePageSize(q.Get("page_size"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	in.PageSize = n
+	if v := q.Get("decision"); v != "" {
+		if in.Decision, err = domain.ParseDecision(v); err != nil {
+			h.api.WriteError(w, r, err)
+			return
+		}
+	}
+	l := listing{
+		field:   "items",
+		file:    "access-review-" + in.CampaignID,
+		columns: itemColumns,
+	}
+	h.list(w, r, l, func(token string) (listPage, error) {
+		in.PageToken = token
+		out, err := h.svc.ListItems(r.Context(), in)
+		if err != nil {
+			return listPage{}, err
+		}
+		rows := make([]map[string]any, 0, len(out.Items))
+		for _, it := range out.Items {
+			rows = append(rows, itemView(it))
+		}
+		return listPage{
+			meta: map[string]any{"access_review": campaignView(out.Campaign)},
+			rows: rows,
+			next: out.NextPageToken,
+		}, nil
+	})
+}
+
+type decideBody struct {
+	Comment string `json:"comment"`
+}
+
+func (h *Handler) decide(d domain.Decision) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var b decideBody
+		if r.ContentLength != 0 {
+			if err := apiutil.DecodeJSON(r, &b); err != nil {
+				h.api.WriteError(w, r, err)
+				return
+			}
+		}
+		it, err := h.svc.DecideItem(r.Context(), revsvc.DecideItemInput{
+			CampaignID: r.PathValue("id"),
+			ItemID:     r.PathValue("item_id"),
+			Decision:   d,
+			Comment:    b.Comment,
+		})
+		if err != nil {
+			h.api.WriteError(w, r, err)
+			return
+		}
+		apiutil.WriteJSON(w, http.StatusOK, itemView(it))
+	}
+}
+
+type reassignBody struct {
+	ReviewerID string `json:"reviewer_id"`
+}
+
+func (h *Handler) reassign(w http.ResponseWriter, r *http.Request) {
+	var b reassignBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	it, err := h.svc.ReassignItem(r.Context(), revsvc.ReassignItemInput{
+		CampaignID: r.PathValue("id"),
+		ItemID:     r.PathValue("item_id"),
+		ReviewerID: b.ReviewerID,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, itemView(it))
+}
+
+// ----------------------------------------------------------------------------
+// Views
+// ----------------------------------------------------------------------------
+
+func campaignView(c *domain.Campaign) map[string]any {
+	v := map[string]any{
+		"id":              c.ID().String(),
+		"name":            c.Name,
+		"app_id":          c.AppID().String(),
+		"status":          c.Status().String(),
+		"deadline_action": c.DeadlineAction().String(),
+		"due_at":          c.DueAt().UTC().Format(time.RFC3339),
+		"created_by":      c.CreatedBy().String(),
+		"etag":            c.Etag().String(),
+		"created_at":      c.CreatedAt().UTC().Format(time.RFC3339),
+		"updated_at":      c.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+	if c.RoleID() != "" {
+		v["role_id"] = c.RoleID().String()
+	}
+	if c.EscalateTo() != "" {
+		v["escalate_to"] = c.EscalateTo().String()
+	}
+	if c.IsEscalated() {
+		v["escalated_at"] = c.EscalatedAt().UTC().Format(time.RFC3339)
+	}
+	if !c.ClosedAt().IsZero() {
+		v["closed_at"] = c.ClosedAt().UTC().Format(time.RFC3339)
+	}
+	return v
+}
+
+// itemView renders an item. decided_by is empty on the deadline's
+// revocations.
+func itemView(it *domain.Item) map[string]any {
+	v := map[string]any{
+		"id":             it.ID.String(),
+		"principal_type": it.PrincipalType.String(),
+		"principal_id":   it.PrincipalID,
+		"role_id":        it.RoleID.String(),
+		"reviewer_id":    it.ReviewerID.String(),
+		"decision":       it.Decision.String(),
+		"comment":        it.Comment,
+		"created_at":     it.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if !it.IsPending() {
+		v["decided_by"] = it.DecidedBy.String()
+		v["decided_at"] = it.DecidedAt.UTC().Format(time.RFC3339)
+	}
+	return v
+}
@@ -0,0 +1,132 @@
+package mariadb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/review/internal/domain"
+	"sso/internal/modules/review/internal/mariadb/dbgen"
+)
+
+// List is hand-written: the status filter is optional and the keyset
+// cursor is (created_at, id) descending. Rows are filtered to
+// tenant.Scope.
+func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListResult, error) {
+	if q.PageSize <= 0 {
+		return domain.ListResult{}, fmt.Errorf("review repo: list: page_size must be > 0")
+	}
+
+	var (
+		where []string
+		args  []any
+	)
+	if id := tenant.Scope(ctx); id != "" {
+		where = append(where, "tenant_id = ?")
+		args = append(args, id)
+	}
+	if len(q.Statuses) > 0 {
+		ph := make([]string, 0, len(q.Statuses))
+		for _, s := range q.Statuses {
+			ph = append(ph, "?")
+			args = append(args, uint8(s))
+		}
+		where = append(where, "status IN ("+strings.Join(ph, ", ")+")")
+	}
+	if q.After != nil {
+		where = append(where, "(created_at, id) < (?, ?)")
+		args = append(args, q.After.CreatedAt, q.After.ID.String())
+	}
+	whereSQL := ""
+	if len(where) > 0 {
+		whereSQL = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	query := fmt.Sprintf(
+		`SELECT id, tenant_id, name, app_id, role_id, status, deadline_action, escalate_to,
+		        due_at, escalated_at, created_by, etag, created_at, updated_at, closed_at
+		 FROM access_review_campaigns %s ORDER BY created_at DESC, id DESC LIMIT %d`,
+		whereSQL, q.PageSize+1,
+	)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return domain.ListResult{}, fmt.Errorf("review repo: list: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*domain.Campaign, 0, q.PageSize)
+	for rows.Next() {
+		var c dbgen.AccessReviewCampaign
+		if err := rows.Scan(
+			&c.ID, &c.TenantID, &c.Name, &c.AppID, &c.RoleID, &c.Status, &c.DeadlineAction, &c.EscalateTo,
+			&c.DueAt, &c.EscalatedAt, &c.CreatedBy, &c.Etag, &c.CreatedAt, &c.UpdatedAt, &c.ClosedAt,
+		); err != nil {
+			return domain.ListResult{}, fmt.Errorf("review repo: list: scan: %w", err)
+		}
+		out = append(out, campaignToDomain(c))
+	}
+	if err := rows.Err(); err != nil {
+		return domain.ListResult{}, fmt.Errorf("review repo: list: rows: %w", err)
+	}
+
+	var next *domain.PageCursor
+	if len(out) > q.PageSize {
+		out = out[:q.PageSize]
+		last := out[len(out)-1]
+		next = &domain.PageCursor{CreatedAt: last.CreatedAt(), ID: last.ID()}
+	}
+	return domain.ListResult{Campaigns: out, NextCursor: next}, nil
+}
+
+// ListItems is hand-written for its optional reviewer and decision
+// filters. The keyset is the item id; ids are UUIDv7 minted in one
+// batch, so id order is creation order.
+func (r *Repository) ListItems(ctx context.Context, q domain.ItemsQuery) ([]*domain.Item, error) {
+	if q.PageSize <= 0 {
+		return nil, fmt.Errorf("review repo: list_items: page_size must be > 0")
+	}
+
+	where := []string{"campaign_id = ?"}
+	args := []any{q.CampaignID.String()}
+	if q.ReviewerID != "" {
+		where = append(where, "reviewer_id = ?")
+		args = append(args, q.ReviewerID.String())
+	}
+	if q.Decision != 0 {
+		where = append(where, "decision = ?")
+		args = append(args, uint8(q.Decision))
+	}
+	if q.After != "" {
+		where = append(where, "id > ?")
+		args = append(args, q.After.String())
+	}
+
+	query := fmt.Sprintf(
+		`SELECT id, campaign_id, principal_type, principal_id, role_id, reviewer_id,
+		        decision, decided_by, decided_at, comment, created_at
+		 FROM access_review_items WHERE %s ORDER BY id LIMIT %d`,
+		strings.Join(where, " AND "), q.PageSize+1,
+	)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("review repo: list_items: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*domain.Item, 0, q.PageSize+1)
+	for rows.Next() {
+		var i dbgen.AccessReviewItem
+		if err := rows.Scan(
+			&i.ID, &i.CampaignID, &i.PrincipalType, &i.PrincipalID, &i.RoleID, &i.ReviewerID,
+			&i.Decision, &i.DecidedBy, &i.DecidedAt, &i.Comment, &i.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("review repo: list_items: scan: %w", err)
+		}
+		out = append(out, itemToDomain(i))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("review repo: list_items: rows: %w", err)
+	}
+	return out, nil
+}
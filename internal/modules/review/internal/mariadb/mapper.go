@@ -0,0 +1,108 @@
+package mariadb
+
+import (
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/review/internal/domain"
+	"sso/internal/modules/review/internal/mariadb/dbgen"
+)
+
+func campaignToDomain(r dbgen.AccessReviewCampaign) *domain.Campaign {
+	return domain.RestoreCampaign(domain.RestoreCampaignParams{
+		ID:             domain.CampaignID(r.ID),
+		TenantID:       r.TenantID,
+		Name:           r.Name,
+		AppID:          domain.AppID(r.AppID),
+		RoleID:         domain.RoleID(r.RoleID.String),
+		Status:         domain.CampaignStatus(r.Status),
+		DeadlineAction: domain.DeadlineAction(r.DeadlineAction),
+		EscalateTo:     domain.UserID(r.EscalateTo.String),
+		DueAt:          r.DueAt,
+		EscalatedAt:    r.EscalatedAt.Time,
+		CreatedBy:      domain.ActorID(r.CreatedBy),
+		Etag:           etag.Etag(r.Etag),
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+		ClosedAt:       r.ClosedAt.Time,
+	})
+}
+
+func toCreateCampaignParams(c *domain.Campaign) dbgen.CreateCampaignParams {
+	return dbgen.CreateCampaignParams{
+		ID:             c.ID().String(),
+		TenantID:       c.TenantID(),
+		Name:           c.Name,
+		AppID:          c.AppID().String(),
+		RoleID:         dbutil.StringToNullString(c.RoleID().String()),
+		Status:         uint8(c.Status()),
+		DeadlineAction: uint8(c.DeadlineAction()),
+		EscalateTo:     dbutil.StringToNullString(c.EscalateTo().String()),
+		DueAt:          c.DueAt(),
+		EscalatedAt:    dbutil.TimeToNullTime(c.EscalatedAt()),
+		CreatedBy:      c.CreatedBy().String(),
+		Etag:           c.Etag().String(),
+		CreatedAt:      c.CreatedAt(),
+		UpdatedAt:      c.UpdatedAt(),
+		ClosedAt:       dbutil.TimeToNullTime(c.ClosedAt()),
+	}
+}
+
+func toUpdateCampaignParams(c *domain.Campaign) dbgen.UpdateCampaignParams {
+	return dbgen.UpdateCampaignParams{
+		Status:      uint8(c.Status()),
+		DueAt:       c.DueAt(),
+		EscalatedAt: dbutil.TimeToNullTime(c.EscalatedAt()),
+		Etag:        c.Etag().String(),
+		UpdatedAt:   c.UpdatedAt(),
+		ClosedAt:    dbutil.TimeToNullTime(c.ClosedAt()),
+		ID:          c.ID().String(),
+	}
+}
+
+// toUpdateCampaignWithEtagParams — Etag_2 is sqlc's positional name for
+// the `etag = ?` in the WHERE clause.
+func toUpdateCampaignWithEtagParams(c *domain.Campaign, expected etag.Etag) dbgen.UpdateCampaignWithEtagParams {
+	u := toUpdateCampaignParams(c)
+	return dbgen.UpdateCampaignWithEtagParams{
+		Status:      u.Status,
+		DueAt:       u.DueAt,
+		EscalatedAt: u.EscalatedAt,
+		Etag:        u.Etag,
+		UpdatedAt:   u.UpdatedAt,
+		ClosedAt:    u.ClosedAt,
+		ID:          u.ID,
+		Etag_2:      expected.String(),
+	}
+}
+
+func itemToDomain(r dbgen.AccessReviewItem) *domain.Item {
+	return &domain.Item{
+		ID:            domain.ItemID(r.ID),
+		CampaignID:    domain.CampaignID(r.CampaignID),
+		PrincipalType: domain.PrincipalType(r.PrincipalType),
+		PrincipalID:   r.PrincipalID,
+		RoleID:        domain.RoleID(r.RoleID),
+		ReviewerID:    domain.UserID(r.ReviewerID),
+		Decision:      domain.Decision(r.Decision),
+		DecidedBy:     domain.ActorID(r.DecidedBy.String),
+		DecidedAt:     r.DecidedAt.Time,
+		Comment:       r.Comment,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+func toCreateItemParams(it *domain.Item) dbgen.CreateItemParams {
+	return dbgen.CreateItemParams{
+		ID:            it.ID.String(),
+		CampaignID:    it.CampaignID.String(),
+		PrincipalType: uint8(it.PrincipalType),
+		PrincipalID:   it.PrincipalID,
+		RoleID:        it.RoleID.String(),
+		ReviewerID:    it.ReviewerID.String(),
+		Decision:      uint8(it.Decision),
+		DecidedBy:     dbutil.StringToNullString(it.DecidedBy.String()),
+		DecidedAt:     dbutil.TimeToNullTime(it.DecidedAt),
+		Comment:       it.Comment,
+		CreatedAt:     it.CreatedAt,
+	}
+}
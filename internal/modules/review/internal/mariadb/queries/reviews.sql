@@ -0,0 +1,72 @@
+-- Access review campaigns and items. The campaign and item listings
+-- are hand-written (list.go).
+
+-- name: CreateCampaign :exec
+INSERT INTO access_review_campaigns (
+    id, tenant_id, name, app_id, role_id, status, deadline_action,
+    escalate_to, due_at, escalated_at, created_by, etag,
+    created_at, updated_at, closed_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetCampaignByID :one
+SELECT * FROM access_review_campaigns
+WHERE id = ?
+LIMIT 1;
+
+-- name: UpdateCampaign :execresult
+UPDATE access_review_campaigns
+SET status = ?, due_at = ?, escalated_at = ?, etag = ?,
+    updated_at = ?, closed_at = ?
+WHERE id = ?;
+
+-- name: UpdateCampaignWithEtag :execresult
+UPDATE access_review_campaigns
+SET status = ?, due_at = ?, escalated_at = ?, etag = ?,
+    updated_at = ?, closed_at = ?
+WHERE id = ? AND etag = ?;
+
+-- name: CountCampaignByID :one
+SELECT COUNT(*) FROM access_review_campaigns
+WHERE id = ?;
+
+-- name: ListDueCampaigns :many
+SELECT * FROM access_review_campaigns
+WHERE status = ? AND due_at <= ?
+ORDER BY due_at, id
+LIMIT ?;
+
+-- name: CreateItem :exec
+INSERT INTO access_review_items (
+    id, campaign_id, principal_type, principal_id, role_id, reviewer_id,
+    decision, decided_by, decided_at, comment, created_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetItem :one
+SELECT * FROM access_review_items
+WHERE id = ? AND campaign_id = ?
+LIMIT 1;
+
+-- name: CountItemsByDecision :many
+SELECT decision, COUNT(*) AS count FROM access_review_items
+WHERE campaign_id = ?
+GROUP BY decision;
+
+-- name: DecideItem :execresult
+UPDATE access_review_items
+SET decision = ?, decided_by = ?, decided_at = ?, comment = ?
+WHERE id = ? AND campaign_id = ? AND decision = ?;
+
+-- name: ReassignItem :execresult
+UPDATE access_review_items
+SET reviewer_id = ?
+WHERE id = ? AND campaign_id = ? AND decision = ?;
+
+-- name: ReassignPendingItems :execresult
+UPDATE access_review_items
+SET reviewer_id = sqlc.arg(reviewer_id)
+WHERE campaign_id = sqlc.arg(campaign_id) AND decision = sqlc.arg(decision)
+  AND principal_id <> sqlc.arg(reviewer_id);
+
+-- name: CountItemByID :one
+SELECT COUNT(*) FROM access_review_items
+WHERE id = ? AND campaign_id = ?;
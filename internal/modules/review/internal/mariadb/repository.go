@@ -0,0 +1,219 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/review/internal/domain"
+	"sso/internal/modules/review/internal/mariadb/dbgen"
+)
+
+type Repository struct {
+	db *sql.DB
+	q  *dbgen.Queries
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db, q: dbgen.New(db)}
+}
+
+var _ domain.Repository = (*Repository)(nil)
+
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
+// ----------------------------------------------------------------------------
+// Campaigns
+// ----------------------------------------------------------------------------
+
+func (r *Repository) Create(ctx context.Context, c *domain.Campaign, items []*domain.Item) error {
+	return dbutil.InTx(ctx, r.db, func(tx *sql.Tx) error {
+		q := r.q.WithTx(tx)
+		if err := q.CreateCampaign(ctx, toCreateCampaignParams(c)); err != nil {
+			return fmt.Errorf("review repo: create: %w", err)
+		}
+		for _, it := range items {
+			if err := q.CreateItem(ctx, toCreateItemParams(it)); err != nil {
+				return fmt.Errorf("review repo: create item: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *Repository) GetByID(ctx context.Context, id domain.CampaignID) (*domain.Campaign, error) {
+	row, err := r.queries(ctx).GetCampaignByID(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCampaignNotFound
+		}
+		return nil, fmt.Errorf("review repo: get: %w", err)
+	}
+	if !tenant.Visible(ctx, row.TenantID) {
+		return nil, domain.ErrCampaignNotFound
+	}
+	return campaignToDomain(row), nil
+}
+
+func (r *Repository) Update(ctx context.Context, c *domain.Campaign, expectedEtag etag.Etag) error {
+	q := r.queries(ctx)
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = q.UpdateCampaign(ctx, toUpdateCampaignParams(c))
+	} else {
+		res, err = q.UpdateCampaignWithEtag(ctx, toUpdateCampaignWithEtagParams(c, expectedEtag))
+	}
+	if err != nil {
+		return fmt.Errorf("review repo: update: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("review repo: rows_affected: %w", err)
+	}
+	if rows == 1 {
+		return nil
+	}
+	return dbutil.Discriminate(ctx, expectedEtag,
+		func(ctx context.Context) (int64, error) {
+			return q.CountCampaignByID(ctx, c.ID().String())
+		},
+		domain.ErrCampaignNotFound, domain.ErrEtagMismatch)
+}
+
+func (r *Repository) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.Campaign, error) {
+	rows, err := r.queries(ctx).ListDueCampaigns(ctx, dbgen.ListDueCampaignsParams{
+		Status: uint8(domain.CampaignStatusOpen),
+		DueAt:  now,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("review repo: list_due: %w", err)
+	}
+	out := make([]*domain.Campaign, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, campaignToDomain(row))
+	}
+	return out, nil
+}
+
+// ----------------------------------------------------------------------------
+// Items
+// ----------------------------------------------------------------------------
+//
+// Items are reached through their campaign, which the service has
+// already loaded under tenant.Visible; every statement keys on
+// campaign_id as well so an item id from another campaign misses.
+
+func (r *Repository) GetItem(ctx context.Context, campaignID domain.CampaignID, id domain.ItemID) (*domain.Item, error) {
+	row, err := r.queries(ctx).GetItem(ctx, dbgen.GetItemParams{ID: id.String(), CampaignID: campaignID.String()})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("review repo: get_item: %w", err)
+	}
+	return itemToDomain(row), nil
+}
+
+func (r *Repository) CountItems(ctx context.Context, campaignID domain.CampaignID) (domain.ItemCounts, error) {
+	rows, err := r.queries(ctx).CountItemsByDecision(ctx, campaignID.String())
+	if err != nil {
+		return domain.ItemCounts{}, fmt.Errorf("review repo: count_items: %w", err)
+	}
+	var out domain.ItemCounts
+	for _, row := range rows {
+		switch domain.Decision(row.Decision) {
+		case domain.DecisionPending:
+			out.Pending = int(row.Count)
+		case domain.DecisionApproved:
+			out.Approved = int(row.Count)
+		case domain.DecisionRevoked:
+			out.Revoked = int(row.Count)
+		}
+	}
+	return out, nil
+}
+
+func (r *Repository) DecideItem(ctx context.Context, it *domain.Item) error {
+	q := r.queries(ctx)
+	res, err := q.DecideItem(ctx, dbgen.DecideItemParams{
+		Decision:   uint8(it.Decision),
+		DecidedBy:  dbutil.StringToNullString(it.DecidedBy.String()),
+		DecidedAt:  dbutil.TimeToNullTime(it.DecidedAt),
+		Comment:    it.Comment,
+		ID:         it.ID.String(),
+		CampaignID: it.CampaignID.String(),
+		Decision_2: uint8(domain.DecisionPending),
+	})
+	if err != nil {
+		return fmt.Errorf("review repo: decide_item: %w", err)
+	}
+	return r.pendingResult(ctx, q, res, it.CampaignID, it.ID, "decide_item")
+}
+
+func (r *Repository) ReassignItem(ctx context.Context, campaignID domain.CampaignID, id domain.ItemID, reviewer domain.UserID) error {
+	q := r.queries(ctx)
+	res, err := q.ReassignItem(ctx, dbgen.ReassignItemParams{
+		ReviewerID: reviewer.String(),
+		ID:         id.String(),
+		CampaignID: campaignID.String(),
+		Decision:   uint8(domain.DecisionPending),
+	})
+	if err != nil {
+		return fmt.Errorf("review repo: reassign_item: %w", err)
+	}
+	return r.pendingResult(ctx, q, res, campaignID, id, "reassign_item")
+}
+
+// pendingResult reads the outcome of a write conditional on the item
+// being pending: 0 rows is a missing item or one already decided.
+// MariaDB counts changed rows, not matched ones, so the service never
+// issues a reassignment to the current reviewer.
+func (r *Repository) pendingResult(ctx context.Context, q *dbgen.Queries, res sql.Result, campaignID domain.CampaignID, id domain.ItemID, op string) error {
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("review repo: %s: rows_affected: %w", op, err)
+	}
+	if rows == 1 {
+		return nil
+	}
+	n, err := q.CountItemByID(ctx, dbgen.CountItemByIDParams{ID: id.String(), CampaignID: campaignID.String()})
+	if err != nil {
+		return fmt.Errorf("review repo: %s: count: %w", op, err)
+	}
+	if n == 0 {
+		return domain.ErrItemNotFound
+	}
+	return domain.ErrItemDecided
+}
+
+func (r *Repository) ReassignPending(ctx context.Context, campaignID domain.CampaignID, reviewer domain.UserID) (int64, error) {
+	res, err := r.queries(ctx).ReassignPendingItems(ctx, dbgen.ReassignPendingItemsParams{
+		ReviewerID: reviewer.String(),
+		CampaignID: campaignID.String(),
+		Decision:   uint8(domain.DecisionPending),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("review repo: reassign_pending: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("review repo: reassign_pending: rows_affected: %w", err)
+	}
+	return n, nil
+}
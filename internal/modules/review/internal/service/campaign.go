@@ -0,0 +1,464 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/cursor"
+	"sso/internal/kernel/tenant"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/access"
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/review/internal/domain"
+	"sso/internal/modules/role"
+)
+
+// ----------------------------------------------------------------------------
+// CreateCampaign
+// ----------------------------------------------------------------------------
+
+const (
+	// maxReviewers bounds ReviewerIDs.
+	maxReviewers = 50
+	// maxCampaignItems bounds one campaign's snapshot; a larger app is
+	// reviewed role by role.
+	maxCampaignItems = 10000
+	// snapshotPageSize is the page size the snapshot reads roles and
+	// role members with.
+	snapshotPageSize = auditx.MaxListPageSize
+)
+
+type CreateCampaignInput struct {
+	Name string
+	// AppID and RoleID scope the campaign: every role of the app, or
+	// the one role. With RoleID set AppID may be empty; if given it must
+	// be the role's app.
+	AppID          string
+	RoleID         string
+	ReviewerIDs    []string
+	DeadlineAction domain.DeadlineAction
+	EscalateTo     string // required with DeadlineEscalate
+	DueAt          time.Time
+}
+
+// CreateCampaign opens a campaign and snapshots its items: one per
+// direct assignment of a role in scope, to a user or a service account,
+// in force now. Holders through a group are left out — revoking a
+// direct assignment would not take their access, which is reviewed on
+// the group instead. Reviewers are dealt the items round-robin; nobody
+// is handed their own access.
+//
+// The snapshot is read before the campaign is written, so an assignment
+// made meanwhile may be missed; it is in the next campaign.
+func (s *Service) CreateCampaign(ctx context.Context, in CreateCampaignInput) (*domain.Campaign, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.NewCampaignID()
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessReviewCreate)
+	aud.SubjectType = audit.SubjectTypeAccessReview
+	aud.SubjectID = id.String()
+
+	c, items, err := s.openCampaign(ctx, a, id, in)
+	if c != nil {
+		aud.AppID = c.AppID().String()
+	}
+	if err == nil {
+		err = s.repo.Create(ctx, c, items)
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("create access review: %w", err)
+	}
+
+	aud.Metadata = map[string]string{
+		"name":            c.Name,
+		"items":           strconv.Itoa(len(items)),
+		"deadline_action": c.DeadlineAction().String(),
+		"due_at":          c.DueAt().Format(time.RFC3339),
+	}
+	if c.RoleID() != "" {
+		aud.Metadata["role_id"] = c.RoleID().String()
+	}
+	s.auditor.Success(ctx, aud)
+	return c, nil
+}
+
+// openCampaign validates the input and builds the campaign and its
+// items. The campaign is returned as soon as it is known, so a failed
+// snapshot is still audited against its app.
+func (s *Service) openCampaign(ctx context.Context, a actor.Actor, id domain.CampaignID, in CreateCampaignInput) (*domain.Campaign, []*domain.Item, error) {
+	ap, roleIDs, err := s.resolveScope(ctx, in.AppID, in.RoleID)
+	if err != nil {
+		return nil, nil, err
+	}
+	reviewers, err := s.resolveReviewers(ctx, in.ReviewerIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	var escalateTo domain.UserID
+	if in.EscalateTo != "" {
+		if escalateTo, err = parseUserID(in.EscalateTo, "escalate_to"); err != nil {
+			return nil, nil, err
+		}
+		if err := s.requireReviewer(ctx, escalateTo, "escalate_to"); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	now := s.now().UTC()
+	c, err := domain.NewCampaign(domain.NewCampaignParams{
+		ID:             id,
+		TenantID:       ap.TenantID(),
+		Name:           in.Name,
+		AppID:          domain.AppID(ap.ID().String()),
+		RoleID:         domain.RoleID(in.RoleID),
+		DeadlineAction: in.DeadlineAction,
+		EscalateTo:     escalateTo,
+		DueAt:          in.DueAt.UTC(),
+		CreatedBy:      domain.ActorID(a.ID),
+		Now:            now,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Fallback reviewers for an item whose principal is the only
+	// reviewer named: the escalation reviewer, then the creator.
+	fallbacks := []domain.UserID{escalateTo}
+	if a.IsUser() {
+		fallbacks = append(fallbacks, domain.UserID(a.ID))
+	}
+
+	var items []*domain.Item
+	for _, rid := range roleIDs {
+		members, err := s.directMembers(ctx, rid)
+		if err != nil {
+			return c, nil, err
+		}
+		for _, m := range members {
+			if len(items) == maxCampaignItems {
+				return c, nil, domain.ErrCampaignTooLarge
+			}
+			reviewer, ok := pickReviewer(reviewers, len(items), fallbacks, m.ID)
+			if !ok {
+				return c, nil, domain.ErrSelfReview
+			}
+			itemID, err := domain.NewItemID()
+			if err != nil {
+				return c, nil, err
+			}
+			items = append(items, &domain.Item{
+				ID:            itemID,
+				CampaignID:    id,
+				PrincipalType: principalType(m),
+				PrincipalID:   m.ID,
+				RoleID:        domain.RoleID(rid.String()),
+				ReviewerID:    reviewer,
+				Decision:      domain.DecisionPending,
+				CreatedAt:     now,
+			})
+		}
+	}
+	if len(items) == 0 {
+		return c, nil, domain.ErrNothingToReview
+	}
+	return c, items, nil
+}
+
+// resolveScope loads the campaign's app and lists the roles under
+// review: the one named, or every role of the app whatever its status —
+// the holders of a disabled role still hold it.
+func (s *Service) resolveScope(ctx context.Context, rawAppID, rawRoleID string) (*app.App, []role.RoleID, error) {
+	if rawRoleID != "" {
+		rid, err := role.ParseRoleID(rawRoleID)
+		if err != nil {
+			return nil, nil, err
+		}
+		r, err := s.roles.GetByID(ctx, rid)
+		if err != nil {
+			return nil, nil, err
+		}
+		if rawAppID != "" && rawAppID != r.AppID().String() {
+			return nil, nil, access.ErrRoleNotInApp
+		}
+		ap, err := s.apps.GetByID(ctx, r.AppID())
+		if err != nil {
+			return nil, nil, err
+		}
+		return ap, []role.RoleID{rid}, nil
+	}
+
+	if rawAppID == "" {
+		return nil, nil, &validation.Error{Field: "app_id", Reason: "app_id or role_id is required"}
+	}
+	aid, err := app.ParseAppID(rawAppID)
+	if err != nil {
+		return nil, nil, err
+	}
+	ap, err := s.apps.GetByID(ctx, aid)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		ids   []role.RoleID
+		after *role.PageCursor
+	)
+	for {
+		res, err := s.roles.List(ctx, role.ListQuery{AppID: aid, PageSize: snapshotPageSize, After: after})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, r := range res.Roles {
+			ids = append(ids, r.ID())
+		}
+		if res.NextCursor == nil {
+			return ap, ids, nil
+		}
+		after = res.NextCursor
+	}
+}
+
+// resolveReviewers parses, de-duplicates and checks ReviewerIDs,
+// keeping their order.
+func (s *Service) resolveReviewers(ctx context.Context, raw []string) ([]domain.UserID, error) {
+	if len(raw) == 0 || len(raw) > maxReviewers {
+		return nil, &validation.Error{Field: "reviewer_ids", Reason: fmt.Sprintf("must name between 1 and %d reviewers", maxReviewers)}
+	}
+	seen := make(map[domain.UserID]bool, len(raw))
+	out := make([]domain.UserID, 0, len(raw))
+	for _, r := range raw {
+		id, err := parseUserID(r, "reviewer_ids")
+		if err != nil {
+			return nil, err
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if err := s.requireReviewer(ctx, id, "reviewer_ids"); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+// directMembers pages the role's holders and keeps the direct ones.
+func (s *Service) directMembers(ctx context.Context, rid role.RoleID) ([]access.Principal, error) {
+	var (
+		out   []access.Principal
+		token string
+	)
+	for {
+		res, err := s.access.ListRoleMembers(ctx, access.ListRoleMembersInput{
+			RoleID:    rid.String(),
+			PageSize:  snapshotPageSize,
+			PageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range res.Members {
+			if m.Direct {
+				out = append(out, m.Principal)
+			}
+		}
+		if res.NextPageToken == "" {
+			return out, nil
+		}
+		token = res.NextPageToken
+	}
+}
+
+// pickReviewer deals item n to reviewers round-robin, skipping the
+// principal's own id; when the principal is the only reviewer it falls
+// back to the first of fallbacks that is someone else.
+func pickReviewer(reviewers []domain.UserID, n int, fallbacks []domain.UserID, principalID string) (domain.UserID, bool) {
+	for i := range reviewers {
+		r := reviewers[(n+i)%len(reviewers)]
+		if r.String() != principalID {
+			return r, true
+		}
+	}
+	for _, r := range fallbacks {
+		if r != "" && r.String() != principalID {
+			return r, true
+		}
+	}
+	return "", false
+}
+
+func principalType(p access.Principal) domain.PrincipalType {
+	if p.Kind == access.PrincipalKindServiceAccount {
+		return domain.PrincipalServiceAccount
+	}
+	return domain.PrincipalUser
+}
+
+func parseUserID(raw, field string) (domain.UserID, error) {
+	id, err := domain.ParseUserID(raw)
+	if err != nil {
+		return "", &validation.Error{Field: field, Reason: "must be a valid UUID"}
+	}
+	return id, nil
+}
+
+// ----------------------------------------------------------------------------
+// GetCampaign / ListCampaigns
+// ----------------------------------------------------------------------------
+
+type GetCampaignOutput struct {
+	Campaign *domain.Campaign
+	Counts   domain.ItemCounts
+}
+
+// GetCampaign returns the campaign with its progress.
+func (s *Service) GetCampaign(ctx context.Context, rawID string) (GetCampaignOutput, error) {
+	id, err := domain.ParseCampaignID(rawID)
+	if err != nil {
+		return GetCampaignOutput{}, err
+	}
+	c, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return GetCampaignOutput{}, err
+	}
+	counts, err := s.repo.CountItems(ctx, id)
+	if err != nil {
+		return GetCampaignOutput{}, err
+	}
+	return GetCampaignOutput{Campaign: c, Counts: counts}, nil
+}
+
+type ListCampaignsInput struct {
+	PageSize  int32
+	PageToken string
+	Statuses  []domain.CampaignStatus
+}
+
+type ListCampaignsOutput struct {
+	Campaigns     []*domain.Campaign
+	NextPageToken string
+}
+
+// ListCampaigns pages campaigns newest first.
+func (s *Service) ListCampaigns(ctx context.Context, in ListCampaignsInput) (ListCampaignsOutput, error) {
+	after, err := decodeCursor(in.PageToken)
+	if err != nil {
+		return ListCampaignsOutput{}, err
+	}
+	pageSize, err := auditx.ClampPageSize(in.PageSize)
+	if err != nil {
+		return ListCampaignsOutput{}, err
+	}
+	res, err := s.repo.List(ctx, domain.ListQuery{
+		PageSize: pageSize,
+		After:    after,
+		Statuses: in.Statuses,
+	})
+	if err != nil {
+		return ListCampaignsOutput{}, err
+	}
+	next, err := encodeCursor(res.NextCursor)
+	if err != nil {
+		return ListCampaignsOutput{}, err
+	}
+	return ListCampaignsOutput{Campaigns: res.Campaigns, NextPageToken: next}, nil
+}
+
+type pageToken struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+func encodeCursor(c *domain.PageCursor) (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	return cursor.Encode(&pageToken{CreatedAt: c.CreatedAt, ID: c.ID.String()})
+}
+
+func decodeCursor(s string) (*domain.PageCursor, error) {
+	t, err := cursor.Decode[pageToken](s)
+	if err != nil {
+		return nil, &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	if t == nil {
+		return nil, nil
+	}
+	id, err := domain.ParseCampaignID(t.ID)
+	if err != nil {
+		return nil, &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	return &domain.PageCursor{CreatedAt: t.CreatedAt, ID: id}, nil
+}
+
+// ----------------------------------------------------------------------------
+// CancelCampaign
+// ----------------------------------------------------------------------------
+
+type CancelCampaignInput struct {
+	CampaignID   string
+	ExpectedEtag string
+}
+
+// CancelCampaign closes an open campaign early; the decisions made so
+// far stand and nothing more is revoked. Only its creator or a
+// super-admin may cancel it.
+func (s *Service) CancelCampaign(ctx context.Context, in CancelCampaignInput) (*domain.Campaign, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseCampaignID(in.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, false)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessReviewCancel)
+	aud.SubjectType = audit.SubjectTypeAccessReview
+	aud.SubjectID = id.String()
+
+	c, err := s.repo.GetByID(ctx, id)
+	if err == nil {
+		aud.AppID = c.AppID().String()
+		err = requireOwner(ctx, a, c)
+	}
+	if err == nil {
+		err = c.Cancel(s.now().UTC())
+	}
+	if err == nil {
+		err = s.repo.Update(ctx, c, expectedEtag)
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("cancel access review: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return c, nil
+}
+
+// requireOwner admits the campaign's creator and super-admins.
+func requireOwner(ctx context.Context, a actor.Actor, c *domain.Campaign) error {
+	if a.ID == c.CreatedBy().String() || tenant.IsSuperAdmin(ctx, a) {
+		return nil
+	}
+	return domain.ErrNotCampaignOwner
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"sso/internal/modules/access"
+	"sso/internal/modules/review/internal/domain"
+)
+
+// TestCreateCampaignNoSelfReview checks that no reviewer is dealt an
+// item for their own access, whoever is named.
+func TestCreateCampaignNoSelfReview(t *testing.T) {
+	cases := []struct {
+		name       string
+		creator    string
+		reviewers  []string
+		escalateTo string
+		want       error
+		// wantReviewer is who reviews ada's item; "" when any other
+		// reviewer will do.
+		wantReviewer string
+	}{
+		{name: "reviewers among the members", creator: adminID, reviewers: []string{userAda, userBob}},
+		{
+			name: "only reviewer is the member, creator falls back", creator: adminID,
+			reviewers: []string{userAda}, wantReviewer: adminID,
+		},
+		{
+			name: "escalation reviewer before the creator", creator: adminID,
+			reviewers: []string{userAda}, escalateTo: userCyd, wantReviewer: userCyd,
+		},
+		{
+			name: "nobody else to review", creator: userAda,
+			reviewers: []string{userAda}, want: domain.ErrSelfReview,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := newWorld()
+			w.grant(roleOps, user(userAda))
+			w.grant(roleOps, user(userBob))
+			w.grant(roleOps, access.Principal{Kind: access.PrincipalKindServiceAccount, ID: botID})
+			w.members[roleOps] = append(w.members[roleOps], access.RoleMember{
+				Principal: user(userCyd), ViaGroups: []access.GroupID{"0190b6f2-8a43-7c1e-9d2a-00000000e001"},
+			})
+			s, _ := w.newService(t0)
+
+			in := CreateCampaignInput{
+				Name:           "Q2 ops",
+				RoleID:         roleOps,
+				ReviewerIDs:    tc.reviewers,
+				DeadlineAction: domain.DeadlineRevoke,
+				DueAt:          t0.Add(14 * 24 * time.Hour),
+			}
+			if tc.escalateTo != "" {
+				in.DeadlineAction, in.EscalateTo = domain.DeadlineEscalate, tc.escalateTo
+			}
+			c, err := s.CreateCampaign(as(tc.creator), in)
+			if tc.want != nil {
+				if !errors.Is(err, tc.want) {
+					t.Fatalf("err = %v, want %v", err, tc.want)
+				}
+				if len(w.campaigns) != 0 || len(w.items) != 0 {
+					t.Fatalf("stored %d campaigns, %d items", len(w.campaigns), len(w.items))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("err = %v", err)
+			}
+
+			// One item per direct holder; cyd holds the role through a
+			// group only.
+			if len(w.items) != 3 {
+				t.Fatalf("items = %d, want 3", len(w.items))
+			}
+			for _, it := range w.items {
+				if it.ReviewerID.String() == it.PrincipalID {
+					t.Fatalf("%s reviews their own access", it.PrincipalID)
+				}
+			}
+			if got := w.item(t, c, userAda).ReviewerID.String(); tc.wantReviewer != "" && got != tc.wantReviewer {
+				t.Fatalf("ada's item reviewed by %s, want %s", got, tc.wantReviewer)
+			}
+			if got := w.item(t, c, botID).PrincipalType; got != domain.PrincipalServiceAccount {
+				t.Fatalf("bot's item principal type = %v", got)
+			}
+		})
+	}
+}
+
+func user(id string) access.Principal {
+	return access.Principal{Kind: access.PrincipalKindUser, ID: id}
+}
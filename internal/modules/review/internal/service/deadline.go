@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/review/internal/domain"
+)
+
+// ----------------------------------------------------------------------------
+// Deadline sweep
+// ----------------------------------------------------------------------------
+
+const (
+	// deadlineSweepBatch bounds the campaigns one sweep handles; the
+	// rest are due on the next.
+	deadlineSweepBatch = 100
+	// deadlineItemBatch bounds one ListItems round trip.
+	deadlineItemBatch = 100
+)
+
+// deadlineReason is the metadata "reason" on the access_review.decide
+// events the sweep emits.
+const deadlineReason = "deadline"
+
+// SweepDeadlines applies the deadline of every open campaign past its
+// due_at, as the system actor. A campaign that escalates hands its
+// pending items to the escalation reviewer and moves due_at by
+// Config.EscalationGrace; any other revokes its pending items and
+// completes. A revocation that fails leaves its item pending and the
+// campaign open, to be retried on the next sweep. Returns how many
+// campaigns were escalated or completed.
+func (s *Service) SweepDeadlines(ctx context.Context) (int, error) {
+	ctx = actor.Inject(ctx, actor.System())
+	now := s.now().UTC()
+	due, err := s.repo.ListDue(ctx, now, deadlineSweepBatch)
+	if err != nil {
+		return 0, err
+	}
+	done := 0
+	var errs []error
+	for _, c := range due {
+		cctx := tenant.With(ctx, c.TenantID())
+		if c.EscalatesAtDeadline() {
+			err = s.escalate(cctx, c, now)
+		} else {
+			err = s.expire(cctx, c, now)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("campaign %s: %w", c.ID(), err))
+			continue
+		}
+		done++
+	}
+	return done, errors.Join(errs...)
+}
+
+// escalate hands c's pending items to its escalation reviewer — all but
+// the reviewer's own, which stay where they are — and moves the
+// deadline. The reassignment is idempotent, so a failed update is
+// simply redone by the next sweep.
+func (s *Service) escalate(ctx context.Context, c *domain.Campaign, now time.Time) error {
+	n, err := s.repo.ReassignPending(ctx, c.ID(), c.EscalateTo())
+	if err != nil {
+		return err
+	}
+	expected := c.Etag()
+	if err := c.Escalate(now.Add(s.cfg.EscalationGrace), now); err != nil {
+		return err
+	}
+	if err := s.repo.Update(ctx, c, expected); err != nil {
+		return err
+	}
+	s.auditor.Success(ctx, audit.NewAuditParams{
+		EventType:   audit.EventTypeAccessReviewEscalate,
+		ActorType:   audit.ActorTypeSystem,
+		SubjectType: audit.SubjectTypeAccessReview,
+		SubjectID:   c.ID().String(),
+		AppID:       c.AppID().String(),
+		Metadata: map[string]string{
+			"reviewer_id": c.EscalateTo().String(),
+			"reassigned":  strconv.FormatInt(n, 10),
+			"due_at":      c.DueAt().Format(time.RFC3339),
+		},
+	})
+	return nil
+}
+
+// expire revokes c's pending items and completes it once none is left.
+func (s *Service) expire(ctx context.Context, c *domain.Campaign, now time.Time) error {
+	var (
+		after  domain.ItemID
+		failed int
+	)
+	for {
+		batch, err := s.repo.ListItems(ctx, domain.ItemsQuery{
+			CampaignID: c.ID(),
+			Decision:   domain.DecisionPending,
+			After:      after,
+			PageSize:   deadlineItemBatch,
+		})
+		if err != nil {
+			return err
+		}
+		for _, it := range batch {
+			after = it.ID
+			if err := s.expireItem(ctx, c, it, now); err != nil {
+				s.log.ErrorContext(ctx, "review: deadline revocation",
+					"campaign_id", c.ID().String(), "item_id", it.ID.String(), "err", err)
+				failed++
+			}
+		}
+		// ListItems returns up to PageSize+1 rows.
+		if len(batch) <= deadlineItemBatch {
+			break
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d revocations failed", failed)
+	}
+
+	counts, err := s.repo.CountItems(ctx, c.ID())
+	if err != nil {
+		return err
+	}
+	expected := c.Etag()
+	if err := c.Complete(now); err != nil {
+		return err
+	}
+	if err := s.repo.Update(ctx, c, expected); err != nil {
+		return err
+	}
+	s.auditor.Success(ctx, audit.NewAuditParams{
+		EventType:   audit.EventTypeAccessReviewComplete,
+		ActorType:   audit.ActorTypeSystem,
+		SubjectType: audit.SubjectTypeAccessReview,
+		SubjectID:   c.ID().String(),
+		AppID:       c.AppID().String(),
+		Metadata: map[string]string{
+			"approved": strconv.Itoa(counts.Approved),
+			"revoked":  strconv.Itoa(counts.Revoked),
+			"reason":   deadlineReason,
+		},
+	})
+	return nil
+}
+
+// expireItem revokes one pending item for the deadline. An item its
+// reviewer decided meanwhile is left as decided.
+func (s *Service) expireItem(ctx context.Context, c *domain.Campaign, it *domain.Item, now time.Time) error {
+	if err := it.Decide(domain.DecisionRevoked, "", "", now); err != nil {
+		return err
+	}
+	if err := s.storeDecision(ctx, it); err != nil {
+		if errors.Is(err, domain.ErrItemDecided) {
+			return nil
+		}
+		return err
+	}
+	s.auditor.Success(ctx, audit.NewAuditParams{
+		EventType:   audit.EventTypeAccessReviewDecide,
+		ActorType:   audit.ActorTypeSystem,
+		SubjectType: audit.SubjectTypeAccessReview,
+		SubjectID:   c.ID().String(),
+		AppID:       c.AppID().String(),
+		Metadata: map[string]string{
+			"item_id":      it.ID.String(),
+			"principal_id": it.PrincipalID,
+			"role_id":      it.RoleID.String(),
+			"decision":     it.Decision.String(),
+			"reason":       deadlineReason,
+		},
+	})
+	return nil
+}
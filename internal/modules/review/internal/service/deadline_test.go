@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"sso/internal/modules/audit"
+	"sso/internal/modules/review/internal/domain"
+)
+
+// TestSweepDeadlinesRevokes checks that a campaign closing on its
+// deadline takes the access nobody vouched for, and only that.
+func TestSweepDeadlinesRevokes(t *testing.T) {
+	w := newWorld()
+	c := w.openCampaign(t, domain.DeadlineRevoke, "", userBob, userAda, userCyd, adminID)
+	if err := w.item(t, c, adminID).Decide(domain.DecisionApproved, userBob, "", t0); err != nil {
+		t.Fatal(err)
+	}
+	s, em := w.newService(c.DueAt())
+
+	n, err := s.SweepDeadlines(context.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("SweepDeadlines = %d, %v", n, err)
+	}
+	for _, p := range []string{userAda, userCyd} {
+		if it := w.item(t, c, p); it.Decision != domain.DecisionRevoked || it.DecidedBy != "" {
+			t.Fatalf("%s's item = %v by %q, want revoked by the deadline", p, it.Decision, it.DecidedBy)
+		}
+		if !w.revoked(p) {
+			t.Fatalf("%s's assignment kept", p)
+		}
+	}
+	if w.revoked(adminID) {
+		t.Fatal("approved assignment revoked")
+	}
+	if c.Status() != domain.CampaignStatusCompleted {
+		t.Fatalf("campaign %v, want completed", c.Status())
+	}
+	if evs := em.ofType(audit.EventTypeAccessReviewDecide); len(evs) != 2 {
+		t.Fatalf("audited %d revocations, want 2", len(evs))
+	}
+	evs := em.ofType(audit.EventTypeAccessReviewComplete)
+	if len(evs) != 1 || evs[0].Metadata()["reason"] != deadlineReason ||
+		evs[0].Metadata()["revoked"] != "2" || evs[0].Metadata()["approved"] != "1" {
+		t.Fatalf("completion audit = %v", evs)
+	}
+}
+
+// TestSweepDeadlinesRetriesFailedRevocation checks that a campaign does
+// not complete while an assignment it should revoke is still held.
+func TestSweepDeadlinesRetriesFailedRevocation(t *testing.T) {
+	w := newWorld()
+	c := w.openCampaign(t, domain.DeadlineRevoke, "", userBob, userAda, userCyd)
+	w.removeErr[userAda] = errors.New("boom")
+	s, _ := w.newService(c.DueAt().Add(time.Minute))
+
+	if n, err := s.SweepDeadlines(context.Background()); err == nil || n != 0 {
+		t.Fatalf("SweepDeadlines = %d, %v, want the failure reported", n, err)
+	}
+	if it := w.item(t, c, userAda); it.Decision != domain.DecisionPending {
+		t.Fatalf("ada's item = %v, want pending for the retry", it.Decision)
+	}
+	if !w.revoked(userCyd) {
+		t.Fatal("cyd's assignment kept")
+	}
+	if c.Status() != domain.CampaignStatusOpen {
+		t.Fatalf("campaign %v, want open", c.Status())
+	}
+
+	delete(w.removeErr, userAda)
+	if n, err := s.SweepDeadlines(context.Background()); err != nil || n != 1 {
+		t.Fatalf("retry = %d, %v", n, err)
+	}
+	if !w.revoked(userAda) || c.Status() != domain.CampaignStatusCompleted {
+		t.Fatalf("after retry: revoked %v, campaign %v", w.revoked(userAda), c.Status())
+	}
+}
+
+// TestSweepDeadlinesEscalates checks that an escalating deadline hands
+// the pending items to the escalation reviewer — never their own — and
+// that the moved deadline then revokes.
+func TestSweepDeadlinesEscalates(t *testing.T) {
+	w := newWorld()
+	c := w.openCampaign(t, domain.DeadlineEscalate, userCyd, userBob, userAda, userCyd)
+	due := c.DueAt()
+	s, em := w.newService(due)
+
+	if n, err := s.SweepDeadlines(context.Background()); err != nil || n != 1 {
+		t.Fatalf("SweepDeadlines = %d, %v", n, err)
+	}
+	if got := w.item(t, c, userAda).ReviewerID; got != userCyd {
+		t.Fatalf("ada's item reviewer = %s, want cyd", got)
+	}
+	if got := w.item(t, c, userCyd).ReviewerID; got != userBob {
+		t.Fatalf("cyd's item reviewer = %s, want bob still", got)
+	}
+	if len(w.removed) != 0 || c.Status() != domain.CampaignStatusOpen {
+		t.Fatalf("escalation revoked %v, campaign %v", w.removed, c.Status())
+	}
+	if !c.DueAt().Equal(due.Add(3*24*time.Hour)) || !c.IsEscalated() {
+		t.Fatalf("due_at = %v, escalated %v", c.DueAt(), c.IsEscalated())
+	}
+	if evs := em.ofType(audit.EventTypeAccessReviewEscalate); len(evs) != 1 || evs[0].Metadata()["reassigned"] != "1" {
+		t.Fatalf("escalation audit = %v", evs)
+	}
+
+	s, _ = w.newService(c.DueAt())
+	if n, err := s.SweepDeadlines(context.Background()); err != nil || n != 1 {
+		t.Fatalf("second SweepDeadlines = %d, %v", n, err)
+	}
+	if !w.revoked(userAda) || !w.revoked(userCyd) || c.Status() != domain.CampaignStatusCompleted {
+		t.Fatalf("after the moved deadline: removed %v, campaign %v", w.removed, c.Status())
+	}
+}
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/cursor"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/access"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/review/internal/domain"
+)
+
+// ----------------------------------------------------------------------------
+// ListItems
+// ----------------------------------------------------------------------------
+
+type ListItemsInput struct {
+	CampaignID string
+	ReviewerID string          // "" = every reviewer
+	Decision   domain.Decision // 0 = every decision
+	PageSize   int32
+	PageToken  string
+}
+
+type ListItemsOutput struct {
+	Campaign      *domain.Campaign
+	Items         []*domain.Item
+	NextPageToken string
+}
+
+// ListItems pages a campaign's items in creation order. A reviewer's
+// work queue is ReviewerID = self, Decision = pending.
+func (s *Service) ListItems(ctx context.Context, in ListItemsInput) (ListItemsOutput, error) {
+	id, err := domain.ParseCampaignID(in.CampaignID)
+	if err != nil {
+		return ListItemsOutput{}, err
+	}
+	var reviewer domain.UserID
+	if in.ReviewerID != "" {
+		if reviewer, err = domain.ParseUserID(in.ReviewerID); err != nil {
+			return ListItemsOutput{}, err
+		}
+	}
+	after, err := decodeItemCursor(in.PageToken)
+	if err != nil {
+		return ListItemsOutput{}, err
+	}
+	pageSize, err := auditx.ClampPageSize(in.PageSize)
+	if err != nil {
+		return ListItemsOutput{}, err
+	}
+	c, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return ListItemsOutput{}, err
+	}
+
+	items, err := s.repo.ListItems(ctx, domain.ItemsQuery{
+		CampaignID: id,
+		ReviewerID: reviewer,
+		Decision:   in.Decision,
+		After:      after,
+		PageSize:   pageSize,
+	})
+	if err != nil {
+		return ListItemsOutput{}, err
+	}
+
+	var next string
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		if next, err = cursor.Encode(&itemToken{ID: items[pageSize-1].ID.String()}); err != nil {
+			return ListItemsOutput{}, err
+		}
+	}
+	return ListItemsOutput{Campaign: c, Items: items, NextPageToken: next}, nil
+}
+
+type itemToken struct {
+	ID string `json:"i"`
+}
+
+func decodeItemCursor(s string) (domain.ItemID, error) {
+	t, err := cursor.Decode[itemToken](s)
+	if err != nil {
+		return "", &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	if t == nil {
+		return "", nil
+	}
+	id, err := domain.ParseItemID(t.ID)
+	if err != nil {
+		return "", &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	return id, nil
+}
+
+// ----------------------------------------------------------------------------
+// DecideItem
+// ----------------------------------------------------------------------------
+
+type DecideItemInput struct {
+	CampaignID string
+	ItemID     string
+	Decision   domain.Decision // approved or revoked
+	Comment    string
+}
+
+// DecideItem records the reviewer's decision on a pending item of an
+// open campaign. Only the item's reviewer may decide it. A revocation
+// removes the assignment through access.RemoveRoleFromUser, as the
+// reviewer, in the transaction that stores the decision: a stored
+// "revoked" always means the access is gone. A principal or role
+// deleted since the snapshot has nothing left to revoke and is recorded
+// as revoked.
+//
+// Deciding the last pending item completes the campaign.
+func (s *Service) DecideItem(ctx context.Context, in DecideItemInput) (*domain.Item, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cid, err := domain.ParseCampaignID(in.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	iid, err := domain.ParseItemID(in.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessReviewDecide)
+	aud.SubjectType = audit.SubjectTypeAccessReview
+	aud.SubjectID = cid.String()
+	aud.Metadata = map[string]string{"item_id": iid.String(), "decision": in.Decision.String()}
+
+	c, it, err := s.loadPendingItem(ctx, cid, iid)
+	if c != nil {
+		aud.AppID = c.AppID().String()
+	}
+	if it != nil {
+		aud.Metadata["principal_id"] = it.PrincipalID
+		aud.Metadata["role_id"] = it.RoleID.String()
+	}
+	if err == nil && it.ReviewerID.String() != a.ID {
+		err = domain.ErrNotReviewer
+	}
+	if err == nil {
+		err = it.Decide(in.Decision, domain.ActorID(a.ID), in.Comment, s.now().UTC())
+	}
+	if err == nil {
+		err = s.storeDecision(ctx, it)
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("decide access review item: %w", err)
+	}
+	s.auditor.Success(ctx, aud)
+
+	s.completeIfDone(ctx, a, c)
+	return it, nil
+}
+
+// loadPendingItem loads an item of an open campaign, still pending.
+// The campaign is returned whenever it was found, for the audit record.
+func (s *Service) loadPendingItem(ctx context.Context, cid domain.CampaignID, iid domain.ItemID) (*domain.Campaign, *domain.Item, error) {
+	c, err := s.repo.GetByID(ctx, cid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.Status() != domain.CampaignStatusOpen {
+		return c, nil, domain.ErrCampaignClosed
+	}
+	it, err := s.repo.GetItem(ctx, cid, iid)
+	if err != nil {
+		return c, nil, err
+	}
+	if !it.IsPending() {
+		return c, it, domain.ErrItemDecided
+	}
+	return c, it, nil
+}
+
+// storeDecision stores it's decision and, for a revocation, removes the
+// assignment under ctx's actor, as one transaction. The conditional
+// write comes first, so of two concurrent decisions only the winner
+// revokes.
+func (s *Service) storeDecision(ctx context.Context, it *domain.Item) error {
+	return s.tx(ctx, func(ctx context.Context) error {
+		if err := s.repo.DecideItem(ctx, it); err != nil {
+			return err
+		}
+		if it.Decision != domain.DecisionRevoked {
+			return nil
+		}
+		err := s.access.RemoveRoleFromUser(ctx, access.RemoveRoleFromUserInput{
+			UserID: it.PrincipalID,
+			RoleID: it.RoleID.String(),
+		})
+		if err != nil && !isGone(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+// completeIfDone completes c once no item is pending. It runs after
+// the decision is stored and audited; a failure here leaves the
+// campaign open with nothing pending, which the deadline closes.
+func (s *Service) completeIfDone(ctx context.Context, a actor.Actor, c *domain.Campaign) {
+	counts, err := s.repo.CountItems(ctx, c.ID())
+	if err != nil || counts.Pending > 0 {
+		if err != nil {
+			s.log.ErrorContext(ctx, "review: count items", "campaign_id", c.ID().String(), "err", err)
+		}
+		return
+	}
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessReviewComplete)
+	aud.SubjectType = audit.SubjectTypeAccessReview
+	aud.SubjectID = c.ID().String()
+	aud.AppID = c.AppID().String()
+	aud.Metadata = map[string]string{
+		"approved": strconv.Itoa(counts.Approved),
+		"revoked":  strconv.Itoa(counts.Revoked),
+	}
+
+	expected := c.Etag()
+	if err := c.Complete(s.now().UTC()); err != nil {
+		return
+	}
+	if err := s.repo.Update(ctx, c, expected); err != nil {
+		// A concurrent last decision completed it first.
+		if !errors.Is(err, domain.ErrEtagMismatch) {
+			s.log.ErrorContext(ctx, "review: complete campaign", "campaign_id", c.ID().String(), "err", err)
+		}
+		return
+	}
+	s.auditor.Success(ctx, aud)
+}
+
+// ----------------------------------------------------------------------------
+// ReassignItem
+// ----------------------------------------------------------------------------
+
+type ReassignItemInput struct {
+	CampaignID string
+	ItemID     string
+	ReviewerID string
+}
+
+// ReassignItem hands a pending item to another reviewer, who must be an
+// active user other than the item's principal. Only the campaign's
+// creator or a super-admin may reassign.
+func (s *Service) ReassignItem(ctx context.Context, in ReassignItemInput) (*domain.Item, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cid, err := domain.ParseCampaignID(in.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	iid, err := domain.ParseItemID(in.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	reviewer, err := domain.ParseUserID(in.ReviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeAccessReviewReassign)
+	aud.SubjectType = audit.SubjectTypeAccessReview
+	aud.SubjectID = cid.String()
+	aud.Metadata = map[string]string{"item_id": iid.String(), "reviewer_id": reviewer.String()}
+
+	c, it, err := s.loadPendingItem(ctx, cid, iid)
+	if c != nil {
+		aud.AppID = c.AppID().String()
+		if err == nil {
+			err = requireOwner(ctx, a, c)
+		}
+	}
+	if err == nil && it.PrincipalID == reviewer.String() {
+		err = domain.ErrSelfReview
+	}
+	if err == nil {
+		err = s.requireReviewer(ctx, reviewer, "reviewer_id")
+	}
+	if err == nil && it.ReviewerID != reviewer {
+		aud.Metadata["previous_reviewer_id"] = it.ReviewerID.String()
+		err = s.repo.ReassignItem(ctx, cid, iid, reviewer)
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("reassign access review item: %w", err)
+	}
+
+	it.ReviewerID = reviewer
+	s.auditor.Success(ctx, aud)
+	return it, nil
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"sso/internal/modules/access"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/review/internal/domain"
+)
+
+func TestDecideItem(t *testing.T) {
+	boom := errors.New("boom")
+
+	cases := []struct {
+		name      string
+		caller    string
+		decision  domain.Decision
+		removeErr error
+		want      error
+		// wantStored is the decision stored for ada's item afterwards.
+		wantStored  domain.Decision
+		wantRevoked bool
+	}{
+		{
+			name: "reviewer revokes", caller: userBob, decision: domain.DecisionRevoked,
+			wantStored: domain.DecisionRevoked, wantRevoked: true,
+		},
+		{
+			name: "reviewer approves", caller: userBob, decision: domain.DecisionApproved,
+			wantStored: domain.DecisionApproved,
+		},
+		{
+			// Ada may not certify her own access, even though the item
+			// is about her.
+			name: "principal approves their own access", caller: userAda, decision: domain.DecisionApproved,
+			want: domain.ErrNotReviewer, wantStored: domain.DecisionPending,
+		},
+		{
+			name: "someone else decides", caller: userCyd, decision: domain.DecisionRevoked,
+			want: domain.ErrNotReviewer, wantStored: domain.DecisionPending,
+		},
+		{
+			// A stored "revoked" means the access is gone: a removal
+			// that fails takes the decision with it.
+			name: "revocation fails", caller: userBob, decision: domain.DecisionRevoked, removeErr: boom,
+			want: boom, wantStored: domain.DecisionPending,
+		},
+		{
+			name: "principal deleted since the snapshot", caller: userBob, decision: domain.DecisionRevoked,
+			removeErr: access.ErrUserNotFound, wantStored: domain.DecisionRevoked,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := newWorld()
+			c := w.openCampaign(t, domain.DeadlineRevoke, "", userBob, userAda, userCyd)
+			if tc.removeErr != nil {
+				w.removeErr[userAda] = tc.removeErr
+			}
+			s, em := w.newService(t0)
+			it := w.item(t, c, userAda)
+
+			_, err := s.DecideItem(as(tc.caller), DecideItemInput{
+				CampaignID: c.ID().String(), ItemID: it.ID.String(), Decision: tc.decision,
+			})
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("err = %v, want %v", err, tc.want)
+			}
+			if it.Decision != tc.wantStored {
+				t.Fatalf("stored decision = %v, want %v", it.Decision, tc.wantStored)
+			}
+			if got := w.revoked(userAda); got != tc.wantRevoked {
+				t.Fatalf("revoked = %v, want %v", got, tc.wantRevoked)
+			}
+			evs := em.ofType(audit.EventTypeAccessReviewDecide)
+			if len(evs) != 1 {
+				t.Fatalf("audited %d decisions, want 1", len(evs))
+			}
+			if tc.want == nil && evs[0].Outcome() != audit.OutcomeSuccess {
+				t.Fatalf("audit outcome = %v", evs[0].Outcome())
+			}
+			if errors.Is(tc.want, domain.ErrNotReviewer) && evs[0].Outcome() != audit.OutcomeDenied {
+				t.Fatalf("audit outcome = %v, want denied", evs[0].Outcome())
+			}
+			if c.Status() != domain.CampaignStatusOpen {
+				t.Fatalf("campaign %v with cyd's item pending", c.Status())
+			}
+		})
+	}
+}
+
+// TestDecideLastItemCompletes checks that the campaign closes with its
+// last decision.
+func TestDecideLastItemCompletes(t *testing.T) {
+	w := newWorld()
+	c := w.openCampaign(t, domain.DeadlineRevoke, "", userBob, userAda, userCyd)
+	s, em := w.newService(t0)
+
+	for _, p := range []string{userAda, userCyd} {
+		if c.Status() != domain.CampaignStatusOpen {
+			t.Fatalf("campaign %v before %s's item was decided", c.Status(), p)
+		}
+		_, err := s.DecideItem(as(userBob), DecideItemInput{
+			CampaignID: c.ID().String(), ItemID: w.item(t, c, p).ID.String(), Decision: domain.DecisionRevoked,
+		})
+		if err != nil {
+			t.Fatalf("decide %s: %v", p, err)
+		}
+	}
+	if c.Status() != domain.CampaignStatusCompleted {
+		t.Fatalf("campaign %v, want completed", c.Status())
+	}
+	evs := em.ofType(audit.EventTypeAccessReviewComplete)
+	if len(evs) != 1 || evs[0].Metadata()["revoked"] != "2" {
+		t.Fatalf("completion audit = %v", evs)
+	}
+	if !w.revoked(userAda) || !w.revoked(userCyd) {
+		t.Fatalf("removed = %v", w.removed)
+	}
+
+	_, err := s.DecideItem(as(userBob), DecideItemInput{
+		CampaignID: c.ID().String(), ItemID: w.item(t, c, userAda).ID.String(), Decision: domain.DecisionApproved,
+	})
+	if !errors.Is(err, domain.ErrCampaignClosed) {
+		t.Fatalf("decide on a completed campaign: err = %v, want ErrCampaignClosed", err)
+	}
+}
+
+func TestReassignItemToPrincipal(t *testing.T) {
+	w := newWorld()
+	c := w.openCampaign(t, domain.DeadlineRevoke, "", userBob, userAda)
+	s, _ := w.newService(t0)
+	it := w.item(t, c, userAda)
+
+	_, err := s.ReassignItem(as(adminID), ReassignItemInput{
+		CampaignID: c.ID().String(), ItemID: it.ID.String(), ReviewerID: userAda,
+	})
+	if !errors.Is(err, domain.ErrSelfReview) {
+		t.Fatalf("err = %v, want ErrSelfReview", err)
+	}
+	if it.ReviewerID != userBob {
+		t.Fatalf("reviewer = %s, want bob still", it.ReviewerID)
+	}
+}
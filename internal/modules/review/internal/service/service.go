@@ -0,0 +1,139 @@
+// Package service hosts the application-layer use-cases of the review
+// bounded context:
+//
+//	service.go  — Service struct + helpers
+//	campaign.go — Create/Get/List/CancelCampaign
+//	item.go     — ListItems, DecideItem, ReassignItem
+//	deadline.go — SweepDeadlines (the background revoke / escalate)
+//
+// The module owns only the campaign and item rows. Assignments are read
+// through access.Service.ListRoleMembers when a campaign opens and
+// revoked through access.Service.RemoveRoleFromUser, so a revocation
+// goes through the same checks, cache invalidation and audit event as
+// an admin's removal; the tx port stores the decision and the removal
+// as one transaction.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/access"
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/review/internal/domain"
+	"sso/internal/modules/role"
+)
+
+// Access is the slice of access.Service a campaign works through.
+// Satisfied by *access.Service.
+type Access interface {
+	ListRoleMembers(ctx context.Context, in access.ListRoleMembersInput) (access.ListRoleMembersOutput, error)
+	RemoveRoleFromUser(ctx context.Context, in access.RemoveRoleFromUserInput) error
+}
+
+// TxRunner runs fn in one database transaction carried by ctx
+// (dbutil.WithTx bound to the module's *sql.DB).
+type TxRunner func(ctx context.Context, fn func(ctx context.Context) error) error
+
+// Config carries the non-dependency settings of the Service.
+type Config struct {
+	// EscalationGrace is how far an escalating deadline moves the due
+	// date for the escalation reviewer.
+	EscalationGrace time.Duration
+}
+
+type Service struct {
+	repo    domain.Repository
+	access  Access
+	apps    app.Repository
+	roles   role.Repository
+	users   identity.UserReader
+	tx      TxRunner
+	cfg     Config
+	now     func() time.Time
+	log     *slog.Logger
+	auditor auditx.Auditor
+}
+
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	acc Access,
+	apps app.Repository,
+	roles role.Repository,
+	users identity.UserReader,
+	tx TxRunner,
+	cfg Config,
+	now func() time.Time,
+	emitter audit.Emitter,
+) *Service {
+	return &Service{
+		repo:    repo,
+		access:  acc,
+		apps:    apps,
+		roles:   roles,
+		users:   users,
+		tx:      tx,
+		cfg:     cfg,
+		now:     now,
+		log:     log,
+		auditor: auditx.New(log, emitter),
+	}
+}
+
+// errReasonMap maps review sentinels (and the cross-module ones the
+// use-cases pass through) to their audit (Outcome, Reason) pair.
+var errReasonMap = map[error]auditx.OutcomeReason{
+	domain.ErrCampaignNotFound:   auditx.Fail(audit.ReasonAccessReviewNotFound),
+	domain.ErrItemNotFound:       auditx.Fail(audit.ReasonAccessReviewItemNotFound),
+	domain.ErrEtagMismatch:       auditx.Fail(audit.ReasonEtagMismatch),
+	domain.ErrCampaignClosed:     auditx.Fail(audit.ReasonAccessReviewClosed),
+	domain.ErrItemDecided:        auditx.Fail(audit.ReasonAccessReviewItemDecided),
+	domain.ErrNotReviewer:        auditx.Deny(audit.ReasonPermissionDenied),
+	domain.ErrNotCampaignOwner:   auditx.Deny(audit.ReasonPermissionDenied),
+	domain.ErrSelfReview:         auditx.Deny(audit.ReasonPermissionDenied),
+	domain.ErrNothingToReview:    auditx.Fail(audit.ReasonAccessReviewEmpty),
+	domain.ErrCampaignTooLarge:   auditx.Fail(audit.ReasonAccessReviewTooLarge),
+	app.ErrAppNotFound:           auditx.Fail(audit.ReasonAppNotFound),
+	role.ErrRoleNotFound:         auditx.Fail(audit.ReasonRoleNotFound),
+	access.ErrRoleNotFound:       auditx.Fail(audit.ReasonRoleNotFound),
+	access.ErrRoleNotInApp:       auditx.Fail(audit.ReasonRoleNotInApp),
+	identity.ErrUserNotFound:     auditx.Fail(audit.ReasonUserNotFound),
+	access.ErrUserNotFound:       auditx.Fail(audit.ReasonUserNotFound),
+	access.ErrAssignmentNotFound: auditx.Fail(audit.ReasonAssignmentNotFound),
+}
+
+// classifyError is the per-package thin wrapper around auditx.Classify.
+func classifyError(err error) (audit.AuditOutcome, string) {
+	return auditx.Classify(err, errReasonMap)
+}
+
+// withOutcome is a thin alias over auditx.WithOutcome.
+func withOutcome(p audit.NewAuditParams, out audit.AuditOutcome, reason string) audit.NewAuditParams {
+	return auditx.WithOutcome(p, out, reason)
+}
+
+// requireReviewer checks that id names an active user of the tenant in
+// scope; field names the input it came from.
+func (s *Service) requireReviewer(ctx context.Context, id domain.UserID, field string) error {
+	u, err := s.users.GetByID(ctx, identity.UserID(id))
+	if err != nil {
+		return err
+	}
+	if u.Status() != identity.UserStatusActive {
+		return &validation.Error{Field: field, Reason: "must name an active user"}
+	}
+	return nil
+}
+
+// isGone reports whether a revocation failed only because the principal
+// or the role no longer exists — the assignment went with it.
+func isGone(err error) bool {
+	return errors.Is(err, access.ErrUserNotFound) || errors.Is(err, access.ErrRoleNotFound)
+}
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/access"
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/review/internal/domain"
+	"sso/internal/modules/role"
+)
+
+// Fixed ids of the test world. UUIDs, as the service parses them.
+const (
+	appID    = "0190b6f2-8a43-7c1e-9d2a-00000000a001"
+	roleOps  = "0190b6f2-8a43-7c1e-9d2a-00000000b001"
+	roleRead = "0190b6f2-8a43-7c1e-9d2a-00000000b002"
+	userAda  = "0190b6f2-8a43-7c1e-9d2a-00000000c001"
+	userBob  = "0190b6f2-8a43-7c1e-9d2a-00000000c002"
+	userCyd  = "0190b6f2-8a43-7c1e-9d2a-00000000c003"
+	botID    = "0190b6f2-8a43-7c1e-9d2a-00000000d001"
+	adminID  = "0190b6f2-8a43-7c1e-9d2a-00000000f001"
+)
+
+var t0 = time.Date(2026, time.April, 1, 9, 0, 0, 0, time.UTC)
+
+// world is the in-memory state behind the fakes: the app, its roles and
+// their direct members as access reports them, the users, and the
+// campaigns and items the service stores. Each fake implements only
+// what the service calls; the rest panics through the nil embedded
+// interface.
+type world struct {
+	app     *app.App
+	roles   []*role.Role
+	users   map[identity.UserID]*identity.User
+	members map[string][]access.RoleMember
+
+	campaigns map[domain.CampaignID]*domain.Campaign
+	items     []*domain.Item
+
+	// removed records the RemoveRoleFromUser calls that succeeded;
+	// removeErr fails the removals of a principal.
+	removed   []access.RemoveRoleFromUserInput
+	removeErr map[string]error
+}
+
+func newWorld() *world {
+	w := &world{
+		app: app.RestoreApp(app.RestoreAppParams{
+			ID: appID, TenantID: tenant.System, Name: "Payments", Slug: "payments", Status: app.AppStatusActive,
+		}),
+		users:     map[identity.UserID]*identity.User{},
+		members:   map[string][]access.RoleMember{},
+		campaigns: map[domain.CampaignID]*domain.Campaign{},
+		removeErr: map[string]error{},
+	}
+	for _, id := range []string{roleOps, roleRead} {
+		w.roles = append(w.roles, role.RestoreRole(role.RestoreRoleParams{
+			ID: role.RoleID(id), AppID: appID, TenantID: tenant.System, Name: id, Status: role.RoleStatusActive,
+		}))
+	}
+	for _, id := range []string{userAda, userBob, userCyd, adminID} {
+		w.users[identity.UserID(id)] = identity.RestoreUser(identity.RestoreUserParams{
+			ID: identity.UserID(id), TenantID: tenant.System, Email: id + "@example.com", Status: identity.UserStatusActive,
+		})
+	}
+	return w
+}
+
+// grant makes p a direct member of the role.
+func (w *world) grant(roleID string, p access.Principal) {
+	w.members[roleID] = append(w.members[roleID], access.RoleMember{Principal: p, Direct: true})
+}
+
+// openCampaign stores an open campaign of the app with one pending item
+// per principal, all for roleOps and dealt to reviewer.
+func (w *world) openCampaign(t *testing.T, action domain.DeadlineAction, escalateTo string, reviewer string, principals ...string) *domain.Campaign {
+	t.Helper()
+	id, err := domain.NewCampaignID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := domain.NewCampaign(domain.NewCampaignParams{
+		ID:             id,
+		TenantID:       tenant.System,
+		Name:           "Q2 ops",
+		AppID:          appID,
+		RoleID:         roleOps,
+		DeadlineAction: action,
+		EscalateTo:     domain.UserID(escalateTo),
+		DueAt:          t0.Add(7 * 24 * time.Hour),
+		CreatedBy:      adminID,
+		Now:            t0,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.campaigns[id] = c
+	for _, p := range principals {
+		itemID, err := domain.NewItemID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.items = append(w.items, &domain.Item{
+			ID:            itemID,
+			CampaignID:    id,
+			PrincipalType: domain.PrincipalUser,
+			PrincipalID:   p,
+			RoleID:        roleOps,
+			ReviewerID:    domain.UserID(reviewer),
+			Decision:      domain.DecisionPending,
+			CreatedAt:     t0,
+		})
+	}
+	return c
+}
+
+// item returns the stored item of the principal in the campaign.
+func (w *world) item(t *testing.T, c *domain.Campaign, principalID string) *domain.Item {
+	t.Helper()
+	for _, it := range w.items {
+		if it.CampaignID == c.ID() && it.PrincipalID == principalID {
+			return it
+		}
+	}
+	t.Fatalf("no item for %s", principalID)
+	return nil
+}
+
+// revoked reports whether the principal's roleOps assignment was
+// removed.
+func (w *world) revoked(principalID string) bool {
+	return slices.Contains(w.removed, access.RemoveRoleFromUserInput{UserID: principalID, RoleID: roleOps})
+}
+
+func (w *world) newService(now time.Time) (*Service, *recordingEmitter) {
+	em := &recordingEmitter{}
+	s := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)),
+		worldRepo{w: w}, worldAccess{w: w}, worldApps{w: w}, worldRoles{w: w}, worldUsers{w: w}, w.tx,
+		Config{EscalationGrace: 3 * 24 * time.Hour},
+		func() time.Time { return now }, em)
+	return s, em
+}
+
+// tx rolls the items back when fn fails, as the database would.
+func (w *world) tx(ctx context.Context, fn func(ctx context.Context) error) error {
+	saved := make([]domain.Item, len(w.items))
+	for i, it := range w.items {
+		saved[i] = *it
+	}
+	if err := fn(ctx); err != nil {
+		for i := range saved {
+			*w.items[i] = saved[i]
+		}
+		return err
+	}
+	return nil
+}
+
+func as(id string) context.Context {
+	return actor.Inject(context.Background(), actor.Actor{ID: id, Kind: actor.KindUser})
+}
+
+type recordingEmitter struct {
+	mu     sync.Mutex
+	events []*audit.Audit
+}
+
+func (e *recordingEmitter) Emit(_ context.Context, a *audit.Audit) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, a)
+	return nil
+}
+
+// ofType returns the recorded events of the type.
+func (e *recordingEmitter) ofType(typ audit.EventType) []*audit.Audit {
+	var out []*audit.Audit
+	for _, ev := range e.events {
+		if ev.EventType() == typ {
+			out = append(out, ev)
+		}
+	}
+	return out
+}
+
+// ----------------------------------------------------------------------------
+// Fakes
+// ----------------------------------------------------------------------------
+
+type worldApps struct {
+	app.Repository
+	w *world
+}
+
+func (a worldApps) GetByID(_ context.Context, id app.AppID) (*app.App, error) {
+	if id == a.w.app.ID() {
+		return a.w.app, nil
+	}
+	return nil, app.ErrAppNotFound
+}
+
+type worldRoles struct {
+	role.Repository
+	w *world
+}
+
+func (r worldRoles) GetByID(_ context.Context, id role.RoleID) (*role.Role, error) {
+	for _, ro := range r.w.roles {
+		if ro.ID() == id {
+			return ro, nil
+		}
+	}
+	return nil, role.ErrRoleNotFound
+}
+
+func (r worldRoles) List(_ context.Context, q role.ListQuery) (role.ListResult, error) {
+	var out []*role.Role
+	for _, ro := range r.w.roles {
+		if ro.AppID() == q.AppID {
+			out = append(out, ro)
+		}
+	}
+	return role.ListResult{Roles: out}, nil
+}
+
+type worldUsers struct {
+	identity.UserReader
+	w *world
+}
+
+func (u worldUsers) GetByID(_ context.Context, id identity.UserID) (*identity.User, error) {
+	if us, ok := u.w.users[id]; ok {
+		return us, nil
+	}
+	return nil, identity.ErrUserNotFound
+}
+
+type worldAccess struct {
+	w *world
+}
+
+func (a worldAccess) ListRoleMembers(_ context.Context, in access.ListRoleMembersInput) (access.ListRoleMembersOutput, error) {
+	return access.ListRoleMembersOutput{Members: a.w.members[in.RoleID]}, nil
+}
+
+func (a worldAccess) RemoveRoleFromUser(_ context.Context, in access.RemoveRoleFromUserInput) error {
+	if err := a.w.removeErr[in.UserID]; err != nil {
+		return err
+	}
+	a.w.removed = append(a.w.removed, in)
+	return nil
+}
+
+// worldRepo is domain.Repository over the world, following the
+// contracts documented on the interface. Items are handed out as
+// copies, so only DecideItem and the reassignments change them.
+type worldRepo struct {
+	domain.Repository
+	w *world
+}
+
+func (r worldRepo) Create(_ context.Context, c *domain.Campaign, items []*domain.Item) error {
+	r.w.campaigns[c.ID()] = c
+	r.w.items = append(r.w.items, items...)
+	return nil
+}
+
+func (r worldRepo) GetByID(_ context.Context, id domain.CampaignID) (*domain.Campaign, error) {
+	if c, ok := r.w.campaigns[id]; ok {
+		return c, nil
+	}
+	return nil, domain.ErrCampaignNotFound
+}
+
+func (r worldRepo) Update(_ context.Context, c *domain.Campaign, _ etag.Etag) error {
+	r.w.campaigns[c.ID()] = c
+	return nil
+}
+
+func (r worldRepo) ListDue(_ context.Context, now time.Time, limit int) ([]*domain.Campaign, error) {
+	var out []*domain.Campaign
+	for _, c := range r.w.campaigns {
+		if c.Status() == domain.CampaignStatusOpen && !c.DueAt().After(now) && len(out) < limit {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (r worldRepo) GetItem(_ context.Context, cid domain.CampaignID, id domain.ItemID) (*domain.Item, error) {
+	for _, it := range r.w.items {
+		if it.CampaignID == cid && it.ID == id {
+			cp := *it
+			return &cp, nil
+		}
+	}
+	return nil, domain.ErrItemNotFound
+}
+
+func (r worldRepo) ListItems(_ context.Context, q domain.ItemsQuery) ([]*domain.Item, error) {
+	var out []*domain.Item
+	for _, it := range r.w.items {
+		if it.CampaignID != q.CampaignID || it.ID <= q.After ||
+			(q.ReviewerID != "" && it.ReviewerID != q.ReviewerID) ||
+			(q.Decision != 0 && it.Decision != q.Decision) {
+			continue
+		}
+		cp := *it
+		out = append(out, &cp)
+		if len(out) > q.PageSize {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (r worldRepo) CountItems(_ context.Context, cid domain.CampaignID) (domain.ItemCounts, error) {
+	var n domain.ItemCounts
+	for _, it := range r.w.items {
+		if it.CampaignID != cid {
+			continue
+		}
+		switch it.Decision {
+		case domain.DecisionPending:
+			n.Pending++
+		case domain.DecisionApproved:
+			n.Approved++
+		case domain.DecisionRevoked:
+			n.Revoked++
+		}
+	}
+	return n, nil
+}
+
+func (r worldRepo) DecideItem(_ context.Context, it *domain.Item) error {
+	for _, stored := range r.w.items {
+		if stored.CampaignID == it.CampaignID && stored.ID == it.ID {
+			if !stored.IsPending() {
+				return domain.ErrItemDecided
+			}
+			*stored = *it
+			return nil
+		}
+	}
+	return domain.ErrItemNotFound
+}
+
+func (r worldRepo) ReassignItem(_ context.Context, cid domain.CampaignID, id domain.ItemID, reviewer domain.UserID) error {
+	for _, it := range r.w.items {
+		if it.CampaignID == cid && it.ID == id {
+			if !it.IsPending() {
+				return domain.ErrItemDecided
+			}
+			it.ReviewerID = reviewer
+			return nil
+		}
+	}
+	return domain.ErrItemNotFound
+}
+
+func (r worldRepo) ReassignPending(_ context.Context, cid domain.CampaignID, reviewer domain.UserID) (int64, error) {
+	var n int64
+	for _, it := range r.w.items {
+		if it.CampaignID == cid && it.IsPending() && it.PrincipalID != reviewer.String() {
+			it.ReviewerID = reviewer
+			n++
+		}
+	}
+	return n, nil
+}
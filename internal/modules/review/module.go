@@ -0,0 +1,169 @@
+// Package review exposes the wire-up for the access review bounded
+// context (periodic recertification of role assignments). bootstrap.New
+// constructs a single *review.Module and pulls everything else off it:
+//
+//	mod.RegisterHTTP(mux)  // mounts the /v1/access-reviews endpoints
+//	mod.Start(ctx)         // deadline sweeper (revoke / escalate)
+//	mod.Service()          // application-layer service (rare)
+//	mod.Repository()       // persistence contract
+//
+// The surface is HTTP-only until an AccessReviewService contract is
+// published in sso_protos.
+package review
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/review/internal/httpapi"
+	"sso/internal/modules/review/internal/mariadb"
+	"sso/internal/modules/review/internal/service"
+	"sso/internal/modules/role"
+)
+
+// Emitter is the audit-event sink the module uses to record write
+// operations. Type alias over audit.Emitter — keeps the wiring
+// contract local to this module.
+type Emitter = audit.Emitter
+
+// Deps lists everything review needs from its host. Access must write
+// through the same *sql.DB as DB: a revocation and its decision are
+// stored in one dbutil.WithTx transaction.
+type Deps struct {
+	DB  *sql.DB
+	Log *slog.Logger
+
+	Access        Access // *access.Service
+	Apps          app.Repository
+	Roles         role.Repository
+	Users         identity.UserReader
+	Authenticator Authenticator // *grpcauth.Interceptor
+	Authorizer    Authorizer    // *httprbac.Authorizer
+
+	// DeadlineSweepInterval is how often Start looks for campaigns past
+	// their deadline. Defaults to five minutes.
+	DeadlineSweepInterval time.Duration
+	// EscalationGrace is how long the escalation reviewer gets once a
+	// deadline escalates. Defaults to 168h.
+	EscalationGrace time.Duration
+
+	Clock func() time.Time
+	Audit Emitter
+}
+
+// Module is the assembled review bounded context.
+type Module struct {
+	service *service.Service
+	handler *httpapi.Handler
+	repo    *mariadb.Repository
+	log     *slog.Logger
+
+	sweepInterval time.Duration
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.DB == nil {
+		return nil, fmt.Errorf("review: db is required")
+	}
+	if d.Log == nil {
+		return nil, fmt.Errorf("review: log is required")
+	}
+	if d.Access == nil {
+		return nil, fmt.Errorf("review: access service is required")
+	}
+	if d.Apps == nil {
+		return nil, fmt.Errorf("review: apps repository is required")
+	}
+	if d.Roles == nil {
+		return nil, fmt.Errorf("review: roles repository is required")
+	}
+	if d.Users == nil {
+		return nil, fmt.Errorf("review: users reader is required")
+	}
+	if d.Authenticator == nil {
+		return nil, fmt.Errorf("review: authenticator is required")
+	}
+	if d.Authorizer == nil {
+		return nil, fmt.Errorf("review: authorizer is required")
+	}
+	if d.DeadlineSweepInterval <= 0 {
+		d.DeadlineSweepInterval = 5 * time.Minute
+	}
+	if d.EscalationGrace <= 0 {
+		d.EscalationGrace = 168 * time.Hour
+	}
+	if d.Clock == nil {
+		d.Clock = time.Now
+	}
+	if d.Audit == nil {
+		d.Audit = audit.NopEmitter{}
+	}
+
+	repo := mariadb.NewRepository(d.DB)
+
+	var _ Repository = repo
+
+	tx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return dbutil.WithTx(ctx, d.DB, fn)
+	}
+	svc := service.NewService(d.Log, repo, d.Access, d.Apps, d.Roles, d.Users, tx,
+		service.Config{EscalationGrace: d.EscalationGrace},
+		d.Clock, d.Audit)
+
+	return &Module{
+		service: svc,
+		handler: httpapi.NewHandler(svc, d.Authenticator, d.Authorizer, d.Log),
+		repo:    repo,
+		log:     d.Log,
+
+		sweepInterval: d.DeadlineSweepInterval,
+	}, nil
+}
+
+// RegisterHTTP mounts the access review endpoints on the HTTP
+// listener's root mux.
+func (m *Module) RegisterHTTP(mux *http.ServeMux) {
+	m.handler.Register(mux)
+}
+
+// Start launches the sweeper that applies the deadline action of every
+// open campaign past its due date, every DeadlineSweepInterval until
+// ctx is cancelled. It returns immediately.
+func (m *Module) Start(ctx context.Context) {
+	go m.sweepDeadlines(ctx)
+}
+
+func (m *Module) sweepDeadlines(ctx context.Context) {
+	ticker := time.NewTicker(m.sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := m.service.SweepDeadlines(ctx)
+			if err != nil && ctx.Err() == nil {
+				m.log.ErrorContext(ctx, "review: sweep deadlines", "campaigns", n, "err", err)
+				continue
+			}
+			if n > 0 {
+				m.log.InfoContext(ctx, "review: swept deadlines", "campaigns", n)
+			}
+		}
+	}
+}
+
+// Service returns the application-layer Service.
+func (m *Module) Service() *service.Service { return m.service }
+
+// Repository returns the persistence contract.
+func (m *Module) Repository() Repository { return m.repo }
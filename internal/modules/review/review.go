@@ -0,0 +1,75 @@
+// Package review is the public API of the access review bounded
+// context. External callers interact with the module through:
+//
+//	review.New(Deps)    wires the module (module.go)
+//	review.Service      application-layer use-cases (service.go)
+//	review.Repository   persistence contract
+package review
+
+import (
+	"sso/internal/modules/review/internal/domain"
+	"sso/internal/modules/review/internal/httpapi"
+	"sso/internal/modules/review/internal/service"
+)
+
+type (
+	Campaign              = domain.Campaign
+	CampaignID            = domain.CampaignID
+	CampaignStatus        = domain.CampaignStatus
+	DeadlineAction        = domain.DeadlineAction
+	Item                  = domain.Item
+	ItemID                = domain.ItemID
+	Decision              = domain.Decision
+	PrincipalType         = domain.PrincipalType
+	ItemCounts            = domain.ItemCounts
+	NewCampaignParams     = domain.NewCampaignParams
+	RestoreCampaignParams = domain.RestoreCampaignParams
+	Repository            = domain.Repository
+
+	// Access is satisfied by *access.Service.
+	Access = service.Access
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+)
+
+// Enum re-exports.
+const (
+	CampaignStatusOpen      = domain.CampaignStatusOpen
+	CampaignStatusCompleted = domain.CampaignStatusCompleted
+	CampaignStatusCancelled = domain.CampaignStatusCancelled
+
+	DeadlineRevoke   = domain.DeadlineRevoke
+	DeadlineEscalate = domain.DeadlineEscalate
+
+	DecisionPending  = domain.DecisionPending
+	DecisionApproved = domain.DecisionApproved
+	DecisionRevoked  = domain.DecisionRevoked
+
+	PrincipalUser           = domain.PrincipalUser
+	PrincipalServiceAccount = domain.PrincipalServiceAccount
+)
+
+var (
+	NewCampaignID       = domain.NewCampaignID
+	ParseCampaignID     = domain.ParseCampaignID
+	ParseItemID         = domain.ParseItemID
+	ParseCampaignStatus = domain.ParseCampaignStatus
+	ParseDeadlineAction = domain.ParseDeadlineAction
+	ParseDecision       = domain.ParseDecision
+)
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var (
+	ErrCampaignNotFound = domain.ErrCampaignNotFound
+	ErrItemNotFound     = domain.ErrItemNotFound
+	ErrEtagMismatch     = domain.ErrEtagMismatch
+	ErrCampaignClosed   = domain.ErrCampaignClosed
+	ErrItemDecided      = domain.ErrItemDecided
+	ErrNotReviewer      = domain.ErrNotReviewer
+	ErrNotCampaignOwner = domain.ErrNotCampaignOwner
+	ErrNothingToReview  = domain.ErrNothingToReview
+	ErrCampaignTooLarge = domain.ErrCampaignTooLarge
+	ErrSelfReview       = domain.ErrSelfReview
+)
@@ -0,0 +1,22 @@
+// Package review re-exports the application-layer Service together
+// with the typed Input/Output structs declared in internal/service.
+package review
+
+import "sso/internal/modules/review/internal/service"
+
+// Service is the use-case orchestrator, split across files in
+// internal/service: campaign.go, item.go, deadline.go.
+type Service = service.Service
+
+// Input / Output type aliases.
+type (
+	CreateCampaignInput = service.CreateCampaignInput
+	GetCampaignOutput   = service.GetCampaignOutput
+	ListCampaignsInput  = service.ListCampaignsInput
+	ListCampaignsOutput = service.ListCampaignsOutput
+	CancelCampaignInput = service.CancelCampaignInput
+	ListItemsInput      = service.ListItemsInput
+	ListItemsOutput     = service.ListItemsOutput
+	DecideItemInput     = service.DecideItemInput
+	ReassignItemInput   = service.ReassignItemInput
+)
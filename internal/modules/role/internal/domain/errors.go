@@ -11,4 +11,6 @@ var (
 	ErrRoleDisabled       = errors.New("role: disabled")
 	ErrRoleNotInApp       = errors.New("role: not in app")
 	ErrRoleHasAssignments = errors.New("role: has assignments")
+	ErrRoleCycle          = errors.New("role: include cycle")
+	ErrAppNotFound        = errors.New("role: app not found")
 )
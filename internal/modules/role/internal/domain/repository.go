@@ -42,10 +42,21 @@ type ListResult struct {
 	TotalSize  *int
 }
 
+// IncludeEdge is one (parent, child) edge of an app's role-include graph.
+type IncludeEdge struct {
+	RoleID         RoleID
+	IncludedRoleID RoleID
+}
+
 type Repository interface {
 	Create(ctx context.Context, r *Role) error
 	GetByID(ctx context.Context, id RoleID) (*Role, error)
 	List(ctx context.Context, q ListQuery) (ListResult, error)
 	Update(ctx context.Context, r *Role, expectedEtag etag.Etag) error
 	Delete(ctx context.Context, id RoleID, expectedEtag etag.Etag) error
+
+	// ListIncludeEdges returns every include edge between roles of the
+	// app. The graph per app is small (tens of roles), so UpdateRole
+	// loads it whole for cycle detection.
+	ListIncludeEdges(ctx context.Context, appID app.AppID) ([]IncludeEdge, error)
 }
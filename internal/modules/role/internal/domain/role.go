@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"maps"
 	"slices"
 	"time"
 
@@ -42,13 +43,23 @@ func (r RoleID) String() string { return string(r) }
 // Field visibility split:
 //
 //   Unexported (only the aggregate itself can change them):
-//     id, appID, status, etag, createdAt, updatedAt, permissions
+//     id, appID, status, etag, createdAt, updatedAt, permissions,
+//     conditions, includes
 //   * id and appID are immutable after construction.
+//   * tenantID is the app's; the repository stamps it when the role is
+//     stored, so only a Role read back from it carries one.
 //   * createdAt is immutable after construction.
 //   * status is advanced only by Disable/Enable.
 //   * etag and updatedAt are advanced exclusively by bumpVersion.
 //   * permissions is canonicalised (sorted) on store, so the equality
 //     check in ApplyPatch is a straight slice compare.
+//   * conditions maps a held permission to the CEL expression guarding
+//     it; unconditional permissions have no entry. ApplyPatch drops the
+//     entries of permissions the role no longer holds. Whether an
+//     expression compiles is checked by the use-case.
+//   * includes (the ids of the roles this one is composed of) is
+//     canonicalised the same way. Acyclicity and same-app membership
+//     are graph properties, checked by the UpdateRole use-case.
 //
 //   Exported (plain data):
 //     Name, Description
@@ -56,11 +67,14 @@ func (r RoleID) String() string { return string(r) }
 type Role struct {
 	id          RoleID
 	appID       AppID
+	tenantID    string
 	status      RoleStatus
 	etag        etag.Etag
 	createdAt   time.Time
 	updatedAt   time.Time
 	permissions []string
+	conditions  map[string]string
+	includes    []RoleID
 
 	Name        string
 	Description string
@@ -68,7 +82,8 @@ type Role struct {
 
 // NewRoleParams carries the values supplied by the CreateRole use-case.
 // Server-managed fields (status defaults to ACTIVE here; etag/timestamps
-// stamped by NewRole) are not part of it.
+// stamped by NewRole) are not part of it. A new role includes no other
+// roles; includes are attached later through UpdateRole.
 type NewRoleParams struct {
 	ID          RoleID
 	AppID       app.AppID
@@ -99,9 +114,12 @@ func NewRole(p NewRoleParams) *Role {
 type RestoreRoleParams struct {
 	ID          RoleID
 	AppID       app.AppID
+	TenantID    string
 	Name        string
 	Description string
 	Permissions []string
+	Conditions  map[string]string
+	Includes    []RoleID
 	Status      RoleStatus
 	Etag        etag.Etag
 	CreatedAt   time.Time
@@ -110,17 +128,20 @@ type RestoreRoleParams struct {
 
 // RestoreRole rebuilds a Role from a persisted row. No validation: the
 // row is trusted (it was written by NewRole/ApplyPatch earlier).
-// Permissions are NOT re-sorted because the SQL query that produces them
-// already returns them ORDER BY permission ASC.
+// Permissions and Includes are NOT re-sorted because the SQL queries that
+// produce them already return them in ascending order.
 func RestoreRole(p RestoreRoleParams) *Role {
 	return &Role{
 		id:          p.ID,
 		appID:       p.AppID,
+		tenantID:    p.TenantID,
 		status:      p.Status,
 		etag:        p.Etag,
 		createdAt:   p.CreatedAt,
 		updatedAt:   p.UpdatedAt,
 		permissions: p.Permissions,
+		conditions:  p.Conditions,
+		includes:    p.Includes,
 		Name:        p.Name,
 		Description: p.Description,
 	}
@@ -129,11 +150,17 @@ func RestoreRole(p RestoreRoleParams) *Role {
 // Read-only accessors for the unexported fields.
 func (r *Role) ID() RoleID            { return r.id }
 func (r *Role) AppID() app.AppID      { return r.appID }
+func (r *Role) TenantID() string      { return r.tenantID }
 func (r *Role) Status() RoleStatus    { return r.status }
 func (r *Role) Etag() etag.Etag       { return r.etag }
 func (r *Role) CreatedAt() time.Time  { return r.createdAt }
 func (r *Role) UpdatedAt() time.Time  { return r.updatedAt }
 func (r *Role) Permissions() []string { return r.permissions }
+func (r *Role) Includes() []RoleID    { return r.includes }
+
+// Conditions returns the CEL condition of each conditional permission,
+// keyed by permission. nil when every permission is unconditional.
+func (r *Role) Conditions() map[string]string { return r.conditions }
 
 // ----------------------------------------------------------------------------
 // RolePatch — set of changes for ApplyPatch. nil pointer = "field not in
@@ -148,10 +175,13 @@ type RolePatch struct {
 	Name        *string
 	Description *string
 	Permissions *[]string
+	Conditions  *map[string]string
+	Includes    *[]RoleID
 }
 
 func (p RolePatch) IsEmpty() bool {
-	return p.Name == nil && p.Description == nil && p.Permissions == nil
+	return p.Name == nil && p.Description == nil && p.Permissions == nil &&
+		p.Conditions == nil && p.Includes == nil
 }
 
 // ----------------------------------------------------------------------------
@@ -196,25 +226,91 @@ func (r *Role) ApplyPatch(p RolePatch, now time.Time) {
 			changed = true
 		}
 	}
+	if p.Conditions != nil {
+		newConds := canonicalConditions(*p.Conditions)
+		if !maps.Equal(newConds, r.conditions) {
+			r.conditions = newConds
+			changed = true
+		}
+	}
+	if p.Permissions != nil && r.pruneConditions() {
+		changed = true
+	}
+	if p.Includes != nil {
+		newIncludes := canonicalPerms(*p.Includes)
+		if !slices.Equal(newIncludes, r.includes) {
+			r.includes = newIncludes
+			changed = true
+		}
+	}
 	if changed {
 		r.bumpVersion(now)
 	}
 }
 
+// pruneConditions drops the conditions of permissions the role no
+// longer holds. Reports whether any were dropped.
+func (r *Role) pruneConditions() bool {
+	kept := make(map[string]string, len(r.conditions))
+	for perm, expr := range r.conditions {
+		if _, held := slices.BinarySearch(r.permissions, perm); held {
+			kept[perm] = expr
+		}
+	}
+	if len(kept) == len(r.conditions) {
+		return false
+	}
+	r.conditions = canonicalConditions(kept)
+	return true
+}
+
+// CheckConditionTargets rejects a condition on a permission that is not
+// in perms: a condition only narrows a permission the role grants.
+func CheckConditionTargets(perms []string, conds map[string]string) error {
+	for perm := range conds {
+		if !slices.Contains(perms, perm) {
+			return &validation.Error{
+				Field:  "permission_conditions",
+				Reason: fmt.Sprintf("%q is not a permission of the role", perm),
+			}
+		}
+	}
+	return nil
+}
+
 func (r *Role) bumpVersion(now time.Time) {
 	r.updatedAt = now
 	r.etag = etag.New()
 }
 
 // canonicalPerms returns a sorted copy of p, leaving the input slice
-// untouched. nil input yields nil — distinguishable from an empty
+// untouched. It is generic so role includes share the same canonical
+// form. nil input yields nil — distinguishable from an empty
 // permission set should that distinction ever matter (currently it does
 // not — RolePatch.Permissions == nil already means "not in mask").
-func canonicalPerms(p []string) []string {
+func canonicalPerms[T ~string](p []T) []T {
 	if p == nil {
 		return nil
 	}
-	out := append([]string(nil), p...)
+	out := append([]T(nil), p...)
 	slices.Sort(out)
 	return out
 }
+
+// canonicalConditions returns a copy of c without empty expressions (an
+// empty condition means "unconditional", which is stored as no entry).
+// An empty result is nil, the form RestoreRole produces for a role with
+// no conditions, so maps.Equal needs no special case.
+func canonicalConditions(c map[string]string) map[string]string {
+	var out map[string]string
+	for perm, expr := range c {
+		if expr == "" {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string, len(c))
+		}
+		out[perm] = expr
+	}
+	return out
+}
@@ -12,7 +12,8 @@ import (
 // ErrRoleHasAssignments uses Reason = ERROR_REASON_UNSPECIFIED — its
 // proto reason code (ERROR_REASON_ROLE_HAS_ASSIGNMENTS = 84) was
 // retired, so grpcerr.MapError emits a bare FailedPrecondition with no
-// ErrorInfo attachment.
+// ErrorInfo attachment. ErrRoleCycle has no proto reason code either
+// and follows the same convention.
 var errorMap = map[error]grpcerr.ErrorMapping{
 	domain.ErrRoleNotFound: {
 		Code:    codes.NotFound,
@@ -44,6 +45,16 @@ var errorMap = map[error]grpcerr.ErrorMapping{
 		Reason:  ssocommonv1.ErrorReason_ERROR_REASON_UNSPECIFIED,
 		Message: "role has assignments",
 	},
+	domain.ErrRoleCycle: {
+		Code:    codes.FailedPrecondition,
+		Reason:  ssocommonv1.ErrorReason_ERROR_REASON_UNSPECIFIED,
+		Message: "role include would create a cycle",
+	},
+	domain.ErrAppNotFound: {
+		Code:    codes.NotFound,
+		Reason:  ssocommonv1.ErrorReason_ERROR_REASON_APP_NOT_FOUND,
+		Message: "app not found",
+	},
 }
 
 // toGRPCError is the per-package thin wrapper around grpcerr.MapError.
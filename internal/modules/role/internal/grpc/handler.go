@@ -7,6 +7,7 @@ package grpcadapter
 import (
 	"context"
 	"log/slog"
+	"slices"
 
 	"sso/internal/kernel/validation"
 	"sso/internal/modules/role/internal/domain"
@@ -124,6 +125,21 @@ func (h *Handler) UpdateRole(ctx context.Context, req *ssorolesv1.UpdateRoleRequ
 	mask := req.GetUpdateMask()
 	r := req.GetRole()
 
+	// The proto Role has no include list or conditions, so honouring
+	// these paths here would silently clear them. They are HTTP-only.
+	if slices.Contains(mask.GetPaths(), "included_role_ids") {
+		return nil, toGRPCError(&validation.Error{
+			Field:  "update_mask",
+			Reason: "included_role_ids is set via PUT /v1/roles/{role_id}/includes",
+		})
+	}
+	if slices.Contains(mask.GetPaths(), "permission_conditions") {
+		return nil, toGRPCError(&validation.Error{
+			Field:  "update_mask",
+			Reason: "permission_conditions is set via PUT /v1/roles/{role_id}/conditions",
+		})
+	}
+
 	in := rolesvc.UpdateRoleInput{
 		RoleID:       req.GetRoleId(),
 		MaskPaths:    mask.GetPaths(),
@@ -0,0 +1,26 @@
+package httpapi
+
+import (
+	"sso/internal/modules/role/internal/domain"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap mirrors the gRPC adapter's table for the sentinels the
+// include endpoints can surface.
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrRoleNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_FOUND, Message: "role not found"},
+	domain.ErrEtagMismatch: {
+		Code: codes.Aborted, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ETAG_MISMATCH, Message: "etag mismatch"},
+	domain.ErrRoleNotInApp: {
+		Code: codes.FailedPrecondition, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ROLE_NOT_IN_APP, Message: "included role is not in the same app"},
+	domain.ErrRoleCycle: {
+		Code: codes.FailedPrecondition, Message: "role include would create a cycle"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
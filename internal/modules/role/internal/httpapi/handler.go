@@ -0,0 +1,151 @@
+// Package httpapi is the HTTP adapter for the role context's composite
+// roles and permission conditions.
+//
+// The proto Role message has no include list or conditions, so both are
+// read and written through these hand-written net/http handlers mounted
+// next to the grpc-gateway. Error bodies use the same google.rpc.Status
+// JSON shape as the gateway, so clients need a single error decoder.
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+
+	"sso/internal/modules/role/internal/domain"
+	rolesvc "sso/internal/modules/role/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+type Handler struct {
+	svc *rolesvc.Service
+	api *apiutil.Adapter
+	log *slog.Logger
+}
+
+func NewHandler(svc *rolesvc.Service, authn Authenticator, authz Authorizer, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("role", authn, authz, log, toStatus), log: log}
+}
+
+// Register mounts the include and condition endpoints. All are admin.
+//
+//	GET /v1/roles/{role_id}/includes
+//	PUT /v1/roles/{role_id}/includes?etag=      {"role_ids": [...]}
+//	GET /v1/roles/{role_id}/conditions
+//	PUT /v1/roles/{role_id}/conditions?etag=    {"conditions": {"<permission>": "<CEL>"}}
+//
+// Each PUT replaces the whole set; an empty list or object clears it.
+// The etag is the role's, as for UpdateRole, and is required.
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/roles/{role_id}/includes", h.api.Authed(h.getIncludes))
+	mux.HandleFunc("PUT /v1/roles/{role_id}/includes", h.api.Authed(h.setIncludes))
+	mux.HandleFunc("GET /v1/roles/{role_id}/conditions", h.api.Authed(h.getConditions))
+	mux.HandleFunc("PUT /v1/roles/{role_id}/conditions", h.api.Authed(h.setConditions))
+}
+
+// ----------------------------------------------------------------------------
+// Includes
+// ----------------------------------------------------------------------------
+
+func (h *Handler) getIncludes(w http.ResponseWriter, r *http.Request) {
+	role, err := h.svc.GetRole(r.Context(), r.PathValue("role_id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, includesView(role))
+}
+
+type setIncludesBody struct {
+	RoleIDs []string `json:"role_ids"`
+}
+
+func (h *Handler) setIncludes(w http.ResponseWriter, r *http.Request) {
+	var b setIncludesBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	role, err := h.svc.UpdateRole(r.Context(), rolesvc.UpdateRoleInput{
+		RoleID:          r.PathValue("role_id"),
+		MaskPaths:       []string{"included_role_ids"},
+		ExpectedEtag:    r.URL.Query().Get("etag"),
+		IncludedRoleIDs: b.RoleIDs,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, includesView(role))
+}
+
+// ----------------------------------------------------------------------------
+// Conditions
+// ----------------------------------------------------------------------------
+
+func (h *Handler) getConditions(w http.ResponseWriter, r *http.Request) {
+	role, err := h.svc.GetRole(r.Context(), r.PathValue("role_id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, conditionsView(role))
+}
+
+type setConditionsBody struct {
+	Conditions map[string]string `json:"conditions"`
+}
+
+func (h *Handler) setConditions(w http.ResponseWriter, r *http.Request) {
+	var b setConditionsBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	role, err := h.svc.UpdateRole(r.Context(), rolesvc.UpdateRoleInput{
+		RoleID:               r.PathValue("role_id"),
+		MaskPaths:            []string{"permission_conditions"},
+		ExpectedEtag:         r.URL.Query().Get("etag"),
+		PermissionConditions: b.Conditions,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, conditionsView(role))
+}
+
+// ----------------------------------------------------------------------------
+// Views
+// ----------------------------------------------------------------------------
+
+func conditionsView(role *domain.Role) map[string]any {
+	conds := role.Conditions()
+	if conds == nil {
+		conds = map[string]string{}
+	}
+	return map[string]any{
+		"role_id":    role.ID().String(),
+		"conditions": conds,
+		"etag":       role.Etag().String(),
+	}
+}
+
+func includesView(role *domain.Role) map[string]any {
+	ids := make([]string, 0, len(role.Includes()))
+	for _, id := range role.Includes() {
+		ids = append(ids, id.String())
+	}
+	return map[string]any{
+		"role_id":           role.ID().String(),
+		"included_role_ids": ids,
+		"etag":              role.Etag().String(),
+	}
+}
@@ -21,6 +21,15 @@ type App struct {
 	UpdatedAt time.Time
 }
 
+type AppPermission struct {
+	AppID       string
+	Name        string
+	Description string
+	Deprecated  bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
 type AuditEvent struct {
 	ID          string
 	OccurredAt  time.Time
@@ -59,6 +68,7 @@ type Role struct {
 	Etag        string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	TenantID    string
 }
 
 type RoleAssignment struct {
@@ -67,11 +77,19 @@ type RoleAssignment struct {
 	AppID           string
 	GrantedByUserID string
 	GrantedAt       time.Time
+	NotBefore       sql.NullTime
+	ExpiresAt       sql.NullTime
+}
+
+type RoleInclude struct {
+	RoleID         string
+	IncludedRoleID string
 }
 
 type RolePermission struct {
-	RoleID     string
-	Permission string
+	RoleID        string
+	Permission    string
+	ConditionExpr string
 }
 
 type ServiceAccount struct {
@@ -86,6 +104,16 @@ type ServiceAccount struct {
 	LastAuthenticatedAt sql.NullTime
 }
 
+type ServiceAccountRoleAssignment struct {
+	ServiceAccountID string
+	RoleID           string
+	AppID            string
+	GrantedByUserID  string
+	GrantedAt        time.Time
+	NotBefore        sql.NullTime
+	ExpiresAt        sql.NullTime
+}
+
 type Session struct {
 	ID                    string
 	UserID                string
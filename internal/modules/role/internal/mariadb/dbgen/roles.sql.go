@@ -27,8 +27,8 @@ func (q *Queries) CountRoleByID(ctx context.Context, id string) (int64, error) {
 const createRole = `-- name: CreateRole :exec
 
 INSERT INTO roles
-    (id, app_id, name, description, status, etag, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    (id, app_id, name, description, status, etag, created_at, updated_at, tenant_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateRoleParams struct {
@@ -40,6 +40,7 @@ type CreateRoleParams struct {
 	Etag        string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	TenantID    string
 }
 
 // Roles directory: per-row queries plus permission-management helpers.
@@ -69,6 +70,7 @@ func (q *Queries) CreateRole(ctx context.Context, arg CreateRoleParams) error {
 		arg.Etag,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.TenantID,
 	)
 	return err
 }
@@ -105,8 +107,20 @@ func (q *Queries) DeleteRoleWithEtag(ctx context.Context, arg DeleteRoleWithEtag
 	return q.db.ExecContext(ctx, deleteRoleWithEtag, arg.ID, arg.Etag)
 }
 
+const getAppTenantID = `-- name: GetAppTenantID :one
+SELECT tenant_id FROM apps WHERE id = ?
+`
+
+// The tenant a new role inherits from its app.
+func (q *Queries) GetAppTenantID(ctx context.Context, id string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getAppTenantID, id)
+	var tenant_id string
+	err := row.Scan(&tenant_id)
+	return tenant_id, err
+}
+
 const getRoleByID = `-- name: GetRoleByID :one
-SELECT id, app_id, name, description, status, etag, created_at, updated_at
+SELECT id, app_id, name, description, status, etag, created_at, updated_at, tenant_id
 FROM roles
 WHERE id = ?
 `
@@ -123,10 +137,47 @@ func (q *Queries) GetRoleByID(ctx context.Context, id string) (Role, error) {
 		&i.Etag,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
 
+const getRolePermissionConditions = `-- name: GetRolePermissionConditions :many
+SELECT permission, condition_expr FROM role_permissions
+WHERE role_id = ? AND condition_expr <> ''
+ORDER BY permission
+`
+
+type GetRolePermissionConditionsRow struct {
+	Permission    string
+	ConditionExpr string
+}
+
+// Returns the CEL conditions guarding a role's permissions. Unconditional
+// permissions (empty condition_expr) are left out.
+func (q *Queries) GetRolePermissionConditions(ctx context.Context, roleID string) ([]GetRolePermissionConditionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRolePermissionConditions, roleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetRolePermissionConditionsRow{}
+	for rows.Next() {
+		var i GetRolePermissionConditionsRow
+		if err := rows.Scan(&i.Permission, &i.ConditionExpr); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRolePermissions = `-- name: GetRolePermissions :many
 
 SELECT permission FROM role_permissions WHERE role_id = ? ORDER BY permission
@@ -161,19 +212,21 @@ func (q *Queries) GetRolePermissions(ctx context.Context, roleID string) ([]stri
 }
 
 const insertRolePermission = `-- name: InsertRolePermission :exec
-INSERT INTO role_permissions (role_id, permission) VALUES (?, ?)
+INSERT INTO role_permissions (role_id, permission, condition_expr) VALUES (?, ?, ?)
 `
 
 type InsertRolePermissionParams struct {
-	RoleID     string
-	Permission string
+	RoleID        string
+	Permission    string
+	ConditionExpr string
 }
 
-// Inserts a single (role_id, permission) row. Callers (CreateRole and
-// UpdateRole with mask containing "permissions") loop over the role's
+// Inserts a single (role_id, permission) row with its condition (empty =
+// none). Callers (CreateRole and UpdateRole with mask containing
+// "permissions" or "permission_conditions") loop over the role's
 // permission slice inside a transaction.
 func (q *Queries) InsertRolePermission(ctx context.Context, arg InsertRolePermissionParams) error {
-	_, err := q.db.ExecContext(ctx, insertRolePermission, arg.RoleID, arg.Permission)
+	_, err := q.db.ExecContext(ctx, insertRolePermission, arg.RoleID, arg.Permission, arg.ConditionExpr)
 	return err
 }
 
@@ -6,13 +6,14 @@ import (
 	"strings"
 
 	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/tenant"
 	"sso/internal/modules/role/internal/domain"
 	"sso/internal/modules/role/internal/mariadb/dbgen"
 )
 
 // listSelectCols mirrors the column list of GetRoleByID. Kept as a
 // constant so the scan order in List stays in lockstep with dbgen.Role.
-const listSelectCols = `id, app_id, name, description, status, etag, created_at, updated_at`
+const listSelectCols = `id, app_id, name, description, status, etag, created_at, updated_at, tenant_id`
 
 // List paginates roles within a single app. Hand-written rather than
 // sqlc-generated because the WHERE / ORDER BY shape varies per request.
@@ -29,7 +30,7 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 		return domain.ListResult{}, fmt.Errorf("role repo: list: app_id is required")
 	}
 
-	where, args := buildWhere(q)
+	where, args := buildWhere(q, tenant.Scope(ctx))
 	orderBy := orderClauseFor(q.OrderBy)
 
 	// Fetch one extra row to detect "has next page".
@@ -49,7 +50,7 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 		var role dbgen.Role
 		if err := rows.Scan(
 			&role.ID, &role.AppID, &role.Name, &role.Description,
-			&role.Status, &role.Etag, &role.CreatedAt, &role.UpdatedAt,
+			&role.Status, &role.Etag, &role.CreatedAt, &role.UpdatedAt, &role.TenantID,
 		); err != nil {
 			return domain.ListResult{}, fmt.Errorf("role repo: list: scan: %w", err)
 		}
@@ -72,14 +73,18 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 		}
 	}
 
-	permsByRoleID, err := r.loadPermissionsForPage(ctx, pageRows)
+	permsByRoleID, condsByRoleID, err := r.loadPermissionsForPage(ctx, pageRows)
+	if err != nil {
+		return domain.ListResult{}, err
+	}
+	includesByRoleID, err := r.loadIncludesForPage(ctx, pageRows)
 	if err != nil {
 		return domain.ListResult{}, err
 	}
 
 	out := make([]*domain.Role, 0, len(pageRows))
 	for _, row := range pageRows {
-		out = append(out, dbgenToDomain(row, permsByRoleID[row.ID]))
+		out = append(out, dbgenToDomain(row, permsByRoleID[row.ID], condsByRoleID[row.ID], includesByRoleID[row.ID]))
 	}
 
 	return domain.ListResult{Roles: out, NextCursor: nextCursor}, nil
@@ -88,16 +93,18 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 // loadPermissionsForPage issues one SELECT to fetch all permissions for
 // the supplied page of roles, grouping results by role_id in Go. Roles
 // with no permissions get nil from the resulting map (a normal Go zero
-// value lookup), which is fine — RestoreRole accepts nil.
+// value lookup), which is fine — RestoreRole accepts nil. The second
+// map carries the conditions, keyed the same way; roles without any
+// are absent from it.
 //
 // ORDER BY role_id, permission keeps each per-role slice sorted, which
 // matches the canonical order maintained by domain (NewRole / ApplyPatch
 // canonicalise via slices.Sort), so direct slice equality keeps working.
 func (r *Repository) loadPermissionsForPage(
 	ctx context.Context, pageRows []dbgen.Role,
-) (map[string][]string, error) {
+) (map[string][]string, map[string]map[string]string, error) {
 	if len(pageRows) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 	placeholders := make([]string, len(pageRows))
 	args := make([]any, len(pageRows))
@@ -105,33 +112,79 @@ func (r *Repository) loadPermissionsForPage(
 		placeholders[i] = "?"
 		args[i] = row.ID
 	}
-	query := `SELECT role_id, permission FROM role_permissions WHERE role_id IN (` +
+	query := `SELECT role_id, permission, condition_expr FROM role_permissions WHERE role_id IN (` +
 		strings.Join(placeholders, ",") +
 		`) ORDER BY role_id, permission`
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("role repo: list: load permissions: %w", err)
+		return nil, nil, fmt.Errorf("role repo: list: load permissions: %w", err)
 	}
 	defer rows.Close()
 
 	out := make(map[string][]string, len(pageRows))
+	conds := make(map[string]map[string]string)
 	for rows.Next() {
-		var roleID, permission string
-		if err := rows.Scan(&roleID, &permission); err != nil {
-			return nil, fmt.Errorf("role repo: list: scan permission: %w", err)
+		var roleID, permission, cond string
+		if err := rows.Scan(&roleID, &permission, &cond); err != nil {
+			return nil, nil, fmt.Errorf("role repo: list: scan permission: %w", err)
 		}
 		out[roleID] = append(out[roleID], permission)
+		if cond != "" {
+			if conds[roleID] == nil {
+				conds[roleID] = make(map[string]string)
+			}
+			conds[roleID][permission] = cond
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("role repo: list: permissions rows: %w", err)
+	}
+	return out, conds, nil
+}
+
+// loadIncludesForPage is loadPermissionsForPage's twin for role_includes:
+// one SELECT for the whole page, grouped by role_id, each slice sorted.
+func (r *Repository) loadIncludesForPage(
+	ctx context.Context, pageRows []dbgen.Role,
+) (map[string][]string, error) {
+	if len(pageRows) == 0 {
+		return nil, nil
+	}
+	placeholders := make([]string, len(pageRows))
+	args := make([]any, len(pageRows))
+	for i, row := range pageRows {
+		placeholders[i] = "?"
+		args[i] = row.ID
+	}
+	query := `SELECT role_id, included_role_id FROM role_includes WHERE role_id IN (` +
+		strings.Join(placeholders, ",") +
+		`) ORDER BY role_id, included_role_id`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("role repo: list: load includes: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[string][]string, len(pageRows))
+	for rows.Next() {
+		var roleID, included string
+		if err := rows.Scan(&roleID, &included); err != nil {
+			return nil, fmt.Errorf("role repo: list: scan include: %w", err)
+		}
+		out[roleID] = append(out[roleID], included)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("role repo: list: permissions rows: %w", err)
+		return nil, fmt.Errorf("role repo: list: includes rows: %w", err)
 	}
 	return out, nil
 }
 
 // buildWhere assembles the AND-joined predicates and matching args.
-// Always non-empty: app_id filter is mandatory.
-func buildWhere(q domain.ListQuery) ([]string, []any) {
+// Always non-empty: app_id filter is mandatory. tenantID is
+// tenant.Scope; "" lists every tenant.
+func buildWhere(q domain.ListQuery, tenantID string) ([]string, []any) {
 	var (
 		where []string
 		args  []any
@@ -141,6 +194,12 @@ func buildWhere(q domain.ListQuery) ([]string, []any) {
 	where = append(where, "app_id = ?")
 	args = append(args, q.AppID.String())
 
+	// --- tenant -----------------------------------------------------------
+	if tenantID != "" {
+		where = append(where, "tenant_id = ?")
+		args = append(args, tenantID)
+	}
+
 	// --- status filter ---------------------------------------------------
 	// Per proto: empty list means no filter (both ACTIVE and DISABLED
 	// returned). Not "exclude DISABLED by default" — different from users.
@@ -9,13 +9,15 @@ import (
 )
 
 // dbgenToDomain hydrates a domain.Role from a freshly-scanned sqlc row
-// plus the permission strings fetched separately from role_permissions.
+// plus the permission strings (and the conditions of the conditional
+// ones) fetched separately from role_permissions and the included role
+// ids fetched from role_includes.
 //
 // Permissions are stored in their own table, so the repository fetches
 // them with a follow-up query (GetRolePermissions, ORDER BY permission)
 // and passes them in here. RestoreRole accepts the already-sorted slice
 // without re-sorting.
-func dbgenToDomain(r dbgen.Role, perms []string) *domain.Role {
+func dbgenToDomain(r dbgen.Role, perms []string, conds map[string]string, includes []string) *domain.Role {
 	desc := ""
 	if r.Description.Valid {
 		desc = r.Description.String
@@ -23,9 +25,12 @@ func dbgenToDomain(r dbgen.Role, perms []string) *domain.Role {
 	return domain.RestoreRole(domain.RestoreRoleParams{
 		ID:          domain.RoleID(r.ID),
 		AppID:       domain.AppID(r.AppID),
+		TenantID:    r.TenantID,
 		Name:        r.Name,
 		Description: desc,
 		Permissions: perms,
+		Conditions:  conds,
+		Includes:    includesFromDB(includes),
 		Status:      domain.RoleStatus(r.Status),
 		Etag:        etag.Etag(r.Etag),
 		CreatedAt:   r.CreatedAt,
@@ -47,6 +52,7 @@ func toCreateParams(r *domain.Role) dbgen.CreateRoleParams {
 		Etag:        r.Etag().String(),
 		CreatedAt:   r.CreatedAt(),
 		UpdatedAt:   r.UpdatedAt(),
+		TenantID:    r.TenantID(),
 	}
 }
 
@@ -79,16 +85,64 @@ func toUpdateWithEtagParams(r *domain.Role, expectedEtag etag.Etag) dbgen.Update
 }
 
 // toInsertPermissionParams flattens a single (role_id, permission) pair
-// into the sqlc InsertRolePermission arg shape. Callers (CreateRole and
-// UpdateRole with mask containing "permissions") loop over the role's
+// and the permission's condition into the sqlc InsertRolePermission arg
+// shape. Callers (CreateRole and UpdateRole) loop over the role's
 // permission slice and call this once per entry inside a transaction.
-func toInsertPermissionParams(roleID domain.RoleID, permission string) dbgen.InsertRolePermissionParams {
+func toInsertPermissionParams(role *domain.Role, permission string) dbgen.InsertRolePermissionParams {
 	return dbgen.InsertRolePermissionParams{
-		RoleID:     roleID.String(),
-		Permission: permission,
+		RoleID:        role.ID().String(),
+		Permission:    permission,
+		ConditionExpr: role.Conditions()[permission],
 	}
 }
 
+// conditionsFromDB turns GetRolePermissionConditions rows into the
+// domain map. No rows yields nil, matching canonicalConditions.
+func conditionsFromDB(rows []dbgen.GetRolePermissionConditionsRow) map[string]string {
+	if len(rows) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(rows))
+	for _, row := range rows {
+		out[row.Permission] = row.ConditionExpr
+	}
+	return out
+}
+
+// toInsertIncludeParams flattens a single (role_id, included_role_id)
+// edge into the sqlc InsertRoleInclude arg shape.
+func toInsertIncludeParams(roleID, included domain.RoleID) dbgen.InsertRoleIncludeParams {
+	return dbgen.InsertRoleIncludeParams{
+		RoleID:         roleID.String(),
+		IncludedRoleID: included.String(),
+	}
+}
+
+// includesFromDB converts the sorted id strings read from role_includes
+// into domain ids. nil stays nil, so a role with no includes restores
+// the same way a freshly-created one looks.
+func includesFromDB(ids []string) []domain.RoleID {
+	if len(ids) == 0 {
+		return nil
+	}
+	out := make([]domain.RoleID, len(ids))
+	for i, id := range ids {
+		out[i] = domain.RoleID(id)
+	}
+	return out
+}
+
+// includesToDB is the inverse of includesFromDB, used by Update to
+// compare the stored edge set with the aggregate's. It always returns a
+// non-nil slice to match GetRoleIncludes' empty result.
+func includesToDB(ids []domain.RoleID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id.String()
+	}
+	return out
+}
+
 // descriptionToDB maps the empty domain value to SQL NULL. The proto
 // contract says "Empty if not set", so an empty string is the canonical
 // "absent" value at the wire — and we mirror that to NULL on disk.
@@ -0,0 +1,25 @@
+-- Role includes (role_includes join table): the edges of the per-app
+-- composite-role DAG. Written alongside the role row inside the
+-- repository's transaction, exactly like role_permissions.
+
+-- name: GetRoleIncludes :many
+-- Returns a role's direct includes in a deterministic order, matching
+-- the canonical (sorted) order the domain keeps.
+SELECT included_role_id FROM role_includes WHERE role_id = ? ORDER BY included_role_id;
+
+-- name: InsertRoleInclude :exec
+INSERT INTO role_includes (role_id, included_role_id) VALUES (?, ?);
+
+-- name: DeleteRoleIncludes :exec
+-- Wipes a role's outgoing edges. UpdateRole with mask containing
+-- "included_role_ids" wipes then re-inserts inside a single tx.
+DELETE FROM role_includes WHERE role_id = ?;
+
+-- name: ListRoleIncludeEdgesByApp :many
+-- Every edge whose parent belongs to the app. Children are always in
+-- the same app (service-enforced), so this is the app's whole graph.
+SELECT ri.role_id, ri.included_role_id
+FROM role_includes ri
+JOIN roles r ON r.id = ri.role_id
+WHERE r.app_id = ?
+ORDER BY ri.role_id, ri.included_role_id;
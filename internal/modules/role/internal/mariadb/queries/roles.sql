@@ -18,11 +18,15 @@
 
 -- name: CreateRole :exec
 INSERT INTO roles
-    (id, app_id, name, description, status, etag, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+    (id, app_id, name, description, status, etag, created_at, updated_at, tenant_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetAppTenantID :one
+-- The tenant a new role inherits from its app.
+SELECT tenant_id FROM apps WHERE id = ?;
 
 -- name: GetRoleByID :one
-SELECT id, app_id, name, description, status, etag, created_at, updated_at
+SELECT id, app_id, name, description, status, etag, created_at, updated_at, tenant_id
 FROM roles
 WHERE id = ?;
 
@@ -56,11 +60,19 @@ SELECT COUNT(*) FROM roles WHERE id = ?;
 -- build a stable string slice without an extra in-memory sort.
 SELECT permission FROM role_permissions WHERE role_id = ? ORDER BY permission;
 
+-- name: GetRolePermissionConditions :many
+-- Returns the CEL conditions guarding a role's permissions. Unconditional
+-- permissions (empty condition_expr) are left out.
+SELECT permission, condition_expr FROM role_permissions
+WHERE role_id = ? AND condition_expr <> ''
+ORDER BY permission;
+
 -- name: InsertRolePermission :exec
--- Inserts a single (role_id, permission) row. Callers (CreateRole and
--- UpdateRole with mask containing "permissions") loop over the role's
+-- Inserts a single (role_id, permission) row with its condition (empty =
+-- none). Callers (CreateRole and UpdateRole with mask containing
+-- "permissions" or "permission_conditions") loop over the role's
 -- permission slice inside a transaction.
-INSERT INTO role_permissions (role_id, permission) VALUES (?, ?);
+INSERT INTO role_permissions (role_id, permission, condition_expr) VALUES (?, ?, ?);
 
 -- name: DeleteRolePermissions :exec
 -- Wipes all permissions for a role. Used by UpdateRole.permissions: the
@@ -4,8 +4,13 @@
 // One wrinkle compared to identity / app: the role aggregate carries a
 // permission set stored in a separate role_permissions table, so writes
 // (Create / Update) wrap the row INSERT/UPDATE and the permission rows
-// in a single transaction. Reads (GetByID) issue two queries — the row
-// plus the permission list — and the mapper assembles the aggregate.
+// in a single transaction. Reads (GetByID) issue four queries — the
+// row, the permission list, the permission conditions and the include
+// list — and the mapper assembles the aggregate. role_includes is handled exactly like
+// role_permissions.
+//
+// Roles belong to their app's tenant: Create stamps it, and a role
+// outside tenant.Scope reads as ErrRoleNotFound.
 //
 // Dynamic ListRoles lives in the sibling list.go file (sqlc cannot
 // template variable WHERE / ORDER BY economically).
@@ -16,10 +21,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 
 	"sso/internal/kernel/dbutil"
 	"sso/internal/kernel/etag"
+	"sso/internal/kernel/tenant"
 	"sso/internal/modules/role/internal/domain"
 	"sso/internal/modules/role/internal/mariadb/dbgen"
 )
@@ -49,17 +56,34 @@ var _ domain.Repository = (*Repository)(nil)
 
 func (r *Repository) Create(ctx context.Context, role *domain.Role) error {
 	return r.inTx(ctx, func(q *dbgen.Queries) error {
-		if err := q.CreateRole(ctx, toCreateParams(role)); err != nil {
+		tenantID, err := q.GetAppTenantID(ctx, role.AppID().String())
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrAppNotFound
+			}
+			return fmt.Errorf("role repo: create: app tenant: %w", err)
+		}
+		if !tenant.Visible(ctx, tenantID) {
+			return domain.ErrAppNotFound
+		}
+		params := toCreateParams(role)
+		params.TenantID = tenantID
+		if err := q.CreateRole(ctx, params); err != nil {
 			if dbutil.IsDuplicateEntry(err) {
 				return domain.ErrRoleAlreadyExists
 			}
 			return fmt.Errorf("role repo: create: %w", err)
 		}
 		for _, p := range role.Permissions() {
-			if err := q.InsertRolePermission(ctx, toInsertPermissionParams(role.ID(), p)); err != nil {
+			if err := q.InsertRolePermission(ctx, toInsertPermissionParams(role, p)); err != nil {
 				return fmt.Errorf("role repo: create: insert permission: %w", err)
 			}
 		}
+		for _, inc := range role.Includes() {
+			if err := q.InsertRoleInclude(ctx, toInsertIncludeParams(role.ID(), inc)); err != nil {
+				return fmt.Errorf("role repo: create: insert include: %w", err)
+			}
+		}
 		return nil
 	})
 }
@@ -76,6 +100,9 @@ func (r *Repository) GetByID(ctx context.Context, id domain.RoleID) (*domain.Rol
 		}
 		return nil, fmt.Errorf("role repo: get_by_id: %w", err)
 	}
+	if !tenant.Visible(ctx, row.TenantID) {
+		return nil, domain.ErrRoleNotFound
+	}
 
 	// GetRolePermissions is :many — an empty result is (empty slice, nil),
 	// not sql.ErrNoRows. A role with zero permissions is valid; we just
@@ -84,7 +111,15 @@ func (r *Repository) GetByID(ctx context.Context, id domain.RoleID) (*domain.Rol
 	if err != nil {
 		return nil, fmt.Errorf("role repo: get_by_id: permissions: %w", err)
 	}
-	return dbgenToDomain(row, perms), nil
+	conds, err := r.q.GetRolePermissionConditions(ctx, id.String())
+	if err != nil {
+		return nil, fmt.Errorf("role repo: get_by_id: conditions: %w", err)
+	}
+	includes, err := r.q.GetRoleIncludes(ctx, id.String())
+	if err != nil {
+		return nil, fmt.Errorf("role repo: get_by_id: includes: %w", err)
+	}
+	return dbgenToDomain(row, perms, conditionsFromDB(conds), includes), nil
 }
 
 // ----------------------------------------------------------------------------
@@ -98,7 +133,8 @@ func (r *Repository) GetByID(ctx context.Context, id domain.RoleID) (*domain.Rol
 // inside the same tx and only rewrite if it differs from
 // role.Permissions(). Both sides are sorted (the DB query has
 // ORDER BY permission, domain canonicalises in NewRole/ApplyPatch),
-// so the comparison is a direct slices.Equal.
+// so the comparison is a direct slices.Equal; a changed condition also
+// rewrites the set. Includes follow the same read-compare-rewrite path.
 
 func (r *Repository) Update(ctx context.Context, role *domain.Role, expectedEtag etag.Etag) error {
 	return r.inTx(ctx, func(q *dbgen.Queries) error {
@@ -133,22 +169,60 @@ func (r *Repository) Update(ctx context.Context, role *domain.Role, expectedEtag
 		if err != nil {
 			return fmt.Errorf("role repo: update: load permissions: %w", err)
 		}
+		existingConds, err := q.GetRolePermissionConditions(ctx, role.ID().String())
+		if err != nil {
+			return fmt.Errorf("role repo: update: load conditions: %w", err)
+		}
 		desired := role.Permissions()
-		if slices.Equal(existing, desired) {
+		if !slices.Equal(existing, desired) || !maps.Equal(conditionsFromDB(existingConds), role.Conditions()) {
+			if err := q.DeleteRolePermissions(ctx, role.ID().String()); err != nil {
+				return fmt.Errorf("role repo: update: clear permissions: %w", err)
+			}
+			for _, p := range desired {
+				if err := q.InsertRolePermission(ctx, toInsertPermissionParams(role, p)); err != nil {
+					return fmt.Errorf("role repo: update: insert permission: %w", err)
+				}
+			}
+		}
+
+		existingIncludes, err := q.GetRoleIncludes(ctx, role.ID().String())
+		if err != nil {
+			return fmt.Errorf("role repo: update: load includes: %w", err)
+		}
+		if slices.Equal(existingIncludes, includesToDB(role.Includes())) {
 			return nil
 		}
-		if err := q.DeleteRolePermissions(ctx, role.ID().String()); err != nil {
-			return fmt.Errorf("role repo: update: clear permissions: %w", err)
+		if err := q.DeleteRoleIncludes(ctx, role.ID().String()); err != nil {
+			return fmt.Errorf("role repo: update: clear includes: %w", err)
 		}
-		for _, p := range desired {
-			if err := q.InsertRolePermission(ctx, toInsertPermissionParams(role.ID(), p)); err != nil {
-				return fmt.Errorf("role repo: update: insert permission: %w", err)
+		for _, inc := range role.Includes() {
+			if err := q.InsertRoleInclude(ctx, toInsertIncludeParams(role.ID(), inc)); err != nil {
+				return fmt.Errorf("role repo: update: insert include: %w", err)
 			}
 		}
 		return nil
 	})
 }
 
+// ----------------------------------------------------------------------------
+// ListIncludeEdges
+// ----------------------------------------------------------------------------
+
+func (r *Repository) ListIncludeEdges(ctx context.Context, appID domain.AppID) ([]domain.IncludeEdge, error) {
+	rows, err := r.q.ListRoleIncludeEdgesByApp(ctx, appID.String())
+	if err != nil {
+		return nil, fmt.Errorf("role repo: list include edges: %w", err)
+	}
+	out := make([]domain.IncludeEdge, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, domain.IncludeEdge{
+			RoleID:         domain.RoleID(row.RoleID),
+			IncludedRoleID: domain.RoleID(row.IncludedRoleID),
+		})
+	}
+	return out, nil
+}
+
 // ----------------------------------------------------------------------------
 // Delete
 // ----------------------------------------------------------------------------
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"sso/internal/kernel/condition"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/role/internal/domain"
+)
+
+// checkConditions validates the conditions a role will carry: each
+// must guard a permission in perms and compile as a bool CEL
+// expression. Permissions are visited in order so the reported error
+// is stable. An empty expression clears the condition and is not
+// compiled.
+func checkConditions(perms []string, conds map[string]string) error {
+	if err := domain.CheckConditionTargets(perms, conds); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(conds))
+	for perm := range conds {
+		keys = append(keys, perm)
+	}
+	sort.Strings(keys)
+	for _, perm := range keys {
+		expr := conds[perm]
+		if expr == "" {
+			continue
+		}
+		if _, err := condition.Compile(expr); err != nil {
+			return &validation.Error{
+				Field:  fmt.Sprintf("permission_conditions[%s]", perm),
+				Reason: err.Error(),
+			}
+		}
+	}
+	return nil
+}
@@ -11,7 +11,8 @@ import (
 // CreateRoleInput is the parsed CreateRoleRequest. ParentAppID is the
 // proto's parent_app_id field; Name/Description/Permissions come off the
 // embedded Role message. Field-level validation (regex on permission
-// strings, length caps) is expected upstream from protovalidate.
+// strings, length caps) is expected upstream from protovalidate; the
+// app's permission catalog is checked here.
 type CreateRoleInput struct {
 	ParentAppID string
 	Name        string
@@ -24,7 +25,8 @@ type CreateRoleInput struct {
 //
 // Errors:
 //
-//	ValidationError      — parent_app_id not a valid UUID
+//	ValidationError      — parent_app_id not a valid UUID, or a
+//	                       permission the app's catalog does not allow
 //	ErrRoleAlreadyExists — name collides with an existing role in the app
 func (s *Service) CreateRole(ctx context.Context, in CreateRoleInput) (*domain.Role, error) {
 	a, err := actor.Require(ctx)
@@ -40,6 +42,16 @@ func (s *Service) CreateRole(ctx context.Context, in CreateRoleInput) (*domain.R
 		return nil, err
 	}
 
+	aud := audit.BaseFromActor(a, audit.EventTypeRoleCreateRole)
+	aud.SubjectType = audit.SubjectTypeApp
+	aud.SubjectID = appID.String()
+
+	if err := s.checkPermissions(ctx, appID, in.Permissions, nil); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+
 	r := domain.NewRole(domain.NewRoleParams{
 		ID:          id,
 		AppID:       appID,
@@ -49,10 +61,6 @@ func (s *Service) CreateRole(ctx context.Context, in CreateRoleInput) (*domain.R
 		Now:         s.now().UTC(),
 	})
 
-	aud := audit.BaseFromActor(a, audit.EventTypeRoleCreateRole)
-	aud.SubjectType = audit.SubjectTypeApp
-	aud.SubjectID = appID.String()
-
 	if err := s.repo.Create(ctx, r); err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
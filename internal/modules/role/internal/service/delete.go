@@ -69,6 +69,7 @@ func (s *Service) PermanentlyDeleteRole(ctx context.Context, in PermanentlyDelet
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return err
 	}
+	s.version.Bump(ctx)
 
 	s.auditor.Success(ctx, aud)
 	return nil
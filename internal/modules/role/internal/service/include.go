@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/role/internal/domain"
+)
+
+// parseIncludedRoleIDs validates the wire ids and returns them sorted
+// and de-duplicated, so a repeated id cannot trip the role_includes
+// primary key. An empty list is valid: it clears the include set.
+func parseIncludedRoleIDs(raw []string) ([]domain.RoleID, error) {
+	out := make([]domain.RoleID, 0, len(raw))
+	for _, s := range raw {
+		id, err := domain.ParseRoleID(s)
+		if err != nil {
+			return nil, &validation.Error{Field: "included_role_ids", Reason: "must contain valid UUIDs"}
+		}
+		out = append(out, id)
+	}
+	slices.Sort(out)
+	return slices.Compact(out), nil
+}
+
+// checkIncludes enforces the composite-role invariants before r's
+// include set is replaced by includes:
+//
+//   - every included role exists (unknown ids are a validation error,
+//     not ErrRoleNotFound, which would read as "r itself is missing");
+//   - every included role belongs to r's app (ErrRoleNotInApp);
+//   - the resulting graph stays acyclic (ErrRoleCycle), a self-include
+//     being the one-edge cycle.
+//
+// The check runs against a snapshot of the app's edges. Two concurrent
+// updates on different roles could still close a cycle between them;
+// access's evaluator tracks visited roles, so such a cycle cannot loop,
+// and the next UpdateRole on either role surfaces it.
+func (s *Service) checkIncludes(ctx context.Context, r *domain.Role, includes []domain.RoleID) error {
+	for _, id := range includes {
+		if id == r.ID() {
+			return domain.ErrRoleCycle
+		}
+		child, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, domain.ErrRoleNotFound) {
+				return &validation.Error{Field: "included_role_ids", Reason: "unknown role: " + id.String()}
+			}
+			return fmt.Errorf("role: check includes: %w", err)
+		}
+		if child.AppID() != r.AppID() {
+			return domain.ErrRoleNotInApp
+		}
+	}
+	if len(includes) == 0 {
+		return nil
+	}
+
+	edges, err := s.repo.ListIncludeEdges(ctx, r.AppID())
+	if err != nil {
+		return fmt.Errorf("role: check includes: %w", err)
+	}
+	graph := make(map[domain.RoleID][]domain.RoleID, len(edges))
+	for _, e := range edges {
+		if e.RoleID == r.ID() {
+			continue // replaced by includes below
+		}
+		graph[e.RoleID] = append(graph[e.RoleID], e.IncludedRoleID)
+	}
+	graph[r.ID()] = includes
+
+	if reaches(graph, includes, r.ID()) {
+		return domain.ErrRoleCycle
+	}
+	return nil
+}
+
+// reaches reports whether target is reachable from any of the start
+// nodes. Iterative DFS with a visited set, so pre-existing cycles
+// elsewhere in the graph cannot make it loop.
+func reaches(graph map[domain.RoleID][]domain.RoleID, start []domain.RoleID, target domain.RoleID) bool {
+	stack := append([]domain.RoleID(nil), start...)
+	visited := make(map[domain.RoleID]bool, len(graph))
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if n == target {
+			return true
+		}
+		if visited[n] {
+			continue
+		}
+		visited[n] = true
+		stack = append(stack, graph[n]...)
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/role/internal/domain"
+)
+
+const (
+	roleA       = "0190b6f2-8a43-7c1e-9d2a-00000000b101"
+	roleB       = "0190b6f2-8a43-7c1e-9d2a-00000000b102"
+	roleC       = "0190b6f2-8a43-7c1e-9d2a-00000000b103"
+	roleD       = "0190b6f2-8a43-7c1e-9d2a-00000000b104"
+	roleLoopX   = "0190b6f2-8a43-7c1e-9d2a-00000000b105"
+	roleLoopY   = "0190b6f2-8a43-7c1e-9d2a-00000000b106"
+	roleForeign = "0190b6f2-8a43-7c1e-9d2a-00000000b107"
+	roleMissing = "0190b6f2-8a43-7c1e-9d2a-00000000b1ff"
+)
+
+// includeWorld chains A → B → C, leaves D on its own, and has X and Y
+// already including each other, as a race between two updates could
+// leave them.
+func includeWorld() *world {
+	w := newWorld()
+	w.addRole(roleA, roleSpec{includes: []string{roleB}})
+	w.addRole(roleB, roleSpec{includes: []string{roleC}})
+	w.addRole(roleC, roleSpec{})
+	w.addRole(roleD, roleSpec{})
+	w.addRole(roleLoopX, roleSpec{includes: []string{roleLoopY}})
+	w.addRole(roleLoopY, roleSpec{includes: []string{roleLoopX}})
+	w.addRole(roleForeign, roleSpec{app: otherAppID})
+	return w
+}
+
+func TestUpdateRoleIncludes(t *testing.T) {
+	cases := []struct {
+		name     string
+		role     string
+		includes []string
+		want     error // nil = accepted
+		reason   string
+	}{
+		{"diamond", roleD, []string{roleA, roleC}, nil, ""},
+		{"reaching a cycle elsewhere", roleD, []string{roleLoopX}, nil, ""},
+		{"its own includes replaced", roleB, []string{roleD}, nil, ""},
+		{"cleared", roleA, []string{}, nil, ""},
+		{"itself", roleD, []string{roleD}, domain.ErrRoleCycle, audit.ReasonRoleIncludeCycle},
+		{"two-role cycle", roleC, []string{roleB}, domain.ErrRoleCycle, audit.ReasonRoleIncludeCycle},
+		{"three-role cycle", roleC, []string{roleD, roleA}, domain.ErrRoleCycle, audit.ReasonRoleIncludeCycle},
+		{"role of another app", roleD, []string{roleForeign}, domain.ErrRoleNotInApp, audit.ReasonRoleNotInApp},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := includeWorld()
+			before := slices.Clone(w.roles[domain.RoleID(tc.role)].Includes())
+			s, em := w.newService()
+			_, err := s.UpdateRole(asAdmin(), UpdateRoleInput{
+				RoleID: tc.role, MaskPaths: []string{"included_role_ids"}, ExpectedEtag: EtagWildcard,
+				IncludedRoleIDs: tc.includes,
+			})
+			ev := em.only(t)
+			got := w.roles[domain.RoleID(tc.role)].Includes()
+			if tc.want == nil {
+				if err != nil {
+					t.Fatalf("err = %v", err)
+				}
+				if len(got) != len(tc.includes) || ev.Outcome() != audit.OutcomeSuccess {
+					t.Fatalf("includes = %v, audited %v", got, ev.Outcome())
+				}
+				return
+			}
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("err = %v, want %v", err, tc.want)
+			}
+			if !slices.Equal(got, before) || w.bumps != 0 {
+				t.Fatalf("includes = %v after %d bumps, want %v", got, w.bumps, before)
+			}
+			if ev.Outcome() != audit.OutcomeFailure || ev.Reason() != tc.reason {
+				t.Fatalf("audit = %v %s, want %s", ev.Outcome(), ev.Reason(), tc.reason)
+			}
+		})
+	}
+}
+
+func TestUpdateRoleIncludesUnknownRole(t *testing.T) {
+	w := includeWorld()
+	s, _ := w.newService()
+	_, err := s.UpdateRole(asAdmin(), UpdateRoleInput{
+		RoleID: roleD, MaskPaths: []string{"included_role_ids"}, ExpectedEtag: EtagWildcard,
+		IncludedRoleIDs: []string{roleC, roleMissing},
+	})
+	var vErr *validation.Error
+	if !errors.As(err, &vErr) || vErr.Field != "included_role_ids" {
+		t.Fatalf("err = %v, want an included_role_ids validation error", err)
+	}
+	if errors.Is(err, domain.ErrRoleNotFound) {
+		t.Fatal("an unknown include reads as the role itself missing")
+	}
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"sso/internal/modules/permission"
+	"sso/internal/modules/role/internal/domain"
+)
+
+// checkPermissions validates perms against the app's permission
+// catalog. held is the role's current permission list (nil on create),
+// so a deprecated permission the role already has may stay while a
+// newly added one is rejected. An app without a catalog accepts any
+// well-formed permission, as before catalogs existed.
+func (s *Service) checkPermissions(ctx context.Context, appID domain.AppID, perms, held []string) error {
+	if len(perms) == 0 {
+		return nil
+	}
+	entries, err := s.catalog.List(ctx, permission.AppID(appID.String()))
+	if err != nil {
+		return fmt.Errorf("role: load permission catalog: %w", err)
+	}
+	return permission.NewCatalog(entries).CheckRolePermissions(perms, held)
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/role/internal/domain"
+)
+
+const roleHeld = "0190b6f2-8a43-7c1e-9d2a-00000000b001"
+
+// catalogWorld declares payments:read and payments:refund, and the
+// deprecated payments:void, which roleHeld still holds, and
+// payments:export.
+func catalogWorld() *world {
+	w := newWorld()
+	w.declare("payments:read", false)
+	w.declare("payments:refund", false)
+	w.declare("payments:void", true)
+	w.declare("payments:export", true)
+	w.addRole(roleHeld, roleSpec{perms: []string{"payments:read", "payments:void"}})
+	return w
+}
+
+func TestCreateRoleChecksCatalog(t *testing.T) {
+	cases := []struct {
+		name  string
+		perms []string
+		ok    bool
+	}{
+		{"catalog entries", []string{"payments:read", "payments:refund"}, true},
+		{"wildcard over a known resource", []string{"payments:*"}, true},
+		{"no permissions", nil, true},
+		{"unknown permission", []string{"payments:read", "payments:delete"}, false},
+		{"wildcard over an unknown resource", []string{"ledger:*"}, false},
+		{"deprecated permission", []string{"payments:void"}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := catalogWorld()
+			s, em := w.newService()
+			r, err := s.CreateRole(asAdmin(), CreateRoleInput{ParentAppID: appID, Name: "clerk", Permissions: tc.perms})
+			ev := em.only(t)
+			if tc.ok {
+				if err != nil {
+					t.Fatalf("err = %v", err)
+				}
+				if _, stored := w.roles[r.ID()]; !stored || ev.Outcome() != audit.OutcomeSuccess {
+					t.Fatalf("stored %v, audited %v", stored, ev.Outcome())
+				}
+				return
+			}
+			var vErr *validation.Error
+			if !errors.As(err, &vErr) || vErr.Field != "permissions" {
+				t.Fatalf("err = %v, want a permissions validation error", err)
+			}
+			if len(w.roles) != 1 {
+				t.Fatalf("stored %d roles, want only roleHeld", len(w.roles))
+			}
+			if ev.Outcome() != audit.OutcomeFailure || ev.Reason() != audit.ReasonValidationFailed {
+				t.Fatalf("audit = %v %s", ev.Outcome(), ev.Reason())
+			}
+		})
+	}
+}
+
+func TestCreateRoleWithoutCatalog(t *testing.T) {
+	w := newWorld()
+	s, _ := w.newService()
+	if _, err := s.CreateRole(asAdmin(), CreateRoleInput{
+		ParentAppID: appID, Name: "clerk", Permissions: []string{"anything:goes", "ledger:*"},
+	}); err != nil {
+		t.Fatalf("an app without a catalog rejected a role: %v", err)
+	}
+}
+
+// TestUpdateRoleKeepsDeprecated checks that a role may keep a
+// deprecated permission it holds but not take on another one.
+func TestUpdateRoleKeepsDeprecated(t *testing.T) {
+	cases := []struct {
+		name  string
+		perms []string
+		ok    bool
+	}{
+		{"keeps the deprecated one", []string{"payments:refund", "payments:void"}, true},
+		{"drops it", []string{"payments:read"}, true},
+		{"adds another deprecated one", []string{"payments:void", "payments:export"}, false},
+		{"adds an unknown one", []string{"payments:void", "payments:delete"}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := catalogWorld()
+			s, _ := w.newService()
+			_, err := s.UpdateRole(asAdmin(), UpdateRoleInput{
+				RoleID: roleHeld, MaskPaths: []string{"permissions"}, ExpectedEtag: EtagWildcard, Permissions: tc.perms,
+			})
+			held := w.roles[domain.RoleID(roleHeld)].Permissions()
+			if tc.ok {
+				if err != nil {
+					t.Fatalf("err = %v", err)
+				}
+				if len(held) != len(tc.perms) || w.bumps != 1 {
+					t.Fatalf("held %v after %d bumps, want %v", held, w.bumps, tc.perms)
+				}
+				return
+			}
+			var vErr *validation.Error
+			if !errors.As(err, &vErr) || vErr.Field != "permissions" {
+				t.Fatalf("err = %v, want a permissions validation error", err)
+			}
+			if len(held) != 2 || w.bumps != 0 {
+				t.Fatalf("held %v after %d bumps, want it unchanged", held, w.bumps)
+			}
+		})
+	}
+}
@@ -3,11 +3,14 @@
 // sso.roles.v1.RolesService, grouped across files (mirrors identity /
 // app):
 //
-//	service.go — Service struct + helpers shared by multiple use-cases
-//	create.go  — CreateRole
-//	get.go     — GetRole, ListRoles (and the page-cursor codec)
-//	update.go  — UpdateRole, DisableRole, EnableRole, buildPatch
-//	delete.go  — PermanentlyDeleteRole
+//	service.go     — Service struct + helpers shared by multiple use-cases
+//	create.go      — CreateRole
+//	get.go         — GetRole, ListRoles (and the page-cursor codec)
+//	update.go      — UpdateRole, DisableRole, EnableRole, buildPatch
+//	include.go     — include-set validation and cycle detection for UpdateRole
+//	permissions.go — permission-catalog check for CreateRole / UpdateRole
+//	conditions.go  — CEL condition validation for UpdateRole
+//	delete.go      — PermanentlyDeleteRole
 //
 // Importers should alias as `rolesvc` (or whatever fits the call site)
 // to avoid confusion with the `role` domain package.
@@ -19,23 +22,35 @@ import (
 	"log/slog"
 	"time"
 
+	"sso/internal/kernel/authzver"
 	"sso/internal/modules/audit"
 	"sso/internal/modules/audit/auditx"
 	"sso/internal/kernel/actor"
+	"sso/internal/modules/permission"
 	"sso/internal/modules/role/internal/domain"
 )
 
 // Service exposes the role use-cases. now is injected for testability;
-// production wiring uses time.Now (see bootstrap).
+// production wiring uses time.Now (see bootstrap). version is bumped
+// after every write that changes what a role grants.
 type Service struct {
 	repo    domain.Repository
+	catalog permission.CatalogReader
 	now     func() time.Time
 	auditor auditx.Auditor
+	version authzver.Bumper
 }
 
-// NewService constructs the service. now must not be nil.
-func NewService(log *slog.Logger, repo domain.Repository, now func() time.Time, emitter audit.Emitter) *Service {
-	return &Service{repo: repo, now: now, auditor: auditx.New(log, emitter)}
+// NewService constructs the service. now and version must not be nil.
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	catalog permission.CatalogReader,
+	now func() time.Time,
+	emitter audit.Emitter,
+	version authzver.Bumper,
+) *Service {
+	return &Service{repo: repo, catalog: catalog, now: now, auditor: auditx.New(log, emitter), version: version}
 }
 
 // EtagWildcard re-exports auditx.EtagWildcard so existing call sites
@@ -52,6 +67,9 @@ var errReasonMap = map[error]auditx.OutcomeReason{
 	domain.ErrRoleNotFound:      auditx.Fail(audit.ReasonRoleNotFound),
 	domain.ErrRoleAlreadyExists: auditx.Fail(audit.ReasonRoleAlreadyExists),
 	domain.ErrEtagMismatch:      auditx.Fail(audit.ReasonEtagMismatch),
+	domain.ErrRoleNotInApp:      auditx.Fail(audit.ReasonRoleNotInApp),
+	domain.ErrRoleCycle:         auditx.Fail(audit.ReasonRoleIncludeCycle),
+	domain.ErrAppNotFound:       auditx.Fail(audit.ReasonAppNotFound),
 }
 
 // classifyError is the per-package thin wrapper around auditx.Classify.
@@ -104,6 +122,7 @@ func (s *Service) lifecycleTransition(
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return err
 	}
+	s.version.Bump(ctx)
 
 	s.auditor.Success(ctx, aud)
 	return nil
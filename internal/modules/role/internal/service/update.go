@@ -19,6 +19,14 @@ import (
 // Allowed mask paths (per proto): name, description, permissions
 // Forbidden: role_id, app_id, status, etag, created_at, updated_at —
 // rejected by buildPatch as "unknown".
+//
+// included_role_ids is an extension path the proto Role message cannot
+// carry; only the HTTP includes surface sets it (the gRPC handler
+// rejects it). IncludedRoleIDs replaces the role's include set whole.
+//
+// permission_conditions is HTTP-only for the same reason.
+// PermissionConditions replaces the role's conditions whole, keyed by
+// permission; an empty expression makes that permission unconditional.
 type UpdateRoleInput struct {
 	RoleID       string
 	MaskPaths    []string
@@ -27,6 +35,9 @@ type UpdateRoleInput struct {
 	Name        string
 	Description string
 	Permissions []string
+
+	IncludedRoleIDs      []string
+	PermissionConditions map[string]string
 }
 
 func (s *Service) UpdateRole(ctx context.Context, in UpdateRoleInput) (*domain.Role, error) {
@@ -67,12 +78,41 @@ func (s *Service) UpdateRole(ctx context.Context, in UpdateRoleInput) (*domain.R
 		return nil, err
 	}
 
+	if patch.Permissions != nil {
+		if err := s.checkPermissions(ctx, r.AppID(), *patch.Permissions, r.Permissions()); err != nil {
+			out, reason := classifyError(err)
+			s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+			return nil, err
+		}
+	}
+
+	if patch.Conditions != nil {
+		perms := r.Permissions()
+		if patch.Permissions != nil {
+			perms = *patch.Permissions
+		}
+		if err := checkConditions(perms, *patch.Conditions); err != nil {
+			out, reason := classifyError(err)
+			s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+			return nil, err
+		}
+	}
+
+	if patch.Includes != nil {
+		if err := s.checkIncludes(ctx, r, *patch.Includes); err != nil {
+			out, reason := classifyError(err)
+			s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+			return nil, err
+		}
+	}
+
 	r.ApplyPatch(patch, s.now().UTC())
 	if err := s.repo.Update(ctx, r, expectedEtag); err != nil {
 		out, reason := classifyError(err)
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return nil, err
 	}
+	s.version.Bump(ctx)
 
 	s.auditor.Success(ctx, aud)
 	return r, nil
@@ -91,6 +131,18 @@ func buildPatch(in UpdateRoleInput) (domain.RolePatch, error) {
 		case "permissions":
 			v := in.Permissions
 			p.Permissions = &v
+		case "included_role_ids":
+			v, err := parseIncludedRoleIDs(in.IncludedRoleIDs)
+			if err != nil {
+				return domain.RolePatch{}, err
+			}
+			p.Includes = &v
+		case "permission_conditions":
+			v := in.PermissionConditions
+			if v == nil {
+				v = map[string]string{}
+			}
+			p.Conditions = &v
 		default:
 			return domain.RolePatch{}, &validation.Error{
 				Field:  "update_mask",
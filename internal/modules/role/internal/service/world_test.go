@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/permission"
+	"sso/internal/modules/role/internal/domain"
+)
+
+// Fixed ids of the test world. UUIDs, as the service parses them.
+const (
+	appID      = "0190b6f2-8a43-7c1e-9d2a-00000000a001"
+	otherAppID = "0190b6f2-8a43-7c1e-9d2a-00000000a002"
+	adminID    = "0190b6f2-8a43-7c1e-9d2a-00000000f001"
+)
+
+var t0 = time.Date(2026, time.May, 4, 12, 0, 0, 0, time.UTC)
+
+// world is the in-memory state behind the fakes: the roles the service
+// reads and writes, and each app's permission catalog. Each fake
+// implements only what the service calls; the rest panics through the
+// nil embedded interface.
+type world struct {
+	roles    map[domain.RoleID]*domain.Role
+	catalogs map[permission.AppID][]*permission.Permission
+	bumps    int
+}
+
+func newWorld() *world {
+	return &world{
+		roles:    map[domain.RoleID]*domain.Role{},
+		catalogs: map[permission.AppID][]*permission.Permission{},
+	}
+}
+
+// roleSpec describes a stored role of the test app.
+type roleSpec struct {
+	app      string
+	perms    []string
+	includes []string
+}
+
+func (w *world) addRole(id string, spec roleSpec) {
+	if spec.app == "" {
+		spec.app = appID
+	}
+	includes := make([]domain.RoleID, len(spec.includes))
+	for i, inc := range spec.includes {
+		includes[i] = domain.RoleID(inc)
+	}
+	w.roles[domain.RoleID(id)] = domain.RestoreRole(domain.RestoreRoleParams{
+		ID:          domain.RoleID(id),
+		AppID:       domain.AppID(spec.app),
+		Name:        id,
+		Permissions: spec.perms,
+		Includes:    includes,
+		Status:      domain.RoleStatusActive,
+		Etag:        etag.New(),
+	})
+}
+
+// declare adds an entry to the test app's catalog.
+func (w *world) declare(name string, deprecated bool) {
+	w.catalogs[appID] = append(w.catalogs[appID], permission.RestorePermission(permission.RestorePermissionParams{
+		AppID: appID, Name: name, Deprecated: deprecated,
+	}))
+}
+
+// newService wires a Service over the world and returns the events it
+// audits.
+func (w *world) newService() (*Service, *recordingEmitter) {
+	em := &recordingEmitter{}
+	s := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)),
+		worldRepo{w: w}, worldCatalog{w: w}, func() time.Time { return t0 }, em, worldVersion{w: w})
+	return s, em
+}
+
+// asAdmin is a context carrying the admin as the calling actor.
+func asAdmin() context.Context {
+	return actor.Inject(context.Background(), actor.Actor{ID: adminID, Kind: actor.KindUser})
+}
+
+type recordingEmitter struct {
+	mu     sync.Mutex
+	events []*audit.Audit
+}
+
+func (e *recordingEmitter) Emit(_ context.Context, a *audit.Audit) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, a)
+	return nil
+}
+
+// only returns the single event recorded, failing the test otherwise.
+func (e *recordingEmitter) only(t *testing.T) *audit.Audit {
+	t.Helper()
+	if len(e.events) != 1 {
+		t.Fatalf("audited %d events, want 1", len(e.events))
+	}
+	return e.events[0]
+}
+
+// ----------------------------------------------------------------------------
+// Fakes
+// ----------------------------------------------------------------------------
+
+type worldRepo struct {
+	domain.Repository
+	w *world
+}
+
+func (r worldRepo) Create(_ context.Context, ro *domain.Role) error {
+	r.w.roles[ro.ID()] = ro
+	return nil
+}
+
+// GetByID hands out a copy, so a use-case that fails after mutating its
+// role leaves the stored one as it was.
+func (r worldRepo) GetByID(_ context.Context, id domain.RoleID) (*domain.Role, error) {
+	ro, ok := r.w.roles[id]
+	if !ok {
+		return nil, domain.ErrRoleNotFound
+	}
+	return domain.RestoreRole(domain.RestoreRoleParams{
+		ID:          ro.ID(),
+		AppID:       ro.AppID(),
+		Name:        ro.Name,
+		Description: ro.Description,
+		Permissions: slices.Clone(ro.Permissions()),
+		Conditions:  ro.Conditions(),
+		Includes:    slices.Clone(ro.Includes()),
+		Status:      ro.Status(),
+		Etag:        ro.Etag(),
+		CreatedAt:   ro.CreatedAt(),
+		UpdatedAt:   ro.UpdatedAt(),
+	}), nil
+}
+
+func (r worldRepo) Update(_ context.Context, ro *domain.Role, _ etag.Etag) error {
+	r.w.roles[ro.ID()] = ro
+	return nil
+}
+
+func (r worldRepo) ListIncludeEdges(_ context.Context, app domain.AppID) ([]domain.IncludeEdge, error) {
+	var out []domain.IncludeEdge
+	for _, ro := range r.w.roles {
+		if ro.AppID() != app {
+			continue
+		}
+		for _, inc := range ro.Includes() {
+			out = append(out, domain.IncludeEdge{RoleID: ro.ID(), IncludedRoleID: inc})
+		}
+	}
+	return out, nil
+}
+
+type worldCatalog struct{ w *world }
+
+func (c worldCatalog) List(_ context.Context, app permission.AppID) ([]*permission.Permission, error) {
+	return c.w.catalogs[app], nil
+}
+
+type worldVersion struct{ w *world }
+
+func (v worldVersion) Bump(context.Context) { v.w.bumps++ }
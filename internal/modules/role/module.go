@@ -3,6 +3,7 @@
 // else off it:
 //
 //	mod.RegisterServer(grpcServer)  // attaches the RolesService handler
+//	mod.HTTPRoutes(authn)           // /v1/roles/{id}/includes, /conditions
 //	mod.Repository()                // full persistence contract for access
 //	mod.Service()                   // full admin Service (rarely needed)
 //
@@ -16,10 +17,14 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"time"
 
+	"sso/internal/kernel/authzver"
 	"sso/internal/modules/audit"
+	"sso/internal/modules/permission"
 	grpcadapter "sso/internal/modules/role/internal/grpc"
+	"sso/internal/modules/role/internal/httpapi"
 	"sso/internal/modules/role/internal/mariadb"
 	"sso/internal/modules/role/internal/service"
 
@@ -36,15 +41,21 @@ type Emitter = audit.Emitter
 
 // Deps lists everything role needs from its host.
 //
-// DB     — connection pool, owned upstream (bootstrap closes it).
-// Log    — structured logger; required.
-// Clock  — optional; defaults to time.Now when nil.
-// Audit  — optional; defaults to audit.NopEmitter when nil.
+// DB          — connection pool, owned upstream (bootstrap closes it).
+// Log         — structured logger; required.
+// Permissions — the apps' permission catalogs; required.
+// Clock       — optional; defaults to time.Now when nil.
+// Audit       — optional; defaults to audit.NopEmitter when nil.
+// AuthzVersion — optional; bumped after role edits, status changes and
+//
+//	deletes, which change permission checks. Defaults to authzver.Nop.
 type Deps struct {
-	DB    *sql.DB
-	Log   *slog.Logger
-	Clock func() time.Time
-	Audit Emitter
+	DB           *sql.DB
+	Log          *slog.Logger
+	Permissions  permission.CatalogReader
+	Clock        func() time.Time
+	Audit        Emitter
+	AuthzVersion authzver.Bumper
 }
 
 // Module is the assembled role bounded context. Construct with New;
@@ -53,6 +64,7 @@ type Module struct {
 	service *service.Service
 	handler *grpcadapter.Handler
 	repo    *mariadb.Repository
+	log     *slog.Logger
 }
 
 // New wires the module from its dependencies.
@@ -63,12 +75,18 @@ func New(d Deps) (*Module, error) {
 	if d.Log == nil {
 		return nil, fmt.Errorf("role: log is required")
 	}
+	if d.Permissions == nil {
+		return nil, fmt.Errorf("role: permission catalog reader is required")
+	}
 	if d.Clock == nil {
 		d.Clock = time.Now
 	}
 	if d.Audit == nil {
 		d.Audit = audit.NopEmitter{}
 	}
+	if d.AuthzVersion == nil {
+		d.AuthzVersion = authzver.Nop{}
+	}
 
 	repo := mariadb.NewRepository(d.DB)
 
@@ -77,13 +95,14 @@ func New(d Deps) (*Module, error) {
 	// the build breaks here.
 	var _ Repository = repo
 
-	svc := service.NewService(d.Log, repo, d.Clock, d.Audit)
+	svc := service.NewService(d.Log, repo, d.Permissions, d.Clock, d.Audit, d.AuthzVersion)
 	h := grpcadapter.NewHandler(svc, d.Log)
 
 	return &Module{
 		service: svc,
 		handler: h,
 		repo:    repo,
+		log:     d.Log,
 	}, nil
 }
 
@@ -93,6 +112,15 @@ func (m *Module) RegisterServer(s *grpc.Server) {
 	m.handler.RegisterServer(s)
 }
 
+// HTTPRoutes returns the registrar for the composite-role include
+// endpoints. The authenticator and authorizer are taken here rather
+// than in Deps because bootstrap builds them (grpcauth.Interceptor,
+// httprbac.Authorizer) after this module.
+func (m *Module) HTTPRoutes(authn Authenticator, authz Authorizer) func(*http.ServeMux) {
+	h := httpapi.NewHandler(m.service, authn, authz, m.log)
+	return h.Register
+}
+
 // Service returns the application-layer Service.
 func (m *Module) Service() *service.Service { return m.service }
 
@@ -16,6 +16,7 @@ import (
 	"sso/internal/modules/app"
 	"sso/internal/kernel/etag"
 	"sso/internal/modules/role/internal/domain"
+	"sso/internal/modules/role/internal/httpapi"
 )
 
 type (
@@ -29,8 +30,14 @@ type (
 	ListQuery         = domain.ListQuery
 	ListResult        = domain.ListResult
 	PageCursor        = domain.PageCursor
+	IncludeEdge       = domain.IncludeEdge
 	Etag              = etag.Etag
 
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+
 	// AppID is a cross-context handle to app.AppID, re-exported here so
 	// access's use-case layer can write role.AppID instead of pulling
 	// the app package in just for the type.
@@ -74,6 +81,8 @@ var (
 	ErrRoleDisabled       = domain.ErrRoleDisabled
 	ErrRoleNotInApp       = domain.ErrRoleNotInApp
 	ErrRoleHasAssignments = domain.ErrRoleHasAssignments
+	ErrRoleCycle          = domain.ErrRoleCycle
+	ErrAppNotFound        = domain.ErrAppNotFound
 )
 
 // ----------------------------------------------------------------------------
@@ -0,0 +1,30 @@
+package domain
+
+import "errors"
+
+var (
+	ErrServiceProviderNotFound      = errors.New("saml: service provider not found")
+	ErrServiceProviderAlreadyExists = errors.New("saml: service provider already exists")
+	ErrEtagMismatch                 = errors.New("saml: etag mismatch")
+
+	// ErrInvalidRequest — an inbound AuthnRequest / LogoutRequest /
+	// LogoutResponse could not be decoded, names an unknown issuer,
+	// carries a bad signature or an ACS URL other than the registered
+	// one. Fused into one sentinel: the detail goes to the log, the
+	// browser only learns that the request was refused.
+	ErrInvalidRequest = errors.New("saml: invalid request")
+
+	// ErrUserActorRequired — SSO asserts the identity of the signed-in
+	// user; a service-account token has nothing to assert.
+	ErrUserActorRequired = errors.New("saml: user actor required")
+
+	// ErrAppUnavailable — the SP's app is disabled or in maintenance.
+	ErrAppUnavailable = errors.New("saml: app unavailable")
+
+	// ErrUserInactive — the signed-in user is blocked or deleted; the
+	// session outlived the status change, the assertion must not.
+	ErrUserInactive = errors.New("saml: user is not active")
+
+	// ErrParticipantNotFound — the session was never asserted to the SP.
+	ErrParticipantNotFound = errors.New("saml: participant not found")
+)
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// Participant records that an assertion for SessionID was issued to the
+// SP of AppID. Single logout walks the participants of the session being
+// ended. SessionIndex is what the assertion's AuthnStatement carried: a
+// random value per (session, SP), never the session id. NameID is
+// repeated in the LogoutRequest sent to the SP.
+type Participant struct {
+	SessionID    SessionID
+	AppID        AppID
+	NameID       string
+	SessionIndex string
+	CreatedAt    time.Time
+}
+
+// LogoutState is one single-logout round in progress. The IdP sends a
+// LogoutRequest to each participating SP in turn; the opaque RelayState
+// it attaches is the key of this row, and the SP's LogoutResponse brings
+// it back so the round can continue.
+//
+// Origin* are set when an SP started the logout: the round ends with a
+// LogoutResponse to that SP instead of a redirect to the post-logout
+// page. Only the SHA-256 of the relay value is stored.
+type LogoutState struct {
+	StateHash        []byte
+	SessionID        SessionID
+	OriginAppID      AppID
+	OriginRequestID  string
+	OriginRelayState string
+	CreatedAt        time.Time
+	ExpiresAt        time.Time
+}
+
+func (s *LogoutState) IsExpired(now time.Time) bool { return !now.Before(s.ExpiresAt) }
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+
+	"sso/internal/kernel/etag"
+)
+
+// Repository is the persistence contract for the saml context.
+//
+// Error contract:
+//
+//	CreateServiceProvider        → ErrServiceProviderAlreadyExists (app or entity id)
+//	GetServiceProvider*          → ErrServiceProviderNotFound
+//	UpdateServiceProvider        → ErrServiceProviderNotFound / ErrEtagMismatch /
+//	                               ErrServiceProviderAlreadyExists (entity id)
+//	DeleteServiceProvider        → ErrServiceProviderNotFound / ErrEtagMismatch
+//	GetParticipant* / DeleteParticipant → ErrParticipantNotFound
+//	ConsumeLogoutState           → ErrInvalidRequest (unknown or already consumed)
+//
+// expectedEtag "" means unconditional, same as every other module.
+type Repository interface {
+	CreateServiceProvider(ctx context.Context, sp *ServiceProvider) error
+	GetServiceProviderByAppID(ctx context.Context, appID AppID) (*ServiceProvider, error)
+	GetServiceProviderByEntityID(ctx context.Context, entityID string) (*ServiceProvider, error)
+	ListServiceProviders(ctx context.Context) ([]*ServiceProvider, error)
+	UpdateServiceProvider(ctx context.Context, sp *ServiceProvider, expectedEtag etag.Etag) error
+	DeleteServiceProvider(ctx context.Context, appID AppID, expectedEtag etag.Etag) error
+
+	// UpsertParticipant records (or refreshes) the session's
+	// participation in the SP.
+	UpsertParticipant(ctx context.Context, p *Participant) error
+	GetParticipant(ctx context.Context, sessionID SessionID, appID AppID) (*Participant, error)
+	// GetParticipantBySessionIndex resolves the SessionIndex an SP
+	// names in its LogoutRequest.
+	GetParticipantBySessionIndex(ctx context.Context, appID AppID, sessionIndex string) (*Participant, error)
+	ListParticipants(ctx context.Context, sessionID SessionID) ([]*Participant, error)
+	DeleteParticipant(ctx context.Context, sessionID SessionID, appID AppID) error
+
+	// CreateLogoutState stores a fresh state row. Expired rows are
+	// pruned opportunistically by the same call.
+	CreateLogoutState(ctx context.Context, s *LogoutState) error
+	// ConsumeLogoutState atomically reads and deletes the state row.
+	ConsumeLogoutState(ctx context.Context, stateHash []byte) (*LogoutState, error)
+}
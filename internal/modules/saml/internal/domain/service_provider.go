@@ -0,0 +1,231 @@
+// Package domain holds the aggregates of the saml bounded context: the
+// per-app ServiceProvider registration, the Participant row recording
+// that a session was asserted to an SP, and the LogoutState that carries
+// a single-logout round across SP redirects.
+//
+// The package knows nothing about SAML wire formats — platform/saml
+// builds and parses the XML.
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/validation"
+	"sso/internal/platform/saml"
+)
+
+// AppID is a cross-context handle to app.App; an SP registration is
+// keyed by its app.
+type AppID string
+
+func (id AppID) String() string { return string(id) }
+
+// SessionID is a cross-context handle to session.Session.
+type SessionID string
+
+func (id SessionID) String() string { return string(id) }
+
+// ----------------------------------------------------------------------------
+// NameIDFormat
+// ----------------------------------------------------------------------------
+
+// NameIDFormat is the on-wire value of saml_service_providers.name_id_format
+// — do not renumber.
+type NameIDFormat uint8
+
+const (
+	NameIDFormatEmail      NameIDFormat = 1 // users.email
+	NameIDFormatPersistent NameIDFormat = 2 // users.id
+)
+
+func ParseNameIDFormat(s string) (NameIDFormat, error) {
+	switch s {
+	case "", "email":
+		return NameIDFormatEmail, nil
+	case "persistent":
+		return NameIDFormatPersistent, nil
+	}
+	return 0, &validation.Error{Field: "name_id_format", Reason: "must be one of: email, persistent"}
+}
+
+func (f NameIDFormat) String() string {
+	switch f {
+	case NameIDFormatEmail:
+		return "email"
+	case NameIDFormatPersistent:
+		return "persistent"
+	}
+	return fmt.Sprintf("NameIDFormat(%d)", f)
+}
+
+// URI is the SAML NameID Format attribute value.
+func (f NameIDFormat) URI() string {
+	if f == NameIDFormatPersistent {
+		return saml.NameIDFormatPersistent
+	}
+	return saml.NameIDFormatEmail
+}
+
+// ----------------------------------------------------------------------------
+// ServiceProvider aggregate
+// ----------------------------------------------------------------------------
+//
+// One registration per app. The app id and bookkeeping fields are
+// unexported; the SP settings are plain data changed through ApplyPatch
+// so the etag always advances with them.
+
+type ServiceProvider struct {
+	appID     AppID
+	etag      etag.Etag
+	createdAt time.Time
+	updatedAt time.Time
+
+	EntityID string
+	ACSURL   string
+	// SLOURL is the SP's HTTP-Redirect SingleLogoutService; empty means
+	// the SP does not take part in single logout. LogoutRequests from the
+	// SP are accepted only when Certificate is set as well.
+	SLOURL string
+	// Certificate is the SP's PEM signing certificate. When set, every
+	// request from the SP must carry a valid redirect-binding signature.
+	Certificate  string
+	NameIDFormat NameIDFormat
+}
+
+type NewServiceProviderParams struct {
+	AppID        AppID
+	EntityID     string
+	ACSURL       string
+	SLOURL       string
+	Certificate  string
+	NameIDFormat NameIDFormat
+	Now          time.Time
+}
+
+func NewServiceProvider(p NewServiceProviderParams) (*ServiceProvider, error) {
+	sp := &ServiceProvider{
+		appID:        p.AppID,
+		etag:         etag.New(),
+		createdAt:    p.Now,
+		updatedAt:    p.Now,
+		EntityID:     p.EntityID,
+		ACSURL:       p.ACSURL,
+		SLOURL:       p.SLOURL,
+		Certificate:  p.Certificate,
+		NameIDFormat: p.NameIDFormat,
+	}
+	if err := sp.validate(); err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+type RestoreServiceProviderParams struct {
+	AppID        AppID
+	EntityID     string
+	ACSURL       string
+	SLOURL       string
+	Certificate  string
+	NameIDFormat NameIDFormat
+	Etag         etag.Etag
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// RestoreServiceProvider rebuilds a ServiceProvider from a trusted row.
+func RestoreServiceProvider(p RestoreServiceProviderParams) *ServiceProvider {
+	return &ServiceProvider{
+		appID:        p.AppID,
+		etag:         p.Etag,
+		createdAt:    p.CreatedAt,
+		updatedAt:    p.UpdatedAt,
+		EntityID:     p.EntityID,
+		ACSURL:       p.ACSURL,
+		SLOURL:       p.SLOURL,
+		Certificate:  p.Certificate,
+		NameIDFormat: p.NameIDFormat,
+	}
+}
+
+func (sp *ServiceProvider) AppID() AppID         { return sp.appID }
+func (sp *ServiceProvider) Etag() etag.Etag      { return sp.etag }
+func (sp *ServiceProvider) CreatedAt() time.Time { return sp.createdAt }
+func (sp *ServiceProvider) UpdatedAt() time.Time { return sp.updatedAt }
+
+// ServiceProviderPatch — nil pointer = "leave unchanged".
+type ServiceProviderPatch struct {
+	EntityID     *string
+	ACSURL       *string
+	SLOURL       *string
+	Certificate  *string
+	NameIDFormat *NameIDFormat
+}
+
+// ApplyPatch applies the supplied changes and re-validates. The etag
+// advances only when something actually changed.
+func (sp *ServiceProvider) ApplyPatch(patch ServiceProviderPatch, now time.Time) error {
+	next := *sp
+	if patch.EntityID != nil {
+		next.EntityID = *patch.EntityID
+	}
+	if patch.ACSURL != nil {
+		next.ACSURL = *patch.ACSURL
+	}
+	if patch.SLOURL != nil {
+		next.SLOURL = *patch.SLOURL
+	}
+	if patch.Certificate != nil {
+		next.Certificate = *patch.Certificate
+	}
+	if patch.NameIDFormat != nil {
+		next.NameIDFormat = *patch.NameIDFormat
+	}
+	if err := next.validate(); err != nil {
+		return err
+	}
+	if next.EntityID == sp.EntityID && next.ACSURL == sp.ACSURL && next.SLOURL == sp.SLOURL &&
+		next.Certificate == sp.Certificate && next.NameIDFormat == sp.NameIDFormat {
+		return nil
+	}
+	*sp = next
+	sp.updatedAt = now
+	sp.etag = etag.New()
+	return nil
+}
+
+func (sp *ServiceProvider) validate() error {
+	if sp.EntityID == "" || len(sp.EntityID) > 1024 {
+		return &validation.Error{Field: "entity_id", Reason: "required, at most 1024 characters"}
+	}
+	if err := validateEndpoint("acs_url", sp.ACSURL); err != nil {
+		return err
+	}
+	if sp.SLOURL != "" {
+		if err := validateEndpoint("slo_url", sp.SLOURL); err != nil {
+			return err
+		}
+	}
+	if sp.Certificate != "" {
+		if _, err := saml.ParseCertificate(sp.Certificate); err != nil {
+			return &validation.Error{Field: "certificate", Reason: "must be a PEM or base64 DER X.509 certificate"}
+		}
+	}
+	if sp.NameIDFormat != NameIDFormatEmail && sp.NameIDFormat != NameIDFormatPersistent {
+		return &validation.Error{Field: "name_id_format", Reason: "must be one of: email, persistent"}
+	}
+	return nil
+}
+
+// validateEndpoint requires an absolute http(s) URL without fragment.
+// The assertion is posted there by the browser, so plain http is the
+// SP operator's call, not ours.
+func validateEndpoint(field, s string) error {
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" || u.Fragment != "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return &validation.Error{Field: field, Reason: "must be an absolute http(s) URL without fragment"}
+	}
+	return nil
+}
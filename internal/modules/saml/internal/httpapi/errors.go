@@ -0,0 +1,41 @@
+package httpapi
+
+import (
+	"sso/internal/modules/app"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/saml/internal/domain"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap translates saml sentinels into statuses. errors.proto has no
+// saml reasons yet, so those entries are bare statuses (Reason
+// UNSPECIFIED).
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrServiceProviderNotFound: {
+		Code: codes.NotFound, Message: "service provider not found"},
+	domain.ErrServiceProviderAlreadyExists: {
+		Code: codes.AlreadyExists, Message: "service provider already exists"},
+	domain.ErrEtagMismatch: {
+		Code: codes.Aborted, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ETAG_MISMATCH, Message: "etag mismatch"},
+	domain.ErrInvalidRequest: {
+		Code: codes.InvalidArgument, Message: "invalid SAML message"},
+	domain.ErrUserActorRequired: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "a user token is required"},
+	domain.ErrUserInactive: {
+		Code: codes.FailedPrecondition, Message: "user is not active"},
+	domain.ErrAppUnavailable: {
+		Code: codes.FailedPrecondition, Message: "app is disabled or in maintenance"},
+	domain.ErrParticipantNotFound: {
+		Code: codes.NotFound, Message: "session was not asserted to this service provider"},
+	app.ErrAppNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_APP_NOT_FOUND, Message: "app not found"},
+	identity.ErrUserNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_NOT_FOUND, Message: "user not found"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
@@ -0,0 +1,343 @@
+// Package httpapi is the HTTP adapter for the saml context.
+//
+// SAML bindings are browser-driven — redirects carrying deflated
+// messages and auto-submitted POST forms — so these endpoints are
+// hand-written net/http handlers mounted next to the grpc-gateway, like
+// federation's. Error bodies use the same google.rpc.Status JSON shape
+// as the gateway.
+package httpapi
+
+import (
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"sso/internal/modules/saml/internal/domain"
+	samlsvc "sso/internal/modules/saml/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+	"sso/internal/platform/httpserver/sessioncookie"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+// Config carries the browser-facing URLs the handler needs.
+type Config struct {
+	// BaseURL is the externally visible origin of this server; the SSO
+	// URL the user is sent back to after signing in is built from it.
+	BaseURL string
+	// LoginURL is the sign-in page of the front-end. An unauthenticated
+	// SSO request is redirected there with ?return_to=<sso url>.
+	LoginURL string
+}
+
+type Handler struct {
+	svc *samlsvc.Service
+	api *apiutil.Adapter
+	cfg Config
+	log *slog.Logger
+}
+
+func NewHandler(svc *samlsvc.Service, authn Authenticator, authz Authorizer, cfg Config, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("saml", authn, authz, log, toStatus), cfg: cfg, log: log}
+}
+
+// Register mounts the saml endpoints:
+//
+//	GET    /v1/saml/metadata                       IdP metadata
+//	GET    /v1/saml/sso                            SP-initiated SSO (HTTP-Redirect)
+//	POST   /v1/saml/idp/{app_id}                   IdP-initiated SSO (form post, csrf_token)
+//	GET    /v1/saml/slo                            SP LogoutRequest / LogoutResponse
+//	POST   /v1/saml/logout                         start single logout (bearer)
+//	GET    /v1/saml/service-providers              admin (bearer)
+//	POST   /v1/saml/service-providers
+//	GET    /v1/saml/service-providers/{app_id}
+//	PATCH  /v1/saml/service-providers/{app_id}
+//	DELETE /v1/saml/service-providers/{app_id}?etag=
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/saml/metadata", h.metadata)
+	mux.HandleFunc("GET /v1/saml/sso", h.signedIn(h.sso))
+	mux.HandleFunc("POST /v1/saml/idp/{app_id}", h.signedIn(h.idpInitiated))
+	mux.HandleFunc("GET /v1/saml/slo", h.slo)
+	mux.HandleFunc("POST /v1/saml/logout", h.api.Authed(h.logout))
+	mux.HandleFunc("GET /v1/saml/service-providers", h.api.Authed(h.listServiceProviders))
+	mux.HandleFunc("POST /v1/saml/service-providers", h.api.Authed(h.createServiceProvider))
+	mux.HandleFunc("GET /v1/saml/service-providers/{app_id}", h.api.Authed(h.getServiceProvider))
+	mux.HandleFunc("PATCH /v1/saml/service-providers/{app_id}", h.api.Authed(h.updateServiceProvider))
+	mux.HandleFunc("DELETE /v1/saml/service-providers/{app_id}", h.api.Authed(h.deleteServiceProvider))
+}
+
+// ----------------------------------------------------------------------------
+// Protocol endpoints
+// ----------------------------------------------------------------------------
+
+func (h *Handler) metadata(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(h.svc.Metadata()))
+}
+
+func (h *Handler) sso(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	out, err := h.svc.SSO(r.Context(), samlsvc.SSOInput{
+		SAMLRequest: q.Get("SAMLRequest"),
+		RelayState:  q.Get("RelayState"),
+		RawQuery:    r.URL.RawQuery,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	h.writePostForm(w, r, out)
+}
+
+// idpInitiated is a form post from the portal rather than a link: the
+// response is an auto-submitting form that signs the user in to the SP,
+// so a cross-site page must not be able to trigger it. The cookie
+// middleware holds the post to the CSRF check (csrf_token field).
+func (h *Handler) idpInitiated(w http.ResponseWriter, r *http.Request) {
+	out, err := h.svc.SSO(r.Context(), samlsvc.SSOInput{
+		AppID:      r.PathValue("app_id"),
+		RelayState: r.PostFormValue("RelayState"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	h.writePostForm(w, r, out)
+}
+
+func (h *Handler) slo(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	in := samlsvc.SLOInput{
+		SAMLRequest:  q.Get("SAMLRequest"),
+		SAMLResponse: q.Get("SAMLResponse"),
+		RelayState:   q.Get("RelayState"),
+		RawQuery:     r.URL.RawQuery,
+		IpAddress:    apiutil.ClientIP(r),
+		UserAgent:    r.UserAgent(),
+	}
+	var (
+		out samlsvc.LogoutOutput
+		err error
+	)
+	switch {
+	case in.SAMLRequest != "" && in.SAMLResponse == "":
+		out, err = h.svc.HandleLogoutRequest(r.Context(), in)
+	case in.SAMLResponse != "" && in.SAMLRequest == "":
+		out, err = h.svc.HandleLogoutResponse(r.Context(), in)
+	default:
+		err = domain.ErrInvalidRequest
+	}
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	http.Redirect(w, r, out.RedirectURL, http.StatusFound)
+}
+
+// logout starts an IdP-initiated single logout. Not a redirect: the
+// request carries a bearer token (and, in cookie mode, the CSRF header),
+// so it comes from a script, which then navigates the browser itself.
+func (h *Handler) logout(w http.ResponseWriter, r *http.Request) {
+	out, err := h.svc.Logout(r.Context())
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"redirect_url": out.RedirectURL})
+}
+
+// postForm is the HTTP-POST binding: the browser submits the response
+// to the SP's ACS on load. The noscript button covers browsers with
+// scripting off.
+var postForm = template.Must(template.New("saml-post").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Signing in…</title></head>
+<body onload="document.forms[0].submit()">
+<form method="post" action="{{.ACSURL}}">
+<input type="hidden" name="SAMLResponse" value="{{.SAMLResponse}}">
+{{if .RelayState}}<input type="hidden" name="RelayState" value="{{.RelayState}}">{{end}}
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+</body></html>
+`))
+
+func (h *Handler) writePostForm(w http.ResponseWriter, r *http.Request, out samlsvc.SSOOutput) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; script-src 'unsafe-inline'; form-action *")
+	w.WriteHeader(http.StatusOK)
+	if err := postForm.Execute(w, out); err != nil {
+		h.log.ErrorContext(r.Context(), "saml http: render post form", "err", err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Service provider registry (admin)
+// ----------------------------------------------------------------------------
+
+// serviceProviderBody is the JSON body of create and update.
+// update_mask lists the fields an update applies.
+type serviceProviderBody struct {
+	AppID        string `json:"app_id"`
+	EntityID     string `json:"entity_id"`
+	ACSURL       string `json:"acs_url"`
+	SLOURL       string `json:"slo_url"`
+	Certificate  string `json:"certificate"`
+	NameIDFormat string `json:"name_id_format"`
+	UpdateMask   string `json:"update_mask"`
+	Etag         string `json:"etag"`
+}
+
+func (h *Handler) listServiceProviders(w http.ResponseWriter, r *http.Request) {
+	sps, err := h.svc.ListServiceProviders(r.Context())
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(sps))
+	for _, sp := range sps {
+		views = append(views, serviceProviderView(sp))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{"service_providers": views})
+}
+
+func (h *Handler) createServiceProvider(w http.ResponseWriter, r *http.Request) {
+	var b serviceProviderBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	sp, err := h.svc.CreateServiceProvider(r.Context(), samlsvc.CreateServiceProviderInput{
+		AppID:        b.AppID,
+		EntityID:     b.EntityID,
+		ACSURL:       b.ACSURL,
+		SLOURL:       b.SLOURL,
+		Certificate:  b.Certificate,
+		NameIDFormat: b.NameIDFormat,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusCreated, serviceProviderView(sp))
+}
+
+func (h *Handler) getServiceProvider(w http.ResponseWriter, r *http.Request) {
+	sp, err := h.svc.GetServiceProvider(r.Context(), r.PathValue("app_id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, serviceProviderView(sp))
+}
+
+func (h *Handler) updateServiceProvider(w http.ResponseWriter, r *http.Request) {
+	var b serviceProviderBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	sp, err := h.svc.UpdateServiceProvider(r.Context(), samlsvc.UpdateServiceProviderInput{
+		AppID:        r.PathValue("app_id"),
+		MaskPaths:    apiutil.SplitList(b.UpdateMask),
+		ExpectedEtag: b.Etag,
+		EntityID:     b.EntityID,
+		ACSURL:       b.ACSURL,
+		SLOURL:       b.SLOURL,
+		Certificate:  b.Certificate,
+		NameIDFormat: b.NameIDFormat,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, serviceProviderView(sp))
+}
+
+func (h *Handler) deleteServiceProvider(w http.ResponseWriter, r *http.Request) {
+	err := h.svc.DeleteServiceProvider(r.Context(), samlsvc.DeleteServiceProviderInput{
+		AppID:        r.PathValue("app_id"),
+		ExpectedEtag: r.URL.Query().Get("etag"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func serviceProviderView(sp *domain.ServiceProvider) map[string]any {
+	return map[string]any{
+		"app_id":         sp.AppID().String(),
+		"entity_id":      sp.EntityID,
+		"acs_url":        sp.ACSURL,
+		"slo_url":        sp.SLOURL,
+		"certificate":    sp.Certificate,
+		"name_id_format": sp.NameIDFormat.String(),
+		"etag":           sp.Etag().String(),
+		"created_at":     sp.CreatedAt().UTC().Format(time.RFC3339),
+		"updated_at":     sp.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Plumbing
+// ----------------------------------------------------------------------------
+
+// signedIn is Authed for the SSO endpoints, which the browser reaches by
+// navigation: without a valid session a GET sends the user to the
+// sign-in page, to come back to the same URL afterwards; a post cannot
+// be replayed that way and is refused. On the SP's cross-site redirect
+// the Strict access cookie stays behind, so the session comes from the
+// Lax navigation cookie scoped to the SSO endpoint.
+func (h *Handler) signedIn(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			if ck, err := r.Cookie(sessioncookie.NavigationName); err == nil && ck.Value != "" {
+				r = r.Clone(r.Context())
+				r.Header.Set("Authorization", "Bearer "+ck.Value)
+			}
+		}
+		a, ok := h.api.Authenticate(r)
+		if !ok {
+			if r.Method != http.MethodGet {
+				h.api.WriteError(w, r, apiutil.ErrUnauthenticated)
+				return
+			}
+			returnTo := strings.TrimSuffix(h.cfg.BaseURL, "/") + r.URL.RequestURI()
+			http.Redirect(w, r, withParam(h.cfg.LoginURL, "return_to", returnTo), http.StatusFound)
+			return
+		}
+		r, err := h.api.Bind(r, a)
+		if err == nil {
+			err = h.api.Authorize(r, a)
+		}
+		if err != nil {
+			h.api.WriteError(w, r, err)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// withParam adds one query parameter to a URL that may already carry a
+// query.
+func withParam(raw, key, value string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	q := u.Query()
+	q.Set(key, value)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
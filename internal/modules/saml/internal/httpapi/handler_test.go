@@ -0,0 +1,88 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/platform/httpserver/sessioncookie"
+)
+
+// tokenAuthn accepts the single token valid.
+type tokenAuthn struct{ valid string }
+
+func (a tokenAuthn) Authenticate(_ context.Context, token string) (actor.Actor, error) {
+	if token != a.valid {
+		return actor.Actor{}, errors.New("invalid token")
+	}
+	return actor.Actor{ID: "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90", Kind: actor.KindUser}, nil
+}
+
+func (tokenAuthn) Bind(ctx context.Context, a actor.Actor, _ string) (context.Context, error) {
+	return actor.Inject(ctx, a), nil
+}
+
+type allowAll struct{}
+
+func (allowAll) Authorize(*http.Request, actor.Actor) error { return nil }
+
+func TestSignedIn(t *testing.T) {
+	h := NewHandler(nil, tokenAuthn{valid: "access-1"}, allowAll{},
+		Config{BaseURL: "https://sso.example.com", LoginURL: "https://app.example.com/login"},
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	reached := false
+	next := h.signedIn(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := actor.Require(r.Context()); err != nil {
+			t.Errorf("no actor injected: %v", err)
+		}
+		reached = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	cases := []struct {
+		name     string
+		method   string
+		cookie   *http.Cookie
+		bearer   string
+		wantCode int
+	}{
+		{"navigation cookie", http.MethodGet,
+			&http.Cookie{Name: sessioncookie.NavigationName, Value: "access-1"}, "", http.StatusOK},
+		{"bearer wins over the cookie", http.MethodGet,
+			&http.Cookie{Name: sessioncookie.NavigationName, Value: "stale"}, "access-1", http.StatusOK},
+		{"no session on a GET", http.MethodGet, nil, "", http.StatusFound},
+		{"no session on a post", http.MethodPost, nil, "", http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			reached = false
+			req := httptest.NewRequest(tc.method, "/v1/saml/sso?SAMLRequest=x", nil)
+			if tc.cookie != nil {
+				req.AddCookie(tc.cookie)
+			}
+			if tc.bearer != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.bearer)
+			}
+			rec := httptest.NewRecorder()
+			next(rec, req)
+			if rec.Code != tc.wantCode {
+				t.Fatalf("status = %d, want %d", rec.Code, tc.wantCode)
+			}
+			if reached != (tc.wantCode == http.StatusOK) {
+				t.Fatalf("handler reached = %v", reached)
+			}
+			if tc.wantCode == http.StatusFound {
+				loc := rec.Header().Get("Location")
+				if !strings.HasPrefix(loc, "https://app.example.com/login?return_to=") {
+					t.Fatalf("Location = %q", loc)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package mariadb
+
+import (
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/saml/internal/domain"
+	"sso/internal/modules/saml/internal/mariadb/dbgen"
+)
+
+func serviceProviderToDomain(r dbgen.SamlServiceProvider) *domain.ServiceProvider {
+	return domain.RestoreServiceProvider(domain.RestoreServiceProviderParams{
+		AppID:        domain.AppID(r.AppID),
+		EntityID:     r.EntityID,
+		ACSURL:       r.AcsUrl,
+		SLOURL:       r.SloUrl.String,
+		Certificate:  r.Certificate.String,
+		NameIDFormat: domain.NameIDFormat(r.NameIDFormat),
+		Etag:         etag.Etag(r.Etag),
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	})
+}
+
+func toCreateServiceProviderParams(sp *domain.ServiceProvider) dbgen.CreateSAMLServiceProviderParams {
+	return dbgen.CreateSAMLServiceProviderParams{
+		AppID:        sp.AppID().String(),
+		EntityID:     sp.EntityID,
+		AcsUrl:       sp.ACSURL,
+		SloUrl:       dbutil.StringToNullString(sp.SLOURL),
+		Certificate:  dbutil.StringToNullString(sp.Certificate),
+		NameIDFormat: uint8(sp.NameIDFormat),
+		Etag:         sp.Etag().String(),
+		CreatedAt:    sp.CreatedAt(),
+		UpdatedAt:    sp.UpdatedAt(),
+	}
+}
+
+func toUpdateServiceProviderParams(sp *domain.ServiceProvider) dbgen.UpdateSAMLServiceProviderParams {
+	return dbgen.UpdateSAMLServiceProviderParams{
+		EntityID:     sp.EntityID,
+		AcsUrl:       sp.ACSURL,
+		SloUrl:       dbutil.StringToNullString(sp.SLOURL),
+		Certificate:  dbutil.StringToNullString(sp.Certificate),
+		NameIDFormat: uint8(sp.NameIDFormat),
+		Etag:         sp.Etag().String(),
+		UpdatedAt:    sp.UpdatedAt(),
+		AppID:        sp.AppID().String(),
+	}
+}
+
+// toUpdateServiceProviderWithEtagParams — Etag_2 is sqlc's positional
+// name for the `etag = ?` in the WHERE clause.
+func toUpdateServiceProviderWithEtagParams(sp *domain.ServiceProvider, expected etag.Etag) dbgen.UpdateSAMLServiceProviderWithEtagParams {
+	u := toUpdateServiceProviderParams(sp)
+	return dbgen.UpdateSAMLServiceProviderWithEtagParams{
+		EntityID:     u.EntityID,
+		AcsUrl:       u.AcsUrl,
+		SloUrl:       u.SloUrl,
+		Certificate:  u.Certificate,
+		NameIDFormat: u.NameIDFormat,
+		Etag:         u.Etag,
+		UpdatedAt:    u.UpdatedAt,
+		AppID:        u.AppID,
+		Etag_2:       expected.String(),
+	}
+}
+
+func participantToDomain(r dbgen.SamlParticipant) *domain.Participant {
+	return &domain.Participant{
+		SessionID:    domain.SessionID(r.SessionID),
+		AppID:        domain.AppID(r.AppID),
+		NameID:       r.NameID,
+		SessionIndex: r.SessionIndex,
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+func logoutStateToDomain(r dbgen.SamlLogoutState) *domain.LogoutState {
+	return &domain.LogoutState{
+		StateHash:        r.StateHash,
+		SessionID:        domain.SessionID(r.SessionID),
+		OriginAppID:      domain.AppID(r.OriginAppID.String),
+		OriginRequestID:  r.OriginRequestID.String,
+		OriginRelayState: r.OriginRelayState.String,
+		CreatedAt:        r.CreatedAt,
+		ExpiresAt:        r.ExpiresAt,
+	}
+}
+
+func toCreateLogoutStateParams(s *domain.LogoutState) dbgen.CreateSAMLLogoutStateParams {
+	return dbgen.CreateSAMLLogoutStateParams{
+		StateHash:        s.StateHash,
+		SessionID:        s.SessionID.String(),
+		OriginAppID:      dbutil.StringToNullString(s.OriginAppID.String()),
+		OriginRequestID:  dbutil.StringToNullString(s.OriginRequestID),
+		OriginRelayState: dbutil.StringToNullString(s.OriginRelayState),
+		CreatedAt:        s.CreatedAt,
+		ExpiresAt:        s.ExpiresAt,
+	}
+}
@@ -0,0 +1,89 @@
+-- SAML service providers, session participants and logout states.
+
+-- name: CreateSAMLServiceProvider :exec
+INSERT INTO saml_service_providers (
+    app_id, entity_id, acs_url, slo_url, certificate, name_id_format,
+    etag, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetSAMLServiceProviderByAppID :one
+SELECT * FROM saml_service_providers
+WHERE app_id = ?
+LIMIT 1;
+
+-- name: GetSAMLServiceProviderByEntityID :one
+SELECT * FROM saml_service_providers
+WHERE entity_id = ?
+LIMIT 1;
+
+-- name: ListSAMLServiceProviders :many
+SELECT * FROM saml_service_providers
+ORDER BY entity_id;
+
+-- name: UpdateSAMLServiceProvider :execresult
+UPDATE saml_service_providers
+SET entity_id = ?, acs_url = ?, slo_url = ?, certificate = ?,
+    name_id_format = ?, etag = ?, updated_at = ?
+WHERE app_id = ?;
+
+-- name: UpdateSAMLServiceProviderWithEtag :execresult
+UPDATE saml_service_providers
+SET entity_id = ?, acs_url = ?, slo_url = ?, certificate = ?,
+    name_id_format = ?, etag = ?, updated_at = ?
+WHERE app_id = ? AND etag = ?;
+
+-- name: DeleteSAMLServiceProvider :execresult
+DELETE FROM saml_service_providers
+WHERE app_id = ?;
+
+-- name: DeleteSAMLServiceProviderWithEtag :execresult
+DELETE FROM saml_service_providers
+WHERE app_id = ? AND etag = ?;
+
+-- name: CountSAMLServiceProviderByAppID :one
+SELECT COUNT(*) FROM saml_service_providers
+WHERE app_id = ?;
+
+-- name: UpsertSAMLParticipant :exec
+INSERT INTO saml_participants (
+    session_id, app_id, name_id, session_index, created_at
+) VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE name_id = VALUES(name_id), session_index = VALUES(session_index);
+
+-- name: GetSAMLParticipant :one
+SELECT * FROM saml_participants
+WHERE session_id = ? AND app_id = ?
+LIMIT 1;
+
+-- name: GetSAMLParticipantBySessionIndex :one
+SELECT * FROM saml_participants
+WHERE app_id = ? AND session_index = ?
+LIMIT 1;
+
+-- name: ListSAMLParticipants :many
+SELECT * FROM saml_participants
+WHERE session_id = ?
+ORDER BY created_at;
+
+-- name: DeleteSAMLParticipant :execresult
+DELETE FROM saml_participants
+WHERE session_id = ? AND app_id = ?;
+
+-- name: CreateSAMLLogoutState :exec
+INSERT INTO saml_logout_states (
+    state_hash, session_id, origin_app_id, origin_request_id,
+    origin_relay_state, created_at, expires_at
+) VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetSAMLLogoutStateForUpdate :one
+SELECT * FROM saml_logout_states
+WHERE state_hash = ?
+FOR UPDATE;
+
+-- name: DeleteSAMLLogoutState :exec
+DELETE FROM saml_logout_states
+WHERE state_hash = ?;
+
+-- name: DeleteExpiredSAMLLogoutStates :exec
+DELETE FROM saml_logout_states
+WHERE expires_at < ?;
@@ -0,0 +1,244 @@
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/saml/internal/domain"
+	"sso/internal/modules/saml/internal/mariadb/dbgen"
+)
+
+type Repository struct {
+	db *sql.DB
+	q  *dbgen.Queries
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db, q: dbgen.New(db)}
+}
+
+var _ domain.Repository = (*Repository)(nil)
+
+// ----------------------------------------------------------------------------
+// Service providers
+// ----------------------------------------------------------------------------
+
+func (r *Repository) CreateServiceProvider(ctx context.Context, sp *domain.ServiceProvider) error {
+	if err := r.q.CreateSAMLServiceProvider(ctx, toCreateServiceProviderParams(sp)); err != nil {
+		if dbutil.IsDuplicateEntry(err) {
+			return domain.ErrServiceProviderAlreadyExists
+		}
+		return fmt.Errorf("saml repo: create_service_provider: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetServiceProviderByAppID(ctx context.Context, appID domain.AppID) (*domain.ServiceProvider, error) {
+	row, err := r.q.GetSAMLServiceProviderByAppID(ctx, appID.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrServiceProviderNotFound
+		}
+		return nil, fmt.Errorf("saml repo: get_service_provider: %w", err)
+	}
+	return serviceProviderToDomain(row), nil
+}
+
+func (r *Repository) GetServiceProviderByEntityID(ctx context.Context, entityID string) (*domain.ServiceProvider, error) {
+	row, err := r.q.GetSAMLServiceProviderByEntityID(ctx, entityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrServiceProviderNotFound
+		}
+		return nil, fmt.Errorf("saml repo: get_service_provider_by_entity_id: %w", err)
+	}
+	return serviceProviderToDomain(row), nil
+}
+
+func (r *Repository) ListServiceProviders(ctx context.Context) ([]*domain.ServiceProvider, error) {
+	rows, err := r.q.ListSAMLServiceProviders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("saml repo: list_service_providers: %w", err)
+	}
+	out := make([]*domain.ServiceProvider, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, serviceProviderToDomain(row))
+	}
+	return out, nil
+}
+
+func (r *Repository) UpdateServiceProvider(ctx context.Context, sp *domain.ServiceProvider, expectedEtag etag.Etag) error {
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = r.q.UpdateSAMLServiceProvider(ctx, toUpdateServiceProviderParams(sp))
+	} else {
+		res, err = r.q.UpdateSAMLServiceProviderWithEtag(ctx, toUpdateServiceProviderWithEtagParams(sp, expectedEtag))
+	}
+	if err != nil {
+		if dbutil.IsDuplicateEntry(err) {
+			return domain.ErrServiceProviderAlreadyExists
+		}
+		return fmt.Errorf("saml repo: update_service_provider: %w", err)
+	}
+	return r.discriminate(ctx, res, sp.AppID(), expectedEtag)
+}
+
+func (r *Repository) DeleteServiceProvider(ctx context.Context, appID domain.AppID, expectedEtag etag.Etag) error {
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = r.q.DeleteSAMLServiceProvider(ctx, appID.String())
+	} else {
+		res, err = r.q.DeleteSAMLServiceProviderWithEtag(ctx, dbgen.DeleteSAMLServiceProviderWithEtagParams{
+			AppID: appID.String(),
+			Etag:  expectedEtag.String(),
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("saml repo: delete_service_provider: %w", err)
+	}
+	return r.discriminate(ctx, res, appID, expectedEtag)
+}
+
+func (r *Repository) discriminate(ctx context.Context, res sql.Result, appID domain.AppID, expectedEtag etag.Etag) error {
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("saml repo: rows_affected: %w", err)
+	}
+	if rows == 1 {
+		return nil
+	}
+	return dbutil.Discriminate(ctx, expectedEtag,
+		func(ctx context.Context) (int64, error) {
+			return r.q.CountSAMLServiceProviderByAppID(ctx, appID.String())
+		},
+		domain.ErrServiceProviderNotFound, domain.ErrEtagMismatch)
+}
+
+// ----------------------------------------------------------------------------
+// Participants
+// ----------------------------------------------------------------------------
+
+func (r *Repository) UpsertParticipant(ctx context.Context, p *domain.Participant) error {
+	err := r.q.UpsertSAMLParticipant(ctx, dbgen.UpsertSAMLParticipantParams{
+		SessionID:    p.SessionID.String(),
+		AppID:        p.AppID.String(),
+		NameID:       p.NameID,
+		SessionIndex: p.SessionIndex,
+		CreatedAt:    p.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("saml repo: upsert_participant: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetParticipant(ctx context.Context, sessionID domain.SessionID, appID domain.AppID) (*domain.Participant, error) {
+	row, err := r.q.GetSAMLParticipant(ctx, dbgen.GetSAMLParticipantParams{
+		SessionID: sessionID.String(),
+		AppID:     appID.String(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrParticipantNotFound
+		}
+		return nil, fmt.Errorf("saml repo: get_participant: %w", err)
+	}
+	return participantToDomain(row), nil
+}
+
+func (r *Repository) GetParticipantBySessionIndex(ctx context.Context, appID domain.AppID, sessionIndex string) (*domain.Participant, error) {
+	row, err := r.q.GetSAMLParticipantBySessionIndex(ctx, dbgen.GetSAMLParticipantBySessionIndexParams{
+		AppID:        appID.String(),
+		SessionIndex: sessionIndex,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrParticipantNotFound
+		}
+		return nil, fmt.Errorf("saml repo: get_participant_by_session_index: %w", err)
+	}
+	return participantToDomain(row), nil
+}
+
+func (r *Repository) ListParticipants(ctx context.Context, sessionID domain.SessionID) ([]*domain.Participant, error) {
+	rows, err := r.q.ListSAMLParticipants(ctx, sessionID.String())
+	if err != nil {
+		return nil, fmt.Errorf("saml repo: list_participants: %w", err)
+	}
+	out := make([]*domain.Participant, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, participantToDomain(row))
+	}
+	return out, nil
+}
+
+func (r *Repository) DeleteParticipant(ctx context.Context, sessionID domain.SessionID, appID domain.AppID) error {
+	res, err := r.q.DeleteSAMLParticipant(ctx, dbgen.DeleteSAMLParticipantParams{
+		SessionID: sessionID.String(),
+		AppID:     appID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("saml repo: delete_participant: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("saml repo: delete_participant: rows_affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrParticipantNotFound
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Logout states
+// ----------------------------------------------------------------------------
+
+// CreateLogoutState prunes expired rows before inserting, like
+// federation's login states: the table only holds rounds started within
+// the state TTL.
+func (r *Repository) CreateLogoutState(ctx context.Context, s *domain.LogoutState) error {
+	if err := r.q.DeleteExpiredSAMLLogoutStates(ctx, s.CreatedAt); err != nil {
+		return fmt.Errorf("saml repo: prune_states: %w", err)
+	}
+	if err := r.q.CreateSAMLLogoutState(ctx, toCreateLogoutStateParams(s)); err != nil {
+		return fmt.Errorf("saml repo: create_state: %w", err)
+	}
+	return nil
+}
+
+// ConsumeLogoutState reads the row under FOR UPDATE and deletes it in
+// the same transaction, so a replayed LogoutResponse cannot advance the
+// round twice.
+func (r *Repository) ConsumeLogoutState(ctx context.Context, stateHash []byte) (*domain.LogoutState, error) {
+	var out *domain.LogoutState
+	err := dbutil.InTx(ctx, r.db, func(tx *sql.Tx) error {
+		q := r.q.WithTx(tx)
+		row, err := q.GetSAMLLogoutStateForUpdate(ctx, stateHash)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrInvalidRequest
+			}
+			return fmt.Errorf("saml repo: get_state: %w", err)
+		}
+		if err := q.DeleteSAMLLogoutState(ctx, stateHash); err != nil {
+			return fmt.Errorf("saml repo: delete_state: %w", err)
+		}
+		out = logoutStateToDomain(row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
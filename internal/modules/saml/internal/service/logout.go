@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/saml/internal/domain"
+	"sso/internal/modules/session"
+	"sso/internal/platform/saml"
+)
+
+// Single logout runs as a chain of browser redirects. Ending the session
+// is the first step, so however far the chain gets, the sessions row is
+// revoked and the user's tokens stop working. Each later step sends a
+// LogoutRequest to one participating SP (HTTP-Redirect binding) and
+// waits for its LogoutResponse at the SLO endpoint; the round ends with
+// a LogoutResponse to the SP that asked for it, or with a redirect to
+// Config.PostLogoutURL when the user logged out here.
+//
+// SPs without an SLO URL are dropped from the chain silently: they keep
+// their own session until it times out, which is all SAML offers them.
+
+// LogoutOutput — the transport redirects the browser to RedirectURL.
+type LogoutOutput struct {
+	RedirectURL string
+}
+
+// Logout is the IdP-initiated entry point: it ends the caller's session
+// and starts single logout across the SPs the session was asserted to.
+func (s *Service) Logout(ctx context.Context) (LogoutOutput, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return LogoutOutput{}, err
+	}
+	if !a.IsUser() || a.SessionID == "" {
+		return LogoutOutput{}, domain.ErrUserActorRequired
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeSAMLLogout)
+	aud.SubjectType = audit.SubjectTypeSession
+	aud.SubjectID = a.SessionID
+	aud.Metadata = map[string]string{"initiated_by": "idp"}
+
+	sid := domain.SessionID(a.SessionID)
+	if err := s.endSession(ctx, sid); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return LogoutOutput{}, err
+	}
+	s.auditor.Success(ctx, aud)
+
+	return s.next(ctx, &domain.LogoutState{SessionID: sid})
+}
+
+// SLOInput is a message arriving at the SLO endpoint over the
+// HTTP-Redirect binding: either an SP's LogoutRequest (SAMLRequest) or
+// its LogoutResponse to one of ours (SAMLResponse). RawQuery is the
+// query string exactly as received.
+type SLOInput struct {
+	SAMLRequest  string
+	SAMLResponse string
+	RelayState   string
+	RawQuery     string
+	IpAddress    string
+	UserAgent    string
+}
+
+// HandleLogoutRequest is the SP-initiated entry point. The request must
+// be signed with the SP's registered certificate: a LogoutRequest ends
+// the session everywhere, so an unsigned one would let anybody who
+// learned a SessionIndex log the user out. The session is identified by
+// the opaque SessionIndex the SP got in its assertion, looked up among
+// that SP's participant rows so it can only end sessions asserted to it.
+func (s *Service) HandleLogoutRequest(ctx context.Context, in SLOInput) (LogoutOutput, error) {
+	aud := audit.NewAuditParams{
+		EventType:   audit.EventTypeSAMLLogout,
+		ActorType:   audit.ActorTypeAnonymous,
+		SubjectType: audit.SubjectTypeSession,
+		IpAddress:   in.IpAddress,
+		UserAgent:   in.UserAgent,
+		Metadata:    map[string]string{"initiated_by": "sp"},
+	}
+
+	sp, req, p, err := s.logoutRequest(ctx, in)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return LogoutOutput{}, err
+	}
+	aud.AppID = sp.AppID().String()
+	aud.SubjectID = p.SessionID.String()
+	aud.Metadata["entity_id"] = sp.EntityID
+
+	if err := s.endSession(ctx, p.SessionID); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return LogoutOutput{}, err
+	}
+	if err := s.repo.DeleteParticipant(ctx, p.SessionID, sp.AppID()); err != nil && !errors.Is(err, domain.ErrParticipantNotFound) {
+		s.log.WarnContext(ctx, "saml: drop participant", "session_id", p.SessionID.String(), "err", err)
+	}
+	s.auditor.Success(ctx, aud)
+
+	return s.next(ctx, &domain.LogoutState{
+		SessionID:        p.SessionID,
+		OriginAppID:      sp.AppID(),
+		OriginRequestID:  req.ID,
+		OriginRelayState: in.RelayState,
+	})
+}
+
+// logoutRequest decodes and checks an SP's LogoutRequest and resolves
+// the participant it targets.
+func (s *Service) logoutRequest(ctx context.Context, in SLOInput) (*domain.ServiceProvider, *saml.LogoutRequest, *domain.Participant, error) {
+	raw, err := saml.DecodeRedirect(in.SAMLRequest)
+	if err != nil {
+		return nil, nil, nil, s.invalid(ctx, "decode LogoutRequest", err)
+	}
+	req, err := saml.ParseLogoutRequest(raw)
+	if err != nil {
+		return nil, nil, nil, s.invalid(ctx, "parse LogoutRequest", err)
+	}
+	sp, err := s.requestingSP(ctx, req.Issuer, in.RawQuery)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if sp.SLOURL == "" {
+		return nil, nil, nil, s.invalid(ctx, "LogoutRequest from an SP without SLO URL",
+			fmt.Errorf("issuer %q", req.Issuer))
+	}
+	// requestingSP verified the signature when the SP has a
+	// certificate; without one there is nothing to verify against.
+	if sp.Certificate == "" {
+		return nil, nil, nil, s.invalid(ctx, "unsigned LogoutRequest from an SP without certificate",
+			fmt.Errorf("issuer %q", req.Issuer))
+	}
+	for _, idx := range req.SessionIndexes {
+		p, err := s.repo.GetParticipantBySessionIndex(ctx, sp.AppID(), idx)
+		switch {
+		case err == nil:
+			if p.NameID != req.NameID {
+				return nil, nil, nil, s.invalid(ctx, "LogoutRequest NameID does not match the session",
+					fmt.Errorf("issuer %q", req.Issuer))
+			}
+			return sp, req, p, nil
+		case errors.Is(err, domain.ErrParticipantNotFound):
+		default:
+			return nil, nil, nil, err
+		}
+	}
+	return nil, nil, nil, s.invalid(ctx, "LogoutRequest names no session asserted to the SP",
+		fmt.Errorf("issuer %q", req.Issuer))
+}
+
+// HandleLogoutResponse continues the round after an SP answered our
+// LogoutRequest. RelayState is the key of the round; an SP reporting a
+// failure does not stop it, since the session is already gone here.
+func (s *Service) HandleLogoutResponse(ctx context.Context, in SLOInput) (LogoutOutput, error) {
+	if in.RelayState == "" {
+		return LogoutOutput{}, s.invalid(ctx, "LogoutResponse without RelayState", errors.New("missing RelayState"))
+	}
+	st, err := s.repo.ConsumeLogoutState(ctx, hashState(in.RelayState))
+	if err != nil {
+		return LogoutOutput{}, err
+	}
+	if st.IsExpired(s.now()) {
+		return LogoutOutput{}, s.invalid(ctx, "LogoutResponse for an expired round", errors.New("state expired"))
+	}
+
+	raw, err := saml.DecodeRedirect(in.SAMLResponse)
+	if err != nil {
+		return LogoutOutput{}, s.invalid(ctx, "decode LogoutResponse", err)
+	}
+	resp, err := saml.ParseLogoutResponse(raw)
+	if err != nil {
+		return LogoutOutput{}, s.invalid(ctx, "parse LogoutResponse", err)
+	}
+	if _, err := s.requestingSP(ctx, resp.Issuer, in.RawQuery); err != nil {
+		return LogoutOutput{}, err
+	}
+	if resp.StatusCode != saml.StatusSuccess {
+		s.log.InfoContext(ctx, "saml: SP reported logout failure",
+			"issuer", resp.Issuer, "status", resp.StatusCode)
+	}
+	return s.next(ctx, st)
+}
+
+// endSession revokes the sessions row. Already revoked or gone counts
+// as done: a retried logout must still reach the SPs.
+func (s *Service) endSession(ctx context.Context, id domain.SessionID) error {
+	sess, err := s.sessions.GetByID(ctx, session.SessionID(id))
+	if err != nil {
+		if errors.Is(err, session.ErrSessionNotFound) {
+			return nil
+		}
+		return fmt.Errorf("saml logout: get session: %w", err)
+	}
+	if sess.IsRevoked() {
+		return nil
+	}
+	sess.Revoke(s.now().UTC())
+	if err := s.sessions.Update(ctx, sess); err != nil {
+		return fmt.Errorf("saml logout: persist revocation: %w", err)
+	}
+	return nil
+}
+
+// next picks the next participant of the round and redirects the
+// browser to it, or finishes the round when none is left. from carries
+// the session and origin of the round; its StateHash is ignored.
+func (s *Service) next(ctx context.Context, from *domain.LogoutState) (LogoutOutput, error) {
+	ps, err := s.repo.ListParticipants(ctx, from.SessionID)
+	if err != nil {
+		return LogoutOutput{}, err
+	}
+	for _, p := range ps {
+		if err := s.repo.DeleteParticipant(ctx, p.SessionID, p.AppID); err != nil && !errors.Is(err, domain.ErrParticipantNotFound) {
+			return LogoutOutput{}, err
+		}
+		sp, err := s.repo.GetServiceProviderByAppID(ctx, p.AppID)
+		if err != nil {
+			if errors.Is(err, domain.ErrServiceProviderNotFound) {
+				continue
+			}
+			return LogoutOutput{}, err
+		}
+		if sp.SLOURL == "" || sp.AppID() == from.OriginAppID {
+			continue
+		}
+		return s.sendLogoutRequest(ctx, from, sp, p)
+	}
+	return s.finish(ctx, from)
+}
+
+func (s *Service) sendLogoutRequest(ctx context.Context, from *domain.LogoutState, sp *domain.ServiceProvider, p *domain.Participant) (LogoutOutput, error) {
+	relay, err := saml.NewID()
+	if err != nil {
+		return LogoutOutput{}, err
+	}
+	now := s.now().UTC()
+	st := &domain.LogoutState{
+		StateHash:        hashState(relay),
+		SessionID:        from.SessionID,
+		OriginAppID:      from.OriginAppID,
+		OriginRequestID:  from.OriginRequestID,
+		OriginRelayState: from.OriginRelayState,
+		CreatedAt:        now,
+		ExpiresAt:        now.Add(s.cfg.LogoutStateTTL),
+	}
+	if err := s.repo.CreateLogoutState(ctx, st); err != nil {
+		return LogoutOutput{}, fmt.Errorf("saml logout: %w", err)
+	}
+
+	msg, _, err := s.idp.LogoutRequest(sp.SLOURL, p.NameID, sp.NameIDFormat.URI(), p.SessionIndex)
+	if err != nil {
+		return LogoutOutput{}, err
+	}
+	q, err := saml.SignRedirect("SAMLRequest", msg, relay, s.idp.Keys)
+	if err != nil {
+		return LogoutOutput{}, err
+	}
+	return LogoutOutput{RedirectURL: withQuery(sp.SLOURL, q)}, nil
+}
+
+// finish ends the round: a LogoutResponse to the SP that started it, or
+// the post-logout page.
+func (s *Service) finish(ctx context.Context, st *domain.LogoutState) (LogoutOutput, error) {
+	if st.OriginAppID == "" {
+		return LogoutOutput{RedirectURL: s.cfg.PostLogoutURL}, nil
+	}
+	sp, err := s.repo.GetServiceProviderByAppID(ctx, st.OriginAppID)
+	if err != nil {
+		if errors.Is(err, domain.ErrServiceProviderNotFound) {
+			return LogoutOutput{RedirectURL: s.cfg.PostLogoutURL}, nil
+		}
+		return LogoutOutput{}, err
+	}
+	if sp.SLOURL == "" {
+		return LogoutOutput{RedirectURL: s.cfg.PostLogoutURL}, nil
+	}
+	msg, err := s.idp.LogoutResponse(sp.SLOURL, st.OriginRequestID, saml.StatusSuccess)
+	if err != nil {
+		return LogoutOutput{}, err
+	}
+	q, err := saml.SignRedirect("SAMLResponse", msg, st.OriginRelayState, s.idp.Keys)
+	if err != nil {
+		return LogoutOutput{}, err
+	}
+	return LogoutOutput{RedirectURL: withQuery(sp.SLOURL, q)}, nil
+}
+
+// withQuery appends an already-encoded query to an endpoint that may
+// carry a query of its own.
+func withQuery(endpoint, q string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.RawQuery == "" {
+		return endpoint + "?" + q
+	}
+	return endpoint + "&" + q
+}
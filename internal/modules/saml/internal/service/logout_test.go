@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"sso/internal/modules/audit"
+	"sso/internal/modules/saml/internal/domain"
+	"sso/internal/modules/session"
+	"sso/internal/platform/saml"
+)
+
+// fakeRepo holds one SP and its participants; methods the tests do not
+// reach panic through the nil embedded interface.
+type fakeRepo struct {
+	domain.Repository
+	sp           *domain.ServiceProvider
+	participants []*domain.Participant
+}
+
+func (r *fakeRepo) GetServiceProviderByEntityID(_ context.Context, entityID string) (*domain.ServiceProvider, error) {
+	if r.sp == nil || r.sp.EntityID != entityID {
+		return nil, domain.ErrServiceProviderNotFound
+	}
+	return r.sp, nil
+}
+
+func (r *fakeRepo) GetServiceProviderByAppID(_ context.Context, appID domain.AppID) (*domain.ServiceProvider, error) {
+	if r.sp == nil || r.sp.AppID() != appID {
+		return nil, domain.ErrServiceProviderNotFound
+	}
+	return r.sp, nil
+}
+
+func (r *fakeRepo) GetParticipant(_ context.Context, sid domain.SessionID, appID domain.AppID) (*domain.Participant, error) {
+	for _, p := range r.participants {
+		if p.SessionID == sid && p.AppID == appID {
+			return p, nil
+		}
+	}
+	return nil, domain.ErrParticipantNotFound
+}
+
+func (r *fakeRepo) GetParticipantBySessionIndex(_ context.Context, appID domain.AppID, idx string) (*domain.Participant, error) {
+	for _, p := range r.participants {
+		if p.AppID == appID && p.SessionIndex == idx {
+			return p, nil
+		}
+	}
+	return nil, domain.ErrParticipantNotFound
+}
+
+func (r *fakeRepo) ListParticipants(_ context.Context, sid domain.SessionID) ([]*domain.Participant, error) {
+	var out []*domain.Participant
+	for _, p := range r.participants {
+		if p.SessionID == sid {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeRepo) DeleteParticipant(_ context.Context, sid domain.SessionID, appID domain.AppID) error {
+	for i, p := range r.participants {
+		if p.SessionID == sid && p.AppID == appID {
+			r.participants = append(r.participants[:i], r.participants[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrParticipantNotFound
+}
+
+// fakeSessions records the sessions looked up for revocation and
+// reports each as already gone.
+type fakeSessions struct {
+	session.Repository
+	ended []session.SessionID
+}
+
+func (s *fakeSessions) GetByID(_ context.Context, id session.SessionID) (*session.Session, error) {
+	s.ended = append(s.ended, id)
+	return nil, session.ErrSessionNotFound
+}
+
+func newKeyPair(t *testing.T, cn string) (*saml.KeyPair, string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	return &saml.KeyPair{Cert: cert, Key: key}, certPEM
+}
+
+// logoutQuery builds the redirect-binding query of a LogoutRequest from
+// the SP entityID signed with kp.
+func logoutQuery(t *testing.T, entityID string, kp *saml.KeyPair, nameID, sessionIndex string) SLOInput {
+	t.Helper()
+	sp := &saml.IdP{EntityID: entityID, Keys: kp, Now: time.Now}
+	msg, _, err := sp.LogoutRequest("https://sso.example.com/v1/saml/slo", nameID, saml.NameIDFormatEmail, sessionIndex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := saml.SignRedirect("SAMLRequest", msg, "sp-relay", kp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vals, err := url.ParseQuery(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return SLOInput{SAMLRequest: vals.Get("SAMLRequest"), RelayState: vals.Get("RelayState"), RawQuery: q}
+}
+
+func TestHandleLogoutRequest(t *testing.T) {
+	const (
+		entityID  = "https://sp.example.com/metadata"
+		sessionID = "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90"
+		index     = "_4f1c2a9e8b7d6c5e4f3a2b1c0d9e8f7a"
+		nameID    = "ada@example.com"
+	)
+	idpKeys, _ := newKeyPair(t, "idp")
+	spKeys, spCert := newKeyPair(t, "sp")
+	otherKeys, _ := newKeyPair(t, "other")
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	newService := func(cert string) (*Service, *fakeRepo, *fakeSessions) {
+		repo := &fakeRepo{
+			sp: domain.RestoreServiceProvider(domain.RestoreServiceProviderParams{
+				AppID:        "app-1",
+				EntityID:     entityID,
+				ACSURL:       "https://sp.example.com/acs",
+				SLOURL:       "https://sp.example.com/slo",
+				Certificate:  cert,
+				NameIDFormat: domain.NameIDFormatEmail,
+			}),
+			participants: []*domain.Participant{{
+				SessionID: sessionID, AppID: "app-1", NameID: nameID, SessionIndex: index,
+			}},
+		}
+		sessions := &fakeSessions{}
+		idp := &saml.IdP{EntityID: "https://sso.example.com", Keys: idpKeys, Now: time.Now}
+		s := NewService(log, repo, nil, nil, nil, sessions, idp,
+			Config{LogoutStateTTL: time.Minute, PostLogoutURL: "/"}, time.Now, audit.NopEmitter{})
+		return s, repo, sessions
+	}
+
+	t.Run("signed request naming the index", func(t *testing.T) {
+		s, repo, sessions := newService(spCert)
+		out, err := s.HandleLogoutRequest(context.Background(), logoutQuery(t, entityID, spKeys, nameID, index))
+		if err != nil {
+			t.Fatalf("err = %v", err)
+		}
+		if len(sessions.ended) != 1 || sessions.ended[0] != sessionID {
+			t.Fatalf("ended sessions = %v, want [%s]", sessions.ended, sessionID)
+		}
+		if len(repo.participants) != 0 {
+			t.Fatalf("participants left = %d", len(repo.participants))
+		}
+		if !strings.HasPrefix(out.RedirectURL, "https://sp.example.com/slo?SAMLResponse=") {
+			t.Fatalf("redirect = %q, want a LogoutResponse to the SP", out.RedirectURL)
+		}
+	})
+
+	rejected := []struct {
+		name  string
+		cert  string
+		keys  *saml.KeyPair
+		index string
+	}{
+		{"session id instead of the index", spCert, spKeys, sessionID},
+		{"signed with another key", spCert, otherKeys, index},
+		{"SP without certificate", "", spKeys, index},
+	}
+	for _, tc := range rejected {
+		t.Run(tc.name, func(t *testing.T) {
+			s, repo, sessions := newService(tc.cert)
+			_, err := s.HandleLogoutRequest(context.Background(), logoutQuery(t, entityID, tc.keys, nameID, tc.index))
+			if !errors.Is(err, domain.ErrInvalidRequest) {
+				t.Fatalf("err = %v, want ErrInvalidRequest", err)
+			}
+			if len(sessions.ended) != 0 || len(repo.participants) != 1 {
+				t.Fatalf("session ended despite the rejection")
+			}
+		})
+	}
+
+	t.Run("unsigned request", func(t *testing.T) {
+		s, _, sessions := newService(spCert)
+		in := logoutQuery(t, entityID, spKeys, nameID, index)
+		in.RawQuery = "SAMLRequest=" + url.QueryEscape(in.SAMLRequest)
+		if _, err := s.HandleLogoutRequest(context.Background(), in); !errors.Is(err, domain.ErrInvalidRequest) {
+			t.Fatalf("err = %v, want ErrInvalidRequest", err)
+		}
+		if len(sessions.ended) != 0 {
+			t.Fatal("session ended by an unsigned request")
+		}
+	})
+}
+
+func TestSessionIndexIsOpaqueAndStable(t *testing.T) {
+	const sessionID = "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90"
+	repo := &fakeRepo{}
+	s := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, nil, nil, nil, nil, nil,
+		Config{}, time.Now, audit.NopEmitter{})
+	ctx := context.Background()
+
+	first, err := s.sessionIndex(ctx, sessionID, "app-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == "" || strings.Contains(first, sessionID) {
+		t.Fatalf("index %q reveals the session id", first)
+	}
+	other, err := s.sessionIndex(ctx, sessionID, "app-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == first {
+		t.Fatal("two SPs got the same index")
+	}
+
+	repo.participants = append(repo.participants, &domain.Participant{SessionID: sessionID, AppID: "app-1", SessionIndex: first})
+	again, err := s.sessionIndex(ctx, sessionID, "app-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != first {
+		t.Fatalf("index changed on re-assertion: %q → %q", first, again)
+	}
+}
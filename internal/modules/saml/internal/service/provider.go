@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/saml/internal/domain"
+)
+
+// ----------------------------------------------------------------------------
+// CreateServiceProvider
+// ----------------------------------------------------------------------------
+
+type CreateServiceProviderInput struct {
+	AppID        string
+	EntityID     string
+	ACSURL       string
+	SLOURL       string
+	Certificate  string
+	NameIDFormat string
+}
+
+// CreateServiceProvider registers the SAML settings of an existing app.
+// An app has at most one SP registration.
+func (s *Service) CreateServiceProvider(ctx context.Context, in CreateServiceProviderInput) (*domain.ServiceProvider, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create service provider: %w", err)
+	}
+	appID, err := app.ParseAppID(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	format, err := domain.ParseNameIDFormat(in.NameIDFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := domain.NewServiceProvider(domain.NewServiceProviderParams{
+		AppID:        domain.AppID(appID),
+		EntityID:     in.EntityID,
+		ACSURL:       in.ACSURL,
+		SLOURL:       in.SLOURL,
+		Certificate:  in.Certificate,
+		NameIDFormat: format,
+		Now:          s.now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeSAMLCreateServiceProvider)
+	aud.SubjectType = audit.SubjectTypeApp
+	aud.SubjectID = appID.String()
+	aud.AppID = appID.String()
+
+	if _, err := s.apps.GetByID(ctx, appID); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	if err := s.repo.CreateServiceProvider(ctx, sp); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("create service provider: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return sp, nil
+}
+
+// ----------------------------------------------------------------------------
+// GetServiceProvider / ListServiceProviders
+// ----------------------------------------------------------------------------
+
+func (s *Service) GetServiceProvider(ctx context.Context, rawAppID string) (*domain.ServiceProvider, error) {
+	appID, err := app.ParseAppID(rawAppID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetServiceProviderByAppID(ctx, domain.AppID(appID))
+}
+
+// ListServiceProviders returns every registration ordered by entity id.
+// There is at most one per app, so the list is not paginated.
+func (s *Service) ListServiceProviders(ctx context.Context) ([]*domain.ServiceProvider, error) {
+	return s.repo.ListServiceProviders(ctx)
+}
+
+// ----------------------------------------------------------------------------
+// UpdateServiceProvider
+// ----------------------------------------------------------------------------
+
+type UpdateServiceProviderInput struct {
+	AppID        string
+	MaskPaths    []string
+	ExpectedEtag string
+
+	EntityID     string
+	ACSURL       string
+	SLOURL       string
+	Certificate  string
+	NameIDFormat string
+}
+
+func (s *Service) UpdateServiceProvider(ctx context.Context, in UpdateServiceProviderInput) (*domain.ServiceProvider, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	appID, err := app.ParseAppID(in.AppID)
+	if err != nil {
+		return nil, err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, true /*required*/)
+	if err != nil {
+		return nil, err
+	}
+	if len(in.MaskPaths) == 0 {
+		return nil, &validation.Error{Field: "update_mask", Reason: "must list at least one field"}
+	}
+	patch, err := buildServiceProviderPatch(in)
+	if err != nil {
+		return nil, err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeSAMLUpdateServiceProvider)
+	aud.SubjectType = audit.SubjectTypeApp
+	aud.SubjectID = appID.String()
+	aud.AppID = appID.String()
+
+	sp, err := s.repo.GetServiceProviderByAppID(ctx, domain.AppID(appID))
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	if err := sp.ApplyPatch(patch, s.now().UTC()); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+	if err := s.repo.UpdateServiceProvider(ctx, sp, expectedEtag); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, err
+	}
+
+	s.auditor.Success(ctx, aud)
+	return sp, nil
+}
+
+func buildServiceProviderPatch(in UpdateServiceProviderInput) (domain.ServiceProviderPatch, error) {
+	var p domain.ServiceProviderPatch
+	for _, path := range in.MaskPaths {
+		switch path {
+		case "entity_id":
+			v := in.EntityID
+			p.EntityID = &v
+		case "acs_url":
+			v := in.ACSURL
+			p.ACSURL = &v
+		case "slo_url":
+			v := in.SLOURL
+			p.SLOURL = &v
+		case "certificate":
+			v := in.Certificate
+			p.Certificate = &v
+		case "name_id_format":
+			v, err := domain.ParseNameIDFormat(in.NameIDFormat)
+			if err != nil {
+				return domain.ServiceProviderPatch{}, err
+			}
+			p.NameIDFormat = &v
+		default:
+			return domain.ServiceProviderPatch{}, &validation.Error{
+				Field:  "update_mask",
+				Reason: "unknown field path: " + path,
+			}
+		}
+	}
+	return p, nil
+}
+
+// ----------------------------------------------------------------------------
+// DeleteServiceProvider
+// ----------------------------------------------------------------------------
+
+type DeleteServiceProviderInput struct {
+	AppID        string
+	ExpectedEtag string
+}
+
+// DeleteServiceProvider removes the registration together with its
+// participant rows (ON DELETE CASCADE). Sessions already asserted to the
+// SP are not ended; the SP simply drops out of their single logout.
+func (s *Service) DeleteServiceProvider(ctx context.Context, in DeleteServiceProviderInput) error {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return fmt.Errorf("delete service provider: %w", err)
+	}
+	appID, err := app.ParseAppID(in.AppID)
+	if err != nil {
+		return err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, true /*required*/)
+	if err != nil {
+		return err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeSAMLDeleteServiceProvider)
+	aud.SubjectType = audit.SubjectTypeApp
+	aud.SubjectID = appID.String()
+	aud.AppID = appID.String()
+
+	if err := s.repo.DeleteServiceProvider(ctx, domain.AppID(appID), expectedEtag); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return err
+	}
+
+	s.auditor.Success(ctx, aud)
+	return nil
+}
@@ -0,0 +1,120 @@
+// Package service hosts the application-layer use-cases of the saml
+// bounded context (this server acting as a SAML 2.0 identity provider):
+//
+//	service.go  — Service struct + helpers
+//	provider.go — Create/Get/List/Update/DeleteServiceProvider (admin)
+//	sso.go      — SSO (SP- and IdP-initiated)
+//	logout.go   — Logout, HandleLogoutRequest, HandleLogoutResponse
+//
+// SSO never creates sessions: it asserts the session the browser
+// already holds, so signing in stays with auth and federation. Single
+// logout ends that session (the sessions row is revoked) and then walks
+// every SP the session was asserted to.
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"time"
+
+	"sso/internal/modules/access"
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/saml/internal/domain"
+	"sso/internal/modules/session"
+	"sso/internal/platform/saml"
+)
+
+// RoleLister is the slice of access.Service used to fill the "roles"
+// attribute of an assertion.
+type RoleLister interface {
+	ListUserRoles(ctx context.Context, in access.ListUserRolesInput) (access.ListUserRolesOutput, error)
+}
+
+// Config carries the non-dependency settings of the Service.
+type Config struct {
+	// AssertionTTL bounds NotOnOrAfter of issued assertions.
+	AssertionTTL time.Duration
+	// LogoutStateTTL bounds how long an SP may take to answer a
+	// LogoutRequest before the single-logout round is abandoned.
+	LogoutStateTTL time.Duration
+	// PostLogoutURL is where an IdP-initiated logout lands once every
+	// SP has been visited.
+	PostLogoutURL string
+}
+
+type Service struct {
+	repo     domain.Repository
+	users    identity.Repository
+	apps     app.Repository
+	roles    RoleLister
+	sessions session.Repository
+	idp      *saml.IdP
+	cfg      Config
+	now      func() time.Time
+	log      *slog.Logger
+	auditor  auditx.Auditor
+}
+
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	users identity.Repository,
+	apps app.Repository,
+	roles RoleLister,
+	sessions session.Repository,
+	idp *saml.IdP,
+	cfg Config,
+	now func() time.Time,
+	emitter audit.Emitter,
+) *Service {
+	return &Service{
+		repo:     repo,
+		users:    users,
+		apps:     apps,
+		roles:    roles,
+		sessions: sessions,
+		idp:      idp,
+		cfg:      cfg,
+		now:      now,
+		log:      log,
+		auditor:  auditx.New(log, emitter),
+	}
+}
+
+// Metadata returns the IdP EntityDescriptor XML.
+func (s *Service) Metadata() string { return s.idp.Metadata() }
+
+// errReasonMap maps saml sentinels to their audit (Outcome, Reason)
+// pair.
+var errReasonMap = map[error]auditx.OutcomeReason{
+	domain.ErrServiceProviderNotFound:      auditx.Fail(audit.ReasonSAMLServiceProviderNotFound),
+	domain.ErrServiceProviderAlreadyExists: auditx.Fail(audit.ReasonSAMLServiceProviderAlreadyExists),
+	domain.ErrEtagMismatch:                 auditx.Fail(audit.ReasonEtagMismatch),
+	domain.ErrInvalidRequest:               auditx.Fail(audit.ReasonSAMLRequestInvalid),
+	domain.ErrUserActorRequired:            auditx.Deny(audit.ReasonPermissionDenied),
+	domain.ErrUserInactive:                 auditx.Deny(audit.ReasonUserNotEligible),
+	app.ErrAppNotFound:                     auditx.Fail(audit.ReasonAppNotFound),
+	identity.ErrUserNotFound:               auditx.Fail(audit.ReasonUserNotFound),
+	session.ErrSessionNotFound:             auditx.Fail(audit.ReasonSessionNotFound),
+}
+
+// classifyError is the per-package thin wrapper around auditx.Classify.
+func classifyError(err error) (audit.AuditOutcome, string) {
+	return auditx.Classify(err, errReasonMap)
+}
+
+// withOutcome is a thin alias over auditx.WithOutcome.
+func withOutcome(p audit.NewAuditParams, out audit.AuditOutcome, reason string) audit.NewAuditParams {
+	return auditx.WithOutcome(p, out, reason)
+}
+
+// hashState is the storage key of a logout relay value. The raw value
+// only ever travels through the browser.
+func hashState(state string) []byte {
+	sum := sha256.Sum256([]byte(state))
+	return sum[:]
+}
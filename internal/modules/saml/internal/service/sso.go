@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/access"
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/role"
+	"sso/internal/modules/saml/internal/domain"
+	"sso/internal/modules/session"
+	"sso/internal/platform/saml"
+)
+
+// SSOInput — exactly one of SAMLRequest (SP-initiated, HTTP-Redirect
+// binding) and AppID (IdP-initiated) is set. RawQuery is the request's
+// query string exactly as received, needed to check the redirect-binding
+// signature.
+type SSOInput struct {
+	SAMLRequest string
+	RelayState  string
+	RawQuery    string
+	AppID       string
+}
+
+// SSOOutput — the transport auto-posts SAMLResponse and RelayState to
+// ACSURL (HTTP-POST binding).
+type SSOOutput struct {
+	ACSURL       string
+	SAMLResponse string
+	RelayState   string
+}
+
+// SSO issues a signed assertion about the caller's session to an SP and
+// records the session's participation for single logout.
+//
+// SP-initiated requests are matched to a registration by Issuer. When
+// the SP has a certificate on file the request must be signed with it;
+// an AssertionConsumerServiceURL other than the registered one is
+// refused rather than honoured, so an assertion is only ever posted to
+// the endpoint an administrator configured.
+func (s *Service) SSO(ctx context.Context, in SSOInput) (SSOOutput, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return SSOOutput{}, err
+	}
+	if !a.IsUser() || a.SessionID == "" {
+		return SSOOutput{}, domain.ErrUserActorRequired
+	}
+	if (in.SAMLRequest == "") == (in.AppID == "") {
+		return SSOOutput{}, &validation.Error{Field: "SAMLRequest", Reason: "exactly one of SAMLRequest and app_id is required"}
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeSAMLSSO)
+	aud.SubjectType = audit.SubjectTypeUser
+	aud.SubjectID = a.ID
+	aud.Metadata = map[string]string{"initiated_by": "idp"}
+
+	var (
+		sp        *domain.ServiceProvider
+		requestID string
+	)
+	if in.SAMLRequest != "" {
+		aud.Metadata["initiated_by"] = "sp"
+		sp, requestID, err = s.authnRequest(ctx, in)
+	} else {
+		var appID app.AppID
+		if appID, err = app.ParseAppID(in.AppID); err != nil {
+			return SSOOutput{}, err
+		}
+		sp, err = s.repo.GetServiceProviderByAppID(ctx, domain.AppID(appID))
+	}
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return SSOOutput{}, err
+	}
+	aud.AppID = sp.AppID().String()
+	aud.Metadata["entity_id"] = sp.EntityID
+
+	if err := s.requireAppActive(ctx, aud, sp.AppID()); err != nil {
+		return SSOOutput{}, err
+	}
+
+	uid, err := identity.ParseUserID(a.ID)
+	if err != nil {
+		return SSOOutput{}, err
+	}
+	user, err := s.users.GetByID(ctx, uid)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return SSOOutput{}, err
+	}
+	if user.Status() != identity.UserStatusActive {
+		s.auditor.Deny(ctx, aud, audit.ReasonUserNotEligible)
+		return SSOOutput{}, domain.ErrUserInactive
+	}
+	sess, err := s.sessions.GetByID(ctx, session.SessionID(a.SessionID))
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return SSOOutput{}, err
+	}
+
+	roles, err := s.roleNames(ctx, a.ID, sp.AppID())
+	if err != nil {
+		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
+		return SSOOutput{}, fmt.Errorf("saml sso: %w", err)
+	}
+
+	nameID := user.Email
+	if sp.NameIDFormat == domain.NameIDFormatPersistent {
+		nameID = user.ID().String()
+	}
+	sessionIndex, err := s.sessionIndex(ctx, domain.SessionID(a.SessionID), sp.AppID())
+	if err != nil {
+		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
+		return SSOOutput{}, fmt.Errorf("saml sso: %w", err)
+	}
+	resp, err := s.idp.Response(saml.Assertion{
+		InResponseTo: requestID,
+		Audience:     sp.EntityID,
+		Recipient:    sp.ACSURL,
+		NameID:       nameID,
+		NameIDFormat: sp.NameIDFormat.URI(),
+		SessionIndex: sessionIndex,
+		AuthnInstant: sess.IssuedAt(),
+		TTL:          s.cfg.AssertionTTL,
+		Attributes: []saml.Attribute{
+			{Name: "email", Values: nonEmpty(user.Email)},
+			{Name: "username", Values: nonEmpty(user.Username)},
+			{Name: "display_name", Values: nonEmpty(user.DisplayName)},
+			{Name: "roles", Values: roles},
+		},
+	})
+	if err != nil {
+		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
+		return SSOOutput{}, fmt.Errorf("saml sso: %w", err)
+	}
+
+	err = s.repo.UpsertParticipant(ctx, &domain.Participant{
+		SessionID:    domain.SessionID(a.SessionID),
+		AppID:        sp.AppID(),
+		NameID:       nameID,
+		SessionIndex: sessionIndex,
+		CreatedAt:    s.now().UTC(),
+	})
+	if err != nil {
+		s.auditor.Fail(ctx, aud, audit.ReasonInternal)
+		return SSOOutput{}, fmt.Errorf("saml sso: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return SSOOutput{ACSURL: sp.ACSURL, SAMLResponse: resp, RelayState: in.RelayState}, nil
+}
+
+// sessionIndex returns the SessionIndex asserted to the SP for the
+// session: the one it already holds, or a fresh random value. It is
+// opaque and per SP, so an SP never learns the session id (with which
+// any other SP's logout could be forged) and cannot name sessions it
+// was not part of.
+func (s *Service) sessionIndex(ctx context.Context, sid domain.SessionID, appID domain.AppID) (string, error) {
+	p, err := s.repo.GetParticipant(ctx, sid, appID)
+	switch {
+	case err == nil:
+		return p.SessionIndex, nil
+	case errors.Is(err, domain.ErrParticipantNotFound):
+		return saml.NewID()
+	default:
+		return "", err
+	}
+}
+
+// authnRequest decodes and checks an SP-initiated AuthnRequest. Every
+// rejection is ErrInvalidRequest; the reason only goes to the log.
+func (s *Service) authnRequest(ctx context.Context, in SSOInput) (*domain.ServiceProvider, string, error) {
+	raw, err := saml.DecodeRedirect(in.SAMLRequest)
+	if err != nil {
+		return nil, "", s.invalid(ctx, "decode AuthnRequest", err)
+	}
+	req, err := saml.ParseAuthnRequest(raw)
+	if err != nil {
+		return nil, "", s.invalid(ctx, "parse AuthnRequest", err)
+	}
+	sp, err := s.requestingSP(ctx, req.Issuer, in.RawQuery)
+	if err != nil {
+		return nil, "", err
+	}
+	if req.ACSURL != "" && req.ACSURL != sp.ACSURL {
+		return nil, "", s.invalid(ctx, "AuthnRequest ACS URL is not the registered one",
+			fmt.Errorf("got %q, registered %q", req.ACSURL, sp.ACSURL))
+	}
+	return sp, req.ID, nil
+}
+
+// requestingSP resolves the issuer of an inbound message and, when the
+// SP has a certificate on file, checks the redirect-binding signature.
+func (s *Service) requestingSP(ctx context.Context, issuer, rawQuery string) (*domain.ServiceProvider, error) {
+	sp, err := s.repo.GetServiceProviderByEntityID(ctx, issuer)
+	if err != nil {
+		if errors.Is(err, domain.ErrServiceProviderNotFound) {
+			return nil, s.invalid(ctx, "unknown issuer", fmt.Errorf("issuer %q", issuer))
+		}
+		return nil, err
+	}
+	if sp.Certificate == "" {
+		return sp, nil
+	}
+	cert, err := saml.ParseCertificate(sp.Certificate)
+	if err != nil {
+		return nil, fmt.Errorf("saml: stored certificate of %q: %w", sp.EntityID, err)
+	}
+	if err := saml.VerifyRedirect(rawQuery, cert); err != nil {
+		return nil, s.invalid(ctx, "signature check failed", fmt.Errorf("issuer %q: %w", issuer, err))
+	}
+	return sp, nil
+}
+
+// invalid logs why an inbound message was refused and returns
+// ErrInvalidRequest.
+func (s *Service) invalid(ctx context.Context, msg string, err error) error {
+	s.log.WarnContext(ctx, "saml: "+msg, "err", err)
+	return domain.ErrInvalidRequest
+}
+
+// requireAppActive refuses SSO into a disabled or maintenance app, with
+// the same audit reasons as a password login.
+func (s *Service) requireAppActive(ctx context.Context, aud audit.NewAuditParams, appID domain.AppID) error {
+	a, err := s.apps.GetByID(ctx, app.AppID(appID))
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return err
+	}
+	switch a.Status() {
+	case app.AppStatusActive:
+		return nil
+	case app.AppStatusMaintenance:
+		s.auditor.Deny(ctx, aud, audit.ReasonAppInMaintenance)
+	default:
+		s.auditor.Deny(ctx, aud, audit.ReasonAppDisabled)
+	}
+	return domain.ErrAppUnavailable
+}
+
+// roleNames lists the names of the user's roles in the app, walking
+// every page. Disabled roles are left out: they grant nothing.
+func (s *Service) roleNames(ctx context.Context, userID string, appID domain.AppID) ([]string, error) {
+	var (
+		names []string
+		token string
+	)
+	for {
+		out, err := s.roles.ListUserRoles(ctx, access.ListUserRolesInput{
+			UserID:    userID,
+			AppID:     appID.String(),
+			PageToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list roles: %w", err)
+		}
+		for _, r := range out.Roles {
+			if r.Status() == role.RoleStatusActive {
+				names = append(names, r.Name)
+			}
+		}
+		if out.NextPageToken == "" {
+			return names, nil
+		}
+		token = out.NextPageToken
+	}
+}
+
+func nonEmpty(v string) []string {
+	if v == "" {
+		return nil
+	}
+	return []string{v}
+}
@@ -0,0 +1,152 @@
+// Package saml exposes the wire-up for the saml bounded context.
+// bootstrap.New constructs a single *saml.Module and pulls everything
+// else off it:
+//
+//	mod.RegisterHTTP(mux)  // mounts the /v1/saml/* endpoints
+//	mod.Service()          // application-layer service (rare)
+//	mod.Repository()       // persistence contract
+//
+// SAML is a browser protocol, so the surface is HTTP-only.
+package saml
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"sso/internal/modules/app"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/saml/internal/httpapi"
+	"sso/internal/modules/saml/internal/mariadb"
+	"sso/internal/modules/saml/internal/service"
+	"sso/internal/modules/session"
+	platsaml "sso/internal/platform/saml"
+)
+
+// Emitter is the audit-event sink the module uses to record write
+// operations. Type alias over audit.Emitter — keeps the wiring
+// contract local to this module.
+type Emitter = audit.Emitter
+
+// Deps lists everything saml needs from its host.
+type Deps struct {
+	DB  *sql.DB
+	Log *slog.Logger
+
+	Users         identity.Repository
+	Apps          app.Repository
+	Sessions      session.Repository
+	Roles         RoleLister    // *access.Service
+	Authenticator Authenticator // *grpcauth.Interceptor
+	Authorizer    Authorizer    // *httprbac.Authorizer
+
+	// Keys is the IdP signing pair (platform/saml.LoadKeyPair).
+	Keys *platsaml.KeyPair
+
+	EntityID       string
+	BaseURL        string
+	LoginURL       string
+	PostLogoutURL  string
+	AssertionTTL   time.Duration // default 5m
+	LogoutStateTTL time.Duration // default 5m
+
+	Clock func() time.Time
+	Audit Emitter
+}
+
+// Module is the assembled saml bounded context.
+type Module struct {
+	service *service.Service
+	handler *httpapi.Handler
+	repo    *mariadb.Repository
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.DB == nil {
+		return nil, fmt.Errorf("saml: db is required")
+	}
+	if d.Log == nil {
+		return nil, fmt.Errorf("saml: log is required")
+	}
+	if d.Users == nil {
+		return nil, fmt.Errorf("saml: users repository is required")
+	}
+	if d.Apps == nil {
+		return nil, fmt.Errorf("saml: apps repository is required")
+	}
+	if d.Sessions == nil {
+		return nil, fmt.Errorf("saml: sessions repository is required")
+	}
+	if d.Roles == nil {
+		return nil, fmt.Errorf("saml: role lister is required")
+	}
+	if d.Authenticator == nil {
+		return nil, fmt.Errorf("saml: authenticator is required")
+	}
+	if d.Authorizer == nil {
+		return nil, fmt.Errorf("saml: authorizer is required")
+	}
+	if d.Keys == nil {
+		return nil, fmt.Errorf("saml: signing keys are required")
+	}
+	if d.EntityID == "" || d.BaseURL == "" {
+		return nil, fmt.Errorf("saml: entity id and base url are required")
+	}
+	if d.AssertionTTL <= 0 {
+		d.AssertionTTL = 5 * time.Minute
+	}
+	if d.LogoutStateTTL <= 0 {
+		d.LogoutStateTTL = 5 * time.Minute
+	}
+	if d.Clock == nil {
+		d.Clock = time.Now
+	}
+	if d.Audit == nil {
+		d.Audit = audit.NopEmitter{}
+	}
+
+	repo := mariadb.NewRepository(d.DB)
+
+	var _ Repository = repo
+
+	base := strings.TrimSuffix(d.BaseURL, "/")
+	idp := &platsaml.IdP{
+		EntityID: d.EntityID,
+		SSOURL:   base + "/v1/saml/sso",
+		SLOURL:   base + "/v1/saml/slo",
+		Keys:     d.Keys,
+		Now:      d.Clock,
+	}
+
+	svc := service.NewService(d.Log, repo, d.Users, d.Apps, d.Roles, d.Sessions, idp,
+		service.Config{
+			AssertionTTL:   d.AssertionTTL,
+			LogoutStateTTL: d.LogoutStateTTL,
+			PostLogoutURL:  d.PostLogoutURL,
+		},
+		d.Clock, d.Audit)
+
+	return &Module{
+		service: svc,
+		handler: httpapi.NewHandler(svc, d.Authenticator, d.Authorizer,
+			httpapi.Config{BaseURL: base, LoginURL: d.LoginURL}, d.Log),
+		repo: repo,
+	}, nil
+}
+
+// RegisterHTTP mounts the saml endpoints on the HTTP listener's root
+// mux.
+func (m *Module) RegisterHTTP(mux *http.ServeMux) {
+	m.handler.Register(mux)
+}
+
+// Service returns the application-layer Service.
+func (m *Module) Service() *service.Service { return m.service }
+
+// Repository returns the persistence contract.
+func (m *Module) Repository() Repository { return m.repo }
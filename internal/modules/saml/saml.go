@@ -0,0 +1,56 @@
+// Package saml is the public API of the saml bounded context (this
+// server acting as a SAML 2.0 identity provider for apps that only
+// speak SAML). External callers interact with the module through:
+//
+//	saml.New(Deps)     wires the module (module.go)
+//	saml.Service       application-layer use-cases (service.go)
+//	saml.Repository    persistence contract
+//
+// The type aliases below let other modules program against
+// saml.ServiceProvider etc. instead of importing the internal domain
+// package directly.
+package saml
+
+import (
+	"sso/internal/modules/saml/internal/domain"
+	"sso/internal/modules/saml/internal/httpapi"
+	"sso/internal/modules/saml/internal/service"
+)
+
+type (
+	ServiceProvider              = domain.ServiceProvider
+	ServiceProviderPatch         = domain.ServiceProviderPatch
+	NewServiceProviderParams     = domain.NewServiceProviderParams
+	RestoreServiceProviderParams = domain.RestoreServiceProviderParams
+	NameIDFormat                 = domain.NameIDFormat
+	Participant                  = domain.Participant
+	LogoutState                  = domain.LogoutState
+	Repository                   = domain.Repository
+
+	// RoleLister is satisfied by *access.Service.
+	RoleLister = service.RoleLister
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+)
+
+// NameIDFormat enum re-exports.
+const (
+	NameIDFormatEmail      = domain.NameIDFormatEmail
+	NameIDFormatPersistent = domain.NameIDFormatPersistent
+)
+
+var ParseNameIDFormat = domain.ParseNameIDFormat
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var (
+	ErrServiceProviderNotFound      = domain.ErrServiceProviderNotFound
+	ErrServiceProviderAlreadyExists = domain.ErrServiceProviderAlreadyExists
+	ErrEtagMismatch                 = domain.ErrEtagMismatch
+	ErrInvalidRequest               = domain.ErrInvalidRequest
+	ErrUserActorRequired            = domain.ErrUserActorRequired
+	ErrAppUnavailable               = domain.ErrAppUnavailable
+	ErrUserInactive                 = domain.ErrUserInactive
+	ErrParticipantNotFound          = domain.ErrParticipantNotFound
+)
@@ -0,0 +1,20 @@
+// Package saml re-exports the application-layer Service together with
+// the typed Input/Output structs declared in internal/service.
+package saml
+
+import "sso/internal/modules/saml/internal/service"
+
+// Service is the use-case orchestrator, split across files in
+// internal/service: provider.go, sso.go, logout.go.
+type Service = service.Service
+
+// Input / Output type aliases.
+type (
+	CreateServiceProviderInput = service.CreateServiceProviderInput
+	UpdateServiceProviderInput = service.UpdateServiceProviderInput
+	DeleteServiceProviderInput = service.DeleteServiceProviderInput
+	SSOInput                   = service.SSOInput
+	SSOOutput                  = service.SSOOutput
+	SLOInput                   = service.SLOInput
+	LogoutOutput               = service.LogoutOutput
+)
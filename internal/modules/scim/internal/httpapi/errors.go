@@ -0,0 +1,29 @@
+package httpapi
+
+import (
+	"sso/internal/modules/identity"
+	scimsvc "sso/internal/modules/scim/internal/service"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap translates the identity sentinels provisioning can hit into
+// statuses; writeError turns those into SCIM error bodies.
+var errorMap = map[error]grpcerr.ErrorMapping{
+	identity.ErrUserNotFound: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_NOT_FOUND, Message: "user not found"},
+	identity.ErrUserAlreadyExists: {
+		Code: codes.AlreadyExists, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_ALREADY_EXISTS, Message: "userName or email already in use"},
+	identity.ErrEtagMismatch: {
+		Code: codes.Aborted, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ETAG_MISMATCH, Message: "version mismatch"},
+	identity.ErrUserDeleted: {
+		Code: codes.NotFound, Reason: ssocommonv1.ErrorReason_ERROR_REASON_USER_DELETED, Message: "user not found"},
+	scimsvc.ErrServiceAccountRequired: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "a service account token is required"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
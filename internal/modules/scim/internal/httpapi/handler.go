@@ -0,0 +1,304 @@
+// Package httpapi is the HTTP adapter for the scim context: the SCIM 2.0
+// protocol endpoints (RFC 7644) under /scim/v2. SCIM is JSON over plain
+// HTTP with its own media type and error shape, so these are hand-written
+// net/http handlers mounted next to the grpc-gateway rather than gateway
+// routes. Errors use the SCIM error body, not google.rpc.Status.
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/scim/internal/schema"
+	scimsvc "sso/internal/modules/scim/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+// Config — BaseURL is the externally visible origin; meta.location is
+// built from it.
+type Config struct {
+	BaseURL string
+}
+
+type Handler struct {
+	svc *scimsvc.Service
+	api *apiutil.Adapter
+	cfg Config
+	log *slog.Logger
+}
+
+func NewHandler(svc *scimsvc.Service, authn Authenticator, authz Authorizer, cfg Config, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("scim", authn, authz, log, toStatus), cfg: cfg, log: log}
+}
+
+const contentType = "application/scim+json"
+
+// Register mounts the scim endpoints. Discovery is public (RFC 7644
+// §4); everything under /Users needs a service-account bearer token.
+//
+//	GET    /scim/v2/ServiceProviderConfig
+//	GET    /scim/v2/ResourceTypes
+//	GET    /scim/v2/ResourceTypes/User
+//	GET    /scim/v2/Users?filter=&startIndex=&count=
+//	POST   /scim/v2/Users
+//	GET    /scim/v2/Users/{id}
+//	PUT    /scim/v2/Users/{id}
+//	PATCH  /scim/v2/Users/{id}
+//	DELETE /scim/v2/Users/{id}
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /scim/v2/ServiceProviderConfig", h.serviceProviderConfig)
+	mux.HandleFunc("GET /scim/v2/ResourceTypes", h.resourceTypes)
+	mux.HandleFunc("GET /scim/v2/ResourceTypes/User", h.resourceType)
+	mux.HandleFunc("GET /scim/v2/Users", h.authed(h.listUsers))
+	mux.HandleFunc("POST /scim/v2/Users", h.authed(h.createUser))
+	mux.HandleFunc("GET /scim/v2/Users/{id}", h.authed(h.getUser))
+	mux.HandleFunc("PUT /scim/v2/Users/{id}", h.authed(h.replaceUser))
+	mux.HandleFunc("PATCH /scim/v2/Users/{id}", h.authed(h.patchUser))
+	mux.HandleFunc("DELETE /scim/v2/Users/{id}", h.authed(h.deleteUser))
+}
+
+// ----------------------------------------------------------------------------
+// Discovery
+// ----------------------------------------------------------------------------
+
+func (h *Handler) serviceProviderConfig(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, serviceProviderConfig())
+}
+
+func (h *Handler) resourceTypes(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":      []string{schema.ListResponseSchema},
+		"totalResults": 1,
+		"startIndex":   1,
+		"itemsPerPage": 1,
+		"Resources":    []any{h.userResourceType()},
+	})
+}
+
+func (h *Handler) resourceType(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, h.userResourceType())
+}
+
+// ----------------------------------------------------------------------------
+// Users
+// ----------------------------------------------------------------------------
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	startIndex, err := intParam(q.Get("startIndex"), 1)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	count, err := intParam(q.Get("count"), auditx.DefaultListPageSize)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	out, err := h.svc.List(r.Context(), scimsvc.ListInput{
+		Filter:     q.Get("filter"),
+		StartIndex: startIndex,
+		Count:      count,
+	})
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	resp := schema.ListResponse{
+		Schemas:      []string{schema.ListResponseSchema},
+		TotalResults: out.TotalResults,
+		StartIndex:   out.StartIndex,
+		ItemsPerPage: len(out.Users),
+		Resources:    make([]schema.User, 0, len(out.Users)),
+	}
+	for _, u := range out.Users {
+		resp.Resources = append(resp.Resources, h.userResource(u))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) {
+	var b schema.User
+	if err := decodeJSON(r, &b); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	u, err := h.svc.Create(r.Context(), b.Attributes())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	res := h.userResource(u)
+	w.Header().Set("Location", res.Meta.Location)
+	h.writeResource(w, http.StatusCreated, res)
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) {
+	u, err := h.svc.Get(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeResource(w, http.StatusOK, h.userResource(u))
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	var b schema.User
+	if err := decodeJSON(r, &b); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	u, err := h.svc.Replace(r.Context(), r.PathValue("id"), ifMatch(r.Header.Get("If-Match")), b.Attributes())
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeResource(w, http.StatusOK, h.userResource(u))
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request) {
+	var b schema.PatchRequest
+	if err := decodeJSON(r, &b); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	u, err := h.svc.Patch(r.Context(), r.PathValue("id"), ifMatch(r.Header.Get("If-Match")), b.Operations)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	h.writeResource(w, http.StatusOK, h.userResource(u))
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	if err := h.svc.Delete(r.Context(), r.PathValue("id"), ifMatch(r.Header.Get("If-Match"))); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ----------------------------------------------------------------------------
+// Plumbing
+// ----------------------------------------------------------------------------
+
+var errBadBody = &schema.Error{Type: schema.ErrInvalidSyntax, Detail: "malformed JSON body"}
+
+// authed is apiutil.Adapter.Authed answering in SCIM's error shape.
+// The service-account check is the service's.
+func (h *Handler) authed(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		a, ok := h.api.Authenticate(r)
+		if !ok {
+			h.writeError(w, r, apiutil.ErrUnauthenticated)
+			return
+		}
+		r, err := h.api.Bind(r, a)
+		if err == nil {
+			err = h.api.Authorize(r, a)
+		}
+		if err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// writeError renders the SCIM error body (RFC 7644 §3.12). Protocol
+// errors carry their scimType; domain errors go through errorMap and
+// the gateway's code → HTTP status table, except a version mismatch,
+// which SCIM reports as 412.
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var (
+		code     int
+		scimType string
+		detail   string
+	)
+	var serr *schema.Error
+	var verr *validation.Error
+	switch {
+	case errors.As(err, &serr):
+		code, scimType, detail = http.StatusBadRequest, string(serr.Type), serr.Detail
+	case errors.As(err, &verr):
+		code, scimType, detail = http.StatusBadRequest, string(schema.ErrInvalidValue), verr.Field+": "+verr.Reason
+	default:
+		if _, ok := status.FromError(err); !ok {
+			err = toStatus(err)
+		}
+		st := status.Convert(err)
+		code, detail = runtime.HTTPStatusFromCode(st.Code()), st.Message()
+		switch st.Code() {
+		case codes.Internal:
+			h.log.ErrorContext(r.Context(), "scim http", "path", r.URL.Path, "err", err)
+		case codes.AlreadyExists:
+			scimType = "uniqueness"
+		case codes.Aborted:
+			code = http.StatusPreconditionFailed
+		case codes.Unauthenticated:
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		}
+	}
+
+	body := map[string]any{
+		"schemas": []string{schema.ErrorSchema},
+		"status":  strconv.Itoa(code),
+		"detail":  detail,
+	}
+	if scimType != "" {
+		body["scimType"] = scimType
+	}
+	writeJSON(w, code, body)
+}
+
+// writeResource writes a single resource with its version as ETag.
+func (h *Handler) writeResource(w http.ResponseWriter, code int, res schema.User) {
+	w.Header().Set("ETag", res.Meta.Version)
+	writeJSON(w, code, res)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// decodeJSON is lenient about unknown attributes: clients send whole
+// profiles (name, externalId, extensions) of which we keep a part.
+func decodeJSON(r *http.Request, dst any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(nil, r.Body, apiutil.MaxBodyBytes))
+	if err := dec.Decode(dst); err != nil {
+		return errBadBody
+	}
+	return nil
+}
+
+// intParam parses a paging parameter; "" is def.
+func intParam(s string, def int) (int, error) {
+	if s == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, &schema.Error{Type: schema.ErrInvalidValue, Detail: "startIndex and count must be integers"}
+	}
+	return n, nil
+}
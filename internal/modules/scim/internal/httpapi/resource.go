@@ -0,0 +1,93 @@
+package httpapi
+
+import (
+	"strings"
+	"time"
+
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/scim/internal/schema"
+)
+
+// userResource renders a user. The SCIM version is our etag, weak
+// because the resource is a projection of the row rather than its
+// bytes.
+func (h *Handler) userResource(u *identity.User) schema.User {
+	active := u.Status() == identity.UserStatusActive
+	r := schema.User{
+		Schemas:     []string{schema.UserSchema},
+		ID:          u.ID().String(),
+		UserName:    u.Username,
+		DisplayName: u.DisplayName,
+		Locale:      u.Locale,
+		Timezone:    u.Timezone,
+		Active:      &active,
+		Meta: &schema.Meta{
+			ResourceType: "User",
+			Created:      u.CreatedAt().UTC().Format(time.RFC3339),
+			LastModified: u.UpdatedAt().UTC().Format(time.RFC3339),
+			Location:     h.cfg.BaseURL + "/scim/v2/Users/" + u.ID().String(),
+			Version:      weakETag(u.Etag().String()),
+		},
+	}
+	if u.Email != "" {
+		r.Emails = []schema.MultiValue{{Value: u.Email, Type: "work", Primary: true}}
+	}
+	if u.AvatarURL != "" {
+		r.Photos = []schema.MultiValue{{Value: u.AvatarURL, Type: "photo", Primary: true}}
+	}
+	return r
+}
+
+func weakETag(etag string) string {
+	return `W/"` + etag + `"`
+}
+
+// ifMatch extracts our etag from an If-Match header: W/"x", "x" or *.
+// Only the first of a list is used; we only ever hand out one version.
+// "" means no precondition.
+func ifMatch(header string) string {
+	v, _, _ := strings.Cut(header, ",")
+	v = strings.TrimSpace(v)
+	if v == "*" {
+		return identity.EtagWildcard
+	}
+	v = strings.TrimPrefix(v, "W/")
+	return strings.Trim(v, `"`)
+}
+
+// serviceProviderConfig advertises what this endpoint supports
+// (RFC 7643 §5).
+func serviceProviderConfig() map[string]any {
+	return map[string]any{
+		"schemas":        []string{schema.ServiceProviderConfigSchema},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": auditx.MaxListPageSize},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": true},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Service account access token in the Authorization header",
+			"primary":     true,
+		}},
+		"meta": map[string]any{"resourceType": "ServiceProviderConfig"},
+	}
+}
+
+func (h *Handler) userResourceType() map[string]any {
+	return map[string]any{
+		"schemas":     []string{schema.ResourceTypeSchema},
+		"id":          "User",
+		"name":        "User",
+		"endpoint":    "/Users",
+		"description": "User Account",
+		"schema":      schema.UserSchema,
+		"meta": map[string]any{
+			"resourceType": "ResourceType",
+			"location":     h.cfg.BaseURL + "/scim/v2/ResourceTypes/User",
+		},
+	}
+}
@@ -0,0 +1,24 @@
+package schema
+
+// ErrorType is a scimType detail error keyword (RFC 7644 §3.12). Every
+// *Error is a 400 Bad Request.
+type ErrorType string
+
+const (
+	ErrInvalidFilter ErrorType = "invalidFilter"
+	ErrInvalidSyntax ErrorType = "invalidSyntax"
+	ErrInvalidPath   ErrorType = "invalidPath"
+	ErrInvalidValue  ErrorType = "invalidValue"
+	ErrNoTarget      ErrorType = "noTarget"
+	ErrMutability    ErrorType = "mutability"
+)
+
+// Error is a protocol-level rejection of a request.
+type Error struct {
+	Type   ErrorType
+	Detail string
+}
+
+func (e *Error) Error() string {
+	return "scim: " + string(e.Type) + ": " + e.Detail
+}
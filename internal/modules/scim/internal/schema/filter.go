@@ -0,0 +1,149 @@
+package schema
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Filterable attributes. Attribute names are case-insensitive in SCIM;
+// these are the lower-cased, URN-stripped forms.
+const (
+	AttrUserName    = "username"
+	AttrDisplayName = "displayname"
+	AttrEmails      = "emails"
+	AttrActive      = "active"
+)
+
+// Term is one "attr eq value" comparison. Value is a string, or a bool
+// for active.
+type Term struct {
+	Attr  string
+	Value any
+}
+
+// ParseFilter parses the subset of the filter grammar that maps onto the
+// directory's list query: equality on userName, displayName, emails (or
+// emails.value) and active, joined with "and". That is what provisioning
+// clients use to look a user up before creating it; anything richer
+// (or, not, pr, co, sw, grouping, value paths) is rejected as
+// invalidFilter rather than answered wrongly.
+//
+// An empty filter yields no terms.
+func ParseFilter(s string) ([]Term, error) {
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	var terms []Term
+	for len(toks) > 0 {
+		if len(terms) > 0 {
+			if toks[0].quoted || !strings.EqualFold(toks[0].text, "and") {
+				return nil, invalidFilter("only \"and\" may join comparisons")
+			}
+			toks = toks[1:]
+		}
+		if len(toks) < 3 {
+			return nil, invalidFilter("expected: attribute eq value")
+		}
+		attr, op, val := toks[0], toks[1], toks[2]
+		toks = toks[3:]
+
+		if attr.quoted {
+			return nil, invalidFilter("attribute name must not be quoted")
+		}
+		if op.quoted || !strings.EqualFold(op.text, "eq") {
+			return nil, invalidFilter("unsupported operator " + op.text)
+		}
+		name := normalizePath(attr.text)
+		switch name {
+		case AttrUserName, AttrDisplayName, AttrEmails, AttrEmails + ".value":
+			if !val.quoted {
+				return nil, invalidFilter(attr.text + " must be compared with a string")
+			}
+			if name != AttrUserName && name != AttrDisplayName {
+				name = AttrEmails
+			}
+			terms = append(terms, Term{Attr: name, Value: val.text})
+		case AttrActive:
+			b, ok := parseBool(val)
+			if !ok {
+				return nil, invalidFilter("active must be compared with true or false")
+			}
+			terms = append(terms, Term{Attr: AttrActive, Value: b})
+		default:
+			return nil, invalidFilter("unsupported attribute " + attr.text)
+		}
+	}
+	return terms, nil
+}
+
+// normalizePath lower-cases an attribute path and strips the core User
+// schema URN a fully qualified path carries.
+func normalizePath(p string) string {
+	p = strings.ToLower(p)
+	if rest, ok := strings.CutPrefix(p, strings.ToLower(UserSchema)+":"); ok {
+		return rest
+	}
+	return p
+}
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+// tokenize splits a filter on whitespace. A quoted token is a JSON string
+// and is unescaped as one. Parentheses and brackets are not split off:
+// they end up inside a token that then fails as an unsupported
+// attribute or operator.
+func tokenize(s string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(s); {
+		switch {
+		case s[i] == ' ' || s[i] == '\t':
+			i++
+		case s[i] == '"':
+			j := i + 1
+			for j < len(s) && s[j] != '"' {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				return nil, invalidFilter("unterminated string")
+			}
+			var v string
+			if err := json.Unmarshal([]byte(s[i:j+1]), &v); err != nil {
+				return nil, invalidFilter("malformed string")
+			}
+			toks = append(toks, token{text: v, quoted: true})
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && s[j] != ' ' && s[j] != '\t' {
+				j++
+			}
+			toks = append(toks, token{text: s[i:j]})
+			i = j
+		}
+	}
+	return toks, nil
+}
+
+func parseBool(t token) (bool, bool) {
+	if t.quoted {
+		return false, false
+	}
+	switch strings.ToLower(t.text) {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+	return false, false
+}
+
+func invalidFilter(detail string) error {
+	return &Error{Type: ErrInvalidFilter, Detail: detail}
+}
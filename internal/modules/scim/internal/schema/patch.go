@@ -0,0 +1,156 @@
+package schema
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// PatchRequest is the body of a PATCH (RFC 7644 §3.5.2).
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Apply runs ops against a in order and validates the result; a is left
+// partially modified on error.
+//
+// Op names are case-insensitive ("Replace" is common). The directory
+// holds a single email and a single photo, so add and replace behave
+// the same, and a value filter in a path (emails[type eq "work"].value)
+// addresses that one value whatever the filter says. Paths naming an
+// attribute the directory does not store (name.givenName, externalId,
+// extension attributes) are ignored, just as on POST and PUT, so a
+// client syncing its full profile is not refused.
+func (a *Attributes) Apply(ops []PatchOperation) error {
+	for _, op := range ops {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if op.Path == "" {
+				var m map[string]json.RawMessage
+				if err := json.Unmarshal(op.Value, &m); err != nil {
+					return &Error{Type: ErrInvalidValue, Detail: "an operation without path needs an object value"}
+				}
+				for k, v := range m {
+					if err := a.set(k, v); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := a.set(op.Path, op.Value); err != nil {
+				return err
+			}
+		case "remove":
+			if op.Path == "" {
+				return &Error{Type: ErrNoTarget, Detail: "remove needs a path"}
+			}
+			if err := a.remove(op.Path); err != nil {
+				return err
+			}
+		default:
+			return &Error{Type: ErrInvalidSyntax, Detail: "unknown op " + op.Op}
+		}
+	}
+	return a.Validate()
+}
+
+func (a *Attributes) set(path string, raw json.RawMessage) error {
+	switch p := patchPath(path); p {
+	case AttrUserName:
+		return decodeString(p, raw, &a.UserName)
+	case AttrDisplayName:
+		return decodeString(p, raw, &a.DisplayName)
+	case "locale":
+		return decodeString(p, raw, &a.Locale)
+	case "timezone":
+		return decodeString(p, raw, &a.Timezone)
+	case AttrActive:
+		return decodeBool(p, raw, &a.Active)
+	case AttrEmails:
+		return decodeMulti(p, raw, &a.Email)
+	case AttrEmails + ".value":
+		return decodeString(p, raw, &a.Email)
+	case "photos":
+		return decodeMulti(p, raw, &a.AvatarURL)
+	case "photos.value":
+		return decodeString(p, raw, &a.AvatarURL)
+	}
+	return nil
+}
+
+func (a *Attributes) remove(path string) error {
+	switch p := patchPath(path); p {
+	case AttrUserName, AttrEmails, AttrEmails + ".value":
+		return &Error{Type: ErrMutability, Detail: path + " is required and cannot be removed"}
+	case AttrActive:
+		return &Error{Type: ErrMutability, Detail: "active cannot be removed"}
+	case AttrDisplayName:
+		a.DisplayName = ""
+	case "locale":
+		a.Locale = ""
+	case "timezone":
+		a.Timezone = ""
+	case "photos", "photos.value":
+		a.AvatarURL = ""
+	}
+	return nil
+}
+
+// patchPath normalises a PATCH path and drops a value filter:
+// emails[type eq "work"].value → emails.value.
+func patchPath(path string) string {
+	if i := strings.IndexByte(path, '['); i >= 0 {
+		if j := strings.IndexByte(path[i:], ']'); j >= 0 {
+			path = path[:i] + path[i+j+1:]
+		}
+	}
+	return normalizePath(path)
+}
+
+func decodeString(path string, raw json.RawMessage, dst *string) error {
+	if err := json.Unmarshal(raw, dst); err != nil {
+		return &Error{Type: ErrInvalidValue, Detail: path + " must be a string"}
+	}
+	return nil
+}
+
+// decodeBool also takes "True"/"False" strings, which some clients send
+// for active.
+func decodeBool(path string, raw json.RawMessage, dst *bool) error {
+	if err := json.Unmarshal(raw, dst); err == nil {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		switch strings.ToLower(s) {
+		case "true":
+			*dst = true
+			return nil
+		case "false":
+			*dst = false
+			return nil
+		}
+	}
+	return &Error{Type: ErrInvalidValue, Detail: path + " must be a boolean"}
+}
+
+// decodeMulti takes the primary (or first) value of a multi-valued
+// attribute given as an array or a single object.
+func decodeMulti(path string, raw json.RawMessage, dst *string) error {
+	var vs []MultiValue
+	if err := json.Unmarshal(raw, &vs); err != nil {
+		var v MultiValue
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return &Error{Type: ErrInvalidValue, Detail: path + " must be a list of values"}
+		}
+		vs = []MultiValue{v}
+	}
+	*dst = primary(vs)
+	return nil
+}
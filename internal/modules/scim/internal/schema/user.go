@@ -0,0 +1,114 @@
+// Package schema holds the SCIM 2.0 wire types (RFC 7643) and the two
+// pieces of the protocol (RFC 7644) that are pure data manipulation:
+// the filter grammar and PATCH operations. It knows nothing about the
+// identity module; the service layer translates Attributes to and from
+// identity.User.
+package schema
+
+import (
+	"strings"
+)
+
+// Schema and message URNs.
+const (
+	UserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	ListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	ServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	ResourceTypeSchema          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+// User is the core User resource, limited to the attributes the
+// directory stores. Inbound documents may carry more (name, externalId,
+// enterprise extension, ...); they are accepted and ignored.
+type User struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	UserName    string       `json:"userName"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Locale      string       `json:"locale,omitempty"`
+	Timezone    string       `json:"timezone,omitempty"`
+	Active      *bool        `json:"active,omitempty"`
+	Emails      []MultiValue `json:"emails,omitempty"`
+	Photos      []MultiValue `json:"photos,omitempty"`
+	Meta        *Meta        `json:"meta,omitempty"`
+}
+
+// MultiValue is one entry of a multi-valued attribute (emails, photos).
+type MultiValue struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+// ListResponse is the body of a query (RFC 7644 §3.4.2).
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []User   `json:"Resources"`
+}
+
+// Attributes is the flattened view of a user the directory can store:
+// one email, one photo. A SCIM User maps onto it by taking the primary
+// (or else the first) value of each multi-valued attribute.
+type Attributes struct {
+	UserName    string
+	DisplayName string
+	Email       string
+	AvatarURL   string
+	Locale      string
+	Timezone    string
+	Active      bool
+}
+
+// Attributes flattens u. An absent active means true, as in a create
+// without the attribute.
+func (u *User) Attributes() Attributes {
+	a := Attributes{
+		UserName:    u.UserName,
+		DisplayName: u.DisplayName,
+		Email:       primary(u.Emails),
+		AvatarURL:   primary(u.Photos),
+		Locale:      u.Locale,
+		Timezone:    u.Timezone,
+		Active:      true,
+	}
+	if u.Active != nil {
+		a.Active = *u.Active
+	}
+	return a
+}
+
+// Validate checks the attributes the directory cannot do without.
+func (a Attributes) Validate() error {
+	if strings.TrimSpace(a.UserName) == "" {
+		return &Error{Type: ErrInvalidValue, Detail: "userName is required"}
+	}
+	if strings.TrimSpace(a.Email) == "" {
+		return &Error{Type: ErrInvalidValue, Detail: "an email is required"}
+	}
+	return nil
+}
+
+func primary(vs []MultiValue) string {
+	for _, v := range vs {
+		if v.Primary {
+			return v.Value
+		}
+	}
+	if len(vs) > 0 {
+		return vs[0].Value
+	}
+	return ""
+}
@@ -0,0 +1,322 @@
+// Package service maps SCIM provisioning onto identity.Service. It holds
+// no state of its own: every write goes through the identity use-cases,
+// so provisioning is audited, etag-checked and validated exactly like
+// the admin RPCs, with the calling service account as the actor.
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/identity"
+	"sso/internal/modules/scim/internal/schema"
+)
+
+// ErrServiceAccountRequired — SCIM clients authenticate as service
+// accounts; user tokens are refused.
+var ErrServiceAccountRequired = errors.New("scim: a service account token is required")
+
+// Users is the slice of identity.Service provisioning needs. Satisfied
+// by *identity.Service.
+type Users interface {
+	CreateUser(ctx context.Context, in identity.CreateUserInput) (*identity.User, error)
+	GetUser(ctx context.Context, id string) (*identity.User, error)
+	ListUsers(ctx context.Context, in identity.ListUsersInput) (identity.ListUsersOutput, error)
+	UpdateUser(ctx context.Context, in identity.UpdateUserInput) (*identity.User, error)
+	DisableUser(ctx context.Context, in identity.DisableUserInput) error
+	EnableUser(ctx context.Context, in identity.EnableUserInput) error
+	SoftDeleteUser(ctx context.Context, in identity.SoftDeleteUserInput) error
+}
+
+type Service struct {
+	log   *slog.Logger
+	users Users
+}
+
+func NewService(log *slog.Logger, users Users) *Service {
+	return &Service{log: log, users: users}
+}
+
+// ----------------------------------------------------------------------------
+// Queries
+// ----------------------------------------------------------------------------
+
+// ListInput — StartIndex is 1-based; Count 0 asks for the total only.
+type ListInput struct {
+	Filter     string
+	StartIndex int
+	Count      int
+}
+
+type ListOutput struct {
+	Users        []*identity.User
+	TotalResults int
+	StartIndex   int
+}
+
+// List answers a SCIM query with one page read at the requested offset,
+// oldest first (an offset stays stable while users are added), and a
+// COUNT of every match for totalResults.
+func (s *Service) List(ctx context.Context, in ListInput) (ListOutput, error) {
+	if err := requireServiceAccount(ctx); err != nil {
+		return ListOutput{}, err
+	}
+	terms, err := schema.ParseFilter(in.Filter)
+	if err != nil {
+		return ListOutput{}, err
+	}
+	if in.StartIndex < 1 {
+		in.StartIndex = 1
+	}
+	in.Count = max(0, min(in.Count, auditx.MaxListPageSize))
+
+	q, ok := listQuery(terms)
+	if !ok {
+		return ListOutput{StartIndex: in.StartIndex}, nil
+	}
+	q.PageSize = int32(max(in.Count, 1))
+	q.Offset = in.StartIndex - 1
+	q.OrderBy = identity.OrderByCreatedAtAsc
+	q.CountTotal = true
+
+	page, err := s.users.ListUsers(ctx, q)
+	if err != nil {
+		return ListOutput{}, err
+	}
+	out := ListOutput{StartIndex: in.StartIndex}
+	if page.TotalSize != nil {
+		out.TotalResults = *page.TotalSize
+	}
+	if in.Count > 0 {
+		out.Users = page.Users
+	}
+	return out, nil
+}
+
+// listQuery turns filter terms into list filters. The list query ORs
+// values within a field, a SCIM "and" needs all of them to hold, so two
+// different values for one attribute match nothing (ok = false).
+func listQuery(terms []schema.Term) (identity.ListUsersInput, bool) {
+	var q identity.ListUsersInput
+	add := func(dst *[]string, v string) bool {
+		if len(*dst) > 0 && (*dst)[0] != v {
+			return false
+		}
+		*dst = []string{v}
+		return true
+	}
+	for _, t := range terms {
+		ok := true
+		switch t.Attr {
+		case schema.AttrUserName:
+			ok = add(&q.Usernames, t.Value.(string))
+		case schema.AttrDisplayName:
+			ok = add(&q.DisplayNames, t.Value.(string))
+		case schema.AttrEmails:
+			ok = add(&q.Emails, t.Value.(string))
+		case schema.AttrActive:
+			st := identity.UserStatusBlocked
+			if t.Value.(bool) {
+				st = identity.UserStatusActive
+			}
+			if len(q.Statuses) > 0 && q.Statuses[0] != st {
+				return q, false
+			}
+			q.Statuses = []identity.UserStatus{st}
+		}
+		if !ok {
+			return q, false
+		}
+	}
+	return q, true
+}
+
+// Get returns one user. Soft-deleted users are gone as far as SCIM is
+// concerned.
+func (s *Service) Get(ctx context.Context, id string) (*identity.User, error) {
+	if err := requireServiceAccount(ctx); err != nil {
+		return nil, err
+	}
+	return s.get(ctx, id)
+}
+
+func (s *Service) get(ctx context.Context, id string) (*identity.User, error) {
+	u, err := s.users.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u.Status() == identity.UserStatusDeleted {
+		return nil, identity.ErrUserNotFound
+	}
+	return u, nil
+}
+
+// ----------------------------------------------------------------------------
+// Writes
+// ----------------------------------------------------------------------------
+
+// Create provisions a user; active=false creates it disabled.
+func (s *Service) Create(ctx context.Context, attrs schema.Attributes) (*identity.User, error) {
+	if err := requireServiceAccount(ctx); err != nil {
+		return nil, err
+	}
+	if err := attrs.Validate(); err != nil {
+		return nil, err
+	}
+	u, err := s.users.CreateUser(ctx, identity.CreateUserInput{
+		Email:       attrs.Email,
+		Username:    attrs.UserName,
+		DisplayName: attrs.DisplayName,
+		AvatarURL:   attrs.AvatarURL,
+		Locale:      attrs.Locale,
+		Timezone:    attrs.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if attrs.Active {
+		return u, nil
+	}
+	if err := s.setActive(ctx, u, false); err != nil {
+		return nil, err
+	}
+	return s.get(ctx, u.ID().String())
+}
+
+// Replace is PUT: every stored attribute takes the supplied value, an
+// absent one is cleared. etag is the If-Match value, "" for none.
+func (s *Service) Replace(ctx context.Context, id, etag string, attrs schema.Attributes) (*identity.User, error) {
+	if err := requireServiceAccount(ctx); err != nil {
+		return nil, err
+	}
+	if err := attrs.Validate(); err != nil {
+		return nil, err
+	}
+	u, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.write(ctx, u, etag, attrs)
+}
+
+// Patch applies PATCH operations to the stored attributes. Without
+// If-Match the update is still conditional on the etag read here, so a
+// concurrent write is reported instead of overwritten.
+func (s *Service) Patch(ctx context.Context, id, etag string, ops []schema.PatchOperation) (*identity.User, error) {
+	if err := requireServiceAccount(ctx); err != nil {
+		return nil, err
+	}
+	u, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	attrs := attributesOf(u)
+	if err := attrs.Apply(ops); err != nil {
+		return nil, err
+	}
+	if etag == "" {
+		etag = u.Etag().String()
+	}
+	return s.write(ctx, u, etag, attrs)
+}
+
+// Delete soft-deletes the user, the same as DELETE on the admin API.
+func (s *Service) Delete(ctx context.Context, id, etag string) error {
+	if err := requireServiceAccount(ctx); err != nil {
+		return err
+	}
+	u, err := s.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if etag != "" && etag != identity.EtagWildcard && etag != u.Etag().String() {
+		return identity.ErrEtagMismatch
+	}
+	return s.users.SoftDeleteUser(ctx, identity.SoftDeleteUserInput{UserID: id})
+}
+
+// write updates the fields of u that differ from attrs, then its
+// status, and returns the stored result.
+func (s *Service) write(ctx context.Context, u *identity.User, etag string, attrs schema.Attributes) (*identity.User, error) {
+	if etag == "" {
+		etag = identity.EtagWildcard
+	}
+	cur := attributesOf(u)
+	var mask []string
+	for _, f := range []struct {
+		path      string
+		cur, next string
+	}{
+		{"email", cur.Email, attrs.Email},
+		{"username", cur.UserName, attrs.UserName},
+		{"display_name", cur.DisplayName, attrs.DisplayName},
+		{"avatar_url", cur.AvatarURL, attrs.AvatarURL},
+		{"locale", cur.Locale, attrs.Locale},
+		{"timezone", cur.Timezone, attrs.Timezone},
+	} {
+		if f.cur != f.next {
+			mask = append(mask, f.path)
+		}
+	}
+
+	if len(mask) > 0 {
+		updated, err := s.users.UpdateUser(ctx, identity.UpdateUserInput{
+			UserID:       u.ID().String(),
+			MaskPaths:    mask,
+			ExpectedEtag: etag,
+			Email:        attrs.Email,
+			Username:     attrs.UserName,
+			DisplayName:  attrs.DisplayName,
+			AvatarURL:    attrs.AvatarURL,
+			Locale:       attrs.Locale,
+			Timezone:     attrs.Timezone,
+		})
+		if err != nil {
+			return nil, err
+		}
+		u = updated
+	} else if etag != identity.EtagWildcard && etag != u.Etag().String() {
+		return nil, identity.ErrEtagMismatch
+	}
+
+	if attrs.Active == cur.Active {
+		return u, nil
+	}
+	if err := s.setActive(ctx, u, attrs.Active); err != nil {
+		return nil, err
+	}
+	return s.get(ctx, u.ID().String())
+}
+
+func (s *Service) setActive(ctx context.Context, u *identity.User, active bool) error {
+	if active {
+		return s.users.EnableUser(ctx, identity.EnableUserInput{UserID: u.ID().String()})
+	}
+	return s.users.DisableUser(ctx, identity.DisableUserInput{UserID: u.ID().String()})
+}
+
+func attributesOf(u *identity.User) schema.Attributes {
+	return schema.Attributes{
+		UserName:    u.Username,
+		DisplayName: u.DisplayName,
+		Email:       u.Email,
+		AvatarURL:   u.AvatarURL,
+		Locale:      u.Locale,
+		Timezone:    u.Timezone,
+		Active:      u.Status() == identity.UserStatusActive,
+	}
+}
+
+func requireServiceAccount(ctx context.Context) error {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return err
+	}
+	if !a.IsServiceAccount() {
+		return ErrServiceAccountRequired
+	}
+	return nil
+}
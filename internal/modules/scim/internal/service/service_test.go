@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/identity"
+)
+
+// fakeUsers answers ListUsers from a fixed match set of total users,
+// recording each query; methods the tests do not reach panic through
+// the nil embedded interface.
+type fakeUsers struct {
+	Users
+	total   int
+	queries []identity.ListUsersInput
+}
+
+func (u *fakeUsers) ListUsers(_ context.Context, in identity.ListUsersInput) (identity.ListUsersOutput, error) {
+	u.queries = append(u.queries, in)
+	out := identity.ListUsersOutput{}
+	for i := in.Offset; i < u.total && len(out.Users) < int(in.PageSize); i++ {
+		out.Users = append(out.Users, &identity.User{})
+	}
+	if in.CountTotal {
+		out.TotalSize = &u.total
+	}
+	return out, nil
+}
+
+func TestListReadsOnePageAndCounts(t *testing.T) {
+	ctx := actor.Inject(context.Background(), actor.Actor{
+		ID: "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90", Kind: actor.KindServiceAccount,
+	})
+	cases := []struct {
+		name       string
+		in         ListInput
+		wantOffset int
+		wantUsers  int
+	}{
+		{"first page", ListInput{Filter: `userName eq "ada"`, StartIndex: 1, Count: 10}, 0, 10},
+		{"later page", ListInput{StartIndex: 2491, Count: 20}, 2490, 10},
+		{"total only", ListInput{StartIndex: 1, Count: 0}, 0, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			users := &fakeUsers{total: 2500}
+			s := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), users)
+			out, err := s.List(ctx, tc.in)
+			if err != nil {
+				t.Fatalf("err = %v", err)
+			}
+			if len(users.queries) != 1 {
+				t.Fatalf("queries = %d, want one page read", len(users.queries))
+			}
+			q := users.queries[0]
+			if !q.CountTotal || q.Offset != tc.wantOffset {
+				t.Fatalf("query counts = %v, offset = %d, want a count at offset %d", q.CountTotal, q.Offset, tc.wantOffset)
+			}
+			if out.TotalResults != 2500 || len(out.Users) != tc.wantUsers {
+				t.Fatalf("totalResults = %d, users = %d, want 2500 and %d", out.TotalResults, len(out.Users), tc.wantUsers)
+			}
+		})
+	}
+}
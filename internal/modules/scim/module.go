@@ -0,0 +1,66 @@
+package scim
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"sso/internal/modules/scim/internal/httpapi"
+	"sso/internal/modules/scim/internal/service"
+)
+
+// Deps lists everything scim needs from its host.
+type Deps struct {
+	Log *slog.Logger
+
+	Users         Users         // *identity.Service
+	Authenticator Authenticator // *grpcauth.Interceptor
+	Authorizer    Authorizer    // *httprbac.Authorizer
+
+	// BaseURL is the externally visible origin; resource locations are
+	// built from it.
+	BaseURL string
+}
+
+// Module is the assembled scim bounded context.
+type Module struct {
+	service *service.Service
+	handler *httpapi.Handler
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.Log == nil {
+		return nil, fmt.Errorf("scim: log is required")
+	}
+	if d.Users == nil {
+		return nil, fmt.Errorf("scim: users service is required")
+	}
+	if d.Authenticator == nil {
+		return nil, fmt.Errorf("scim: authenticator is required")
+	}
+	if d.Authorizer == nil {
+		return nil, fmt.Errorf("scim: authorizer is required")
+	}
+	if d.BaseURL == "" {
+		return nil, fmt.Errorf("scim: base url is required")
+	}
+
+	svc := service.NewService(d.Log, d.Users)
+
+	return &Module{
+		service: svc,
+		handler: httpapi.NewHandler(svc, d.Authenticator, d.Authorizer,
+			httpapi.Config{BaseURL: strings.TrimSuffix(d.BaseURL, "/")}, d.Log),
+	}, nil
+}
+
+// RegisterHTTP mounts the scim endpoints on the HTTP listener's root
+// mux.
+func (m *Module) RegisterHTTP(mux *http.ServeMux) {
+	m.handler.Register(mux)
+}
+
+// Service returns the application-layer Service.
+func (m *Module) Service() *service.Service { return m.service }
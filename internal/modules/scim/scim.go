@@ -0,0 +1,29 @@
+// Package scim is the public API of the scim bounded context: a SCIM 2.0
+// (RFC 7643/7644) provisioning endpoint through which an external
+// identity system (an HR system, Okta, Entra ID) creates, updates,
+// disables and deletes users in the directory. External callers
+// interact with the module through:
+//
+//	scim.New(Deps)        wires the module (module.go)
+//	mod.RegisterHTTP(mux) mounts /scim/v2/*
+//
+// Only the Users resource is served; the directory has no groups.
+// The module stores nothing: every write is an identity.Service call.
+package scim
+
+import (
+	"sso/internal/modules/scim/internal/httpapi"
+	"sso/internal/modules/scim/internal/service"
+)
+
+type (
+	// Users is satisfied by *identity.Service.
+	Users = service.Users
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+)
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var ErrServiceAccountRequired = service.ErrServiceAccountRequired
@@ -44,10 +44,12 @@ func (s ServiceAccountID) String() string { return string(s) }
 // secretHash is a server-side-only field; never serialised to the wire.
 // The plaintext secret leaves the system exactly twice — at create and
 // at rotation — and the use-case layer is responsible for returning it
-// to the caller. The aggregate only ever holds the hash.
+// to the caller. The aggregate only ever holds the hash. tenantID is
+// fixed at creation.
 
 type ServiceAccount struct {
 	id         ServiceAccountID
+	tenantID   string
 	etag       etag.Etag
 	status     ServiceAccountStatus
 	createdAt  time.Time
@@ -59,8 +61,11 @@ type ServiceAccount struct {
 	LastAuthenticatedAt time.Time
 }
 
+// NewServiceAccountParams.TenantID is normally tenant.Home(ctx) of the
+// creating request.
 type NewServiceAccountParams struct {
 	ID          ServiceAccountID
+	TenantID    string
 	Name        string
 	Description string
 	SecretHash  []byte
@@ -70,6 +75,7 @@ type NewServiceAccountParams struct {
 func NewServiceAccount(p NewServiceAccountParams) *ServiceAccount {
 	return &ServiceAccount{
 		id:          p.ID,
+		tenantID:    p.TenantID,
 		etag:        etag.New(),
 		status:      ServiceAccountActive,
 		createdAt:   p.Now,
@@ -82,6 +88,7 @@ func NewServiceAccount(p NewServiceAccountParams) *ServiceAccount {
 
 type RestoreServiceAccountParams struct {
 	ID                  ServiceAccountID
+	TenantID            string
 	Etag                etag.Etag
 	Status              ServiceAccountStatus
 	CreatedAt           time.Time
@@ -95,6 +102,7 @@ type RestoreServiceAccountParams struct {
 func RestoreServiceAccount(p RestoreServiceAccountParams) *ServiceAccount {
 	return &ServiceAccount{
 		id:                  p.ID,
+		tenantID:            p.TenantID,
 		etag:                p.Etag,
 		status:              p.Status,
 		createdAt:           p.CreatedAt,
@@ -107,6 +115,7 @@ func RestoreServiceAccount(p RestoreServiceAccountParams) *ServiceAccount {
 }
 
 func (s *ServiceAccount) ID() ServiceAccountID         { return s.id }
+func (s *ServiceAccount) TenantID() string             { return s.tenantID }
 func (s *ServiceAccount) Etag() etag.Etag              { return s.etag }
 func (s *ServiceAccount) Status() ServiceAccountStatus { return s.status }
 func (s *ServiceAccount) CreatedAt() time.Time         { return s.createdAt }
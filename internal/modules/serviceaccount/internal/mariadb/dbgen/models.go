@@ -19,4 +19,5 @@ type ServiceAccount struct {
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 	LastAuthenticatedAt sql.NullTime
+	TenantID            string
 }
@@ -26,8 +26,8 @@ func (q *Queries) CountServiceAccountByID(ctx context.Context, id string) (int64
 
 const createServiceAccount = `-- name: CreateServiceAccount :exec
 INSERT INTO service_accounts
-    (id, name, description, client_secret_hash, status, etag, created_at, updated_at, last_authenticated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+    (id, name, description, client_secret_hash, status, etag, created_at, updated_at, last_authenticated_at, tenant_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateServiceAccountParams struct {
@@ -40,6 +40,7 @@ type CreateServiceAccountParams struct {
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 	LastAuthenticatedAt sql.NullTime
+	TenantID            string
 }
 
 func (q *Queries) CreateServiceAccount(ctx context.Context, arg CreateServiceAccountParams) error {
@@ -53,6 +54,7 @@ func (q *Queries) CreateServiceAccount(ctx context.Context, arg CreateServiceAcc
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.LastAuthenticatedAt,
+		arg.TenantID,
 	)
 	return err
 }
@@ -80,7 +82,7 @@ func (q *Queries) DeleteServiceAccountWithEtag(ctx context.Context, arg DeleteSe
 
 const getServiceAccountById = `-- name: GetServiceAccountById :one
 SELECT id, name, description, client_secret_hash, status, etag,
-    created_at, updated_at, last_authenticated_at
+    created_at, updated_at, last_authenticated_at, tenant_id
 FROM service_accounts
 WHERE id = ?
 `
@@ -98,6 +100,7 @@ func (q *Queries) GetServiceAccountById(ctx context.Context, id string) (Service
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LastAuthenticatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -7,16 +7,17 @@ import (
 
 	domain "sso/internal/modules/serviceaccount/internal/domain"
 	"sso/internal/modules/serviceaccount/internal/mariadb/dbgen"
+	"sso/internal/kernel/tenant"
 )
 
-const listSelectCols = `id, name, description, client_secret_hash, status, etag, created_at, updated_at, last_authenticated_at`
+const listSelectCols = `id, name, description, client_secret_hash, status, etag, created_at, updated_at, last_authenticated_at, tenant_id`
 
 func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListResult, error) {
 	if q.PageSize <= 0 {
 		return domain.ListResult{}, fmt.Errorf("service_account repo: list: page_size must be > 0")
 	}
 
-	where, args := buildWhere(q)
+	where, args := buildWhere(q, tenant.Scope(ctx))
 	orderBy := orderClauseFor(q.OrderBy)
 
 	limit := q.PageSize + 1
@@ -41,7 +42,7 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 		var sa dbgen.ServiceAccount
 		if err := rows.Scan(
 			&sa.ID, &sa.Name, &sa.Description, &sa.ClientSecretHash,
-			&sa.Status, &sa.Etag, &sa.CreatedAt, &sa.UpdatedAt, &sa.LastAuthenticatedAt,
+			&sa.Status, &sa.Etag, &sa.CreatedAt, &sa.UpdatedAt, &sa.LastAuthenticatedAt, &sa.TenantID,
 		); err != nil {
 			return domain.ListResult{}, fmt.Errorf("service_account repo: list: scan: %w", err)
 		}
@@ -69,12 +70,18 @@ func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListR
 	return domain.ListResult{ServiceAccounts: out, NextCursor: nextCursor}, nil
 }
 
-func buildWhere(q domain.ListQuery) ([]string, []any) {
+// buildWhere: tenantID is tenant.Scope; "" lists every tenant.
+func buildWhere(q domain.ListQuery, tenantID string) ([]string, []any) {
 	var (
 		where []string
 		args  []any
 	)
 
+	if tenantID != "" {
+		where = append(where, "tenant_id = ?")
+		args = append(args, tenantID)
+	}
+
 	if len(q.Statuses) > 0 {
 		ph := make([]string, len(q.Statuses))
 		for i, s := range q.Statuses {
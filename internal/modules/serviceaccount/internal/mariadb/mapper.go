@@ -16,6 +16,7 @@ func dbgenToDomain(r dbgen.ServiceAccount) *domain.ServiceAccount {
 	}
 	return domain.RestoreServiceAccount(domain.RestoreServiceAccountParams{
 		ID:                  domain.ServiceAccountID(r.ID),
+		TenantID:            r.TenantID,
 		Name:                r.Name,
 		Description:         r.Description,
 		SecretHash:          r.ClientSecretHash,
@@ -38,6 +39,7 @@ func toCreateParams(s *domain.ServiceAccount) dbgen.CreateServiceAccountParams {
 		CreatedAt:           s.CreatedAt(),
 		UpdatedAt:           s.UpdatedAt(),
 		LastAuthenticatedAt: lastAuthToDB(s.LastAuthenticatedAt),
+		TenantID:            s.TenantID(),
 	}
 }
 
@@ -1,11 +1,11 @@
 -- name: CreateServiceAccount :exec
 INSERT INTO service_accounts
-    (id, name, description, client_secret_hash, status, etag, created_at, updated_at, last_authenticated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+    (id, name, description, client_secret_hash, status, etag, created_at, updated_at, last_authenticated_at, tenant_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: GetServiceAccountById :one
 SELECT id, name, description, client_secret_hash, status, etag,
-    created_at, updated_at, last_authenticated_at
+    created_at, updated_at, last_authenticated_at, tenant_id
 FROM service_accounts
 WHERE id = ?;
 
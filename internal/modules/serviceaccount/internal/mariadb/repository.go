@@ -5,6 +5,8 @@
 // dynamic List in list.go, etag-aware Update/Delete with
 // discriminateMissingOrMismatch when a 0-rows-affected write needs to
 // be classified as ErrServiceAccountNotFound vs ErrEtagMismatch.
+// Tenancy too: an account outside tenant.Scope reads as
+// ErrServiceAccountNotFound, and List is filtered to the scope.
 package mariadb
 
 import (
@@ -16,6 +18,7 @@ import (
 	domain "sso/internal/modules/serviceaccount/internal/domain"
 	"sso/internal/kernel/dbutil"
 	"sso/internal/kernel/etag"
+	"sso/internal/kernel/tenant"
 	"sso/internal/modules/serviceaccount/internal/mariadb/dbgen"
 )
 
@@ -56,6 +59,9 @@ func (r *Repository) GetByID(ctx context.Context, id domain.ServiceAccountID) (*
 		}
 		return nil, fmt.Errorf("service_account repo: get_by_id: %w", err)
 	}
+	if !tenant.Visible(ctx, row.TenantID) {
+		return nil, domain.ErrServiceAccountNotFound
+	}
 	return dbgenToDomain(row), nil
 }
 
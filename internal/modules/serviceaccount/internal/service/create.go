@@ -8,6 +8,7 @@ import (
 	"sso/internal/modules/audit"
 	serviceAccount "sso/internal/modules/serviceaccount/internal/domain"
 	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
 )
 
 type CreateServiceAccountInput struct {
@@ -45,6 +46,7 @@ func (s *Service) CreateServiceAccount(ctx context.Context, in CreateServiceAcco
 	now := s.now().UTC()
 	sa := serviceAccount.NewServiceAccount(serviceAccount.NewServiceAccountParams{
 		ID:          id,
+		TenantID:    tenant.Home(ctx),
 		Name:        in.Name,
 		Description: in.Description,
 		SecretHash:  hash,
@@ -57,6 +57,7 @@ func (s *Service) PermanentlyDeleteServiceAccount(ctx context.Context, in Perman
 		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
 		return err
 	}
+	s.version.Bump(ctx)
 
 	s.auditor.Success(ctx, aud)
 	return nil
@@ -15,6 +15,7 @@ import (
 	"log/slog"
 	"time"
 
+	"sso/internal/kernel/authzver"
 	"sso/internal/modules/audit"
 	"sso/internal/modules/audit/auditx"
 	serviceAccount "sso/internal/modules/serviceaccount/internal/domain"
@@ -24,10 +25,11 @@ type Service struct {
 	repo    serviceAccount.Repository
 	now     func() time.Time
 	auditor auditx.Auditor
+	version authzver.Bumper
 }
 
-func NewService(log *slog.Logger, repo serviceAccount.Repository, now func() time.Time, emitter audit.Emitter) *Service {
-	return &Service{repo: repo, now: now, auditor: auditx.New(log, emitter)}
+func NewService(log *slog.Logger, repo serviceAccount.Repository, now func() time.Time, emitter audit.Emitter, version authzver.Bumper) *Service {
+	return &Service{repo: repo, now: now, auditor: auditx.New(log, emitter), version: version}
 }
 
 // EtagWildcard re-exports auditx.EtagWildcard so existing call sites
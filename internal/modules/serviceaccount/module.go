@@ -13,6 +13,7 @@ import (
 	"log/slog"
 	"time"
 
+	"sso/internal/kernel/authzver"
 	"sso/internal/modules/audit"
 	grpcadapter "sso/internal/modules/serviceaccount/internal/grpc"
 	"sso/internal/modules/serviceaccount/internal/mariadb"
@@ -32,6 +33,10 @@ type Deps struct {
 	Log   *slog.Logger
 	Clock func() time.Time
 	Audit Emitter
+
+	// AuthzVersion is bumped after a permanent delete, which takes the
+	// account's grants with it. Optional; defaults to authzver.Nop.
+	AuthzVersion authzver.Bumper
 }
 
 // Module is the assembled service-account bounded context.
@@ -55,12 +60,15 @@ func New(d Deps) (*Module, error) {
 	if d.Audit == nil {
 		d.Audit = audit.NopEmitter{}
 	}
+	if d.AuthzVersion == nil {
+		d.AuthzVersion = authzver.Nop{}
+	}
 
 	repo := mariadb.NewRepository(d.DB)
 
 	var _ Repository = repo
 
-	svc := service.NewService(d.Log, repo, d.Clock, d.Audit)
+	svc := service.NewService(d.Log, repo, d.Clock, d.Audit, d.AuthzVersion)
 	h := grpcadapter.NewHandler(svc, d.Log)
 
 	return &Module{
@@ -23,13 +23,13 @@ import (
 // Cross-context UUID handles
 // ----------------------------------------------------------------------------
 //
-// SessionID is owned by this bounded context. UserID is a cross-context
-// handle (the User aggregate is in internal/domain/identity); we
-// declare it as a typed alias here to keep session free of an identity
-// import.
+// SessionID is owned by this bounded context. UserID and AppID are
+// cross-context handles (identity.User, app.App); we declare them as
+// typed aliases here to keep session free of those imports.
 
 type SessionID string
 type UserID string
+type AppID string
 
 func NewSessionID() (SessionID, error) {
 	id, err := uuid.NewV7()
@@ -55,6 +55,7 @@ func ParseUserID(s string) (UserID, error) {
 
 func (s SessionID) String() string { return string(s) }
 func (u UserID) String() string    { return string(u) }
+func (a AppID) String() string     { return string(a) }
 
 // ----------------------------------------------------------------------------
 // Session aggregate
@@ -64,6 +65,8 @@ func (u UserID) String() string    { return string(u) }
 //
 //   Unexported (only the aggregate itself can change them):
 //     id, userID                — immutable after construction
+//     appID                     — app signed into; immutable, "" for
+//                                 sessions that predate it
 //     issuedAt                  — immutable after construction
 //     expiresAt                 — absolute hard-cap; set once at Login
 //     refreshTokenHash          — rotated by RotateRefresh
@@ -82,6 +85,7 @@ func (u UserID) String() string    { return string(u) }
 type Session struct {
 	id                    SessionID
 	userID                UserID
+	appID                 AppID
 	refreshTokenHash      []byte // SHA-256 (32 bytes)
 	issuedAt              time.Time
 	expiresAt             time.Time // absolute hard-cap, never extended
@@ -99,6 +103,7 @@ type Session struct {
 type NewSessionParams struct {
 	ID                    SessionID
 	UserID                UserID
+	AppID                 AppID // app the user signed in to; carried into refreshed tokens
 	RefreshTokenHash      []byte
 	UserAgent             string
 	IpAddress             string
@@ -111,6 +116,7 @@ func NewSession(p NewSessionParams) *Session {
 	return &Session{
 		id:                    p.ID,
 		userID:                p.UserID,
+		appID:                 p.AppID,
 		refreshTokenHash:      p.RefreshTokenHash,
 		issuedAt:              p.Now,
 		expiresAt:             p.ExpiresAt,
@@ -127,6 +133,7 @@ func NewSession(p NewSessionParams) *Session {
 type RestoreSessionParams struct {
 	ID                    SessionID
 	UserID                UserID
+	AppID                 AppID
 	RefreshTokenHash      []byte
 	UserAgent             string
 	IpAddress             string
@@ -141,6 +148,7 @@ func RestoreSession(p RestoreSessionParams) *Session {
 	return &Session{
 		id:                    p.ID,
 		userID:                p.UserID,
+		appID:                 p.AppID,
 		refreshTokenHash:      p.RefreshTokenHash,
 		issuedAt:              p.IssuedAt,
 		expiresAt:             p.ExpiresAt,
@@ -158,6 +166,7 @@ func RestoreSession(p RestoreSessionParams) *Session {
 
 func (s *Session) ID() SessionID                    { return s.id }
 func (s *Session) UserID() UserID                   { return s.userID }
+func (s *Session) AppID() AppID                     { return s.appID }
 func (s *Session) RefreshTokenHash() []byte         { return s.refreshTokenHash }
 func (s *Session) IssuedAt() time.Time              { return s.issuedAt }
 func (s *Session) ExpiresAt() time.Time             { return s.expiresAt }
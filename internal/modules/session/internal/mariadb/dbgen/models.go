@@ -20,4 +20,5 @@ type Session struct {
 	RefreshTokenExpiresAt time.Time
 	LastSeenAt            time.Time
 	RevokedAt             sql.NullTime
+	AppID                 sql.NullString
 }
@@ -17,8 +17,8 @@ INSERT INTO sessions (
     id, user_id, refresh_token_hash,
     user_agent, ip_address,
     issued_at, expires_at, refresh_token_expires_at,
-    last_seen_at, revoked_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    last_seen_at, revoked_at, app_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateSessionParams struct {
@@ -32,6 +32,7 @@ type CreateSessionParams struct {
 	RefreshTokenExpiresAt time.Time
 	LastSeenAt            time.Time
 	RevokedAt             sql.NullTime
+	AppID                 sql.NullString
 }
 
 // Sessions directory
@@ -47,12 +48,13 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) er
 		arg.RefreshTokenExpiresAt,
 		arg.LastSeenAt,
 		arg.RevokedAt,
+		arg.AppID,
 	)
 	return err
 }
 
 const getSessionById = `-- name: GetSessionById :one
-SELECT id, user_id, refresh_token_hash, user_agent, ip_address, issued_at, expires_at, refresh_token_expires_at, last_seen_at, revoked_at FROM sessions WHERE id = ?
+SELECT id, user_id, refresh_token_hash, user_agent, ip_address, issued_at, expires_at, refresh_token_expires_at, last_seen_at, revoked_at, app_id FROM sessions WHERE id = ?
 `
 
 func (q *Queries) GetSessionById(ctx context.Context, id string) (Session, error) {
@@ -69,12 +71,13 @@ func (q *Queries) GetSessionById(ctx context.Context, id string) (Session, error
 		&i.RefreshTokenExpiresAt,
 		&i.LastSeenAt,
 		&i.RevokedAt,
+		&i.AppID,
 	)
 	return i, err
 }
 
 const getSessionByRefreshHash = `-- name: GetSessionByRefreshHash :one
-SELECT id, user_id, refresh_token_hash, user_agent, ip_address, issued_at, expires_at, refresh_token_expires_at, last_seen_at, revoked_at FROM sessions WHERE refresh_token_hash = ?
+SELECT id, user_id, refresh_token_hash, user_agent, ip_address, issued_at, expires_at, refresh_token_expires_at, last_seen_at, revoked_at, app_id FROM sessions WHERE refresh_token_hash = ?
 `
 
 func (q *Queries) GetSessionByRefreshHash(ctx context.Context, refreshTokenHash []byte) (Session, error) {
@@ -91,12 +94,13 @@ func (q *Queries) GetSessionByRefreshHash(ctx context.Context, refreshTokenHash
 		&i.RefreshTokenExpiresAt,
 		&i.LastSeenAt,
 		&i.RevokedAt,
+		&i.AppID,
 	)
 	return i, err
 }
 
 const listSessionsByUser = `-- name: ListSessionsByUser :many
-SELECT id, user_id, refresh_token_hash, user_agent, ip_address, issued_at, expires_at, refresh_token_expires_at, last_seen_at, revoked_at FROM sessions
+SELECT id, user_id, refresh_token_hash, user_agent, ip_address, issued_at, expires_at, refresh_token_expires_at, last_seen_at, revoked_at, app_id FROM sessions
 WHERE user_id = ?
 ORDER BY issued_at DESC, id DESC
 `
@@ -121,6 +125,7 @@ func (q *Queries) ListSessionsByUser(ctx context.Context, userID string) ([]Sess
 			&i.RefreshTokenExpiresAt,
 			&i.LastSeenAt,
 			&i.RevokedAt,
+			&i.AppID,
 		); err != nil {
 			return nil, err
 		}
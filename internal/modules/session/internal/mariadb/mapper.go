@@ -26,6 +26,7 @@ func dbgenToDomain(s dbgen.Session) *domain.Session {
 	return domain.RestoreSession(domain.RestoreSessionParams{
 		ID:                    domain.SessionID(s.ID),
 		UserID:                domain.UserID(s.UserID),
+		AppID:                 domain.AppID(s.AppID.String),
 		RefreshTokenHash:      s.RefreshTokenHash,
 		UserAgent:             userAgent,
 		IpAddress:             ipAddress,
@@ -49,6 +50,7 @@ func toCreateParams(s *domain.Session) dbgen.CreateSessionParams {
 		RefreshTokenExpiresAt: s.RefreshTokenExpiresAt(),
 		LastSeenAt:            s.LastSeenAt(),
 		RevokedAt:             revokedAtToDB(s.RevokedAt()),
+		AppID:                 nullableString(s.AppID().String()),
 	}
 }
 
@@ -85,7 +87,7 @@ func toRevokeParams(userID domain.UserID, now time.Time) dbgen.RevokeAllSessions
 }
 
 // nullableString folds the use-case's empty-string convention onto SQL
-// NULL: domain treats "" as "absent" for UserAgent / IpAddress (the
+// NULL: domain treats "" as "absent" for UserAgent / IpAddress / AppID (the
 // columns are nullable in the schema, so we keep that distinction at the
 // persistence boundary).
 func nullableString(v string) sql.NullString {
@@ -5,8 +5,8 @@ INSERT INTO sessions (
     id, user_id, refresh_token_hash,
     user_agent, ip_address,
     issued_at, expires_at, refresh_token_expires_at,
-    last_seen_at, revoked_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+    last_seen_at, revoked_at, app_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: GetSessionById :one
 SELECT * FROM sessions WHERE id = ?;
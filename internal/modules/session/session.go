@@ -18,6 +18,7 @@ type (
 	Session              = domain.Session
 	SessionID            = domain.SessionID
 	UserID               = domain.UserID
+	AppID                = domain.AppID
 	NewSessionParams     = domain.NewSessionParams
 	RestoreSessionParams = domain.RestoreSessionParams
 	Repository           = domain.Repository
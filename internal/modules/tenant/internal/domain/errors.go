@@ -0,0 +1,22 @@
+package domain
+
+import "errors"
+
+var (
+	ErrTenantNotFound = errors.New("tenant: not found")
+	ErrEtagMismatch   = errors.New("tenant: etag mismatch")
+
+	// ErrTenantAlreadyExists — another tenant has the same slug.
+	ErrTenantAlreadyExists = errors.New("tenant: slug already in use")
+
+	// ErrTenantNotEmpty — the tenant still owns users, apps or service
+	// accounts; they must be removed before the tenant is.
+	ErrTenantNotEmpty = errors.New("tenant: still owns users, apps or service accounts")
+
+	// ErrSystemTenant — the system tenant cannot be deleted.
+	ErrSystemTenant = errors.New("tenant: the system tenant cannot be deleted")
+
+	// ErrSuperAdminRequired — the caller is not a super-admin (a
+	// principal of the system tenant).
+	ErrSuperAdminRequired = errors.New("tenant: super-admin required")
+)
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"context"
+
+	"sso/internal/kernel/etag"
+)
+
+// Repository is the persistence contract for the tenant context.
+//
+// Error contract:
+//
+//	Create / Update  → ErrTenantAlreadyExists (slug taken)
+//	GetByID          → ErrTenantNotFound
+//	Update / Delete  → ErrTenantNotFound / ErrEtagMismatch
+//	Delete           → ErrTenantNotEmpty (rows still reference it)
+//
+// expectedEtag "" means unconditional, same as every other module.
+// Unlike the tenant-owned repositories, this one is not filtered by
+// kernel/tenant: the service decides who may see which tenant.
+type Repository interface {
+	Create(ctx context.Context, t *Tenant) error
+	GetByID(ctx context.Context, id TenantID) (*Tenant, error)
+	List(ctx context.Context, q ListQuery) (ListResult, error)
+	Update(ctx context.Context, t *Tenant, expectedEtag etag.Etag) error
+	Delete(ctx context.Context, id TenantID, expectedEtag etag.Etag) error
+}
+
+// ListQuery pages tenants by slug ascending. After is the last slug of
+// the previous page; "" = first page.
+type ListQuery struct {
+	PageSize int
+	After    string
+}
+
+type ListResult struct {
+	Tenants   []*Tenant
+	NextAfter string // "" = last page
+}
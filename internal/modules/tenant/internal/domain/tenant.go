@@ -0,0 +1,158 @@
+// Package domain holds the Tenant aggregate of the tenant bounded
+// context: an organisation whose users, apps, roles and service
+// accounts are isolated from every other tenant's. The rows themselves
+// carry the tenant id; kernel/tenant decides which of them a request
+// sees.
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/tenant"
+	"sso/internal/kernel/validation"
+)
+
+// ----------------------------------------------------------------------------
+// IDs
+// ----------------------------------------------------------------------------
+
+// TenantID — RFC 4122 UUID, generated as v7 (k-sortable).
+type TenantID string
+
+// SystemTenantID is the tenant seeded by the tenancy migration.
+const SystemTenantID = TenantID(tenant.System)
+
+func NewTenantID() (TenantID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("generate tenant id: %w", err)
+	}
+	return TenantID(id.String()), nil
+}
+
+func ParseTenantID(s string) (TenantID, error) {
+	if _, err := uuid.Parse(s); err != nil {
+		return "", &validation.Error{Field: "tenant_id", Reason: "must be a valid UUID"}
+	}
+	return TenantID(s), nil
+}
+
+func (id TenantID) String() string { return string(id) }
+
+// ----------------------------------------------------------------------------
+// Tenant
+// ----------------------------------------------------------------------------
+
+const maxNameLen = 128
+
+var slugRe = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}[a-z0-9]$`)
+
+// Tenant is one organisation. The slug is immutable after creation;
+// Name is plain data checked by ValidateName. etag and updatedAt
+// advance only through ApplyPatch.
+type Tenant struct {
+	id        TenantID
+	slug      string
+	etag      etag.Etag
+	createdAt time.Time
+	updatedAt time.Time
+
+	Name string
+}
+
+type NewTenantParams struct {
+	ID   TenantID
+	Slug string
+	Name string
+	Now  time.Time
+}
+
+// NewTenant validates the supplied fields and constructs a fresh Tenant.
+func NewTenant(p NewTenantParams) (*Tenant, error) {
+	if !slugRe.MatchString(p.Slug) {
+		return nil, &validation.Error{Field: "slug", Reason: "must match " + slugRe.String()}
+	}
+	name := strings.TrimSpace(p.Name)
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	return &Tenant{
+		id:        p.ID,
+		slug:      p.Slug,
+		etag:      etag.New(),
+		createdAt: p.Now,
+		updatedAt: p.Now,
+		Name:      name,
+	}, nil
+}
+
+// RestoreTenantParams carries the full row read back from the repository.
+type RestoreTenantParams struct {
+	ID        TenantID
+	Slug      string
+	Name      string
+	Etag      etag.Etag
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RestoreTenant rebuilds a Tenant from a persisted row. No validation.
+func RestoreTenant(p RestoreTenantParams) *Tenant {
+	return &Tenant{
+		id:        p.ID,
+		slug:      p.Slug,
+		etag:      p.Etag,
+		createdAt: p.CreatedAt,
+		updatedAt: p.UpdatedAt,
+		Name:      p.Name,
+	}
+}
+
+func (t *Tenant) ID() TenantID         { return t.id }
+func (t *Tenant) Slug() string         { return t.slug }
+func (t *Tenant) Etag() etag.Etag      { return t.etag }
+func (t *Tenant) CreatedAt() time.Time { return t.createdAt }
+func (t *Tenant) UpdatedAt() time.Time { return t.updatedAt }
+
+// IsSystem reports whether t is the system tenant, home of the
+// super-admins.
+func (t *Tenant) IsSystem() bool { return t.id == SystemTenantID }
+
+// TenantPatch — nil pointer = "field not in the update".
+type TenantPatch struct {
+	Name *string
+}
+
+func (p TenantPatch) IsEmpty() bool { return p.Name == nil }
+
+// ApplyPatch validates and applies the supplied changes. Bumps
+// etag/updated_at only when a field actually changes.
+func (t *Tenant) ApplyPatch(p TenantPatch, now time.Time) error {
+	if p.Name == nil {
+		return nil
+	}
+	name := strings.TrimSpace(*p.Name)
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if name != t.Name {
+		t.Name = name
+		t.updatedAt = now
+		t.etag = etag.New()
+	}
+	return nil
+}
+
+func ValidateName(name string) error {
+	if n := utf8.RuneCountInString(name); n == 0 || n > maxNameLen {
+		return &validation.Error{Field: "name", Reason: fmt.Sprintf("length must be between 1 and %d", maxNameLen)}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package httpapi
+
+import (
+	"sso/internal/modules/tenant/internal/domain"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// errorMap translates tenant sentinels into statuses. errors.proto has
+// no tenant reasons yet, so those entries are bare statuses (Reason
+// UNSPECIFIED).
+var errorMap = map[error]grpcerr.ErrorMapping{
+	domain.ErrTenantNotFound: {
+		Code: codes.NotFound, Message: "tenant not found"},
+	domain.ErrTenantAlreadyExists: {
+		Code: codes.AlreadyExists, Message: "a tenant with this slug already exists"},
+	domain.ErrTenantNotEmpty: {
+		Code: codes.FailedPrecondition, Message: "tenant still owns users, apps or service accounts"},
+	domain.ErrSystemTenant: {
+		Code: codes.FailedPrecondition, Message: "the system tenant cannot be deleted"},
+	domain.ErrSuperAdminRequired: {
+		Code: codes.PermissionDenied, Reason: ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, Message: "super-admin required"},
+	domain.ErrEtagMismatch: {
+		Code: codes.Aborted, Reason: ssocommonv1.ErrorReason_ERROR_REASON_ETAG_MISMATCH, Message: "etag mismatch"},
+}
+
+func toStatus(err error) error {
+	return grpcerr.MapError(err, errorMap)
+}
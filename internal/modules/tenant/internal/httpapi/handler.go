@@ -0,0 +1,178 @@
+// Package httpapi is the HTTP adapter for the tenant context.
+//
+// Tenants are not part of the published sso_protos, so these are
+// hand-written net/http handlers mounted next to the grpc-gateway.
+// Error bodies use the same google.rpc.Status JSON shape as the gateway,
+// so clients need a single error decoder.
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/tenant/internal/domain"
+	tensvc "sso/internal/modules/tenant/internal/service"
+	"sso/internal/platform/httpserver/apiutil"
+)
+
+// Authenticator resolves a bearer token to an Actor. Satisfied by
+// (*grpcauth.Interceptor).Authenticate.
+type Authenticator = apiutil.Authenticator
+
+// Authorizer decides whether the caller may use the route. Satisfied
+// by *httprbac.Authorizer.
+type Authorizer = apiutil.Authorizer
+
+type Handler struct {
+	svc *tensvc.Service
+	api *apiutil.Adapter
+	log *slog.Logger
+}
+
+func NewHandler(svc *tensvc.Service, authn Authenticator, authz Authorizer, log *slog.Logger) *Handler {
+	return &Handler{svc: svc, api: apiutil.New("tenant", authn, authz, log, toStatus), log: log}
+}
+
+// Register mounts the tenant endpoints. All but GET of the caller's own
+// tenant are super-admin only.
+//
+//	GET    /v1/tenants?page_size=&page_token=
+//	POST   /v1/tenants
+//	GET    /v1/tenants/{id}
+//	PATCH  /v1/tenants/{id}?etag=
+//	DELETE /v1/tenants/{id}?etag=
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/tenants", h.api.Authed(h.list))
+	mux.HandleFunc("POST /v1/tenants", h.api.Authed(h.create))
+	mux.HandleFunc("GET /v1/tenants/{id}", h.api.Authed(h.get))
+	mux.HandleFunc("PATCH /v1/tenants/{id}", h.api.Authed(h.update))
+	mux.HandleFunc("DELETE /v1/tenants/{id}", h.api.Authed(h.delete))
+}
+
+// ----------------------------------------------------------------------------
+// Tenants
+// ----------------------------------------------------------------------------
+
+type createBody struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	var b createBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	t, err := h.svc.CreateTenant(r.Context(), tensvc.CreateTenantInput{
+		Slug: b.Slug,
+		Name: b.Name,
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusCreated, tenantView(t))
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	pageSize, err := parsePageSize(q.Get("page_size"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	out, err := h.svc.ListTenants(r.Context(), tensvc.ListTenantsInput{
+		PageSize:  pageSize,
+		PageToken: q.Get("page_token"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	views := make([]map[string]any, 0, len(out.Tenants))
+	for _, t := range out.Tenants {
+		views = append(views, tenantView(t))
+	}
+	apiutil.WriteJSON(w, http.StatusOK, map[string]any{
+		"tenants":         views,
+		"next_page_token": out.NextPageToken,
+	})
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
+	t, err := h.svc.GetTenant(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, tenantView(t))
+}
+
+type updateBody struct {
+	Name *string `json:"name"`
+}
+
+func (h *Handler) update(w http.ResponseWriter, r *http.Request) {
+	var b updateBody
+	if err := apiutil.DecodeJSON(r, &b); err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	t, err := h.svc.UpdateTenant(r.Context(), tensvc.UpdateTenantInput{
+		TenantID:     r.PathValue("id"),
+		Name:         b.Name,
+		ExpectedEtag: r.URL.Query().Get("etag"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	apiutil.WriteJSON(w, http.StatusOK, tenantView(t))
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request) {
+	err := h.svc.DeleteTenant(r.Context(), tensvc.DeleteTenantInput{
+		TenantID:     r.PathValue("id"),
+		ExpectedEtag: r.URL.Query().Get("etag"),
+	})
+	if err != nil {
+		h.api.WriteError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ----------------------------------------------------------------------------
+// Views
+// ----------------------------------------------------------------------------
+
+func tenantView(t *domain.Tenant) map[string]any {
+	return map[string]any{
+		"id":         t.ID().String(),
+		"slug":       t.Slug(),
+		"name":       t.Name,
+		"system":     t.IsSystem(),
+		"etag":       t.Etag().String(),
+		"created_at": t.CreatedAt().UTC().Format(time.RFC3339),
+		"updated_at": t.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Plumbing
+// ----------------------------------------------------------------------------
+
+func parsePageSize(v string) (int32, error) {
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0, &validation.Error{Field: "page_size", Reason: "must be an integer"}
+	}
+	return int32(n), nil
+}
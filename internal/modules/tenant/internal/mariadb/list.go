@@ -0,0 +1,46 @@
+package mariadb
+
+import (
+	"context"
+	"fmt"
+
+	"sso/internal/modules/tenant/internal/domain"
+	"sso/internal/modules/tenant/internal/mariadb/dbgen"
+)
+
+// List is hand-written: the keyset cursor is the slug, ascending.
+func (r *Repository) List(ctx context.Context, q domain.ListQuery) (domain.ListResult, error) {
+	if q.PageSize <= 0 {
+		return domain.ListResult{}, fmt.Errorf("tenant repo: list: page_size must be > 0")
+	}
+
+	query := fmt.Sprintf(
+		`SELECT id, slug, name, etag, created_at, updated_at
+		 FROM tenants WHERE slug > ? ORDER BY slug LIMIT %d`,
+		q.PageSize+1,
+	)
+	rows, err := r.db.QueryContext(ctx, query, q.After)
+	if err != nil {
+		return domain.ListResult{}, fmt.Errorf("tenant repo: list: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*domain.Tenant, 0, q.PageSize)
+	for rows.Next() {
+		var t dbgen.Tenant
+		if err := rows.Scan(&t.ID, &t.Slug, &t.Name, &t.Etag, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return domain.ListResult{}, fmt.Errorf("tenant repo: list: scan: %w", err)
+		}
+		out = append(out, tenantToDomain(t))
+	}
+	if err := rows.Err(); err != nil {
+		return domain.ListResult{}, fmt.Errorf("tenant repo: list: rows: %w", err)
+	}
+
+	var next string
+	if len(out) > q.PageSize {
+		out = out[:q.PageSize]
+		next = out[len(out)-1].Slug()
+	}
+	return domain.ListResult{Tenants: out, NextAfter: next}, nil
+}
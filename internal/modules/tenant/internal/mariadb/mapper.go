@@ -0,0 +1,48 @@
+package mariadb
+
+import (
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/tenant/internal/domain"
+	"sso/internal/modules/tenant/internal/mariadb/dbgen"
+)
+
+func tenantToDomain(row dbgen.Tenant) *domain.Tenant {
+	return domain.RestoreTenant(domain.RestoreTenantParams{
+		ID:        domain.TenantID(row.ID),
+		Slug:      row.Slug,
+		Name:      row.Name,
+		Etag:      etag.Etag(row.Etag),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	})
+}
+
+func toCreateParams(t *domain.Tenant) dbgen.CreateTenantParams {
+	return dbgen.CreateTenantParams{
+		ID:        t.ID().String(),
+		Slug:      t.Slug(),
+		Name:      t.Name,
+		Etag:      t.Etag().String(),
+		CreatedAt: t.CreatedAt(),
+		UpdatedAt: t.UpdatedAt(),
+	}
+}
+
+func toUpdateParams(t *domain.Tenant) dbgen.UpdateTenantParams {
+	return dbgen.UpdateTenantParams{
+		Name:      t.Name,
+		Etag:      t.Etag().String(),
+		UpdatedAt: t.UpdatedAt(),
+		ID:        t.ID().String(),
+	}
+}
+
+func toUpdateWithEtagParams(t *domain.Tenant, expected etag.Etag) dbgen.UpdateTenantWithEtagParams {
+	return dbgen.UpdateTenantWithEtagParams{
+		Name:      t.Name,
+		Etag:      t.Etag().String(),
+		UpdatedAt: t.UpdatedAt(),
+		ID:        t.ID().String(),
+		Etag_2:    expected.String(),
+	}
+}
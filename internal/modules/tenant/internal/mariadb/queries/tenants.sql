@@ -0,0 +1,30 @@
+-- Tenants. List is hand-written (list.go).
+
+-- name: CreateTenant :exec
+INSERT INTO tenants (
+    id, slug, name, etag, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetTenantByID :one
+SELECT * FROM tenants
+WHERE id = ?
+LIMIT 1;
+
+-- name: UpdateTenant :execresult
+UPDATE tenants
+SET name = ?, etag = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: UpdateTenantWithEtag :execresult
+UPDATE tenants
+SET name = ?, etag = ?, updated_at = ?
+WHERE id = ? AND etag = ?;
+
+-- name: DeleteTenant :execresult
+DELETE FROM tenants WHERE id = ?;
+
+-- name: DeleteTenantWithEtag :execresult
+DELETE FROM tenants WHERE id = ? AND etag = ?;
+
+-- name: CountTenantByID :one
+SELECT COUNT(*) FROM tenants WHERE id = ?;
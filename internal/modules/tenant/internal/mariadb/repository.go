@@ -0,0 +1,121 @@
+// Package mariadb is the MariaDB implementation of the tenant
+// Repository. Single-row statements go through sqlc; the paged list is
+// hand-written in list.go.
+package mariadb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"sso/internal/kernel/dbutil"
+	"sso/internal/kernel/etag"
+	"sso/internal/modules/tenant/internal/domain"
+	"sso/internal/modules/tenant/internal/mariadb/dbgen"
+)
+
+type Repository struct {
+	db *sql.DB
+	q  *dbgen.Queries
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db, q: dbgen.New(db)}
+}
+
+var _ domain.Repository = (*Repository)(nil)
+
+// queries returns the Queries bound to the ambient transaction of
+// dbutil.WithTx when there is one.
+func (r *Repository) queries(ctx context.Context) *dbgen.Queries {
+	if tx, ok := dbutil.TxFrom(ctx); ok {
+		return r.q.WithTx(tx)
+	}
+	return r.q
+}
+
+func (r *Repository) Create(ctx context.Context, t *domain.Tenant) error {
+	if err := r.queries(ctx).CreateTenant(ctx, toCreateParams(t)); err != nil {
+		if dbutil.IsDuplicateEntry(err) {
+			return domain.ErrTenantAlreadyExists
+		}
+		return fmt.Errorf("tenant repo: create: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id domain.TenantID) (*domain.Tenant, error) {
+	row, err := r.queries(ctx).GetTenantByID(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("tenant repo: get: %w", err)
+	}
+	return tenantToDomain(row), nil
+}
+
+func (r *Repository) Update(ctx context.Context, t *domain.Tenant, expectedEtag etag.Etag) error {
+	q := r.queries(ctx)
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = q.UpdateTenant(ctx, toUpdateParams(t))
+	} else {
+		res, err = q.UpdateTenantWithEtag(ctx, toUpdateWithEtagParams(t, expectedEtag))
+	}
+	if err != nil {
+		return fmt.Errorf("tenant repo: update: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("tenant repo: update: rows_affected: %w", err)
+	}
+	if rows == 1 {
+		return nil
+	}
+	return dbutil.Discriminate(ctx, expectedEtag,
+		func(ctx context.Context) (int64, error) {
+			return q.CountTenantByID(ctx, t.ID().String())
+		},
+		domain.ErrTenantNotFound, domain.ErrEtagMismatch)
+}
+
+// Delete removes the tenant. The tenant_id foreign keys do not cascade:
+// a tenant that still owns rows is refused with ErrTenantNotEmpty.
+func (r *Repository) Delete(ctx context.Context, id domain.TenantID, expectedEtag etag.Etag) error {
+	q := r.queries(ctx)
+	var (
+		res sql.Result
+		err error
+	)
+	if expectedEtag == "" {
+		res, err = q.DeleteTenant(ctx, id.String())
+	} else {
+		res, err = q.DeleteTenantWithEtag(ctx, dbgen.DeleteTenantWithEtagParams{
+			ID:   id.String(),
+			Etag: expectedEtag.String(),
+		})
+	}
+	if err != nil {
+		if dbutil.IsRowReferenced(err) {
+			return domain.ErrTenantNotEmpty
+		}
+		return fmt.Errorf("tenant repo: delete: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("tenant repo: delete: rows_affected: %w", err)
+	}
+	if rows == 1 {
+		return nil
+	}
+	return dbutil.Discriminate(ctx, expectedEtag,
+		func(ctx context.Context) (int64, error) {
+			return q.CountTenantByID(ctx, id.String())
+		},
+		domain.ErrTenantNotFound, domain.ErrEtagMismatch)
+}
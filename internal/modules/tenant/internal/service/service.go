@@ -0,0 +1,78 @@
+// Package service hosts the application-layer use-cases of the tenant
+// bounded context:
+//
+//	service.go — Service struct + helpers
+//	tenant.go  — Create/Get/List/Update/DeleteTenant
+//
+// Managing tenants is reserved to super-admins; a tenant's own
+// principals may only read their tenant. What lives in a tenant is
+// managed through the owning contexts, which filter by kernel/tenant.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/tenant/internal/domain"
+)
+
+type Service struct {
+	repo    domain.Repository
+	now     func() time.Time
+	log     *slog.Logger
+	auditor auditx.Auditor
+}
+
+func NewService(
+	log *slog.Logger,
+	repo domain.Repository,
+	now func() time.Time,
+	emitter audit.Emitter,
+) *Service {
+	return &Service{
+		repo:    repo,
+		now:     now,
+		log:     log,
+		auditor: auditx.New(log, emitter),
+	}
+}
+
+// errReasonMap maps tenant sentinels to their audit (Outcome, Reason)
+// pair.
+var errReasonMap = map[error]auditx.OutcomeReason{
+	domain.ErrTenantNotFound:      auditx.Fail(audit.ReasonTenantNotFound),
+	domain.ErrTenantAlreadyExists: auditx.Fail(audit.ReasonTenantAlreadyExists),
+	domain.ErrTenantNotEmpty:      auditx.Fail(audit.ReasonTenantNotEmpty),
+	domain.ErrSystemTenant:        auditx.Deny(audit.ReasonPermissionDenied),
+	domain.ErrSuperAdminRequired:  auditx.Deny(audit.ReasonPermissionDenied),
+	domain.ErrEtagMismatch:        auditx.Fail(audit.ReasonEtagMismatch),
+}
+
+// classifyError is the per-package thin wrapper around auditx.Classify.
+func classifyError(err error) (audit.AuditOutcome, string) {
+	return auditx.Classify(err, errReasonMap)
+}
+
+// withOutcome is a thin alias over auditx.WithOutcome.
+func withOutcome(p audit.NewAuditParams, out audit.AuditOutcome, reason string) audit.NewAuditParams {
+	return auditx.WithOutcome(p, out, reason)
+}
+
+// requireSuperAdmin returns the actor when it is a super-admin acting
+// in its own tenant. A super-admin who narrowed the request to another
+// tenant (x-tenant-id) is working as that tenant and is refused too.
+func requireSuperAdmin(ctx context.Context) (actor.Actor, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return actor.Actor{}, err
+	}
+	if !tenant.IsSuperAdmin(ctx, a) || tenant.Home(ctx) != tenant.System {
+		return a, domain.ErrSuperAdminRequired
+	}
+	return a, nil
+}
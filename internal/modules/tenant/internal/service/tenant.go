@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/cursor"
+	"sso/internal/kernel/etag"
+	"sso/internal/kernel/tenant"
+	"sso/internal/kernel/validation"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	"sso/internal/modules/tenant/internal/domain"
+)
+
+// ----------------------------------------------------------------------------
+// CreateTenant
+// ----------------------------------------------------------------------------
+
+type CreateTenantInput struct {
+	Slug string
+	Name string
+}
+
+// CreateTenant registers an empty tenant. Its first users and apps are
+// created by a super-admin working in it (x-tenant-id).
+func (s *Service) CreateTenant(ctx context.Context, in CreateTenantInput) (*domain.Tenant, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	aud := audit.BaseFromActor(a, audit.EventTypeTenantCreate)
+	aud.SubjectType = audit.SubjectTypeTenant
+	aud.Metadata = map[string]string{"slug": in.Slug}
+
+	if _, err := requireSuperAdmin(ctx); err != nil {
+		s.auditor.Deny(ctx, aud, audit.ReasonPermissionDenied)
+		return nil, err
+	}
+	id, err := domain.NewTenantID()
+	if err != nil {
+		return nil, err
+	}
+	t, err := domain.NewTenant(domain.NewTenantParams{
+		ID:   id,
+		Slug: in.Slug,
+		Name: in.Name,
+		Now:  s.now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	aud.SubjectID = id.String()
+
+	if err := s.repo.Create(ctx, t); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("create tenant: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return t, nil
+}
+
+// ----------------------------------------------------------------------------
+// GetTenant / ListTenants
+// ----------------------------------------------------------------------------
+
+// GetTenant returns the tenant. Principals of other tenants get
+// ErrTenantNotFound, as if it did not exist.
+func (s *Service) GetTenant(ctx context.Context, rawID string) (*domain.Tenant, error) {
+	if _, err := actor.Require(ctx); err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseTenantID(rawID)
+	if err != nil {
+		return nil, err
+	}
+	if !tenant.Visible(ctx, id.String()) {
+		return nil, domain.ErrTenantNotFound
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+type ListTenantsInput struct {
+	PageSize  int32
+	PageToken string
+}
+
+type ListTenantsOutput struct {
+	Tenants       []*domain.Tenant
+	NextPageToken string
+}
+
+// ListTenants pages every tenant by slug. Super-admins only.
+func (s *Service) ListTenants(ctx context.Context, in ListTenantsInput) (ListTenantsOutput, error) {
+	if _, err := requireSuperAdmin(ctx); err != nil {
+		return ListTenantsOutput{}, err
+	}
+	after, err := decodeCursor(in.PageToken)
+	if err != nil {
+		return ListTenantsOutput{}, err
+	}
+	pageSize, err := auditx.ClampPageSize(in.PageSize)
+	if err != nil {
+		return ListTenantsOutput{}, err
+	}
+	res, err := s.repo.List(ctx, domain.ListQuery{PageSize: pageSize, After: after})
+	if err != nil {
+		return ListTenantsOutput{}, err
+	}
+	next, err := encodeCursor(res.NextAfter)
+	if err != nil {
+		return ListTenantsOutput{}, err
+	}
+	return ListTenantsOutput{Tenants: res.Tenants, NextPageToken: next}, nil
+}
+
+type pageToken struct {
+	Slug string `json:"s"`
+}
+
+func encodeCursor(after string) (string, error) {
+	if after == "" {
+		return "", nil
+	}
+	return cursor.Encode(&pageToken{Slug: after})
+}
+
+func decodeCursor(s string) (string, error) {
+	t, err := cursor.Decode[pageToken](s)
+	if err != nil || (t != nil && t.Slug == "") {
+		return "", &validation.Error{Field: "page_token", Reason: "malformed token"}
+	}
+	if t == nil {
+		return "", nil
+	}
+	return t.Slug, nil
+}
+
+// ----------------------------------------------------------------------------
+// UpdateTenant
+// ----------------------------------------------------------------------------
+
+type UpdateTenantInput struct {
+	TenantID     string
+	Name         *string
+	ExpectedEtag string
+}
+
+func (s *Service) UpdateTenant(ctx context.Context, in UpdateTenantInput) (*domain.Tenant, error) {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := domain.ParseTenantID(in.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, false)
+	if err != nil {
+		return nil, err
+	}
+	patch := domain.TenantPatch{Name: in.Name}
+	if patch.IsEmpty() {
+		return nil, &validation.Error{Field: "update", Reason: "must change at least one field"}
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeTenantUpdate)
+	aud.SubjectType = audit.SubjectTypeTenant
+	aud.SubjectID = id.String()
+
+	t, err := s.update(ctx, id, patch, expectedEtag)
+	if err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return nil, fmt.Errorf("update tenant: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return t, nil
+}
+
+func (s *Service) update(ctx context.Context, id domain.TenantID, patch domain.TenantPatch, expectedEtag etag.Etag) (*domain.Tenant, error) {
+	if _, err := requireSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if expectedEtag != "" && expectedEtag != t.Etag() {
+		return nil, domain.ErrEtagMismatch
+	}
+	before := t.Etag()
+	if err := t.ApplyPatch(patch, s.now().UTC()); err != nil {
+		return nil, err
+	}
+	if t.Etag() == before {
+		// Nothing changed; MariaDB would report 0 affected rows.
+		return t, nil
+	}
+	if err := s.repo.Update(ctx, t, before); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ----------------------------------------------------------------------------
+// DeleteTenant
+// ----------------------------------------------------------------------------
+
+type DeleteTenantInput struct {
+	TenantID     string
+	ExpectedEtag string
+}
+
+// DeleteTenant removes an empty tenant. One that still owns users, apps
+// or service accounts is refused with ErrTenantNotEmpty, and the
+// system tenant can never be deleted.
+func (s *Service) DeleteTenant(ctx context.Context, in DeleteTenantInput) error {
+	a, err := actor.Require(ctx)
+	if err != nil {
+		return err
+	}
+	id, err := domain.ParseTenantID(in.TenantID)
+	if err != nil {
+		return err
+	}
+	expectedEtag, err := auditx.ParseExpectedEtag(in.ExpectedEtag, false)
+	if err != nil {
+		return err
+	}
+
+	aud := audit.BaseFromActor(a, audit.EventTypeTenantDelete)
+	aud.SubjectType = audit.SubjectTypeTenant
+	aud.SubjectID = id.String()
+
+	if err := s.delete(ctx, id, expectedEtag); err != nil {
+		out, reason := classifyError(err)
+		s.auditor.Emit(ctx, withOutcome(aud, out, reason))
+		return fmt.Errorf("delete tenant: %w", err)
+	}
+
+	s.auditor.Success(ctx, aud)
+	return nil
+}
+
+func (s *Service) delete(ctx context.Context, id domain.TenantID, expectedEtag etag.Etag) error {
+	if _, err := requireSuperAdmin(ctx); err != nil {
+		return err
+	}
+	if id == domain.SystemTenantID {
+		return domain.ErrSystemTenant
+	}
+	return s.repo.Delete(ctx, id, expectedEtag)
+}
@@ -0,0 +1,88 @@
+// Package tenant exposes the wire-up for the tenant bounded context.
+// bootstrap.New constructs a single *tenant.Module and pulls everything
+// else off it:
+//
+//	mod.HTTPRoutes(authn)  // /v1/tenants
+//	mod.Service()          // application-layer service (rare)
+//	mod.Repository()       // persistence contract
+//
+// Like group, the surface is HTTP-only until a TenantService contract
+// is published in sso_protos.
+package tenant
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"sso/internal/modules/audit"
+	"sso/internal/modules/tenant/internal/httpapi"
+	"sso/internal/modules/tenant/internal/mariadb"
+	"sso/internal/modules/tenant/internal/service"
+)
+
+// Emitter is the audit-event sink the module uses to record write
+// operations. Type alias over audit.Emitter — keeps the wiring
+// contract local to this module.
+type Emitter = audit.Emitter
+
+// Deps lists everything tenant needs from its host.
+type Deps struct {
+	DB  *sql.DB
+	Log *slog.Logger
+
+	Clock func() time.Time
+	Audit Emitter
+}
+
+// Module is the assembled tenant bounded context.
+type Module struct {
+	service *service.Service
+	repo    *mariadb.Repository
+	log     *slog.Logger
+}
+
+// New wires the module from its dependencies.
+func New(d Deps) (*Module, error) {
+	if d.DB == nil {
+		return nil, fmt.Errorf("tenant: db is required")
+	}
+	if d.Log == nil {
+		return nil, fmt.Errorf("tenant: log is required")
+	}
+	if d.Clock == nil {
+		d.Clock = time.Now
+	}
+	if d.Audit == nil {
+		d.Audit = audit.NopEmitter{}
+	}
+
+	repo := mariadb.NewRepository(d.DB)
+
+	var _ Repository = repo
+
+	svc := service.NewService(d.Log, repo, d.Clock, d.Audit)
+
+	return &Module{
+		service: svc,
+		repo:    repo,
+		log:     d.Log,
+	}, nil
+}
+
+// HTTPRoutes returns the registrar for the tenant endpoints. The
+// authenticator and authorizer are taken here rather than in Deps
+// because bootstrap builds them (grpcauth.Interceptor,
+// httprbac.Authorizer) after the modules.
+func (m *Module) HTTPRoutes(authn Authenticator, authz Authorizer) func(*http.ServeMux) {
+	h := httpapi.NewHandler(m.service, authn, authz, m.log)
+	return h.Register
+}
+
+// Service returns the application-layer Service.
+func (m *Module) Service() *service.Service { return m.service }
+
+// Repository returns the persistence contract.
+func (m *Module) Repository() Repository { return m.repo }
@@ -0,0 +1,17 @@
+// Package tenant re-exports the application-layer Service together with
+// the typed Input/Output structs declared in internal/service.
+package tenant
+
+import "sso/internal/modules/tenant/internal/service"
+
+// Service is the use-case orchestrator, in internal/service/tenant.go.
+type Service = service.Service
+
+// Input / Output type aliases.
+type (
+	CreateTenantInput = service.CreateTenantInput
+	ListTenantsInput  = service.ListTenantsInput
+	ListTenantsOutput = service.ListTenantsOutput
+	UpdateTenantInput = service.UpdateTenantInput
+	DeleteTenantInput = service.DeleteTenantInput
+)
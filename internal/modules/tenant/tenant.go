@@ -0,0 +1,48 @@
+// Package tenant is the public API of the tenant bounded context
+// (isolated organisations sharing one deployment). External callers
+// interact with the module through:
+//
+//	tenant.New(Deps)     wires the module (module.go)
+//	tenant.Service       application-layer use-cases (service.go)
+//	tenant.Repository    persistence contract
+//
+// Which tenant a request works in is not decided here but by
+// kernel/tenant, which every tenant-owned repository consults.
+package tenant
+
+import (
+	"sso/internal/modules/tenant/internal/domain"
+	"sso/internal/modules/tenant/internal/httpapi"
+)
+
+type (
+	Tenant              = domain.Tenant
+	TenantID            = domain.TenantID
+	TenantPatch         = domain.TenantPatch
+	NewTenantParams     = domain.NewTenantParams
+	RestoreTenantParams = domain.RestoreTenantParams
+	Repository          = domain.Repository
+
+	// Authenticator is satisfied by *grpcauth.Interceptor.
+	Authenticator = httpapi.Authenticator
+	// Authorizer is satisfied by *httprbac.Authorizer.
+	Authorizer = httpapi.Authorizer
+)
+
+var (
+	NewTenantID   = domain.NewTenantID
+	ParseTenantID = domain.ParseTenantID
+)
+
+// SystemTenantID is the tenant seeded by the tenancy migration.
+const SystemTenantID = domain.SystemTenantID
+
+// Sentinel errors. External consumers test for them with errors.Is.
+var (
+	ErrTenantNotFound      = domain.ErrTenantNotFound
+	ErrTenantAlreadyExists = domain.ErrTenantAlreadyExists
+	ErrTenantNotEmpty      = domain.ErrTenantNotEmpty
+	ErrSystemTenant        = domain.ErrSystemTenant
+	ErrSuperAdminRequired  = domain.ErrSuperAdminRequired
+	ErrEtagMismatch        = domain.ErrEtagMismatch
+)
@@ -7,33 +7,95 @@ import (
 	"log/slog"
 	"sso/internal/modules/access"
 	"sso/internal/kernel/actor"
-	"sync/atomic"
+	"sso/internal/kernel/tenant"
+	"strings"
+	"sync"
 )
 
 const (
-	adminAppSlug       = "sso-admin"
+	adminAppSlug = "sso-admin"
+
+	// tenantAdminAppSlugPrefix prefixes a tenant's slug to name the
+	// admin app of a tenant other than System: the tenant "acme" is
+	// administered through "sso-admin-acme", an app of that tenant.
+	tenantAdminAppSlugPrefix = "sso-admin-"
+
 	requiredPermission = "audit:read"
+
+	// superAdminPermission makes a System-tenant principal a
+	// super-admin (see kernel/tenant).
+	superAdminPermission = "tenants:admin"
 )
 
+// systemOnlyResources are the permission resources only System-tenant
+// principals may hold: tenants are managed by super-admins, and audit
+// events carry no tenant, so reading them would cross tenants.
+var systemOnlyResources = []string{"tenants:", "audit:"}
+
 type AccessBackedAuthorizer struct {
 	accessSvc *access.Service
 	db        *sql.DB
 	log       *slog.Logger
 
-	appID atomic.Pointer[string]
+	mu     sync.RWMutex
+	appIDs map[string]string // tenant id → admin app id
 }
 
 func New(accessSvc *access.Service, db *sql.DB, log *slog.Logger) *AccessBackedAuthorizer {
-	return &AccessBackedAuthorizer{accessSvc: accessSvc, db: db, log: log}
+	return &AccessBackedAuthorizer{accessSvc: accessSvc, db: db, log: log, appIDs: make(map[string]string)}
 }
 
+// CanReadAudit asks access whether the caller holds audit:read in the
+// admin app. Users and service accounts are checked alike — both can
+// hold roles; a system actor has no assignments and is refused. Audit
+// events carry no tenant, so only System-tenant principals qualify.
 func (a *AccessBackedAuthorizer) CanReadAudit(ctx context.Context) (bool, error) {
 	act, ok := actor.From(ctx)
-	if !ok || act.Kind != actor.KindUser {
+	if !ok {
 		return false, nil
 	}
+	return a.HasPermission(ctx, act, requiredPermission)
+}
 
-	resolved, err := a.resolveAdminAppID(ctx)
+// HasPermission asks access whether act holds permission in the admin
+// app of act's home tenant. It satisfies grpcrbac.Checker and
+// httprbac.Checker. A tenant admin administers its own tenant only —
+// the services scope every call to the caller's tenant — and never
+// holds a systemOnlyResources permission.
+func (a *AccessBackedAuthorizer) HasPermission(ctx context.Context, act actor.Actor, permission string) (bool, error) {
+	if !act.IsUser() && !act.IsServiceAccount() {
+		return false, nil
+	}
+	home := tenant.Of(act)
+	if home != tenant.System {
+		for _, res := range systemOnlyResources {
+			if strings.HasPrefix(permission, res) {
+				return false, nil
+			}
+		}
+	}
+	return a.checkAdmin(ctx, act, home, permission)
+}
+
+// IsSuperAdmin asks access whether a holds tenants:admin in the admin
+// app. It satisfies grpcauth.SuperAdminChecker, whose check runs
+// outside the request's own super-admin answer, so it takes the actor
+// explicitly.
+func (a *AccessBackedAuthorizer) IsSuperAdmin(ctx context.Context, act actor.Actor) (bool, error) {
+	if !act.IsUser() && !act.IsServiceAccount() {
+		return false, nil
+	}
+	if tenant.Of(act) != tenant.System {
+		return false, nil
+	}
+	return a.checkAdmin(ctx, act, tenant.System, superAdminPermission)
+}
+
+// checkAdmin runs in tenantID, where the admin app and act's roles in
+// it live, whichever tenant a super-admin is working in.
+func (a *AccessBackedAuthorizer) checkAdmin(ctx context.Context, act actor.Actor, tenantID, permission string) (bool, error) {
+	ctx = tenant.With(ctx, tenantID)
+	resolved, err := a.resolveAdminAppID(ctx, tenantID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return false, nil
@@ -45,7 +107,7 @@ func (a *AccessBackedAuthorizer) CanReadAudit(ctx context.Context) (bool, error)
 	output, err := a.accessSvc.CheckPermission(ctx, access.CheckPermissionInput{
 		UserID:     act.ID,
 		AppID:      resolved,
-		Permission: requiredPermission,
+		Permission: permission,
 	})
 	if err != nil {
 		a.log.Warn("auditauthz: check permission", slog.Any("error", err))
@@ -55,19 +117,35 @@ func (a *AccessBackedAuthorizer) CanReadAudit(ctx context.Context) (bool, error)
 	return output.Allowed, nil
 }
 
-// resolveAdminAppID looks up the sso-admin app UUID. Hits are cached;
-// misses are not, so seed-admin can be run after sso starts without a
-// process restart.
-func (a *AccessBackedAuthorizer) resolveAdminAppID(ctx context.Context) (string, error) {
-	if p := a.appID.Load(); p != nil {
-		return *p, nil
+// resolveAdminAppID looks up the UUID of tenantID's admin app:
+// sso-admin for System, sso-admin-<tenant slug> for any other tenant.
+// CreateApp refuses the sso-admin prefix, so only seed-admin makes
+// these apps.
+// Hits are cached; misses are not, so seed-admin can be run after sso
+// starts without a process restart.
+func (a *AccessBackedAuthorizer) resolveAdminAppID(ctx context.Context, tenantID string) (string, error) {
+	a.mu.RLock()
+	id, ok := a.appIDs[tenantID]
+	a.mu.RUnlock()
+	if ok {
+		return id, nil
 	}
-	var id string
-	if err := a.db.QueryRowContext(ctx,
-		"SELECT id FROM apps WHERE slug = ?", adminAppSlug,
-	).Scan(&id); err != nil {
+	var err error
+	if tenantID == tenant.System {
+		err = a.db.QueryRowContext(ctx,
+			"SELECT id FROM apps WHERE slug = ? AND tenant_id = ?", adminAppSlug, tenantID,
+		).Scan(&id)
+	} else {
+		err = a.db.QueryRowContext(ctx,
+			`SELECT a.id FROM apps a JOIN tenants t ON t.id = a.tenant_id
+			 WHERE t.id = ? AND a.slug = CONCAT(?, t.slug)`, tenantID, tenantAdminAppSlugPrefix,
+		).Scan(&id)
+	}
+	if err != nil {
 		return "", err
 	}
-	a.appID.Store(&id)
+	a.mu.Lock()
+	a.appIDs[tenantID] = id
+	a.mu.Unlock()
 	return id, nil
 }
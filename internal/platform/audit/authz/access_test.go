@@ -0,0 +1,30 @@
+package authz
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"sso/internal/kernel/actor"
+)
+
+// Refusals decided before access is consulted need neither the access
+// service nor a database.
+func TestTenantAdminsNeverHoldSystemOnlyPermissions(t *testing.T) {
+	a := New(nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	tenantAdmin := actor.Actor{
+		ID:       "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90",
+		Kind:     actor.KindUser,
+		TenantID: "0190b6f2-8a43-7c1e-9d2a-000000000001",
+	}
+	for _, perm := range []string{"tenants:create", "tenants:admin", "audit:read"} {
+		ok, err := a.HasPermission(context.Background(), tenantAdmin, perm)
+		if err != nil || ok {
+			t.Fatalf("HasPermission(%q) = %v, %v; want false, nil", perm, ok, err)
+		}
+	}
+	if ok, err := a.IsSuperAdmin(context.Background(), tenantAdmin); err != nil || ok {
+		t.Fatalf("IsSuperAdmin = %v, %v; want false, nil", ok, err)
+	}
+}
@@ -0,0 +1,117 @@
+// Package authzversion implements kernel/authzver over the single-row
+// authz_version table (migration 0025).
+//
+// A Bump increments the row and advances the local generation at once,
+// so the replica that made a write never serves a decision cached
+// before it. Other replicas learn of the bump by polling the row every
+// poll interval; that interval is the longest another replica can go on
+// serving a snapshot older than the write.
+package authzversion
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"sso/internal/kernel/authzver"
+)
+
+const (
+	bumpQuery = `UPDATE authz_version SET version = LAST_INSERT_ID(version + 1) WHERE id = 1`
+	readQuery = `SELECT version FROM authz_version WHERE id = 1`
+)
+
+// Counter is the database-backed authzver.Version. Construct with New
+// and run Start for cross-replica invalidation; without Start only
+// local bumps are observed.
+type Counter struct {
+	db       *sql.DB
+	log      *slog.Logger
+	interval time.Duration
+
+	// mu orders updates of seen, the last row version this replica
+	// knows of, between Bump and the poller.
+	mu   sync.Mutex
+	seen uint64
+	gen  atomic.Uint64
+}
+
+var _ authzver.Version = (*Counter)(nil)
+
+// New returns a Counter polling every interval. The caller passes an
+// already-validated, positive interval.
+func New(db *sql.DB, log *slog.Logger, interval time.Duration) *Counter {
+	return &Counter{db: db, log: log, interval: interval}
+}
+
+// Generation implements authzver.Version.
+func (c *Counter) Generation() uint64 { return c.gen.Load() }
+
+// Bump advances the local generation and the shared row. The local
+// step happens whatever the database says: a failed increment only
+// delays the other replicas until their snapshots expire.
+func (c *Counter) Bump(ctx context.Context) {
+	c.gen.Add(1)
+
+	res, err := c.db.ExecContext(ctx, bumpQuery)
+	if err == nil {
+		var v int64
+		if v, err = res.LastInsertId(); err == nil {
+			c.mu.Lock()
+			c.seen = max(c.seen, uint64(v))
+			c.mu.Unlock()
+			return
+		}
+	}
+	c.log.ErrorContext(ctx, "authzversion: bump", "err", err)
+}
+
+// Start reads the current row version and launches the poller, which
+// runs until ctx is cancelled. It returns immediately.
+func (c *Counter) Start(ctx context.Context) {
+	if v, err := c.read(ctx); err == nil {
+		c.mu.Lock()
+		c.seen = v
+		c.mu.Unlock()
+	} else {
+		c.log.WarnContext(ctx, "authzversion: initial read", "err", err)
+	}
+	go c.poll(ctx)
+}
+
+func (c *Counter) poll(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			v, err := c.read(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					c.log.WarnContext(ctx, "authzversion: poll", "err", err)
+				}
+				continue
+			}
+			// Any difference counts, not just a higher version: a
+			// spurious bump costs cache misses, a missed one stale
+			// decisions.
+			c.mu.Lock()
+			if v != c.seen {
+				c.seen = v
+				c.gen.Add(1)
+			}
+			c.mu.Unlock()
+		}
+	}
+}
+
+func (c *Counter) read(ctx context.Context) (uint64, error) {
+	var v uint64
+	err := c.db.QueryRowContext(ctx, readQuery).Scan(&v)
+	return v, err
+}
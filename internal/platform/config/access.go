@@ -0,0 +1,51 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AccessConfig tunes the access module's background work and its
+// permission snapshot cache.
+//
+// ExpirySweepInterval is how often role assignments past their
+// expires_at are deleted; CheckPermission ignores them as soon as they
+// lapse, so the sweep only bounds how long the rows linger.
+//
+// SnapshotTTL caps how long a cached (principal, app) permission
+// snapshot is served; 0 turns the cache off. SnapshotMaxEntries bounds
+// its size; past it the least recently used snapshot goes.
+// VersionPollInterval is how often the authz_version row is
+// read for writes made on other replicas — the longest a replica can
+// serve a decision that another replica's write has changed.
+//
+// SoDOverridePermission is the sso-admin permission a caller needs to
+// grant a role past a separation-of-duties rule with x-sod-override.
+type AccessConfig struct {
+	ExpirySweepInterval time.Duration `yaml:"expiry_sweep_interval" env:"ACCESS_EXPIRY_SWEEP_INTERVAL" env-default:"1m"`
+	SnapshotTTL         time.Duration `yaml:"snapshot_ttl" env:"ACCESS_SNAPSHOT_TTL" env-default:"30s"`
+	SnapshotMaxEntries  int           `yaml:"snapshot_max_entries" env:"ACCESS_SNAPSHOT_MAX_ENTRIES" env-default:"10000"`
+	VersionPollInterval time.Duration `yaml:"version_poll_interval" env:"ACCESS_VERSION_POLL_INTERVAL" env-default:"1s"`
+
+	SoDOverridePermission string `yaml:"sod_override_permission" env:"ACCESS_SOD_OVERRIDE_PERMISSION" env-default:"access:sod_override"`
+}
+
+func (c *AccessConfig) validate() error {
+	if c.ExpirySweepInterval <= 0 {
+		return fmt.Errorf("access.expiry_sweep_interval: must be > 0")
+	}
+	if c.SnapshotTTL < 0 {
+		return fmt.Errorf("access.snapshot_ttl: must be >= 0")
+	}
+	if c.SnapshotTTL > 0 && c.SnapshotMaxEntries <= 0 {
+		return fmt.Errorf("access.snapshot_max_entries: must be > 0 when the snapshot cache is on")
+	}
+	if c.VersionPollInterval <= 0 {
+		return fmt.Errorf("access.version_poll_interval: must be > 0")
+	}
+	if strings.TrimSpace(c.SoDOverridePermission) == "" {
+		return fmt.Errorf("access.sod_override_permission: must not be empty")
+	}
+	return nil
+}
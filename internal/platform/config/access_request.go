@@ -0,0 +1,47 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AccessRequestConfig turns on the just-in-time access request
+// endpoints (/v1/access-requests) on the HTTP listener, so Enabled
+// requires http.enabled.
+//
+// ApproverPermission is checked in the app of each request: its holders
+// approve or deny the app's requests. MaxDuration caps the grant a
+// request may ask for; PendingTTL is how long a request waits for a
+// decision before ExpirySweepInterval's sweep expires it.
+type AccessRequestConfig struct {
+	Enabled             bool          `yaml:"enabled" env:"ACCESS_REQUESTS_ENABLED" env-default:"false"`
+	ApproverPermission  string        `yaml:"approver_permission" env:"ACCESS_REQUESTS_APPROVER_PERMISSION" env-default:"access_requests:approve"`
+	MaxDuration         time.Duration `yaml:"max_duration" env:"ACCESS_REQUESTS_MAX_DURATION" env-default:"8h"`
+	PendingTTL          time.Duration `yaml:"pending_ttl" env:"ACCESS_REQUESTS_PENDING_TTL" env-default:"72h"`
+	ExpirySweepInterval time.Duration `yaml:"expiry_sweep_interval" env:"ACCESS_REQUESTS_EXPIRY_SWEEP_INTERVAL" env-default:"1m"`
+}
+
+func (c *AccessRequestConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+
+	if strings.TrimSpace(c.ApproverPermission) == "" {
+		errs = append(errs, fmt.Errorf("access_requests.approver_permission: required"))
+	}
+	if c.MaxDuration < time.Minute {
+		errs = append(errs, fmt.Errorf("access_requests.max_duration: must be >= 1m"))
+	}
+	if c.PendingTTL <= 0 {
+		errs = append(errs, fmt.Errorf("access_requests.pending_ttl: must be > 0"))
+	}
+	if c.ExpirySweepInterval <= 0 {
+		errs = append(errs, fmt.Errorf("access_requests.expiry_sweep_interval: must be > 0"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -12,8 +12,19 @@ type AuthConfig struct {
 	Session SessionConfig `yaml:"session"`
 	Bcrypt  BcryptConfig  `yaml:"bcrypt"`
 	Lockout LockoutConfig `yaml:"lockout"`
+
+	// Backends is the ordered list of password backends Login tries.
+	// "local" checks users.password_hash; "ldap" binds against the
+	// directory section.
+	Backends []string `yaml:"backends" env:"AUTH_BACKENDS" env-default:"local" env-separator:","`
 }
 
+// Password backend names accepted in auth.backends.
+const (
+	AuthBackendLocal = "local"
+	AuthBackendLDAP  = "ldap"
+)
+
 type JWTConfig struct {
 	PrivateKeyPath string        `yaml:"private_key_path" env:"JWT_PRIVATE_KEY_PATH" env-required:"true"`
 	PublicKeyPath  string        `yaml:"public_key_path"  env:"JWT_PUBLIC_KEY_PATH"  env-required:"true"`
@@ -70,5 +81,19 @@ func (c *AuthConfig) validate() error {
 		errs = append(errs, fmt.Errorf("auth.lockout.duration: must be > 0"))
 	}
 
+	if len(c.Backends) == 0 {
+		errs = append(errs, fmt.Errorf("auth.backends: must list at least one backend"))
+	}
+	seen := make(map[string]bool, len(c.Backends))
+	for _, b := range c.Backends {
+		switch {
+		case b != AuthBackendLocal && b != AuthBackendLDAP:
+			errs = append(errs, fmt.Errorf("auth.backends: unknown backend %q", b))
+		case seen[b]:
+			errs = append(errs, fmt.Errorf("auth.backends: duplicate backend %q", b))
+		}
+		seen[b] = true
+	}
+
 	return errors.Join(errs...)
 }
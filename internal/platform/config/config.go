@@ -32,6 +32,18 @@ type Config struct {
 	Auth      AuthConfig      `yaml:"auth"`
 	Audit     AuditConfig     `yaml:"audit"`
 	RateLimit RateLimitConfig `yaml:"rate_limit"`
+
+	Federation  FederationConfig    `yaml:"federation"`
+	Directory   DirectoryConfig     `yaml:"directory"`
+	SAML        SAMLConfig          `yaml:"saml"`
+	SCIM        SCIMConfig          `yaml:"scim"`
+	Mail        MailConfig          `yaml:"mail"`
+	Invitations InvitationConfig    `yaml:"invitations"`
+	EmailChange EmailChangeConfig   `yaml:"email_change"`
+	Access      AccessConfig        `yaml:"access"`
+	Permissions PermissionConfig    `yaml:"permissions"`
+	Reviews     ReviewConfig        `yaml:"access_reviews"`
+	Requests    AccessRequestConfig `yaml:"access_requests"`
 }
 
 const EnvConfigPath = "CONFIG_PATH"
@@ -84,5 +96,83 @@ func (c *Config) Validate() error {
 		c.Auth.validate(),
 		c.Audit.validate(),
 		c.RateLimit.validate(),
+		c.Federation.validate(),
+		c.validateFederationListener(),
+		c.Directory.validate(),
+		c.validateAuthBackends(),
+		c.SAML.validate(),
+		c.validateSAMLListener(),
+		c.SCIM.validate(),
+		c.validateSCIMListener(),
+		c.Mail.validate(),
+		c.Invitations.validate(),
+		c.validateInvitationsListener(),
+		c.EmailChange.validate(),
+		c.Access.validate(),
+		c.Permissions.validate(),
+		c.Reviews.validate(),
+		c.validateReviewsListener(),
+		c.Requests.validate(),
+		c.validateRequestsListener(),
 	)
 }
+
+// validateFederationListener checks the cross-section constraints: the
+// federation endpoints are plain HTTP handlers, so they need the HTTP
+// listener, and a federated login ends in a cookie-mode session.
+func (c *Config) validateFederationListener() error {
+	if c.Federation.Enabled && !c.HTTP.Enabled {
+		return fmt.Errorf("federation.enabled: requires http.enabled")
+	}
+	if c.Federation.Enabled && !c.HTTP.Cookies.Enabled {
+		return fmt.Errorf("federation.enabled: requires http.cookies.enabled")
+	}
+	return nil
+}
+
+// validateSAMLListener — the SAML endpoints are plain HTTP handlers too,
+// and browser SSO asserts the cookie-mode session.
+func (c *Config) validateSAMLListener() error {
+	if c.SAML.Enabled && !c.HTTP.Enabled {
+		return fmt.Errorf("saml.enabled: requires http.enabled")
+	}
+	if c.SAML.Enabled && !c.HTTP.Cookies.Enabled {
+		return fmt.Errorf("saml.enabled: requires http.cookies.enabled")
+	}
+	return nil
+}
+
+// validateSCIMListener — same constraint for the SCIM endpoint.
+func (c *Config) validateSCIMListener() error {
+	if c.SCIM.Enabled && !c.HTTP.Enabled {
+		return fmt.Errorf("scim.enabled: requires http.enabled")
+	}
+	return nil
+}
+
+// validateInvitationsListener — same constraint for the invitations
+// endpoints.
+func (c *Config) validateInvitationsListener() error {
+	if c.Invitations.Enabled && !c.HTTP.Enabled {
+		return fmt.Errorf("invitations.enabled: requires http.enabled")
+	}
+	return nil
+}
+
+// validateReviewsListener — same constraint for the access review
+// endpoints.
+func (c *Config) validateReviewsListener() error {
+	if c.Reviews.Enabled && !c.HTTP.Enabled {
+		return fmt.Errorf("access_reviews.enabled: requires http.enabled")
+	}
+	return nil
+}
+
+// validateRequestsListener — same constraint for the access request
+// endpoints.
+func (c *Config) validateRequestsListener() error {
+	if c.Requests.Enabled && !c.HTTP.Enabled {
+		return fmt.Errorf("access_requests.enabled: requires http.enabled")
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DirectoryConfig describes the LDAP / Active Directory server used by
+// the "ldap" entry of auth.backends. Sign-in is search-then-bind: the
+// service account (BindDN) searches BaseDN with UserFilter, where
+// {login} is replaced by the escaped email or username the client sent,
+// and the matched entry is then bound with the client's password.
+//
+// Provision creates a local, password-less user on the first successful
+// bind, populated from the Attributes mapping. GroupRoles maps group DNs
+// (read from GroupAttribute) to role ids; mapped roles are granted and
+// removed on every directory sign-in, unmapped roles are left alone.
+type DirectoryConfig struct {
+	Enabled            bool          `yaml:"enabled" env:"DIRECTORY_ENABLED" env-default:"false"`
+	URL                string        `yaml:"url" env:"DIRECTORY_URL"`
+	StartTLS           bool          `yaml:"start_tls" env:"DIRECTORY_START_TLS" env-default:"false"`
+	InsecureSkipVerify bool          `yaml:"insecure_skip_verify" env:"DIRECTORY_INSECURE_SKIP_VERIFY" env-default:"false"`
+	BindDN             string        `yaml:"bind_dn" env:"DIRECTORY_BIND_DN"`
+	BindPassword       Secret        `yaml:"bind_password" env:"DIRECTORY_BIND_PASSWORD"`
+	BaseDN             string        `yaml:"base_dn" env:"DIRECTORY_BASE_DN"`
+	UserFilter         string        `yaml:"user_filter" env:"DIRECTORY_USER_FILTER" env-default:"(&(objectClass=person)(|(mail={login})(uid={login})))"`
+	Timeout            time.Duration `yaml:"timeout" env:"DIRECTORY_TIMEOUT" env-default:"10s"`
+
+	Provision      bool                       `yaml:"provision" env:"DIRECTORY_PROVISION" env-default:"true"`
+	Attributes     DirectoryAttributeConfig   `yaml:"attributes"`
+	GroupAttribute string                     `yaml:"group_attribute" env:"DIRECTORY_GROUP_ATTRIBUTE" env-default:"memberOf"`
+	GroupRoles     []DirectoryGroupRoleConfig `yaml:"group_roles"`
+}
+
+type DirectoryAttributeConfig struct {
+	Email       string `yaml:"email" env:"DIRECTORY_ATTR_EMAIL" env-default:"mail"`
+	Username    string `yaml:"username" env:"DIRECTORY_ATTR_USERNAME" env-default:"uid"`
+	DisplayName string `yaml:"display_name" env:"DIRECTORY_ATTR_DISPLAY_NAME" env-default:"cn"`
+}
+
+type DirectoryGroupRoleConfig struct {
+	Group  string `yaml:"group"`
+	RoleID string `yaml:"role_id"`
+}
+
+func (c *DirectoryConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+
+	u, err := url.Parse(c.URL)
+	if c.URL == "" || err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("directory.url: must be an ldap:// or ldaps:// URL"))
+	} else if c.StartTLS && u.Scheme == "ldaps" {
+		errs = append(errs, fmt.Errorf("directory.start_tls: not allowed with ldaps://"))
+	}
+
+	if c.BaseDN == "" {
+		errs = append(errs, fmt.Errorf("directory.base_dn: required"))
+	}
+	if !strings.Contains(c.UserFilter, "{login}") {
+		errs = append(errs, fmt.Errorf("directory.user_filter: must contain {login}"))
+	}
+	if c.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("directory.timeout: must be > 0"))
+	}
+	if c.Provision && c.Attributes.Email == "" {
+		errs = append(errs, fmt.Errorf("directory.attributes.email: required when provision is on"))
+	}
+
+	if len(c.GroupRoles) > 0 && c.GroupAttribute == "" {
+		errs = append(errs, fmt.Errorf("directory.group_attribute: required with group_roles"))
+	}
+	for i, gr := range c.GroupRoles {
+		if gr.Group == "" || gr.RoleID == "" {
+			errs = append(errs, fmt.Errorf("directory.group_roles[%d]: group and role_id are required", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateAuthBackends checks auth.backends against the sections that
+// back them: "ldap" needs directory.enabled, and an enabled directory
+// that no backend refers to is almost certainly a mistake.
+func (c *Config) validateAuthBackends() error {
+	ldap := slices.Contains(c.Auth.Backends, AuthBackendLDAP)
+	switch {
+	case ldap && !c.Directory.Enabled:
+		return fmt.Errorf("auth.backends: %q requires directory.enabled", AuthBackendLDAP)
+	case !ldap && c.Directory.Enabled:
+		return fmt.Errorf("directory.enabled: add %q to auth.backends", AuthBackendLDAP)
+	}
+	return nil
+}
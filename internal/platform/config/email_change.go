@@ -0,0 +1,46 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// EmailChangeConfig drives the verified login-email change, which is
+// always on: a new address only takes effect once confirmed through a
+// link to ConfirmURL mailed to it, and the old address is sent a link
+// to CancelURL. Both pages post the ?token= they receive to the
+// /v1/email-change endpoints. TTL bounds the confirm link; CancelWindow,
+// counted from the request, bounds the cancel link, which also reverts
+// a confirmed change.
+type EmailChangeConfig struct {
+	ConfirmURL   string        `yaml:"confirm_url" env:"EMAIL_CHANGE_CONFIRM_URL"`
+	CancelURL    string        `yaml:"cancel_url" env:"EMAIL_CHANGE_CANCEL_URL"`
+	TTL          time.Duration `yaml:"ttl" env:"EMAIL_CHANGE_TTL" env-default:"24h"`
+	CancelWindow time.Duration `yaml:"cancel_window" env:"EMAIL_CHANGE_CANCEL_WINDOW" env-default:"72h"`
+}
+
+func (c *EmailChangeConfig) validate() error {
+	var errs []error
+
+	if !isAbsURL(c.ConfirmURL) {
+		errs = append(errs, fmt.Errorf("email_change.confirm_url: must be an absolute URL"))
+	}
+	if !isAbsURL(c.CancelURL) {
+		errs = append(errs, fmt.Errorf("email_change.cancel_url: must be an absolute URL"))
+	}
+	if c.TTL <= 0 {
+		errs = append(errs, fmt.Errorf("email_change.ttl: must be > 0"))
+	}
+	if c.CancelWindow < c.TTL {
+		errs = append(errs, fmt.Errorf("email_change.cancel_window: must be >= ttl"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func isAbsURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return raw != "" && err == nil && u.IsAbs() && u.Host != ""
+}
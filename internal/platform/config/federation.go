@@ -0,0 +1,43 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// FederationConfig controls sign-in through external OpenID Connect
+// providers. The providers themselves live in the database (managed via
+// /v1/federation/providers); this block only holds what every provider
+// shares. The endpoints are served by the HTTP listener, so Enabled
+// requires http.enabled.
+//
+// CallbackURL is the absolute redirect_uri registered with each
+// provider and must route to GET /v1/federation/callback on this
+// service. StateTTL bounds how long a user may spend at the provider
+// between the authorize redirect and the callback.
+type FederationConfig struct {
+	Enabled     bool          `yaml:"enabled" env:"FEDERATION_ENABLED" env-default:"false"`
+	CallbackURL string        `yaml:"callback_url" env:"FEDERATION_CALLBACK_URL"`
+	StateTTL    time.Duration `yaml:"state_ttl" env:"FEDERATION_STATE_TTL" env-default:"10m"`
+}
+
+func (c *FederationConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+
+	u, err := url.Parse(c.CallbackURL)
+	if c.CallbackURL == "" || err != nil || !u.IsAbs() || u.Host == "" {
+		errs = append(errs, fmt.Errorf("federation.callback_url: must be an absolute URL"))
+	}
+
+	if c.StateTTL <= 0 {
+		errs = append(errs, fmt.Errorf("federation.state_ttl: must be > 0"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -23,6 +23,7 @@ type HTTPConfig struct {
 	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"HTTP_IDLE_TIMEOUT" env-default:"60s"`
 	CORS              CORSConfig    `yaml:"cors"`
 	TLS               HTTPTLSConfig `yaml:"tls"`
+	Cookies           CookieConfig  `yaml:"cookies"`
 }
 
 // CORSConfig configures the HTTP CORS middleware. AllowedOrigins entries are
@@ -33,6 +34,22 @@ type CORSConfig struct {
 	AllowedOrigins []string `yaml:"allowed_origins" env:"HTTP_CORS_ALLOWED_ORIGINS" env-separator:","`
 }
 
+// CookieConfig switches on the browser session mode for the admin SPA.
+// When Enabled=true the HTTP listener exposes cookie-flavoured
+// login / refresh / logout endpoints under Path, keeps the refresh token
+// in an HttpOnly cookie scoped to Path, translates the access-token
+// cookie into `authorization` metadata for the gRPC backend and enforces
+// a double-submit CSRF token on state-changing requests.
+//
+// Cookies are always Secure and HttpOnly (except the CSRF cookie, which
+// the SPA has to read). SameSite is one of strict | lax | none.
+type CookieConfig struct {
+	Enabled  bool   `yaml:"enabled" env:"HTTP_COOKIES_ENABLED" env-default:"false"`
+	Domain   string `yaml:"domain" env:"HTTP_COOKIES_DOMAIN" env-default:""`
+	Path     string `yaml:"path" env:"HTTP_COOKIES_PATH" env-default:"/v1/auth/cookie"`
+	SameSite string `yaml:"same_site" env:"HTTP_COOKIES_SAME_SITE" env-default:"strict"`
+}
+
 type HTTPTLSConfig struct {
 	Enabled  bool   `yaml:"enabled" env:"HTTP_TLS_ENABLED" env-default:"false"`
 	CertPath string `yaml:"cert_path" env:"HTTP_TLS_CERT_PATH" env-default:""`
@@ -67,5 +84,30 @@ func (c *HTTPConfig) validate() error {
 			errs = append(errs, fmt.Errorf("http.cors.allowed_origins[%d]: empty entry", i))
 		}
 	}
+	if c.Cookies.Enabled {
+		errs = append(errs, c.Cookies.validate(c.CORS)...)
+	}
 	return errors.Join(errs...)
 }
+
+func (c *CookieConfig) validate(cors CORSConfig) []error {
+	var errs []error
+	if !strings.HasPrefix(c.Path, "/") || strings.HasSuffix(c.Path, "/") {
+		errs = append(errs, fmt.Errorf("http.cookies.path: %q must start with \"/\" and have no trailing slash", c.Path))
+	}
+	switch strings.ToLower(c.SameSite) {
+	case "strict", "lax", "none":
+	default:
+		errs = append(errs, fmt.Errorf("http.cookies.same_site: %q is not one of strict/lax/none", c.SameSite))
+	}
+	// Browsers drop credentials on a wildcard CORS origin, so the SPA
+	// would never get its cookies back. Fail at startup instead of
+	// shipping a silently broken login.
+	for _, o := range cors.AllowedOrigins {
+		if strings.TrimSpace(o) == "*" {
+			errs = append(errs, fmt.Errorf("http.cookies.enabled: incompatible with wildcard \"*\" in http.cors.allowed_origins"))
+			break
+		}
+	}
+	return errs
+}
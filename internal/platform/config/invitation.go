@@ -0,0 +1,37 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// InvitationConfig turns on the invitations endpoints (/v1/invitations)
+// on the HTTP listener, so Enabled requires http.enabled. Invitations
+// are mailed through the mail section. AcceptURL is the front-end page
+// that takes the invitee's password; the token is appended as
+// ?token=. TTL bounds how long an invitation can be accepted.
+type InvitationConfig struct {
+	Enabled   bool          `yaml:"enabled" env:"INVITATIONS_ENABLED" env-default:"false"`
+	AcceptURL string        `yaml:"accept_url" env:"INVITATIONS_ACCEPT_URL"`
+	TTL       time.Duration `yaml:"ttl" env:"INVITATIONS_TTL" env-default:"168h"`
+}
+
+func (c *InvitationConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+
+	u, err := url.Parse(c.AcceptURL)
+	if c.AcceptURL == "" || err != nil || !u.IsAbs() || u.Host == "" {
+		errs = append(errs, fmt.Errorf("invitations.accept_url: must be an absolute URL"))
+	}
+	if c.TTL <= 0 {
+		errs = append(errs, fmt.Errorf("invitations.ttl: must be > 0"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+)
+
+// MailConfig selects how transactional mail (invitations, email-change
+// confirmations) is delivered.
+// Driver "log" writes messages to the service log instead of sending
+// them — fine for development, never for production, since the bodies
+// carry one-time links.
+type MailConfig struct {
+	Driver string         `yaml:"driver" env:"MAIL_DRIVER" env-default:"log"`
+	SMTP   SMTPMailConfig `yaml:"smtp"`
+}
+
+type SMTPMailConfig struct {
+	Host     string `yaml:"host" env:"MAIL_SMTP_HOST"`
+	Port     int    `yaml:"port" env:"MAIL_SMTP_PORT" env-default:"587"`
+	Username string `yaml:"username" env:"MAIL_SMTP_USERNAME"`
+	Password Secret `yaml:"password" env:"MAIL_SMTP_PASSWORD"`
+	From     string `yaml:"from" env:"MAIL_SMTP_FROM"`
+}
+
+func (c *MailConfig) validate() error {
+	switch c.Driver {
+	case "log":
+		return nil
+	case "smtp":
+	default:
+		return fmt.Errorf("mail.driver: must be one of: log, smtp")
+	}
+
+	var errs []error
+	if c.SMTP.Host == "" {
+		errs = append(errs, fmt.Errorf("mail.smtp.host: required"))
+	}
+	if c.SMTP.Port <= 0 || c.SMTP.Port > 65535 {
+		errs = append(errs, fmt.Errorf("mail.smtp.port: must be in 1..65535"))
+	}
+	if _, err := mail.ParseAddress(c.SMTP.From); err != nil {
+		errs = append(errs, fmt.Errorf("mail.smtp.from: must be a mail address"))
+	}
+	return errors.Join(errs...)
+}
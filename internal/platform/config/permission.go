@@ -0,0 +1,24 @@
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// PermissionConfig points at the declarative permission catalog file.
+// When CatalogFile is set, bootstrap replaces the catalog of every app
+// the file lists before serving; the admin HTTP API stays available
+// either way. Empty disables the sync.
+type PermissionConfig struct {
+	CatalogFile string `yaml:"catalog_file" env:"PERMISSION_CATALOG_FILE"`
+}
+
+func (c *PermissionConfig) validate() error {
+	if c.CatalogFile == "" {
+		return nil
+	}
+	if _, err := os.Stat(c.CatalogFile); err != nil {
+		return fmt.Errorf("permissions.catalog_file %q: %w", c.CatalogFile, err)
+	}
+	return nil
+}
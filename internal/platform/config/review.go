@@ -0,0 +1,38 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ReviewConfig turns on the access review endpoints (/v1/access-reviews)
+// on the HTTP listener, so Enabled requires http.enabled.
+//
+// DeadlineSweepInterval is how often open campaigns past their due date
+// get their deadline action applied; a campaign can run up to one
+// interval late. EscalationGrace is how long an escalating campaign
+// gives its escalation reviewer, counted from the sweep that escalates
+// it.
+type ReviewConfig struct {
+	Enabled               bool          `yaml:"enabled" env:"ACCESS_REVIEWS_ENABLED" env-default:"false"`
+	DeadlineSweepInterval time.Duration `yaml:"deadline_sweep_interval" env:"ACCESS_REVIEWS_DEADLINE_SWEEP_INTERVAL" env-default:"5m"`
+	EscalationGrace       time.Duration `yaml:"escalation_grace" env:"ACCESS_REVIEWS_ESCALATION_GRACE" env-default:"168h"`
+}
+
+func (c *ReviewConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+
+	if c.DeadlineSweepInterval <= 0 {
+		errs = append(errs, fmt.Errorf("access_reviews.deadline_sweep_interval: must be > 0"))
+	}
+	if c.EscalationGrace <= 0 {
+		errs = append(errs, fmt.Errorf("access_reviews.escalation_grace: must be > 0"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// SAMLConfig makes this service a SAML 2.0 identity provider. Service
+// providers are registered per app in the database (managed via
+// /v1/saml/service-providers); this block only holds the IdP side. The
+// endpoints are served by the HTTP listener, so Enabled requires
+// http.enabled.
+//
+// EntityID names the IdP in metadata and in every message it issues.
+// BaseURL is the externally visible origin the /v1/saml/* endpoints are
+// published under. CertPath/KeyPath are the RSA signing pair (PEM); the
+// certificate is what SPs pin from the metadata. LoginURL is the
+// front-end sign-in page an unauthenticated SSO request is sent to, with
+// ?return_to=; PostLogoutURL is where an IdP-initiated single logout
+// ends.
+type SAMLConfig struct {
+	Enabled        bool          `yaml:"enabled" env:"SAML_ENABLED" env-default:"false"`
+	EntityID       string        `yaml:"entity_id" env:"SAML_ENTITY_ID"`
+	BaseURL        string        `yaml:"base_url" env:"SAML_BASE_URL"`
+	CertPath       string        `yaml:"cert_path" env:"SAML_CERT_PATH"`
+	KeyPath        string        `yaml:"key_path" env:"SAML_KEY_PATH"`
+	LoginURL       string        `yaml:"login_url" env:"SAML_LOGIN_URL"`
+	PostLogoutURL  string        `yaml:"post_logout_url" env:"SAML_POST_LOGOUT_URL"`
+	AssertionTTL   time.Duration `yaml:"assertion_ttl" env:"SAML_ASSERTION_TTL" env-default:"5m"`
+	LogoutStateTTL time.Duration `yaml:"logout_state_ttl" env:"SAML_LOGOUT_STATE_TTL" env-default:"5m"`
+}
+
+func (c *SAMLConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var errs []error
+
+	if c.EntityID == "" {
+		errs = append(errs, fmt.Errorf("saml.entity_id: required"))
+	}
+	for _, f := range []struct{ name, value string }{
+		{"saml.base_url", c.BaseURL},
+		{"saml.login_url", c.LoginURL},
+		{"saml.post_logout_url", c.PostLogoutURL},
+	} {
+		u, err := url.Parse(f.value)
+		if f.value == "" || err != nil || !u.IsAbs() || u.Host == "" {
+			errs = append(errs, fmt.Errorf("%s: must be an absolute URL", f.name))
+		}
+	}
+	if c.CertPath == "" {
+		errs = append(errs, fmt.Errorf("saml.cert_path: required"))
+	}
+	if c.KeyPath == "" {
+		errs = append(errs, fmt.Errorf("saml.key_path: required"))
+	}
+	if c.AssertionTTL <= 0 {
+		errs = append(errs, fmt.Errorf("saml.assertion_ttl: must be > 0"))
+	}
+	if c.LogoutStateTTL <= 0 {
+		errs = append(errs, fmt.Errorf("saml.logout_state_ttl: must be > 0"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// SCIMConfig turns on the SCIM 2.0 provisioning endpoint (/scim/v2) on
+// the HTTP listener, so Enabled requires http.enabled. Clients
+// authenticate with service-account access tokens. BaseURL is the
+// externally visible origin, used for resource locations.
+type SCIMConfig struct {
+	Enabled bool   `yaml:"enabled" env:"SCIM_ENABLED" env-default:"false"`
+	BaseURL string `yaml:"base_url" env:"SCIM_BASE_URL"`
+}
+
+func (c *SCIMConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	u, err := url.Parse(c.BaseURL)
+	if c.BaseURL == "" || err != nil || !u.IsAbs() || u.Host == "" {
+		return fmt.Errorf("scim.base_url: must be an absolute URL")
+	}
+	return nil
+}
@@ -21,8 +21,11 @@ type ed25519Verifier struct {
 
 type tokenClaims struct {
 	jwt.RegisteredClaims
-	SubjectType SubjectType `json:"subject_type"`
-	SessionID   string      `json:"sid,omitempty"`
+	SubjectType SubjectType    `json:"subject_type"`
+	SessionID   string         `json:"sid,omitempty"`
+	AppID       string         `json:"app_id,omitempty"`
+	TenantID    string         `json:"tid,omitempty"`
+	Attributes  map[string]any `json:"attrs,omitempty"`
 }
 
 func NewEd25519Signer(priv ed25519.PrivateKey, issuer string, accessTTL time.Duration) Signer {
@@ -45,6 +48,12 @@ func (s *ed25519Signer) Sign(c Claims) (string, error) {
 		},
 		SubjectType: c.SubjectType,
 		SessionID:   c.SessionID,
+		AppID:       c.AppID,
+		TenantID:    c.TenantID,
+		Attributes:  c.Attributes,
+	}
+	if c.AppID != "" {
+		tokenClaim.Audience = jwt.ClaimStrings{c.AppID}
 	}
 
 	sign := jwt.SigningMethodEdDSA
@@ -80,6 +89,9 @@ func tokenClaimsToClaims(c tokenClaims) Claims {
 		Subject:     c.Subject,
 		SubjectType: c.SubjectType,
 		SessionID:   c.SessionID,
+		AppID:       c.AppID,
+		TenantID:    c.TenantID,
+		Attributes:  c.Attributes,
 		IssuedAt:    c.IssuedAt.Time,
 		ExpiresAt:   c.ExpiresAt.Time,
 		JTI:         c.ID,
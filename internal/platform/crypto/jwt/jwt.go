@@ -11,11 +11,19 @@ const (
 
 func (s SubjectType) String() string { return string(s) }
 
+// Claims is the access-token payload. AppID is the app the token was
+// issued for; it is also signed as the audience. TenantID is the
+// subject's tenant (the "tid" claim). Attributes are the
+// app's custom user attributes marked for token emission (the "attrs"
+// claim); nil for service accounts and apps that emit none.
 type Claims struct {
 	Issuer      string
 	Subject     string
 	SubjectType SubjectType
 	SessionID   string
+	AppID       string
+	TenantID    string
+	Attributes  map[string]any
 	IssuedAt    time.Time
 	ExpiresAt   time.Time
 	JTI         string
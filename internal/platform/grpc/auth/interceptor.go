@@ -2,13 +2,16 @@ package grpcauth
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
 	"sso/internal/platform/crypto/jwt"
 	"sso/internal/modules/session"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -21,14 +24,24 @@ var (
 	errMissingAuthHeader = status.Error(codes.Unauthenticated, "missing authorization header")
 	errBadAuthScheme     = status.Error(codes.Unauthenticated, "authorization scheme must be Bearer")
 	errEmptyToken        = status.Error(codes.Unauthenticated, "empty bearer token")
+	errForeignTenant     = status.Error(codes.PermissionDenied, "only super-admins may act in another tenant")
+	errBadTenant         = status.Error(codes.InvalidArgument, "x-tenant-id must be a valid UUID")
 )
 
+// SuperAdminChecker decides whether a System-tenant principal is a
+// super-admin (see kernel/tenant). Only consulted for actors of the
+// System tenant, and only when a request needs the answer.
+type SuperAdminChecker interface {
+	IsSuperAdmin(ctx context.Context, a actor.Actor) (bool, error)
+}
+
 type Interceptor struct {
-	verifier   jwt.Verifier
-	sessions   session.Repository
-	log        *slog.Logger
-	publicRPCs map[string]struct{}
-	now        func() time.Time
+	verifier    jwt.Verifier
+	sessions    session.Repository
+	log         *slog.Logger
+	publicRPCs  map[string]struct{}
+	now         func() time.Time
+	superAdmins SuperAdminChecker
 }
 
 func NewInterceptor(
@@ -44,6 +57,10 @@ func NewInterceptor(
 	return &Interceptor{verifier: v, sessions: s, log: log, publicRPCs: set, now: time.Now}
 }
 
+// SetSuperAdmins installs the super-admin check. Without one no one is
+// a super-admin. Call before the servers start serving.
+func (i *Interceptor) SetSuperAdmins(c SuperAdminChecker) { i.superAdmins = c }
+
 func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		if _, public := i.publicRPCs[info.FullMethod]; public {
@@ -56,44 +73,93 @@ func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
 			return nil, errUnauthenticated
 		}
 
-		claims, err := i.verifier.Verify(token)
+		a, err := i.Authenticate(ctx, token)
 		if err != nil {
-			i.log.WarnContext(ctx, "grpcauth: verify", "method", info.FullMethod, "err", err)
+			i.log.WarnContext(ctx, "grpcauth: authenticate", "method", info.FullMethod, "err", err)
 			return nil, errUnauthenticated
 		}
+		a.IpAddress = PeerIP(ctx)
+		a.UserAgent = UserAgentFromCtx(ctx)
 
-		var kind actor.Kind
-		switch claims.SubjectType {
-		case jwt.SubjectTypeUser:
-			kind = actor.KindUser
-		case jwt.SubjectTypeServiceAccount:
-			kind = actor.KindServiceAccount
-		default:
-			i.log.WarnContext(ctx, "grpcauth: unknown subject_type",
-				"method", info.FullMethod, "subject_type", claims.SubjectType)
-			return nil, errUnauthenticated
+		ctx, err = i.Bind(ctx, a, TenantFromCtx(ctx))
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		if kind == actor.KindUser {
-			sess, err := i.sessions.GetByID(ctx, session.SessionID(claims.SessionID))
-			if err != nil || !sess.IsActive(i.now().UTC()) {
-				if err != nil {
-					i.log.WarnContext(ctx, "grpcauth: session lookup",
-						"method", info.FullMethod, "session_id", claims.SessionID, "err", err)
-				}
-				return nil, errUnauthenticated
+// Bind injects a into ctx and moves the request into tenantID, the
+// x-tenant-id the caller sent ("" for none), when that names a tenant
+// other than a's own — which only a super-admin may do. Whether a is a
+// super-admin is asked of the host lazily, through
+// tenant.WithSuperAdminCheck. Unary binds every call; the hand-written
+// HTTP endpoints bind through apiutil.
+func (i *Interceptor) Bind(ctx context.Context, a actor.Actor, tenantID string) (context.Context, error) {
+	ctx = actor.Inject(ctx, a)
+	if i.superAdmins != nil && tenant.Of(a) == tenant.System {
+		// A failed check leaves the caller scoped to the System tenant
+		// rather than failing the request.
+		checkCtx := ctx
+		ctx = tenant.WithSuperAdminCheck(ctx, a, func() bool {
+			ok, err := i.superAdmins.IsSuperAdmin(checkCtx, a)
+			if err != nil {
+				i.log.WarnContext(checkCtx, "grpcauth: super-admin check", "subject", a.ID, "err", err)
 			}
-		}
-
-		ctx = actor.Inject(ctx, actor.Actor{
-			ID:        claims.Subject,
-			Kind:      kind,
-			SessionID: claims.SessionID,
-			IpAddress: PeerIP(ctx),
-			UserAgent: UserAgentFromCtx(ctx),
+			return ok && err == nil
 		})
-		return handler(ctx, req)
 	}
+	if tenantID == "" || tenantID == tenant.Of(a) {
+		return ctx, nil
+	}
+	if _, err := uuid.Parse(tenantID); err != nil {
+		return nil, errBadTenant
+	}
+	if !tenant.IsSuperAdmin(ctx, a) {
+		i.log.WarnContext(ctx, "grpcauth: foreign tenant", "subject", a.ID, "tenant_id", tenantID)
+		return nil, errForeignTenant
+	}
+	return tenant.With(ctx, tenantID), nil
+}
+
+// Authenticate verifies a bearer token and, for user tokens, checks that
+// the backing session is still active. The returned Actor has no
+// IpAddress / UserAgent — those are transport facts the caller fills
+// in. Exported for the hand-written HTTP endpoints that sit next to the
+// gateway and cannot go through Unary.
+func (i *Interceptor) Authenticate(ctx context.Context, token string) (actor.Actor, error) {
+	claims, err := i.verifier.Verify(token)
+	if err != nil {
+		return actor.Actor{}, fmt.Errorf("verify: %w", err)
+	}
+
+	var kind actor.Kind
+	switch claims.SubjectType {
+	case jwt.SubjectTypeUser:
+		kind = actor.KindUser
+	case jwt.SubjectTypeServiceAccount:
+		kind = actor.KindServiceAccount
+	default:
+		return actor.Actor{}, fmt.Errorf("unknown subject_type %q", claims.SubjectType)
+	}
+
+	if kind == actor.KindUser {
+		sess, err := i.sessions.GetByID(ctx, session.SessionID(claims.SessionID))
+		if err != nil {
+			return actor.Actor{}, fmt.Errorf("session %s: %w", claims.SessionID, err)
+		}
+		if !sess.IsActive(i.now().UTC()) {
+			return actor.Actor{}, fmt.Errorf("session %s: not active", claims.SessionID)
+		}
+	}
+
+	return actor.Actor{
+		ID:        claims.Subject,
+		Kind:      kind,
+		SessionID: claims.SessionID,
+		AppID:     claims.AppID,
+		TenantID:  claims.TenantID,
+	}, nil
 }
 
 func bearerFromCtx(ctx context.Context) (string, error) {
@@ -0,0 +1,99 @@
+package grpcauth
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// countingChecker answers super for every actor and counts the calls.
+type countingChecker struct {
+	super bool
+	calls int
+}
+
+func (c *countingChecker) IsSuperAdmin(context.Context, actor.Actor) (bool, error) {
+	c.calls++
+	return c.super, nil
+}
+
+func TestBind(t *testing.T) {
+	const other = "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d91"
+	systemUser := actor.Actor{ID: "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90", Kind: actor.KindUser}
+	tenantUser := actor.Actor{ID: "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d92", Kind: actor.KindUser, TenantID: other}
+
+	cases := []struct {
+		name      string
+		super     bool
+		act       actor.Actor
+		tenantID  string
+		wantCode  codes.Code
+		wantCalls int
+		wantScope string
+	}{
+		{"own tenant skips the check", true, systemUser, "", codes.OK, 0, ""},
+		{"own tenant named explicitly", true, systemUser, tenant.System, codes.OK, 0, ""},
+		{"super-admin in a foreign tenant", true, systemUser, other, codes.OK, 1, other},
+		{"foreign tenant refused", false, systemUser, other, codes.PermissionDenied, 1, ""},
+		{"malformed tenant", true, systemUser, "acme", codes.InvalidArgument, 0, ""},
+		{"tenant principal never checked", true, tenantUser, tenant.System, codes.PermissionDenied, 0, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &countingChecker{super: tc.super}
+			i := NewInterceptor(nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil)
+			i.SetSuperAdmins(c)
+
+			ctx, err := i.Bind(context.Background(), tc.act, tc.tenantID)
+			if got := status.Code(err); got != tc.wantCode {
+				t.Fatalf("code = %v, want %v (err %v)", got, tc.wantCode, err)
+			}
+			if c.calls != tc.wantCalls {
+				t.Fatalf("checks = %d, want %d", c.calls, tc.wantCalls)
+			}
+			if err != nil {
+				return
+			}
+			if a, ok := actor.From(ctx); !ok || a.ID != tc.act.ID {
+				t.Fatalf("actor = %+v, %v", a, ok)
+			}
+			if tc.wantScope != "" {
+				if got := tenant.Scope(ctx); got != tc.wantScope {
+					t.Fatalf("scope = %q, want %q", got, tc.wantScope)
+				}
+			}
+		})
+	}
+}
+
+// TestBindResolvesOnce checks the super-admin check runs on the first
+// unscoped read and is reused afterwards.
+func TestBindResolvesOnce(t *testing.T) {
+	c := &countingChecker{super: true}
+	i := NewInterceptor(nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil)
+	i.SetSuperAdmins(c)
+	a := actor.Actor{ID: "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90", Kind: actor.KindUser}
+
+	ctx, err := i.Bind(context.Background(), a, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.calls != 0 {
+		t.Fatalf("checked %d times before any read", c.calls)
+	}
+	for range 3 {
+		if got := tenant.Scope(ctx); got != "" {
+			t.Fatalf("super-admin scope = %q, want unscoped", got)
+		}
+	}
+	if c.calls != 1 {
+		t.Fatalf("checks = %d, want 1", c.calls)
+	}
+}
@@ -3,6 +3,7 @@ package grpcauth
 import (
 	"context"
 	"net"
+	"strings"
 
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -36,3 +37,62 @@ func UserAgentFromCtx(ctx context.Context) string {
 	}
 	return ""
 }
+
+// tenantHeader lets a super-admin work inside one tenant: reads are
+// filtered to it and creates land in it. Anyone else may only name
+// their own tenant.
+const tenantHeader = "x-tenant-id"
+
+// TenantFromCtx returns the tenant requested through the x-tenant-id
+// metadata, "" when absent. The value is not validated here; an
+// unknown tenant simply matches no rows.
+func TenantFromCtx(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(tenantHeader); len(v) > 0 {
+		return strings.TrimSpace(v[0])
+	}
+	return ""
+}
+
+// sodOverrideHeader asks that a role grant go through even though it
+// breaks a separation-of-duties rule. access honours it only for
+// callers holding the override permission.
+const sodOverrideHeader = "x-sod-override"
+
+// SoDOverrideFromCtx reports whether the x-sod-override metadata is
+// "true".
+func SoDOverrideFromCtx(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	v := md.Get(sodOverrideHeader)
+	return len(v) > 0 && strings.EqualFold(strings.TrimSpace(v[0]), "true")
+}
+
+// Condition attributes of a permission check: JSON objects the access
+// service evaluates permission conditions against, which the check
+// messages have no field for.
+const (
+	conditionResourceHeader = "x-condition-resource"
+	conditionRequestHeader  = "x-condition-request"
+)
+
+// ConditionContextFromCtx returns the raw x-condition-resource and
+// x-condition-request metadata, nil when absent.
+func ConditionContextFromCtx(ctx context.Context) (resource, request []byte) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	if v := md.Get(conditionResourceHeader); len(v) > 0 {
+		resource = []byte(v[0])
+	}
+	if v := md.Get(conditionRequestHeader); len(v) > 0 {
+		request = []byte(v[0])
+	}
+	return resource, request
+}
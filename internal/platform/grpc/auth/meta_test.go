@@ -0,0 +1,32 @@
+package grpcauth
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestSoDOverrideFromCtx(t *testing.T) {
+	cases := []struct {
+		name string
+		md   metadata.MD
+		want bool
+	}{
+		{"absent", nil, false},
+		{"true", metadata.Pairs("x-sod-override", "true"), true},
+		{"case and spaces", metadata.Pairs("x-sod-override", " TRUE "), true},
+		{"anything else", metadata.Pairs("x-sod-override", "1"), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.md)
+			}
+			if got := SoDOverrideFromCtx(ctx); got != tc.want {
+				t.Fatalf("SoDOverrideFromCtx = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
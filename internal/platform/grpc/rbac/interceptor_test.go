@@ -0,0 +1,112 @@
+package grpcrbac
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/audit"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubChecker grants the permissions in allowed; err, when set, is
+// returned for every check.
+type stubChecker struct {
+	allowed map[string]bool
+	err     error
+}
+
+func (c stubChecker) HasPermission(_ context.Context, _ actor.Actor, permission string) (bool, error) {
+	if c.err != nil {
+		return false, c.err
+	}
+	return c.allowed[permission], nil
+}
+
+type recordingEmitter struct {
+	mu     sync.Mutex
+	events []*audit.Audit
+}
+
+func (e *recordingEmitter) Emit(_ context.Context, a *audit.Audit) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, a)
+	return nil
+}
+
+func TestUnary(t *testing.T) {
+	const (
+		grant  = "/sso.access.v1.AccessService/GrantRoleToUser"
+		me     = "/sso.identity.v1.IdentityService/GetMe"
+		orphan = "/sso.identity.v1.IdentityService/Unmapped"
+	)
+	permissions := map[string]string{grant: "access:grant"}
+	exempt := []string{me}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	a := actor.Actor{ID: "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90", Kind: actor.KindUser}
+
+	cases := []struct {
+		name        string
+		checker     stubChecker
+		method      string
+		noActor     bool
+		wantCode    codes.Code
+		wantHandler bool
+		wantAudit   bool
+	}{
+		{"exempt self-service method", stubChecker{}, me, false, codes.OK, true, false},
+		{"mapped and held", stubChecker{allowed: map[string]bool{"access:grant": true}},
+			grant, false, codes.OK, true, false},
+		{"mapped and not held", stubChecker{allowed: map[string]bool{"access:read": true}},
+			grant, false, codes.PermissionDenied, false, true},
+		{"unmapped method", stubChecker{allowed: map[string]bool{"access:grant": true}},
+			orphan, false, codes.PermissionDenied, false, true},
+		{"no actor", stubChecker{allowed: map[string]bool{"access:grant": true}},
+			grant, true, codes.Unauthenticated, false, false},
+		{"check fails", stubChecker{err: errors.New("db down")},
+			grant, false, codes.Internal, false, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			em := &recordingEmitter{}
+			i := New(tc.checker, log, em, permissions, exempt)
+
+			ctx := context.Background()
+			if !tc.noActor {
+				ctx = actor.Inject(ctx, a)
+			}
+			called := false
+			handler := func(context.Context, any) (any, error) {
+				called = true
+				return "ok", nil
+			}
+			_, err := i.Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			if got := status.Code(err); got != tc.wantCode {
+				t.Fatalf("code = %v, want %v (err %v)", got, tc.wantCode, err)
+			}
+			if called != tc.wantHandler {
+				t.Fatalf("handler called = %v, want %v", called, tc.wantHandler)
+			}
+			if got := len(em.events) > 0; got != tc.wantAudit {
+				t.Fatalf("audited = %v, want %v", got, tc.wantAudit)
+			}
+			if tc.wantAudit {
+				ev := em.events[0]
+				if ev.EventType() != audit.EventTypeAuthzRPCDenied || ev.Metadata()["method"] != tc.method {
+					t.Fatalf("audit = %+v", ev)
+				}
+				if p := permissions[tc.method]; ev.Metadata()["permission"] != p {
+					t.Fatalf("audit permission = %q, want %q", ev.Metadata()["permission"], p)
+				}
+			}
+		})
+	}
+}
@@ -31,19 +31,22 @@ type Server struct {
 // internally so the wiring stays a single line.
 type Registrar func(*grpc.Server)
 
-// New builds the gRPC server with the standard interceptor chain. Both
-// `unaryAuth` and `unaryRateLimit` are optional (nil-tolerant for tests
-// / bootstraps that haven't wired them yet). Order matters:
+// New builds the gRPC server with the standard interceptor chain.
+// `unaryAuth`, `unaryRateLimit` and `unaryAuthz` are optional
+// (nil-tolerant for tests / bootstraps that haven't wired them yet).
+// Order matters:
 //
-//	requestID → logging → recovery → auth → ratelimit → validation
+//	requestID → logging → recovery → auth → ratelimit → authz → validation
 //
 // Auth precedes ratelimit so policies can key on the authenticated
-// subject. Ratelimit precedes validation so we don't burn CPU on
-// protobuf validation for a request we're about to reject anyway.
+// subject. Ratelimit precedes authz so a flood does not turn into
+// permission queries, and both precede validation so we don't burn CPU
+// on protobuf validation for a request we're about to reject anyway.
 func New(
 	cfg config.GRPCConfig, log *slog.Logger,
 	unaryAuth grpc.UnaryServerInterceptor,
 	unaryRateLimit grpc.UnaryServerInterceptor,
+	unaryAuthz grpc.UnaryServerInterceptor,
 	registrars ...Registrar,
 ) (*Server, error) {
 	unary := []grpc.UnaryServerInterceptor{
@@ -57,6 +60,9 @@ func New(
 	if unaryRateLimit != nil {
 		unary = append(unary, unaryRateLimit)
 	}
+	if unaryAuthz != nil {
+		unary = append(unary, unaryAuthz)
+	}
 	unary = append(unary, unaryValidation())
 
 	opts := []grpc.ServerOption{
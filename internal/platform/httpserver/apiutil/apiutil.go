@@ -0,0 +1,203 @@
+// Package apiutil is the plumbing shared by the hand-written HTTP
+// adapters (each module's internal/httpapi) mounted next to the
+// grpc-gateway: bearer-token authentication, error bodies in the
+// gateway's google.rpc.Status JSON shape, and JSON in and out. Keeping
+// it in one place keeps every hand-written route answering the way the
+// gateway does, so clients need a single error decoder, and puts every
+// authenticated route behind the same admin permission check as the
+// gRPC methods (see httprbac).
+package apiutil
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+
+	"sso/internal/kernel/actor"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// MaxBodyBytes bounds a JSON request body.
+const MaxBodyBytes = 64 << 10
+
+// TenantHeader names the tenant a super-admin works in, as the
+// x-tenant-id metadata does on gateway routes.
+const TenantHeader = "X-Tenant-Id"
+
+var (
+	ErrUnauthenticated = status.Error(codes.Unauthenticated, "unauthenticated")
+	ErrBadBody         = status.Error(codes.InvalidArgument, "malformed JSON body")
+)
+
+// Authenticator resolves a bearer token to an Actor and binds the
+// Actor to a request context in the tenant it asked for. Satisfied by
+// *grpcauth.Interceptor.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (actor.Actor, error)
+	Bind(ctx context.Context, a actor.Actor, tenantID string) (context.Context, error)
+}
+
+// Authorizer decides whether a may call the route r matched
+// (r.Pattern). It returns nil or the gRPC status to answer with.
+// Satisfied by *httprbac.Authorizer.
+type Authorizer interface {
+	Authorize(r *http.Request, a actor.Actor) error
+}
+
+// Adapter is one module's view of the plumbing: its authenticator and
+// authorizer, its logger and the error table its errors are rendered
+// through. Build it with New; safe for concurrent use.
+type Adapter struct {
+	name     string
+	authn    Authenticator
+	authz    Authorizer
+	log      *slog.Logger
+	toStatus func(error) error
+}
+
+// New builds an Adapter. name prefixes log lines ("access" logs as
+// "access http"); toStatus maps the module's errors that are not yet a
+// gRPC status, usually grpcerr.MapError over the module's errorMap.
+func New(name string, authn Authenticator, authz Authorizer, log *slog.Logger, toStatus func(error) error) *Adapter {
+	return &Adapter{name: name, authn: authn, authz: authz, log: log, toStatus: toStatus}
+}
+
+// Authenticate resolves the request's bearer token to an Actor carrying
+// the request's client address and user agent. ok is false when there
+// is no token or it does not verify; the reason is logged, not returned.
+func (a *Adapter) Authenticate(r *http.Request) (actor.Actor, bool) {
+	raw := r.Header.Get("Authorization")
+	token, ok := strings.CutPrefix(raw, "Bearer ")
+	if !ok || strings.TrimSpace(token) == "" {
+		return actor.Actor{}, false
+	}
+	act, err := a.authn.Authenticate(r.Context(), strings.TrimSpace(token))
+	if err != nil {
+		a.log.WarnContext(r.Context(), a.name+" http: authenticate", "path", r.URL.Path, "err", err)
+		return actor.Actor{}, false
+	}
+	act.IpAddress = ClientIP(r)
+	act.UserAgent = r.UserAgent()
+	return act, true
+}
+
+// Authed resolves the bearer token, binds the Actor to the request in
+// the tenant X-Tenant-Id names and asks the authorizer whether it may
+// call the route, mirroring what the grpcauth and grpcrbac interceptors
+// do for gateway routes.
+func (a *Adapter) Authed(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		act, ok := a.Authenticate(r)
+		if !ok {
+			a.WriteError(w, r, ErrUnauthenticated)
+			return
+		}
+		r, err := a.Bind(r, act)
+		if err == nil {
+			err = a.Authorize(r, act)
+		}
+		if err != nil {
+			a.WriteError(w, r, err)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// Bind returns r with act injected and, when X-Tenant-Id names another
+// tenant and act is a super-admin, working in that tenant. Anyone else
+// naming a foreign tenant gets PermissionDenied, a malformed id
+// InvalidArgument. Handlers that authenticate on their own terms
+// (browser redirects, SCIM's error shape) call it after Authenticate.
+func (a *Adapter) Bind(r *http.Request, act actor.Actor) (*http.Request, error) {
+	ctx, err := a.authn.Bind(r.Context(), act, strings.TrimSpace(r.Header.Get(TenantHeader)))
+	if err != nil {
+		return r, err
+	}
+	return r.WithContext(ctx), nil
+}
+
+// Authorize is the authorizer's verdict on act calling the route r
+// matched. Handlers that authenticate on their own terms call it after
+// Bind.
+func (a *Adapter) Authorize(r *http.Request, act actor.Actor) error {
+	return a.authz.Authorize(r, act)
+}
+
+// WriteError renders err as the gateway would: a gRPC status as is,
+// anything else through the module's error table first. Internal and
+// Unavailable are logged; the body never carries more than the status
+// message.
+func (a *Adapter) WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	if _, ok := status.FromError(err); !ok {
+		err = a.toStatus(err)
+	}
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.Internal, codes.Unavailable:
+		a.log.ErrorContext(r.Context(), a.name+" http", "path", r.URL.Path, "err", err)
+	}
+	body, mErr := protojson.Marshal(st.Proto())
+	if mErr != nil {
+		body = []byte(`{"code":13,"message":"internal error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	_, _ = w.Write(body)
+}
+
+// WriteJSON writes v as a JSON body. Responses are never cached: they
+// describe principals and grants.
+func WriteJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// DecodeJSON decodes a body of at most MaxBodyBytes into dst, refusing
+// unknown fields. Any failure is ErrBadBody.
+func DecodeJSON(r *http.Request, dst any) error {
+	return DecodeJSONLimit(r, dst, MaxBodyBytes)
+}
+
+// DecodeJSONLimit is DecodeJSON for endpoints taking bodies larger than
+// MaxBodyBytes (whole catalogs, for one).
+func DecodeJSONLimit(r *http.Request, dst any, limit int64) error {
+	dec := json.NewDecoder(http.MaxBytesReader(nil, r.Body, limit))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(dst); err != nil {
+		return ErrBadBody
+	}
+	return nil
+}
+
+// SplitList splits a comma-separated query parameter or update mask,
+// dropping empty items.
+func SplitList(s string) []string {
+	var out []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// ClientIP is the TCP peer address. X-Forwarded-For is not trusted here
+// for the same reason grpcauth.PeerIP ignores it: the value lands in
+// the audit log and must not be client-controlled.
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
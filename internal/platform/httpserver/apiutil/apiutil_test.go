@@ -0,0 +1,204 @@
+package apiutil
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/kernel/tenant"
+	grpcauth "sso/internal/platform/grpc/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubAuthn accepts token as act and binds through a real grpcauth
+// interceptor, so the tenant header is handled as on gateway routes.
+type stubAuthn struct {
+	*grpcauth.Interceptor
+	token string
+	act   actor.Actor
+}
+
+// superAdmins makes the actors with the listed ids super-admins.
+type superAdmins []string
+
+func (s superAdmins) IsSuperAdmin(_ context.Context, a actor.Actor) (bool, error) {
+	for _, id := range s {
+		if a.ID == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newAuthn(token string, act actor.Actor, super ...string) stubAuthn {
+	i := grpcauth.NewInterceptor(nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil)
+	i.SetSuperAdmins(superAdmins(super))
+	return stubAuthn{Interceptor: i, token: token, act: act}
+}
+
+func (s stubAuthn) Authenticate(_ context.Context, token string) (actor.Actor, error) {
+	if token != s.token {
+		return actor.Actor{}, errors.New("bad token")
+	}
+	return s.act, nil
+}
+
+// stubAuthz admits every actor but "u2".
+type stubAuthz struct{}
+
+func (stubAuthz) Authorize(_ *http.Request, a actor.Actor) error {
+	if a.ID == "u2" {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+var errGone = errors.New("gone")
+
+func newAdapter() *Adapter {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	toStatus := func(err error) error {
+		if errors.Is(err, errGone) {
+			return status.Error(codes.NotFound, "gone")
+		}
+		return status.Error(codes.Internal, "internal error")
+	}
+	return New("test", newAuthn("good", actor.Actor{ID: "u1"}), stubAuthz{}, log, toStatus)
+}
+
+func TestAuthed(t *testing.T) {
+	api := newAdapter()
+	var got actor.Actor
+	h := api.Authed(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = actor.From(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	cases := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{"no header", "", http.StatusUnauthorized},
+		{"not bearer", "Basic Zm9v", http.StatusUnauthorized},
+		{"bad token", "Bearer bad", http.StatusUnauthorized},
+		{"good token", "Bearer good", http.StatusNoContent},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/x", nil)
+			req.RemoteAddr = "192.0.2.7:4321"
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			rec := httptest.NewRecorder()
+			h(rec, req)
+			if rec.Code != tc.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tc.want)
+			}
+		})
+	}
+	if got.ID != "u1" || got.IpAddress != "192.0.2.7" {
+		t.Fatalf("injected actor = %+v", got)
+	}
+}
+
+func TestAuthedRefusesUnauthorized(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	api := New("test", newAuthn("good", actor.Actor{ID: "u2"}), stubAuthz{}, log, func(err error) error { return err })
+	called := false
+	h := api.Authed(func(w http.ResponseWriter, r *http.Request) { called = true })
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/x", nil)
+	req.Header.Set("Authorization", "Bearer good")
+	rec := httptest.NewRecorder()
+	h(rec, req)
+	if rec.Code != http.StatusForbidden || called {
+		t.Fatalf("status = %d, handler called = %v; want 403, false", rec.Code, called)
+	}
+}
+
+func TestAuthedTenantHeader(t *testing.T) {
+	const other = "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d91"
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	cases := []struct {
+		name      string
+		super     bool
+		tenantID  string
+		want      int
+		wantScope string
+	}{
+		{"own tenant", false, "", http.StatusNoContent, tenant.System},
+		{"super-admin in a foreign tenant", true, other, http.StatusNoContent, other},
+		{"foreign tenant refused", false, other, http.StatusForbidden, ""},
+		{"malformed tenant", true, "acme", http.StatusBadRequest, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var super []string
+			if tc.super {
+				super = []string{"u1"}
+			}
+			authn := newAuthn("good", actor.Actor{ID: "u1", Kind: actor.KindUser}, super...)
+			api := New("test", authn, stubAuthz{}, log, func(err error) error { return err })
+			var scope string
+			h := api.Authed(func(w http.ResponseWriter, r *http.Request) {
+				scope = tenant.Scope(r.Context())
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/x", nil)
+			req.Header.Set("Authorization", "Bearer good")
+			if tc.tenantID != "" {
+				req.Header.Set(TenantHeader, tc.tenantID)
+			}
+			rec := httptest.NewRecorder()
+			h(rec, req)
+			if rec.Code != tc.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tc.want)
+			}
+			if scope != tc.wantScope {
+				t.Fatalf("scope = %q, want %q", scope, tc.wantScope)
+			}
+		})
+	}
+}
+
+func TestWriteErrorMapsModuleErrors(t *testing.T) {
+	api := newAdapter()
+	rec := httptest.NewRecorder()
+	api.WriteError(rec, httptest.NewRequest(http.MethodGet, "/v1/x", nil), errGone)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404", rec.Code)
+	}
+	if body := rec.Body.String(); !strings.Contains(body, `"message":"gone"`) {
+		t.Fatalf("body = %s", body)
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	var dst struct {
+		Name string `json:"name"`
+	}
+	ok := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"a"}`))
+	if err := DecodeJSON(ok, &dst); err != nil || dst.Name != "a" {
+		t.Fatalf("DecodeJSON = %v, %+v", err, dst)
+	}
+	unknown := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"other":1}`))
+	if err := DecodeJSON(unknown, &dst); !errors.Is(err, ErrBadBody) {
+		t.Fatalf("unknown field: err = %v, want ErrBadBody", err)
+	}
+	big := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"`+strings.Repeat("a", 100)+`"}`))
+	if err := DecodeJSONLimit(big, &dst, 16); !errors.Is(err, ErrBadBody) {
+		t.Fatalf("oversized body: err = %v, want ErrBadBody", err)
+	}
+}
@@ -0,0 +1,294 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"sso/internal/platform/config"
+	"sso/internal/platform/httpserver/sessioncookie"
+
+	ssoauthv1 "github.com/Nergous/sso_protos/gen/go/sso/auth/v1"
+)
+
+const (
+	refreshCookieName = sessioncookie.RefreshName
+	accessCookieName  = sessioncookie.AccessName
+	csrfCookieName    = sessioncookie.CSRFName
+	csrfHeader        = sessioncookie.CSRFHeader
+)
+
+var (
+	errCookieMissingRefresh = status.Error(codes.Unauthenticated, "missing refresh cookie")
+	errCookieCSRF           = status.Error(codes.PermissionDenied, "csrf token mismatch")
+)
+
+// cookieSession implements the browser session mode (http.cookies.*).
+// It owns three hand-written endpoints under cfg.Path — Login and
+// Refresh are not part of the grpc-gateway surface, and even if they
+// were, the gateway can only echo tokens in the JSON body — plus the
+// middleware that turns the access cookie back into a bearer header and
+// enforces the double-submit CSRF check.
+type cookieSession struct {
+	cfg  config.CookieConfig
+	jar  *sessioncookie.Jar
+	auth ssoauthv1.AuthServiceClient
+	mux  *runtime.ServeMux
+	log  *slog.Logger
+}
+
+func newCookieSession(cfg config.CookieConfig, conn *grpc.ClientConn, mux *runtime.ServeMux, log *slog.Logger) *cookieSession {
+	return &cookieSession{
+		cfg:  cfg,
+		jar:  sessioncookie.New(cfg),
+		auth: ssoauthv1.NewAuthServiceClient(conn),
+		mux:  mux,
+		log:  log,
+	}
+}
+
+// register mounts POST {path}/login, {path}/refresh and {path}/logout.
+// The refresh cookie is scoped to cfg.Path, so the browser only ever
+// sends it to these three endpoints.
+func (c *cookieSession) register(root *http.ServeMux) {
+	root.Handle("POST "+c.cfg.Path+"/login", http.HandlerFunc(c.login))
+	root.Handle("POST "+c.cfg.Path+"/refresh", http.HandlerFunc(c.refresh))
+	root.Handle("POST "+c.cfg.Path+"/logout", http.HandlerFunc(c.logout))
+}
+
+// middleware translates the access cookie into `Authorization: Bearer`
+// for the gateway (which forwards it as `authorization` metadata) and
+// enforces CSRF on state-changing requests that ride on cookies.
+//
+// A request that brings its own Authorization header is a non-browser
+// client: cookies are ignored and no CSRF check applies, because an
+// attacker page cannot set that header cross-origin. Login is exempt
+// since there is no session to forge yet; refresh and logout always
+// require the token because they act on the refresh cookie.
+func (c *cookieSession) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		usesCookies := false
+		if r.Header.Get("Authorization") == "" {
+			if ck, err := r.Cookie(accessCookieName); err == nil && ck.Value != "" {
+				r.Header.Set("Authorization", "Bearer "+ck.Value)
+				usesCookies = true
+			}
+		}
+		switch r.URL.Path {
+		case c.cfg.Path + "/refresh", c.cfg.Path + "/logout":
+			usesCookies = true
+		case c.cfg.Path + "/login":
+			usesCookies = false
+		}
+
+		if usesCookies && isStateChanging(r.Method) && !validCSRF(r) {
+			c.log.WarnContext(r.Context(), "httpserver: csrf check failed",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.String("request_id", RequestIDFromContext(r.Context())),
+			)
+			c.writeError(w, r, errCookieCSRF)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func isStateChanging(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// validCSRF implements the double-submit check: the header the SPA
+// copies out of the (script-readable) CSRF cookie must match the cookie
+// the browser attached. A cross-site page can make the browser send the
+// cookie but cannot read it to forge the header. A form post, which
+// cannot set headers, carries the token in the csrf_token field
+// instead.
+func validCSRF(r *http.Request) bool {
+	ck, err := r.Cookie(csrfCookieName)
+	if err != nil || ck.Value == "" {
+		return false
+	}
+	token := r.Header.Get(csrfHeader)
+	if token == "" && isFormPost(r) {
+		token = r.PostFormValue(sessioncookie.CSRFField)
+	}
+	if token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(ck.Value), []byte(token)) == 1
+}
+
+func isFormPost(r *http.Request) bool {
+	ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return r.Method == http.MethodPost && ct == "application/x-www-form-urlencoded"
+}
+
+// login proxies AuthService.Login. The request body is the regular
+// LoginRequest JSON; the response is the regular LoginResponse with
+// refresh_token blanked — the refresh token only ever travels in the
+// HttpOnly cookie.
+func (c *cookieSession) login(w http.ResponseWriter, r *http.Request) {
+	inbound, outbound := runtime.MarshalerForRequest(c.mux, r)
+	ctx, err := runtime.AnnotateContext(r.Context(), c.mux, r, "/sso.auth.v1.AuthService/Login")
+	if err != nil {
+		c.writeError(w, r, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+
+	var req ssoauthv1.LoginRequest
+	if err := inbound.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		c.writeError(w, r, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+
+	var md runtime.ServerMetadata
+	resp, err := c.auth.Login(ctx, &req, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+	if err != nil {
+		c.writeError(w, r, err)
+		return
+	}
+
+	c.setSessionCookies(w, resp.GetTokens())
+	resp.GetTokens().RefreshToken = ""
+	runtime.ForwardResponseMessage(runtime.NewServerMetadataContext(ctx, md), c.mux, outbound, w, r, resp)
+}
+
+// refresh proxies AuthService.Refresh with the refresh token taken from
+// the cookie. On UNAUTHENTICATED (reused, expired or revoked chain) the
+// cookies are cleared so the SPA falls back to the login screen.
+func (c *cookieSession) refresh(w http.ResponseWriter, r *http.Request) {
+	_, outbound := runtime.MarshalerForRequest(c.mux, r)
+	ctx, err := runtime.AnnotateContext(r.Context(), c.mux, r, "/sso.auth.v1.AuthService/Refresh")
+	if err != nil {
+		c.writeError(w, r, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+
+	ck, err := r.Cookie(refreshCookieName)
+	if err != nil || ck.Value == "" {
+		c.clearSessionCookies(w)
+		c.writeError(w, r, errCookieMissingRefresh)
+		return
+	}
+
+	var md runtime.ServerMetadata
+	resp, err := c.auth.Refresh(ctx, &ssoauthv1.RefreshRequest{RefreshToken: ck.Value},
+		grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			c.clearSessionCookies(w)
+		}
+		c.writeError(w, r, err)
+		return
+	}
+
+	c.setSessionCookies(w, resp)
+	resp.RefreshToken = ""
+	runtime.ForwardResponseMessage(runtime.NewServerMetadataContext(ctx, md), c.mux, outbound, w, r, resp)
+}
+
+// logout revokes the session behind the cookies and clears every
+// session cookie. AuthService.Logout reads the session from the bearer
+// the middleware injected; when the access cookie is gone or has
+// expired, the refresh cookie is traded for a fresh access token first,
+// so a session outliving its access token is still revoked. Cookies are
+// cleared even when the backend calls fail: the browser should never
+// keep credentials the user asked to drop.
+func (c *cookieSession) logout(w http.ResponseWriter, r *http.Request) {
+	ctx, err := runtime.AnnotateContext(r.Context(), c.mux, r, "/sso.auth.v1.AuthService/Logout")
+	if err != nil {
+		c.writeError(w, r, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+
+	c.clearSessionCookies(w)
+
+	revoked := false
+	if r.Header.Get("Authorization") != "" {
+		_, err := c.auth.Logout(ctx, &emptypb.Empty{})
+		switch {
+		case err == nil:
+			revoked = true
+		case status.Code(err) != codes.Unauthenticated:
+			c.writeError(w, r, err)
+			return
+		}
+	}
+	if !revoked {
+		if err := c.logoutWithRefresh(ctx, r); err != nil {
+			c.writeError(w, r, err)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// logoutWithRefresh revokes the session behind the refresh cookie by
+// refreshing it and logging out with the resulting access token. A
+// missing, expired or already revoked refresh token leaves nothing to
+// revoke and is not an error.
+func (c *cookieSession) logoutWithRefresh(ctx context.Context, r *http.Request) error {
+	ck, err := r.Cookie(refreshCookieName)
+	if err != nil || ck.Value == "" {
+		return nil
+	}
+	tokens, err := c.auth.Refresh(ctx, &ssoauthv1.RefreshRequest{RefreshToken: ck.Value})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return nil
+		}
+		return err
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set("authorization", "Bearer "+tokens.GetAccessToken())
+	if _, err := c.auth.Logout(metadata.NewOutgoingContext(ctx, md), &emptypb.Empty{}); err != nil &&
+		status.Code(err) != codes.Unauthenticated {
+		return err
+	}
+	return nil
+}
+
+// setSessionCookies writes the session cookies for a fresh token pair,
+// with a freshly minted CSRF token (see sessioncookie.Jar.Set).
+func (c *cookieSession) setSessionCookies(w http.ResponseWriter, t *ssoauthv1.AuthTokens) {
+	c.jar.Set(w, sessioncookie.Tokens{
+		AccessToken:      t.GetAccessToken(),
+		AccessExpiresAt:  t.GetAccessTokenExpiresAt().AsTime(),
+		RefreshToken:     t.GetRefreshToken(),
+		RefreshExpiresAt: t.GetRefreshTokenExpiresAt().AsTime(),
+	})
+}
+
+func (c *cookieSession) clearSessionCookies(w http.ResponseWriter) {
+	c.jar.Clear(w)
+}
+
+func (c *cookieSession) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	_, outbound := runtime.MarshalerForRequest(c.mux, r)
+	runtime.HTTPError(r.Context(), c.mux, outbound, w, r, err)
+}
+
+// withCookieSession is a no-op passthrough when cookie mode is disabled,
+// keeping the middleware chain in New free of conditionals.
+func withCookieSession(c *cookieSession) func(http.Handler) http.Handler {
+	if c == nil {
+		return func(next http.Handler) http.Handler { return next }
+	}
+	return c.middleware
+}
@@ -0,0 +1,243 @@
+package httpserver
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"sso/internal/platform/config"
+	"sso/internal/platform/httpserver/sessioncookie"
+
+	ssoauthv1 "github.com/Nergous/sso_protos/gen/go/sso/auth/v1"
+)
+
+// fakeAuth serves Login, Refresh and Logout; the other methods panic
+// through the nil embedded interface. Logout succeeds only for the
+// bearer in validAccess.
+type fakeAuth struct {
+	ssoauthv1.AuthServiceClient
+	now         time.Time
+	validAccess string
+	refreshed   []string
+	loggedOut   []string
+}
+
+func (f *fakeAuth) tokens(access, refresh string) *ssoauthv1.AuthTokens {
+	return &ssoauthv1.AuthTokens{
+		AccessToken:           access,
+		AccessTokenExpiresAt:  timestamppb.New(f.now.Add(15 * time.Minute)),
+		RefreshToken:          refresh,
+		RefreshTokenExpiresAt: timestamppb.New(f.now.Add(24 * time.Hour)),
+	}
+}
+
+func (f *fakeAuth) Login(context.Context, *ssoauthv1.LoginRequest, ...grpc.CallOption) (*ssoauthv1.LoginResponse, error) {
+	return &ssoauthv1.LoginResponse{Tokens: f.tokens("access-1", "refresh-1")}, nil
+}
+
+func (f *fakeAuth) Refresh(_ context.Context, in *ssoauthv1.RefreshRequest, _ ...grpc.CallOption) (*ssoauthv1.AuthTokens, error) {
+	f.refreshed = append(f.refreshed, in.GetRefreshToken())
+	if in.GetRefreshToken() != "refresh-1" {
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	f.validAccess = "access-2"
+	return f.tokens("access-2", "refresh-2"), nil
+}
+
+func (f *fakeAuth) Logout(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	got := strings.TrimPrefix(strings.Join(md.Get("authorization"), ""), "Bearer ")
+	if got == "" || got != f.validAccess {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	f.loggedOut = append(f.loggedOut, got)
+	return &emptypb.Empty{}, nil
+}
+
+func newTestCookieSession(auth *fakeAuth) (*cookieSession, http.Handler) {
+	cfg := config.CookieConfig{Path: "/v1/auth/cookie", SameSite: "strict"}
+	c := &cookieSession{
+		cfg:  cfg,
+		jar:  sessioncookie.New(cfg),
+		auth: auth,
+		mux:  runtime.NewServeMux(),
+		log:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	root := http.NewServeMux()
+	c.register(root)
+	root.HandleFunc("POST /v1/form", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) })
+	return c, c.middleware(root)
+}
+
+func responseCookie(rec *httptest.ResponseRecorder, name string) *http.Cookie {
+	for _, ck := range rec.Result().Cookies() {
+		if ck.Name == name {
+			return ck
+		}
+	}
+	return nil
+}
+
+func TestCookieLoginMintsFreshCSRF(t *testing.T) {
+	_, h := newTestCookieSession(&fakeAuth{now: time.Now()})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/auth/cookie/login", strings.NewReader(`{}`))
+	req.AddCookie(&http.Cookie{Name: csrfCookieName, Value: "planted"})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+	csrf := responseCookie(rec, csrfCookieName)
+	if csrf == nil || csrf.Value == "" || csrf.Value == "planted" {
+		t.Fatalf("csrf cookie = %+v, want a fresh token", csrf)
+	}
+	if csrf.HttpOnly {
+		t.Fatal("csrf cookie is HttpOnly; the SPA must read it")
+	}
+	refresh := responseCookie(rec, refreshCookieName)
+	if refresh == nil || refresh.Value != "refresh-1" || !refresh.HttpOnly || refresh.Path != "/v1/auth/cookie" {
+		t.Fatalf("refresh cookie = %+v", refresh)
+	}
+	if strings.Contains(rec.Body.String(), "refresh-1") {
+		t.Fatalf("body leaks the refresh token: %s", rec.Body)
+	}
+	nav := responseCookie(rec, sessioncookie.NavigationName)
+	if nav == nil || nav.Value != "access-1" || nav.SameSite != http.SameSiteLaxMode ||
+		nav.Path != sessioncookie.NavigationPath || !nav.HttpOnly {
+		t.Fatalf("navigation cookie = %+v", nav)
+	}
+}
+
+func TestCookieRefreshRotatesCSRF(t *testing.T) {
+	_, h := newTestCookieSession(&fakeAuth{now: time.Now()})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/auth/cookie/refresh", nil)
+	req.AddCookie(&http.Cookie{Name: refreshCookieName, Value: "refresh-1"})
+	req.AddCookie(&http.Cookie{Name: csrfCookieName, Value: "old"})
+	req.Header.Set(csrfHeader, "old")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+	if csrf := responseCookie(rec, csrfCookieName); csrf == nil || csrf.Value == "old" || csrf.Value == "" {
+		t.Fatalf("csrf cookie = %+v, want a fresh token", csrf)
+	}
+}
+
+func TestCookieCSRFRequired(t *testing.T) {
+	_, h := newTestCookieSession(&fakeAuth{now: time.Now()})
+
+	cases := []struct {
+		name   string
+		header string
+	}{
+		{"missing header", ""},
+		{"mismatched header", "other"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v1/auth/cookie/refresh", nil)
+			req.AddCookie(&http.Cookie{Name: refreshCookieName, Value: "refresh-1"})
+			req.AddCookie(&http.Cookie{Name: csrfCookieName, Value: "token"})
+			if tc.header != "" {
+				req.Header.Set(csrfHeader, tc.header)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != http.StatusForbidden {
+				t.Fatalf("status = %d, want 403", rec.Code)
+			}
+		})
+	}
+}
+
+func TestCookieLogoutRevokesWithExpiredAccess(t *testing.T) {
+	cases := []struct {
+		name          string
+		access        string
+		wantRefreshed bool
+	}{
+		{"access cookie expired", "", true},
+		{"access token rejected", "stale", true},
+		{"access token valid", "access-1", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			auth := &fakeAuth{now: time.Now(), validAccess: "access-1"}
+			_, h := newTestCookieSession(auth)
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/auth/cookie/logout", nil)
+			if tc.access != "" {
+				req.AddCookie(&http.Cookie{Name: accessCookieName, Value: tc.access})
+			}
+			req.AddCookie(&http.Cookie{Name: refreshCookieName, Value: "refresh-1"})
+			req.AddCookie(&http.Cookie{Name: csrfCookieName, Value: "token"})
+			req.Header.Set(csrfHeader, "token")
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusNoContent {
+				t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+			}
+			if len(auth.loggedOut) != 1 {
+				t.Fatalf("logged out %d sessions, want 1", len(auth.loggedOut))
+			}
+			if got := len(auth.refreshed) > 0; got != tc.wantRefreshed {
+				t.Fatalf("refreshed = %v, want %v", got, tc.wantRefreshed)
+			}
+			for _, name := range []string{accessCookieName, refreshCookieName, csrfCookieName} {
+				if ck := responseCookie(rec, name); ck == nil || ck.MaxAge >= 0 {
+					t.Fatalf("cookie %s not cleared: %+v", name, ck)
+				}
+			}
+		})
+	}
+}
+
+func TestCookieCSRFFormField(t *testing.T) {
+	_, h := newTestCookieSession(&fakeAuth{now: time.Now()})
+
+	cases := []struct {
+		name  string
+		field string
+		want  int
+	}{
+		{"matching field", "token", http.StatusNoContent},
+		{"mismatched field", "other", http.StatusForbidden},
+		{"missing field", "", http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			body := "RelayState=x"
+			if tc.field != "" {
+				body += "&csrf_token=" + tc.field
+			}
+			req := httptest.NewRequest(http.MethodPost, "/v1/form", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.AddCookie(&http.Cookie{Name: accessCookieName, Value: "access-1"})
+			req.AddCookie(&http.Cookie{Name: csrfCookieName, Value: "token"})
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tc.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tc.want)
+			}
+		})
+	}
+}
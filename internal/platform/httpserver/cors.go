@@ -5,9 +5,12 @@ import (
 	"strings"
 )
 
+// corsAllowHeaders lists, besides the basics, every request header the
+// server honours (see middleware.go), so browser clients can send them.
 const (
 	corsAllowMethods = "GET, POST, PUT, PATCH, DELETE, OPTIONS"
-	corsAllowHeaders = "Authorization, Content-Type, X-Request-Id, Grpc-Metadata-*"
+	corsAllowHeaders = "Authorization, Content-Type, X-Request-Id, X-Csrf-Token, " +
+		"X-Tenant-Id, X-Sod-Override, X-Condition-Resource, X-Condition-Request, Grpc-Metadata-*"
 	corsExposeHeader = "X-Request-Id"
 	corsMaxAge       = "600"
 )
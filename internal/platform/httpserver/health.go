@@ -2,6 +2,7 @@ package httpserver
 
 import (
 	"context"
+	"io"
 	"log/slog"
 	"net/http"
 	"time"
@@ -9,6 +10,10 @@ import (
 
 type ReadinessFunc func(ctx context.Context) error
 
+// MetricsFunc writes a module's metrics to /metrics in the Prometheus
+// text exposition format.
+type MetricsFunc func(w io.Writer)
+
 const readinessTimeout = 2 * time.Second
 
 func healthzHandler() http.Handler {
@@ -47,10 +52,18 @@ func readyzHandler(log *slog.Logger, readiness ReadinessFunc) http.Handler {
 	})
 }
 
-func metricsStubHandler() http.Handler {
+// metricsHandler serves what the registered MetricsFuncs write, or the
+// stub line while none are.
+func metricsHandler(metrics []MetricsFunc) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
 		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte("# metrics not yet wired (see TODO.md §5.3)\n"))
+		if len(metrics) == 0 {
+			_, _ = w.Write([]byte("# metrics not yet wired (see TODO.md §5.3)\n"))
+			return
+		}
+		for _, write := range metrics {
+			write(w)
+		}
 	})
 }
@@ -12,6 +12,22 @@ import (
 
 const requestIDHeader = "X-Request-Id"
 
+// tenantHeader is forwarded to the gRPC backend, where grpcauth honours
+// it for super-admins.
+const tenantHeader = "X-Tenant-Id"
+
+// sodOverrideHeader is forwarded to the gRPC backend, where access
+// honours it on role grants for callers holding the override
+// permission.
+const sodOverrideHeader = "X-Sod-Override"
+
+// The condition headers are forwarded to the gRPC backend, where access
+// evaluates permission conditions of CheckPermission against them.
+const (
+	conditionResourceHeader = "X-Condition-Resource"
+	conditionRequestHeader  = "X-Condition-Request"
+)
+
 type ctxKey struct{}
 
 var requestIDKey ctxKey
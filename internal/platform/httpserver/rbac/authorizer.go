@@ -0,0 +1,117 @@
+// Package httprbac enforces a route → permission table on the
+// hand-written HTTP endpoints, as grpcrbac does for gRPC methods. The
+// routes are those the modules mount next to the gateway
+// (httpserver.Deps.Routes); the gateway's own routes reach the gRPC
+// server and are checked there.
+//
+// apiutil.Adapter calls Authorize once the bearer token has been
+// resolved, with the ServeMux pattern the request matched
+// ("POST /v1/users/{user_id}/role-grants"), which is what the table is
+// keyed by. A route must either be mapped to a permission or be listed
+// as exempt (public, self-service and workflow routes whose service
+// checks the caller itself); anything else is refused, so a new route
+// stays closed until someone decides who may call it.
+//
+// Denials come back as PERMISSION_DENIED and are recorded as
+// authz.route_denied audit events naming the route and permission.
+package httprbac
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/audit"
+	"sso/internal/modules/audit/auditx"
+	grpcerr "sso/internal/platform/grpc/errors"
+
+	ssocommonv1 "github.com/Nergous/sso_protos/gen/go/sso/common/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errCheckFailed      = status.Error(codes.Internal, "internal error")
+	errPermissionDenied = grpcerr.StatusWithReason(codes.PermissionDenied,
+		ssocommonv1.ErrorReason_ERROR_REASON_PERMISSION_DENIED, "permission denied")
+)
+
+// Checker decides whether a holds permission. Satisfied by
+// *authz.AccessBackedAuthorizer, as grpcrbac.Checker is.
+type Checker interface {
+	HasPermission(ctx context.Context, a actor.Actor, permission string) (bool, error)
+}
+
+// Authorizer enforces the route → permission table. Build it with New;
+// it satisfies apiutil.Authorizer. Safe for concurrent use; its tables
+// are not modified after construction.
+type Authorizer struct {
+	checker     Checker
+	log         *slog.Logger
+	auditor     auditx.Auditor
+	permissions map[string]string
+	exempt      map[string]struct{}
+}
+
+// New builds an Authorizer. permissions maps a route pattern, exactly
+// as the module registers it, to the permission its caller must hold;
+// exempt lists the routes any authenticated caller may reach.
+func New(
+	c Checker,
+	log *slog.Logger,
+	emitter audit.Emitter,
+	permissions map[string]string,
+	exempt []string,
+) *Authorizer {
+	set := make(map[string]struct{}, len(exempt))
+	for _, p := range exempt {
+		set[p] = struct{}{}
+	}
+	return &Authorizer{
+		checker:     c,
+		log:         log,
+		auditor:     auditx.New(log, emitter),
+		permissions: permissions,
+		exempt:      set,
+	}
+}
+
+// Authorize returns nil when a may call the route r matched, and a
+// PERMISSION_DENIED (or, when the check itself fails, INTERNAL) status
+// otherwise.
+func (z *Authorizer) Authorize(r *http.Request, a actor.Actor) error {
+	ctx := r.Context()
+	route := r.Pattern
+	if _, ok := z.exempt[route]; ok {
+		return nil
+	}
+
+	permission, ok := z.permissions[route]
+	if !ok {
+		z.log.ErrorContext(ctx, "httprbac: route has no permission", "route", route)
+		z.deny(ctx, a, route, "")
+		return errPermissionDenied
+	}
+
+	allowed, err := z.checker.HasPermission(ctx, a, permission)
+	if err != nil {
+		z.log.ErrorContext(ctx, "httprbac: check permission",
+			"route", route, "permission", permission, "err", err)
+		return errCheckFailed
+	}
+	if !allowed {
+		z.deny(ctx, a, route, permission)
+		return errPermissionDenied
+	}
+	return nil
+}
+
+func (z *Authorizer) deny(ctx context.Context, a actor.Actor, route, permission string) {
+	aud := audit.BaseFromActor(a, audit.EventTypeAuthzRouteDenied)
+	aud.Metadata = map[string]string{"route": route}
+	if permission != "" {
+		aud.Metadata["permission"] = permission
+	}
+	z.auditor.Deny(ctx, aud, audit.ReasonPermissionDenied)
+}
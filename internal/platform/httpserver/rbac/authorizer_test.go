@@ -0,0 +1,109 @@
+package httprbac
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"sso/internal/kernel/actor"
+	"sso/internal/modules/audit"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubChecker grants the permissions in allowed; err, when set, is
+// returned for every check.
+type stubChecker struct {
+	allowed map[string]bool
+	err     error
+}
+
+func (c stubChecker) HasPermission(_ context.Context, _ actor.Actor, permission string) (bool, error) {
+	if c.err != nil {
+		return false, c.err
+	}
+	return c.allowed[permission], nil
+}
+
+type recordingEmitter struct {
+	mu     sync.Mutex
+	events []*audit.Audit
+}
+
+func (e *recordingEmitter) Emit(_ context.Context, a *audit.Audit) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, a)
+	return nil
+}
+
+// request returns a request that the mux matched against pattern, so
+// r.Pattern is set as it is in production.
+func request(t *testing.T, pattern, method, path string) *http.Request {
+	t.Helper()
+	var matched *http.Request
+	mux := http.NewServeMux()
+	mux.HandleFunc(pattern, func(_ http.ResponseWriter, r *http.Request) { matched = r })
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(method, path, nil))
+	if matched == nil {
+		t.Fatalf("%s %s did not match %q", method, path, pattern)
+	}
+	return matched
+}
+
+func TestAuthorize(t *testing.T) {
+	const (
+		grant  = "POST /v1/users/{user_id}/role-grants"
+		me     = "GET /v1/me"
+		orphan = "GET /v1/unmapped"
+	)
+	permissions := map[string]string{grant: "access:grant"}
+	exempt := []string{me}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	a := actor.Actor{ID: "0190b6f2-8a43-7c1e-9d2a-3f5e6b7c8d90", Kind: actor.KindUser}
+
+	cases := []struct {
+		name      string
+		checker   stubChecker
+		pattern   string
+		method    string
+		path      string
+		wantCode  codes.Code
+		wantAudit bool
+	}{
+		{"exempt route", stubChecker{}, me, http.MethodGet, "/v1/me", codes.OK, false},
+		{"mapped and held", stubChecker{allowed: map[string]bool{"access:grant": true}},
+			grant, http.MethodPost, "/v1/users/u9/role-grants", codes.OK, false},
+		{"mapped and not held", stubChecker{allowed: map[string]bool{"access:read": true}},
+			grant, http.MethodPost, "/v1/users/u9/role-grants", codes.PermissionDenied, true},
+		{"unmapped route", stubChecker{allowed: map[string]bool{"access:grant": true}},
+			orphan, http.MethodGet, "/v1/unmapped", codes.PermissionDenied, true},
+		{"check fails", stubChecker{err: errors.New("db down")},
+			grant, http.MethodPost, "/v1/users/u9/role-grants", codes.Internal, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			em := &recordingEmitter{}
+			z := New(tc.checker, log, em, permissions, exempt)
+			err := z.Authorize(request(t, tc.pattern, tc.method, tc.path), a)
+			if got := status.Code(err); got != tc.wantCode {
+				t.Fatalf("code = %v, want %v (err %v)", got, tc.wantCode, err)
+			}
+			if got := len(em.events) > 0; got != tc.wantAudit {
+				t.Fatalf("audited = %v, want %v", got, tc.wantAudit)
+			}
+			if tc.wantAudit {
+				ev := em.events[0]
+				if ev.EventType() != audit.EventTypeAuthzRouteDenied || ev.Metadata()["route"] != tc.pattern {
+					t.Fatalf("audit = %+v", ev)
+				}
+			}
+		})
+	}
+}
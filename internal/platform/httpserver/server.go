@@ -22,6 +22,14 @@ type Deps struct {
 	Log        *slog.Logger
 	GRPCTarget string
 	Readiness  ReadinessFunc
+
+	// Routes mounts hand-written endpoints that cannot go through the
+	// gateway (browser redirect flows, for one) on the root mux. They
+	// sit behind the same middleware chain as the gateway routes.
+	Routes []func(*http.ServeMux)
+
+	// Metrics are written, in order, to /metrics.
+	Metrics []MetricsFunc
 }
 
 type Server struct {
@@ -55,10 +63,23 @@ func New(ctx context.Context, deps Deps) (*Server, error) {
 	root := http.NewServeMux()
 	root.Handle("/healthz", healthzHandler())
 	root.Handle("/readyz", readyzHandler(deps.Log, deps.Readiness))
-	root.Handle("/metrics", metricsStubHandler())
+	root.Handle("/metrics", metricsHandler(deps.Metrics))
 	root.Handle("/", mux)
+	for _, register := range deps.Routes {
+		register(root)
+	}
+
+	// Cookie mode mounts its own login/refresh/logout endpoints next to
+	// the gateway and sits inside CORS, so preflights are answered before
+	// the CSRF check ever sees them.
+	var cookies *cookieSession
+	if deps.Cfg.Cookies.Enabled {
+		cookies = newCookieSession(deps.Cfg.Cookies, conn, mux, deps.Log)
+		cookies.register(root)
+	}
 
 	var handler http.Handler = root
+	handler = withCookieSession(cookies)(handler)
 	handler = corsMiddleware(deps.Cfg.CORS.AllowedOrigins)(handler)
 	handler = loggingMiddleware(deps.Log)(handler)
 	handler = requestIDMiddleware(handler)
@@ -121,5 +142,17 @@ func incomingHeaderMatcher(key string) (string, bool) {
 	if strings.EqualFold(key, requestIDHeader) {
 		return strings.ToLower(requestIDHeader), true
 	}
+	if strings.EqualFold(key, tenantHeader) {
+		return strings.ToLower(tenantHeader), true
+	}
+	if strings.EqualFold(key, sodOverrideHeader) {
+		return strings.ToLower(sodOverrideHeader), true
+	}
+	if strings.EqualFold(key, conditionResourceHeader) {
+		return strings.ToLower(conditionResourceHeader), true
+	}
+	if strings.EqualFold(key, conditionRequestHeader) {
+		return strings.ToLower(conditionRequestHeader), true
+	}
 	return runtime.DefaultHeaderMatcher(key)
 }
@@ -0,0 +1,144 @@
+// Package sessioncookie writes the browser session cookies of the
+// cookie mode (http.cookies.*): the access token, the refresh token
+// scoped to the cookie endpoints and the script-readable CSRF token.
+//
+// httpserver's cookie endpoints (login, refresh, logout) and the
+// browser-redirect flows that end in a session (federated login) share
+// it, so every session lands in the browser the same way and the
+// middleware that turns the access cookie back into a bearer header
+// recognises all of them.
+package sessioncookie
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"sso/internal/platform/config"
+)
+
+// Cookie names and the CSRF header are fixed rather than configurable:
+// the SPA hard-codes them, and the CORS preflight default list has to
+// name the header up front.
+const (
+	RefreshName = "sso_refresh"
+	AccessName  = "sso_access"
+	CSRFName    = "sso_csrf"
+	CSRFHeader  = "X-Csrf-Token"
+	// CSRFField carries the token in form posts, which cannot set
+	// headers (IdP-initiated SAML SSO).
+	CSRFField = "csrf_token"
+)
+
+// The navigation cookie repeats the access token for the SAML SSO
+// endpoint only, with SameSite=Lax whatever http.cookies.same_site
+// says: an SP sends the browser there by a cross-site redirect, on
+// which a Strict access cookie is withheld and the user would bounce
+// between the SP and the sign-in page. Lax cookies go along with
+// top-level GET navigations only, and the endpoint only ever answers
+// with an assertion for the SP registered for the request.
+const (
+	NavigationName = "sso_nav"
+	NavigationPath = "/v1/saml/sso"
+)
+
+// Tokens is a freshly issued token pair.
+type Tokens struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// Jar writes the session cookies for one cookie configuration. Build it
+// with New; safe for concurrent use.
+type Jar struct {
+	cfg      config.CookieConfig
+	sameSite http.SameSite
+	now      func() time.Time
+}
+
+func New(cfg config.CookieConfig) *Jar {
+	return &Jar{cfg: cfg, sameSite: parseSameSite(cfg.SameSite), now: time.Now}
+}
+
+func parseSameSite(v string) http.SameSite {
+	switch strings.ToLower(v) {
+	case "lax":
+		return http.SameSiteLaxMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteStrictMode
+	}
+}
+
+// RefreshPath is the path the refresh cookie is scoped to: the cookie
+// endpoints, the only ones that act on it.
+func (j *Jar) RefreshPath() string { return j.cfg.Path }
+
+// Set writes the access, refresh and CSRF cookies for t. The CSRF token
+// is minted anew every time, never carried over from the request: a
+// value planted in the browser before login (a sibling subdomain can
+// set cookies for the parent domain) must not become the session's
+// token. The SPA re-reads the cookie after login and refresh.
+func (j *Jar) Set(w http.ResponseWriter, t Tokens) {
+	now := j.now()
+	accessMaxAge := expiry(now, t.AccessExpiresAt)
+	http.SetCookie(w, j.Cookie(AccessName, t.AccessToken, "/", accessMaxAge, true))
+	http.SetCookie(w, j.navigationCookie(t.AccessToken, accessMaxAge))
+	refreshMaxAge := expiry(now, t.RefreshExpiresAt)
+	http.SetCookie(w, j.Cookie(RefreshName, t.RefreshToken, j.cfg.Path, refreshMaxAge, true))
+	http.SetCookie(w, j.Cookie(CSRFName, newCSRFToken(), "/", refreshMaxAge, false))
+}
+
+// Clear deletes every session cookie.
+func (j *Jar) Clear(w http.ResponseWriter) {
+	http.SetCookie(w, j.Cookie(AccessName, "", "/", -1, true))
+	http.SetCookie(w, j.navigationCookie("", -1))
+	http.SetCookie(w, j.Cookie(RefreshName, "", j.cfg.Path, -1, true))
+	http.SetCookie(w, j.Cookie(CSRFName, "", "/", -1, false))
+}
+
+// Cookie builds a cookie with the configured domain and SameSite mode.
+// Cookies are always Secure.
+func (j *Jar) Cookie(name, value, path string, maxAge int, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   j.cfg.Domain,
+		MaxAge:   maxAge,
+		Secure:   true,
+		HttpOnly: httpOnly,
+		SameSite: j.sameSite,
+	}
+}
+
+func (j *Jar) navigationCookie(value string, maxAge int) *http.Cookie {
+	ck := j.Cookie(NavigationName, value, NavigationPath, maxAge, true)
+	ck.SameSite = http.SameSiteLaxMode
+	return ck
+}
+
+// expiry converts an absolute token expiry into a cookie Max-Age. A
+// missing or already-past time yields -1 (delete the cookie) rather
+// than 0, which net/http would render as a session cookie.
+func expiry(now, at time.Time) int {
+	if at.IsZero() {
+		return -1
+	}
+	secs := int(at.Sub(now) / time.Second)
+	if secs <= 0 {
+		return -1
+	}
+	return secs
+}
+
+func newCSRFToken() string {
+	var b [32]byte
+	_, _ = rand.Read(b[:])
+	return base64.RawURLEncoding.EncodeToString(b[:])
+}
@@ -0,0 +1,182 @@
+// Package ldap is a minimal LDAP / Active Directory client for password
+// sign-in: a search-then-bind against one directory.
+//
+// Authenticate binds with the service account, searches BaseDN for the
+// single entry matching UserFilter, then re-binds as that entry with the
+// caller's password. It returns the entry's attributes so the caller can
+// map them onto a local account. The package knows nothing about users,
+// roles or groups as stored entities; it only moves attributes around.
+//
+// Every call opens its own connection. Sign-in is not a hot path and a
+// pool would have to deal with half-bound connections after a failed
+// user bind.
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+var (
+	// ErrUserNotFound is returned when the search finds no entry for the
+	// login. More than one match is reported the same way: an ambiguous
+	// filter must not pick an account at random.
+	ErrUserNotFound = errors.New("ldap: user not found")
+
+	// ErrInvalidCredentials is returned when the directory rejects the
+	// user bind (wrong password, locked or expired account).
+	ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+
+	// ErrUnavailable covers dialing, TLS and service-bind failures.
+	ErrUnavailable = errors.New("ldap: directory unavailable")
+)
+
+// Config describes one directory. UserFilter must contain the {login}
+// placeholder, which is replaced by the escaped login.
+type Config struct {
+	URL                string // ldap://host:389 or ldaps://host:636
+	StartTLS           bool
+	InsecureSkipVerify bool
+
+	BindDN       string
+	BindPassword string
+
+	BaseDN     string
+	UserFilter string   // e.g. (&(objectClass=person)(uid={login}))
+	Attributes []string // attributes to return with the entry
+
+	Timeout time.Duration // per operation; default 10s
+}
+
+// Entry is a matched directory entry.
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// First returns the first value of the attribute, or "".
+func (e *Entry) First(name string) string {
+	for k, v := range e.Attributes {
+		if strings.EqualFold(k, name) && len(v) > 0 {
+			return strings.TrimSpace(v[0])
+		}
+	}
+	return ""
+}
+
+// Values returns every value of the attribute.
+func (e *Entry) Values(name string) []string {
+	for k, v := range e.Attributes {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// Client authenticates against the configured directory.
+type Client struct {
+	cfg Config
+}
+
+// New returns a Client. The directory is not contacted until the first
+// Authenticate.
+func New(cfg Config) *Client {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &Client{cfg: cfg}
+}
+
+// Authenticate verifies (login, password) against the directory and
+// returns the matched entry. An empty password is refused up front:
+// most servers treat it as an unauthenticated bind and report success.
+func (c *Client) Authenticate(ctx context.Context, login, password string) (*Entry, error) {
+	if login == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if c.cfg.BindDN != "" {
+		if err := conn.Bind(c.cfg.BindDN, c.cfg.BindPassword); err != nil {
+			return nil, fmt.Errorf("%w: service bind: %v", ErrUnavailable, err)
+		}
+	}
+
+	filter := strings.ReplaceAll(c.cfg.UserFilter, "{login}", goldap.EscapeFilter(login))
+	res, err := conn.Search(goldap.NewSearchRequest(
+		c.cfg.BaseDN,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		2, // size limit: one match is expected, two means ambiguous
+		int(c.cfg.Timeout/time.Second),
+		false,
+		filter,
+		c.cfg.Attributes,
+		nil,
+	))
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, fmt.Errorf("%w: search: %v", ErrUnavailable, err)
+	}
+	if res == nil || len(res.Entries) != 1 {
+		return nil, ErrUserNotFound
+	}
+	e := res.Entries[0]
+
+	if err := conn.Bind(e.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("%w: user bind: %v", ErrUnavailable, err)
+	}
+
+	out := &Entry{DN: e.DN, Attributes: make(map[string][]string, len(e.Attributes))}
+	for _, a := range e.Attributes {
+		out.Attributes[a.Name] = a.Values
+	}
+	return out, nil
+}
+
+func (c *Client) dial(ctx context.Context) (*goldap.Conn, error) {
+	u, err := url.Parse(c.cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse url: %v", ErrUnavailable, err)
+	}
+	tlsCfg := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: c.cfg.InsecureSkipVerify, //nolint:gosec // opt-in for lab directories
+	}
+	conn, err := goldap.DialURL(c.cfg.URL,
+		goldap.DialWithDialer(&net.Dialer{Timeout: c.cfg.Timeout}),
+		goldap.DialWithTLSConfig(tlsCfg),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: dial: %v", ErrUnavailable, err)
+	}
+	conn.SetTimeout(c.cfg.Timeout)
+	if deadline, ok := ctx.Deadline(); ok {
+		if d := time.Until(deadline); d > 0 && d < c.cfg.Timeout {
+			conn.SetTimeout(d)
+		}
+	}
+
+	if c.cfg.StartTLS {
+		if err := conn.StartTLS(tlsCfg); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("%w: starttls: %v", ErrUnavailable, err)
+		}
+	}
+	return conn, nil
+}
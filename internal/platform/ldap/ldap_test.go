@@ -0,0 +1,142 @@
+package ldap_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"sso/internal/platform/ldap"
+	"sso/internal/platform/ldap/ldaptest"
+)
+
+const (
+	baseDN = "ou=people,dc=example,dc=com"
+	svcDN  = "cn=sso,dc=example,dc=com"
+	adaDN  = "uid=ada,ou=people,dc=example,dc=com"
+)
+
+var (
+	admins  = "cn=Admins,ou=groups,dc=example,dc=com"
+	viewers = "cn=Viewers,ou=groups,dc=example,dc=com"
+)
+
+// directory starts a directory with the service account, ada (two
+// groups) and two entries sharing the uid twin.
+func directory(t *testing.T) *ldaptest.Server {
+	return ldaptest.Start(t,
+		ldaptest.Entry{DN: svcDN, Password: "svc-pw"},
+		ldaptest.Entry{DN: adaDN, Password: "s3cret", Attributes: map[string][]string{
+			"objectClass": {"person"},
+			"uid":         {"ada"},
+			"mail":        {"ada@example.com"},
+			"memberOf":    {admins, viewers},
+		}},
+		ldaptest.Entry{DN: "uid=twin,ou=people,dc=example,dc=com", Password: "pw", Attributes: map[string][]string{
+			"objectClass": {"person"},
+			"uid":         {"twin"},
+		}},
+		ldaptest.Entry{DN: "cn=twin,ou=people,dc=example,dc=com", Password: "pw", Attributes: map[string][]string{
+			"objectClass": {"person"},
+			"uid":         {"twin"},
+		}},
+	)
+}
+
+func client(srv *ldaptest.Server, filter string) *ldap.Client {
+	return ldap.New(ldap.Config{
+		URL:          srv.URL,
+		BindDN:       svcDN,
+		BindPassword: "svc-pw",
+		BaseDN:       baseDN,
+		UserFilter:   filter,
+		Attributes:   []string{"uid", "mail", "memberOf"},
+	})
+}
+
+func TestAuthenticateReturnsEntryWithGroups(t *testing.T) {
+	srv := directory(t)
+	c := client(srv, "(&(objectClass=person)(uid={login}))")
+
+	e, err := c.Authenticate(context.Background(), "ada", "s3cret")
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if e.DN != adaDN || e.First("mail") != "ada@example.com" {
+		t.Fatalf("entry = %+v", e)
+	}
+	// Attribute names are matched case-insensitively, as directories
+	// spell them inconsistently (memberOf, memberof).
+	if got := e.Values("MEMBEROF"); !slices.Equal(got, []string{admins, viewers}) {
+		t.Fatalf("groups = %v, want both", got)
+	}
+	if got := srv.Binds(); !slices.Equal(got, []string{svcDN, adaDN}) {
+		t.Fatalf("binds = %v, want service bind then user bind", got)
+	}
+}
+
+func TestAuthenticateEscapesFilter(t *testing.T) {
+	srv := directory(t)
+	c := client(srv, "(&(objectClass=person)(uid={login}))")
+
+	// Unescaped, this login would turn the filter into
+	// (&(objectClass=person)(uid=*)(uid=ada)) and match every person.
+	_, err := c.Authenticate(context.Background(), "*)(uid=ada", "s3cret")
+	if !errors.Is(err, ldap.ErrUserNotFound) {
+		t.Fatalf("err = %v, want ErrUserNotFound", err)
+	}
+	filters := srv.Filters()
+	if len(filters) != 1 || !strings.Contains(filters[0], `\2a\29\28uid=ada`) {
+		t.Fatalf("filters = %q, want the login escaped", filters)
+	}
+}
+
+func TestAuthenticateRejects(t *testing.T) {
+	cases := []struct {
+		name     string
+		filter   string
+		login    string
+		password string
+		want     error
+	}{
+		{"empty password", "(uid={login})", "ada", "", ldap.ErrInvalidCredentials},
+		{"wrong password", "(uid={login})", "ada", "nope", ldap.ErrInvalidCredentials},
+		{"unknown login", "(uid={login})", "grace", "s3cret", ldap.ErrUserNotFound},
+		// Two entries match: the size limit of 2 is reached without being
+		// exceeded, and the result is ambiguous.
+		{"two entries", "(uid={login})", "twin", "pw", ldap.ErrUserNotFound},
+		// Every person matches: the directory stops at the size limit.
+		{"size limit exceeded", "(|(uid={login})(objectClass=person))", "ada", "s3cret", ldap.ErrUserNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := directory(t)
+			_, err := client(srv, tc.filter).Authenticate(context.Background(), tc.login, tc.password)
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("err = %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+// TestAuthenticateEmptyPasswordNeverBinds checks the empty password is
+// refused before the directory is contacted: this one, like many,
+// would accept the bind as unauthenticated.
+func TestAuthenticateEmptyPasswordNeverBinds(t *testing.T) {
+	srv := directory(t)
+	if _, err := client(srv, "(uid={login})").Authenticate(context.Background(), "ada", ""); !errors.Is(err, ldap.ErrInvalidCredentials) {
+		t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+	}
+	if got := srv.Binds(); len(got) != 0 {
+		t.Fatalf("binds = %v, want none", got)
+	}
+}
+
+func TestAuthenticateServiceBindFails(t *testing.T) {
+	srv := directory(t)
+	c := ldap.New(ldap.Config{URL: srv.URL, BindDN: svcDN, BindPassword: "wrong", BaseDN: baseDN, UserFilter: "(uid={login})"})
+	if _, err := c.Authenticate(context.Background(), "ada", "s3cret"); !errors.Is(err, ldap.ErrUnavailable) {
+		t.Fatalf("err = %v, want ErrUnavailable", err)
+	}
+}
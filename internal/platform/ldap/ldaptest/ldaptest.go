@@ -0,0 +1,220 @@
+// Package ldaptest runs an in-process LDAP directory for tests: a gldap
+// server answering simple binds and searches over a fixed set of
+// entries, the way OpenLDAP and Active Directory answer the operations
+// platform/ldap uses.
+//
+// Like most servers it accepts a bind with an empty password as an
+// unauthenticated bind, and it honours the search size limit by
+// returning sizeLimitExceeded after that many entries. Filters are
+// evaluated for and, or, not, equality and presence; anything else
+// matches nothing.
+package ldaptest
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/go-hclog"
+	"github.com/jimlambrt/gldap"
+)
+
+// Entry is one directory entry and the password it binds with.
+type Entry struct {
+	DN         string
+	Password   string
+	Attributes map[string][]string
+}
+
+// Server is a running directory. Stopped by the test's cleanup.
+type Server struct {
+	// URL is the ldap:// URL to dial.
+	URL string
+
+	entries []Entry
+
+	mu      sync.Mutex
+	filters []string
+	binds   []string
+}
+
+// Start serves entries on a loopback port until t ends.
+func Start(t testing.TB, entries ...Entry) *Server {
+	t.Helper()
+	addr := freeAddr(t)
+	s := &Server{URL: "ldap://" + addr, entries: entries}
+
+	srv, err := gldap.NewServer(gldap.WithLogger(hclog.NewNullLogger()))
+	if err != nil {
+		t.Fatalf("ldaptest: new server: %v", err)
+	}
+	mux, err := gldap.NewMux()
+	if err != nil {
+		t.Fatalf("ldaptest: new mux: %v", err)
+	}
+	if err := mux.Bind(s.bind); err != nil {
+		t.Fatalf("ldaptest: bind route: %v", err)
+	}
+	if err := mux.Search(s.search); err != nil {
+		t.Fatalf("ldaptest: search route: %v", err)
+	}
+	if err := srv.Router(mux); err != nil {
+		t.Fatalf("ldaptest: router: %v", err)
+	}
+	go func() { _ = srv.Run(addr) }()
+	t.Cleanup(func() { _ = srv.Stop() })
+
+	for deadline := time.Now().Add(5 * time.Second); !srv.Ready(); {
+		if time.Now().After(deadline) {
+			t.Fatal("ldaptest: server did not start")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return s
+}
+
+// Filters returns the search filters received, in order, as the server
+// decoded them.
+func (s *Server) Filters() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.filters...)
+}
+
+// Binds returns the DNs of the bind requests received, in order.
+func (s *Server) Binds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.binds...)
+}
+
+func (s *Server) bind(w *gldap.ResponseWriter, r *gldap.Request) {
+	resp := r.NewBindResponse(gldap.WithResponseCode(gldap.ResultInvalidCredentials))
+	defer func() { _ = w.Write(resp) }()
+
+	m, err := r.GetSimpleBindMessage()
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	s.binds = append(s.binds, m.UserName)
+	s.mu.Unlock()
+
+	if m.Password == "" {
+		resp.SetResultCode(gldap.ResultSuccess) // unauthenticated bind
+		return
+	}
+	for _, e := range s.entries {
+		if strings.EqualFold(e.DN, m.UserName) && e.Password == string(m.Password) {
+			resp.SetResultCode(gldap.ResultSuccess)
+			return
+		}
+	}
+}
+
+func (s *Server) search(w *gldap.ResponseWriter, r *gldap.Request) {
+	resp := r.NewSearchDoneResponse(gldap.WithResponseCode(gldap.ResultSuccess))
+	defer func() { _ = w.Write(resp) }()
+
+	m, err := r.GetSearchMessage()
+	if err != nil {
+		resp.SetResultCode(gldap.ResultProtocolError)
+		return
+	}
+	s.mu.Lock()
+	s.filters = append(s.filters, m.Filter)
+	s.mu.Unlock()
+
+	f, err := goldap.CompileFilter(m.Filter)
+	if err != nil {
+		resp.SetResultCode(gldap.ResultProtocolError)
+		return
+	}
+	sent := int64(0)
+	for _, e := range s.entries {
+		if !strings.HasSuffix(strings.ToLower(e.DN), strings.ToLower(m.BaseDN)) || !matches(f, e) {
+			continue
+		}
+		if m.SizeLimit > 0 && sent == m.SizeLimit {
+			resp.SetResultCode(gldap.ResultSizeLimitExceeded)
+			return
+		}
+		_ = w.Write(r.NewSearchResponseEntry(e.DN, gldap.WithAttributes(selectAttrs(e.Attributes, m.Attributes))))
+		sent++
+	}
+}
+
+// matches evaluates a compiled filter against e.
+func matches(f *ber.Packet, e Entry) bool {
+	switch f.Tag {
+	case goldap.FilterAnd:
+		for _, c := range f.Children {
+			if !matches(c, e) {
+				return false
+			}
+		}
+		return true
+	case goldap.FilterOr:
+		for _, c := range f.Children {
+			if matches(c, e) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterNot:
+		return len(f.Children) == 1 && !matches(f.Children[0], e)
+	case goldap.FilterEqualityMatch:
+		attr, value := f.Children[0].Data.String(), f.Children[1].Data.String()
+		for _, v := range values(e.Attributes, attr) {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterPresent:
+		return len(values(e.Attributes, f.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func values(attrs map[string][]string, name string) []string {
+	for k, v := range attrs {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// selectAttrs returns the requested attributes of attrs; all of them
+// when none or "*" is requested.
+func selectAttrs(attrs map[string][]string, requested []string) map[string][]string {
+	if len(requested) == 0 || (len(requested) == 1 && requested[0] == "*") {
+		return attrs
+	}
+	out := make(map[string][]string, len(requested))
+	for _, name := range requested {
+		for k, v := range attrs {
+			if strings.EqualFold(k, name) {
+				out[k] = v
+			}
+		}
+	}
+	return out
+}
+
+func freeAddr(t testing.TB) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ldaptest: listen: %v", err)
+	}
+	defer l.Close()
+	return fmt.Sprintf("127.0.0.1:%d", l.Addr().(*net.TCPAddr).Port)
+}
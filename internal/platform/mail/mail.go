@@ -0,0 +1,110 @@
+// Package mail delivers transactional messages (invitations, address
+// confirmations). Modules depend on the Sender interface; bootstrap
+// picks the implementation from config:
+//
+//	LogSender   writes the message to the log — development only, the
+//	            body carries one-time links
+//	SMTPSender  submits over SMTP with STARTTLS when offered
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Message is a plain-text mail.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers a Message. Implementations must be safe for
+// concurrent use.
+type Sender interface {
+	Send(ctx context.Context, m Message) error
+}
+
+// ----------------------------------------------------------------------------
+// LogSender
+// ----------------------------------------------------------------------------
+
+type LogSender struct {
+	Log *slog.Logger
+}
+
+func (s LogSender) Send(ctx context.Context, m Message) error {
+	s.Log.InfoContext(ctx, "mail: not sent (log driver)",
+		"to", m.To, "subject", m.Subject, "body", m.Body)
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// SMTPSender
+// ----------------------------------------------------------------------------
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // empty = no AUTH
+	Password string
+	From     string
+}
+
+type SMTPSender struct {
+	cfg SMTPConfig
+}
+
+func NewSMTPSender(cfg SMTPConfig) *SMTPSender {
+	return &SMTPSender{cfg: cfg}
+}
+
+// Send submits m. net/smtp upgrades to TLS when the server offers
+// STARTTLS and refuses PLAIN auth over a cleartext connection to
+// anything but localhost, so credentials never travel in the clear.
+func (s *SMTPSender) Send(ctx context.Context, m Message) error {
+	if strings.ContainsAny(m.To, "\r\n") || strings.ContainsAny(m.Subject, "\r\n") {
+		return fmt.Errorf("mail: header contains a line break")
+	}
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		auth = smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(addr, auth, s.cfg.From, []string{m.To}, s.render(m))
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("mail: smtp send: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("mail: smtp send: %w", ctx.Err())
+	}
+}
+
+func (s *SMTPSender) render(m Message) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + s.cfg.From + "\r\n")
+	b.WriteString("To: " + m.To + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", m.Subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().UTC().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(m.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
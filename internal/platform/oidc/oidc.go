@@ -0,0 +1,372 @@
+// Package oidc is a minimal OpenID Connect relying-party client: issuer
+// discovery, the authorization-code + PKCE exchange, and ID-token
+// verification against the issuer's JWKS.
+//
+// It deliberately covers only what federated login needs. There is no
+// userinfo call (every claim we map is expected in the ID token), no
+// implicit / hybrid flow, and no dynamic client registration. The
+// package is module-agnostic: it knows nothing about identity providers
+// as stored entities, only about (issuer, client_id, client_secret)
+// triples handed in by the caller.
+//
+// Discovery documents and key sets are cached per issuer for
+// Config.CacheTTL. A token signed by a key id the cache does not know
+// forces one refetch, so provider key rotation does not need a restart;
+// such refetches are at most one per Config.RefetchCooldown per issuer,
+// so tokens with made-up key ids cannot turn the callback into a
+// request amplifier against the provider.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	// ErrDiscovery covers every failure to fetch or trust the issuer's
+	// discovery document (network, non-200, issuer mismatch).
+	ErrDiscovery = errors.New("oidc: discovery failed")
+
+	// ErrExchange is returned when the token endpoint rejects the
+	// authorization code or answers without an id_token.
+	ErrExchange = errors.New("oidc: code exchange failed")
+
+	// ErrInvalidIDToken covers signature, issuer, audience, expiry and
+	// nonce failures on the ID token.
+	ErrInvalidIDToken = errors.New("oidc: invalid id token")
+)
+
+// maxResponseBytes caps every body read from a provider. Discovery
+// documents and key sets are a few KiB; anything larger is either a
+// misconfigured issuer URL or hostile.
+const maxResponseBytes = 1 << 20
+
+// Metadata is the subset of the discovery document the client uses.
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Config tunes the client. Zero values pick the defaults noted inline.
+type Config struct {
+	HTTPClient      *http.Client     // default: 10s timeout
+	CacheTTL        time.Duration    // default: 1h
+	RefetchCooldown time.Duration    // default: 1m
+	Now             func() time.Time // default: time.Now
+}
+
+// Client talks to any number of OIDC issuers. Safe for concurrent use.
+type Client struct {
+	http     *http.Client
+	cacheTTL time.Duration
+	cooldown time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	issuers map[string]*issuerCache
+
+	// fetches collapses concurrent fetches of one issuer into one.
+	fetches singleflight.Group
+}
+
+type issuerCache struct {
+	meta      Metadata
+	keys      map[string]any // kid → public key
+	fetchedAt time.Time
+}
+
+func New(cfg Config) *Client {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = time.Hour
+	}
+	if cfg.RefetchCooldown <= 0 {
+		cfg.RefetchCooldown = time.Minute
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &Client{
+		http:     cfg.HTTPClient,
+		cacheTTL: cfg.CacheTTL,
+		cooldown: cfg.RefetchCooldown,
+		now:      cfg.Now,
+		issuers:  make(map[string]*issuerCache),
+	}
+}
+
+// Discover returns the issuer's metadata, fetching
+// {issuer}/.well-known/openid-configuration when the cache is cold or
+// stale. The document's "issuer" must equal the configured issuer
+// exactly (OIDC Discovery §4.3) — otherwise a compromised or
+// mis-pointed endpoint could vouch for tokens from someone else.
+func (c *Client) Discover(ctx context.Context, issuer string) (Metadata, error) {
+	ic, err := c.issuer(ctx, issuer, false)
+	if err != nil {
+		return Metadata{}, err
+	}
+	return ic.meta, nil
+}
+
+// AuthRequest is everything AuthCodeURL needs to build the redirect.
+type AuthRequest struct {
+	ClientID      string
+	RedirectURI   string
+	Scopes        []string
+	State         string
+	Nonce         string
+	CodeChallenge string // S256 challenge from NewPKCE
+}
+
+// AuthCodeURL builds the authorization-endpoint redirect for the
+// code flow with PKCE (S256).
+func AuthCodeURL(meta Metadata, r AuthRequest) string {
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", r.ClientID)
+	q.Set("redirect_uri", r.RedirectURI)
+	q.Set("scope", strings.Join(r.Scopes, " "))
+	q.Set("state", r.State)
+	q.Set("nonce", r.Nonce)
+	q.Set("code_challenge", r.CodeChallenge)
+	q.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + q.Encode()
+}
+
+// ExchangeRequest carries the token-endpoint parameters for the code
+// grant. The client authenticates with client_secret_basic.
+type ExchangeRequest struct {
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+}
+
+// Exchange trades an authorization code for tokens and returns the raw
+// ID token. Access / refresh tokens from the provider are discarded:
+// we only need to know who the user is, not to call the provider's
+// APIs on their behalf.
+func (c *Client) Exchange(ctx context.Context, meta Metadata, r ExchangeRequest) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", r.Code)
+	form.Set("redirect_uri", r.RedirectURI)
+	form.Set("code_verifier", r.CodeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(r.ClientID), url.QueryEscape(r.ClientSecret))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return "", fmt.Errorf("%w: read body: %v", ErrExchange, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%w: token endpoint returned %d", ErrExchange, resp.StatusCode)
+	}
+
+	var tok struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &tok); err != nil {
+		return "", fmt.Errorf("%w: decode: %v", ErrExchange, err)
+	}
+	if tok.IDToken == "" {
+		return "", fmt.Errorf("%w: response has no id_token", ErrExchange)
+	}
+	return tok.IDToken, nil
+}
+
+// VerifyRequest pins the values an ID token must carry.
+type VerifyRequest struct {
+	Issuer   string
+	ClientID string
+	Nonce    string
+}
+
+// VerifyIDToken checks the signature against the issuer's JWKS and the
+// iss / aud / exp / nonce claims, then returns the full claim set so
+// the caller can apply its own claim mapping. "sub" is guaranteed
+// non-empty on success.
+func (c *Client) VerifyIDToken(ctx context.Context, raw string, r VerifyRequest) (map[string]any, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims,
+		func(t *jwt.Token) (any, error) {
+			kid, _ := t.Header["kid"].(string)
+			return c.key(ctx, r.Issuer, kid)
+		},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "PS256", "EdDSA"}),
+		jwt.WithIssuer(r.Issuer),
+		jwt.WithAudience(r.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30*time.Second),
+		jwt.WithTimeFunc(c.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	if nonce, _ := claims["nonce"].(string); nonce != r.Nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, fmt.Errorf("%w: missing sub", ErrInvalidIDToken)
+	}
+	return claims, nil
+}
+
+// key resolves a signing key by kid. An unknown kid triggers one forced
+// refresh of the key set before giving up, unless the set was fetched
+// within the refetch cooldown. When the token carries no kid the set
+// must contain exactly one key.
+func (c *Client) key(ctx context.Context, issuer, kid string) (any, error) {
+	for _, force := range []bool{false, true} {
+		ic, err := c.issuer(ctx, issuer, force)
+		if err != nil {
+			return nil, err
+		}
+		if kid == "" {
+			if len(ic.keys) == 1 {
+				for _, k := range ic.keys {
+					return k, nil
+				}
+			}
+			return nil, errors.New("token has no kid and the key set is ambiguous")
+		}
+		if k, ok := ic.keys[kid]; ok {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// issuer returns the cached discovery document and key set, fetching
+// them when the entry is missing or stale. force refetches a fresh
+// entry too, but never one younger than the refetch cooldown.
+func (c *Client) issuer(ctx context.Context, issuer string, force bool) (*issuerCache, error) {
+	c.mu.Lock()
+	ic, ok := c.issuers[issuer]
+	c.mu.Unlock()
+	if ok {
+		age := c.now().Sub(ic.fetchedAt)
+		if age < c.cooldown || (!force && age < c.cacheTTL) {
+			return ic, nil
+		}
+	}
+
+	v, err, _ := c.fetches.Do(issuer, func() (any, error) {
+		// A caller that missed the cache while another was fetching
+		// takes that fetch's result instead of starting its own.
+		c.mu.Lock()
+		ic, ok := c.issuers[issuer]
+		c.mu.Unlock()
+		if ok && c.now().Sub(ic.fetchedAt) < c.cooldown {
+			return ic, nil
+		}
+		return c.fetch(ctx, issuer)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*issuerCache), nil
+}
+
+func (c *Client) fetch(ctx context.Context, issuer string) (*issuerCache, error) {
+	var meta Metadata
+	wellKnown := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	if err := c.getJSON(ctx, wellKnown, &meta); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDiscovery, err)
+	}
+	if meta.Issuer != issuer {
+		return nil, fmt.Errorf("%w: issuer mismatch: got %q, want %q", ErrDiscovery, meta.Issuer, issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: document is missing required endpoints", ErrDiscovery)
+	}
+
+	var set jwks
+	if err := c.getJSON(ctx, meta.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("%w: jwks: %v", ErrDiscovery, err)
+	}
+
+	ic := &issuerCache{meta: meta, keys: set.publicKeys(), fetchedAt: c.now()}
+	c.mu.Lock()
+	c.issuers[issuer] = ic
+	c.mu.Unlock()
+	return ic, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, u string, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", u, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(dst)
+}
+
+// ----------------------------------------------------------------------------
+// PKCE / state helpers
+// ----------------------------------------------------------------------------
+
+// NewPKCE returns a fresh RFC 7636 code verifier and its S256 challenge.
+func NewPKCE() (verifier, challenge string, err error) {
+	verifier, err = RandomString()
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// RandomString returns 32 random bytes, base64url-encoded. Used for
+// state, nonce and the PKCE verifier.
+func RandomString() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("oidc: read random: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,304 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// stubProvider is an OIDC issuer serving discovery, a JWKS of the keys
+// in keys and a token endpoint that answers with idToken.
+type stubProvider struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	mu         sync.Mutex
+	keys       map[string]*rsa.PrivateKey
+	idToken    string
+	issuer     string // served issuer; defaults to the server URL
+	jwksHits   int
+	tokenForms []map[string]string
+}
+
+func newStubProvider(t *testing.T) *stubProvider {
+	t.Helper()
+	p := &stubProvider{t: t, keys: map[string]*rsa.PrivateKey{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("GET /jwks", p.jwks)
+	mux.HandleFunc("POST /token", p.token)
+	p.srv = httptest.NewServer(mux)
+	t.Cleanup(p.srv.Close)
+	return p
+}
+
+func (p *stubProvider) URL() string { return p.srv.URL }
+
+func (p *stubProvider) addKey(kid string) *rsa.PrivateKey {
+	p.t.Helper()
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	p.mu.Lock()
+	p.keys[kid] = k
+	p.mu.Unlock()
+	return k
+}
+
+func (p *stubProvider) discovery(w http.ResponseWriter, _ *http.Request) {
+	p.mu.Lock()
+	iss := p.issuer
+	p.mu.Unlock()
+	if iss == "" {
+		iss = p.srv.URL
+	}
+	_ = json.NewEncoder(w).Encode(Metadata{
+		Issuer:                iss,
+		AuthorizationEndpoint: p.srv.URL + "/authorize",
+		TokenEndpoint:         p.srv.URL + "/token",
+		JWKSURI:               p.srv.URL + "/jwks",
+	})
+}
+
+func (p *stubProvider) jwks(w http.ResponseWriter, _ *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.jwksHits++
+	set := jwks{}
+	for kid, k := range p.keys {
+		set.Keys = append(set.Keys, jwk{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		})
+	}
+	_ = json.NewEncoder(w).Encode(set)
+}
+
+func (p *stubProvider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	user, pass, _ := r.BasicAuth()
+	p.mu.Lock()
+	p.tokenForms = append(p.tokenForms, map[string]string{
+		"client_id":     user,
+		"client_secret": pass,
+		"code":          r.PostForm.Get("code"),
+		"code_verifier": r.PostForm.Get("code_verifier"),
+	})
+	tok := p.idToken
+	p.mu.Unlock()
+	if r.PostForm.Get("code") != "good-code" {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]string{"id_token": tok, "token_type": "Bearer"})
+}
+
+func (p *stubProvider) hits() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.jwksHits
+}
+
+// sign issues an RS256 ID token under kid.
+func sign(t *testing.T, k *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	tok := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tok.Header["kid"] = kid
+	raw, err := tok.SignedString(k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+// clock is a settable time source.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	c.mu.Unlock()
+}
+
+func claimsFor(p *stubProvider, now time.Time, nonce string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":   p.URL(),
+		"aud":   "client-1",
+		"sub":   "user-42",
+		"nonce": nonce,
+		"iat":   now.Unix(),
+		"exp":   now.Add(5 * time.Minute).Unix(),
+	}
+}
+
+func TestCodeFlow(t *testing.T) {
+	p := newStubProvider(t)
+	k := p.addKey("k1")
+	clk := &clock{now: time.Now()}
+	c := New(Config{Now: clk.Now})
+	ctx := context.Background()
+
+	meta, err := c.Discover(ctx, p.URL())
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	p.idToken = sign(t, k, "k1", claimsFor(p, clk.Now(), "n-1"))
+
+	raw, err := c.Exchange(ctx, meta, ExchangeRequest{
+		ClientID:     "client-1",
+		ClientSecret: "s3cret",
+		Code:         "good-code",
+		RedirectURI:  "https://sso.example.com/v1/federation/callback",
+		CodeVerifier: "verifier",
+	})
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	form := p.tokenForms[0]
+	if form["client_id"] != "client-1" || form["client_secret"] != "s3cret" || form["code_verifier"] != "verifier" {
+		t.Fatalf("token request = %v", form)
+	}
+
+	claims, err := c.VerifyIDToken(ctx, raw, VerifyRequest{Issuer: p.URL(), ClientID: "client-1", Nonce: "n-1"})
+	if err != nil {
+		t.Fatalf("VerifyIDToken: %v", err)
+	}
+	if claims["sub"] != "user-42" {
+		t.Fatalf("sub = %v", claims["sub"])
+	}
+
+	if _, err := c.Exchange(ctx, meta, ExchangeRequest{ClientID: "client-1", Code: "bad-code"}); !errors.Is(err, ErrExchange) {
+		t.Fatalf("bad code: err = %v, want ErrExchange", err)
+	}
+}
+
+func TestVerifyIDTokenRejects(t *testing.T) {
+	p := newStubProvider(t)
+	k := p.addKey("k1")
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clk := &clock{now: time.Now()}
+	c := New(Config{Now: clk.Now})
+	req := VerifyRequest{Issuer: p.URL(), ClientID: "client-1", Nonce: "n-1"}
+
+	with := func(mut func(jwt.MapClaims)) jwt.MapClaims {
+		cl := claimsFor(p, clk.Now(), "n-1")
+		mut(cl)
+		return cl
+	}
+	cases := []struct {
+		name string
+		raw  string
+	}{
+		{"wrong nonce", sign(t, k, "k1", with(func(c jwt.MapClaims) { c["nonce"] = "n-2" }))},
+		{"wrong audience", sign(t, k, "k1", with(func(c jwt.MapClaims) { c["aud"] = "client-2" }))},
+		{"wrong issuer", sign(t, k, "k1", with(func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }))},
+		{"expired", sign(t, k, "k1", with(func(c jwt.MapClaims) { c["exp"] = clk.Now().Add(-time.Hour).Unix() }))},
+		{"missing sub", sign(t, k, "k1", with(func(c jwt.MapClaims) { delete(c, "sub") }))},
+		{"foreign key", sign(t, other, "k1", claimsFor(p, clk.Now(), "n-1"))},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := c.VerifyIDToken(context.Background(), tc.raw, req); !errors.Is(err, ErrInvalidIDToken) {
+				t.Fatalf("err = %v, want ErrInvalidIDToken", err)
+			}
+		})
+	}
+}
+
+func TestDiscoverRejectsIssuerMismatch(t *testing.T) {
+	p := newStubProvider(t)
+	p.issuer = "https://other.example.com"
+	c := New(Config{})
+	if _, err := c.Discover(context.Background(), p.URL()); !errors.Is(err, ErrDiscovery) {
+		t.Fatalf("err = %v, want ErrDiscovery", err)
+	}
+}
+
+func TestUnknownKidRefetchCooldown(t *testing.T) {
+	p := newStubProvider(t)
+	k1 := p.addKey("k1")
+	clk := &clock{now: time.Now()}
+	c := New(Config{Now: clk.Now, RefetchCooldown: time.Minute})
+	ctx := context.Background()
+	req := VerifyRequest{Issuer: p.URL(), ClientID: "client-1", Nonce: "n-1"}
+
+	if _, err := c.VerifyIDToken(ctx, sign(t, k1, "k1", claimsFor(p, clk.Now(), "n-1")), req); err != nil {
+		t.Fatalf("known kid: %v", err)
+	}
+	if got := p.hits(); got != 1 {
+		t.Fatalf("jwks hits = %d, want 1", got)
+	}
+
+	// Made-up kids inside the cooldown are refused from the cache.
+	for range 20 {
+		raw := sign(t, k1, "made-up", claimsFor(p, clk.Now(), "n-1"))
+		if _, err := c.VerifyIDToken(ctx, raw, req); !errors.Is(err, ErrInvalidIDToken) {
+			t.Fatalf("unknown kid: err = %v, want ErrInvalidIDToken", err)
+		}
+	}
+	if got := p.hits(); got != 1 {
+		t.Fatalf("jwks hits after unknown kids = %d, want 1", got)
+	}
+
+	// A rotated key is picked up by one refetch once the cooldown ran out.
+	k2 := p.addKey("k2")
+	clk.Advance(2 * time.Minute)
+	if _, err := c.VerifyIDToken(ctx, sign(t, k2, "k2", claimsFor(p, clk.Now(), "n-1")), req); err != nil {
+		t.Fatalf("rotated kid: %v", err)
+	}
+	if got := p.hits(); got != 2 {
+		t.Fatalf("jwks hits after rotation = %d, want 2", got)
+	}
+}
+
+func TestConcurrentRefetchesCollapse(t *testing.T) {
+	p := newStubProvider(t)
+	k1 := p.addKey("k1")
+	clk := &clock{now: time.Now()}
+	c := New(Config{Now: clk.Now})
+	req := VerifyRequest{Issuer: p.URL(), ClientID: "client-1", Nonce: "n-1"}
+	raw := sign(t, k1, "k1", claimsFor(p, clk.Now(), "n-1"))
+
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Go(func() {
+			if _, err := c.VerifyIDToken(context.Background(), raw, req); err != nil {
+				t.Errorf("VerifyIDToken: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+	if got := p.hits(); got != 1 {
+		t.Fatalf("jwks hits = %d, want 1", got)
+	}
+}
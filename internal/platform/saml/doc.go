@@ -0,0 +1,12 @@
+// Package saml is a minimal SAML 2.0 identity-provider toolkit: IdP
+// metadata, signed Web Browser SSO responses (HTTP-POST binding),
+// logout messages (HTTP-Redirect binding) and decoding of the requests
+// service providers send.
+//
+// It deliberately covers only what the IdP side needs. Assertions are
+// signed (enveloped XML-DSig, exc-c14n, RSA-SHA256) but not encrypted;
+// inbound messages are accepted on the HTTP-Redirect binding, whose
+// query-string signature can be checked without canonicalising foreign
+// XML. The package knows nothing about apps, users or sessions: callers
+// hand in entity ids, URLs and attribute values.
+package saml
@@ -0,0 +1,98 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeyPair is the IdP signing material: an RSA key and the certificate
+// published in metadata. XML-DSig support for Ed25519 is too thin among
+// SAML service providers, so the JWT key is not reused.
+type KeyPair struct {
+	Cert *x509.Certificate
+	Key  *rsa.PrivateKey
+}
+
+// LoadKeyPair reads a PEM certificate and a PEM (PKCS#1 or PKCS#8) RSA
+// private key and checks that they belong together.
+func LoadKeyPair(certPath, keyPath string) (*KeyPair, error) {
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, fmt.Errorf("saml: read certificate: %w", err)
+	}
+	cert, err := ParseCertificate(string(certPEM))
+	if err != nil {
+		return nil, err
+	}
+
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("saml: read private key: %w", err)
+	}
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("saml: private key: no PEM block")
+	}
+	var key *rsa.PrivateKey
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		var k any
+		k, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err == nil {
+			var ok bool
+			if key, ok = k.(*rsa.PrivateKey); !ok {
+				return nil, errors.New("saml: private key: not an RSA key")
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("saml: parse private key: %w", err)
+	}
+
+	pub, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok || !pub.Equal(key.Public()) {
+		return nil, errors.New("saml: certificate does not match private key")
+	}
+	return &KeyPair{Cert: cert, Key: key}, nil
+}
+
+// ParseCertificate parses a PEM certificate. A bare base64 DER body (as
+// copied out of SP metadata) is accepted too.
+func ParseCertificate(s string) (*x509.Certificate, error) {
+	s = strings.TrimSpace(s)
+	var der []byte
+	if block, _ := pem.Decode([]byte(s)); block != nil {
+		der = block.Bytes
+	} else {
+		var err error
+		der, err = base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+		if err != nil {
+			return nil, errors.New("saml: certificate: neither PEM nor base64 DER")
+		}
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("saml: parse certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// certBase64 is the certificate body as it appears in ds:X509Certificate.
+func (k *KeyPair) certBase64() string {
+	return base64.StdEncoding.EncodeToString(k.Cert.Raw)
+}
+
+func (k *KeyPair) sign(data []byte) ([]byte, error) {
+	h := crypto.SHA256.New()
+	h.Write(data)
+	return rsa.SignPKCS1v15(nil, k.Key, crypto.SHA256, h.Sum(nil))
+}
@@ -0,0 +1,360 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// SAML 2.0 namespaces and URIs used on the wire.
+const (
+	nsAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	nsProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	nsMetadata  = "urn:oasis:names:tc:SAML:2.0:metadata"
+
+	BindingRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	BindingPOST     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+	NameIDFormatEmail      = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	NameIDFormatPersistent = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+
+	StatusSuccess   = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	StatusRequester = "urn:oasis:names:tc:SAML:2.0:status:Requester"
+
+	attrNameFormatBasic = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+	authnContextPPT     = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+	confirmationBearer  = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+)
+
+// maxMessageSize bounds an inflated inbound message; real AuthnRequests
+// are a few hundred bytes.
+const maxMessageSize = 64 << 10
+
+// ErrMalformed is returned for an inbound message that cannot be
+// decoded or parsed.
+var ErrMalformed = errors.New("saml: malformed message")
+
+// ----------------------------------------------------------------------------
+// Inbound messages
+// ----------------------------------------------------------------------------
+
+// AuthnRequest is the subset of samlp:AuthnRequest the IdP acts on.
+type AuthnRequest struct {
+	ID     string
+	Issuer string
+	// ACSURL is empty when the SP relies on the ACS registered with the
+	// IdP.
+	ACSURL string
+}
+
+// LogoutRequest is the subset of samlp:LogoutRequest the IdP acts on.
+type LogoutRequest struct {
+	ID             string
+	Issuer         string
+	NameID         string
+	SessionIndexes []string
+}
+
+// LogoutResponse is the subset of samlp:LogoutResponse the IdP acts on.
+type LogoutResponse struct {
+	ID           string
+	Issuer       string
+	InResponseTo string
+	StatusCode   string
+}
+
+type xmlAuthnRequest struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+	ID      string   `xml:"ID,attr"`
+	ACSURL  string   `xml:"AssertionConsumerServiceURL,attr"`
+	Issuer  string   `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+}
+
+type xmlLogoutRequest struct {
+	XMLName        xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol LogoutRequest"`
+	ID             string   `xml:"ID,attr"`
+	Issuer         string   `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	NameID         string   `xml:"urn:oasis:names:tc:SAML:2.0:assertion NameID"`
+	SessionIndexes []string `xml:"urn:oasis:names:tc:SAML:2.0:protocol SessionIndex"`
+}
+
+type xmlLogoutResponse struct {
+	XMLName      xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol LogoutResponse"`
+	ID           string   `xml:"ID,attr"`
+	InResponseTo string   `xml:"InResponseTo,attr"`
+	Issuer       string   `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	Status       struct {
+		Code struct {
+			Value string `xml:"Value,attr"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:protocol StatusCode"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:protocol Status"`
+}
+
+// DecodeRedirect decodes an HTTP-Redirect binding parameter value
+// (base64 of raw DEFLATE).
+func DecodeRedirect(v string) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	out, err := io.ReadAll(io.LimitReader(flate.NewReader(bytes.NewReader(raw)), maxMessageSize+1))
+	if err != nil || len(out) > maxMessageSize {
+		return nil, ErrMalformed
+	}
+	return out, nil
+}
+
+// DecodePost decodes an HTTP-POST binding parameter value (plain base64).
+func DecodePost(v string) ([]byte, error) {
+	out, err := base64.StdEncoding.DecodeString(v)
+	if err != nil || len(out) > maxMessageSize {
+		return nil, ErrMalformed
+	}
+	return out, nil
+}
+
+func ParseAuthnRequest(data []byte) (*AuthnRequest, error) {
+	var x xmlAuthnRequest
+	if err := unmarshal(data, &x); err != nil || x.ID == "" || x.Issuer == "" {
+		return nil, ErrMalformed
+	}
+	return &AuthnRequest{ID: x.ID, Issuer: strings.TrimSpace(x.Issuer), ACSURL: x.ACSURL}, nil
+}
+
+func ParseLogoutRequest(data []byte) (*LogoutRequest, error) {
+	var x xmlLogoutRequest
+	if err := unmarshal(data, &x); err != nil || x.ID == "" || x.Issuer == "" {
+		return nil, ErrMalformed
+	}
+	idx := make([]string, 0, len(x.SessionIndexes))
+	for _, s := range x.SessionIndexes {
+		idx = append(idx, strings.TrimSpace(s))
+	}
+	return &LogoutRequest{
+		ID:             x.ID,
+		Issuer:         strings.TrimSpace(x.Issuer),
+		NameID:         strings.TrimSpace(x.NameID),
+		SessionIndexes: idx,
+	}, nil
+}
+
+func ParseLogoutResponse(data []byte) (*LogoutResponse, error) {
+	var x xmlLogoutResponse
+	if err := unmarshal(data, &x); err != nil || x.ID == "" {
+		return nil, ErrMalformed
+	}
+	return &LogoutResponse{
+		ID:           x.ID,
+		Issuer:       strings.TrimSpace(x.Issuer),
+		InResponseTo: x.InResponseTo,
+		StatusCode:   x.Status.Code.Value,
+	}, nil
+}
+
+// unmarshal refuses DTDs: encoding/xml does not expand external
+// entities, but a DOCTYPE has no business in a SAML message either.
+func unmarshal(data []byte, v any) error {
+	if bytes.Contains(data, []byte("<!DOCTYPE")) {
+		return ErrMalformed
+	}
+	return xml.Unmarshal(data, v)
+}
+
+// ----------------------------------------------------------------------------
+// Outbound messages
+// ----------------------------------------------------------------------------
+
+// IdP builds and signs the messages the identity provider sends.
+type IdP struct {
+	EntityID string
+	SSOURL   string // HTTP-Redirect SingleSignOnService
+	SLOURL   string // HTTP-Redirect SingleLogoutService
+	Keys     *KeyPair
+	Now      func() time.Time
+}
+
+// Attribute is one saml:Attribute of the assertion.
+type Attribute struct {
+	Name   string
+	Values []string
+}
+
+// Assertion describes the statement made about the signed-in user.
+type Assertion struct {
+	InResponseTo string // AuthnRequest ID; empty for IdP-initiated SSO
+	Audience     string // SP entity ID
+	Recipient    string // ACS URL
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+	AuthnInstant time.Time
+	TTL          time.Duration
+	Attributes   []Attribute
+}
+
+// Metadata returns the IdP's EntityDescriptor.
+func (p *IdP) Metadata() string {
+	return E("md:EntityDescriptor").NS("md", nsMetadata).NS("ds", nsDSig).
+		A("entityID", p.EntityID).C(
+		E("md:IDPSSODescriptor").
+			A("WantAuthnRequestsSigned", "false").
+			A("protocolSupportEnumeration", nsProtocol).C(
+			E("md:KeyDescriptor").A("use", "signing").C(
+				E("ds:KeyInfo").C(
+					E("ds:X509Data").C(E("ds:X509Certificate").T(p.Keys.certBase64())),
+				),
+			),
+			E("md:SingleLogoutService").A("Binding", BindingRedirect).A("Location", p.SLOURL),
+			E("md:NameIDFormat").T(NameIDFormatEmail),
+			E("md:NameIDFormat").T(NameIDFormatPersistent),
+			E("md:SingleSignOnService").A("Binding", BindingRedirect).A("Location", p.SSOURL),
+		),
+	).String()
+}
+
+// Response builds a samlp:Response carrying a signed assertion and
+// returns it base64-encoded, ready for the HTTP-POST binding.
+func (p *IdP) Response(a Assertion) (string, error) {
+	now := p.Now().UTC()
+	respID, err := NewID()
+	if err != nil {
+		return "", err
+	}
+	assertionID, err := NewID()
+	if err != nil {
+		return "", err
+	}
+
+	attrs := E("saml:AttributeStatement")
+	for _, at := range a.Attributes {
+		if len(at.Values) == 0 {
+			continue
+		}
+		el := E("saml:Attribute").A("Name", at.Name).A("NameFormat", attrNameFormatBasic)
+		for _, v := range at.Values {
+			el.C(E("saml:AttributeValue").T(v))
+		}
+		attrs.C(el)
+	}
+	if len(attrs.children) == 0 {
+		attrs = nil
+	}
+
+	assertion := E("saml:Assertion").NS("saml", nsAssertion).
+		A("ID", assertionID).A("IssueInstant", timestamp(now)).A("Version", "2.0").C(
+		E("saml:Issuer").T(p.EntityID),
+		E("saml:Subject").C(
+			E("saml:NameID").A("Format", a.NameIDFormat).A("SPNameQualifier", a.Audience).T(a.NameID),
+			E("saml:SubjectConfirmation").A("Method", confirmationBearer).C(
+				E("saml:SubjectConfirmationData").
+					A("InResponseTo", a.InResponseTo).
+					A("NotOnOrAfter", timestamp(now.Add(a.TTL))).
+					A("Recipient", a.Recipient),
+			),
+		),
+		E("saml:Conditions").
+			A("NotBefore", timestamp(now.Add(-clockSkew))).
+			A("NotOnOrAfter", timestamp(now.Add(a.TTL))).C(
+			E("saml:AudienceRestriction").C(E("saml:Audience").T(a.Audience)),
+		),
+		E("saml:AuthnStatement").
+			A("AuthnInstant", timestamp(a.AuthnInstant.UTC())).
+			A("SessionIndex", a.SessionIndex).C(
+			E("saml:AuthnContext").C(E("saml:AuthnContextClassRef").T(authnContextPPT)),
+		),
+		attrs,
+	)
+	if err := signEnveloped(assertion, assertionID, p.Keys); err != nil {
+		return "", err
+	}
+
+	resp := E("samlp:Response").NS("samlp", nsProtocol).NS("saml", nsAssertion).
+		A("ID", respID).A("Version", "2.0").A("IssueInstant", timestamp(now)).
+		A("Destination", a.Recipient).A("InResponseTo", a.InResponseTo).C(
+		E("saml:Issuer").T(p.EntityID),
+		E("samlp:Status").C(E("samlp:StatusCode").A("Value", StatusSuccess)),
+		assertion,
+	)
+	return base64.StdEncoding.EncodeToString([]byte(resp.String())), nil
+}
+
+// LogoutRequest builds a samlp:LogoutRequest for the HTTP-Redirect
+// binding and returns it with its ID.
+func (p *IdP) LogoutRequest(destination, nameID, nameIDFormat, sessionIndex string) (*Element, string, error) {
+	id, err := NewID()
+	if err != nil {
+		return nil, "", err
+	}
+	el := E("samlp:LogoutRequest").NS("samlp", nsProtocol).NS("saml", nsAssertion).
+		A("ID", id).A("Version", "2.0").A("IssueInstant", timestamp(p.Now().UTC())).
+		A("Destination", destination).C(
+		E("saml:Issuer").T(p.EntityID),
+		E("saml:NameID").A("Format", nameIDFormat).T(nameID),
+		E("samlp:SessionIndex").T(sessionIndex),
+	)
+	return el, id, nil
+}
+
+// LogoutResponse builds a samlp:LogoutResponse for the HTTP-Redirect
+// binding.
+func (p *IdP) LogoutResponse(destination, inResponseTo, statusCode string) (*Element, error) {
+	id, err := NewID()
+	if err != nil {
+		return nil, err
+	}
+	return E("samlp:LogoutResponse").NS("samlp", nsProtocol).NS("saml", nsAssertion).
+		A("ID", id).A("Version", "2.0").A("IssueInstant", timestamp(p.Now().UTC())).
+		A("Destination", destination).A("InResponseTo", inResponseTo).C(
+		E("saml:Issuer").T(p.EntityID),
+		E("samlp:Status").C(E("samlp:StatusCode").A("Value", statusCode)),
+	), nil
+}
+
+// clockSkew is subtracted from NotBefore to absorb SP clocks running
+// slightly behind.
+const clockSkew = 30 * time.Second
+
+// NewID returns a random xs:ID (must not start with a digit).
+func NewID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("saml: random id: %w", err)
+	}
+	return "_" + hex.EncodeToString(b[:]), nil
+}
+
+func timestamp(t time.Time) string { return t.Format("2006-01-02T15:04:05Z") }
+
+func deflateBase64(s string) (string, error) {
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write([]byte(s)); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func splitQuery(q string) []string {
+	if q == "" {
+		return nil
+	}
+	return strings.Split(q, "&")
+}
+
+func cutEq(kv string) (key, value string, ok bool) {
+	return strings.Cut(kv, "=")
+}
@@ -0,0 +1,149 @@
+package saml_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	crewsaml "github.com/crewjam/saml"
+
+	"sso/internal/platform/saml"
+)
+
+const (
+	idpEntityID = "https://sso.example.com"
+	spEntityID  = "https://sp.example.com/metadata"
+	acsURL      = "https://sp.example.com/acs"
+	requestID   = "_9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d"
+)
+
+func newKeyPair(t *testing.T, cn string) *saml.KeyPair {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &saml.KeyPair{Cert: cert, Key: key}
+}
+
+func newIdP(kp *saml.KeyPair) *saml.IdP {
+	return &saml.IdP{
+		EntityID: idpEntityID,
+		SSOURL:   idpEntityID + "/v1/saml/sso",
+		SLOURL:   idpEntityID + "/v1/saml/slo",
+		Keys:     kp,
+		Now:      time.Now,
+	}
+}
+
+// serviceProvider is an independent SP (crewjam/saml, over goxmldsig)
+// configured from idp's published metadata.
+func serviceProvider(t *testing.T, idp *saml.IdP) *crewsaml.ServiceProvider {
+	t.Helper()
+	var md crewsaml.EntityDescriptor
+	if err := xml.Unmarshal([]byte(idp.Metadata()), &md); err != nil {
+		t.Fatalf("metadata: %v", err)
+	}
+	acs, _ := url.Parse(acsURL)
+	return &crewsaml.ServiceProvider{EntityID: spEntityID, AcsURL: *acs, IDPMetadata: &md}
+}
+
+func response(t *testing.T, idp *saml.IdP) []byte {
+	t.Helper()
+	encoded, err := idp.Response(saml.Assertion{
+		InResponseTo: requestID,
+		Audience:     spEntityID,
+		Recipient:    acsURL,
+		NameID:       "ada@example.com",
+		NameIDFormat: saml.NameIDFormatEmail,
+		SessionIndex: "_4f1c2a9e8b7d6c5e4f3a2b1c0d9e8f7a",
+		AuthnInstant: time.Now(),
+		TTL:          5 * time.Minute,
+		Attributes:   []saml.Attribute{{Name: "groups", Values: []string{"admins", "R&D <core>"}}},
+	})
+	if err != nil {
+		t.Fatalf("Response: %v", err)
+	}
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+// TestResponseVerifiesAtServiceProvider checks the signed assertion
+// against a third-party XML-DSig implementation: if the serialisation
+// ever stops being canonical, the digest no longer matches.
+func TestResponseVerifiesAtServiceProvider(t *testing.T) {
+	idp := newIdP(newKeyPair(t, "idp"))
+	sp := serviceProvider(t, idp)
+	acs, _ := url.Parse(acsURL)
+
+	a, err := sp.ParseXMLResponse(response(t, idp), []string{requestID}, *acs)
+	if err != nil {
+		t.Fatalf("ParseXMLResponse: %v", err.(*crewsaml.InvalidResponseError).PrivateErr)
+	}
+	if a.Subject.NameID.Value != "ada@example.com" {
+		t.Fatalf("NameID = %q", a.Subject.NameID.Value)
+	}
+	var groups []string
+	for _, st := range a.AttributeStatements {
+		for _, at := range st.Attributes {
+			for _, v := range at.Values {
+				groups = append(groups, v.Value)
+			}
+		}
+	}
+	if strings.Join(groups, ",") != "admins,R&D <core>" {
+		t.Fatalf("attribute values = %q", groups)
+	}
+}
+
+func TestResponseRejectedAtServiceProvider(t *testing.T) {
+	idp := newIdP(newKeyPair(t, "idp"))
+	acs, _ := url.Parse(acsURL)
+
+	cases := []struct {
+		name   string
+		sp     *crewsaml.ServiceProvider
+		tamper func(string) string
+	}{
+		{"tampered NameID", serviceProvider(t, idp), func(s string) string {
+			return strings.Replace(s, ">ada@example.com<", ">eve@example.com<", 1)
+		}},
+		{"tampered attribute", serviceProvider(t, idp), func(s string) string {
+			return strings.Replace(s, ">admins<", ">auditors<", 1)
+		}},
+		{"signed by another IdP key", serviceProvider(t, newIdP(newKeyPair(t, "other"))), func(s string) string { return s }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw := tc.tamper(string(response(t, idp)))
+			if _, err := tc.sp.ParseXMLResponse([]byte(raw), []string{requestID}, *acs); err == nil {
+				t.Fatal("accepted")
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package saml_test
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	crewsaml "github.com/crewjam/saml"
+
+	"sso/internal/platform/saml"
+)
+
+// TestLogoutResponseRedirectSignature checks the redirect-binding
+// signature from first principles (SAML bindings §3.4.4.1): RSA-SHA256
+// over SAMLResponse, RelayState and SigAlg, URL-encoded, in that order.
+func TestLogoutResponseRedirectSignature(t *testing.T) {
+	kp := newKeyPair(t, "idp")
+	idp := newIdP(kp)
+	msg, err := idp.LogoutResponse("https://sp.example.com/slo", requestID, saml.StatusSuccess)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := saml.SignRedirect("SAMLResponse", msg, "sp-relay", kp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vals, err := url.ParseQuery(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := "SAMLResponse=" + url.QueryEscape(vals.Get("SAMLResponse")) +
+		"&RelayState=" + url.QueryEscape(vals.Get("RelayState")) +
+		"&SigAlg=" + url.QueryEscape(vals.Get("SigAlg"))
+	sig, err := base64.StdEncoding.DecodeString(vals.Get("Signature"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := sha256.Sum256([]byte(signed))
+	if err := rsa.VerifyPKCS1v15(&kp.Key.PublicKey, crypto.SHA256, h[:], sig); err != nil {
+		t.Fatalf("signature: %v", err)
+	}
+	if vals.Get("SigAlg") != saml.AlgRSASHA256 {
+		t.Fatalf("SigAlg = %q", vals.Get("SigAlg"))
+	}
+	if err := saml.VerifyRedirect(q, kp.Cert); err != nil {
+		t.Fatalf("VerifyRedirect: %v", err)
+	}
+
+	data, err := saml.DecodeRedirect(vals.Get("SAMLResponse"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp crewsaml.LogoutResponse
+	if err := xml.Unmarshal(data, &resp); err != nil {
+		t.Fatalf("LogoutResponse: %v", err)
+	}
+	if resp.Destination != "https://sp.example.com/slo" || resp.InResponseTo != requestID ||
+		resp.Issuer.Value != idpEntityID || resp.Status.StatusCode.Value != saml.StatusSuccess {
+		t.Fatalf("LogoutResponse = %+v", resp)
+	}
+}
+
+// TestVerifyRedirectVector checks a LogoutRequest signed outside this
+// package: testdata/logout_request.query was deflated with zlib and
+// signed with `openssl dgst -sha256 -sign` by the key of testdata/sp.crt.
+func TestVerifyRedirectVector(t *testing.T) {
+	raw, err := os.ReadFile("testdata/logout_request.query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := strings.TrimSpace(string(raw))
+	certPEM, err := os.ReadFile("testdata/sp.crt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := saml.ParseCertificate(string(certPEM))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := saml.VerifyRedirect(query, cert); err != nil {
+		t.Fatalf("VerifyRedirect: %v", err)
+	}
+	vals, _ := url.ParseQuery(query)
+	data, err := saml.DecodeRedirect(vals.Get("SAMLRequest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := saml.ParseLogoutRequest(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Issuer != spEntityID || req.NameID != "ada@example.com" ||
+		len(req.SessionIndexes) != 1 || req.SessionIndexes[0] != "_4f1c2a9e8b7d6c5e4f3a2b1c0d9e8f7a" {
+		t.Fatalf("LogoutRequest = %+v", req)
+	}
+
+	// A byte flipped inside the deflated message: the base64 stays
+	// valid, the signed octets do not.
+	msg := vals.Get("SAMLRequest")
+	flipped := msg[:40] + string(rune(msg[40]^1)) + msg[40:][1:]
+
+	rejected := []struct {
+		name  string
+		query string
+		cert  string
+	}{
+		{"tampered message", strings.Replace(query, url.QueryEscape(msg), url.QueryEscape(flipped), 1), ""},
+		{"tampered relay state", strings.Replace(query, "RelayState=sp-relay", "RelayState=evil", 1), ""},
+		{"relay state dropped", strings.Replace(query, "&RelayState=sp-relay", "", 1), ""},
+		{"signature dropped", query[:strings.Index(query, "&Signature=")], ""},
+		{"weaker algorithm", strings.Replace(query, url.QueryEscape(saml.AlgRSASHA256),
+			url.QueryEscape("http://www.w3.org/2000/09/xmldsig#rsa-sha1"), 1), ""},
+		{"other certificate", query, "other"},
+	}
+	for _, tc := range rejected {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.query == query && tc.cert == "" {
+				t.Fatal("tampering did not change the query")
+			}
+			c := cert
+			if tc.cert != "" {
+				c = newKeyPair(t, tc.cert).Cert
+			}
+			if err := saml.VerifyRedirect(tc.query, c); !errors.Is(err, saml.ErrSignature) {
+				t.Fatalf("err = %v, want ErrSignature", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS federation_login_states;
+DROP TABLE IF EXISTS external_identities;
+DROP TABLE IF EXISTS identity_providers;
@@ -0,0 +1,76 @@
+-- Federated login through external OpenID Connect providers.
+--
+-- identity_providers      registry of trusted issuers. client_secret is
+--                         stored as-is: it is needed in plaintext for the
+--                         token-endpoint call, so hashing is not an option.
+--                         Protect the column with DB-level access control.
+-- scopes                  space-separated, as sent in the `scope` param.
+-- claim_*                 ID-token claim names mapped onto user fields.
+-- auto_provision          1 = create a local user on first login when no
+--                         link exists; 0 = the user must link explicitly.
+-- status                  1=ENABLED, 2=DISABLED.
+--
+-- external_identities     (provider, sub) → users.id. One link per
+--                         provider per user; a provider subject maps to
+--                         exactly one local user.
+--
+-- federation_login_states one row per in-flight authorization request.
+--                         state_hash is SHA-256 of the opaque `state`
+--                         value; rows are single-use (deleted on callback)
+--                         and expire after federation.state_ttl.
+--                         link_user_id is set when the flow links a
+--                         provider to an already signed-in user instead
+--                         of logging in.
+
+CREATE TABLE IF NOT EXISTS identity_providers (
+    id                 CHAR(36)         NOT NULL,
+    slug               VARCHAR(64)      NOT NULL,
+    display_name       VARCHAR(128)     NOT NULL,
+    issuer             VARCHAR(2048)    NOT NULL,
+    client_id          VARCHAR(255)     NOT NULL,
+    client_secret      VARCHAR(1024)    NOT NULL,
+    scopes             VARCHAR(1024)    NOT NULL,
+    claim_email        VARCHAR(64)      NOT NULL,
+    claim_username     VARCHAR(64)      NOT NULL,
+    claim_display_name VARCHAR(64)      NOT NULL,
+    auto_provision     TINYINT(1)       NOT NULL DEFAULT 0,
+    status             TINYINT UNSIGNED NOT NULL,
+    etag               CHAR(36)         NOT NULL,
+    created_at         DATETIME(6)      NOT NULL,
+    updated_at         DATETIME(6)      NOT NULL,
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_identity_providers_slug (slug)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS external_identities (
+    provider_id   CHAR(36)     NOT NULL,
+    subject       VARCHAR(255) NOT NULL,
+    user_id       CHAR(36)     NOT NULL,
+    email         VARCHAR(254)     NULL,
+    linked_at     DATETIME(6)  NOT NULL,
+    last_login_at DATETIME(6)      NULL,
+
+    PRIMARY KEY (provider_id, subject),
+    UNIQUE KEY uk_external_identities_user_provider (user_id, provider_id),
+    CONSTRAINT fk_external_identities_provider
+        FOREIGN KEY (provider_id) REFERENCES identity_providers(id) ON DELETE CASCADE,
+    CONSTRAINT fk_external_identities_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS federation_login_states (
+    state_hash    VARBINARY(32) NOT NULL,
+    provider_id   CHAR(36)      NOT NULL,
+    app_id        CHAR(36)          NULL,
+    nonce         VARCHAR(64)   NOT NULL,
+    code_verifier VARCHAR(128)  NOT NULL,
+    link_user_id  CHAR(36)          NULL,
+    created_at    DATETIME(6)   NOT NULL,
+    expires_at    DATETIME(6)   NOT NULL,
+
+    PRIMARY KEY (state_hash),
+    KEY idx_federation_login_states_expires (expires_at),
+    CONSTRAINT fk_federation_login_states_provider
+        FOREIGN KEY (provider_id) REFERENCES identity_providers(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
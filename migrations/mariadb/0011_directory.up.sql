@@ -0,0 +1,27 @@
+-- Password sign-in against an LDAP / Active Directory server.
+--
+-- directory_accounts  marks a local user as owned by the directory:
+--                     users.id → the entry's DN. The row is written
+--                     when the user is provisioned on first sign-in and
+--                     is what lets the ldap authenticator accept the
+--                     account later. A local account without a row is
+--                     never signed in through the directory, so a
+--                     directory entry that happens to share an email or
+--                     username cannot take it over.
+-- dn                  distinguished name as returned by the search;
+--                     unique (case-insensitive collation, matching LDAP
+--                     DN comparison for the common attribute types).
+-- synced_at           last successful sign-in, i.e. the last time the
+--                     group → role mapping was applied.
+
+CREATE TABLE IF NOT EXISTS directory_accounts (
+    user_id   CHAR(36)     NOT NULL,
+    dn        VARCHAR(700) NOT NULL,
+    linked_at DATETIME(6)  NOT NULL,
+    synced_at DATETIME(6)  NOT NULL,
+
+    PRIMARY KEY (user_id),
+    UNIQUE KEY uk_directory_accounts_dn (dn),
+    CONSTRAINT fk_directory_accounts_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS saml_logout_states;
+DROP TABLE IF EXISTS saml_participants;
+DROP TABLE IF EXISTS saml_service_providers;
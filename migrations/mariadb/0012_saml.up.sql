@@ -0,0 +1,66 @@
+-- SAML 2.0 identity provider.
+--
+-- saml_service_providers  one SP registration per app. entity_id is the
+--                         SP's Issuer and is unique: inbound requests are
+--                         routed to an app by it. certificate is the SP's
+--                         PEM signing certificate; when present, requests
+--                         from the SP must be signed with it.
+-- name_id_format          1=email (users.email), 2=persistent (users.id).
+--
+-- saml_participants       (session, app) pairs an assertion was issued
+--                         for. Single logout walks the participants of
+--                         the session being ended; rows go away with the
+--                         session or the SP registration. session_index
+--                         is a random value per pair, and an SP's
+--                         LogoutRequest is resolved through it.
+--
+-- saml_logout_states      one row per single-logout round in progress.
+--                         state_hash is SHA-256 of the RelayState sent
+--                         along with each LogoutRequest; origin_* are set
+--                         when an SP started the round and must receive
+--                         the final LogoutResponse.
+
+CREATE TABLE IF NOT EXISTS saml_service_providers (
+    app_id         CHAR(36)         NOT NULL,
+    entity_id      VARCHAR(700)     NOT NULL,
+    acs_url        VARCHAR(2048)    NOT NULL,
+    slo_url        VARCHAR(2048)        NULL,
+    certificate    TEXT                 NULL,
+    name_id_format TINYINT UNSIGNED NOT NULL,
+    etag           CHAR(36)         NOT NULL,
+    created_at     DATETIME(6)      NOT NULL,
+    updated_at     DATETIME(6)      NOT NULL,
+
+    PRIMARY KEY (app_id),
+    UNIQUE KEY uk_saml_service_providers_entity_id (entity_id),
+    CONSTRAINT fk_saml_service_providers_app
+        FOREIGN KEY (app_id) REFERENCES apps(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS saml_participants (
+    session_id    CHAR(36)     NOT NULL,
+    app_id        CHAR(36)     NOT NULL,
+    name_id       VARCHAR(254) NOT NULL,
+    session_index VARCHAR(64)  NOT NULL,
+    created_at    DATETIME(6)  NOT NULL,
+
+    PRIMARY KEY (session_id, app_id),
+    UNIQUE KEY uk_saml_participants_session_index (app_id, session_index),
+    CONSTRAINT fk_saml_participants_session
+        FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_saml_participants_sp
+        FOREIGN KEY (app_id) REFERENCES saml_service_providers(app_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS saml_logout_states (
+    state_hash         VARBINARY(32) NOT NULL,
+    session_id         CHAR(36)      NOT NULL,
+    origin_app_id      CHAR(36)          NULL,
+    origin_request_id  VARCHAR(128)      NULL,
+    origin_relay_state VARCHAR(1024)     NULL,
+    created_at         DATETIME(6)   NOT NULL,
+    expires_at         DATETIME(6)   NOT NULL,
+
+    PRIMARY KEY (state_hash),
+    KEY idx_saml_logout_states_expires (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,39 @@
+-- User invitations.
+--
+-- invitations    one row per invitation. token_hash is SHA-256 of the
+--                single-use acceptance token mailed to email; the token
+--                itself is never stored. Resend replaces token_hash and
+--                expires_at, so an earlier mail stops working.
+-- status         1=pending, 2=accepted, 3=revoked. Expiry is not a
+--                status: a pending row past expires_at is expired.
+-- role_ids       JSON array of role ids granted on acceptance.
+-- invited_by     user or service account that created the invitation;
+--                recorded as granted_by_user_id on the grants.
+-- pending_email  generated; equals email while pending and is NULL
+--                otherwise, so the unique key allows one pending
+--                invitation per address and any number of closed ones.
+
+CREATE TABLE IF NOT EXISTS invitations (
+    id               CHAR(36)         NOT NULL,
+    email            VARCHAR(255)     NOT NULL,
+    role_ids         JSON             NOT NULL,
+    status           TINYINT UNSIGNED NOT NULL,
+    token_hash       VARBINARY(32)    NOT NULL,
+    invited_by       CHAR(36)         NOT NULL,
+    accepted_by      CHAR(36)             NULL,
+    etag             CHAR(36)         NOT NULL,
+    expires_at       DATETIME(6)      NOT NULL,
+    accepted_at      DATETIME(6)          NULL,
+    created_at       DATETIME(6)      NOT NULL,
+    updated_at       DATETIME(6)      NOT NULL,
+    pending_email    VARCHAR(255)
+        AS (IF(status = 1, email, NULL)) PERSISTENT,
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_invitations_token_hash (token_hash),
+    UNIQUE KEY uk_invitations_pending_email (pending_email),
+    KEY idx_invitations_created (created_at, id),
+    KEY idx_invitations_email (email),
+    CONSTRAINT fk_invitations_accepted_by
+        FOREIGN KEY (accepted_by) REFERENCES users(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,39 @@
+-- Pending and past login-email changes.
+--
+-- user_email_changes  one row per request to move users.email from
+--                     old_email to new_email. users.email is only
+--                     rewritten when the change is confirmed.
+-- confirm_token_hash  SHA-256 of the token mailed to new_email.
+-- cancel_token_hash   SHA-256 of the token mailed to old_email with the
+--                     change notice. Valid while pending, and after
+--                     confirmation until cancel_until (reverts the
+--                     change).
+-- status              1=pending, 2=confirmed, 3=cancelled. A newer
+--                     request cancels the user's older pending ones.
+-- requested_by        user or admin that made the request; not a FK
+--                     (service accounts may ask too).
+
+CREATE TABLE IF NOT EXISTS user_email_changes (
+    id                  CHAR(36)         NOT NULL,
+    user_id             CHAR(36)         NOT NULL,
+    old_email           VARCHAR(254)     NOT NULL,
+    new_email           VARCHAR(254)     NOT NULL,
+    status              TINYINT UNSIGNED NOT NULL,
+    confirm_token_hash  VARBINARY(32)    NOT NULL,
+    cancel_token_hash   VARBINARY(32)    NOT NULL,
+    revoke_sessions     BOOLEAN          NOT NULL,
+    requested_by        CHAR(36)         NOT NULL,
+    expires_at          DATETIME(6)      NOT NULL,
+    cancel_until        DATETIME(6)      NOT NULL,
+    confirmed_at        DATETIME(6)          NULL,
+    cancelled_at        DATETIME(6)          NULL,
+    created_at          DATETIME(6)      NOT NULL,
+    updated_at          DATETIME(6)      NOT NULL,
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_user_email_changes_confirm (confirm_token_hash),
+    UNIQUE KEY uk_user_email_changes_cancel (cancel_token_hash),
+    KEY idx_user_email_changes_user (user_id, status),
+    CONSTRAINT fk_user_email_changes_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,9 @@
+ALTER TABLE users
+    DROP INDEX uk_users_username_skeleton,
+    DROP INDEX uk_users_email_skeleton,
+    DROP INDEX uk_users_username_key,
+    DROP INDEX uk_users_email_key,
+    DROP COLUMN username_skeleton,
+    DROP COLUMN email_skeleton,
+    DROP COLUMN username_key,
+    DROP COLUMN email_key;
@@ -0,0 +1,26 @@
+-- Unicode-aware identifier uniqueness for users.
+--
+-- email_key          NFKC + case-folded email with per-provider aliasing
+--                    removed (gmail dots, "+tag"); the lookup key.
+-- username_key       NFKC + case-folded username; the lookup key.
+-- *_skeleton         UTS #39 skeleton of the key: look-alike identifiers
+--                    ("аdmin" with Cyrillic а vs "admin") share it, so the
+--                    unique keys reject them.
+--
+-- All four are computed in Go (identity domain), hence utf8mb4_bin: the
+-- column collation must not fold a second time. Widths leave room for
+-- folding (ß → ss) and skeleton (m → rn) expansion; 768 utf8mb4
+-- characters is the 3072-byte InnoDB index limit. They are NULL for rows
+-- written before this migration until `identity-normalize -apply`
+-- backfills them; rows it reports as colliding keep NULL keys until an
+-- operator resolves the collision.
+
+ALTER TABLE users
+    ADD COLUMN email_key         VARCHAR(512) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL,
+    ADD COLUMN username_key      VARCHAR(256) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL,
+    ADD COLUMN email_skeleton    VARCHAR(768) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL,
+    ADD COLUMN username_skeleton VARCHAR(512) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL,
+    ADD UNIQUE KEY uk_users_email_key (email_key),
+    ADD UNIQUE KEY uk_users_username_key (username_key),
+    ADD UNIQUE KEY uk_users_email_skeleton (email_skeleton),
+    ADD UNIQUE KEY uk_users_username_skeleton (username_skeleton);
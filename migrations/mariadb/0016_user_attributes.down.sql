@@ -0,0 +1,5 @@
+ALTER TABLE sessions
+    DROP FOREIGN KEY fk_sessions_app,
+    DROP COLUMN app_id;
+DROP TABLE IF EXISTS user_attribute_values;
+DROP TABLE IF EXISTS app_attribute_defs;
@@ -0,0 +1,54 @@
+-- Per-app custom user attributes.
+--
+-- app_attribute_defs     the attribute schema of an app: one row per key.
+--                        type 1=string, 2=integer, 3=boolean; pattern is
+--                        an RE2 expression the whole string value must
+--                        match ('' = any); enum_values is a JSON array of
+--                        allowed string values (NULL = any). token_claim
+--                        marks attributes signed into the "attrs" claim
+--                        of access tokens issued for the app.
+-- user_attribute_values  one row per (user, app, key). value holds the
+--                        canonical text form ("42", "true"); the type
+--                        comes from the definition. utf8mb4_bin so
+--                        filters match exactly. Deleting a definition,
+--                        app or user cascades to its values.
+-- sessions.app_id        the app a session signed in to, so Refresh can
+--                        re-issue the app's claims. NULL for sessions
+--                        created before this migration.
+
+CREATE TABLE IF NOT EXISTS app_attribute_defs (
+    app_id       CHAR(36)         NOT NULL,
+    attr_key     VARCHAR(64)      NOT NULL,
+    type         TINYINT UNSIGNED NOT NULL,
+    required     BOOLEAN          NOT NULL,
+    pattern      VARCHAR(512)     NOT NULL,
+    enum_values  JSON                 NULL,
+    token_claim  BOOLEAN          NOT NULL,
+    description  VARCHAR(512)     NOT NULL,
+    created_at   DATETIME(6)      NOT NULL,
+    updated_at   DATETIME(6)      NOT NULL,
+
+    PRIMARY KEY (app_id, attr_key),
+    CONSTRAINT fk_app_attribute_defs_app
+        FOREIGN KEY (app_id) REFERENCES apps(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS user_attribute_values (
+    user_id      CHAR(36)         NOT NULL,
+    app_id       CHAR(36)         NOT NULL,
+    attr_key     VARCHAR(64)      NOT NULL,
+    value        VARCHAR(1024) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    updated_at   DATETIME(6)      NOT NULL,
+
+    PRIMARY KEY (user_id, app_id, attr_key),
+    KEY idx_user_attribute_values_lookup (app_id, attr_key, value(512), user_id),
+    CONSTRAINT fk_user_attribute_values_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_attribute_values_def
+        FOREIGN KEY (app_id, attr_key) REFERENCES app_attribute_defs(app_id, attr_key) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE sessions
+    ADD COLUMN app_id CHAR(36) NULL,
+    ADD CONSTRAINT fk_sessions_app
+        FOREIGN KEY (app_id) REFERENCES apps(id) ON DELETE SET NULL;
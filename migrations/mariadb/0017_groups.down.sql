@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS group_role_assignments;
+DROP TABLE IF EXISTS user_group_members;
+DROP TABLE IF EXISTS user_groups;
@@ -0,0 +1,58 @@
+-- User groups and group-level role assignments.
+--
+-- user_groups             one row per group. Groups are global (not per
+--                         app): the roles granted to a group carry their
+--                         own app. name is unique, case-insensitively.
+-- user_group_members      (group, user) membership. Deleting either side
+--                         cascades.
+-- group_role_assignments  the group counterpart of role_assignments: a
+--                         role granted to every member of the group.
+--                         app_id is denormalised from the role, as in
+--                         role_assignments. Deleting the group or the
+--                         role cascades.
+
+CREATE TABLE IF NOT EXISTS user_groups (
+    id           CHAR(36)         NOT NULL,
+    name         VARCHAR(128)     NOT NULL,
+    description  VARCHAR(1024)    NOT NULL,
+    etag         CHAR(36)         NOT NULL,
+    created_at   DATETIME(6)      NOT NULL,
+    updated_at   DATETIME(6)      NOT NULL,
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_user_groups_name (name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS user_group_members (
+    group_id          CHAR(36)     NOT NULL,
+    user_id           CHAR(36)     NOT NULL,
+    added_by_user_id  CHAR(36)     NOT NULL,
+    added_at          DATETIME(6)  NOT NULL,
+
+    PRIMARY KEY (group_id, user_id),
+
+    -- Membership of one user; drives permission resolution.
+    KEY idx_user_group_members_user (user_id, group_id),
+
+    CONSTRAINT fk_user_group_members_group
+        FOREIGN KEY (group_id) REFERENCES user_groups(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_group_members_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS group_role_assignments (
+    group_id           CHAR(36)     NOT NULL,
+    role_id            CHAR(36)     NOT NULL,
+    app_id             CHAR(36)     NOT NULL,
+    granted_by_user_id CHAR(36)     NOT NULL,
+    granted_at         DATETIME(6)  NOT NULL,
+
+    PRIMARY KEY (group_id, role_id),
+    KEY idx_group_role_assignments_group_app (group_id, app_id, granted_at, role_id),
+    KEY idx_group_role_assignments_role (role_id),
+
+    CONSTRAINT fk_group_role_assignments_group
+        FOREIGN KEY (group_id) REFERENCES user_groups(id) ON DELETE CASCADE,
+    CONSTRAINT fk_group_role_assignments_role
+        FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,20 @@
+-- Composite roles: a role may include other roles of the same app and
+-- thereby grant their permissions transitively.
+--
+-- role_includes  one row per (parent, child) edge. The graph is a DAG;
+--                the role service rejects an edge set that would close a
+--                cycle before writing it. Same-app membership is also
+--                enforced by the service (roles carry no composite key
+--                the FKs could pin). Deleting either role cascades.
+
+CREATE TABLE IF NOT EXISTS role_includes (
+    role_id           CHAR(36) NOT NULL,
+    included_role_id  CHAR(36) NOT NULL,
+
+    PRIMARY KEY (role_id, included_role_id),
+    KEY idx_role_includes_included (included_role_id),
+    CONSTRAINT fk_role_includes_role
+        FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_role_includes_included
+        FOREIGN KEY (included_role_id) REFERENCES roles(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
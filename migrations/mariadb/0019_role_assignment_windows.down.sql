@@ -0,0 +1,4 @@
+ALTER TABLE role_assignments
+    DROP KEY idx_role_assignments_expires_at,
+    DROP COLUMN expires_at,
+    DROP COLUMN not_before;
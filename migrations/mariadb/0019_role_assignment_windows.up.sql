@@ -0,0 +1,13 @@
+-- Time-bound role assignments.
+--
+-- role_assignments.not_before  the assignment contributes to
+--                              CheckPermission only from this instant
+--                              on. NULL = immediately.
+-- role_assignments.expires_at  ... and only before this instant. NULL =
+--                              never expires. The expiry sweeper deletes
+--                              rows past it, oldest first, via the index.
+
+ALTER TABLE role_assignments
+    ADD COLUMN not_before DATETIME(6) NULL,
+    ADD COLUMN expires_at DATETIME(6) NULL,
+    ADD KEY idx_role_assignments_expires_at (expires_at);
@@ -0,0 +1,30 @@
+-- Role assignments held by service accounts.
+--
+-- service_account_role_assignments  the service-account counterpart of
+--                                   role_assignments, window columns
+--                                   included. A sibling table rather
+--                                   than a principal type on
+--                                   role_assignments so both sides keep
+--                                   their FK: hard-deleting the service
+--                                   account or the role cascades, as it
+--                                   does for users.
+
+CREATE TABLE IF NOT EXISTS service_account_role_assignments (
+    service_account_id CHAR(36)     NOT NULL,
+    role_id            CHAR(36)     NOT NULL,
+    app_id             CHAR(36)     NOT NULL,
+    granted_by_user_id CHAR(36)     NOT NULL,
+    granted_at         DATETIME(6)  NOT NULL,
+    not_before         DATETIME(6)  NULL,
+    expires_at         DATETIME(6)  NULL,
+
+    PRIMARY KEY (service_account_id, role_id),
+    KEY idx_sa_role_assignments_sa_app_granted (service_account_id, app_id, granted_at, role_id),
+    KEY idx_sa_role_assignments_role (role_id),
+    KEY idx_sa_role_assignments_expires_at (expires_at),
+
+    CONSTRAINT fk_sa_role_assignments_role
+        FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
+    CONSTRAINT fk_sa_role_assignments_service_account
+        FOREIGN KEY (service_account_id) REFERENCES service_accounts(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
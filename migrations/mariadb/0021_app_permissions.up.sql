@@ -0,0 +1,20 @@
+-- Per-app permission catalog.
+--
+-- app_permissions  the permissions an app declares, one row per
+--                  resource:action name. deprecated entries stay valid
+--                  on roles that already hold them but cannot be added
+--                  to a role again. Roles of an app with no catalog
+--                  rows are not checked against it.
+
+CREATE TABLE IF NOT EXISTS app_permissions (
+    app_id       CHAR(36)      NOT NULL,
+    name         VARCHAR(64)   NOT NULL,
+    description  VARCHAR(512)  NOT NULL,
+    deprecated   BOOLEAN       NOT NULL,
+    created_at   DATETIME(6)   NOT NULL,
+    updated_at   DATETIME(6)   NOT NULL,
+
+    PRIMARY KEY (app_id, name),
+    CONSTRAINT fk_app_permissions_app
+        FOREIGN KEY (app_id) REFERENCES apps(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
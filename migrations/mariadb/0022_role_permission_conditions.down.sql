@@ -0,0 +1,2 @@
+ALTER TABLE role_permissions
+    DROP COLUMN condition_expr;
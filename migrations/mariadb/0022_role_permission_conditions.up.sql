@@ -0,0 +1,9 @@
+-- Conditional permissions (ABAC).
+--
+-- role_permissions.condition_expr  a CEL expression over user, app,
+--                                  resource, request and now that must
+--                                  hold for the permission to match in
+--                                  CheckPermission. '' = unconditional.
+
+ALTER TABLE role_permissions
+    ADD COLUMN condition_expr VARCHAR(1024) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS service_account_scoped_role_assignments;
+DROP TABLE IF EXISTS scoped_role_assignments;
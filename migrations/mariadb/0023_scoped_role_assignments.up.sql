@@ -0,0 +1,63 @@
+-- Resource-scoped role assignments.
+--
+-- scoped_role_assignments  a role granted to a user on one resource
+--                          instance (or subtree) rather than across the
+--                          whole app: "editor of project 42". The scope
+--                          is (resource_type, resource_id); resource_id
+--                          is a '/'-separated path, and a grant on a
+--                          path covers every id below it ("acme" covers
+--                          "acme/42"). A sibling table rather than
+--                          columns on role_assignments so the existing
+--                          (user, role) key and its callers stay
+--                          app-wide. app_id is denormalised from the
+--                          role. resource_id compares byte-wise: ids
+--                          are opaque to us and may be case-sensitive.
+--                          Deleting the user or the role cascades.
+--
+-- service_account_scoped_role_assignments
+--                          the service-account counterpart, a sibling
+--                          table for the same reason as
+--                          service_account_role_assignments: both sides
+--                          keep their FK, and deleting the service
+--                          account or the role cascades.
+
+CREATE TABLE IF NOT EXISTS scoped_role_assignments (
+    user_id            CHAR(36)      NOT NULL,
+    role_id            CHAR(36)      NOT NULL,
+    app_id             CHAR(36)      NOT NULL,
+    resource_type      VARCHAR(64)   NOT NULL,
+    resource_id        VARCHAR(255)  COLLATE utf8mb4_bin NOT NULL,
+    granted_by_user_id CHAR(36)      NOT NULL,
+    granted_at         DATETIME(6)   NOT NULL,
+
+    PRIMARY KEY (user_id, role_id, resource_type, resource_id),
+
+    -- One user's scoped grants in an app; drives permission resolution.
+    KEY idx_scoped_role_assignments_user_app (user_id, app_id),
+    KEY idx_scoped_role_assignments_role (role_id),
+
+    CONSTRAINT fk_scoped_role_assignments_user
+        FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_scoped_role_assignments_role
+        FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS service_account_scoped_role_assignments (
+    service_account_id CHAR(36)      NOT NULL,
+    role_id            CHAR(36)      NOT NULL,
+    app_id             CHAR(36)      NOT NULL,
+    resource_type      VARCHAR(64)   NOT NULL,
+    resource_id        VARCHAR(255)  COLLATE utf8mb4_bin NOT NULL,
+    granted_by_user_id CHAR(36)      NOT NULL,
+    granted_at         DATETIME(6)   NOT NULL,
+
+    PRIMARY KEY (service_account_id, role_id, resource_type, resource_id),
+
+    KEY idx_sa_scoped_role_assignments_sa_app (service_account_id, app_id),
+    KEY idx_sa_scoped_role_assignments_role (role_id),
+
+    CONSTRAINT fk_sa_scoped_role_assignments_service_account
+        FOREIGN KEY (service_account_id) REFERENCES service_accounts(id) ON DELETE CASCADE,
+    CONSTRAINT fk_sa_scoped_role_assignments_role
+        FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
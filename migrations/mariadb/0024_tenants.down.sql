@@ -0,0 +1,44 @@
+-- Restoring the global unique keys fails while two tenants share an
+-- email, username or name; resolve those rows first.
+
+ALTER TABLE roles
+    DROP FOREIGN KEY fk_roles_tenant,
+    DROP INDEX idx_roles_tenant,
+    DROP COLUMN tenant_id;
+
+ALTER TABLE invitations
+    DROP FOREIGN KEY fk_invitations_tenant,
+    DROP INDEX uk_invitations_tenant_pending_email,
+    ADD UNIQUE KEY uk_invitations_pending_email (pending_email),
+    DROP COLUMN tenant_id;
+
+ALTER TABLE service_accounts
+    DROP FOREIGN KEY fk_service_accounts_tenant,
+    DROP INDEX uk_service_accounts_tenant_name,
+    ADD UNIQUE KEY uk_service_accounts_name (name),
+    DROP COLUMN tenant_id;
+
+ALTER TABLE apps
+    DROP FOREIGN KEY fk_apps_tenant,
+    DROP INDEX uk_apps_tenant_name,
+    ADD UNIQUE KEY uk_apps_name (name),
+    DROP COLUMN tenant_id;
+
+ALTER TABLE users
+    DROP FOREIGN KEY fk_users_tenant,
+    DROP INDEX uk_users_tenant_username_skeleton,
+    DROP INDEX uk_users_tenant_email_skeleton,
+    DROP INDEX uk_users_tenant_username_key,
+    DROP INDEX uk_users_tenant_email_key,
+    DROP INDEX uk_users_tenant_username,
+    DROP INDEX uk_users_tenant_email,
+    ADD UNIQUE KEY uk_users_email (email),
+    ADD UNIQUE KEY uk_users_username (username),
+    ADD UNIQUE KEY uk_users_email_key (email_key),
+    ADD UNIQUE KEY uk_users_username_key (username_key),
+    ADD UNIQUE KEY uk_users_email_skeleton (email_skeleton),
+    ADD UNIQUE KEY uk_users_username_skeleton (username_skeleton),
+    MODIFY COLUMN email_skeleton VARCHAR(768) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL,
+    DROP COLUMN tenant_id;
+
+DROP TABLE IF EXISTS tenants;
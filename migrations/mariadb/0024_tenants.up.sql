@@ -0,0 +1,79 @@
+-- Tenants: isolated customer organisations sharing one deployment.
+--
+-- tenants           one row per organisation. slug is the stable,
+--                   URL-safe handle; unique. The system tenant (fixed id
+--                   below, slug "system") owns every row that predates
+--                   tenancy and is home to the super-admins (its
+--                   principals holding tenants:admin in sso-admin).
+-- *.tenant_id       users, apps, service accounts and roles each belong
+--                   to one tenant; existing rows default to the system
+--                   tenant. roles.tenant_id is denormalised from the app
+--                   so a role lookup can be filtered without a join.
+--                   Invitations record the tenant the account is
+--                   created in on acceptance. Deleting a tenant is
+--                   refused while it owns rows.
+--
+-- Natural keys become unique per tenant: the same email, username,
+-- app name or service-account name may exist once in each tenant. App
+-- slugs stay globally unique — they name apps in URLs and SAML
+-- metadata before any tenant is known.
+--
+-- email_skeleton narrows from 768 to 732 characters so that
+-- (tenant_id, email_skeleton) still fits the 3072-byte InnoDB index
+-- limit; skeletons of a 254-character email stay well below it.
+
+CREATE TABLE IF NOT EXISTS tenants (
+    id         CHAR(36)     NOT NULL,
+    slug       VARCHAR(64)  NOT NULL,
+    name       VARCHAR(128) NOT NULL,
+    etag       CHAR(36)     NOT NULL,
+    created_at DATETIME(6)  NOT NULL,
+    updated_at DATETIME(6)  NOT NULL,
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenants_slug (slug)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT INTO tenants (id, slug, name, etag, created_at, updated_at)
+VALUES ('00000000-0000-7000-8000-000000000000', 'system', 'System',
+        UUID(), UTC_TIMESTAMP(6), UTC_TIMESTAMP(6));
+
+ALTER TABLE users
+    ADD COLUMN tenant_id CHAR(36) NOT NULL DEFAULT '00000000-0000-7000-8000-000000000000',
+    MODIFY COLUMN email_skeleton VARCHAR(732) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL,
+    DROP INDEX uk_users_email,
+    DROP INDEX uk_users_username,
+    DROP INDEX uk_users_email_key,
+    DROP INDEX uk_users_username_key,
+    DROP INDEX uk_users_email_skeleton,
+    DROP INDEX uk_users_username_skeleton,
+    ADD UNIQUE KEY uk_users_tenant_email (tenant_id, email),
+    ADD UNIQUE KEY uk_users_tenant_username (tenant_id, username),
+    ADD UNIQUE KEY uk_users_tenant_email_key (tenant_id, email_key),
+    ADD UNIQUE KEY uk_users_tenant_username_key (tenant_id, username_key),
+    ADD UNIQUE KEY uk_users_tenant_email_skeleton (tenant_id, email_skeleton),
+    ADD UNIQUE KEY uk_users_tenant_username_skeleton (tenant_id, username_skeleton),
+    ADD CONSTRAINT fk_users_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id);
+
+ALTER TABLE apps
+    ADD COLUMN tenant_id CHAR(36) NOT NULL DEFAULT '00000000-0000-7000-8000-000000000000',
+    DROP INDEX uk_apps_name,
+    ADD UNIQUE KEY uk_apps_tenant_name (tenant_id, name),
+    ADD CONSTRAINT fk_apps_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id);
+
+ALTER TABLE service_accounts
+    ADD COLUMN tenant_id CHAR(36) NOT NULL DEFAULT '00000000-0000-7000-8000-000000000000',
+    DROP INDEX uk_service_accounts_name,
+    ADD UNIQUE KEY uk_service_accounts_tenant_name (tenant_id, name),
+    ADD CONSTRAINT fk_service_accounts_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id);
+
+ALTER TABLE invitations
+    ADD COLUMN tenant_id CHAR(36) NOT NULL DEFAULT '00000000-0000-7000-8000-000000000000',
+    DROP INDEX uk_invitations_pending_email,
+    ADD UNIQUE KEY uk_invitations_tenant_pending_email (tenant_id, pending_email),
+    ADD CONSTRAINT fk_invitations_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id);
+
+ALTER TABLE roles
+    ADD COLUMN tenant_id CHAR(36) NOT NULL DEFAULT '00000000-0000-7000-8000-000000000000',
+    ADD KEY idx_roles_tenant (tenant_id),
+    ADD CONSTRAINT fk_roles_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id);
@@ -0,0 +1,18 @@
+-- Authorization data version: the cross-replica invalidation channel of
+-- the permission snapshots CheckPermission caches.
+--
+-- authz_version  a single row (id = 1). Every write that can change a
+--                permission decision — grants, role edits, group
+--                membership, principal and app deletion — increments
+--                version; each replica polls it and drops its cached
+--                snapshots when it moves. The value itself means
+--                nothing beyond "changed".
+
+CREATE TABLE IF NOT EXISTS authz_version (
+    id      TINYINT UNSIGNED NOT NULL,
+    version BIGINT UNSIGNED  NOT NULL,
+
+    PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT IGNORE INTO authz_version (id, version) VALUES (1, 0);
@@ -0,0 +1,65 @@
+-- Access review (certification) campaigns.
+--
+-- access_review_campaigns  one row per campaign: the assignments of one
+--                          app, or of one role in it, re-certified by a
+--                          deadline. role_id is NULL for an app-wide
+--                          campaign. deadline_action is what happens to
+--                          items still pending at due_at: 1 revoke them,
+--                          2 hand them to escalate_to and move due_at
+--                          (escalated_at records it); an escalated
+--                          campaign revokes at its new deadline.
+--                          tenant_id is the app's.
+-- access_review_items      one direct assignment captured when the
+--                          campaign opened, with the reviewer deciding
+--                          it. decision: 1 pending, 2 approved,
+--                          3 revoked; decided_by is NULL for the
+--                          deadline's revocations. principal_type
+--                          mirrors access's PrincipalKind. Items keep
+--                          plain ids rather than foreign keys: a review
+--                          record outlives the principal, role and
+--                          reviewer it names.
+
+CREATE TABLE IF NOT EXISTS access_review_campaigns (
+    id               CHAR(36)         NOT NULL,
+    tenant_id        CHAR(36)         NOT NULL,
+    name             VARCHAR(128)     NOT NULL,
+    app_id           CHAR(36)         NOT NULL,
+    role_id          CHAR(36)         NULL,
+    status           TINYINT UNSIGNED NOT NULL,
+    deadline_action  TINYINT UNSIGNED NOT NULL,
+    escalate_to      CHAR(36)         NULL,
+    due_at           DATETIME(6)      NOT NULL,
+    escalated_at     DATETIME(6)      NULL,
+    created_by       CHAR(36)         NOT NULL,
+    etag             CHAR(36)         NOT NULL,
+    created_at       DATETIME(6)      NOT NULL,
+    updated_at       DATETIME(6)      NOT NULL,
+    closed_at        DATETIME(6)      NULL,
+
+    PRIMARY KEY (id),
+    KEY idx_access_review_campaigns_tenant (tenant_id, created_at, id),
+    -- The deadline sweep: open campaigns past due_at.
+    KEY idx_access_review_campaigns_due (status, due_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS access_review_items (
+    id              CHAR(36)         NOT NULL,
+    campaign_id     CHAR(36)         NOT NULL,
+    principal_type  TINYINT UNSIGNED NOT NULL,
+    principal_id    CHAR(36)         NOT NULL,
+    role_id         CHAR(36)         NOT NULL,
+    reviewer_id     CHAR(36)         NOT NULL,
+    decision        TINYINT UNSIGNED NOT NULL,
+    decided_by      CHAR(36)         NULL,
+    decided_at      DATETIME(6)      NULL,
+    comment         VARCHAR(1024)    NOT NULL,
+    created_at      DATETIME(6)      NOT NULL,
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_access_review_items_assignment (campaign_id, principal_id, role_id),
+    KEY idx_access_review_items_campaign (campaign_id, decision, id),
+    KEY idx_access_review_items_reviewer (reviewer_id, decision),
+
+    CONSTRAINT fk_access_review_items_campaign
+        FOREIGN KEY (campaign_id) REFERENCES access_review_campaigns(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,45 @@
+-- Just-in-time access requests.
+--
+-- access_requests   one row per request by requester_id for role_id of
+--                   app_id, for duration_seconds once approved.
+--                   tenant_id is the app's.
+-- status            1=pending, 2=approved, 3=denied, 4=cancelled,
+--                   5=expired. A pending request past expires_at can no
+--                   longer be approved; the expiry sweep moves it to 5.
+-- decided_by        the approver or denier; NULL while pending and for
+--                   cancelled and expired requests.
+-- grant_expires_at  expires_at of the role assignment made on approval.
+-- pending_key       generated; requester_id + role_id while pending and
+--                   NULL otherwise, so the unique key allows one pending
+--                   request per requester and role.
+--
+-- Plain ids rather than foreign keys: the request is a record of the
+-- grant and outlives the requester, role and app it names.
+
+CREATE TABLE IF NOT EXISTS access_requests (
+    id                CHAR(36)         NOT NULL,
+    tenant_id         CHAR(36)         NOT NULL,
+    app_id            CHAR(36)         NOT NULL,
+    role_id           CHAR(36)         NOT NULL,
+    requester_id      CHAR(36)         NOT NULL,
+    justification     VARCHAR(1024)    NOT NULL,
+    duration_seconds  BIGINT           NOT NULL,
+    status            TINYINT UNSIGNED NOT NULL,
+    decided_by        CHAR(36)             NULL,
+    decision_comment  VARCHAR(1024)    NOT NULL,
+    decided_at        DATETIME(6)          NULL,
+    grant_expires_at  DATETIME(6)          NULL,
+    etag              CHAR(36)         NOT NULL,
+    expires_at        DATETIME(6)      NOT NULL,
+    created_at        DATETIME(6)      NOT NULL,
+    updated_at        DATETIME(6)      NOT NULL,
+    pending_key       CHAR(72)
+        AS (IF(status = 1, CONCAT(requester_id, role_id), NULL)) PERSISTENT,
+
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_access_requests_pending_key (pending_key),
+    KEY idx_access_requests_tenant (tenant_id, created_at, id),
+    KEY idx_access_requests_requester (requester_id, created_at),
+    -- The expiry sweep: pending requests past expires_at.
+    KEY idx_access_requests_expiry (status, expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS sod_rule_roles;
+DROP TABLE IF EXISTS sod_rules;